@@ -0,0 +1,53 @@
+package dto
+
+import "time"
+
+// NovelAnalyticsRequest represents query parameters for the owner analytics dashboard
+type NovelAnalyticsRequest struct {
+	From        string `form:"from" validate:"omitempty,datetime=2006-01-02"`   // Ngày bắt đầu (YYYY-MM-DD, default: 30 ngày trước)
+	To          string `form:"to" validate:"omitempty,datetime=2006-01-02"`     // Ngày kết thúc (YYYY-MM-DD, default: hôm nay)
+	Granularity string `form:"granularity" validate:"omitempty,oneof=day week"` // Độ chi tiết của chuỗi (default: day)
+}
+
+// AnalyticsPoint represents one bucket of an analytics series
+type AnalyticsPoint struct {
+	Period        time.Time `json:"period"`         // Ngày, hoặc thứ hai đầu tuần khi granularity=week
+	Views         int64     `json:"views"`          // Lượt xem novel
+	UniqueReaders int64     `json:"unique_readers"` // Người đọc duy nhất trong kỳ
+	Purchases     int64     `json:"purchases"`      // Lượt mua
+	Rentals       int64     `json:"rentals"`        // Lượt thuê
+	RevenueCoins  int64     `json:"revenue_coins"`  // Doanh thu (coins)
+	Bookmarks     int64     `json:"bookmarks"`      // Lượt bookmark
+}
+
+// AnalyticsTotals sums the additive metrics over the requested range
+type AnalyticsTotals struct {
+	Views        int64 `json:"views"`
+	Purchases    int64 `json:"purchases"`
+	Rentals      int64 `json:"rentals"`
+	RevenueCoins int64 `json:"revenue_coins"`
+	Bookmarks    int64 `json:"bookmarks"`
+}
+
+// ChapterDropoffResponse reports how many readers reached a chapter during the range
+type ChapterDropoffResponse struct {
+	ChapterID             string  `json:"chapter_id"`
+	VolumeNumber          int     `json:"volume_number"`
+	ChapterNumber         int     `json:"chapter_number"`
+	Title                 *string `json:"title"`
+	Views                 int64   `json:"views"`                   // Lượt đọc chương
+	Readers               int64   `json:"readers"`                 // Tổng người đọc duy nhất theo ngày
+	RetentionFromFirst    float64 `json:"retention_from_first"`    // Readers / readers của chương đầu tiên
+	RetentionFromPrevious float64 `json:"retention_from_previous"` // Readers / readers của chương liền trước
+}
+
+// NovelAnalyticsResponse represents the owner analytics dashboard of a novel
+type NovelAnalyticsResponse struct {
+	NovelID        string                   `json:"novel_id"`
+	From           string                   `json:"from"`
+	To             string                   `json:"to"`
+	Granularity    string                   `json:"granularity"`
+	Series         []AnalyticsPoint         `json:"series"`
+	Totals         AnalyticsTotals          `json:"totals"`
+	ChapterDropoff []ChapterDropoffResponse `json:"chapter_dropoff"`
+}
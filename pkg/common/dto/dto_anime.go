@@ -0,0 +1,311 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	m "wibusystem/pkg/common/model"
+)
+
+// CreateAnimeRequest represents the payload for creating a new anime series
+type CreateAnimeRequest struct {
+	Title           string           `json:"title" validate:"required,max=1000"`                                              // Tên anime (bắt buộc)
+	CoverImage      string           `json:"cover_image" validate:"max=1000"`                                                 // URL ảnh bìa
+	Summary         *json.RawMessage `json:"summary,omitempty"`                                                               // Tóm tắt đa ngôn ngữ (JSONB)
+	Status          string           `json:"status" validate:"required,oneof=ONGOING COMPLETED HIATUS"`                       // Trạng thái phát sóng
+	BroadcastSeason string           `json:"broadcast_season,omitempty" validate:"omitempty,oneof=SPRING SUMMER FALL WINTER"` // Mùa phát sóng
+	BroadcastYear   *int             `json:"broadcast_year,omitempty" validate:"omitempty,min=1900,max=2100"`                 // Năm phát sóng
+
+	// Associations
+	Genres       []string                `json:"genres" validate:"dive,uuid"`            // Mảng UUID genres
+	Creators     []CreatorRole           `json:"creators,omitempty" validate:"dive"`     // Studio, đạo diễn...
+	Translations []AnimeTranslationInput `json:"translations,omitempty" validate:"dive"` // Tiêu đề đa ngôn ngữ
+
+	// Publishing và rating
+	OriginalLanguage string `json:"original_language" validate:"max=5"`                                 // Ngôn ngữ gốc (ISO 639-1)
+	AgeRating        string `json:"age_rating,omitempty" validate:"omitempty,oneof=G PG PG-13 R NC-17"` // Phân loại độ tuổi
+	MatureContent    bool   `json:"mature_content"`                                                     // Nội dung người lớn
+	IsPublic         bool   `json:"is_public"`                                                          // Công khai hay riêng tư
+
+	// Ownership fields (owner IDs are set from the auth context)
+	OwnershipType     string    `json:"ownership_type" validate:"required,oneof=PERSONAL TENANT COLLABORATIVE"` // PERSONAL, TENANT, COLLABORATIVE
+	PrimaryOwnerID    uuid.UUID `json:"-"`                                                                      // user_id or tenant_id
+	OriginalCreatorID uuid.UUID `json:"-"`                                                                      // user who creates
+	AccessLevel       string    `json:"access_level" validate:"required,oneof=PRIVATE TENANT_ONLY PUBLIC"`      // PRIVATE, TENANT_ONLY, PUBLIC
+}
+
+// UpdateAnimeRequest represents the payload for updating an anime series
+type UpdateAnimeRequest struct {
+	Title           *string          `json:"title,omitempty" validate:"omitempty,max=1000"`
+	CoverImage      *string          `json:"cover_image,omitempty" validate:"omitempty,max=1000"`
+	Summary         *json.RawMessage `json:"summary,omitempty"`
+	Status          *string          `json:"status,omitempty" validate:"omitempty,oneof=ONGOING COMPLETED HIATUS"`
+	BroadcastSeason *string          `json:"broadcast_season,omitempty" validate:"omitempty,oneof=SPRING SUMMER FALL WINTER"`
+	BroadcastYear   *int             `json:"broadcast_year,omitempty" validate:"omitempty,min=1900,max=2100"`
+	Genres          []string         `json:"genres,omitempty" validate:"dive,uuid"` // Thay thế toàn bộ genres nếu có
+
+	OriginalLanguage *string `json:"original_language,omitempty" validate:"omitempty,max=5"`
+	AgeRating        *string `json:"age_rating,omitempty" validate:"omitempty,oneof=G PG PG-13 R NC-17"`
+	MatureContent    *bool   `json:"mature_content,omitempty"`
+	IsPublic         *bool   `json:"is_public,omitempty"`
+	AccessLevel      *string `json:"access_level,omitempty" validate:"omitempty,oneof=PRIVATE TENANT_ONLY PUBLIC"`
+
+	LastModifiedByUserID uuid.UUID `json:"-"` // Set from context
+}
+
+// AnimeTranslationInput is one localized title of an anime
+type AnimeTranslationInput struct {
+	LanguageCode string  `json:"language_code" validate:"required,max=5"`
+	Title        string  `json:"title" validate:"required,max=1000"`
+	Description  *string `json:"description,omitempty"`
+	IsPrimary    bool    `json:"is_primary"` // Tối đa một tiêu đề chính mỗi ngôn ngữ
+}
+
+// SetAnimeTranslationsRequest replaces all localized titles of an anime
+type SetAnimeTranslationsRequest struct {
+	Translations []AnimeTranslationInput `json:"translations" validate:"dive"`
+}
+
+// AnimeCastInput casts a character, optionally with its voice actor (creator)
+type AnimeCastInput struct {
+	CharacterID  string  `json:"character_id" validate:"required,uuid"`
+	VoiceActorID *string `json:"voice_actor_id,omitempty" validate:"omitempty,uuid"`
+}
+
+// SetAnimeCastRequest replaces the cast of an anime
+type SetAnimeCastRequest struct {
+	Cast []AnimeCastInput `json:"cast" validate:"dive"`
+}
+
+// ListAnimeRequest represents query parameters for listing anime
+type ListAnimeRequest struct {
+	Page     int `form:"page" validate:"omitempty,min=1"`              // Trang hiện tại (default: 1)
+	PageSize int `form:"page_size" validate:"omitempty,min=1,max=100"` // Kích thước trang (default: 20, max: 100)
+
+	Status          string     `form:"status" validate:"omitempty,oneof=ONGOING COMPLETED HIATUS"`
+	BroadcastSeason string     `form:"broadcast_season" validate:"omitempty,oneof=SPRING SUMMER FALL WINTER"`
+	BroadcastYear   *int       `form:"broadcast_year"`
+	GenreID         *uuid.UUID `form:"genre_id"`
+	PrimaryOwnerID  *uuid.UUID `form:"primary_owner_id"`
+	MatureContent   *bool      `form:"mature_content"`
+	Search          string     `form:"search" validate:"omitempty,max=100"` // Tìm trong tên và tiêu đề dịch
+
+	SortBy    string `form:"sort_by" validate:"omitempty,oneof=name created_at updated_at broadcast_year"`
+	SortOrder string `form:"sort_order" validate:"omitempty,oneof=asc desc"` // default: desc
+
+	// Set by the handler from the viewer; nil applies no age gating or reader filters
+	Visibility *ContentVisibility `form:"-" json:"-"`
+}
+
+// AnimeSummaryResponse - response tối ưu cho list anime
+type AnimeSummaryResponse struct {
+	ID              string              `json:"id"`
+	Name            string              `json:"name"` // Tên theo ngôn ngữ client (fallback: name)
+	CoverImage      *string             `json:"cover_image"`
+	Status          string              `json:"status"`
+	BroadcastSeason *string             `json:"broadcast_season"`
+	BroadcastYear   *int                `json:"broadcast_year"`
+	MatureContent   bool                `json:"mature_content"`
+	SeasonCount     int                 `json:"season_count"`
+	EpisodeCount    int                 `json:"episode_count"` // Chỉ tính tập đã phát hành
+	CreatedAt       time.Time           `json:"created_at"`
+	Restriction     *ContentRestriction `json:"restriction,omitempty"` // Có khi anime bị làm mờ hoặc cần cảnh báo
+}
+
+// PaginatedAnimeResponse - response với pagination
+type PaginatedAnimeResponse struct {
+	Anime      []AnimeSummaryResponse `json:"anime"`
+	Pagination PaginationMeta         `json:"pagination"`
+}
+
+// AnimeCastResponse is one cast entry of an anime
+type AnimeCastResponse struct {
+	CharacterID    string  `json:"character_id"`
+	CharacterName  string  `json:"character_name"`
+	CharacterImage *string `json:"character_image"`
+	VoiceActorID   *string `json:"voice_actor_id"`
+	VoiceActorName *string `json:"voice_actor_name"`
+}
+
+// AnimeSeasonSummary is a season with its pricing and published episode count
+type AnimeSeasonSummary struct {
+	ID                 string  `json:"id"`
+	SeasonNumber       int     `json:"season_number"`
+	SeasonTitle        *string `json:"season_title"`
+	PriceCoins         *int    `json:"price_coins"`
+	RentalPriceCoins   *int    `json:"rental_price_coins"`
+	RentalDurationDays *int    `json:"rental_duration_days"`
+	EpisodeCount       int     `json:"episode_count"`
+}
+
+// AnimeTranslationResponse is a localized title of an anime
+type AnimeTranslationResponse struct {
+	LanguageCode string  `json:"language_code"`
+	Title        string  `json:"title"`
+	Description  *string `json:"description"`
+	IsPrimary    bool    `json:"is_primary"`
+}
+
+// AnimeDetailResponse represents detailed anime information
+type AnimeDetailResponse struct {
+	ID               string                        `json:"id"`
+	Name             string                        `json:"name"`          // Tên theo ngôn ngữ client
+	OriginalName     *string                       `json:"original_name"` // Tên mặc định (anime.name)
+	Description      *string                       `json:"description"`   // Mô tả theo ngôn ngữ client
+	CoverImage       *string                       `json:"cover_image"`
+	Summary          *json.RawMessage              `json:"summary"`
+	Status           string                        `json:"status"`
+	BroadcastSeason  *string                       `json:"broadcast_season"`
+	BroadcastYear    *int                          `json:"broadcast_year"`
+	OriginalLanguage string                        `json:"original_language"`
+	CurrentLanguage  string                        `json:"current_language"`
+	AgeRating        *string                       `json:"age_rating"`
+	MatureContent    bool                          `json:"mature_content"`
+	IsPublic         bool                          `json:"is_public"`
+	AccessLevel      string                        `json:"access_level"`
+	OwnershipType    string                        `json:"ownership_type"`
+	PrimaryOwnerID   *string                       `json:"primary_owner_id"`
+	PublishedAt      *time.Time                    `json:"published_at"`
+	Genres           []GenreInfo                   `json:"genres"`
+	Creators         []CreatorInfo                 `json:"creators"`
+	Cast             []AnimeCastResponse           `json:"cast"`
+	Seasons          []AnimeSeasonSummary          `json:"seasons"`
+	Translations     []AnimeTranslationResponse    `json:"translations"`
+	Relations        *ContentRelationGraphResponse `json:"relations"`             // Các tác phẩm liên quan
+	Restriction      *ContentRestriction           `json:"restriction,omitempty"` // Có khi bị làm mờ hoặc cần cảnh báo
+	CreatedAt        time.Time                     `json:"created_at"`
+	UpdatedAt        time.Time                     `json:"updated_at"`
+}
+
+// CreateAnimeSeasonRequest represents the payload for creating a season
+type CreateAnimeSeasonRequest struct {
+	SeasonNumber       int     `json:"season_number" validate:"required,min=1"`
+	SeasonTitle        *string `json:"season_title,omitempty" validate:"omitempty,max=500"`
+	PriceCoins         *int    `json:"price_coins,omitempty" validate:"omitempty,min=0"`          // Giá mua trọn mùa
+	RentalPriceCoins   *int    `json:"rental_price_coins,omitempty" validate:"omitempty,min=0"`   // Giá thuê mùa
+	RentalDurationDays *int    `json:"rental_duration_days,omitempty" validate:"omitempty,min=1"` // Thời hạn thuê (ngày)
+}
+
+// UpdateAnimeSeasonRequest represents the payload for updating a season
+type UpdateAnimeSeasonRequest struct {
+	SeasonNumber       *int    `json:"season_number,omitempty" validate:"omitempty,min=1"`
+	SeasonTitle        *string `json:"season_title,omitempty" validate:"omitempty,max=500"`
+	PriceCoins         *int    `json:"price_coins,omitempty" validate:"omitempty,min=0"`
+	RentalPriceCoins   *int    `json:"rental_price_coins,omitempty" validate:"omitempty,min=0"`
+	RentalDurationDays *int    `json:"rental_duration_days,omitempty" validate:"omitempty,min=1"`
+}
+
+// CreateAnimeEpisodeRequest represents the payload for creating an episode
+type CreateAnimeEpisodeRequest struct {
+	EpisodeNumber   int     `json:"episode_number" validate:"required,min=1"`
+	Title           *string `json:"title,omitempty" validate:"omitempty,max=500"`
+	DurationSeconds *int    `json:"duration_seconds,omitempty" validate:"omitempty,min=1"`
+	VideoURL        *string `json:"video_url,omitempty" validate:"omitempty,max=2000"`
+	IsPublic        bool    `json:"is_public"`                                        // Miễn phí
+	PriceCoins      *int    `json:"price_coins,omitempty" validate:"omitempty,min=0"` // Giá mua lẻ tập
+}
+
+// UpdateAnimeEpisodeRequest represents the payload for updating an episode
+type UpdateAnimeEpisodeRequest struct {
+	EpisodeNumber   *int    `json:"episode_number,omitempty" validate:"omitempty,min=1"`
+	Title           *string `json:"title,omitempty" validate:"omitempty,max=500"`
+	DurationSeconds *int    `json:"duration_seconds,omitempty" validate:"omitempty,min=1"`
+	VideoURL        *string `json:"video_url,omitempty" validate:"omitempty,max=2000"`
+	IsPublic        *bool   `json:"is_public,omitempty"`
+	PriceCoins      *int    `json:"price_coins,omitempty" validate:"omitempty,min=0"`
+}
+
+// PublishAnimeEpisodeRequest represents the payload for publishing an episode
+type PublishAnimeEpisodeRequest struct {
+	PublishAt *time.Time `json:"publish_at,omitempty"` // Mặc định: hiện tại
+}
+
+// ListAnimeEpisodesRequest represents query parameters for listing episodes of a season
+type ListAnimeEpisodesRequest struct {
+	Page     int `form:"page" validate:"omitempty,min=1"`
+	PageSize int `form:"page_size" validate:"omitempty,min=1,max=100"`
+}
+
+// SetEpisodeSubtitleRequest sets the subtitle of one language: either an external
+// subtitle_url, or SRT / WebVTT / ASS content that is validated and stored as WebVTT
+type SetEpisodeSubtitleRequest struct {
+	SubtitleURL string `json:"subtitle_url,omitempty" validate:"omitempty,max=2000"`
+	Content     string `json:"content,omitempty" validate:"omitempty,max=2000000"`
+	Format      string `json:"format,omitempty" validate:"omitempty,oneof=srt vtt webvtt ass ssa"` // Tự nhận diện nếu bỏ trống
+}
+
+// ShiftEpisodeSubtitleRequest moves every cue of a hosted subtitle; negative offsets move cues earlier
+type ShiftEpisodeSubtitleRequest struct {
+	OffsetMs int64 `json:"offset_ms" validate:"required"`
+}
+
+// AnimeEpisodeResponse represents an episode; video_url is only filled for free
+// episodes, entitled viewers and managers
+type AnimeEpisodeResponse struct {
+	ID              string                    `json:"id"`
+	SeasonID        string                    `json:"season_id"`
+	EpisodeNumber   int                       `json:"episode_number"`
+	Title           *string                   `json:"title"`
+	DurationSeconds *int                      `json:"duration_seconds"`
+	VideoURL        *string                   `json:"video_url,omitempty"`
+	IsPublic        bool                      `json:"is_public"`
+	PriceCoins      *int                      `json:"price_coins"`
+	IsDraft         bool                      `json:"is_draft"`
+	PublishedAt     *time.Time                `json:"published_at"`
+	Subtitles       []EpisodeSubtitleResponse `json:"subtitles,omitempty"`
+	CreatedAt       time.Time                 `json:"created_at"`
+	UpdatedAt       time.Time                 `json:"updated_at"`
+}
+
+// EpisodeSubtitleResponse is one subtitle track of an episode; hosted tracks are
+// served as WebVTT by GET /anime/episodes/{episode_id}/subtitles/{language_code}
+type EpisodeSubtitleResponse struct {
+	LanguageCode string  `json:"language_code"`
+	SubtitleURL  *string `json:"subtitle_url"`
+	Hosted       bool    `json:"hosted"`
+	SourceFormat *string `json:"source_format,omitempty"`
+	CueCount     *int    `json:"cue_count,omitempty"`
+}
+
+// CreateSubtitleContributionRequest submits a community subtitle for review
+type CreateSubtitleContributionRequest struct {
+	LanguageCode string  `json:"language_code" validate:"required,max=10"`
+	Content      string  `json:"content" validate:"required,max=2000000"`
+	Format       string  `json:"format,omitempty" validate:"omitempty,oneof=srt vtt webvtt ass ssa"` // Tự nhận diện nếu bỏ trống
+	Note         *string `json:"note,omitempty" validate:"omitempty,max=1000"`
+}
+
+// UpdateSubtitleContributionRequest replaces the subtitle of a pending contribution
+type UpdateSubtitleContributionRequest struct {
+	Content string  `json:"content" validate:"required,max=2000000"`
+	Format  string  `json:"format,omitempty" validate:"omitempty,oneof=srt vtt webvtt ass ssa"`
+	Note    *string `json:"note,omitempty" validate:"omitempty,max=1000"`
+}
+
+// ListSubtitleContributionsRequest represents query parameters for the subtitle review queue
+type ListSubtitleContributionsRequest struct {
+	Page         int    `form:"page" validate:"omitempty,min=1"`
+	PageSize     int    `form:"page_size" validate:"omitempty,min=1,max=100"`
+	Status       string `form:"status" validate:"omitempty,oneof=pending approved rejected"` // Mặc định: pending (queue)
+	EpisodeID    string `form:"episode_id" validate:"omitempty,uuid"`
+	LanguageCode string `form:"language_code" validate:"omitempty,max=10"`
+}
+
+// RejectSubtitleContributionRequest represents the reviewer's rejection reason
+type RejectSubtitleContributionRequest struct {
+	Reason string `json:"reason" validate:"required,max=1000"`
+}
+
+// PaginatedSubtitleContributionsResponse wraps a page of subtitle contributions
+type PaginatedSubtitleContributionsResponse struct {
+	Contributions []m.SubtitleContribution `json:"contributions"`
+	Pagination    PaginationMeta           `json:"pagination"`
+}
+
+// PaginatedAnimeEpisodesResponse represents a paginated list of episodes
+type PaginatedAnimeEpisodesResponse struct {
+	Episodes   []AnimeEpisodeResponse `json:"episodes"`
+	Pagination PaginationMeta         `json:"pagination"`
+}
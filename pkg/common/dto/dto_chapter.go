@@ -53,6 +53,8 @@ type ChapterResponse struct {
 	ChapterNumber      int              `json:"chapter_number"` // Chapter number in volume
 	Title              *string          `json:"title,omitempty"` // Chapter title (optional)
 	Content            *json.RawMessage `json:"content,omitempty"` // Chapter content (only included if requested)
+	ServedLanguage     string           `json:"served_language,omitempty"` // Language of the returned title/content (detail only)
+	AvailableLanguages []string         `json:"available_languages,omitempty"` // Original language first, then translations (detail only)
 	PublishedAt        *time.Time       `json:"published_at,omitempty"` // Publication date (optional)
 	IsPublic           bool             `json:"is_public"` // Public visibility flag
 	IsDraft            bool             `json:"is_draft"` // Draft status flag
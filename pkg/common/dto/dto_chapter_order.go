@@ -0,0 +1,47 @@
+package dto
+
+import "github.com/google/uuid"
+
+// ReorderChaptersRequest lists every live chapter of a volume in the new reading order
+type ReorderChaptersRequest struct {
+	ChapterIDs []string `json:"chapter_ids" validate:"required,min=1"` // Toàn bộ chapter của tập theo thứ tự mới
+}
+
+// MoveChaptersRequest moves chapters of the same novel into the volume in the URL
+type MoveChaptersRequest struct {
+	ChapterIDs []string `json:"chapter_ids" validate:"required,min=1"`         // Giữ nguyên thứ tự khi chèn
+	Position   *int     `json:"position,omitempty" validate:"omitempty,min=1"` // Vị trí chèn (bắt đầu từ 1); mặc định cuối tập
+}
+
+// SplitVolumeRequest moves a chapter and every chapter after it into a new volume
+type SplitVolumeRequest struct {
+	FromChapterID string  `json:"from_chapter_id" validate:"required"` // Chapter đầu tiên của tập mới
+	VolumeNumber  int     `json:"volume_number" validate:"required,min=1"`
+	VolumeTitle   *string `json:"volume_title,omitempty" validate:"omitempty,max=500"`
+}
+
+// MergeVolumeRequest names the volume that receives the chapters of the merged volume
+type MergeVolumeRequest struct {
+	TargetVolumeID string `json:"target_volume_id" validate:"required"`
+}
+
+// VolumeChapterOrder is the complete reading order of one volume's live chapters
+type VolumeChapterOrder struct {
+	VolumeID   uuid.UUID
+	ChapterIDs []uuid.UUID
+}
+
+// RestructuredVolume is a volume touched by a restructure with its chapters in their new order;
+// the chapter number of each chapter is its position in the list
+type RestructuredVolume struct {
+	VolumeID   string   `json:"volume_id"`
+	ChapterIDs []string `json:"chapter_ids"`
+}
+
+// ChapterRestructureResponse reports the volumes changed by a reorder, move, split or merge
+type ChapterRestructureResponse struct {
+	NovelID         string               `json:"novel_id"`
+	Volumes         []RestructuredVolume `json:"volumes"`
+	CreatedVolumeID *string              `json:"created_volume_id,omitempty"` // Tập mới khi tách
+	DeletedVolumeID *string              `json:"deleted_volume_id,omitempty"` // Tập bị gộp (chuyển vào thùng rác)
+}
@@ -1,17 +1,27 @@
 package dto
 
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	m "wibusystem/pkg/common/model"
+)
+
 // CreateCharacterRequest represents the request to create a new character
 type CreateCharacterRequest struct {
-	Name        string  `json:"name" validate:"required,max=255"`
-	Description *string `json:"description,omitempty" validate:"omitempty,max=1000"`
-	ImageURL    *string `json:"image_url,omitempty" validate:"omitempty,url"`
+	Name         string  `json:"name" validate:"required,max=255"`
+	Description  *string `json:"description,omitempty" validate:"omitempty,max=1000"`
+	ImageURL     *string `json:"image_url,omitempty" validate:"omitempty,url"`
+	ImageMediaID *string `json:"image_media_id,omitempty"` // Uploaded image (purpose=avatar)
 }
 
 // UpdateCharacterRequest represents the request to update a character
 type UpdateCharacterRequest struct {
-	Name        *string `json:"name,omitempty" validate:"omitempty,max=255"`
-	Description *string `json:"description,omitempty" validate:"omitempty,max=1000"`
-	ImageURL    *string `json:"image_url,omitempty" validate:"omitempty,url"`
+	Name         *string `json:"name,omitempty" validate:"omitempty,max=255"`
+	Description  *string `json:"description,omitempty" validate:"omitempty,max=1000"`
+	ImageURL     *string `json:"image_url,omitempty" validate:"omitempty,url"`
+	ImageMediaID *string `json:"image_media_id,omitempty"` // Uploaded image (purpose=avatar); "" detaches
 }
 
 // ListCharactersRequest represents the request to list characters with pagination
@@ -19,4 +29,88 @@ type ListCharactersRequest struct {
 	Page     int    `form:"page,default=1" validate:"min=1"`
 	PageSize int    `form:"page_size,default=20" validate:"min=1,max=100"`
 	Search   string `form:"search,omitempty" validate:"max=100"`
-}
\ No newline at end of file
+}
+
+// CharacterTranslationInput represents a localized character description
+type CharacterTranslationInput struct {
+	LanguageCode string `json:"language_code" validate:"required,min=2,max=5"`
+	Description  string `json:"description" validate:"required,max=1000"`
+}
+
+// CreateCharacterContributionRequest proposes a new character or an edit of an existing one.
+// For edits, omitted fields are left unchanged.
+type CreateCharacterContributionRequest struct {
+	CharacterID    *string                     `json:"character_id,omitempty" validate:"omitempty,uuid"` // Bỏ trống khi đề xuất nhân vật mới
+	Name           *string                     `json:"name,omitempty" validate:"omitempty,max=255"`
+	Description    *string                     `json:"description,omitempty" validate:"omitempty,max=1000"`
+	ImageURL       *string                     `json:"image_url,omitempty" validate:"omitempty,url"`
+	Translations   []CharacterTranslationInput `json:"translations,omitempty" validate:"omitempty,dive"`
+	AddNovelIDs    []string                    `json:"add_novel_ids,omitempty" validate:"omitempty,dive,uuid"`
+	RemoveNovelIDs []string                    `json:"remove_novel_ids,omitempty" validate:"omitempty,dive,uuid"`
+	Note           *string                     `json:"note,omitempty" validate:"omitempty,max=1000"` // Nguồn tham khảo
+}
+
+// UpdateCharacterContributionRequest replaces the proposed values of a pending contribution
+type UpdateCharacterContributionRequest struct {
+	Name           *string                     `json:"name,omitempty" validate:"omitempty,max=255"`
+	Description    *string                     `json:"description,omitempty" validate:"omitempty,max=1000"`
+	ImageURL       *string                     `json:"image_url,omitempty" validate:"omitempty,url"`
+	Translations   []CharacterTranslationInput `json:"translations,omitempty" validate:"omitempty,dive"`
+	AddNovelIDs    []string                    `json:"add_novel_ids,omitempty" validate:"omitempty,dive,uuid"`
+	RemoveNovelIDs []string                    `json:"remove_novel_ids,omitempty" validate:"omitempty,dive,uuid"`
+	Note           *string                     `json:"note,omitempty" validate:"omitempty,max=1000"`
+}
+
+// ListCharacterContributionsRequest represents query parameters for the review queue
+type ListCharacterContributionsRequest struct {
+	Page        int    `form:"page" validate:"omitempty,min=1"`
+	PageSize    int    `form:"page_size" validate:"omitempty,min=1,max=100"`
+	Status      string `form:"status" validate:"omitempty,oneof=pending approved rejected"` // Mặc định: pending (queue)
+	CharacterID string `form:"character_id" validate:"omitempty,uuid"`
+}
+
+// RejectCharacterContributionRequest represents the reviewer's rejection reason
+type RejectCharacterContributionRequest struct {
+	Reason string `json:"reason" validate:"required,max=1000"`
+}
+
+// CharacterFieldDiff is one changed field of a contribution against the current record
+type CharacterFieldDiff struct {
+	Field    string      `json:"field"` // name, description, image_url, translations.<lang>, novels
+	Current  interface{} `json:"current"`
+	Proposed interface{} `json:"proposed"`
+}
+
+// CharacterContributionResponse is a contribution with its field-level diff
+type CharacterContributionResponse struct {
+	m.CharacterContribution
+	Diff []CharacterFieldDiff `json:"diff"`
+}
+
+// PaginatedCharacterContributionsResponse wraps a page of contributions
+type PaginatedCharacterContributionsResponse struct {
+	Contributions []CharacterContributionResponse `json:"contributions"`
+	Pagination    PaginationMeta                  `json:"pagination"`
+}
+
+// CharacterContributorResponse attributes approved contributions to a user
+type CharacterContributorResponse struct {
+	UserID            uuid.UUID `json:"user_id"`
+	ContributionCount int       `json:"contribution_count"`
+	LastContributedAt time.Time `json:"last_contributed_at"`
+}
+
+// MergeCharacterRequest names the character that absorbs the merged duplicate
+type MergeCharacterRequest struct {
+	TargetCharacterID string `json:"target_character_id" validate:"required,uuid"`
+}
+
+// CharacterMergeResponse reports a merge of a duplicate character into another
+type CharacterMergeResponse struct {
+	MergedCharacterID string `json:"merged_character_id"`
+	TargetCharacterID string `json:"target_character_id"`
+	NovelLinks        int64  `json:"novel_links"` // Số liên kết novel_character đã chuyển
+	MangaLinks        int64  `json:"manga_links"`
+	AnimeLinks        int64  `json:"anime_links"`
+	Translations      int64  `json:"translations"` // Số mô tả theo ngôn ngữ đã chuyển
+}
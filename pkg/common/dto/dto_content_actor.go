@@ -0,0 +1,11 @@
+package dto
+
+import "github.com/google/uuid"
+
+// ContentActor identifies the authenticated user acting on owned content.
+// Admins bypass ownership and collaborator checks.
+type ContentActor struct {
+	UserID   uuid.UUID
+	TenantID *uuid.UUID
+	IsAdmin  bool
+}
@@ -0,0 +1,59 @@
+package dto
+
+import "github.com/google/uuid"
+
+// UpdateContentFilterRequest replaces the current user's content filters; omitted fields keep their value.
+// Warning lists hold taxonomy codes and a code cannot be both blocked and warned.
+type UpdateContentFilterRequest struct {
+	HideMature        *bool    `json:"hide_mature,omitempty"`
+	BlockedWarnings   []string `json:"blocked_warnings,omitempty" validate:"omitempty,max=50,dive,min=1,max=50"` // Không bao giờ hiển thị
+	WarnedWarnings    []string `json:"warned_warnings,omitempty" validate:"omitempty,max=50,dive,min=1,max=50"`  // Cảnh báo trước khi xem
+	RestrictedDisplay *string  `json:"restricted_display,omitempty" validate:"omitempty,oneof=HIDE BLUR"`
+}
+
+// UpdateTenantContentPolicyRequest replaces a tenant's age gating overrides; an omitted or null setting keeps the default
+type UpdateTenantContentPolicyRequest struct {
+	MinAgePG13        *int    `json:"min_age_pg13,omitempty" validate:"omitempty,min=0,max=21"`
+	MinAgeR           *int    `json:"min_age_r,omitempty" validate:"omitempty,min=0,max=21"`
+	MinAgeNC17        *int    `json:"min_age_nc17,omitempty" validate:"omitempty,min=0,max=21"`
+	MinAgeMature      *int    `json:"min_age_mature,omitempty" validate:"omitempty,min=0,max=21"`
+	RestrictedDisplay *string `json:"restricted_display,omitempty" validate:"omitempty,oneof=HIDE BLUR"`
+}
+
+// ContentViewer is who is looking at the content; UserID and Age are nil for anonymous viewers
+// and Age is nil for users without a declared birthdate
+type ContentViewer struct {
+	UserID  *uuid.UUID
+	Age     *int
+	IsAdmin bool
+}
+
+// ContentVisibility is the part of a viewer's gating that list queries apply in SQL, so works the
+// viewer may not see neither take a slot on the page nor count towards the total
+type ContentVisibility struct {
+	Age             *int     // nil for anonymous viewers and users without a declared birthdate
+	HideMature      bool     // Người xem ẩn nội dung người lớn với hiển thị HIDE
+	BlockedWarnings []string // Cảnh báo không bao giờ hiển thị
+}
+
+// ContentRatingFacts are the rating fields of a work or chapter that gating depends on
+type ContentRatingFacts struct {
+	ID            uuid.UUID
+	AgeRating     *string
+	Mature        bool
+	Warnings      []string
+	OwnerTenantID *uuid.UUID // Tenant sở hữu, để áp dụng chính sách riêng
+}
+
+// ContentRestriction tells the client why an item is restricted and whether to hide, blur or warn about it
+type ContentRestriction struct {
+	Display string              `json:"display"` // HIDE | BLUR | WARN
+	Reasons []RestrictionReason `json:"reasons"`
+}
+
+// RestrictionReason is one structured reason for a restriction
+type RestrictionReason struct {
+	Code        string   `json:"code"`                   // AGE_RESTRICTED | AGE_UNVERIFIED | MATURE_HIDDEN | WARNING_BLOCKED | WARNING_NOTICE
+	RequiredAge *int     `json:"required_age,omitempty"` // Tuổi tối thiểu cho lý do về độ tuổi
+	Warnings    []string `json:"warnings,omitempty"`     // Cảnh báo bị chặn hoặc cần báo trước
+}
@@ -0,0 +1,57 @@
+package dto
+
+import (
+	m "wibusystem/pkg/common/model"
+)
+
+// CreateContentRelationRequest links two works; the target is a <relation_type> of the source
+type CreateContentRelationRequest struct {
+	SourceType   string `json:"source_type" validate:"required,oneof=ANIME MANGA NOVEL"`
+	SourceID     string `json:"source_id" validate:"required,uuid"`
+	TargetType   string `json:"target_type" validate:"required,oneof=ANIME MANGA NOVEL"`
+	TargetID     string `json:"target_id" validate:"required,uuid"`
+	RelationType string `json:"relation_type" validate:"required,oneof=ADAPTATION SEQUEL SPINOFF RELATED PREQUEL SOURCE PARENT_STORY"`
+}
+
+// UpdateContentRelationRequest changes the type of a relation (and of its inverse)
+type UpdateContentRelationRequest struct {
+	RelationType string `json:"relation_type" validate:"required,oneof=ADAPTATION SEQUEL SPINOFF RELATED PREQUEL SOURCE PARENT_STORY"`
+}
+
+// ListContentRelationsRequest selects the work whose relations are listed
+type ListContentRelationsRequest struct {
+	ContentType string `form:"content_type" validate:"required,oneof=ANIME MANGA NOVEL"`
+	ContentID   string `form:"content_id" validate:"required,uuid"`
+}
+
+// ContentRelationGraphRequest selects the root work and traversal depth of a franchise graph
+type ContentRelationGraphRequest struct {
+	ContentType string `form:"content_type" validate:"required,oneof=ANIME MANGA NOVEL"`
+	ContentID   string `form:"content_id" validate:"required,uuid"`
+	Depth       int    `form:"depth" validate:"omitempty,min=1,max=5"` // Mặc định 2
+}
+
+// ContentRelationNode is one work of a franchise graph
+type ContentRelationNode struct {
+	ID          string        `json:"id"`
+	ContentType m.ContentType `json:"content_type"`
+	Name        *string       `json:"name"`
+	CoverImage  *string       `json:"cover_image"`
+	Depth       int           `json:"depth"` // Khoảng cách tới nội dung gốc
+}
+
+// ContentRelationEdge is one relation of a franchise graph, in its canonical direction
+type ContentRelationEdge struct {
+	ID           string                `json:"id"`
+	SourceID     string                `json:"source_id"`
+	TargetID     string                `json:"target_id"`
+	RelationType m.ContentRelationType `json:"relation_type"`
+}
+
+// ContentRelationGraphResponse is the set of works connected to a root work
+type ContentRelationGraphResponse struct {
+	RootID string                `json:"root_id"`
+	Depth  int                   `json:"depth"`
+	Nodes  []ContentRelationNode `json:"nodes"`
+	Edges  []ContentRelationEdge `json:"edges"`
+}
@@ -0,0 +1,29 @@
+package dto
+
+// CreateContentWarningRequest registers a content warning in the taxonomy
+type CreateContentWarningRequest struct {
+	Code         string                           `json:"code" validate:"required,min=1,max=50"`
+	Severity     string                           `json:"severity" validate:"required,oneof=MILD MODERATE SEVERE"`
+	SortOrder    int                              `json:"sort_order"`
+	Translations []ContentWarningTranslationInput `json:"translations" validate:"required,min=1,max=20,dive"`
+}
+
+// UpdateContentWarningRequest changes a content warning; the code itself cannot change
+type UpdateContentWarningRequest struct {
+	Severity     *string                          `json:"severity,omitempty" validate:"omitempty,oneof=MILD MODERATE SEVERE"`
+	SortOrder    *int                             `json:"sort_order,omitempty"`
+	IsActive     *bool                            `json:"is_active,omitempty"`                                     // false: ngừng gắn mới
+	Translations []ContentWarningTranslationInput `json:"translations,omitempty" validate:"omitempty,max=20,dive"` // Thay thế toàn bộ nếu có
+}
+
+// ContentWarningTranslationInput is the label of a content warning in one language
+type ContentWarningTranslationInput struct {
+	LanguageCode string  `json:"language_code" validate:"required,min=2,max=10"`
+	Label        string  `json:"label" validate:"required,min=1,max=100"`
+	Description  *string `json:"description,omitempty" validate:"omitempty,max=1000"`
+}
+
+// ListContentWarningsRequest represents query parameters for listing the taxonomy
+type ListContentWarningsRequest struct {
+	IncludeInactive bool `form:"include_inactive"` // Kèm cảnh báo đã ngừng dùng
+}
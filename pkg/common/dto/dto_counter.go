@@ -0,0 +1,33 @@
+package dto
+
+import "github.com/google/uuid"
+
+// Counter entity types reported by the reconciliation
+const (
+	CounterEntityNovel  = "novel"
+	CounterEntityVolume = "volume"
+)
+
+// CounterDrift is one denormalized counter that did not match its source rows and was rewritten
+type CounterDrift struct {
+	EntityType string   `json:"entity_type"` // novel | volume
+	EntityID   string   `json:"entity_id"`
+	NovelID    string   `json:"novel_id"`
+	Field      string   `json:"field"`    // Tên cột, ví dụ total_chapters, bookmark_count
+	Previous   *float64 `json:"previous"` // Giá trị đã lưu (null nếu chưa từng được tính)
+	Current    *float64 `json:"current"`  // Giá trị tính lại từ dữ liệu gốc
+}
+
+// CounterReconcileReport summarizes one reconciliation run
+type CounterReconcileReport struct {
+	NovelsChecked    int            `json:"novels_checked"`
+	NovelsCorrected  int            `json:"novels_corrected"`
+	VolumesCorrected int            `json:"volumes_corrected"`
+	Fields           map[string]int `json:"fields"`           // Số lần sửa theo từng cột (novel.total_chapters, volume.word_count, ...)
+	Drifts           []CounterDrift `json:"drifts,omitempty"` // Chi tiết từng sai lệch; bỏ trống với lần quét toàn bộ
+}
+
+// ReconcileCountersRequest lists the novels to reconcile after a bulk import
+type ReconcileCountersRequest struct {
+	NovelIDs []uuid.UUID `json:"novel_ids" binding:"required,min=1,max=1000"`
+}
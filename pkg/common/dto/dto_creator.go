@@ -1,5 +1,11 @@
 package dto
 
+import (
+	"time"
+
+	m "wibusystem/pkg/common/model"
+)
+
 // CreateCreatorRequest represents the request to create a new creator
 type CreateCreatorRequest struct {
 	Name        string  `json:"name" validate:"required,max=255"`
@@ -17,4 +23,91 @@ type ListCreatorsRequest struct {
 	Page     int    `form:"page,default=1" validate:"min=1"`
 	PageSize int    `form:"page_size,default=20" validate:"min=1,max=100"`
 	Search   string `form:"search,omitempty" validate:"max=100"`
-}
\ No newline at end of file
+}
+
+// UpdateCreatorProfileRequest represents the profile fields a creator page's owner can edit
+// Slices replace the whole list when present; [] clears it
+type UpdateCreatorProfileRequest struct {
+	Description *string                  `json:"description,omitempty" validate:"omitempty,max=5000"` // Tiểu sử mặc định
+	Biographies []CreatorBiographyInput  `json:"biographies,omitempty" validate:"omitempty,max=50,dive"`
+	SocialLinks []CreatorSocialLinkInput `json:"social_links,omitempty" validate:"omitempty,max=20,dive"`
+	Aliases     []CreatorAliasInput      `json:"aliases,omitempty" validate:"omitempty,max=50,dive"`
+}
+
+// CreatorBiographyInput is the biography of a creator in one language
+type CreatorBiographyInput struct {
+	LanguageCode string `json:"language_code" validate:"required,max=5"`
+	Biography    string `json:"biography" validate:"required,max=5000"`
+}
+
+// CreatorSocialLinkInput is a link to a creator's profile on another site
+type CreatorSocialLinkInput struct {
+	Platform string `json:"platform" validate:"required,max=50"`
+	URL      string `json:"url" validate:"required,url,max=2000"`
+}
+
+// CreatorAliasInput is a pen name or an alternative name of a creator
+type CreatorAliasInput struct {
+	Alias        string  `json:"alias" validate:"required,max=255"`
+	AliasType    string  `json:"alias_type,omitempty" validate:"omitempty,oneof=ALIAS PEN_NAME"` // Mặc định: ALIAS
+	LanguageCode *string `json:"language_code,omitempty" validate:"omitempty,max=5"`
+}
+
+// ListCreatorWorksRequest represents the request to list the works of a creator
+type ListCreatorWorksRequest struct {
+	Page        int    `form:"page,default=1" validate:"min=1"`
+	PageSize    int    `form:"page_size,default=20" validate:"min=1,max=100"`
+	ContentType string `form:"content_type,omitempty" validate:"omitempty,oneof=NOVEL MANGA ANIME"`
+	Role        string `form:"role,omitempty" validate:"omitempty,oneof=AUTHOR ILLUSTRATOR ARTIST STUDIO VOICE_ACTOR"`
+}
+
+// CreatorWork is a novel, manga or anime linked to a creator, with every role they hold on it
+type CreatorWork struct {
+	ContentType string    `json:"content_type"` // NOVEL, MANGA, ANIME
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	CoverImage  *string   `json:"cover_image,omitempty"`
+	Roles       []string  `json:"roles"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// CreateCreatorClaimRequest represents a request to manage a creator page
+type CreateCreatorClaimRequest struct {
+	Evidence         string `json:"evidence" validate:"required,max=5000"` // Bằng chứng xác minh danh tính
+	OnBehalfOfTenant bool   `json:"on_behalf_of_tenant"`                   // Nhận cho tenant hiện tại thay vì tài khoản cá nhân
+}
+
+// ListCreatorClaimsRequest represents query parameters for creator claims
+type ListCreatorClaimsRequest struct {
+	Page     int    `form:"page" validate:"omitempty,min=1"`
+	PageSize int    `form:"page_size" validate:"omitempty,min=1,max=100"`
+	Status   string `form:"status" validate:"omitempty,oneof=pending approved rejected"` // Hàng đợi mặc định: pending
+}
+
+// ReviewCreatorClaimRequest approves or rejects a pending creator claim
+type ReviewCreatorClaimRequest struct {
+	Action          string  `json:"action" validate:"required,oneof=approve reject"`
+	RejectionReason *string `json:"rejection_reason,omitempty" validate:"omitempty,max=1000"` // Bắt buộc khi từ chối
+}
+
+// PaginatedCreatorClaimsResponse wraps a page of creator claims
+type PaginatedCreatorClaimsResponse struct {
+	Claims     []m.CreatorClaim `json:"claims"`
+	Pagination PaginationMeta   `json:"pagination"`
+}
+
+// MergeCreatorRequest names the creator that absorbs the merged duplicate
+type MergeCreatorRequest struct {
+	TargetCreatorID string `json:"target_creator_id" validate:"required,uuid"`
+}
+
+// CreatorMergeResponse reports a merge of a duplicate creator into another
+type CreatorMergeResponse struct {
+	MergedCreatorID string `json:"merged_creator_id"`
+	TargetCreatorID string `json:"target_creator_id"`
+	NovelLinks      int64  `json:"novel_links"` // Số liên kết novel_creator đã chuyển
+	MangaLinks      int64  `json:"manga_links"`
+	AnimeLinks      int64  `json:"anime_links"`
+	VoiceRoles      int64  `json:"voice_roles"` // Số vai lồng tiếng (anime_character) đã chuyển
+	Aliases         int64  `json:"aliases"`     // Số bí danh đã chuyển, gồm cả tên của creator bị gộp
+}
@@ -0,0 +1,23 @@
+package dto
+
+// FindDuplicatesRequest represents the query for possible duplicates of a creator or character
+type FindDuplicatesRequest struct {
+	Name  string `form:"name" validate:"omitempty,max=255"` // Bắt buộc khi tìm theo tên; bỏ qua khi tìm theo ID
+	Limit int    `form:"limit,default=10" validate:"min=1,max=50"`
+}
+
+// Duplicate match types, strongest first
+const (
+	DuplicateMatchName    = "name"    // Tên trùng sau khi bỏ hoa thường, dấu câu và khoảng trắng
+	DuplicateMatchAlias   = "alias"   // Trùng bút danh / tên khác (chỉ creator)
+	DuplicateMatchSimilar = "similar" // Tên gần giống (trigram)
+)
+
+// DuplicateCandidate is an existing creator or character that may be the same entity
+type DuplicateCandidate struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	MatchedName string  `json:"matched_name"` // Tên hoặc bí danh đã khớp
+	MatchType   string  `json:"match_type"`   // name | alias | similar
+	Similarity  float64 `json:"similarity"`   // 0..1, độ giống trigram của matched_name
+}
@@ -2,12 +2,27 @@ package dto
 
 // CreateGenreRequest represents the request to create a new genre
 type CreateGenreRequest struct {
-	Name string `json:"name" validate:"required,max=100"`
+	Name         string                  `json:"name" validate:"required,max=100"`
+	ParentID     *string                 `json:"parent_id,omitempty" validate:"omitempty,uuid"`
+	Description  *string                 `json:"description,omitempty" validate:"omitempty,max=2000"`
+	SortOrder    int                     `json:"sort_order"`
+	Translations []GenreTranslationInput `json:"translations,omitempty" validate:"omitempty,max=50,dive"`
 }
 
 // UpdateGenreRequest represents the request to update a genre
 type UpdateGenreRequest struct {
-	Name *string `json:"name,omitempty" validate:"omitempty,max=100"`
+	Name         *string                 `json:"name,omitempty" validate:"omitempty,max=100"`
+	ParentID     *string                 `json:"parent_id,omitempty" validate:"omitempty,max=36"` // Chuỗi rỗng: chuyển thành thể loại gốc
+	Description  *string                 `json:"description,omitempty" validate:"omitempty,max=2000"`
+	SortOrder    *int                    `json:"sort_order,omitempty"`
+	Translations []GenreTranslationInput `json:"translations,omitempty" validate:"omitempty,max=50,dive"` // Thay thế toàn bộ nếu có; [] để xoá hết
+}
+
+// GenreTranslationInput is the name and description of a genre in one language
+type GenreTranslationInput struct {
+	LanguageCode string  `json:"language_code" validate:"required,max=5"`
+	Name         string  `json:"name" validate:"required,max=100"`
+	Description  *string `json:"description,omitempty" validate:"omitempty,max=2000"`
 }
 
 // ListGenresRequest represents the request to list genres with pagination
@@ -15,4 +30,28 @@ type ListGenresRequest struct {
 	Page     int    `form:"page,default=1" validate:"min=1"`
 	PageSize int    `form:"page_size,default=20" validate:"min=1,max=100"`
 	Search   string `form:"search,omitempty" validate:"max=100"`
-}
\ No newline at end of file
+}
+
+// GenreTreeNode is a genre with its sub-genres, named in the reader's language
+type GenreTreeNode struct {
+	ID          string           `json:"id"`
+	Name        string           `json:"name"`
+	Description *string          `json:"description,omitempty"`
+	SortOrder   int              `json:"sort_order"`
+	Children    []*GenreTreeNode `json:"children"`
+}
+
+// MergeGenreRequest names the genre that absorbs the merged duplicate
+type MergeGenreRequest struct {
+	TargetGenreID string `json:"target_genre_id" validate:"required,uuid"`
+}
+
+// GenreMergeResponse reports a merge of a duplicate genre into another
+type GenreMergeResponse struct {
+	MergedGenreID string `json:"merged_genre_id"`
+	TargetGenreID string `json:"target_genre_id"`
+	NovelLinks    int64  `json:"novel_links"` // Số liên kết novel_genre đã chuyển
+	MangaLinks    int64  `json:"manga_links"`
+	AnimeLinks    int64  `json:"anime_links"`
+	Children      int64  `json:"children"` // Số thể loại con đã chuyển sang thể loại đích
+}
@@ -0,0 +1,247 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateMangaRequest represents the payload for creating a new manga series
+type CreateMangaRequest struct {
+	Title      string           `json:"title" validate:"required,max=1000"`                        // Tên manga (bắt buộc)
+	CoverImage string           `json:"cover_image" validate:"max=1000"`                           // URL ảnh bìa
+	Summary    *json.RawMessage `json:"summary,omitempty"`                                         // Tóm tắt đa ngôn ngữ (JSONB)
+	Status     string           `json:"status" validate:"required,oneof=ONGOING COMPLETED HIATUS"` // Trạng thái xuất bản
+
+	// Associations
+	Genres       []string                `json:"genres" validate:"dive,uuid"`            // Mảng UUID genres
+	Creators     []CreatorRole           `json:"creators,omitempty" validate:"dive"`     // Tác giả, họa sĩ...
+	Translations []MangaTranslationInput `json:"translations,omitempty" validate:"dive"` // Tiêu đề đa ngôn ngữ
+
+	// Publishing và rating
+	OriginalLanguage string `json:"original_language" validate:"max=5"`                                 // Ngôn ngữ gốc (ISO 639-1)
+	AgeRating        string `json:"age_rating,omitempty" validate:"omitempty,oneof=G PG PG-13 R NC-17"` // Phân loại độ tuổi
+	MatureContent    bool   `json:"mature_content"`                                                     // Nội dung người lớn
+	IsPublic         bool   `json:"is_public"`                                                          // Công khai hay riêng tư
+
+	// Ownership fields (owner IDs are set from the auth context)
+	OwnershipType     string    `json:"ownership_type" validate:"required,oneof=PERSONAL TENANT COLLABORATIVE"` // PERSONAL, TENANT, COLLABORATIVE
+	PrimaryOwnerID    uuid.UUID `json:"-"`                                                                      // user_id or tenant_id
+	OriginalCreatorID uuid.UUID `json:"-"`                                                                      // user who creates
+	AccessLevel       string    `json:"access_level" validate:"required,oneof=PRIVATE TENANT_ONLY PUBLIC"`      // PRIVATE, TENANT_ONLY, PUBLIC
+}
+
+// UpdateMangaRequest represents the payload for updating a manga series
+type UpdateMangaRequest struct {
+	Title      *string          `json:"title,omitempty" validate:"omitempty,max=1000"`
+	CoverImage *string          `json:"cover_image,omitempty" validate:"omitempty,max=1000"`
+	Summary    *json.RawMessage `json:"summary,omitempty"`
+	Status     *string          `json:"status,omitempty" validate:"omitempty,oneof=ONGOING COMPLETED HIATUS"`
+	Genres     []string         `json:"genres,omitempty" validate:"dive,uuid"` // Thay thế toàn bộ genres nếu có
+
+	OriginalLanguage *string `json:"original_language,omitempty" validate:"omitempty,max=5"`
+	AgeRating        *string `json:"age_rating,omitempty" validate:"omitempty,oneof=G PG PG-13 R NC-17"`
+	MatureContent    *bool   `json:"mature_content,omitempty"`
+	IsPublic         *bool   `json:"is_public,omitempty"`
+	AccessLevel      *string `json:"access_level,omitempty" validate:"omitempty,oneof=PRIVATE TENANT_ONLY PUBLIC"`
+
+	LastModifiedByUserID uuid.UUID `json:"-"` // Set from context
+}
+
+// MangaTranslationInput is one localized title of a manga
+type MangaTranslationInput struct {
+	LanguageCode string  `json:"language_code" validate:"required,max=5"`
+	Title        string  `json:"title" validate:"required,max=1000"`
+	Description  *string `json:"description,omitempty"`
+	IsPrimary    bool    `json:"is_primary"` // Tối đa một tiêu đề chính mỗi ngôn ngữ
+}
+
+// SetMangaTranslationsRequest replaces all localized titles of a manga
+type SetMangaTranslationsRequest struct {
+	Translations []MangaTranslationInput `json:"translations" validate:"dive"`
+}
+
+// ListMangaRequest represents query parameters for listing manga
+type ListMangaRequest struct {
+	Page     int `form:"page" validate:"omitempty,min=1"`              // Trang hiện tại (default: 1)
+	PageSize int `form:"page_size" validate:"omitempty,min=1,max=100"` // Kích thước trang (default: 20, max: 100)
+
+	Status         string     `form:"status" validate:"omitempty,oneof=ONGOING COMPLETED HIATUS"`
+	GenreID        *uuid.UUID `form:"genre_id"`
+	PrimaryOwnerID *uuid.UUID `form:"primary_owner_id"`
+	MatureContent  *bool      `form:"mature_content"`
+	Search         string     `form:"search" validate:"omitempty,max=100"` // Tìm trong tên và tiêu đề dịch
+
+	SortBy    string `form:"sort_by" validate:"omitempty,oneof=name created_at updated_at"`
+	SortOrder string `form:"sort_order" validate:"omitempty,oneof=asc desc"` // default: desc
+
+	// Set by the handler from the viewer; nil applies no age gating or reader filters
+	Visibility *ContentVisibility `form:"-" json:"-"`
+}
+
+// MangaSummaryResponse - response tối ưu cho list manga
+type MangaSummaryResponse struct {
+	ID            string              `json:"id"`
+	Name          string              `json:"name"` // Tên theo ngôn ngữ client (fallback: name)
+	CoverImage    *string             `json:"cover_image"`
+	Status        string              `json:"status"`
+	MatureContent bool                `json:"mature_content"`
+	VolumeCount   int                 `json:"volume_count"`
+	ChapterCount  int                 `json:"chapter_count"` // Chỉ tính chương đã phát hành
+	CreatedAt     time.Time           `json:"created_at"`
+	Restriction   *ContentRestriction `json:"restriction,omitempty"` // Có khi manga bị làm mờ hoặc cần cảnh báo
+}
+
+// PaginatedMangaResponse - response với pagination
+type PaginatedMangaResponse struct {
+	Manga      []MangaSummaryResponse `json:"manga"`
+	Pagination PaginationMeta         `json:"pagination"`
+}
+
+// MangaVolumeSummary is a volume with its pricing and published chapter count
+type MangaVolumeSummary struct {
+	ID                 string  `json:"id"`
+	VolumeNumber       int     `json:"volume_number"`
+	VolumeTitle        *string `json:"volume_title"`
+	CoverImage         *string `json:"cover_image"`
+	Description        *string `json:"description"`
+	PriceCoins         *int    `json:"price_coins"`
+	RentalPriceCoins   *int    `json:"rental_price_coins"`
+	RentalDurationDays *int    `json:"rental_duration_days"`
+	ChapterCount       int     `json:"chapter_count"`
+}
+
+// MangaTranslationResponse is a localized title of a manga
+type MangaTranslationResponse struct {
+	LanguageCode string  `json:"language_code"`
+	Title        string  `json:"title"`
+	Description  *string `json:"description"`
+	IsPrimary    bool    `json:"is_primary"`
+}
+
+// MangaDetailResponse represents detailed manga information
+type MangaDetailResponse struct {
+	ID               string                        `json:"id"`
+	Name             string                        `json:"name"`          // Tên theo ngôn ngữ client
+	OriginalName     *string                       `json:"original_name"` // Tên mặc định (manga.name)
+	Description      *string                       `json:"description"`   // Mô tả theo ngôn ngữ client
+	CoverImage       *string                       `json:"cover_image"`
+	Summary          *json.RawMessage              `json:"summary"`
+	Status           string                        `json:"status"`
+	OriginalLanguage string                        `json:"original_language"`
+	CurrentLanguage  string                        `json:"current_language"`
+	AgeRating        *string                       `json:"age_rating"`
+	MatureContent    bool                          `json:"mature_content"`
+	IsPublic         bool                          `json:"is_public"`
+	AccessLevel      string                        `json:"access_level"`
+	OwnershipType    string                        `json:"ownership_type"`
+	PrimaryOwnerID   *string                       `json:"primary_owner_id"`
+	PublishedAt      *time.Time                    `json:"published_at"`
+	Genres           []GenreInfo                   `json:"genres"`
+	Creators         []CreatorInfo                 `json:"creators"`
+	Characters       []CharacterInfo               `json:"characters"`
+	Volumes          []MangaVolumeSummary          `json:"volumes"`
+	Translations     []MangaTranslationResponse    `json:"translations"`
+	Relations        *ContentRelationGraphResponse `json:"relations"`             // Các tác phẩm liên quan
+	Restriction      *ContentRestriction           `json:"restriction,omitempty"` // Có khi bị làm mờ hoặc cần cảnh báo
+	CreatedAt        time.Time                     `json:"created_at"`
+	UpdatedAt        time.Time                     `json:"updated_at"`
+}
+
+// CreateMangaVolumeRequest represents the payload for creating a volume
+type CreateMangaVolumeRequest struct {
+	VolumeNumber       int     `json:"volume_number" validate:"required,min=1"`
+	VolumeTitle        *string `json:"volume_title,omitempty" validate:"omitempty,max=500"`
+	CoverImage         *string `json:"cover_image,omitempty" validate:"omitempty,max=1000"`
+	Description        *string `json:"description,omitempty"`
+	PriceCoins         *int    `json:"price_coins,omitempty" validate:"omitempty,min=0"`          // Giá mua trọn tập
+	RentalPriceCoins   *int    `json:"rental_price_coins,omitempty" validate:"omitempty,min=0"`   // Giá thuê tập
+	RentalDurationDays *int    `json:"rental_duration_days,omitempty" validate:"omitempty,min=1"` // Thời hạn thuê (ngày)
+}
+
+// UpdateMangaVolumeRequest represents the payload for updating a volume
+type UpdateMangaVolumeRequest struct {
+	VolumeNumber       *int    `json:"volume_number,omitempty" validate:"omitempty,min=1"`
+	VolumeTitle        *string `json:"volume_title,omitempty" validate:"omitempty,max=500"`
+	CoverImage         *string `json:"cover_image,omitempty" validate:"omitempty,max=1000"`
+	Description        *string `json:"description,omitempty"`
+	PriceCoins         *int    `json:"price_coins,omitempty" validate:"omitempty,min=0"`
+	RentalPriceCoins   *int    `json:"rental_price_coins,omitempty" validate:"omitempty,min=0"`
+	RentalDurationDays *int    `json:"rental_duration_days,omitempty" validate:"omitempty,min=1"`
+}
+
+// CreateMangaChapterRequest represents the payload for creating a chapter
+type CreateMangaChapterRequest struct {
+	ChapterNumber int     `json:"chapter_number" validate:"required,min=1"`
+	Title         *string `json:"title,omitempty" validate:"omitempty,max=500"`
+	IsPublic      bool    `json:"is_public"`                                        // Miễn phí
+	PriceCoins    *int    `json:"price_coins,omitempty" validate:"omitempty,min=0"` // Giá mua lẻ chương
+}
+
+// UpdateMangaChapterRequest represents the payload for updating a chapter
+type UpdateMangaChapterRequest struct {
+	ChapterNumber *int    `json:"chapter_number,omitempty" validate:"omitempty,min=1"`
+	Title         *string `json:"title,omitempty" validate:"omitempty,max=500"`
+	IsPublic      *bool   `json:"is_public,omitempty"`
+	PriceCoins    *int    `json:"price_coins,omitempty" validate:"omitempty,min=0"`
+}
+
+// PublishMangaChapterRequest represents the payload for publishing a chapter
+type PublishMangaChapterRequest struct {
+	PublishAt *time.Time `json:"publish_at,omitempty"` // Mặc định: hiện tại
+}
+
+// ListMangaChaptersRequest represents query parameters for listing chapters of a volume
+type ListMangaChaptersRequest struct {
+	Page     int `form:"page" validate:"omitempty,min=1"`
+	PageSize int `form:"page_size" validate:"omitempty,min=1,max=100"`
+}
+
+// AddMangaPagesRequest uploads pages in the given order. Pages are appended to the
+// chapter unless AfterPage is set (0 inserts before the first page); following
+// pages are renumbered.
+type AddMangaPagesRequest struct {
+	ImageURLs []string `json:"image_urls" validate:"required,min=1,max=500,dive,required,max=2000"`
+	AfterPage *int     `json:"after_page,omitempty" validate:"omitempty,min=0"`
+}
+
+// ReorderMangaPagesRequest lists every page ID of the chapter in its new order
+type ReorderMangaPagesRequest struct {
+	PageIDs []string `json:"page_ids" validate:"required,min=1,dive,uuid"`
+}
+
+// UpdateMangaPageRequest replaces the image of a page
+type UpdateMangaPageRequest struct {
+	ImageURL string `json:"image_url" validate:"required,max=2000"`
+}
+
+// MangaPageResponse is one page of a chapter
+type MangaPageResponse struct {
+	ID         string  `json:"id"`
+	PageNumber int     `json:"page_number"`
+	ImageURL   *string `json:"image_url"`
+}
+
+// MangaChapterResponse represents a chapter; pages are only filled for free
+// chapters, entitled readers and managers
+type MangaChapterResponse struct {
+	ID            string              `json:"id"`
+	VolumeID      string              `json:"volume_id"`
+	ChapterNumber int                 `json:"chapter_number"`
+	Title         *string             `json:"title"`
+	IsPublic      bool                `json:"is_public"`
+	PriceCoins    *int                `json:"price_coins"`
+	IsDraft       bool                `json:"is_draft"`
+	ReleasedAt    *time.Time          `json:"released_at"`
+	PageCount     int                 `json:"page_count"`
+	Pages         []MangaPageResponse `json:"pages,omitempty"`
+	CreatedAt     time.Time           `json:"created_at"`
+	UpdatedAt     time.Time           `json:"updated_at"`
+}
+
+// PaginatedMangaChaptersResponse represents a paginated list of chapters
+type PaginatedMangaChaptersResponse struct {
+	Chapters   []MangaChapterResponse `json:"chapters"`
+	Pagination PaginationMeta         `json:"pagination"`
+}
@@ -0,0 +1,27 @@
+package dto
+
+import (
+	"time"
+
+	m "wibusystem/pkg/common/model"
+)
+
+// MediaAssetResponse describes an uploaded file with a time-limited download URL
+type MediaAssetResponse struct {
+	ID               string    `json:"id"`
+	ChecksumSHA256   string    `json:"checksum_sha256"`
+	ContentType      string    `json:"content_type"`
+	SizeBytes        int64     `json:"size_bytes"`
+	OriginalFilename *string   `json:"original_filename,omitempty"`
+	URL              string    `json:"url"`            // Signed download URL
+	URLExpiresAt     time.Time `json:"url_expires_at"` // Hết hạn thì gọi lại GET /media/{id}
+	Deduplicated     bool      `json:"deduplicated"`   // true nếu file đã tồn tại (trùng checksum)
+	CreatedAt        time.Time `json:"created_at"`
+
+	// Ảnh: kích thước gốc và các bản thu nhỏ (sinh bất đồng bộ)
+	Width            *int                           `json:"width,omitempty"`
+	Height           *int                           `json:"height,omitempty"`
+	Purposes         []string                       `json:"purposes"`
+	ProcessingStatus string                         `json:"processing_status"` // pending | processing | ready | failed | skipped
+	Variants         map[string]m.MediaImageVariant `json:"variants"`
+}
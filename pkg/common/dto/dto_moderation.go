@@ -0,0 +1,60 @@
+package dto
+
+import (
+	m "wibusystem/pkg/common/model"
+)
+
+// CreateReportRequest represents a user report against a piece of content or a user
+type CreateReportRequest struct {
+	TargetType string  `json:"target_type" validate:"required,oneof=NOVEL CHAPTER COMMENT REVIEW USER"` // Loại đối tượng bị báo cáo
+	TargetID   string  `json:"target_id" validate:"required,uuid"`                                      // ID đối tượng
+	Reason     string  `json:"reason" validate:"required"`                                              // Lý do (report_reason)
+	Details    *string `json:"details,omitempty" validate:"omitempty,max=2000"`                         // Mô tả tự do
+}
+
+// CreateReportResponse tells the reporter which case the report joined
+type CreateReportResponse struct {
+	ReportID    string `json:"report_id"`
+	CaseID      string `json:"case_id"`
+	ReportCount int    `json:"report_count"` // Tổng số báo cáo của case sau khi gom
+}
+
+// ListModerationCasesRequest represents query parameters for the moderation queue
+type ListModerationCasesRequest struct {
+	Page       int    `form:"page" validate:"omitempty,min=1"`
+	PageSize   int    `form:"page_size" validate:"omitempty,min=1,max=100"`
+	Status     string `form:"status" validate:"omitempty,oneof=OPEN IN_REVIEW RESOLVED DISMISSED"` // Mặc định: OPEN và IN_REVIEW
+	TargetType string `form:"target_type" validate:"omitempty,oneof=NOVEL CHAPTER COMMENT REVIEW USER"`
+	AssignedTo string `form:"assigned_to" validate:"omitempty"` // UUID moderator, "me" hoặc "none"
+}
+
+// ModerationCaseSummary is one row of the moderation queue
+type ModerationCaseSummary struct {
+	m.ModerationCase
+	ReasonCounts map[string]int `json:"reason_counts"` // Số báo cáo theo từng lý do
+}
+
+// PaginatedModerationCasesResponse wraps a page of the moderation queue
+type PaginatedModerationCasesResponse struct {
+	Cases      []ModerationCaseSummary `json:"cases"`
+	Pagination PaginationMeta          `json:"pagination"`
+}
+
+// ModerationCaseDetailResponse is a case with its reports and audit trail
+type ModerationCaseDetailResponse struct {
+	ModerationCaseSummary
+	Reports    []m.ContentReport      `json:"reports"`
+	AuditTrail []m.ModerationAuditLog `json:"audit_trail"`
+}
+
+// AssignModerationCaseRequest assigns a case to a moderator
+type AssignModerationCaseRequest struct {
+	ModeratorID string `json:"moderator_id" validate:"required,uuid"`
+}
+
+// ResolveModerationCaseRequest closes a case with a resolution action
+type ResolveModerationCaseRequest struct {
+	Action string  `json:"action" validate:"required,oneof=HIDE_CONTENT UNPUBLISH_CHAPTER WARN_USER SUSPEND_USER DISMISS"`
+	Note   *string `json:"note,omitempty" validate:"omitempty,max=2000"` // Ghi chú nội bộ / nội dung cảnh cáo
+	UserID *string `json:"user_id,omitempty" validate:"omitempty,uuid"`  // Người bị cảnh cáo/đình chỉ khi không suy ra được từ target
+}
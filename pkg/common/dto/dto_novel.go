@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	m "wibusystem/pkg/common/model"
 )
 
 // CreateNovelRequest represents the payload for creating a new novel
@@ -12,6 +14,7 @@ type CreateNovelRequest struct {
 	// Core content fields
 	Title      string           `json:"title" validate:"required,max=1000"`     // Tên novel (bắt buộc) - theo API design
 	CoverImage string           `json:"cover_image" validate:"max=1000"`        // URL ảnh bìa
+	CoverMediaID *string        `json:"cover_media_id,omitempty"`               // Ảnh bìa đã upload (purpose=cover)
 	Summary    *json.RawMessage `json:"summary,omitempty"`                      // Tóm tắt đa ngôn ngữ (JSONB)
 	Genres     []string         `json:"genres" validate:"dive,uuid"`            // Mảng UUID genres
 
@@ -65,6 +68,7 @@ type UpdateNovelRequest struct {
 	// Core content fields
 	Title      *string          `json:"title,omitempty" validate:"omitempty,max=1000"` // Tên novel
 	CoverImage *string          `json:"cover_image,omitempty" validate:"omitempty,max=1000"` // URL ảnh bìa
+	CoverMediaID *string        `json:"cover_media_id,omitempty"`                      // Ảnh bìa đã upload; "" để gỡ
 	Summary    *json.RawMessage `json:"summary,omitempty"`                            // Tóm tắt đa ngôn ngữ
 	Genres     []string         `json:"genres,omitempty" validate:"dive,uuid"`        // Mảng UUID genres
 
@@ -116,6 +120,7 @@ type ListNovelsRequest struct {
 	Search   string   `form:"search" validate:"omitempty,max=100"`   // Tìm kiếm trong tên, description
 	Tags     []string `form:"tags" validate:"dive,max=50"`           // Lọc theo tags
 	GenreIDs []string `form:"genre_ids" validate:"dive,uuid"`        // Lọc theo genres
+	IncludeGenreDescendants bool `form:"include_genre_descendants"` // Lọc genre_ids kèm cả thể loại con
 
 	// Sorting
 	SortBy    string `form:"sort_by" validate:"omitempty,oneof=name created_at updated_at published_at view_count rating_average"` // Sắp xếp theo trường
@@ -139,6 +144,9 @@ type ListNovelsRequest struct {
 	// View count range
 	MinViewCount *int64 `form:"min_view_count" validate:"omitempty,min=0"`
 	MaxViewCount *int64 `form:"max_view_count" validate:"omitempty,min=0"`
+
+	// Set by the handler from the viewer; nil applies no age gating or reader filters
+	Visibility *ContentVisibility `form:"-" json:"-"`
 }
 
 // NovelSummaryResponse - response tối ưu cho list novels
@@ -146,6 +154,7 @@ type NovelSummaryResponse struct {
 	ID          string    `json:"id"`
 	Name        string    `json:"name"`
 	CoverImage  *string   `json:"cover_image"`
+	CoverMedia  *m.MediaImage `json:"cover_media"` // Ảnh bìa đã upload kèm bản thu nhỏ
 	ViewCount   int64     `json:"view_count"`
 	CreatedAt   time.Time `json:"created_at"`
 
@@ -160,6 +169,9 @@ type NovelSummaryResponse struct {
 
 	// Latest chapter info
 	LatestChapterUpdatedAt *time.Time `json:"latest_chapter_updated_at"`
+
+	// Restriction is set when the viewer's age or filters blur this novel or ask to warn first
+	Restriction *ContentRestriction `json:"restriction,omitempty"`
 }
 
 // UserSummary represents user info for novel list response
@@ -211,11 +223,14 @@ type NovelDetailResponse struct {
 	ID              string                 `json:"id"`
 	Name            string                 `json:"name"`              // Tên theo ngôn ngữ client
 	CoverImage      *string                `json:"cover_image"`       // URL ảnh bìa
+	CoverMedia      *m.MediaImage          `json:"cover_media"`       // Ảnh bìa đã upload kèm bản thu nhỏ
 	Summary         map[string]interface{} `json:"summary"`           // JSON content từ Plate editor
 	Status          string                 `json:"status"`            // Trạng thái
 	PublishedAt     *time.Time             `json:"published_at"`      // Ngày xuất bản
 	OriginalLanguage string                 `json:"original_language"` // Ngôn ngữ gốc
 	CurrentLanguage string                 `json:"current_language"`  // Ngôn ngữ hiện tại
+	ServedLanguage  string                 `json:"served_language"`   // Ngôn ngữ của tên/tóm tắt được trả về
+	AvailableLanguages []string            `json:"available_languages"` // Ngôn ngữ gốc và các bản dịch
 	SourceURL       *string                `json:"source_url"`        // URL nguồn
 	ISBN            *string                `json:"isbn"`              // Mã ISBN
 	AgeRating       *string                `json:"age_rating"`        // Phân loại độ tuổi
@@ -240,6 +255,9 @@ type NovelDetailResponse struct {
 	Characters      []CharacterInfo        `json:"characters"`        // Danh sách nhân vật
 	Translations    []interface{}          `json:"translations"`      // Bản dịch (nếu có)
 	Stats           map[string]interface{} `json:"stats"`             // Thống kê (nếu có)
+	Similar         []RecommendedNovelResponse `json:"similar"`      // Novel tương tự (nếu include_similar=true)
+	Relations       *ContentRelationGraphResponse `json:"relations"` // Các tác phẩm liên quan (sequel, adaptation...)
+	Restriction     *ContentRestriction    `json:"restriction,omitempty"` // Lý do bị làm mờ theo độ tuổi/bộ lọc
 	CreatedAt       time.Time              `json:"created_at"`
 	UpdatedAt       time.Time              `json:"updated_at"`
 }
@@ -0,0 +1,126 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	m "wibusystem/pkg/common/model"
+)
+
+// Quotable novel item types: the purchase item types plus bundles
+const (
+	QuoteItemNovelChapter = "NOVEL_CHAPTER"
+	QuoteItemNovelVolume  = "NOVEL_VOLUME"
+	QuoteItemNovelSeries  = "NOVEL_SERIES"
+	QuoteItemNovelBundle  = "NOVEL_BUNDLE"
+)
+
+// CreateDiscountCampaignRequest represents the request to create a discount campaign
+type CreateDiscountCampaignRequest struct {
+	Name          string    `json:"name" validate:"required,max=255"`
+	Description   *string   `json:"description,omitempty" validate:"omitempty,max=2000"`
+	DiscountType  string    `json:"discount_type" validate:"required,oneof=PERCENTAGE FIXED"`
+	DiscountValue int       `json:"discount_value" validate:"required,min=1"` // Phần trăm (1-100) hoặc số coins giảm
+	ScopeType     string    `json:"scope_type" validate:"required,oneof=NOVEL TENANT GENRE"`
+	ScopeID       string    `json:"scope_id" validate:"required,uuid"`
+	StartsAt      time.Time `json:"starts_at" validate:"required"`
+	EndsAt        time.Time `json:"ends_at" validate:"required"`
+}
+
+// UpdateDiscountCampaignRequest represents the request to update a discount campaign; the scope cannot change
+type UpdateDiscountCampaignRequest struct {
+	Name          *string    `json:"name,omitempty" validate:"omitempty,max=255"`
+	Description   *string    `json:"description,omitempty" validate:"omitempty,max=2000"`
+	DiscountType  *string    `json:"discount_type,omitempty" validate:"omitempty,oneof=PERCENTAGE FIXED"`
+	DiscountValue *int       `json:"discount_value,omitempty" validate:"omitempty,min=1"`
+	StartsAt      *time.Time `json:"starts_at,omitempty"`
+	EndsAt        *time.Time `json:"ends_at,omitempty"`
+	IsActive      *bool      `json:"is_active,omitempty"` // false: tạm dừng
+}
+
+// ListDiscountCampaignsRequest represents query parameters for discount campaigns
+type ListDiscountCampaignsRequest struct {
+	Page      int    `form:"page" validate:"omitempty,min=1"`
+	PageSize  int    `form:"page_size" validate:"omitempty,min=1,max=100"`
+	ScopeType string `form:"scope_type" validate:"omitempty,oneof=NOVEL TENANT GENRE"`
+	ScopeID   string `form:"scope_id" validate:"omitempty,uuid"`
+	Status    string `form:"status" validate:"omitempty,oneof=scheduled running ended"` // Theo thời gian, bỏ qua is_active
+}
+
+// PaginatedDiscountCampaignsResponse represents a page of discount campaigns
+type PaginatedDiscountCampaignsResponse struct {
+	Campaigns  []m.DiscountCampaign `json:"campaigns"`
+	Pagination PaginationMeta       `json:"pagination"`
+}
+
+// CreateBundleRequest represents the request to create a bundle of volumes of a novel
+type CreateBundleRequest struct {
+	Name        string     `json:"name" validate:"required,max=255"`
+	Description *string    `json:"description,omitempty" validate:"omitempty,max=2000"`
+	PriceCoins  int        `json:"price_coins" validate:"min=0"`
+	VolumeIDs   []string   `json:"volume_ids" validate:"required,min=2,dive,uuid"`
+	StartsAt    *time.Time `json:"starts_at,omitempty"` // Mặc định: bán ngay
+	EndsAt      *time.Time `json:"ends_at,omitempty"`   // Mặc định: không giới hạn
+}
+
+// UpdateBundleRequest represents the request to update a bundle
+type UpdateBundleRequest struct {
+	Name        *string    `json:"name,omitempty" validate:"omitempty,max=255"`
+	Description *string    `json:"description,omitempty" validate:"omitempty,max=2000"`
+	PriceCoins  *int       `json:"price_coins,omitempty" validate:"omitempty,min=0"`
+	VolumeIDs   []string   `json:"volume_ids,omitempty" validate:"omitempty,min=2,dive,uuid"` // Thay thế toàn bộ nếu có
+	StartsAt    *time.Time `json:"starts_at,omitempty"`
+	EndsAt      *time.Time `json:"ends_at,omitempty"`
+	IsActive    *bool      `json:"is_active,omitempty"`
+}
+
+// PriceQuoteRequest represents query parameters for a price quote
+type PriceQuoteRequest struct {
+	ItemType string `form:"item_type" validate:"required,oneof=NOVEL_CHAPTER NOVEL_VOLUME NOVEL_SERIES NOVEL_BUNDLE"`
+	ItemID   string `form:"item_id" validate:"required,uuid"`
+}
+
+// PriceQuoteTarget is the list price of a quoted item and what it covers
+type PriceQuoteTarget struct {
+	NovelID    uuid.UUID
+	PriceCoins *int // NULL: không bán
+	IsFree     bool // Chapter không có giá hoặc đã miễn phí theo wait-until-free
+	// VolumeIDs are the live volumes the purchase grants; empty for a chapter
+	VolumeIDs []uuid.UUID
+	// ListPriceCoins is the sum of the volumes' own prices, used to scale ownership credit on bundles
+	ListPriceCoins int
+}
+
+// PriceQuoteOwnership describes what the user already owns of a quoted item
+type PriceQuoteOwnership struct {
+	AlreadyOwned  bool
+	OwnedVolumes  int
+	OwnedChapters int // Chapter mua lẻ nằm trong tập chưa sở hữu
+	CreditCoins   int // Giá niêm yết của phần đã sở hữu
+}
+
+// AppliedDiscount is the campaign applied to a quote
+type AppliedDiscount struct {
+	CampaignID    string    `json:"campaign_id"`
+	Name          string    `json:"name"`
+	DiscountType  string    `json:"discount_type"`
+	DiscountValue int       `json:"discount_value"`
+	EndsAt        time.Time `json:"ends_at"`
+}
+
+// PriceQuoteResponse is the effective price of an item for the current user
+type PriceQuoteResponse struct {
+	ItemType       string           `json:"item_type"`
+	ItemID         string           `json:"item_id"`
+	NovelID        string           `json:"novel_id"`
+	AlreadyOwned   bool             `json:"already_owned"`
+	BasePriceCoins int              `json:"base_price_coins"`   // Giá niêm yết
+	OwnedCredit    int              `json:"owned_credit_coins"` // Trừ cho phần đã sở hữu
+	DiscountCoins  int              `json:"discount_coins"`     // Giảm theo chương trình khuyến mãi
+	FinalPrice     int              `json:"final_price_coins"`
+	OwnedVolumes   int              `json:"owned_volumes"`
+	OwnedChapters  int              `json:"owned_chapters"`
+	Discount       *AppliedDiscount `json:"discount,omitempty"`
+	QuotedAt       time.Time        `json:"quoted_at"`
+}
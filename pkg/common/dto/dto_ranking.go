@@ -0,0 +1,50 @@
+package dto
+
+import "time"
+
+// ListNovelRankingRequest represents query parameters for reading a ranking snapshot
+type ListNovelRankingRequest struct {
+	// Pagination
+	Page     int `form:"page" validate:"omitempty,min=1"`              // Trang hiện tại (default: 1)
+	PageSize int `form:"page_size" validate:"omitempty,min=1,max=100"` // Kích thước trang (default: 20, max: 100)
+
+	// Filtering
+	GenreID       string `form:"genre_id" validate:"omitempty,uuid"`                       // Lọc theo thể loại
+	Tag           string `form:"tag" validate:"omitempty,max=50"`                          // Lọc theo tag
+	Language      string `form:"language" validate:"omitempty,max=5"`                      // Ngôn ngữ gốc hoặc có bản dịch
+	AgeRating     string `form:"age_rating" validate:"omitempty,oneof=G PG PG-13 R NC-17"` // Lọc theo độ tuổi
+	IncludeMature bool   `form:"include_mature"`                                           // Bao gồm nội dung người lớn (default: false)
+
+	// Set by the handler from the viewer; nil applies no age gating or reader filters
+	Visibility *ContentVisibility `form:"-" json:"-"`
+}
+
+// RankedNovelResponse represents one novel entry of a ranking
+type RankedNovelResponse struct {
+	Rank          int      `json:"rank"`           // Thứ hạng trong danh sách đã lọc
+	Score         float64  `json:"score"`          // Điểm xếp hạng
+	ID            string   `json:"id"`             // UUID novel
+	Name          string   `json:"name"`           // Tên novel
+	CoverImage    *string  `json:"cover_image"`    // URL ảnh bìa
+	AgeRating     *string  `json:"age_rating"`     // Phân loại độ tuổi
+	MatureContent bool     `json:"mature_content"` // Nội dung người lớn
+	ViewCount     int64    `json:"view_count"`     // Tổng lượt xem
+	BookmarkCount int64    `json:"bookmark_count"` // Tổng lượt bookmark
+	RatingAverage *float64 `json:"rating_average"` // Điểm đánh giá TB
+
+	// Restriction is set when the viewer's age or filters blur this novel
+	Restriction *ContentRestriction `json:"restriction,omitempty"`
+}
+
+// PaginatedNovelRankingResponse wraps a ranking page with snapshot metadata
+type PaginatedNovelRankingResponse struct {
+	RankingType string                `json:"ranking_type"` // Loại bảng xếp hạng
+	ComputedAt  *time.Time            `json:"computed_at"`  // Thời điểm snapshot được tính (nil nếu chưa có)
+	Novels      []RankedNovelResponse `json:"novels"`
+	Pagination  PaginationMeta        `json:"pagination"`
+}
+
+// RebuildRankingsResponse reports the outcome of a manual ranking rebuild
+type RebuildRankingsResponse struct {
+	Rankings map[string]int64 `json:"rankings"` // Số novel được xếp hạng theo từng loại
+}
@@ -0,0 +1,47 @@
+package dto
+
+// ListRecommendationsRequest represents query parameters for similar-novel and personal recommendations
+type ListRecommendationsRequest struct {
+	Limit         int  `form:"limit" validate:"omitempty,min=1,max=50"` // Số kết quả (default: 10, max: 50)
+	IncludeMature bool `form:"include_mature"`                          // Bao gồm nội dung người lớn (default: false)
+
+	// Set by the handler from the viewer; nil applies no age gating or reader filters
+	Visibility *ContentVisibility `form:"-" json:"-"`
+}
+
+// NovelReference is a minimal pointer to a novel used inside explanations
+type NovelReference struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// SimilarityReasons lists the evidence that made two novels similar
+type SimilarityReasons struct {
+	SharedGenres    []string `json:"shared_genres"`    // Tên thể loại chung
+	SharedTags      []string `json:"shared_tags"`      // Tags chung
+	SharedCreators  []string `json:"shared_creators"`  // Tên creator chung
+	CoBookmarkCount int64    `json:"cobookmark_count"` // Số người đọc bookmark cả hai
+}
+
+// RecommendedNovelResponse represents one recommended novel with its explanation
+type RecommendedNovelResponse struct {
+	ID             string            `json:"id"`
+	Name           string            `json:"name"`
+	CoverImage     *string           `json:"cover_image"`
+	AgeRating      *string           `json:"age_rating"`
+	MatureContent  bool              `json:"mature_content"`
+	RatingAverage  *float64          `json:"rating_average"`
+	Score          float64           `json:"score"`            // Điểm tương tự
+	BecauseYouRead NovelReference    `json:"because_you_read"` // Novel nguồn của gợi ý
+	Reasons        SimilarityReasons `json:"reasons"`
+	Explanation    string            `json:"explanation"` // Câu giải thích đã bản địa hoá
+
+	// Restriction is set when the viewer's age or filters blur this novel
+	Restriction *ContentRestriction `json:"restriction,omitempty"`
+}
+
+// BookmarkResponse reports the bookmark state of a novel for the current reader
+type BookmarkResponse struct {
+	NovelID    string `json:"novel_id"`
+	Bookmarked bool   `json:"bookmarked"`
+}
@@ -0,0 +1,98 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UpdateReleasePolicyRequest replaces a novel's release policy; an omitted or null setting is disabled
+type UpdateReleasePolicyRequest struct {
+	FreeAfterDays       *int `json:"free_after_days,omitempty" validate:"omitempty,min=1,max=3650"`
+	UnlockIntervalHours *int `json:"unlock_interval_hours,omitempty" validate:"omitempty,min=1,max=8760"`
+	EarlyAccessHours    *int `json:"early_access_hours,omitempty" validate:"omitempty,min=1,max=8760"`
+}
+
+// ListReaderChaptersRequest represents query parameters for a reader's chapter list
+type ListReaderChaptersRequest struct {
+	Page  int `form:"page" validate:"omitempty,min=1"`
+	Limit int `form:"limit" validate:"omitempty,min=1,max=100"`
+}
+
+// ReaderChapterRow is a released or scheduled chapter with what the reader owns of it
+type ReaderChapterRow struct {
+	ID                 uuid.UUID
+	VolumeID           uuid.UUID
+	ChapterNumber      int
+	Title              *string
+	PublishedAt        time.Time
+	PriceCoins         *int
+	WordCount          *int
+	ReadingTimeMinutes *int
+	HasMatureContent   bool
+	ContentWarnings    []string
+	Owned              bool // Mua chapter/tập/series hoặc đang thuê tập/series
+	Unlocked           bool // Đã mở khóa miễn phí
+}
+
+// ReaderFacts are the per-user facts the release rules depend on
+type ReaderFacts struct {
+	IsVIP        bool
+	LastUnlockAt *time.Time // Lần mở khóa miễn phí gần nhất trong novel
+}
+
+// ChapterAccessInfo is a chapter with its novel and the reader's ownership
+type ChapterAccessInfo struct {
+	Chapter ReaderChapterRow
+	NovelID uuid.UUID
+	// Released is false for drafts and unpublished chapters, which readers never see
+	Released bool
+}
+
+// ReaderChapter is a chapter in a reader's list with its access state and countdowns
+type ReaderChapter struct {
+	ID                 string     `json:"id"`
+	VolumeID           string     `json:"volume_id"`
+	ChapterNumber      int        `json:"chapter_number"`
+	Title              *string    `json:"title,omitempty"`
+	PriceCoins         *int       `json:"price_coins,omitempty"`
+	WordCount          *int       `json:"word_count,omitempty"`
+	ReadingTimeMinutes *int       `json:"reading_time_minutes,omitempty"`
+	HasMatureContent   bool       `json:"has_mature_content"`
+	ContentWarnings    []string   `json:"content_warnings"`
+	Access             string     `json:"access"` // FREE | OWNED | EARLY_ACCESS | LOCKED | UPCOMING
+	CanRead            bool       `json:"can_read"`
+	CanUnlock          bool       `json:"can_unlock"`                // Mở khóa miễn phí được ngay bây giờ
+	ReleaseAt          time.Time  `json:"release_at"`                // Phát hành cho mọi người
+	EarlyAccessAt      *time.Time `json:"early_access_at,omitempty"` // VIP đọc được từ thời điểm này
+	FreeAt             *time.Time `json:"free_at,omitempty"`         // Chapter trả phí thành miễn phí
+
+	// Restriction is set when the reader's age or filters blur this chapter
+	Restriction *ContentRestriction `json:"restriction,omitempty"`
+}
+
+// ReaderReleaseStatus is the reader's standing against the novel's release policy
+type ReaderReleaseStatus struct {
+	FreeAfterDays       *int       `json:"free_after_days,omitempty"`
+	UnlockIntervalHours *int       `json:"unlock_interval_hours,omitempty"`
+	EarlyAccessHours    *int       `json:"early_access_hours,omitempty"`
+	IsVIP               bool       `json:"is_vip"`
+	UnlockAvailable     bool       `json:"unlock_available"`         // Còn lượt mở khóa miễn phí
+	NextUnlockAt        *time.Time `json:"next_unlock_at,omitempty"` // Thời điểm có lượt mở khóa tiếp theo
+	ServerTime          time.Time  `json:"server_time"`              // Mốc để tính đếm ngược phía client
+}
+
+// PaginatedReaderChaptersResponse represents a reader's page of chapters
+type PaginatedReaderChaptersResponse struct {
+	Chapters   []ReaderChapter     `json:"chapters"`
+	Release    ReaderReleaseStatus `json:"release"`
+	Pagination PaginationMeta      `json:"pagination"`
+}
+
+// ChapterUnlockResponse reports a free wait-until-free unlock
+type ChapterUnlockResponse struct {
+	ChapterID    string    `json:"chapter_id"`
+	NovelID      string    `json:"novel_id"`
+	UnlockedAt   time.Time `json:"unlocked_at"`
+	NextUnlockAt time.Time `json:"next_unlock_at"`
+}
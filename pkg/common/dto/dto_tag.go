@@ -0,0 +1,59 @@
+package dto
+
+// CreateTagRequest represents the request to register a canonical tag
+type CreateTagRequest struct {
+	Name         string          `json:"name" validate:"required,max=50"`
+	LanguageCode *string         `json:"language_code,omitempty" validate:"omitempty,max=5"`
+	Description  *string         `json:"description,omitempty" validate:"omitempty,max=2000"`
+	Aliases      []TagAliasInput `json:"aliases,omitempty" validate:"omitempty,max=50,dive"`
+}
+
+// UpdateTagRequest represents the request to update a tag; renaming keeps the old name as an alias
+type UpdateTagRequest struct {
+	Name         *string         `json:"name,omitempty" validate:"omitempty,max=50"`
+	LanguageCode *string         `json:"language_code,omitempty" validate:"omitempty,max=5"` // Chuỗi rỗng: bỏ ngôn ngữ
+	Description  *string         `json:"description,omitempty" validate:"omitempty,max=2000"`
+	Aliases      []TagAliasInput `json:"aliases,omitempty" validate:"omitempty,max=50,dive"` // Thay thế toàn bộ nếu có; [] để xoá hết
+}
+
+// TagAliasInput is an alternative spelling of a tag, or a synonym in the given language
+type TagAliasInput struct {
+	Alias        string  `json:"alias" validate:"required,max=50"`
+	LanguageCode *string `json:"language_code,omitempty" validate:"omitempty,max=5"`
+}
+
+// ListTagsRequest represents the request to list tags, most used first
+type ListTagsRequest struct {
+	Page     int    `form:"page,default=1" validate:"min=1"`
+	PageSize int    `form:"page_size,default=20" validate:"min=1,max=100"`
+	Search   string `form:"search,omitempty" validate:"max=50"`
+}
+
+// AutocompleteTagsRequest represents a tag prefix lookup
+type AutocompleteTagsRequest struct {
+	Query string `form:"q" validate:"required,max=50"`
+	Limit int    `form:"limit,default=10" validate:"min=1,max=50"`
+}
+
+// TagSuggestion is an autocomplete match, ranked by usage count
+type TagSuggestion struct {
+	ID           string  `json:"id"`
+	Name         string  `json:"name"`                    // Tên chuẩn, dùng khi gửi tags của novel
+	DisplayName  string  `json:"display_name"`            // Từ đồng nghĩa theo ngôn ngữ người đọc, nếu có
+	LanguageCode *string `json:"language_code,omitempty"` // Ngôn ngữ của display_name
+	MatchedAlias *string `json:"matched_alias,omitempty"` // Bí danh khớp tiền tố khi tên chuẩn không khớp
+	UsageCount   int     `json:"usage_count"`
+}
+
+// MergeTagRequest names the tag that absorbs the merged duplicate
+type MergeTagRequest struct {
+	TargetTagID string `json:"target_tag_id" validate:"required,uuid"`
+}
+
+// TagMergeResponse reports a merge of a duplicate tag into another
+type TagMergeResponse struct {
+	MergedTagID string `json:"merged_tag_id"`
+	TargetTagID string `json:"target_tag_id"`
+	Novels      int64  `json:"novels"`  // Số novel đã đổi sang tag đích
+	Aliases     int64  `json:"aliases"` // Số bí danh đã chuyển, gồm cả tên của tag bị gộp
+}
@@ -0,0 +1,168 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	m "wibusystem/pkg/common/model"
+)
+
+// NovelTranslationInput is one localized title and summary of a novel
+type NovelTranslationInput struct {
+	LanguageCode string           `json:"language_code" validate:"required,max=5"`
+	Title        string           `json:"title" validate:"required,max=1000"`
+	Summary      *json.RawMessage `json:"summary,omitempty"` // Tóm tắt đã dịch (Plate editor)
+	IsPrimary    bool             `json:"is_primary"`        // Tối đa một tiêu đề chính mỗi ngôn ngữ
+}
+
+// SetNovelTranslationsRequest replaces all localized titles and summaries of a novel
+type SetNovelTranslationsRequest struct {
+	Translations []NovelTranslationInput `json:"translations" validate:"dive"`
+}
+
+// NovelTranslationResponse is a localized title and summary of a novel
+type NovelTranslationResponse struct {
+	LanguageCode string           `json:"language_code"`
+	Title        string           `json:"title"`
+	Summary      *json.RawMessage `json:"summary,omitempty"`
+	IsPrimary    bool             `json:"is_primary"`
+	UpdatedAt    time.Time        `json:"updated_at"`
+}
+
+// NovelTranslationsResponse lists the localized metadata of a novel next to its original language
+type NovelTranslationsResponse struct {
+	NovelID          string                     `json:"novel_id"`
+	OriginalLanguage string                     `json:"original_language"`
+	Translations     []NovelTranslationResponse `json:"translations"`
+}
+
+// UpsertChapterTranslationRequest creates or replaces the translation of a chapter in one language
+type UpsertChapterTranslationRequest struct {
+	Title   *string         `json:"title,omitempty" validate:"omitempty,max=500"` // Tiêu đề chapter đã dịch
+	Content json.RawMessage `json:"content" validate:"required"`                  // Nội dung đã dịch (JSONB rich text)
+}
+
+// ChapterTranslationResponse is a translated chapter body in one language
+type ChapterTranslationResponse struct {
+	ChapterID          string           `json:"chapter_id"`
+	LanguageCode       string           `json:"language_code"`
+	Title              *string          `json:"title,omitempty"`
+	Content            *json.RawMessage `json:"content,omitempty"` // Only included when requested
+	WordCount          *int             `json:"word_count,omitempty"`
+	CharacterCount     *int             `json:"character_count,omitempty"`
+	ReadingTimeMinutes *int             `json:"reading_time_minutes,omitempty"`
+	TranslatedByUserID *string          `json:"translated_by_user_id,omitempty"`
+	CreatedAt          time.Time        `json:"created_at"`
+	UpdatedAt          time.Time        `json:"updated_at"`
+	// Glossary check of the saved translation; only returned by PUT
+	GlossaryViolations []GlossaryViolation `json:"glossary_violations,omitempty"`
+}
+
+// ChapterTranslationsResponse lists the translations of a chapter next to the novel's original language
+type ChapterTranslationsResponse struct {
+	ChapterID        string                       `json:"chapter_id"`
+	OriginalLanguage string                       `json:"original_language"`
+	Translations     []ChapterTranslationResponse `json:"translations"`
+}
+
+// GlossaryTermInput is one fixed translation of a novel term
+type GlossaryTermInput struct {
+	SourceTerm  string  `json:"source_term" validate:"required,max=200"`
+	Translation string  `json:"translation" validate:"required,max=200"`
+	Notes       *string `json:"notes,omitempty" validate:"omitempty,max=1000"`    // Ghi chú cho người dịch
+	CharacterID *string `json:"character_id,omitempty" validate:"omitempty,uuid"` // Nhân vật mà thuật ngữ chỉ tới
+}
+
+// SetGlossaryRequest replaces the glossary of a novel for one target language
+type SetGlossaryRequest struct {
+	Terms []GlossaryTermInput `json:"terms" validate:"max=1000,dive"`
+}
+
+// GlossaryTermResponse is one fixed translation of a novel term
+type GlossaryTermResponse struct {
+	ID          string    `json:"id"`
+	SourceTerm  string    `json:"source_term"`
+	Translation string    `json:"translation"`
+	Notes       *string   `json:"notes,omitempty"`
+	CharacterID *string   `json:"character_id,omitempty"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// GlossaryResponse lists the glossary of a novel for one target language
+type GlossaryResponse struct {
+	NovelID      string                 `json:"novel_id"`
+	LanguageCode string                 `json:"language_code"`
+	Terms        []GlossaryTermResponse `json:"terms"`
+}
+
+// MachineDraftResponse is a machine-translated chapter stored as a pending contribution
+type MachineDraftResponse struct {
+	Contribution m.TranslationContribution `json:"contribution"`
+	Provider     string                    `json:"provider"`
+	Blocks       int                       `json:"blocks"`        // Số block đã dịch, kể cả tiêu đề
+	GlossaryHits int                       `json:"glossary_hits"` // Số thuật ngữ đã thay bằng bản dịch cố định
+}
+
+// TextPosition locates a span of translated or source text: block is the index of the
+// text block in document order (0 for the title); offset and length count characters
+// in the block's plain text
+type TextPosition struct {
+	Field  string `json:"field"` // title | content
+	Block  int    `json:"block"`
+	Offset int    `json:"offset"`
+	Length int    `json:"length"`
+}
+
+// GlossaryViolation is a glossary term a translation does not render with its approved translation
+type GlossaryViolation struct {
+	Kind           string        `json:"kind"` // missing_translation | untranslated_term
+	TermID         string        `json:"term_id"`
+	SourceTerm     string        `json:"source_term"`
+	Expected       string        `json:"expected"`
+	SourcePosition *TextPosition `json:"source_position,omitempty"` // Vị trí thuật ngữ trong bản gốc
+	Position       *TextPosition `json:"position,omitempty"`        // Vị trí trong bản dịch (nếu xác định được)
+}
+
+// CreateTranslationContributionRequest submits a translation of a novel (title and summary)
+// or a chapter for review; the source language is the novel's original language
+type CreateTranslationContributionRequest struct {
+	ReferenceType        string          `json:"reference_type" validate:"required,oneof=novel novel_chapter"`
+	ReferenceID          string          `json:"reference_id" validate:"required,uuid"`
+	Title                string          `json:"title" validate:"required,max=1000"`
+	Content              json.RawMessage `json:"content" validate:"required"` // Nội dung đã dịch (Plate editor); tóm tắt với novel
+	TargetLanguage       string          `json:"target_language" validate:"required,max=5"`
+	IsMachineTranslation bool            `json:"is_machine_translation"`
+}
+
+// UpdateTranslationContributionRequest replaces the translation of a pending contribution
+type UpdateTranslationContributionRequest struct {
+	Title   string          `json:"title" validate:"required,max=1000"`
+	Content json.RawMessage `json:"content" validate:"required"`
+}
+
+// ListTranslationContributionsRequest represents query parameters for the review queue
+type ListTranslationContributionsRequest struct {
+	Page          int    `form:"page" validate:"omitempty,min=1"`
+	PageSize      int    `form:"page_size" validate:"omitempty,min=1,max=100"`
+	Status        string `form:"status" validate:"omitempty,oneof=pending approved rejected"` // Mặc định: pending (queue)
+	ReferenceType string `form:"reference_type" validate:"omitempty,oneof=novel novel_chapter"`
+	Language      string `form:"language" validate:"omitempty,max=5"` // Ngôn ngữ đích
+}
+
+// ReviewTranslationContributionRequest approves or rejects a pending contribution
+type ReviewTranslationContributionRequest struct {
+	Action          string  `json:"action" validate:"required,oneof=approve reject"`
+	RejectionReason *string `json:"rejection_reason,omitempty" validate:"omitempty,max=1000"` // Bắt buộc khi từ chối
+}
+
+// TranslationContributionResponse is a contribution with the glossary check of its translation
+type TranslationContributionResponse struct {
+	m.TranslationContribution
+	GlossaryViolations []GlossaryViolation `json:"glossary_violations"`
+}
+
+// PaginatedTranslationContributionsResponse wraps a page of contributions
+type PaginatedTranslationContributionsResponse struct {
+	Contributions []m.TranslationContribution `json:"contributions"`
+	Pagination    PaginationMeta              `json:"pagination"`
+}
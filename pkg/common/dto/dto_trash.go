@@ -0,0 +1,98 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Trash item types
+const (
+	TrashItemNovel   = "novel"
+	TrashItemVolume  = "volume"
+	TrashItemChapter = "chapter"
+)
+
+// ListTrashRequest represents query parameters for the trash listing
+type ListTrashRequest struct {
+	Page     int    `form:"page" validate:"omitempty,min=1"`
+	PageSize int    `form:"page_size" validate:"omitempty,min=1,max=100"`
+	Type     string `form:"type" validate:"omitempty,oneof=novel volume chapter"` // Lọc theo loại nội dung
+}
+
+// TrashItem is one delete operation in the trash: the deleted novel, volume or chapter with
+// the children removed together with it
+type TrashItem struct {
+	ItemType     string    `json:"item_type"` // novel | volume | chapter
+	ID           string    `json:"id"`
+	NovelID      string    `json:"novel_id"`
+	NovelName    string    `json:"novel_name"`
+	VolumeID     *string   `json:"volume_id,omitempty"`
+	Title        *string   `json:"title,omitempty"`
+	Number       *int      `json:"number,omitempty"` // Số tập hoặc số chương
+	VolumeCount  int       `json:"volume_count"`     // Số tập bị xóa cùng thao tác
+	ChapterCount int       `json:"chapter_count"`    // Số chương bị xóa cùng thao tác
+	DeletedAt    time.Time `json:"deleted_at"`
+	PurgeAt      time.Time `json:"purge_at"` // Thời điểm bị xóa vĩnh viễn (hoặc lưu trữ nếu đã có người mua)
+}
+
+// PaginatedTrashResponse wraps a page of trash items
+type PaginatedTrashResponse struct {
+	Items      []TrashItem    `json:"items"`
+	Pagination PaginationMeta `json:"pagination"`
+}
+
+// TrashRestoreResponse reports what a restore brought back
+type TrashRestoreResponse struct {
+	ItemType         string `json:"item_type"`
+	ID               string `json:"id"`
+	NovelID          string `json:"novel_id"`
+	RestoredVolumes  int    `json:"restored_volumes"`
+	RestoredChapters int    `json:"restored_chapters"`
+}
+
+// TrashExpiredItem is a trash item whose retention period has ended
+type TrashExpiredItem struct {
+	ItemType string
+	ID       uuid.UUID
+	NovelID  uuid.UUID
+}
+
+// TrashPurgeReport summarizes one purge run
+type TrashPurgeReport struct {
+	Purged   int `json:"purged"`   // Xóa vĩnh viễn
+	Archived int `json:"archived"` // Giữ lại chỉ đọc cho người mua
+	Failed   int `json:"failed"`
+}
+
+// ListArchivedRequest represents query parameters for the buyer's archived library
+type ListArchivedRequest struct {
+	Page     int `form:"page" validate:"omitempty,min=1"`
+	PageSize int `form:"page_size" validate:"omitempty,min=1,max=100"`
+}
+
+// ArchivedChapter is a chapter of purged content that the user still owns
+type ArchivedChapter struct {
+	ChapterID     string    `json:"chapter_id"`
+	ChapterNumber int       `json:"chapter_number"`
+	Title         *string   `json:"title,omitempty"`
+	VolumeID      string    `json:"volume_id"`
+	VolumeNumber  int       `json:"volume_number"`
+	VolumeTitle   *string   `json:"volume_title,omitempty"`
+	NovelID       string    `json:"novel_id"`
+	NovelName     string    `json:"novel_name"`
+	ArchivedAt    time.Time `json:"archived_at"`
+}
+
+// PaginatedArchivedResponse wraps a page of archived chapters
+type PaginatedArchivedResponse struct {
+	Chapters   []ArchivedChapter `json:"chapters"`
+	Pagination PaginationMeta    `json:"pagination"`
+}
+
+// ArchivedChapterContent is the read-only content of an archived chapter
+type ArchivedChapterContent struct {
+	ArchivedChapter
+	Content *json.RawMessage `json:"content,omitempty"`
+}
@@ -23,6 +23,7 @@ type UpdateUserRequest struct {
 	AvatarURL     *string          `json:"avatar_url,omitempty" validate:"omitempty,url"`
 	CoverImageURL *string          `json:"cover_image_url,omitempty" validate:"omitempty,url"`
 	Bio           *json.RawMessage `json:"bio,omitempty"`
+	Birthdate     *string          `json:"birthdate,omitempty" validate:"omitempty,datetime=2006-01-02"` // YYYY-MM-DD, chỉ đặt được một lần
 }
 
 // UpdateUserStatusRequest represents the request to update user status (admin only)
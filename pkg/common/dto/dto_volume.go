@@ -2,6 +2,8 @@ package dto
 
 import (
 	"time"
+
+	m "wibusystem/pkg/common/model"
 )
 
 // CreateVolumeRequest represents the payload for creating a new volume
@@ -11,6 +13,7 @@ type CreateVolumeRequest struct {
 	Title        *string `json:"title,omitempty" validate:"omitempty,max=500"` // Volume title (optional)
 	Description  *string `json:"description,omitempty" validate:"omitempty,max=5000"` // Volume description (optional)
 	CoverImage   *string `json:"cover_image,omitempty" validate:"omitempty,url,max=1000"` // Cover image URL (optional)
+	CoverMediaID *string `json:"cover_media_id,omitempty"` // Uploaded cover media ID (purpose=cover, optional)
 	IsPublic     bool    `json:"is_public"` // Public visibility flag
 	PriceCoins   *int    `json:"price_coins,omitempty" validate:"omitempty,min=0"` // Purchase price in coins (optional)
 }
@@ -21,6 +24,7 @@ type UpdateVolumeRequest struct {
 	Title       *string `json:"title,omitempty" validate:"omitempty,max=500"` // Volume title (optional)
 	Description *string `json:"description,omitempty" validate:"omitempty,max=5000"` // Volume description (optional)
 	CoverImage  *string `json:"cover_image,omitempty" validate:"omitempty,url,max=1000"` // Cover image URL (optional)
+	CoverMediaID *string `json:"cover_media_id,omitempty"` // Uploaded cover media ID; "" detaches (optional)
 	IsPublic    *bool   `json:"is_public,omitempty"` // Public visibility flag (optional)
 	PriceCoins  *int    `json:"price_coins,omitempty" validate:"omitempty,min=0"` // Purchase price in coins (optional)
 }
@@ -42,6 +46,7 @@ type VolumeResponse struct {
 	Title        *string    `json:"title,omitempty"` // Volume title (optional)
 	Description  *string    `json:"description,omitempty"` // Volume description (optional)
 	CoverImage   *string    `json:"cover_image,omitempty"` // Cover image URL (optional)
+	CoverMedia   *m.MediaImage `json:"cover_media,omitempty"` // Uploaded cover with renditions (optional)
 	PublishedAt  *time.Time `json:"published_at,omitempty"` // Publication date (optional)
 	IsPublic     bool       `json:"is_public"` // Public visibility flag
 	PriceCoins   *int       `json:"price_coins,omitempty"` // Purchase price in coins (optional)
@@ -0,0 +1,120 @@
+// Package imaging validates, cleans and resizes uploaded images with the
+// standard library only: JPEG, PNG and GIF are decoded and re-encoded, WebP is
+// measured and cleaned but cannot be decoded without a third-party codec
+package imaging
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	_ "image/gif" // registers the GIF decoder
+	"image/jpeg"
+	"image/png"
+)
+
+// Format names returned by Detect
+const (
+	FormatJPEG = "jpeg"
+	FormatPNG  = "png"
+	FormatGIF  = "gif"
+	FormatWebP = "webp"
+)
+
+// jpegQuality balances size and artefacts for thumbnails
+const jpegQuality = 82
+
+// Detect returns the image format from the file signature
+func Detect(data []byte) (string, error) {
+	switch {
+	case bytes.HasPrefix(data, []byte{0xff, 0xd8, 0xff}):
+		return FormatJPEG, nil
+	case bytes.HasPrefix(data, []byte("\x89PNG\r\n\x1a\n")):
+		return FormatPNG, nil
+	case bytes.HasPrefix(data, []byte("GIF87a")), bytes.HasPrefix(data, []byte("GIF89a")):
+		return FormatGIF, nil
+	case len(data) >= 12 && bytes.Equal(data[:4], []byte("RIFF")) && bytes.Equal(data[8:12], []byte("WEBP")):
+		return FormatWebP, nil
+	default:
+		return "", fmt.Errorf("invalid image: unsupported format")
+	}
+}
+
+// CanDecode reports whether renditions can be produced from the format
+func CanDecode(format string) bool {
+	return format == FormatJPEG || format == FormatPNG || format == FormatGIF
+}
+
+// Dimensions returns the displayed width and height, honouring the EXIF
+// orientation of JPEG files (orientations 5-8 swap the axes)
+func Dimensions(data []byte) (int, int, error) {
+	format, err := Detect(data)
+	if err != nil {
+		return 0, 0, err
+	}
+	if format == FormatWebP {
+		return webpSize(data)
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid image: %w", err)
+	}
+	if format == FormatJPEG && jpegOrientation(data) >= 5 {
+		return cfg.Height, cfg.Width, nil
+	}
+	return cfg.Width, cfg.Height, nil
+}
+
+// Decode decodes a JPEG, PNG or GIF (first frame) and applies the EXIF orientation
+func Decode(data []byte) (image.Image, error) {
+	format, err := Detect(data)
+	if err != nil {
+		return nil, err
+	}
+	if !CanDecode(format) {
+		return nil, fmt.Errorf("invalid image: %s cannot be decoded", format)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("invalid image: %w", err)
+	}
+	if format == FormatJPEG {
+		img = applyOrientation(img, jpegOrientation(data))
+	}
+	return img, nil
+}
+
+// Encode writes opaque images as JPEG and images with transparency as PNG;
+// neither carries metadata
+func Encode(img image.Image) ([]byte, string, error) {
+	var buf bytes.Buffer
+	if isOpaque(img) {
+		if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality}); err != nil {
+			return nil, "", fmt.Errorf("failed to encode JPEG: %w", err)
+		}
+		return buf.Bytes(), "image/jpeg", nil
+	}
+
+	encoder := png.Encoder{CompressionLevel: png.BestCompression}
+	if err := encoder.Encode(&buf, img); err != nil {
+		return nil, "", fmt.Errorf("failed to encode PNG: %w", err)
+	}
+	return buf.Bytes(), "image/png", nil
+}
+
+// isOpaque reports whether every pixel is fully opaque
+func isOpaque(img image.Image) bool {
+	if o, ok := img.(interface{ Opaque() bool }); ok {
+		return o.Opaque()
+	}
+	b := img.Bounds()
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			if _, _, _, a := img.At(x, y).RGBA(); a != 0xffff {
+				return false
+			}
+		}
+	}
+	return true
+}
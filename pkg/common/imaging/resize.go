@@ -0,0 +1,90 @@
+package imaging
+
+import (
+	"image"
+	"image/draw"
+)
+
+// Fit scales img down so it fits inside maxWidth x maxHeight keeping the aspect
+// ratio; a zero bound is unconstrained. Images are never upscaled.
+func Fit(img image.Image, maxWidth, maxHeight int) image.Image {
+	w, h := img.Bounds().Dx(), img.Bounds().Dy()
+	scale := 1.0
+	if maxWidth > 0 && w > maxWidth {
+		scale = float64(maxWidth) / float64(w)
+	}
+	if maxHeight > 0 && float64(h)*scale > float64(maxHeight) {
+		scale = float64(maxHeight) / float64(h)
+	}
+	if scale >= 1 {
+		return img
+	}
+	return resize(toRGBA(img), max(1, int(float64(w)*scale+0.5)), max(1, int(float64(h)*scale+0.5)))
+}
+
+// Fill scales and centre-crops img to exactly width x height; when the source is
+// smaller than the target the crop keeps the target aspect ratio at source size
+func Fill(img image.Image, width, height int) image.Image {
+	src := toRGBA(img)
+	w, h := src.Bounds().Dx(), src.Bounds().Dy()
+
+	// Largest crop of the source with the target aspect ratio
+	cropW, cropH := w, w*height/width
+	if cropH > h {
+		cropW, cropH = h*width/height, h
+	}
+	x0, y0 := (w-cropW)/2, (h-cropH)/2
+	cropped := src.SubImage(image.Rect(x0, y0, x0+cropW, y0+cropH)).(*image.RGBA)
+
+	if cropW <= width {
+		return toRGBA(cropped)
+	}
+	return resize(toRGBA(cropped), width, height)
+}
+
+// toRGBA copies img into an RGBA image anchored at the origin
+func toRGBA(img image.Image) *image.RGBA {
+	if rgba, ok := img.(*image.RGBA); ok && rgba.Bounds().Min == (image.Point{}) {
+		return rgba
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, img.Bounds().Dx(), img.Bounds().Dy()))
+	draw.Draw(dst, dst.Bounds(), img, img.Bounds().Min, draw.Src)
+	return dst
+}
+
+// resize downsamples with an area-average (box) filter, which is sharp enough
+// for thumbnails and avoids the aliasing of nearest-neighbour sampling
+func resize(src *image.RGBA, width, height int) *image.RGBA {
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	sw, sh := src.Bounds().Dx(), src.Bounds().Dy()
+	xRatio := float64(sw) / float64(width)
+	yRatio := float64(sh) / float64(height)
+
+	for y := 0; y < height; y++ {
+		sy0 := int(float64(y) * yRatio)
+		sy1 := max(sy0+1, min(sh, int(float64(y+1)*yRatio)))
+		for x := 0; x < width; x++ {
+			sx0 := int(float64(x) * xRatio)
+			sx1 := max(sx0+1, min(sw, int(float64(x+1)*xRatio)))
+
+			var r, g, b, a, n uint64
+			for sy := sy0; sy < sy1; sy++ {
+				i := src.PixOffset(sx0, sy)
+				for sx := sx0; sx < sx1; sx++ {
+					r += uint64(src.Pix[i])
+					g += uint64(src.Pix[i+1])
+					b += uint64(src.Pix[i+2])
+					a += uint64(src.Pix[i+3])
+					n++
+					i += 4
+				}
+			}
+			j := dst.PixOffset(x, y)
+			dst.Pix[j] = uint8(r / n)
+			dst.Pix[j+1] = uint8(g / n)
+			dst.Pix[j+2] = uint8(b / n)
+			dst.Pix[j+3] = uint8(a / n)
+		}
+	}
+	return dst
+}
@@ -0,0 +1,176 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"image/jpeg"
+)
+
+// jpegSegment is one marker segment before the JPEG scan data
+type jpegSegment struct {
+	marker  byte
+	payload []byte // segment body without marker and length
+	raw     []byte // whole segment including marker and length
+}
+
+// jpegSegments lists the segments between SOI and SOS
+func jpegSegments(data []byte) []jpegSegment {
+	segments := make([]jpegSegment, 0)
+	pos := 2
+	for pos+4 <= len(data) && data[pos] == 0xff {
+		marker := data[pos+1]
+		if marker == 0xda || marker == 0xd9 { // start of scan / end of image
+			break
+		}
+		length := int(binary.BigEndian.Uint16(data[pos+2 : pos+4]))
+		if length < 2 || pos+2+length > len(data) {
+			break
+		}
+		segments = append(segments, jpegSegment{
+			marker:  marker,
+			payload: data[pos+4 : pos+2+length],
+			raw:     data[pos : pos+2+length],
+		})
+		pos += 2 + length
+	}
+	return segments
+}
+
+// StripMetadata removes EXIF, XMP, IPTC and text metadata (camera details, GPS
+// position, comments). JPEG and PNG are cleaned losslessly unless a JPEG needs
+// its EXIF rotation baked in; WebP loses its EXIF and XMP chunks; GIF is kept.
+func StripMetadata(data []byte) ([]byte, error) {
+	format, err := Detect(data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch format {
+	case FormatJPEG:
+		return stripJPEG(data)
+	case FormatPNG:
+		return stripPNG(data)
+	case FormatWebP:
+		return stripWebP(data)
+	default:
+		return data, nil
+	}
+}
+
+// stripJPEG drops APP1 (EXIF/XMP), APP13 (IPTC) and COM segments; it keeps JFIF,
+// the ICC profile (APP2) and Adobe (APP14) segments that affect colours
+func stripJPEG(data []byte) ([]byte, error) {
+	if orientation := jpegOrientation(data); orientation != 1 {
+		// Removing EXIF would also remove the rotation, so draw it into the pixels
+		img, err := Decode(data)
+		if err != nil {
+			return nil, err
+		}
+		var buf bytes.Buffer
+		if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 92}); err != nil {
+			return nil, fmt.Errorf("failed to encode JPEG: %w", err)
+		}
+		return buf.Bytes(), nil
+	}
+
+	segments := jpegSegments(data)
+	var out bytes.Buffer
+	out.Write(data[:2])
+	consumed := 2
+	for _, segment := range segments {
+		consumed += len(segment.raw)
+		if segment.marker == 0xe1 || segment.marker == 0xed || segment.marker == 0xfe {
+			continue
+		}
+		out.Write(segment.raw)
+	}
+	out.Write(data[consumed:])
+	return out.Bytes(), nil
+}
+
+// pngMetadataChunks are ancillary chunks that only carry metadata
+var pngMetadataChunks = map[string]bool{"tEXt": true, "zTXt": true, "iTXt": true, "eXIf": true, "tIME": true}
+
+// stripPNG drops text, EXIF and timestamp chunks
+func stripPNG(data []byte) ([]byte, error) {
+	var out bytes.Buffer
+	out.Write(data[:8])
+	pos := 8
+	for pos+12 <= len(data) {
+		length := int(binary.BigEndian.Uint32(data[pos : pos+4]))
+		end := pos + 12 + length
+		if length < 0 || end > len(data) {
+			return nil, fmt.Errorf("invalid image: truncated PNG chunk")
+		}
+		chunkType := string(data[pos+4 : pos+8])
+		if !pngMetadataChunks[chunkType] {
+			out.Write(data[pos:end])
+		}
+		pos = end
+		if chunkType == "IEND" {
+			break
+		}
+	}
+	return out.Bytes(), nil
+}
+
+// stripWebP drops EXIF and XMP chunks and clears their VP8X flags
+func stripWebP(data []byte) ([]byte, error) {
+	var body bytes.Buffer
+	body.WriteString("WEBP")
+	pos := 12
+	for pos+8 <= len(data) {
+		chunkType := string(data[pos : pos+4])
+		size := int(binary.LittleEndian.Uint32(data[pos+4 : pos+8]))
+		end := pos + 8 + size + size%2 // chunks are padded to even sizes
+		if end > len(data) {
+			return nil, fmt.Errorf("invalid image: truncated WebP chunk")
+		}
+		switch chunkType {
+		case "EXIF", "XMP ":
+		case "VP8X":
+			chunk := append([]byte(nil), data[pos:end]...)
+			if len(chunk) > 8 {
+				chunk[8] &^= 0x08 | 0x04 // EXIF and XMP present flags
+			}
+			body.Write(chunk)
+		default:
+			body.Write(data[pos:end])
+		}
+		pos = end
+	}
+
+	out := make([]byte, 8, 8+body.Len())
+	copy(out, "RIFF")
+	binary.LittleEndian.PutUint32(out[4:8], uint32(body.Len()))
+	return append(out, body.Bytes()...), nil
+}
+
+// webpSize reads the canvas size from the VP8X, VP8L or VP8 header
+func webpSize(data []byte) (int, int, error) {
+	if len(data) < 30 {
+		return 0, 0, fmt.Errorf("invalid image: truncated WebP header")
+	}
+	switch string(data[12:16]) {
+	case "VP8X":
+		w := 1 + int(uint32(data[24])|uint32(data[25])<<8|uint32(data[26])<<16)
+		h := 1 + int(uint32(data[27])|uint32(data[28])<<8|uint32(data[29])<<16)
+		return w, h, nil
+	case "VP8L":
+		if data[20] != 0x2f {
+			return 0, 0, fmt.Errorf("invalid image: bad VP8L signature")
+		}
+		bits := binary.LittleEndian.Uint32(data[21:25])
+		return 1 + int(bits&0x3fff), 1 + int((bits>>14)&0x3fff), nil
+	case "VP8 ":
+		if !bytes.Equal(data[23:26], []byte{0x9d, 0x01, 0x2a}) {
+			return 0, 0, fmt.Errorf("invalid image: bad VP8 start code")
+		}
+		w := int(binary.LittleEndian.Uint16(data[26:28]) & 0x3fff)
+		h := int(binary.LittleEndian.Uint16(data[28:30]) & 0x3fff)
+		return w, h, nil
+	default:
+		return 0, 0, fmt.Errorf("invalid image: unknown WebP chunk")
+	}
+}
@@ -0,0 +1,38 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AnalyticsGranularity represents the bucket size of an analytics series
+type AnalyticsGranularity string
+
+const (
+	AnalyticsGranularityDay  AnalyticsGranularity = "day"  // Một điểm mỗi ngày
+	AnalyticsGranularityWeek AnalyticsGranularity = "week" // Một điểm mỗi tuần (bắt đầu thứ hai)
+)
+
+// IsValid checks if the granularity is a known value
+func (g AnalyticsGranularity) IsValid() bool {
+	return g == AnalyticsGranularityDay || g == AnalyticsGranularityWeek
+}
+
+// NovelChapterDailyStats represents one day of reading aggregates for a chapter
+type NovelChapterDailyStats struct {
+	ChapterID     uuid.UUID `json:"chapter_id" db:"chapter_id"`         // FK to novel_chapter
+	StatDate      time.Time `json:"stat_date" db:"stat_date"`           // Ngày thống kê
+	NovelID       uuid.UUID `json:"novel_id" db:"novel_id"`             // FK to novel (denormalized)
+	ViewCount     int64     `json:"view_count" db:"view_count"`         // Lượt đọc trong ngày
+	UniqueReaders int64     `json:"unique_readers" db:"unique_readers"` // Người đọc duy nhất trong ngày
+	UpdatedAt     time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// NovelWeeklyStats holds weekly unique readers, which cannot be summed from daily rows
+type NovelWeeklyStats struct {
+	NovelID       uuid.UUID `json:"novel_id" db:"novel_id"`             // FK to novel
+	WeekStart     time.Time `json:"week_start" db:"week_start"`         // Thứ hai đầu tuần
+	UniqueReaders int64     `json:"unique_readers" db:"unique_readers"` // Người đọc duy nhất trong tuần
+	UpdatedAt     time.Time `json:"updated_at" db:"updated_at"`
+}
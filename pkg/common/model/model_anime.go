@@ -10,13 +10,55 @@ import (
 // Anime represents an anime series master table
 type Anime struct {
 	ID              uuid.UUID        `json:"id" db:"id"`
-	Status          string           `json:"status" db:"status"` // content_status enum
+	Name            *string          `json:"name,omitempty" db:"name"` // Primary/default title
+	Status          string           `json:"status" db:"status"`       // content_status enum
 	CoverImage      *string          `json:"cover_image,omitempty" db:"cover_image"`
 	BroadcastSeason *string          `json:"broadcast_season,omitempty" db:"broadcast_season"` // season_name enum
 	BroadcastYear   *int             `json:"broadcast_year,omitempty" db:"broadcast_year"`
 	Summary         *json.RawMessage `json:"summary,omitempty" db:"summary"` // JSONB field
-	CreatedAt       time.Time        `json:"created_at" db:"created_at"`
-	UpdatedAt       time.Time        `json:"updated_at" db:"updated_at"`
+
+	// Ownership Model - same as Novel; owner IDs are nil for legacy admin-managed rows
+	OwnershipType          string     `json:"ownership_type" db:"ownership_type"`                               // PERSONAL, TENANT, COLLABORATIVE
+	PrimaryOwnerID         *uuid.UUID `json:"primary_owner_id,omitempty" db:"primary_owner_id"`                 // User ID (PERSONAL) or Tenant ID (TENANT/COLLABORATIVE)
+	OriginalCreatorID      *uuid.UUID `json:"original_creator_id,omitempty" db:"original_creator_id"`           // User who created the entry - immutable
+	AccessLevel            string     `json:"access_level" db:"access_level"`                                   // PRIVATE, TENANT_ONLY, PUBLIC
+	LastModifiedByUserID   *uuid.UUID `json:"last_modified_by_user_id,omitempty" db:"last_modified_by_user_id"` // User who last modified
+	OwnershipTransferredAt *time.Time `json:"ownership_transferred_at,omitempty" db:"ownership_transferred_at"` // When ownership was last transferred
+
+	// Publishing, rating và visibility
+	OriginalLanguage string     `json:"original_language" db:"original_language"`             // Ngôn ngữ gốc (ISO 639-1)
+	AgeRating        *string    `json:"age_rating,omitempty" db:"age_rating"`                 // G, PG, PG-13, R, NC-17
+	MatureContent    bool       `json:"mature_content" db:"mature_content"`                   // Nội dung người lớn
+	IsPublic         bool       `json:"is_public" db:"is_public"`                             // Công khai hay riêng tư
+	PublishedAt      *time.Time `json:"published_at,omitempty" db:"published_at"`             // Ngày phát hành
+	IsDeleted        bool       `json:"is_deleted" db:"is_deleted"`                           // Soft delete flag
+	DeletedAt        *time.Time `json:"deleted_at,omitempty" db:"deleted_at"`                 // Thời gian xóa
+	DeletedByUserID  *uuid.UUID `json:"deleted_by_user_id,omitempty" db:"deleted_by_user_id"` // User thực hiện xóa
+
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// AnimeTranslation represents a localized title and description of an anime
+type AnimeTranslation struct {
+	ID           uuid.UUID `json:"id" db:"id"`
+	AnimeID      uuid.UUID `json:"anime_id" db:"anime_id"`
+	LanguageCode string    `json:"language_code" db:"language_code"`
+	Title        string    `json:"title" db:"title"`
+	Description  *string   `json:"description,omitempty" db:"description"`
+	IsPrimary    bool      `json:"is_primary" db:"is_primary"` // Tiêu đề chính của ngôn ngữ này
+	CreatedAt    time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// AnimeCharacter maps a character to an anime with an optional voice actor (creator)
+type AnimeCharacter struct {
+	ID           uuid.UUID  `json:"id" db:"id"`
+	AnimeID      uuid.UUID  `json:"anime_id" db:"anime_id"`
+	CharacterID  uuid.UUID  `json:"character_id" db:"character_id"`
+	VoiceActorID *uuid.UUID `json:"voice_actor_id,omitempty" db:"voice_actor_id"`
+	CreatedAt    time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at" db:"updated_at"`
 }
 
 // AnimeSeason represents seasons of an anime series
@@ -34,24 +76,58 @@ type AnimeSeason struct {
 
 // AnimeEpisode represents individual episodes within an anime season
 type AnimeEpisode struct {
-	ID              uuid.UUID `json:"id" db:"id"`
-	SeasonID        uuid.UUID `json:"season_id" db:"season_id"`
-	EpisodeNumber   int       `json:"episode_number" db:"episode_number"`
-	Title           *string   `json:"title,omitempty" db:"title"`
-	DurationSeconds *int      `json:"duration_seconds,omitempty" db:"duration_seconds"`
-	VideoURL        *string   `json:"video_url,omitempty" db:"video_url"`
-	IsPublic        bool      `json:"is_public" db:"is_public"`
-	PriceCoins      *int      `json:"price_coins,omitempty" db:"price_coins"`
-	CreatedAt       time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt       time.Time `json:"updated_at" db:"updated_at"`
+	ID                   uuid.UUID  `json:"id" db:"id"`
+	SeasonID             uuid.UUID  `json:"season_id" db:"season_id"`
+	EpisodeNumber        int        `json:"episode_number" db:"episode_number"`
+	Title                *string    `json:"title,omitempty" db:"title"`
+	DurationSeconds      *int       `json:"duration_seconds,omitempty" db:"duration_seconds"`
+	VideoURL             *string    `json:"video_url,omitempty" db:"video_url"`
+	IsPublic             bool       `json:"is_public" db:"is_public"` // Miễn phí (không cần mua)
+	PriceCoins           *int       `json:"price_coins,omitempty" db:"price_coins"`
+	IsDraft              bool       `json:"is_draft" db:"is_draft"`
+	PublishedAt          *time.Time `json:"published_at,omitempty" db:"published_at"`
+	LastModifiedByUserID *uuid.UUID `json:"last_modified_by_user_id,omitempty" db:"last_modified_by_user_id"`
+	CreatedAt            time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt            time.Time  `json:"updated_at" db:"updated_at"`
 }
 
-// EpisodeSubtitle represents subtitle files for episodes
+// EpisodeSubtitle represents subtitle files for episodes; hosted subtitles keep
+// their WebVTT content, external ones only a subtitle_url
 type EpisodeSubtitle struct {
-	ID           uuid.UUID `json:"id" db:"id"`
-	EpisodeID    uuid.UUID `json:"episode_id" db:"episode_id"`
-	LanguageCode string    `json:"language_code" db:"language_code"`
-	SubtitleURL  *string   `json:"subtitle_url,omitempty" db:"subtitle_url"`
-	CreatedAt    time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt    time.Time `json:"updated_at" db:"updated_at"`
+	ID               uuid.UUID  `json:"id" db:"id"`
+	EpisodeID        uuid.UUID  `json:"episode_id" db:"episode_id"`
+	LanguageCode     string     `json:"language_code" db:"language_code"`
+	SubtitleURL      *string    `json:"subtitle_url,omitempty" db:"subtitle_url"`
+	SourceFormat     *string    `json:"source_format,omitempty" db:"source_format"` // srt | vtt | ass
+	Content          *string    `json:"-" db:"content"`                             // WebVTT
+	CueCount         *int       `json:"cue_count,omitempty" db:"cue_count"`
+	UploadedByUserID *uuid.UUID `json:"uploaded_by_user_id,omitempty" db:"uploaded_by_user_id"`
+	CreatedAt        time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at" db:"updated_at"`
+}
+
+// Subtitle contribution workflow values
+const (
+	SubtitleContributionPending  = "pending"
+	SubtitleContributionApproved = "approved"
+	SubtitleContributionRejected = "rejected"
+)
+
+// SubtitleContribution is a community subtitle for one episode language, reviewed by the anime's managers
+type SubtitleContribution struct {
+	ID              uuid.UUID  `json:"id" db:"id"`
+	EpisodeID       uuid.UUID  `json:"episode_id" db:"episode_id"`
+	LanguageCode    string     `json:"language_code" db:"language_code"`
+	SourceFormat    string     `json:"source_format" db:"source_format"` // srt | vtt | ass
+	Content         string     `json:"content" db:"content"`             // Normalized WebVTT
+	CueCount        int        `json:"cue_count" db:"cue_count"`
+	Note            *string    `json:"note,omitempty" db:"note"`
+	UserID          uuid.UUID  `json:"user_id" db:"user_id"`
+	TenantID        *uuid.UUID `json:"tenant_id,omitempty" db:"tenant_id"`
+	Status          string     `json:"status" db:"status"` // pending | approved | rejected
+	RejectionReason *string    `json:"rejection_reason,omitempty" db:"rejection_reason"`
+	ReviewerID      *uuid.UUID `json:"reviewer_id,omitempty" db:"reviewer_id"`
+	ReviewedAt      *time.Time `json:"reviewed_at,omitempty" db:"reviewed_at"`
+	CreatedAt       time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at" db:"updated_at"`
 }
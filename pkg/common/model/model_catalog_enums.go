@@ -47,6 +47,10 @@ const (
 	ContentRelationSequel     ContentRelationType = "SEQUEL"
 	ContentRelationSpinoff    ContentRelationType = "SPINOFF"
 	ContentRelationRelated    ContentRelationType = "RELATED"
+	// Inverse labels, stored on the mirrored row of each relation
+	ContentRelationPrequel     ContentRelationType = "PREQUEL"
+	ContentRelationSource      ContentRelationType = "SOURCE"
+	ContentRelationParentStory ContentRelationType = "PARENT_STORY"
 
 	PurchaseItemAnimeEpisode PurchaseItemType = "ANIME_EPISODE"
 	PurchaseItemAnimeSeason  PurchaseItemType = "ANIME_SEASON"
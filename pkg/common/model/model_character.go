@@ -8,10 +8,49 @@ import (
 
 // Character represents a fictional character that can appear in multiple content (anime/manga/novel)
 type Character struct {
-	ID          uuid.UUID `json:"id" db:"id"`
-	Name        string    `json:"name" db:"name"`
-	Description *string   `json:"description,omitempty" db:"description"`
-	ImageURL    *string   `json:"image_url,omitempty" db:"image_url"`
-	CreatedAt   time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
-}
\ No newline at end of file
+	ID          uuid.UUID   `json:"id" db:"id"`
+	Name        string      `json:"name" db:"name"`
+	Description *string     `json:"description,omitempty" db:"description"`
+	ImageURL    *string     `json:"image_url,omitempty" db:"image_url"`
+	ImageMedia  *MediaImage `json:"image_media,omitempty" db:"-"` // Uploaded image with renditions
+	CreatedAt   time.Time   `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at" db:"updated_at"`
+}
+
+// CharacterTranslation holds a localized description of a character
+type CharacterTranslation struct {
+	LanguageCode string `json:"language_code" db:"language_code"`
+	Description  string `json:"description" db:"description"`
+}
+
+// Character contribution workflow values
+const (
+	CharacterContributionCreate = "create"
+	CharacterContributionUpdate = "update"
+
+	CharacterContributionPending  = "pending"
+	CharacterContributionApproved = "approved"
+	CharacterContributionRejected = "rejected"
+)
+
+// CharacterContribution is a user proposal to create or edit a character
+type CharacterContribution struct {
+	ID               uuid.UUID              `json:"id" db:"id"`
+	ContributionType string                 `json:"contribution_type" db:"contribution_type"` // create | update
+	CharacterID      *uuid.UUID             `json:"character_id,omitempty" db:"character_id"`
+	Name             *string                `json:"name,omitempty" db:"name"`
+	Description      *string                `json:"description,omitempty" db:"description"`
+	ImageURL         *string                `json:"image_url,omitempty" db:"image_url"`
+	Translations     []CharacterTranslation `json:"translations,omitempty" db:"translations"`
+	AddNovelIDs      []uuid.UUID            `json:"add_novel_ids,omitempty" db:"add_novel_ids"`
+	RemoveNovelIDs   []uuid.UUID            `json:"remove_novel_ids,omitempty" db:"remove_novel_ids"`
+	Note             *string                `json:"note,omitempty" db:"note"`
+	UserID           uuid.UUID              `json:"user_id" db:"user_id"`
+	TenantID         *uuid.UUID             `json:"tenant_id,omitempty" db:"tenant_id"`
+	Status           string                 `json:"status" db:"status"` // pending | approved | rejected
+	RejectionReason  *string                `json:"rejection_reason,omitempty" db:"rejection_reason"`
+	ReviewerID       *uuid.UUID             `json:"reviewer_id,omitempty" db:"reviewer_id"`
+	ReviewedAt       *time.Time             `json:"reviewed_at,omitempty" db:"reviewed_at"`
+	CreatedAt        time.Time              `json:"created_at" db:"created_at"`
+	UpdatedAt        time.Time              `json:"updated_at" db:"updated_at"`
+}
@@ -0,0 +1,57 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserContentFilter holds the content a reader chose not to see
+type UserContentFilter struct {
+	UserID            uuid.UUID `json:"user_id" db:"user_id"`
+	HideMature        bool      `json:"hide_mature" db:"hide_mature"`               // Ẩn nội dung người lớn dù đủ tuổi
+	BlockedWarnings   []string  `json:"blocked_warnings" db:"blocked_warnings"`     // Không bao giờ hiển thị
+	WarnedWarnings    []string  `json:"warned_warnings" db:"warned_warnings"`       // Cảnh báo trước khi xem
+	RestrictedDisplay string    `json:"restricted_display" db:"restricted_display"` // HIDE | BLUR
+	CreatedAt         time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// TenantContentPolicy overrides the platform age gating for content owned by a tenant; nil keeps the default
+type TenantContentPolicy struct {
+	TenantID          uuid.UUID  `json:"tenant_id" db:"tenant_id"`
+	MinAgePG13        *int       `json:"min_age_pg13,omitempty" db:"min_age_pg13"`
+	MinAgeR           *int       `json:"min_age_r,omitempty" db:"min_age_r"`
+	MinAgeNC17        *int       `json:"min_age_nc17,omitempty" db:"min_age_nc17"`
+	MinAgeMature      *int       `json:"min_age_mature,omitempty" db:"min_age_mature"`
+	RestrictedDisplay *string    `json:"restricted_display,omitempty" db:"restricted_display"` // HIDE | BLUR
+	UpdatedByUserID   *uuid.UUID `json:"updated_by_user_id,omitempty" db:"updated_by_user_id"`
+	CreatedAt         time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at" db:"updated_at"`
+}
+
+// How restricted items are shown in lists
+const (
+	RestrictedDisplayHide = "HIDE" // Bỏ khỏi danh sách
+	RestrictedDisplayBlur = "BLUR" // Giữ trong danh sách, client làm mờ
+	RestrictedDisplayWarn = "WARN" // Hiển thị bình thường, client cảnh báo trước khi mở
+)
+
+// Why an item is restricted for a viewer
+const (
+	RestrictionAgeRestricted  = "AGE_RESTRICTED"  // Người xem chưa đủ tuổi
+	RestrictionAgeUnverified  = "AGE_UNVERIFIED"  // Chưa khai báo ngày sinh hoặc chưa đăng nhập
+	RestrictionMatureHidden   = "MATURE_HIDDEN"   // Người xem chọn ẩn nội dung người lớn
+	RestrictionWarningBlocked = "WARNING_BLOCKED" // Có cảnh báo nội dung người xem đã chặn
+	RestrictionWarningNotice  = "WARNING_NOTICE"  // Có cảnh báo nội dung người xem muốn được báo trước
+)
+
+// Platform default minimum ages; tenants can override them for their own content
+const (
+	DefaultMinAgePG13   = 13
+	DefaultMinAgeR      = 17
+	DefaultMinAgeNC17   = 18
+	DefaultMinAgeMature = 18
+	// UnverifiedMaxAge is the highest required age shown to viewers without a declared age
+	UnverifiedMaxAge = 13
+)
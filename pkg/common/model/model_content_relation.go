@@ -0,0 +1,60 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ContentRelation links two works; the target is a <RelationType> of the source
+// (e.g. SEQUEL: target is the sequel of source). Every relation has a mirrored row
+// carrying the inverse type so it can be read from both ends.
+type ContentRelation struct {
+	ID           uuid.UUID           `json:"id" db:"id"`
+	SourceID     uuid.UUID           `json:"source_id" db:"source_id"`
+	SourceType   ContentType         `json:"source_type" db:"source_type"`
+	TargetID     uuid.UUID           `json:"target_id" db:"target_id"`
+	TargetType   ContentType         `json:"target_type" db:"target_type"`
+	RelationType ContentRelationType `json:"relation_type" db:"relation_type"`
+	CreatedAt    time.Time           `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time           `json:"updated_at" db:"updated_at"`
+}
+
+// IsValid reports whether the content type is a known content_type_enum value
+func (t ContentType) IsValid() bool {
+	switch t {
+	case ContentTypeAnime, ContentTypeManga, ContentTypeNovel:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether the relation type is a known content_relation_type value
+func (t ContentRelationType) IsValid() bool {
+	switch t {
+	case ContentRelationAdaptation, ContentRelationSequel, ContentRelationSpinoff, ContentRelationRelated,
+		ContentRelationPrequel, ContentRelationSource, ContentRelationParentStory:
+		return true
+	}
+	return false
+}
+
+// Inverse returns the relation type seen from the target (SEQUEL <-> PREQUEL, ADAPTATION <-> SOURCE,
+// SPINOFF <-> PARENT_STORY); RELATED is symmetric
+func (t ContentRelationType) Inverse() ContentRelationType {
+	switch t {
+	case ContentRelationSequel:
+		return ContentRelationPrequel
+	case ContentRelationPrequel:
+		return ContentRelationSequel
+	case ContentRelationAdaptation:
+		return ContentRelationSource
+	case ContentRelationSource:
+		return ContentRelationAdaptation
+	case ContentRelationSpinoff:
+		return ContentRelationParentStory
+	case ContentRelationParentStory:
+		return ContentRelationSpinoff
+	}
+	return ContentRelationRelated
+}
@@ -0,0 +1,30 @@
+package model
+
+import "time"
+
+// ContentWarning is a managed content warning; novels and chapters store its Code in content_warnings
+type ContentWarning struct {
+	Code         string                      `json:"code" db:"code"`
+	Severity     string                      `json:"severity" db:"severity"` // MILD | MODERATE | SEVERE
+	SortOrder    int                         `json:"sort_order" db:"sort_order"`
+	IsActive     bool                        `json:"is_active" db:"is_active"` // Ngừng dùng: không gắn mới được
+	Label        string                      `json:"label" db:"-"`             // Nhãn theo ngôn ngữ người xem, mặc định là mã
+	Description  *string                     `json:"description,omitempty" db:"-"`
+	Translations []ContentWarningTranslation `json:"translations,omitempty" db:"-"` // Chỉ có khi quản trị
+	CreatedAt    time.Time                   `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time                   `json:"updated_at" db:"updated_at"`
+}
+
+// ContentWarningTranslation is the label of a content warning in one language
+type ContentWarningTranslation struct {
+	LanguageCode string  `json:"language_code" db:"language_code"`
+	Label        string  `json:"label" db:"label"`
+	Description  *string `json:"description,omitempty" db:"description"`
+}
+
+// Content warning severities
+const (
+	ContentWarningSeverityMild     = "MILD"
+	ContentWarningSeverityModerate = "MODERATE"
+	ContentWarningSeveritySevere   = "SEVERE"
+)
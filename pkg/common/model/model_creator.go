@@ -8,11 +8,68 @@ import (
 
 // Creator represents a content creator (author, artist, studio, voice actor, etc.)
 type Creator struct {
-	ID          uuid.UUID `json:"id" db:"id"`
-	Name        string    `json:"name" db:"name"`
-	Description *string   `json:"description,omitempty" db:"description"`
-	CreatedAt   time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
+	ID          uuid.UUID           `json:"id" db:"id"`
+	Name        string              `json:"name" db:"name"`
+	Description *string             `json:"description,omitempty" db:"description"` // Tiểu sử mặc định
+	UserID      *uuid.UUID          `json:"user_id,omitempty" db:"user_id"`         // Tài khoản đã nhận creator
+	TenantID    *uuid.UUID          `json:"tenant_id,omitempty" db:"tenant_id"`     // Tenant đã nhận creator
+	ClaimedAt   *time.Time          `json:"claimed_at,omitempty" db:"claimed_at"`
+	SocialLinks []CreatorSocialLink `json:"social_links,omitempty" db:"social_links"`
+	CreatedAt   time.Time           `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time           `json:"updated_at" db:"updated_at"`
+	Aliases     []CreatorAlias      `json:"aliases,omitempty" db:"-"`     // Chỉ có trong trang creator
+	Biographies []CreatorBiography  `json:"biographies,omitempty" db:"-"` // Chỉ có trong trang creator
+}
+
+// CreatorSocialLink is a link to a creator's profile on another site
+type CreatorSocialLink struct {
+	Platform string `json:"platform"` // twitter, pixiv, website...
+	URL      string `json:"url"`
+}
+
+// CreatorAlias is a pen name or an alternative name of a creator
+type CreatorAlias struct {
+	CreatorID    uuid.UUID `json:"-" db:"creator_id"`
+	Alias        string    `json:"alias" db:"alias"`
+	AliasType    string    `json:"alias_type" db:"alias_type"` // ALIAS, PEN_NAME
+	LanguageCode *string   `json:"language_code,omitempty" db:"language_code"`
+}
+
+// CreatorBiography is the biography of a creator in one language
+type CreatorBiography struct {
+	CreatorID    uuid.UUID `json:"-" db:"creator_id"`
+	LanguageCode string    `json:"language_code" db:"language_code"`
+	Biography    string    `json:"biography" db:"biography"`
+	UpdatedAt    time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// Creator alias types
+const (
+	CreatorAliasAlias   = "ALIAS"
+	CreatorAliasPenName = "PEN_NAME"
+)
+
+// Creator claim workflow values
+const (
+	CreatorClaimPending  = "pending"
+	CreatorClaimApproved = "approved"
+	CreatorClaimRejected = "rejected"
+)
+
+// CreatorClaim is a request to link a creator page to the claimant or their tenant
+type CreatorClaim struct {
+	ID              uuid.UUID  `json:"id" db:"id"`
+	CreatorID       uuid.UUID  `json:"creator_id" db:"creator_id"`
+	CreatorName     string     `json:"creator_name" db:"creator_name"`
+	UserID          uuid.UUID  `json:"user_id" db:"user_id"`
+	TenantID        *uuid.UUID `json:"tenant_id,omitempty" db:"tenant_id"` // Có giá trị: nhận cho tenant
+	Evidence        string     `json:"evidence" db:"evidence"`
+	Status          string     `json:"status" db:"status"` // pending | approved | rejected
+	RejectionReason *string    `json:"rejection_reason,omitempty" db:"rejection_reason"`
+	ReviewerID      *uuid.UUID `json:"reviewer_id,omitempty" db:"reviewer_id"`
+	ReviewedAt      *time.Time `json:"reviewed_at,omitempty" db:"reviewed_at"`
+	CreatedAt       time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at" db:"updated_at"`
 }
 
 // CreatorWithRole represents a creator with their role in a specific content
@@ -8,11 +8,24 @@ import (
 
 // Genre represents a content genre that can be applied to anime, manga, or novels
 type Genre struct {
-	ID         uuid.UUID `json:"id" db:"id"`
-	Name       string    `json:"name" db:"name"`
-	CreatedAt  time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt  time.Time `json:"updated_at" db:"updated_at"`
-	AnimeCount int       `json:"anime_count,omitempty" db:"anime_count"`
-	MangaCount int       `json:"manga_count,omitempty" db:"manga_count"`
-	NovelCount int       `json:"novel_count,omitempty" db:"novel_count"`
-}
\ No newline at end of file
+	ID           uuid.UUID          `json:"id" db:"id"`
+	ParentID     *uuid.UUID         `json:"parent_id,omitempty" db:"parent_id"` // Thể loại cha (nil: thể loại gốc)
+	Name         string             `json:"name" db:"name"`
+	Description  *string            `json:"description,omitempty" db:"description"`
+	SortOrder    int                `json:"sort_order" db:"sort_order"` // Trọng số sắp xếp giữa các thể loại cùng cấp (nhỏ trước)
+	CreatedAt    time.Time          `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time          `json:"updated_at" db:"updated_at"`
+	AnimeCount   int                `json:"anime_count,omitempty" db:"anime_count"`
+	MangaCount   int                `json:"manga_count,omitempty" db:"manga_count"`
+	NovelCount   int                `json:"novel_count,omitempty" db:"novel_count"`
+	Translations []GenreTranslation `json:"translations,omitempty" db:"-"` // Chỉ có trong chi tiết thể loại
+}
+
+// GenreTranslation is the name and description of a genre in one language
+type GenreTranslation struct {
+	GenreID      uuid.UUID `json:"-" db:"genre_id"`
+	LanguageCode string    `json:"language_code" db:"language_code"`
+	Name         string    `json:"name" db:"name"`
+	Description  *string   `json:"description,omitempty" db:"description"`
+	UpdatedAt    time.Time `json:"updated_at" db:"updated_at"`
+}
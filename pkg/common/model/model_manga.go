@@ -10,11 +10,43 @@ import (
 // Manga represents a manga series master table
 type Manga struct {
 	ID         uuid.UUID        `json:"id" db:"id"`
-	Status     string           `json:"status" db:"status"` // content_status enum
+	Name       *string          `json:"name,omitempty" db:"name"` // Primary/default title
+	Status     string           `json:"status" db:"status"`       // content_status enum
 	CoverImage *string          `json:"cover_image,omitempty" db:"cover_image"`
 	Summary    *json.RawMessage `json:"summary,omitempty" db:"summary"` // JSONB field
-	CreatedAt  time.Time        `json:"created_at" db:"created_at"`
-	UpdatedAt  time.Time        `json:"updated_at" db:"updated_at"`
+
+	// Ownership Model - same as Novel; owner IDs are nil for legacy admin-managed rows
+	OwnershipType          string     `json:"ownership_type" db:"ownership_type"`                               // PERSONAL, TENANT, COLLABORATIVE
+	PrimaryOwnerID         *uuid.UUID `json:"primary_owner_id,omitempty" db:"primary_owner_id"`                 // User ID (PERSONAL) or Tenant ID (TENANT/COLLABORATIVE)
+	OriginalCreatorID      *uuid.UUID `json:"original_creator_id,omitempty" db:"original_creator_id"`           // User who created the entry - immutable
+	AccessLevel            string     `json:"access_level" db:"access_level"`                                   // PRIVATE, TENANT_ONLY, PUBLIC
+	LastModifiedByUserID   *uuid.UUID `json:"last_modified_by_user_id,omitempty" db:"last_modified_by_user_id"` // User who last modified
+	OwnershipTransferredAt *time.Time `json:"ownership_transferred_at,omitempty" db:"ownership_transferred_at"` // When ownership was last transferred
+
+	// Publishing, rating và visibility
+	OriginalLanguage string     `json:"original_language" db:"original_language"`             // Ngôn ngữ gốc (ISO 639-1)
+	AgeRating        *string    `json:"age_rating,omitempty" db:"age_rating"`                 // G, PG, PG-13, R, NC-17
+	MatureContent    bool       `json:"mature_content" db:"mature_content"`                   // Nội dung người lớn
+	IsPublic         bool       `json:"is_public" db:"is_public"`                             // Công khai hay riêng tư
+	PublishedAt      *time.Time `json:"published_at,omitempty" db:"published_at"`             // Ngày phát hành
+	IsDeleted        bool       `json:"is_deleted" db:"is_deleted"`                           // Soft delete flag
+	DeletedAt        *time.Time `json:"deleted_at,omitempty" db:"deleted_at"`                 // Thời gian xóa
+	DeletedByUserID  *uuid.UUID `json:"deleted_by_user_id,omitempty" db:"deleted_by_user_id"` // User thực hiện xóa
+
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// MangaTranslation represents a localized title and description of a manga
+type MangaTranslation struct {
+	ID           uuid.UUID `json:"id" db:"id"`
+	MangaID      uuid.UUID `json:"manga_id" db:"manga_id"`
+	LanguageCode string    `json:"language_code" db:"language_code"`
+	Title        string    `json:"title" db:"title"`
+	Description  *string   `json:"description,omitempty" db:"description"`
+	IsPrimary    bool      `json:"is_primary" db:"is_primary"` // Tiêu đề chính của ngôn ngữ này
+	CreatedAt    time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at" db:"updated_at"`
 }
 
 // MangaVolume represents volumes of a manga series
@@ -34,15 +66,17 @@ type MangaVolume struct {
 
 // MangaChapter represents chapters of a manga
 type MangaChapter struct {
-	ID            uuid.UUID  `json:"id" db:"id"`
-	VolumeID      uuid.UUID  `json:"volume_id" db:"volume_id"`
-	ChapterNumber int        `json:"chapter_number" db:"chapter_number"`
-	Title         *string    `json:"title,omitempty" db:"title"`
-	ReleasedAt    *time.Time `json:"released_at,omitempty" db:"released_at"`
-	IsPublic      bool       `json:"is_public" db:"is_public"`
-	PriceCoins    *int       `json:"price_coins,omitempty" db:"price_coins"`
-	CreatedAt     time.Time  `json:"created_at" db:"created_at"`
-	UpdatedAt     time.Time  `json:"updated_at" db:"updated_at"`
+	ID                   uuid.UUID  `json:"id" db:"id"`
+	VolumeID             uuid.UUID  `json:"volume_id" db:"volume_id"`
+	ChapterNumber        int        `json:"chapter_number" db:"chapter_number"`
+	Title                *string    `json:"title,omitempty" db:"title"`
+	ReleasedAt           *time.Time `json:"released_at,omitempty" db:"released_at"` // Thời điểm phát hành (publish time)
+	IsPublic             bool       `json:"is_public" db:"is_public"`               // Miễn phí (không cần mua)
+	PriceCoins           *int       `json:"price_coins,omitempty" db:"price_coins"`
+	IsDraft              bool       `json:"is_draft" db:"is_draft"`
+	LastModifiedByUserID *uuid.UUID `json:"last_modified_by_user_id,omitempty" db:"last_modified_by_user_id"`
+	CreatedAt            time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt            time.Time  `json:"updated_at" db:"updated_at"`
 }
 
 // MangaPage represents individual pages of a manga chapter
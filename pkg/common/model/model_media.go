@@ -0,0 +1,85 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MediaAsset is an uploaded file kept in object storage; identical files share one
+// asset through their SHA-256 checksum
+type MediaAsset struct {
+	ID               uuid.UUID  `json:"id" db:"id"`
+	ChecksumSHA256   string     `json:"checksum_sha256" db:"checksum_sha256"`
+	StorageKey       string     `json:"storage_key" db:"storage_key"`
+	ContentType      string     `json:"content_type" db:"content_type"` // Sniffed from the content, not the client header
+	SizeBytes        int64      `json:"size_bytes" db:"size_bytes"`
+	OriginalFilename *string    `json:"original_filename,omitempty" db:"original_filename"`
+	UploadedByUserID uuid.UUID  `json:"uploaded_by_user_id" db:"uploaded_by_user_id"`
+	TenantID         *uuid.UUID `json:"tenant_id,omitempty" db:"tenant_id"`
+
+	// Image processing
+	Width            *int       `json:"width,omitempty" db:"width"`
+	Height           *int       `json:"height,omitempty" db:"height"`
+	Purposes         []string   `json:"purposes" db:"purposes"`                   // Renditions to derive: generic, cover, avatar, page
+	ProcessingStatus string     `json:"processing_status" db:"processing_status"` // pending | processing | ready | failed | skipped
+	Attempts         int        `json:"attempts" db:"attempts"`
+	ProcessingError  *string    `json:"processing_error,omitempty" db:"processing_error"`
+	ProcessedAt      *time.Time `json:"processed_at,omitempty" db:"processed_at"`
+
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
+
+// Media upload purposes; each selects the dimension rules and renditions of an image
+const (
+	MediaPurposeGeneric = "generic"
+	MediaPurposeCover   = "cover"
+	MediaPurposeAvatar  = "avatar"
+	MediaPurposePage    = "page"
+)
+
+// Media processing states
+const (
+	MediaProcessingPending    = "pending"
+	MediaProcessingProcessing = "processing"
+	MediaProcessingReady      = "ready"
+	MediaProcessingFailed     = "failed"
+	MediaProcessingSkipped    = "skipped" // Not an image, or a format without a decoder (WebP)
+)
+
+// Media attachment kinds: which entity image slot an asset fills
+const (
+	MediaAttachmentNovelCover     = "novel_cover"
+	MediaAttachmentVolumeCover    = "volume_cover"
+	MediaAttachmentCharacterImage = "character_image"
+)
+
+// MediaVariant is a derived rendition (thumbnail, resized page) of an image asset
+type MediaVariant struct {
+	AssetID     uuid.UUID `json:"asset_id" db:"asset_id"`
+	Name        string    `json:"name" db:"name"` // cover_small, avatar_128, page_display...
+	StorageKey  string    `json:"storage_key" db:"storage_key"`
+	ContentType string    `json:"content_type" db:"content_type"`
+	Width       int       `json:"width" db:"width"`
+	Height      int       `json:"height" db:"height"`
+	SizeBytes   int64     `json:"size_bytes" db:"size_bytes"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+}
+
+// MediaImage is an attached image as shown in entity responses, with signed URLs
+type MediaImage struct {
+	MediaID  string                       `json:"media_id"`
+	Status   string                       `json:"status"` // pending | processing | ready | failed | skipped
+	URL      string                       `json:"url"`    // Original (metadata stripped)
+	Width    *int                         `json:"width,omitempty"`
+	Height   *int                         `json:"height,omitempty"`
+	Variants map[string]MediaImageVariant `json:"variants"` // Empty until processing is ready
+}
+
+// MediaImageVariant is one signed rendition of a MediaImage
+type MediaImageVariant struct {
+	URL         string `json:"url"`
+	Width       int    `json:"width"`
+	Height      int    `json:"height"`
+	ContentType string `json:"content_type"`
+}
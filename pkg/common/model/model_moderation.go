@@ -0,0 +1,142 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReportTargetType mirrors the report_target_type enum
+type ReportTargetType string
+
+const (
+	ReportTargetNovel   ReportTargetType = "NOVEL"   // Tiểu thuyết
+	ReportTargetChapter ReportTargetType = "CHAPTER" // Chương tiểu thuyết
+	ReportTargetComment ReportTargetType = "COMMENT" // Bình luận
+	ReportTargetReview  ReportTargetType = "REVIEW"  // Đánh giá
+	ReportTargetUser    ReportTargetType = "USER"    // Người dùng
+)
+
+// IsValid reports whether the target type is a known enum value
+func (t ReportTargetType) IsValid() bool {
+	switch t {
+	case ReportTargetNovel, ReportTargetChapter, ReportTargetComment, ReportTargetReview, ReportTargetUser:
+		return true
+	}
+	return false
+}
+
+// ReportReason mirrors the report_reason enum
+type ReportReason string
+
+const (
+	ReportReasonSpam          ReportReason = "SPAM"
+	ReportReasonHarassment    ReportReason = "HARASSMENT"
+	ReportReasonHateSpeech    ReportReason = "HATE_SPEECH"
+	ReportReasonSexualContent ReportReason = "SEXUAL_CONTENT"
+	ReportReasonViolence      ReportReason = "VIOLENCE"
+	ReportReasonCopyright     ReportReason = "COPYRIGHT"
+	ReportReasonSpoiler       ReportReason = "SPOILER"
+	ReportReasonImpersonation ReportReason = "IMPERSONATION"
+	ReportReasonOther         ReportReason = "OTHER"
+)
+
+// IsValid reports whether the reason is a known enum value
+func (r ReportReason) IsValid() bool {
+	switch r {
+	case ReportReasonSpam, ReportReasonHarassment, ReportReasonHateSpeech, ReportReasonSexualContent,
+		ReportReasonViolence, ReportReasonCopyright, ReportReasonSpoiler, ReportReasonImpersonation, ReportReasonOther:
+		return true
+	}
+	return false
+}
+
+// ModerationCaseStatus mirrors the moderation_case_status enum
+type ModerationCaseStatus string
+
+const (
+	ModerationCaseOpen      ModerationCaseStatus = "OPEN"      // Chờ xử lý
+	ModerationCaseInReview  ModerationCaseStatus = "IN_REVIEW" // Đã có moderator nhận
+	ModerationCaseResolved  ModerationCaseStatus = "RESOLVED"  // Đã xử lý
+	ModerationCaseDismissed ModerationCaseStatus = "DISMISSED" // Không vi phạm
+)
+
+// IsValid reports whether the status is a known enum value
+func (s ModerationCaseStatus) IsValid() bool {
+	switch s {
+	case ModerationCaseOpen, ModerationCaseInReview, ModerationCaseResolved, ModerationCaseDismissed:
+		return true
+	}
+	return false
+}
+
+// ModerationAction mirrors the moderation_action enum
+type ModerationAction string
+
+const (
+	ModerationActionHideContent      ModerationAction = "HIDE_CONTENT"      // Ẩn novel/chapter
+	ModerationActionUnpublishChapter ModerationAction = "UNPUBLISH_CHAPTER" // Gỡ xuất bản chapter
+	ModerationActionWarnUser         ModerationAction = "WARN_USER"         // Cảnh cáo người dùng
+	ModerationActionSuspendUser      ModerationAction = "SUSPEND_USER"      // Đình chỉ tài khoản
+	ModerationActionDismiss          ModerationAction = "DISMISS"           // Không vi phạm
+)
+
+// IsValid reports whether the action is a known enum value
+func (a ModerationAction) IsValid() bool {
+	switch a {
+	case ModerationActionHideContent, ModerationActionUnpublishChapter, ModerationActionWarnUser,
+		ModerationActionSuspendUser, ModerationActionDismiss:
+		return true
+	}
+	return false
+}
+
+// ModerationCase aggregates every open report against the same target
+type ModerationCase struct {
+	ID               uuid.UUID            `json:"id" db:"id"`
+	TargetType       ReportTargetType     `json:"target_type" db:"target_type"`
+	TargetID         uuid.UUID            `json:"target_id" db:"target_id"`
+	Status           ModerationCaseStatus `json:"status" db:"status"`
+	ReportCount      int                  `json:"report_count" db:"report_count"`           // Số báo cáo đã gom
+	FirstReportedAt  time.Time            `json:"first_reported_at" db:"first_reported_at"` // Báo cáo đầu tiên
+	LastReportedAt   time.Time            `json:"last_reported_at" db:"last_reported_at"`   // Báo cáo gần nhất
+	AssignedTo       *uuid.UUID           `json:"assigned_to,omitempty" db:"assigned_to"`   // Moderator đang xử lý
+	AssignedAt       *time.Time           `json:"assigned_at,omitempty" db:"assigned_at"`
+	ResolutionAction *ModerationAction    `json:"resolution_action,omitempty" db:"resolution_action"`
+	ResolutionNote   *string              `json:"resolution_note,omitempty" db:"resolution_note"`
+	ResolvedBy       *uuid.UUID           `json:"resolved_by,omitempty" db:"resolved_by"`
+	ResolvedAt       *time.Time           `json:"resolved_at,omitempty" db:"resolved_at"`
+	CreatedAt        time.Time            `json:"created_at" db:"created_at"`
+	UpdatedAt        time.Time            `json:"updated_at" db:"updated_at"`
+}
+
+// ContentReport is a single user report attached to a moderation case
+type ContentReport struct {
+	ID         uuid.UUID    `json:"id" db:"id"`
+	CaseID     uuid.UUID    `json:"case_id" db:"case_id"`
+	ReporterID uuid.UUID    `json:"reporter_id" db:"reporter_id"`
+	Reason     ReportReason `json:"reason" db:"reason"`
+	Details    *string      `json:"details,omitempty" db:"details"` // Mô tả tự do
+	CreatedAt  time.Time    `json:"created_at" db:"created_at"`
+}
+
+// ModerationAuditLog records one moderation event on a case
+type ModerationAuditLog struct {
+	ID        uuid.UUID        `json:"id" db:"id"`
+	CaseID    uuid.UUID        `json:"case_id" db:"case_id"`
+	ActorID   uuid.UUID        `json:"actor_id" db:"actor_id"`
+	Event     string           `json:"event" db:"event"`               // REPORTED, CLAIMED, ASSIGNED, RESOLVED, DISMISSED
+	Details   *json.RawMessage `json:"details,omitempty" db:"details"` // Dữ liệu bổ sung
+	CreatedAt time.Time        `json:"created_at" db:"created_at"`
+}
+
+// UserWarning is a warning sent by a moderator to a user
+type UserWarning struct {
+	ID          uuid.UUID  `json:"id" db:"id"`
+	UserID      uuid.UUID  `json:"user_id" db:"user_id"`
+	CaseID      *uuid.UUID `json:"case_id,omitempty" db:"case_id"`
+	ModeratorID uuid.UUID  `json:"moderator_id" db:"moderator_id"`
+	Message     string     `json:"message" db:"message"`
+	CreatedAt   time.Time  `json:"created_at" db:"created_at"`
+}
@@ -157,3 +157,29 @@ type NovelChapter struct {
 	CreatedAt time.Time `json:"created_at" db:"created_at"` // Thời gian tạo record
 	UpdatedAt time.Time `json:"updated_at" db:"updated_at"` // Thời gian cập nhật cuối
 }
+
+// NovelTranslation represents a localized title and summary of a novel
+type NovelTranslation struct {
+	ID           uuid.UUID        `json:"id" db:"id"`
+	NovelID      uuid.UUID        `json:"novel_id" db:"novel_id"`
+	LanguageCode string           `json:"language_code" db:"language_code"`
+	Title        string           `json:"title" db:"title"`
+	Summary      *json.RawMessage `json:"summary,omitempty" db:"summary"` // Tóm tắt đã dịch (Plate editor)
+	IsPrimary    bool             `json:"is_primary" db:"is_primary"`     // Tiêu đề chính của ngôn ngữ này
+	CreatedAt    time.Time        `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time        `json:"updated_at" db:"updated_at"`
+}
+
+// NovelChapterTranslation represents a translated chapter body in one language
+type NovelChapterTranslation struct {
+	ChapterID          uuid.UUID        `json:"chapter_id" db:"chapter_id"`
+	LanguageCode       string           `json:"language_code" db:"language_code"`
+	Title              *string          `json:"title,omitempty" db:"title"`
+	Content            *json.RawMessage `json:"content,omitempty" db:"content"` // Nội dung đã dịch (JSONB rich text)
+	WordCount          *int             `json:"word_count,omitempty" db:"word_count"`
+	CharacterCount     *int             `json:"character_count,omitempty" db:"character_count"`
+	ReadingTimeMinutes *int             `json:"reading_time_minutes,omitempty" db:"reading_time_minutes"`
+	TranslatedByUserID *uuid.UUID       `json:"translated_by_user_id,omitempty" db:"translated_by_user_id"`
+	CreatedAt          time.Time        `json:"created_at" db:"created_at"`
+	UpdatedAt          time.Time        `json:"updated_at" db:"updated_at"`
+}
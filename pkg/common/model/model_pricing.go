@@ -0,0 +1,54 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Discount types
+const (
+	DiscountTypePercentage = "PERCENTAGE"
+	DiscountTypeFixed      = "FIXED"
+)
+
+// Discount campaign scopes
+const (
+	DiscountScopeNovel  = "NOVEL"
+	DiscountScopeTenant = "TENANT"
+	DiscountScopeGenre  = "GENRE"
+)
+
+// DiscountCampaign is a time-limited discount on novel chapter, volume and series purchases
+type DiscountCampaign struct {
+	ID              uuid.UUID  `json:"id" db:"id"`
+	Name            string     `json:"name" db:"name"`
+	Description     *string    `json:"description,omitempty" db:"description"`
+	DiscountType    string     `json:"discount_type" db:"discount_type"`   // PERCENTAGE | FIXED
+	DiscountValue   int        `json:"discount_value" db:"discount_value"` // Phần trăm hoặc số coins giảm
+	ScopeType       string     `json:"scope_type" db:"scope_type"`         // NOVEL | TENANT | GENRE
+	ScopeID         uuid.UUID  `json:"scope_id" db:"scope_id"`
+	StartsAt        time.Time  `json:"starts_at" db:"starts_at"`
+	EndsAt          time.Time  `json:"ends_at" db:"ends_at"`
+	IsActive        bool       `json:"is_active" db:"is_active"`
+	CreatedByUserID uuid.UUID  `json:"created_by_user_id" db:"created_by_user_id"`
+	TenantID        *uuid.UUID `json:"tenant_id,omitempty" db:"tenant_id"`
+	CreatedAt       time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at" db:"updated_at"`
+}
+
+// NovelBundle sells a set of volumes of one novel at a fixed price
+type NovelBundle struct {
+	ID              uuid.UUID   `json:"id" db:"id"`
+	NovelID         uuid.UUID   `json:"novel_id" db:"novel_id"`
+	Name            string      `json:"name" db:"name"`
+	Description     *string     `json:"description,omitempty" db:"description"`
+	PriceCoins      int         `json:"price_coins" db:"price_coins"`
+	StartsAt        *time.Time  `json:"starts_at,omitempty" db:"starts_at"` // NULL: bán ngay
+	EndsAt          *time.Time  `json:"ends_at,omitempty" db:"ends_at"`     // NULL: không giới hạn
+	IsActive        bool        `json:"is_active" db:"is_active"`
+	CreatedByUserID uuid.UUID   `json:"created_by_user_id" db:"created_by_user_id"`
+	CreatedAt       time.Time   `json:"created_at" db:"created_at"`
+	UpdatedAt       time.Time   `json:"updated_at" db:"updated_at"`
+	VolumeIDs       []uuid.UUID `json:"volume_ids" db:"-"` // Tập trong gói (chưa xóa), theo số tập
+}
@@ -0,0 +1,67 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NovelRankingType mirrors the novel_ranking_type enum
+type NovelRankingType string
+
+const (
+	RankingTrendingDay    NovelRankingType = "TRENDING_DAY"    // Trending hôm nay
+	RankingTrendingWeek   NovelRankingType = "TRENDING_WEEK"   // Trending tuần này
+	RankingTrendingMonth  NovelRankingType = "TRENDING_MONTH"  // Trending tháng này
+	RankingMostBookmarked NovelRankingType = "MOST_BOOKMARKED" // Nhiều bookmark nhất
+	RankingRising         NovelRankingType = "RISING"          // Đang lên
+)
+
+// AllNovelRankingTypes lists every ranking the scheduled job maintains
+var AllNovelRankingTypes = []NovelRankingType{
+	RankingTrendingDay,
+	RankingTrendingWeek,
+	RankingTrendingMonth,
+	RankingMostBookmarked,
+	RankingRising,
+}
+
+// IsValid reports whether the ranking type is a known enum value
+func (t NovelRankingType) IsValid() bool {
+	for _, known := range AllNovelRankingTypes {
+		if t == known {
+			return true
+		}
+	}
+	return false
+}
+
+// NovelDailyStats represents one day of engagement aggregates for a novel
+type NovelDailyStats struct {
+	NovelID       uuid.UUID `json:"novel_id" db:"novel_id"`             // FK to novel
+	StatDate      time.Time `json:"stat_date" db:"stat_date"`           // Ngày thống kê
+	ViewCount     int64     `json:"view_count" db:"view_count"`         // Lượt xem trong ngày
+	BookmarkCount int64     `json:"bookmark_count" db:"bookmark_count"` // Lượt bookmark trong ngày
+	UniqueReaders int64     `json:"unique_readers" db:"unique_readers"` // Người đọc duy nhất (rollup)
+	PurchaseCount int64     `json:"purchase_count" db:"purchase_count"` // Lượt mua (rollup)
+	RentalCount   int64     `json:"rental_count" db:"rental_count"`     // Lượt thuê (rollup)
+	RevenueCoins  int64     `json:"revenue_coins" db:"revenue_coins"`   // Doanh thu coins (rollup)
+	UpdatedAt     time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// NovelRankingSnapshot represents a pre-computed ranking entry
+type NovelRankingSnapshot struct {
+	ID          uuid.UUID        `json:"id" db:"id"`
+	RankingType NovelRankingType `json:"ranking_type" db:"ranking_type"` // Loại bảng xếp hạng
+	NovelID     uuid.UUID        `json:"novel_id" db:"novel_id"`         // FK to novel
+	Rank        int              `json:"rank" db:"rank"`                 // Thứ hạng (bắt đầu từ 1)
+	Score       float64          `json:"score" db:"score"`               // Điểm xếp hạng
+	ComputedAt  time.Time        `json:"computed_at" db:"computed_at"`   // Thời điểm tính toán
+}
+
+// EngagementWeights controls how much each engagement signal contributes to a score.
+// Likes and ratings have no daily stats until a like/rating feature exists, so they are not weighted.
+type EngagementWeights struct {
+	View     float64 `json:"view"`
+	Bookmark float64 `json:"bookmark"`
+}
@@ -0,0 +1,39 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NovelBookmark represents a reader bookmarking a novel
+type NovelBookmark struct {
+	UserID    uuid.UUID `json:"user_id" db:"user_id"`   // Reader ID từ identify service
+	NovelID   uuid.UUID `json:"novel_id" db:"novel_id"` // FK to novel
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
+
+// NovelSimilarity represents a pre-computed neighbour of a novel
+type NovelSimilarity struct {
+	NovelID          uuid.UUID   `json:"novel_id" db:"novel_id"`                 // Novel gốc
+	SimilarNovelID   uuid.UUID   `json:"similar_novel_id" db:"similar_novel_id"` // Novel tương tự
+	Rank             int         `json:"rank" db:"rank"`                         // Thứ hạng trong danh sách neighbour
+	Score            float64     `json:"score" db:"score"`                       // Điểm tổng hợp
+	GenreScore       float64     `json:"genre_score" db:"genre_score"`           // Jaccard trên thể loại
+	TagScore         float64     `json:"tag_score" db:"tag_score"`               // Jaccard trên tags
+	CreatorScore     float64     `json:"creator_score" db:"creator_score"`       // Creator chung
+	CoBookmarkScore  float64     `json:"cobookmark_score" db:"cobookmark_score"` // Cosine trên bookmark
+	SharedGenreIDs   []uuid.UUID `json:"shared_genre_ids" db:"shared_genre_ids"`
+	SharedTags       []string    `json:"shared_tags" db:"shared_tags"`
+	SharedCreatorIDs []uuid.UUID `json:"shared_creator_ids" db:"shared_creator_ids"`
+	CoBookmarkCount  int64       `json:"cobookmark_count" db:"cobookmark_count"` // Số người bookmark cả hai
+	ComputedAt       time.Time   `json:"computed_at" db:"computed_at"`
+}
+
+// SimilarityWeights controls how similarity components are blended into a score
+type SimilarityWeights struct {
+	Genre      float64 `json:"genre"`
+	Tag        float64 `json:"tag"`
+	Creator    float64 `json:"creator"`
+	CoBookmark float64 `json:"cobookmark"`
+}
@@ -0,0 +1,27 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NovelReleasePolicy holds a novel's wait-until-free and early access settings; nil disables a setting
+type NovelReleasePolicy struct {
+	NovelID             uuid.UUID  `json:"novel_id" db:"novel_id"`
+	FreeAfterDays       *int       `json:"free_after_days,omitempty" db:"free_after_days"`             // Chapter trả phí miễn phí sau N ngày
+	UnlockIntervalHours *int       `json:"unlock_interval_hours,omitempty" db:"unlock_interval_hours"` // Mở khóa miễn phí 1 chapter mỗi X giờ
+	EarlyAccessHours    *int       `json:"early_access_hours,omitempty" db:"early_access_hours"`       // VIP đọc trước X giờ
+	UpdatedByUserID     *uuid.UUID `json:"updated_by_user_id,omitempty" db:"updated_by_user_id"`
+	CreatedAt           time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt           time.Time  `json:"updated_at" db:"updated_at"`
+}
+
+// Chapter access states seen by a reader
+const (
+	ChapterAccessFree        = "FREE"         // Không có giá, hoặc đã miễn phí sau thời gian chờ
+	ChapterAccessOwned       = "OWNED"        // Đã mua, đang thuê hoặc đã mở khóa miễn phí
+	ChapterAccessEarlyAccess = "EARLY_ACCESS" // VIP đọc trước thời điểm phát hành
+	ChapterAccessLocked      = "LOCKED"       // Đã phát hành, cần mua hoặc mở khóa
+	ChapterAccessUpcoming    = "UPCOMING"     // Chưa phát hành với độc giả này
+)
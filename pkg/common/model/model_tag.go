@@ -0,0 +1,29 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Tag is a canonical content tag; novel.tags stores its Name
+type Tag struct {
+	ID             uuid.UUID  `json:"id" db:"id"`
+	Name           string     `json:"name" db:"name"`
+	NormalizedName string     `json:"-" db:"normalized_name"`                     // Khóa so khớp: viết thường, gộp khoảng trắng
+	LanguageCode   *string    `json:"language_code,omitempty" db:"language_code"` // Ngôn ngữ của tên chuẩn
+	Description    *string    `json:"description,omitempty" db:"description"`
+	UsageCount     int        `json:"usage_count" db:"usage_count"` // Số novel đang dùng tag
+	CreatedAt      time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at" db:"updated_at"`
+	Aliases        []TagAlias `json:"aliases,omitempty" db:"-"` // Chỉ có trong chi tiết tag
+}
+
+// TagAlias is an alternative spelling of a tag, or a synonym when it has a language
+type TagAlias struct {
+	TagID           uuid.UUID `json:"-" db:"tag_id"`
+	Alias           string    `json:"alias" db:"alias"`
+	NormalizedAlias string    `json:"-" db:"normalized_alias"`
+	LanguageCode    *string   `json:"language_code,omitempty" db:"language_code"` // NULL: biến thể chính tả
+	CreatedAt       time.Time `json:"created_at" db:"created_at"`
+}
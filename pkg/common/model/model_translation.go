@@ -0,0 +1,60 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Translation contribution workflow values
+const (
+	TranslationContributionPending  = "pending"
+	TranslationContributionApproved = "approved"
+	TranslationContributionRejected = "rejected"
+)
+
+// Translation contribution reference types
+const (
+	TranslationReferenceNovel        = "novel"
+	TranslationReferenceNovelChapter = "novel_chapter"
+)
+
+// TranslationContribution is a community or machine-made translation of a novel or chapter,
+// reviewed by moderators before it is published
+type TranslationContribution struct {
+	ID                     uuid.UUID       `json:"id" db:"id"`
+	ReferenceType          string          `json:"reference_type" db:"reference_type"` // novel | novel_chapter
+	ReferenceID            uuid.UUID       `json:"reference_id" db:"reference_id"`
+	Title                  string          `json:"title" db:"title"`
+	Content                json.RawMessage `json:"content,omitempty" db:"content"` // Nội dung đã dịch (Plate editor)
+	SourceLanguage         string          `json:"source_language" db:"source_language"`
+	TargetLanguage         string          `json:"target_language" db:"target_language"`
+	IsMachineTranslation   bool            `json:"is_machine_translation" db:"is_machine_translation"`
+	MachineProvider        *string         `json:"machine_provider,omitempty" db:"machine_provider"` // Provider đã sinh bản nháp
+	UserID                 uuid.UUID       `json:"user_id" db:"user_id"`
+	TenantID               *uuid.UUID      `json:"tenant_id,omitempty" db:"tenant_id"`
+	Status                 string          `json:"status" db:"status"` // pending | approved | rejected
+	RejectionReason        *string         `json:"rejection_reason,omitempty" db:"rejection_reason"`
+	ReviewerID             *uuid.UUID      `json:"reviewer_id,omitempty" db:"reviewer_id"`
+	ReviewedAt             *time.Time      `json:"reviewed_at,omitempty" db:"reviewed_at"`
+	Upvotes                int             `json:"upvotes" db:"upvotes"`
+	Downvotes              int             `json:"downvotes" db:"downvotes"`
+	GlossaryViolationCount int             `json:"glossary_violation_count" db:"glossary_violation_count"` // Số lỗi thuật ngữ lúc gửi/cập nhật gần nhất
+	CreatedAt              time.Time       `json:"created_at" db:"created_at"`
+	UpdatedAt              time.Time       `json:"updated_at" db:"updated_at"`
+}
+
+// NovelGlossaryTerm fixes the translation of a novel term in one target language
+type NovelGlossaryTerm struct {
+	ID              uuid.UUID  `json:"id" db:"id"`
+	NovelID         uuid.UUID  `json:"novel_id" db:"novel_id"`
+	LanguageCode    string     `json:"language_code" db:"language_code"`
+	SourceTerm      string     `json:"source_term" db:"source_term"`
+	Translation     string     `json:"translation" db:"translation"`
+	Notes           *string    `json:"notes,omitempty" db:"notes"`               // Ghi chú cho người dịch
+	CharacterID     *uuid.UUID `json:"character_id,omitempty" db:"character_id"` // Nhân vật mà thuật ngữ chỉ tới
+	CreatedByUserID *uuid.UUID `json:"created_by_user_id,omitempty" db:"created_by_user_id"`
+	CreatedAt       time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at" db:"updated_at"`
+}
@@ -20,4 +20,19 @@ type User struct {
 	CreatedAt     time.Time        `json:"created_at" db:"created_at"`
 	UpdatedAt     time.Time        `json:"updated_at" db:"updated_at"`
 	LastLoginAt   *time.Time       `json:"last_login_at,omitempty" db:"last_login_at"`
+	Birthdate     *time.Time       `json:"birthdate,omitempty" db:"birthdate"` // Ngày sinh tự khai báo, dùng để xác minh tuổi
+}
+
+// AgeOn returns the user's age in whole years on the given day; ok is false without a birthdate
+func (u *User) AgeOn(day time.Time) (age int, ok bool) {
+	if u.Birthdate == nil {
+		return 0, false
+	}
+	birth := u.Birthdate.UTC()
+	day = day.UTC()
+	age = day.Year() - birth.Year()
+	if day.Month() < birth.Month() || (day.Month() == birth.Month() && day.Day() < birth.Day()) {
+		age--
+	}
+	return age, true
 }
@@ -0,0 +1,243 @@
+package mt
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
+// Glossary violation kinds reported by CheckGlossary
+const (
+	// ViolationMissingTranslation: a source term whose approved translation is absent from the translation
+	ViolationMissingTranslation = "missing_translation"
+	// ViolationUntranslatedTerm: a source term left as is in the translation
+	ViolationUntranslatedTerm = "untranslated_term"
+)
+
+// Fields checked by CheckGlossary
+const (
+	FieldTitle   = "title"
+	FieldContent = "content"
+)
+
+// Text is one side of a translation: a title and a Plate document
+type Text struct {
+	Title   string
+	Content json.RawMessage
+}
+
+// Position locates a span of text. Block is the index of the block (an element holding
+// text, in document order; always 0 for the title), Offset and Length count runes in the
+// block's plain text, inline code excluded.
+type Position struct {
+	Field  string
+	Block  int
+	Offset int
+	Length int
+}
+
+// Violation is a glossary term the translation does not render with its approved translation
+type Violation struct {
+	Kind     string
+	Term     int // Index of the term in the glossary
+	Source   string
+	Expected string
+	// SourcePosition is the occurrence of the term in the source; nil for untranslated terms
+	SourcePosition *Position
+	// Position is the untranslated term, or the block expected to hold the approved translation
+	// when both documents have the same blocks; nil when the blocks cannot be aligned
+	Position *Position
+}
+
+// CheckGlossary compares a translation with its source and reports, field by field:
+//   - source terms left untranslated in the translation;
+//   - source terms whose approved translation appears fewer times than the term itself.
+//
+// When both documents have the same number of blocks the counts are compared block by
+// block, so a violation points at the paragraph to fix; otherwise they are compared over
+// the whole field. The title is only checked when the translation has one.
+func CheckGlossary(source, translation Text, glossary []GlossaryTerm) ([]Violation, error) {
+	sourceBlocks, err := blockTexts(source.Content)
+	if err != nil {
+		return nil, err
+	}
+	translatedBlocks, err := blockTexts(translation.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	checker := newConsistencyChecker(glossary)
+	var violations []Violation
+	if strings.TrimSpace(translation.Title) != "" {
+		violations = checker.checkField(FieldTitle, titleBlocks(source.Title), titleBlocks(translation.Title))
+	}
+	violations = append(violations, checker.checkField(FieldContent, sourceBlocks, translatedBlocks)...)
+	return violations, nil
+}
+
+// titleBlocks treats a non-empty title as a single block
+func titleBlocks(title string) []string {
+	if strings.TrimSpace(title) == "" {
+		return nil
+	}
+	return []string{title}
+}
+
+// consistencyChecker finds source terms in the source, and approved translations and
+// leftover source terms in the translation
+type consistencyChecker struct {
+	glossary []GlossaryTerm
+	source   *glossaryMatcher
+	// target matches approved translations as term i and untranslated source terms as term len(glossary)+i
+	target *glossaryMatcher
+}
+
+// newConsistencyChecker ignores terms without an approved translation
+func newConsistencyChecker(glossary []GlossaryTerm) *consistencyChecker {
+	sources := make([]GlossaryTerm, len(glossary))
+	targets := make([]GlossaryTerm, 2*len(glossary))
+	for i, term := range glossary {
+		translation := strings.TrimSpace(term.Translation)
+		if translation == "" {
+			continue
+		}
+		sources[i] = GlossaryTerm{Source: term.Source}
+		targets[i] = GlossaryTerm{Source: translation}
+		// A term kept as is on purpose (a brand, a code name) cannot be left untranslated
+		if !strings.EqualFold(strings.TrimSpace(term.Source), translation) {
+			targets[len(glossary)+i] = GlossaryTerm{Source: term.Source}
+		}
+	}
+
+	return &consistencyChecker{
+		glossary: glossary,
+		source:   newGlossaryMatcher(sources),
+		target:   newGlossaryMatcher(targets),
+	}
+}
+
+// occurrence is a term found in one block of a field
+type occurrence struct {
+	block int
+	match termMatch
+}
+
+// checkField compares one field; blocks are aligned when both sides have as many
+func (c *consistencyChecker) checkField(field string, source, translation []string) []Violation {
+	aligned := len(source) == len(translation)
+	group := func(block int) int {
+		if aligned {
+			return block
+		}
+		return 0
+	}
+	groups := 1
+	if aligned {
+		groups = len(source)
+	}
+
+	terms := len(c.glossary)
+	sourceOccurrences := make([][][]occurrence, groups)
+	untranslated := make([][][]occurrence, groups)
+	found := make([][]int, groups)
+	for g := 0; g < groups; g++ {
+		sourceOccurrences[g] = make([][]occurrence, terms)
+		untranslated[g] = make([][]occurrence, terms)
+		found[g] = make([]int, terms)
+	}
+
+	for block, text := range source {
+		for _, match := range c.source.scan([]rune(text)) {
+			g := group(block)
+			sourceOccurrences[g][match.index] = append(sourceOccurrences[g][match.index], occurrence{block: block, match: match})
+		}
+	}
+	for block, text := range translation {
+		for _, match := range c.target.scan([]rune(text)) {
+			g := group(block)
+			if match.index < terms {
+				found[g][match.index]++
+				continue
+			}
+			term := match.index - terms
+			untranslated[g][term] = append(untranslated[g][term], occurrence{block: block, match: match})
+		}
+	}
+
+	var violations []Violation
+	for g := 0; g < groups; g++ {
+		for term := 0; term < terms; term++ {
+			for _, leftover := range untranslated[g][term] {
+				violations = append(violations, c.violation(ViolationUntranslatedTerm, term, nil, &Position{
+					Field:  field,
+					Block:  leftover.block,
+					Offset: leftover.match.offset,
+					Length: leftover.match.length,
+				}))
+			}
+
+			// Leftover source terms already explain part of the shortfall
+			occurrences := sourceOccurrences[g][term]
+			missing := len(occurrences) - found[g][term] - len(untranslated[g][term])
+			for i := len(occurrences) - max(missing, 0); i < len(occurrences); i++ {
+				occurrence := occurrences[i]
+				sourcePosition := &Position{
+					Field:  field,
+					Block:  occurrence.block,
+					Offset: occurrence.match.offset,
+					Length: occurrence.match.length,
+				}
+				var position *Position
+				if aligned {
+					position = &Position{
+						Field:  field,
+						Block:  occurrence.block,
+						Length: len([]rune(translation[occurrence.block])),
+					}
+				}
+				violations = append(violations, c.violation(ViolationMissingTranslation, term, sourcePosition, position))
+			}
+		}
+	}
+
+	sort.SliceStable(violations, func(a, b int) bool {
+		return sortKey(violations[a]).less(sortKey(violations[b]))
+	})
+	return violations
+}
+
+// violation builds a violation of a glossary term
+func (c *consistencyChecker) violation(kind string, term int, sourcePosition, position *Position) Violation {
+	return Violation{
+		Kind:           kind,
+		Term:           term,
+		Source:         c.glossary[term].Source,
+		Expected:       c.glossary[term].Translation,
+		SourcePosition: sourcePosition,
+		Position:       position,
+	}
+}
+
+// violationKey orders violations by block, then offset
+type violationKey struct {
+	block, offset int
+}
+
+// sortKey prefers the position in the translation, then the source position
+func sortKey(v Violation) violationKey {
+	if v.Position != nil && v.Kind == ViolationUntranslatedTerm {
+		return violationKey{v.Position.Block, v.Position.Offset}
+	}
+	if v.SourcePosition != nil {
+		return violationKey{v.SourcePosition.Block, v.SourcePosition.Offset}
+	}
+	return violationKey{}
+}
+
+// less compares two keys
+func (k violationKey) less(other violationKey) bool {
+	if k.block != other.block {
+		return k.block < other.block
+	}
+	return k.offset < other.offset
+}
@@ -0,0 +1,141 @@
+package mt
+
+import (
+	"html"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// GlossaryTerm fixes the translation of a source term, e.g. a character or place name
+type GlossaryTerm struct {
+	Source      string
+	Translation string
+}
+
+// glossaryMatcher replaces glossary terms with <gN/> placeholders before translation
+type glossaryMatcher struct {
+	terms []preparedTerm // longest first
+	hits  []int          // per GlossaryTerm index
+}
+
+// preparedTerm is a lower-cased source term with its index in the glossary
+type preparedTerm struct {
+	index int
+	runes []rune
+}
+
+// newGlossaryMatcher prepares the terms; empty terms are ignored
+func newGlossaryMatcher(glossary []GlossaryTerm) *glossaryMatcher {
+	matcher := &glossaryMatcher{hits: make([]int, len(glossary))}
+	for i, term := range glossary {
+		source := strings.TrimSpace(term.Source)
+		if source == "" {
+			continue
+		}
+		runes := []rune(source)
+		for j, r := range runes {
+			runes[j] = unicode.ToLower(r)
+		}
+		matcher.terms = append(matcher.terms, preparedTerm{index: i, runes: runes})
+	}
+	sort.SliceStable(matcher.terms, func(a, b int) bool {
+		return len(matcher.terms[a].runes) > len(matcher.terms[b].runes)
+	})
+	return matcher
+}
+
+// termMatch is a glossary term found in a text; offset and length count runes
+type termMatch struct {
+	index  int // GlossaryTerm index
+	offset int
+	length int
+}
+
+// scan finds the glossary terms in runes from left to right, preferring the longest
+// term at each position. Matching is case-insensitive and does not split words in
+// space-delimited scripts.
+func (g *glossaryMatcher) scan(runes []rune) []termMatch {
+	var matches []termMatch
+	for i := 0; i < len(runes); {
+		matched := false
+		for _, term := range g.terms {
+			if !g.matchesAt(runes, i, term.runes) {
+				continue
+			}
+			matches = append(matches, termMatch{index: term.index, offset: i, length: len(term.runes)})
+			i += len(term.runes)
+			matched = true
+			break
+		}
+		if !matched {
+			i++
+		}
+	}
+	return matches
+}
+
+// protect HTML-escapes text and replaces every glossary match with its placeholder
+func (g *glossaryMatcher) protect(text string) string {
+	runes := []rune(text)
+	var out strings.Builder
+	plainStart := 0
+
+	for _, match := range g.scan(runes) {
+		out.WriteString(html.EscapeString(string(runes[plainStart:match.offset])))
+		out.WriteString(placeholder(match.index))
+		g.hits[match.index]++
+		plainStart = match.offset + match.length
+	}
+	out.WriteString(html.EscapeString(string(runes[plainStart:])))
+
+	return out.String()
+}
+
+// matchesAt reports whether term occurs at position i on word boundaries
+func (g *glossaryMatcher) matchesAt(runes []rune, i int, term []rune) bool {
+	if i+len(term) > len(runes) {
+		return false
+	}
+	for j, r := range term {
+		if unicode.ToLower(runes[i+j]) != r {
+			return false
+		}
+	}
+	if i > 0 && needsWordBoundary(term[0]) && isWordRune(runes[i-1]) {
+		return false
+	}
+	end := i + len(term)
+	if end < len(runes) && needsWordBoundary(term[len(term)-1]) && isWordRune(runes[end]) {
+		return false
+	}
+	return true
+}
+
+// totalHits counts the replaced terms
+func (g *glossaryMatcher) totalHits() int {
+	total := 0
+	for _, hits := range g.hits {
+		total += hits
+	}
+	return total
+}
+
+// placeholder is the self-closing tag standing for glossary term index
+func placeholder(index int) string {
+	return "<g" + strconv.Itoa(index) + "/>"
+}
+
+// isWordRune reports letters and digits
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// needsWordBoundary is false for scripts written without spaces, where terms may sit inside a run of text
+func needsWordBoundary(r rune) bool {
+	if !isWordRune(r) {
+		return false
+	}
+	return !unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul, unicode.Thai)
+}
@@ -0,0 +1,115 @@
+package mt
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// libreTranslateBatchSize caps the segments sent in one request
+const libreTranslateBatchSize = 50
+
+// LibreTranslateTranslator calls a LibreTranslate server (self-hosted or libretranslate.com)
+// in HTML mode, which keeps the formatting tags and glossary placeholders untouched
+type LibreTranslateTranslator struct {
+	endpoint string
+	apiKey   string
+	client   *http.Client
+}
+
+// NewLibreTranslateTranslator validates the configuration; it does not contact the server
+func NewLibreTranslateTranslator(cfg Config) (*LibreTranslateTranslator, error) {
+	base, err := url.Parse(strings.TrimRight(cfg.BaseURL, "/"))
+	if err != nil || base.Host == "" || (base.Scheme != "http" && base.Scheme != "https") {
+		return nil, fmt.Errorf("libretranslate: invalid base URL %q", cfg.BaseURL)
+	}
+
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = time.Minute
+	}
+
+	return &LibreTranslateTranslator{
+		endpoint: base.String() + "/translate",
+		apiKey:   cfg.APIKey,
+		client:   &http.Client{Timeout: timeout},
+	}, nil
+}
+
+// Name returns "libretranslate"
+func (t *LibreTranslateTranslator) Name() string {
+	return ProviderLibreTranslate
+}
+
+// Translate sends the segments in batches and returns them in order
+func (t *LibreTranslateTranslator) Translate(ctx context.Context, segments []string, source, target string) ([]string, error) {
+	translated := make([]string, 0, len(segments))
+	for start := 0; start < len(segments); start += libreTranslateBatchSize {
+		end := min(start+libreTranslateBatchSize, len(segments))
+		batch, err := t.translateBatch(ctx, segments[start:end], source, target)
+		if err != nil {
+			return nil, err
+		}
+		translated = append(translated, batch...)
+	}
+	return translated, nil
+}
+
+// translateBatch sends one request with q as an array
+func (t *LibreTranslateTranslator) translateBatch(ctx context.Context, segments []string, source, target string) ([]string, error) {
+	if source == "" {
+		source = "auto"
+	}
+	payload, err := json.Marshal(map[string]interface{}{
+		"q":       segments,
+		"source":  source,
+		"target":  target,
+		"format":  "html",
+		"api_key": t.apiKey,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("libretranslate: failed to encode request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("libretranslate: failed to build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("libretranslate request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 32<<20))
+	if err != nil {
+		return nil, fmt.Errorf("libretranslate: failed to read response: %w", err)
+	}
+
+	var result struct {
+		TranslatedText []string `json:"translatedText"`
+		Error          string   `json:"error"`
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		detail := strings.TrimSpace(string(body))
+		if json.Unmarshal(body, &result) == nil && result.Error != "" {
+			detail = result.Error
+		}
+		return nil, fmt.Errorf("libretranslate request failed: %s: %s", resp.Status, detail)
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("libretranslate: invalid response: %w", err)
+	}
+	if len(result.TranslatedText) != len(segments) {
+		return nil, fmt.Errorf("libretranslate: expected %d translations, got %d", len(segments), len(result.TranslatedText))
+	}
+	return result.TranslatedText, nil
+}
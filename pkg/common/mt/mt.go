@@ -0,0 +1,60 @@
+// Package mt produces machine-translated drafts of rich-text content behind one
+// provider interface, with a deterministic stub and a LibreTranslate backend, and
+// checks translations against a glossary of fixed terms
+package mt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Provider names accepted by Config.Provider
+const (
+	ProviderNone           = "none"
+	ProviderStub           = "stub"
+	ProviderLibreTranslate = "libretranslate"
+)
+
+// ErrNotConfigured is returned when no machine-translation provider is enabled
+var ErrNotConfigured = errors.New("machine translation is not configured")
+
+// Translator translates batches of marked-up segments.
+//
+// Segments contain formatting tags (<t0>...</t0>) and glossary placeholders (<g0/>)
+// that implementations must keep in place; the text between them is translated.
+// Reserved characters in the text are escaped as HTML entities (&lt; &gt; &amp;).
+// Implementations return exactly one translated segment per input segment, in order.
+type Translator interface {
+	// Name identifies the provider, e.g. "stub" or "libretranslate"
+	Name() string
+	// Translate translates segments from the source to the target language
+	Translate(ctx context.Context, segments []string, source, target string) ([]string, error)
+}
+
+// Config selects and configures a provider
+type Config struct {
+	Provider string
+	// BaseURL is the provider endpoint, e.g. http://localhost:5000 for LibreTranslate
+	BaseURL string
+	APIKey  string
+	// Timeout bounds each request; zero means one minute
+	Timeout time.Duration
+}
+
+// New creates the provider selected by cfg.Provider; "" and "none" return ErrNotConfigured
+func New(cfg Config) (Translator, error) {
+	switch strings.ToLower(cfg.Provider) {
+	case "", ProviderNone:
+		return nil, ErrNotConfigured
+	case ProviderStub:
+		return NewStubTranslator(), nil
+	case ProviderLibreTranslate:
+		return NewLibreTranslateTranslator(cfg)
+	default:
+		return nil, fmt.Errorf("unknown machine translation provider: %s (expected %s, %s or %s)",
+			cfg.Provider, ProviderNone, ProviderStub, ProviderLibreTranslate)
+	}
+}
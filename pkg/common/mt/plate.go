@@ -0,0 +1,360 @@
+package mt
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// skippedBlockTypes are Plate elements whose text must not be translated
+var skippedBlockTypes = map[string]bool{
+	"code_block":  true,
+	"code_line":   true,
+	"code_syntax": true,
+	"equation":    true,
+}
+
+// Request is a chapter to translate: an optional title and a Plate document
+type Request struct {
+	Title    string
+	Content  json.RawMessage
+	Source   string
+	Target   string
+	Glossary []GlossaryTerm
+}
+
+// Result is the translated chapter
+type Result struct {
+	Title        string
+	Content      json.RawMessage
+	Blocks       int // Translated blocks, the title included
+	GlossaryHits int // Glossary terms replaced by their fixed translation
+}
+
+// leaf is a Plate text node; marks (bold, italic...) live beside "text" and are kept as is
+type leaf struct {
+	node map[string]interface{}
+	text string
+}
+
+// TranslateChapter translates the title and every text block of a Plate document.
+//
+// Each block (an element holding text leaves, possibly inside inline elements such
+// as links) is sent as one segment so the provider sees the whole sentence; every
+// leaf is wrapped in a numbered tag so its marks can be restored on the translated
+// text. Code leaves and code blocks are left untouched, and glossary terms are sent
+// as placeholders and replaced by their fixed translation afterwards.
+func TranslateChapter(ctx context.Context, translator Translator, req Request) (*Result, error) {
+	if translator == nil {
+		return nil, ErrNotConfigured
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(req.Content))
+	decoder.UseNumber()
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil {
+		return nil, fmt.Errorf("invalid content: %w", err)
+	}
+
+	var blocks [][]*leaf
+	var titleLeaf *leaf
+	if strings.TrimSpace(req.Title) != "" {
+		titleLeaf = &leaf{node: map[string]interface{}{"text": req.Title}, text: req.Title}
+		blocks = append(blocks, []*leaf{titleLeaf})
+	}
+	blocks = collectBlocks(document, blocks)
+
+	matcher := newGlossaryMatcher(req.Glossary)
+	segments := make([]string, len(blocks))
+	for i, block := range blocks {
+		segments[i] = encodeBlock(block, matcher)
+	}
+
+	if len(segments) > 0 {
+		translated, err := translator.Translate(ctx, segments, req.Source, req.Target)
+		if err != nil {
+			return nil, err
+		}
+		if len(translated) != len(segments) {
+			return nil, fmt.Errorf("machine translation returned %d segments for %d blocks", len(translated), len(segments))
+		}
+		for i, block := range blocks {
+			decodeBlock(block, translated[i], req.Glossary)
+		}
+	}
+
+	var content bytes.Buffer
+	encoder := json.NewEncoder(&content)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(document); err != nil {
+		return nil, fmt.Errorf("failed to encode translated content: %w", err)
+	}
+
+	result := &Result{
+		Content:      json.RawMessage(bytes.TrimSpace(content.Bytes())),
+		Blocks:       len(blocks),
+		GlossaryHits: matcher.totalHits(),
+	}
+	if titleLeaf != nil {
+		result.Title = strings.TrimSpace(titleLeaf.node["text"].(string))
+	}
+	return result, nil
+}
+
+// collectBlocks walks the document and appends the translatable leaves of every block
+func collectBlocks(node interface{}, blocks [][]*leaf) [][]*leaf {
+	walkBlocks(node, func(children []interface{}) {
+		if leaves := collectLeaves(children, nil); len(leaves) > 0 {
+			blocks = append(blocks, leaves)
+		}
+	})
+	return blocks
+}
+
+// walkBlocks calls visit with the children of every block in document order: elements
+// holding text leaves, possibly inside inline elements such as links. Code blocks are
+// skipped and a bare text node outside any block is visited as its own block.
+func walkBlocks(node interface{}, visit func(children []interface{})) {
+	switch value := node.(type) {
+	case []interface{}:
+		for _, child := range value {
+			walkBlocks(child, visit)
+		}
+	case map[string]interface{}:
+		if _, ok := value["text"].(string); ok {
+			visit([]interface{}{value})
+			return
+		}
+		if blockType, _ := value["type"].(string); skippedBlockTypes[blockType] {
+			return
+		}
+		children, ok := value["children"].([]interface{})
+		if !ok {
+			return
+		}
+		if !hasTextChild(children) {
+			// Containers such as lists, tables and blockquotes hold other blocks
+			walkBlocks(children, visit)
+			return
+		}
+		visit(children)
+	}
+}
+
+// blockTexts returns the plain text of every block of a Plate document, without inline code
+func blockTexts(content json.RawMessage) ([]string, error) {
+	if len(bytes.TrimSpace(content)) == 0 || string(bytes.TrimSpace(content)) == "null" {
+		return nil, nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil {
+		return nil, fmt.Errorf("invalid content: %w", err)
+	}
+
+	var texts []string
+	walkBlocks(document, func(children []interface{}) {
+		var text strings.Builder
+		appendPlainText(&text, children)
+		texts = append(texts, text.String())
+	})
+	return texts, nil
+}
+
+// appendPlainText concatenates the text leaves of a block, descending into inline elements
+func appendPlainText(text *strings.Builder, children []interface{}) {
+	for _, child := range children {
+		node, ok := child.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if value, isText := node["text"].(string); isText {
+			if code, _ := node["code"].(bool); !code {
+				text.WriteString(value)
+			}
+			continue
+		}
+		if inline, ok := node["children"].([]interface{}); ok {
+			appendPlainText(text, inline)
+		}
+	}
+}
+
+// hasTextChild reports whether an element directly holds text, which makes it a block
+func hasTextChild(children []interface{}) bool {
+	for _, child := range children {
+		if node, ok := child.(map[string]interface{}); ok {
+			if _, isText := node["text"].(string); isText {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// collectLeaves gathers the text leaves of a block in order, descending into inline elements
+func collectLeaves(children []interface{}, leaves []*leaf) []*leaf {
+	for _, child := range children {
+		node, ok := child.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if text, isText := node["text"].(string); isText {
+			if l := translatableLeaf(node, text); l != nil {
+				leaves = append(leaves, l)
+			}
+			continue
+		}
+		if inline, ok := node["children"].([]interface{}); ok {
+			leaves = collectLeaves(inline, leaves)
+		}
+	}
+	return leaves
+}
+
+// translatableLeaf skips blank and inline-code leaves
+func translatableLeaf(node map[string]interface{}, text string) *leaf {
+	if strings.TrimSpace(text) == "" {
+		return nil
+	}
+	if code, _ := node["code"].(bool); code {
+		return nil
+	}
+	return &leaf{node: node, text: text}
+}
+
+// encodeBlock builds the segment "<t0>...</t0><t1>...</t1>" of a block
+func encodeBlock(block []*leaf, matcher *glossaryMatcher) string {
+	var segment strings.Builder
+	for i, l := range block {
+		tag := strconv.Itoa(i)
+		segment.WriteString("<t" + tag + ">")
+		segment.WriteString(matcher.protect(l.text))
+		segment.WriteString("</t" + tag + ">")
+	}
+	return segment.String()
+}
+
+// decodeBlock splits a translated segment back onto the block's leaves.
+// Text outside any tag joins the previous leaf (or the first one); a leaf whose tag
+// was dropped by the provider ends up empty rather than keeping untranslated text.
+func decodeBlock(block []*leaf, segment string, glossary []GlossaryTerm) {
+	texts := make([]strings.Builder, len(block))
+	current, previous := -1, -1
+	var pending strings.Builder
+
+	target := func() *strings.Builder {
+		switch {
+		case current >= 0:
+			return &texts[current]
+		case previous >= 0:
+			return &texts[previous]
+		default:
+			return &pending
+		}
+	}
+
+	for len(segment) > 0 {
+		start := strings.IndexByte(segment, '<')
+		if start < 0 {
+			target().WriteString(html.UnescapeString(segment))
+			break
+		}
+		if start > 0 {
+			target().WriteString(html.UnescapeString(segment[:start]))
+			segment = segment[start:]
+		}
+
+		kind, index, closing, length := parseTag(segment)
+		if length == 0 {
+			target().WriteString("<")
+			segment = segment[1:]
+			continue
+		}
+		segment = segment[length:]
+
+		switch {
+		case kind == 't' && index < len(block) && !closing:
+			current = index
+			if pending.Len() > 0 {
+				texts[index].WriteString(pending.String())
+				pending.Reset()
+			}
+		case kind == 't' && closing:
+			if current >= 0 {
+				previous = current
+			}
+			current = -1
+		case kind == 'g' && !closing && index < len(glossary):
+			target().WriteString(glossary[index].Translation)
+		}
+	}
+
+	if pending.Len() > 0 && len(block) > 0 {
+		texts[0].WriteString(pending.String())
+	}
+
+	for i, l := range block {
+		l.node["text"] = keepEdgeSpaces(l.text, texts[i].String())
+	}
+}
+
+// parseTag recognizes <tN>, </tN>, <gN/>, <gN /> and <gN></gN> at the start of s;
+// length is zero when s does not start with one of them
+func parseTag(s string) (kind byte, index int, closing bool, length int) {
+	end := strings.IndexByte(s, '>')
+	if end < 0 {
+		return 0, 0, false, 0
+	}
+	body := s[1:end]
+	if strings.HasPrefix(body, "/") {
+		closing = true
+		body = body[1:]
+	}
+	body = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(body), "/"))
+	if len(body) < 2 || (body[0] != 't' && body[0] != 'g') {
+		return 0, 0, false, 0
+	}
+	number, err := strconv.Atoi(body[1:])
+	if err != nil || number < 0 {
+		return 0, 0, false, 0
+	}
+	return body[0], number, closing, end + 1
+}
+
+// keepEdgeSpaces restores the leading and trailing space of the original leaf,
+// which providers tend to drop at tag boundaries and which separates adjacent leaves
+func keepEdgeSpaces(original, translated string) string {
+	if translated == "" {
+		return translated
+	}
+	first, _ := firstRune(original)
+	if unicode.IsSpace(first) && !startsWithSpace(translated) {
+		translated = " " + translated
+	}
+	if strings.TrimRightFunc(original, unicode.IsSpace) != original && strings.TrimRightFunc(translated, unicode.IsSpace) == translated {
+		translated += " "
+	}
+	return translated
+}
+
+// firstRune returns the first rune of s
+func firstRune(s string) (rune, bool) {
+	for _, r := range s {
+		return r, true
+	}
+	return 0, false
+}
+
+// startsWithSpace reports whether s begins with white space
+func startsWithSpace(s string) bool {
+	r, ok := firstRune(s)
+	return ok && unicode.IsSpace(r)
+}
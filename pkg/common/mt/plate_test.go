@@ -0,0 +1,244 @@
+package mt
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+// rewriteTranslator returns fixed translations keyed by the encoded segment, standing in for
+// a provider that reorders, drops or rewrites tags and placeholders
+type rewriteTranslator map[string]string
+
+func (t rewriteTranslator) Name() string {
+	return "rewrite"
+}
+
+func (t rewriteTranslator) Translate(ctx context.Context, segments []string, source, target string) ([]string, error) {
+	translated := make([]string, len(segments))
+	for i, segment := range segments {
+		rewritten, ok := t[segment]
+		if !ok {
+			rewritten = segment
+		}
+		translated[i] = rewritten
+	}
+	return translated, nil
+}
+
+// assertJSONEqual compares two JSON documents ignoring key order and spacing
+func assertJSONEqual(t *testing.T, got json.RawMessage, want string) {
+	t.Helper()
+
+	var gotValue, wantValue interface{}
+	if err := json.Unmarshal(got, &gotValue); err != nil {
+		t.Fatalf("invalid result JSON %s: %v", got, err)
+	}
+	if err := json.Unmarshal([]byte(want), &wantValue); err != nil {
+		t.Fatalf("invalid expected JSON %s: %v", want, err)
+	}
+	if !reflect.DeepEqual(gotValue, wantValue) {
+		t.Errorf("content =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestTranslateChapter_Stub(t *testing.T) {
+	tests := []struct {
+		name         string
+		title        string
+		content      string
+		glossary     []GlossaryTerm
+		wantTitle    string
+		wantContent  string
+		wantBlocks   int
+		wantGlossary int
+	}{
+		{
+			name:        "title and paragraph",
+			title:       "Chapter 1",
+			content:     `[{"type":"p","children":[{"text":"Hello world"}]}]`,
+			wantTitle:   "[vi] Chapter 1",
+			wantContent: `[{"type":"p","children":[{"text":"[vi] Hello world"}]}]`,
+			wantBlocks:  2,
+		},
+		{
+			name:    "bold and italic marks stay on their leaves",
+			content: `[{"type":"p","children":[{"text":"Hello "},{"text":"brave","bold":true},{"text":" world","italic":true}]}]`,
+			wantContent: `[{"type":"p","children":[{"text":"[vi] Hello "},{"text":"brave","bold":true},` +
+				`{"text":" world","italic":true}]}]`,
+			wantBlocks: 1,
+		},
+		{
+			name:    "link text is translated and the URL kept",
+			content: `[{"type":"p","children":[{"text":"See "},{"type":"a","url":"https://example.com/wiki","children":[{"text":"the wiki"}]},{"text":"."}]}]`,
+			wantContent: `[{"type":"p","children":[{"text":"[vi] See "},` +
+				`{"type":"a","url":"https://example.com/wiki","children":[{"text":"the wiki"}]},{"text":"."}]}]`,
+			wantBlocks: 1,
+		},
+		{
+			name: "code blocks and inline code are not translated",
+			content: `[{"type":"code_block","children":[{"type":"code_line","children":[{"text":"x := 1"}]}]},` +
+				`{"type":"p","children":[{"text":"Run "},{"text":"go test","code":true}]}]`,
+			wantContent: `[{"type":"code_block","children":[{"type":"code_line","children":[{"text":"x := 1"}]}]},` +
+				`{"type":"p","children":[{"text":"[vi] Run "},{"text":"go test","code":true}]}]`,
+			wantBlocks: 1,
+		},
+		{
+			name:        "blocks inside containers are translated separately",
+			content:     `[{"type":"blockquote","children":[{"type":"p","children":[{"text":"One"}]},{"type":"p","children":[{"text":"Two"}]}]}]`,
+			wantContent: `[{"type":"blockquote","children":[{"type":"p","children":[{"text":"[vi] One"}]},{"type":"p","children":[{"text":"[vi] Two"}]}]}]`,
+			wantBlocks:  2,
+		},
+		{
+			name:        "reserved characters survive escaping",
+			content:     `[{"type":"p","children":[{"text":"a < b && c > d"}]}]`,
+			wantContent: `[{"type":"p","children":[{"text":"[vi] a < b && c > d"}]}]`,
+			wantBlocks:  1,
+		},
+		{
+			name:         "glossary terms are replaced by their translation",
+			title:        "Aria returns",
+			content:      `[{"type":"p","children":[{"text":"ARIA met the "},{"text":"Demon Lord","bold":true},{"text":" in Ariadne."}]}]`,
+			glossary:     []GlossaryTerm{{Source: "Aria", Translation: "Aria-sama"}, {Source: "Demon Lord", Translation: "Ma Vương"}},
+			wantTitle:    "[vi] Aria-sama returns",
+			wantContent:  `[{"type":"p","children":[{"text":"[vi] Aria-sama met the "},{"text":"Ma Vương","bold":true},{"text":" in Ariadne."}]}]`,
+			wantBlocks:   2,
+			wantGlossary: 3,
+		},
+		{
+			name:         "glossary terms inside unspaced scripts",
+			content:      `[{"type":"p","children":[{"text":"魔王は笑った"}]}]`,
+			glossary:     []GlossaryTerm{{Source: "魔王", Translation: "Ma Vương"}},
+			wantContent:  `[{"type":"p","children":[{"text":"[vi] Ma Vươngは笑った"}]}]`,
+			wantBlocks:   1,
+			wantGlossary: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := TranslateChapter(context.Background(), NewStubTranslator(), Request{
+				Title:    tt.title,
+				Content:  json.RawMessage(tt.content),
+				Source:   "en",
+				Target:   "vi",
+				Glossary: tt.glossary,
+			})
+			if err != nil {
+				t.Fatalf("TranslateChapter() error = %v", err)
+			}
+
+			if result.Title != tt.wantTitle {
+				t.Errorf("Title = %q, want %q", result.Title, tt.wantTitle)
+			}
+			assertJSONEqual(t, result.Content, tt.wantContent)
+			if result.Blocks != tt.wantBlocks {
+				t.Errorf("Blocks = %d, want %d", result.Blocks, tt.wantBlocks)
+			}
+			if result.GlossaryHits != tt.wantGlossary {
+				t.Errorf("GlossaryHits = %d, want %d", result.GlossaryHits, tt.wantGlossary)
+			}
+		})
+	}
+}
+
+func TestTranslateChapter_MangledTags(t *testing.T) {
+	content := `[{"type":"p","children":[{"text":"Hello "},{"text":"Aria","bold":true},{"text":" world"}]}]`
+	glossary := []GlossaryTerm{{Source: "Aria", Translation: "Aria-sama"}}
+	segment := "<t0>Hello </t0><t1><g0/></t1><t2> world</t2>"
+
+	tests := []struct {
+		name        string
+		translation string
+		wantContent string
+	}{
+		{
+			name:        "tags kept in place",
+			translation: "<t0>Xin chào </t0><t1><g0/></t1><t2> thế giới</t2>",
+			wantContent: `[{"type":"p","children":[{"text":"Xin chào "},{"text":"Aria-sama","bold":true},{"text":" thế giới"}]}]`,
+		},
+		{
+			name:        "tags reordered",
+			translation: "<t2>Thế giới </t2><t1><g0/></t1><t0> xin chào</t0>",
+			wantContent: `[{"type":"p","children":[{"text":" xin chào "},{"text":"Aria-sama","bold":true},{"text":" Thế giới "}]}]`,
+		},
+		{
+			name:        "all tags dropped puts the text on the first leaf",
+			translation: "Xin chào <g0/> thế giới",
+			wantContent: `[{"type":"p","children":[{"text":"Xin chào Aria-sama thế giới "},{"text":"","bold":true},{"text":""}]}]`,
+		},
+		{
+			name:        "text outside tags joins the previous leaf",
+			translation: "<t0>Xin chào </t0>, <t1><g0/></t1><t2> thế giới</t2>!",
+			wantContent: `[{"type":"p","children":[{"text":"Xin chào , "},{"text":"Aria-sama","bold":true},{"text":" thế giới!"}]}]`,
+		},
+		{
+			name:        "placeholder spelled with a space or a closing tag",
+			translation: "<t0>Xin chào </t0><t1><g0 /> và <g0></g0></t1><t2> thế giới</t2>",
+			wantContent: `[{"type":"p","children":[{"text":"Xin chào "},{"text":"Aria-sama và Aria-sama","bold":true},{"text":" thế giới"}]}]`,
+		},
+		{
+			name:        "unknown tags and placeholders are dropped",
+			translation: "<t0>Xin chào </t0><t1><g7/><g0/></t1><t9>?</t9><t2> thế giới</t2>",
+			wantContent: `[{"type":"p","children":[{"text":"Xin chào "},{"text":"Aria-sama?","bold":true},{"text":" thế giới"}]}]`,
+		},
+		{
+			name:        "escaped and stray angle brackets",
+			translation: "<t0>1 &lt; 2 < 3 </t0><t1><g0/></t1><t2> &amp; thế giới</t2>",
+			wantContent: `[{"type":"p","children":[{"text":"1 < 2 < 3 "},{"text":"Aria-sama","bold":true},{"text":" & thế giới"}]}]`,
+		},
+		{
+			name:        "placeholder dropped by the provider",
+			translation: "<t0>Xin chào </t0><t1>Aria</t1><t2> thế giới</t2>",
+			wantContent: `[{"type":"p","children":[{"text":"Xin chào "},{"text":"Aria","bold":true},{"text":" thế giới"}]}]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			translator := rewriteTranslator{segment: tt.translation}
+			result, err := TranslateChapter(context.Background(), translator, Request{
+				Content:  json.RawMessage(content),
+				Source:   "en",
+				Target:   "vi",
+				Glossary: glossary,
+			})
+			if err != nil {
+				t.Fatalf("TranslateChapter() error = %v", err)
+			}
+			assertJSONEqual(t, result.Content, tt.wantContent)
+		})
+	}
+}
+
+func TestTranslateChapter_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		translator Translator
+		content    string
+	}{
+		{name: "no translator", translator: nil, content: `[]`},
+		{name: "invalid content", translator: NewStubTranslator(), content: `[{"type":`},
+		{name: "segment count mismatch", translator: droppingTranslator{}, content: `[{"type":"p","children":[{"text":"Hello"}]}]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := TranslateChapter(context.Background(), tt.translator, Request{Content: json.RawMessage(tt.content)}); err == nil {
+				t.Error("TranslateChapter() error = nil, want an error")
+			}
+		})
+	}
+}
+
+// droppingTranslator returns no segments at all
+type droppingTranslator struct{}
+
+func (droppingTranslator) Name() string {
+	return "dropping"
+}
+
+func (droppingTranslator) Translate(ctx context.Context, segments []string, source, target string) ([]string, error) {
+	return nil, nil
+}
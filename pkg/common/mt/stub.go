@@ -0,0 +1,39 @@
+package mt
+
+import (
+	"context"
+	"strings"
+)
+
+// StubTranslator is a deterministic local provider for development and tests.
+// It keeps every segment unchanged and prefixes it with the target language,
+// e.g. "<t0>Hello</t0>" becomes "[vi] <t0>Hello</t0>", so output is predictable
+// and the tag and placeholder handling can be exercised without a network call.
+type StubTranslator struct{}
+
+// NewStubTranslator creates the stub provider
+func NewStubTranslator() *StubTranslator {
+	return &StubTranslator{}
+}
+
+// Name returns "stub"
+func (t *StubTranslator) Name() string {
+	return ProviderStub
+}
+
+// Translate prefixes each non-empty segment with "[target] "
+func (t *StubTranslator) Translate(ctx context.Context, segments []string, source, target string) ([]string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	translated := make([]string, len(segments))
+	for i, segment := range segments {
+		if strings.TrimSpace(segment) == "" {
+			translated[i] = segment
+			continue
+		}
+		translated[i] = "[" + target + "] " + segment
+	}
+	return translated, nil
+}
@@ -4,6 +4,10 @@ package oauth
 
 import "time"
 
+// ClaimAge is the UserInfo.Extra key holding the user's age in whole years. It is present
+// only when the user has declared a birthdate.
+const ClaimAge = "age"
+
 // UserInfo represents authenticated user information from token validation.
 type UserInfo struct {
 	Subject   string            `json:"sub"`
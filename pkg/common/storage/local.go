@@ -0,0 +1,203 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LocalConfig configures the filesystem backend
+type LocalConfig struct {
+	// RootDir is the directory objects are written under
+	RootDir string
+	// BaseURL is the public URL of the route serving signed downloads, e.g.
+	// "https://catalog.example.com/api/v1/media/files"; object keys are appended to it
+	BaseURL string
+	// SigningKey is the HMAC secret of signed download URLs
+	SigningKey string
+}
+
+// LocalStorage stores objects as files under a root directory
+type LocalStorage struct {
+	root       string
+	baseURL    string
+	signingKey []byte
+}
+
+// NewLocalStorage creates the root directory if needed
+func NewLocalStorage(cfg LocalConfig) (*LocalStorage, error) {
+	if cfg.RootDir == "" {
+		return nil, fmt.Errorf("local storage: root directory is required")
+	}
+	if cfg.SigningKey == "" {
+		return nil, fmt.Errorf("local storage: signing key is required")
+	}
+
+	root, err := filepath.Abs(cfg.RootDir)
+	if err != nil {
+		return nil, fmt.Errorf("local storage: %w", err)
+	}
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return nil, fmt.Errorf("local storage: failed to create root directory: %w", err)
+	}
+
+	return &LocalStorage{
+		root:       root,
+		baseURL:    strings.TrimRight(cfg.BaseURL, "/"),
+		signingKey: []byte(cfg.SigningKey),
+	}, nil
+}
+
+// Put writes the object to a temporary file and renames it into place,
+// so readers never see a partially written object
+func (s *LocalStorage) Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create object directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	written, err := io.Copy(tmp, body)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write object: %w", err)
+	}
+	if size >= 0 && written != size {
+		return fmt.Errorf("failed to write object: wrote %d of %d bytes", written, size)
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to store object: %w", err)
+	}
+	return nil
+}
+
+// Get opens the object file
+func (s *LocalStorage) Get(ctx context.Context, key string) (io.ReadCloser, *ObjectInfo, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil, ErrNotFound
+		}
+		return nil, nil, fmt.Errorf("failed to open object: %w", err)
+	}
+
+	stat, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, nil, fmt.Errorf("failed to stat object: %w", err)
+	}
+
+	return file, s.info(key, stat), nil
+}
+
+// Stat returns the size and modification time of the object file
+func (s *LocalStorage) Stat(ctx context.Context, key string) (*ObjectInfo, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	stat, err := os.Stat(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to stat object: %w", err)
+	}
+	return s.info(key, stat), nil
+}
+
+// Delete removes the object file
+func (s *LocalStorage) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to delete object: %w", err)
+	}
+	return nil
+}
+
+// SignedURL returns BaseURL/key with an expiry and an HMAC signature;
+// the serving route checks them with VerifySignedURL
+func (s *LocalStorage) SignedURL(ctx context.Context, key string, expiry time.Duration) (string, error) {
+	if err := ValidateKey(key); err != nil {
+		return "", err
+	}
+	if expiry <= 0 {
+		return "", fmt.Errorf("invalid expiry: %s", expiry)
+	}
+
+	expires := time.Now().Add(expiry).Unix()
+	return fmt.Sprintf("%s/%s?expires=%d&signature=%s", s.baseURL, escapeKey(key), expires, s.sign(key, expires)), nil
+}
+
+// VerifySignedURL checks the expiry and signature of a URL created by SignedURL
+func (s *LocalStorage) VerifySignedURL(key string, expires int64, signature string) error {
+	if err := ValidateKey(key); err != nil {
+		return err
+	}
+	if time.Now().Unix() > expires {
+		return fmt.Errorf("invalid signature: URL expired")
+	}
+	if !hmac.Equal([]byte(signature), []byte(s.sign(key, expires))) {
+		return fmt.Errorf("invalid signature")
+	}
+	return nil
+}
+
+// sign computes the hex HMAC-SHA256 of "key\nexpires"
+func (s *LocalStorage) sign(key string, expires int64) string {
+	mac := hmac.New(sha256.New, s.signingKey)
+	mac.Write([]byte(key + "\n" + strconv.FormatInt(expires, 10)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// path maps a validated key to a file under the root
+func (s *LocalStorage) path(key string) (string, error) {
+	if err := ValidateKey(key); err != nil {
+		return "", err
+	}
+	return filepath.Join(s.root, filepath.FromSlash(key)), nil
+}
+
+// info builds ObjectInfo from a file; the content type comes from the key extension
+func (s *LocalStorage) info(key string, stat fs.FileInfo) *ObjectInfo {
+	contentType := mime.TypeByExtension(filepath.Ext(key))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	return &ObjectInfo{Key: key, Size: stat.Size(), ContentType: contentType, ModTime: stat.ModTime()}
+}
@@ -0,0 +1,114 @@
+// Package storage keeps uploaded binary objects (covers, avatars, page images)
+// behind one interface, with a local filesystem and an S3-compatible backend
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Backend driver names accepted by Config.Driver
+const (
+	DriverLocal = "local"
+	DriverS3    = "s3"
+)
+
+// ErrNotFound is returned when an object does not exist
+var ErrNotFound = errors.New("object not found")
+
+// ObjectInfo describes a stored object
+type ObjectInfo struct {
+	Key         string
+	Size        int64
+	ContentType string
+	ModTime     time.Time
+}
+
+// Storage stores objects under slash-separated keys such as "media/ab/cd/<sha256>.png"
+type Storage interface {
+	// Put writes an object, replacing any existing one; size is the exact body length
+	Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error
+	// Get opens an object for reading; the caller closes the reader
+	Get(ctx context.Context, key string) (io.ReadCloser, *ObjectInfo, error)
+	// Stat returns object metadata without reading the body
+	Stat(ctx context.Context, key string) (*ObjectInfo, error)
+	// Delete removes an object; deleting a missing object is not an error
+	Delete(ctx context.Context, key string) error
+	// SignedURL returns a download URL that stops working after expiry
+	SignedURL(ctx context.Context, key string, expiry time.Duration) (string, error)
+}
+
+// URLVerifier is implemented by backends whose signed URLs are served by the
+// application itself rather than by the storage server
+type URLVerifier interface {
+	VerifySignedURL(key string, expires int64, signature string) error
+}
+
+// Config selects and configures a backend
+type Config struct {
+	Driver string
+	Local  LocalConfig
+	S3     S3Config
+}
+
+// New creates the backend selected by cfg.Driver
+func New(cfg Config) (Storage, error) {
+	switch strings.ToLower(cfg.Driver) {
+	case "", DriverLocal:
+		return NewLocalStorage(cfg.Local)
+	case DriverS3:
+		return NewS3Storage(cfg.S3)
+	default:
+		return nil, fmt.Errorf("unknown storage driver: %s (expected %s or %s)", cfg.Driver, DriverLocal, DriverS3)
+	}
+}
+
+// ValidateKey rejects keys that could escape the storage root or break URLs
+func ValidateKey(key string) error {
+	if key == "" || len(key) > 1024 {
+		return fmt.Errorf("invalid object key: must be 1-1024 characters")
+	}
+	if strings.HasPrefix(key, "/") || strings.HasSuffix(key, "/") || strings.Contains(key, `\`) {
+		return fmt.Errorf("invalid object key: %q", key)
+	}
+	for _, segment := range strings.Split(key, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return fmt.Errorf("invalid object key: %q", key)
+		}
+	}
+	for _, r := range key {
+		if r < 0x20 || r == 0x7f {
+			return fmt.Errorf("invalid object key: control characters are not allowed")
+		}
+	}
+	return nil
+}
+
+// escapeKey percent-encodes every key segment with the RFC 3986 unreserved set,
+// the encoding both signed URL schemes sign over
+func escapeKey(key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = uriEncode(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+// uriEncode encodes everything except A-Z, a-z, 0-9, '-', '.', '_' and '~'
+func uriEncode(value string) string {
+	var b strings.Builder
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') ||
+			c == '-' || c == '.' || c == '_' || c == '~' {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
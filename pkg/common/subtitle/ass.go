@@ -0,0 +1,103 @@
+package subtitle
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// assOverrideBlock matches ASS style override blocks such as {\i1} or {\pos(10,20)}
+var assOverrideBlock = regexp.MustCompile(`\{[^}]*\}`)
+
+// assDefaultFormat is the [Events] field order of ASS (v4.00+) files without a Format line
+var assDefaultFormat = []string{"layer", "start", "end", "style", "name", "marginl", "marginr", "marginv", "effect", "text"}
+
+// parseASS reads the Dialogue lines of the [Events] section. Styling is dropped;
+// comments and drawing commands are skipped.
+func parseASS(content string) ([]Cue, error) {
+	cues := make([]Cue, 0)
+	inEvents := false
+	fields := assDefaultFormat
+
+	for lineNumber, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			inEvents = strings.EqualFold(line, "[Events]")
+			continue
+		}
+		if !inEvents {
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		switch strings.TrimSpace(key) {
+		case "Format":
+			fields = fields[:0:0]
+			for _, field := range strings.Split(value, ",") {
+				fields = append(fields, strings.ToLower(strings.TrimSpace(field)))
+			}
+		case "Dialogue":
+			cue, skip, err := parseASSDialogue(value, fields)
+			if err != nil {
+				return nil, fmt.Errorf("invalid subtitle: ASS line %d: %w", lineNumber+1, err)
+			}
+			if !skip {
+				cues = append(cues, cue)
+			}
+		}
+	}
+
+	if !inEvents && len(cues) == 0 {
+		return nil, fmt.Errorf("invalid subtitle: ASS file has no [Events] section")
+	}
+	return cues, nil
+}
+
+// parseASSDialogue maps one Dialogue line onto the Format fields; the text field keeps its commas
+func parseASSDialogue(value string, fields []string) (Cue, bool, error) {
+	values := strings.SplitN(strings.TrimSpace(value), ",", len(fields))
+	if len(values) != len(fields) {
+		return Cue{}, false, fmt.Errorf("expected %d fields, got %d", len(fields), len(values))
+	}
+
+	var (
+		cue     Cue
+		hasText bool
+		err     error
+	)
+	for i, field := range fields {
+		switch field {
+		case "start":
+			if cue.Start, err = parseTimestamp(values[i]); err != nil {
+				return Cue{}, false, err
+			}
+		case "end":
+			if cue.End, err = parseTimestamp(values[i]); err != nil {
+				return Cue{}, false, err
+			}
+		case "text":
+			// Drawing mode ({\p1}) renders shapes, not dialogue
+			if strings.Contains(values[i], `\p1`) {
+				return Cue{}, true, nil
+			}
+			cue.Text = assText(values[i])
+			hasText = true
+		}
+	}
+	if !hasText {
+		return Cue{}, false, fmt.Errorf("missing Text field")
+	}
+	// Lines left empty once styling is removed carry nothing to display
+	return cue, cue.Text == "", nil
+}
+
+// assText removes override blocks, converts ASS line breaks and escapes WebVTT markup characters
+func assText(text string) string {
+	text = assOverrideBlock.ReplaceAllString(text, "")
+	text = strings.NewReplacer(`\N`, "\n", `\n`, "\n", `\h`, " ").Replace(text)
+	text = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(text)
+	return strings.TrimSpace(text)
+}
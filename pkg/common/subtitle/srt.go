@@ -0,0 +1,52 @@
+package subtitle
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// srtFontTag matches SubRip <font> tags, which WebVTT does not support
+var srtFontTag = regexp.MustCompile(`(?i)</?font[^>]*>`)
+
+// srtStyleTag matches the bold, italic and underline tags SubRip and WebVTT share
+var srtStyleTag = regexp.MustCompile(`(?i)</?[biu]>`)
+
+// parseSRT reads SubRip blocks: an optional counter, a timing line and the text lines
+func parseSRT(content string) ([]Cue, error) {
+	cues := make([]Cue, 0)
+	for i, block := range splitBlocks(content) {
+		timingIndex := 0
+		if !strings.Contains(block[0], "-->") {
+			timingIndex = 1
+		}
+		if timingIndex >= len(block) || !strings.Contains(block[timingIndex], "-->") {
+			return nil, fmt.Errorf("invalid subtitle: SRT block %d has no timing line", i+1)
+		}
+
+		start, end, err := parseTimingLine(block[timingIndex])
+		if err != nil {
+			return nil, fmt.Errorf("invalid subtitle: SRT block %d: %w", i+1, err)
+		}
+
+		cues = append(cues, Cue{Start: start, End: end, Text: srtText(strings.Join(block[timingIndex+1:], "\n"))})
+	}
+	return cues, nil
+}
+
+// srtText drops <font> tags and escapes WebVTT markup characters, keeping the <b>, <i> and <u>
+// tags both formats share
+func srtText(text string) string {
+	text = srtFontTag.ReplaceAllString(text, "")
+	escaper := strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+	var b strings.Builder
+	plainStart := 0
+	for _, tag := range srtStyleTag.FindAllStringIndex(text, -1) {
+		b.WriteString(escaper.Replace(text[plainStart:tag[0]]))
+		b.WriteString(strings.ToLower(text[tag[0]:tag[1]]))
+		plainStart = tag[1]
+	}
+	b.WriteString(escaper.Replace(text[plainStart:]))
+	return strings.TrimSpace(b.String())
+}
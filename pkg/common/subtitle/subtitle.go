@@ -0,0 +1,263 @@
+// Package subtitle parses SRT, WebVTT and ASS subtitles into timed cues,
+// validates their timing and renders them as WebVTT for delivery
+package subtitle
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Format identifies a subtitle file format
+type Format string
+
+const (
+	FormatSRT    Format = "srt"
+	FormatWebVTT Format = "vtt"
+	FormatASS    Format = "ass"
+)
+
+// Cue is one timed piece of subtitle text; lines are separated by "\n"
+type Cue struct {
+	Start time.Duration
+	End   time.Duration
+	Text  string
+}
+
+// ParseFormat normalizes a user supplied format name; SSA is read as ASS
+func ParseFormat(name string) (Format, error) {
+	switch strings.ToLower(strings.TrimPrefix(strings.TrimSpace(name), ".")) {
+	case "srt", "subrip":
+		return FormatSRT, nil
+	case "vtt", "webvtt":
+		return FormatWebVTT, nil
+	case "ass", "ssa":
+		return FormatASS, nil
+	default:
+		return "", fmt.Errorf("invalid subtitle format: %s (expected srt, vtt or ass)", name)
+	}
+}
+
+// DetectFormat guesses the format from the content header
+func DetectFormat(content string) (Format, error) {
+	trimmed := strings.TrimSpace(normalizeNewlines(content))
+	switch {
+	case strings.HasPrefix(trimmed, "WEBVTT"):
+		return FormatWebVTT, nil
+	case strings.HasPrefix(trimmed, "[Script Info]"), strings.HasPrefix(trimmed, "[Events]"), strings.Contains(trimmed, "\n[Events]"):
+		return FormatASS, nil
+	case strings.Contains(trimmed, "-->"):
+		return FormatSRT, nil
+	default:
+		return "", fmt.Errorf("invalid subtitle: unable to detect the format")
+	}
+}
+
+// Parse reads subtitle content in the given format, detecting it when format is empty.
+// Cues are returned sorted by start time.
+func Parse(content string, format Format) ([]Cue, error) {
+	content = normalizeNewlines(content)
+
+	if format == "" {
+		detected, err := DetectFormat(content)
+		if err != nil {
+			return nil, err
+		}
+		format = detected
+	}
+
+	var (
+		cues []Cue
+		err  error
+	)
+	switch format {
+	case FormatSRT:
+		cues, err = parseSRT(content)
+	case FormatWebVTT:
+		cues, err = parseWebVTT(content)
+	case FormatASS:
+		cues, err = parseASS(content)
+	default:
+		return nil, fmt.Errorf("invalid subtitle format: %s", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(cues) == 0 {
+		return nil, fmt.Errorf("invalid subtitle: no cues found")
+	}
+
+	sort.SliceStable(cues, func(i, j int) bool { return cues[i].Start < cues[j].Start })
+	return cues, nil
+}
+
+// Validate rejects negative timestamps, cues that do not end after they start
+// and cues that overlap the previous one. Cues must be sorted by start time.
+func Validate(cues []Cue) error {
+	for i, cue := range cues {
+		if cue.Start < 0 {
+			return fmt.Errorf("invalid subtitle timing: cue %d starts before 00:00:00.000", i+1)
+		}
+		if cue.End <= cue.Start {
+			return fmt.Errorf("invalid subtitle timing: cue %d ends at %s, not after its start %s",
+				i+1, formatTimestamp(cue.End), formatTimestamp(cue.Start))
+		}
+		if i > 0 && cue.Start < cues[i-1].End {
+			return fmt.Errorf("invalid subtitle timing: cue %d starts at %s before cue %d ends at %s",
+				i+1, formatTimestamp(cue.Start), i, formatTimestamp(cues[i-1].End))
+		}
+	}
+	return nil
+}
+
+// Shift moves every cue by offset; cues may not be pushed before the start of the video
+func Shift(cues []Cue, offset time.Duration) ([]Cue, error) {
+	shifted := make([]Cue, len(cues))
+	for i, cue := range cues {
+		cue.Start += offset
+		cue.End += offset
+		if cue.Start < 0 {
+			return nil, fmt.Errorf("invalid offset: cue %d would start before 00:00:00.000", i+1)
+		}
+		shifted[i] = cue
+	}
+	return shifted, nil
+}
+
+// ToWebVTT renders cues as a WebVTT document
+func ToWebVTT(cues []Cue) string {
+	var b strings.Builder
+	b.WriteString("WEBVTT\n")
+	for i, cue := range cues {
+		fmt.Fprintf(&b, "\n%d\n%s --> %s\n%s\n", i+1, formatTimestamp(cue.Start), formatTimestamp(cue.End), vttText(cue.Text))
+	}
+	return b.String()
+}
+
+// parseTimestamp reads "hh:mm:ss.mmm", "mm:ss.mmm" (WebVTT) or "hh:mm:ss,mmm" (SRT)
+func parseTimestamp(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+	clock, fraction, _ := strings.Cut(strings.Replace(value, ",", ".", 1), ".")
+
+	parts := strings.Split(clock, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, fmt.Errorf("invalid timestamp %q", value)
+	}
+
+	var total time.Duration
+	units := []time.Duration{time.Hour, time.Minute, time.Second}[3-len(parts):]
+	for i, part := range parts {
+		n, err := parseDigits(part)
+		if err != nil || (i > 0 && n > 59) {
+			return 0, fmt.Errorf("invalid timestamp %q", value)
+		}
+		total += time.Duration(n) * units[i]
+	}
+
+	if fraction != "" {
+		// Pad or cut to milliseconds: ".5" is 500ms, ".05" (ASS centiseconds) is 50ms
+		if len(fraction) > 3 {
+			fraction = fraction[:3]
+		}
+		fraction += strings.Repeat("0", 3-len(fraction))
+		ms, err := parseDigits(fraction)
+		if err != nil {
+			return 0, fmt.Errorf("invalid timestamp %q", value)
+		}
+		total += time.Duration(ms) * time.Millisecond
+	}
+
+	return total, nil
+}
+
+// parseTimingLine reads "start --> end" and ignores trailing WebVTT cue settings
+func parseTimingLine(line string) (time.Duration, time.Duration, error) {
+	startValue, rest, ok := strings.Cut(line, "-->")
+	if !ok {
+		return 0, 0, fmt.Errorf("missing \"-->\" in timing line %q", line)
+	}
+	fields := strings.Fields(rest)
+	if len(fields) == 0 {
+		return 0, 0, fmt.Errorf("missing end time in timing line %q", line)
+	}
+
+	start, err := parseTimestamp(startValue)
+	if err != nil {
+		return 0, 0, err
+	}
+	end, err := parseTimestamp(fields[0])
+	if err != nil {
+		return 0, 0, err
+	}
+	return start, end, nil
+}
+
+// parseDigits parses a non-empty run of ASCII digits
+func parseDigits(value string) (int, error) {
+	if value == "" {
+		return 0, fmt.Errorf("empty number")
+	}
+	n := 0
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			return 0, fmt.Errorf("invalid number %q", value)
+		}
+		n = n*10 + int(r-'0')
+	}
+	return n, nil
+}
+
+// formatTimestamp renders a duration as a WebVTT timestamp (hh:mm:ss.mmm)
+func formatTimestamp(d time.Duration) string {
+	sign := ""
+	if d < 0 {
+		sign = "-"
+		d = -d
+	}
+	ms := d.Milliseconds()
+	return fmt.Sprintf("%s%02d:%02d:%02d.%03d", sign, ms/3600000, ms/60000%60, ms/1000%60, ms%1000)
+}
+
+// vttText keeps cue text from breaking the WebVTT structure: no blank lines and no "-->"
+func vttText(text string) string {
+	lines := strings.Split(text, "\n")
+	kept := lines[:0]
+	for _, line := range lines {
+		line = strings.TrimRight(line, " \t")
+		if line == "" {
+			continue
+		}
+		kept = append(kept, strings.ReplaceAll(line, "-->", "--&gt;"))
+	}
+	return strings.Join(kept, "\n")
+}
+
+// normalizeNewlines strips a UTF-8 BOM and converts CRLF / CR line endings to LF
+func normalizeNewlines(content string) string {
+	content = strings.TrimPrefix(content, "\ufeff")
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	return strings.ReplaceAll(content, "\r", "\n")
+}
+
+// splitBlocks splits content into blocks separated by blank lines
+func splitBlocks(content string) [][]string {
+	var (
+		blocks  [][]string
+		current []string
+	)
+	for _, line := range strings.Split(content, "\n") {
+		if strings.TrimSpace(line) == "" {
+			if len(current) > 0 {
+				blocks = append(blocks, current)
+				current = nil
+			}
+			continue
+		}
+		current = append(current, line)
+	}
+	if len(current) > 0 {
+		blocks = append(blocks, current)
+	}
+	return blocks
+}
@@ -0,0 +1,129 @@
+package subtitle
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestParse_Fixtures converts each testdata/sample.<format> file to WebVTT, compares it with
+// testdata/sample.<format>.vtt and checks the WebVTT output parses back to the same cues
+func TestParse_Fixtures(t *testing.T) {
+	tests := []struct {
+		file   string
+		format Format
+	}{
+		{file: "sample.srt", format: FormatSRT},
+		{file: "sample.vtt", format: FormatWebVTT},
+		{file: "sample.ass", format: FormatASS},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			input := readFixture(t, tt.file)
+			want := readFixture(t, tt.file+".vtt")
+
+			detected, err := DetectFormat(input)
+			if err != nil || detected != tt.format {
+				t.Fatalf("DetectFormat() = %q, %v, want %q", detected, err, tt.format)
+			}
+
+			cues, err := Parse(input, "")
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if err := Validate(cues); err != nil {
+				t.Fatalf("Validate() error = %v", err)
+			}
+
+			got := ToWebVTT(cues)
+			if got != want {
+				t.Errorf("ToWebVTT() =\n%s\nwant\n%s", got, want)
+			}
+
+			roundTrip, err := Parse(got, FormatWebVTT)
+			if err != nil {
+				t.Fatalf("Parse() of the WebVTT output error = %v", err)
+			}
+			if !reflect.DeepEqual(roundTrip, cues) {
+				t.Errorf("round trip cues = %+v, want %+v", roundTrip, cues)
+			}
+		})
+	}
+}
+
+func TestParse_CRLFAndBOM(t *testing.T) {
+	input := "\ufeff" + strings.ReplaceAll(readFixture(t, "sample.srt"), "\n", "\r\n")
+
+	cues, err := Parse(input, FormatSRT)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if got, want := ToWebVTT(cues), readFixture(t, "sample.srt.vtt"); got != want {
+		t.Errorf("ToWebVTT() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestSRTText(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{name: "plain", text: "Hello", want: "Hello"},
+		{name: "ampersand and angle brackets", text: "Q&A: 1 < 2 > 0", want: "Q&amp;A: 1 &lt; 2 &gt; 0"},
+		{name: "style tags kept and lower-cased", text: "<I>one</I> <b>two</b> <u>three</u>", want: "<i>one</i> <b>two</b> <u>three</u>"},
+		{name: "font tags removed", text: `<font face="Arial" color="red">red</font>`, want: "red"},
+		{name: "unknown tags escaped", text: "<script>x</script>", want: "&lt;script&gt;x&lt;/script&gt;"},
+		{name: "entities are not decoded", text: "&amp;", want: "&amp;amp;"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := srtText(tt.text); got != tt.want {
+				t.Errorf("srtText(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTimestamp(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{value: "00:00:01,500", want: 1500 * time.Millisecond},
+		{value: "01:02:03.004", want: time.Hour + 2*time.Minute + 3*time.Second + 4*time.Millisecond},
+		{value: "02:03.5", want: 2*time.Minute + 3*time.Second + 500*time.Millisecond},
+		{value: "0:00:04.05", want: 4*time.Second + 50*time.Millisecond},
+		{value: "00:60:00.000", wantErr: true},
+		{value: "12", wantErr: true},
+		{value: "aa:bb:cc", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parseTimestamp(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseTimestamp(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("parseTimestamp(%q) = %s, want %s", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func readFixture(t *testing.T, name string) string {
+	t.Helper()
+
+	content, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("failed to read fixture %s: %v", name, err)
+	}
+	return string(content)
+}
@@ -0,0 +1,39 @@
+package subtitle
+
+import (
+	"fmt"
+	"strings"
+)
+
+// parseWebVTT reads WebVTT cues, skipping the header and NOTE, STYLE and REGION blocks
+func parseWebVTT(content string) ([]Cue, error) {
+	blocks := splitBlocks(content)
+	if len(blocks) == 0 || !strings.HasPrefix(strings.TrimSpace(blocks[0][0]), "WEBVTT") {
+		return nil, fmt.Errorf("invalid subtitle: WebVTT files must start with \"WEBVTT\"")
+	}
+
+	cues := make([]Cue, 0)
+	for i, block := range blocks[1:] {
+		first := strings.TrimSpace(block[0])
+		if first == "NOTE" || strings.HasPrefix(first, "NOTE ") || first == "STYLE" || first == "REGION" {
+			continue
+		}
+
+		// A cue may start with an identifier line before its timing line
+		timingIndex := 0
+		if !strings.Contains(block[0], "-->") {
+			timingIndex = 1
+		}
+		if timingIndex >= len(block) || !strings.Contains(block[timingIndex], "-->") {
+			return nil, fmt.Errorf("invalid subtitle: WebVTT block %d has no timing line", i+2)
+		}
+
+		start, end, err := parseTimingLine(block[timingIndex])
+		if err != nil {
+			return nil, fmt.Errorf("invalid subtitle: WebVTT block %d: %w", i+2, err)
+		}
+
+		cues = append(cues, Cue{Start: start, End: end, Text: strings.TrimSpace(strings.Join(block[timingIndex+1:], "\n"))})
+	}
+	return cues, nil
+}
@@ -0,0 +1,13 @@
+-- Rollback Migration 114: Remove Novel Ranking System
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_novel_ranking_snapshot_novel;
+DROP INDEX IF EXISTS idx_novel_ranking_snapshot_type_rank;
+DROP INDEX IF EXISTS idx_novel_daily_stats_date;
+
+-- Drop tables
+DROP TABLE IF EXISTS novel_ranking_snapshot;
+DROP TABLE IF EXISTS novel_daily_stats;
+
+-- Drop enums
+DROP TYPE IF EXISTS novel_ranking_type;
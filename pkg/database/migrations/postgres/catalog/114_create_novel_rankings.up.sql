@@ -0,0 +1,70 @@
+-- Migration 114: Create Novel Ranking System
+-- Daily engagement aggregates and pre-computed ranking snapshots (trending, most bookmarked, rising)
+
+-- Create ranking type enum
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_type WHERE typname = 'novel_ranking_type'
+    ) THEN
+        CREATE TYPE novel_ranking_type AS ENUM (
+            'TRENDING_DAY',    -- Trending today
+            'TRENDING_WEEK',   -- Trending this week
+            'TRENDING_MONTH',  -- Trending this month
+            'MOST_BOOKMARKED', -- All-time most bookmarked
+            'RISING'           -- Fastest growing compared to baseline
+        );
+    END IF;
+END$$;
+
+-- ==========================
+-- NOVEL DAILY STATS TABLE
+-- ==========================
+
+-- One row per novel per day, incremented as engagement happens
+CREATE TABLE novel_daily_stats (
+    novel_id UUID NOT NULL REFERENCES novel(id) ON DELETE CASCADE,
+    stat_date DATE NOT NULL,
+
+    view_count BIGINT NOT NULL DEFAULT 0,     -- Lượt xem trong ngày
+    bookmark_count BIGINT NOT NULL DEFAULT 0, -- Lượt bookmark trong ngày
+
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (novel_id, stat_date)
+);
+
+-- ==========================
+-- NOVEL RANKING SNAPSHOT TABLE
+-- ==========================
+
+-- Ranked output of the scheduled ranking job; rebuilt per ranking_type
+CREATE TABLE novel_ranking_snapshot (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    ranking_type novel_ranking_type NOT NULL,
+    novel_id UUID NOT NULL REFERENCES novel(id) ON DELETE CASCADE,
+    rank INTEGER NOT NULL,
+    score DOUBLE PRECISION NOT NULL,
+    computed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT check_ranking_rank_positive CHECK (rank > 0),
+    UNIQUE (ranking_type, novel_id),
+    UNIQUE (ranking_type, rank)
+);
+
+-- ====================
+-- INDEXES
+-- ====================
+
+CREATE INDEX idx_novel_daily_stats_date ON novel_daily_stats(stat_date);
+CREATE INDEX idx_novel_ranking_snapshot_type_rank ON novel_ranking_snapshot(ranking_type, rank);
+CREATE INDEX idx_novel_ranking_snapshot_novel ON novel_ranking_snapshot(novel_id);
+
+-- ====================
+-- COMMENTS
+-- ====================
+
+COMMENT ON TABLE novel_daily_stats IS 'Thống kê tương tác theo ngày của novel, dùng để tính trending';
+COMMENT ON TABLE novel_ranking_snapshot IS 'Bảng xếp hạng novel được tính định kỳ theo từng loại ranking';
+COMMENT ON COLUMN novel_ranking_snapshot.score IS 'Điểm xếp hạng (time-decayed engagement hoặc tổng bookmark)';
+COMMENT ON COLUMN novel_ranking_snapshot.computed_at IS 'Thời điểm job tính toán snapshot';
@@ -0,0 +1,9 @@
+-- Rollback Migration 115: Remove Novel Recommendation System
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_novel_similarity_rank;
+DROP INDEX IF EXISTS idx_novel_bookmark_novel;
+
+-- Drop tables
+DROP TABLE IF EXISTS novel_similarity;
+DROP TABLE IF EXISTS novel_bookmark;
@@ -0,0 +1,59 @@
+-- Migration 115: Create Novel Recommendation System
+-- Reader bookmarks (co-bookmark signal) and nightly pre-computed similar-novel neighbours
+
+-- ==========================
+-- NOVEL BOOKMARK TABLE
+-- ==========================
+
+CREATE TABLE novel_bookmark (
+    user_id UUID NOT NULL,   -- Reader (identify service user ID)
+    novel_id UUID NOT NULL REFERENCES novel(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (user_id, novel_id)
+);
+
+-- ==========================
+-- NOVEL SIMILARITY TABLE
+-- ==========================
+
+-- Top neighbours per novel with the signals that produced the score, kept for explanations
+CREATE TABLE novel_similarity (
+    novel_id UUID NOT NULL REFERENCES novel(id) ON DELETE CASCADE,
+    similar_novel_id UUID NOT NULL REFERENCES novel(id) ON DELETE CASCADE,
+    rank INTEGER NOT NULL,
+    score DOUBLE PRECISION NOT NULL,
+
+    -- Component scores (0..1)
+    genre_score DOUBLE PRECISION NOT NULL DEFAULT 0,
+    tag_score DOUBLE PRECISION NOT NULL DEFAULT 0,
+    creator_score DOUBLE PRECISION NOT NULL DEFAULT 0,
+    cobookmark_score DOUBLE PRECISION NOT NULL DEFAULT 0,
+
+    -- Explanation evidence
+    shared_genre_ids UUID[] NOT NULL DEFAULT '{}',
+    shared_tags TEXT[] NOT NULL DEFAULT '{}',
+    shared_creator_ids UUID[] NOT NULL DEFAULT '{}',
+    cobookmark_count BIGINT NOT NULL DEFAULT 0,
+
+    computed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (novel_id, similar_novel_id),
+    CONSTRAINT check_similarity_not_self CHECK (novel_id <> similar_novel_id)
+);
+
+-- ====================
+-- INDEXES
+-- ====================
+
+CREATE INDEX idx_novel_bookmark_novel ON novel_bookmark(novel_id);
+CREATE INDEX idx_novel_similarity_rank ON novel_similarity(novel_id, rank);
+
+-- ====================
+-- COMMENTS
+-- ====================
+
+COMMENT ON TABLE novel_bookmark IS 'Novel được người đọc bookmark (dùng cho co-bookmark và thống kê)';
+COMMENT ON TABLE novel_similarity IS 'Danh sách novel tương tự được tính hàng đêm';
+COMMENT ON COLUMN novel_similarity.score IS 'Điểm tổng hợp từ genre, tag, creator và co-bookmark';
+COMMENT ON COLUMN novel_similarity.cobookmark_count IS 'Số người đọc bookmark cả hai novel';
@@ -0,0 +1,22 @@
+-- Rollback Migration 116: Remove Novel Analytics Aggregates
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_user_rental_date;
+DROP INDEX IF EXISTS idx_user_purchase_date;
+DROP INDEX IF EXISTS idx_novel_chapter_daily_stats_novel_date;
+DROP INDEX IF EXISTS idx_novel_reader_activity_date;
+DROP INDEX IF EXISTS idx_novel_reader_activity_novel_date;
+
+-- Drop tables
+DROP TABLE IF EXISTS novel_analytics_rollup_state;
+DROP TABLE IF EXISTS novel_weekly_stats;
+DROP TABLE IF EXISTS novel_chapter_daily_stats;
+DROP TABLE IF EXISTS novel_reader_activity;
+
+-- Drop columns
+ALTER TABLE user_content_rentals DROP COLUMN IF EXISTS price_coins;
+ALTER TABLE user_content_purchases DROP COLUMN IF EXISTS price_coins;
+ALTER TABLE novel_daily_stats DROP COLUMN IF EXISTS revenue_coins;
+ALTER TABLE novel_daily_stats DROP COLUMN IF EXISTS rental_count;
+ALTER TABLE novel_daily_stats DROP COLUMN IF EXISTS purchase_count;
+ALTER TABLE novel_daily_stats DROP COLUMN IF EXISTS unique_readers;
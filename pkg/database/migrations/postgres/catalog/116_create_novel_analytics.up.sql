@@ -0,0 +1,123 @@
+-- Migration 116: Create Novel Analytics Aggregates
+-- Pre-aggregated daily/weekly series for the owner analytics dashboard
+
+-- ==========================
+-- EXTEND DAILY STATS
+-- ==========================
+
+ALTER TABLE novel_daily_stats ADD COLUMN unique_readers BIGINT NOT NULL DEFAULT 0; -- Người đọc duy nhất trong ngày
+ALTER TABLE novel_daily_stats ADD COLUMN purchase_count BIGINT NOT NULL DEFAULT 0; -- Lượt mua trong ngày
+ALTER TABLE novel_daily_stats ADD COLUMN rental_count BIGINT NOT NULL DEFAULT 0;   -- Lượt thuê trong ngày
+ALTER TABLE novel_daily_stats ADD COLUMN revenue_coins BIGINT NOT NULL DEFAULT 0;  -- Doanh thu (coins) trong ngày
+
+-- ==========================
+-- PRICE PAID ON TRANSACTIONS
+-- ==========================
+
+-- Giá thực trả tại thời điểm giao dịch, cần cho tổng hợp doanh thu; NULL khi nơi ghi giao dịch chưa ghi giá,
+-- khi đó tổng hợp dùng giá niêm yết hiện tại của nội dung
+ALTER TABLE user_content_purchases ADD COLUMN price_coins INTEGER CHECK (price_coins >= 0);
+ALTER TABLE user_content_rentals ADD COLUMN price_coins INTEGER CHECK (price_coins >= 0);
+
+-- Giao dịch cũ không có giá thực trả: lấy giá niêm yết hiện tại của nội dung
+UPDATE user_content_purchases t
+SET price_coins = CASE t.item_type
+    WHEN 'NOVEL_SERIES' THEN (SELECT price_coins FROM novel WHERE id = t.item_id)
+    WHEN 'NOVEL_VOLUME' THEN (SELECT price_coins FROM novel_volume WHERE id = t.item_id)
+    WHEN 'NOVEL_CHAPTER' THEN (SELECT price_coins FROM novel_chapter WHERE id = t.item_id)
+    WHEN 'MANGA_VOLUME' THEN (SELECT price_coins FROM manga_volume WHERE id = t.item_id)
+    WHEN 'MANGA_CHAPTER' THEN (SELECT price_coins FROM manga_chapter WHERE id = t.item_id)
+    WHEN 'ANIME_SEASON' THEN (SELECT price_coins FROM anime_season WHERE id = t.item_id)
+    WHEN 'ANIME_EPISODE' THEN (SELECT price_coins FROM anime_episode WHERE id = t.item_id)
+END;
+
+UPDATE user_content_rentals t
+SET price_coins = CASE t.item_type
+    WHEN 'NOVEL_SERIES' THEN (SELECT rental_price_coins FROM novel WHERE id = t.item_id)
+    WHEN 'NOVEL_VOLUME' THEN (SELECT rental_price_coins FROM novel_volume WHERE id = t.item_id)
+    WHEN 'MANGA_VOLUME' THEN (SELECT rental_price_coins FROM manga_volume WHERE id = t.item_id)
+    WHEN 'ANIME_SEASON' THEN (SELECT rental_price_coins FROM anime_season WHERE id = t.item_id)
+END;
+
+-- ==========================
+-- NOVEL READER ACTIVITY TABLE
+-- ==========================
+
+-- One row per reader per chapter per day; source for unique-reader roll-ups, kept for a limited retention
+CREATE TABLE novel_reader_activity (
+    chapter_id UUID NOT NULL REFERENCES novel_chapter(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    stat_date DATE NOT NULL,
+    novel_id UUID NOT NULL REFERENCES novel(id) ON DELETE CASCADE,
+
+    PRIMARY KEY (chapter_id, user_id, stat_date)
+);
+
+-- ==========================
+-- CHAPTER DAILY STATS TABLE
+-- ==========================
+
+CREATE TABLE novel_chapter_daily_stats (
+    chapter_id UUID NOT NULL REFERENCES novel_chapter(id) ON DELETE CASCADE,
+    stat_date DATE NOT NULL,
+    novel_id UUID NOT NULL REFERENCES novel(id) ON DELETE CASCADE,
+
+    view_count BIGINT NOT NULL DEFAULT 0,     -- Lượt đọc chương trong ngày
+    unique_readers BIGINT NOT NULL DEFAULT 0, -- Người đọc duy nhất trong ngày
+
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (chapter_id, stat_date)
+);
+
+-- ==========================
+-- NOVEL WEEKLY STATS TABLE
+-- ==========================
+
+-- Unique readers are not additive across days, so weekly uniques are rolled up separately
+CREATE TABLE novel_weekly_stats (
+    novel_id UUID NOT NULL REFERENCES novel(id) ON DELETE CASCADE,
+    week_start DATE NOT NULL, -- Thứ hai đầu tuần (ISO week)
+
+    unique_readers BIGINT NOT NULL DEFAULT 0,
+
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (novel_id, week_start)
+);
+
+-- ==========================
+-- ROLLUP WATERMARK TABLE
+-- ==========================
+
+-- Single row holding the last day covered by the rollup job; while it is empty, the next
+-- run rolls up the whole purchase, rental and reader history
+CREATE TABLE novel_analytics_rollup_state (
+    id BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+    rolled_up_through DATE NOT NULL, -- Ngày cuối cùng đã được tổng hợp
+
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- ====================
+-- INDEXES
+-- ====================
+
+CREATE INDEX idx_novel_reader_activity_novel_date ON novel_reader_activity(novel_id, stat_date);
+CREATE INDEX idx_novel_reader_activity_date ON novel_reader_activity(stat_date);
+CREATE INDEX idx_novel_chapter_daily_stats_novel_date ON novel_chapter_daily_stats(novel_id, stat_date);
+CREATE INDEX idx_user_purchase_date ON user_content_purchases(purchase_date);
+CREATE INDEX idx_user_rental_date ON user_content_rentals(rent_date);
+
+-- ====================
+-- COMMENTS
+-- ====================
+
+COMMENT ON COLUMN novel_daily_stats.unique_readers IS 'Số người đọc duy nhất trong ngày (tổng hợp từ novel_reader_activity)';
+COMMENT ON COLUMN novel_daily_stats.revenue_coins IS 'Doanh thu từ mua và thuê trong ngày (coins)';
+COMMENT ON COLUMN user_content_purchases.price_coins IS 'Số coins người dùng đã trả cho giao dịch mua; nơi ghi giao dịch phải ghi cột này, NULL thì dùng giá niêm yết';
+COMMENT ON COLUMN user_content_rentals.price_coins IS 'Số coins người dùng đã trả cho giao dịch thuê; nơi ghi giao dịch phải ghi cột này, NULL thì dùng giá niêm yết';
+COMMENT ON TABLE novel_reader_activity IS 'Người đọc theo chương theo ngày, dùng để tính unique readers';
+COMMENT ON TABLE novel_chapter_daily_stats IS 'Thống kê đọc theo chương theo ngày, dùng cho drop-off';
+COMMENT ON TABLE novel_weekly_stats IS 'Số người đọc duy nhất theo tuần của novel';
+COMMENT ON TABLE novel_analytics_rollup_state IS 'Mốc tổng hợp của job rollup analytics (một dòng duy nhất)';
@@ -0,0 +1,21 @@
+-- Rollback Migration 117: Remove Content Reporting and Moderation Queue
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_user_warning_user;
+DROP INDEX IF EXISTS idx_moderation_audit_log_case;
+DROP INDEX IF EXISTS idx_content_report_reporter;
+DROP INDEX IF EXISTS idx_moderation_case_assigned;
+DROP INDEX IF EXISTS idx_moderation_case_queue;
+DROP INDEX IF EXISTS idx_moderation_case_open_target;
+
+-- Drop tables
+DROP TABLE IF EXISTS user_warning;
+DROP TABLE IF EXISTS moderation_audit_log;
+DROP TABLE IF EXISTS content_report;
+DROP TABLE IF EXISTS moderation_case;
+
+-- Drop enums
+DROP TYPE IF EXISTS moderation_action;
+DROP TYPE IF EXISTS moderation_case_status;
+DROP TYPE IF EXISTS report_reason;
+DROP TYPE IF EXISTS report_target_type;
@@ -0,0 +1,167 @@
+-- Migration 117: Create Content Reporting and Moderation Queue
+-- Reader reports aggregated into moderation cases, with claim/assign, resolution actions and audit trail
+
+-- Create report target type enum
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_type WHERE typname = 'report_target_type'
+    ) THEN
+        CREATE TYPE report_target_type AS ENUM (
+            'NOVEL',    -- Tiểu thuyết
+            'CHAPTER',  -- Chương tiểu thuyết
+            'COMMENT',  -- Bình luận (thuộc service khác)
+            'REVIEW',   -- Đánh giá (thuộc service khác)
+            'USER'      -- Người dùng (identify service)
+        );
+    END IF;
+END$$;
+
+-- Create report reason enum
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_type WHERE typname = 'report_reason'
+    ) THEN
+        CREATE TYPE report_reason AS ENUM (
+            'SPAM',             -- Spam, quảng cáo
+            'HARASSMENT',       -- Quấy rối, bắt nạt
+            'HATE_SPEECH',      -- Ngôn từ thù ghét
+            'SEXUAL_CONTENT',   -- Nội dung tình dục không gắn nhãn
+            'VIOLENCE',         -- Bạo lực
+            'COPYRIGHT',        -- Vi phạm bản quyền
+            'SPOILER',          -- Tiết lộ nội dung không cảnh báo
+            'IMPERSONATION',    -- Mạo danh
+            'OTHER'             -- Lý do khác (xem details)
+        );
+    END IF;
+END$$;
+
+-- Create moderation case status enum
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_type WHERE typname = 'moderation_case_status'
+    ) THEN
+        CREATE TYPE moderation_case_status AS ENUM (
+            'OPEN',       -- Chờ xử lý
+            'IN_REVIEW',  -- Đã có moderator nhận
+            'RESOLVED',   -- Đã xử lý bằng một hành động
+            'DISMISSED'   -- Bỏ qua, không vi phạm
+        );
+    END IF;
+END$$;
+
+-- Create moderation action enum
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_type WHERE typname = 'moderation_action'
+    ) THEN
+        CREATE TYPE moderation_action AS ENUM (
+            'HIDE_CONTENT',       -- Ẩn novel/chapter
+            'UNPUBLISH_CHAPTER',  -- Gỡ xuất bản chapter
+            'WARN_USER',          -- Cảnh cáo người dùng
+            'SUSPEND_USER',       -- Đình chỉ tài khoản (qua identify)
+            'DISMISS'             -- Không vi phạm
+        );
+    END IF;
+END$$;
+
+-- ==========================
+-- MODERATION CASE TABLE
+-- ==========================
+
+-- One case per reported target while it is open; duplicate reports are attached to it
+CREATE TABLE moderation_case (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+
+    -- Reported target (no hard FK, target may live in another service)
+    target_type report_target_type NOT NULL,
+    target_id UUID NOT NULL,
+
+    -- Aggregation
+    status moderation_case_status NOT NULL DEFAULT 'OPEN',
+    report_count INTEGER NOT NULL DEFAULT 0,
+    first_reported_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_reported_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    -- Claim / assignment
+    assigned_to UUID,          -- Moderator đang xử lý
+    assigned_at TIMESTAMPTZ,
+
+    -- Resolution
+    resolution_action moderation_action,
+    resolution_note TEXT,
+    resolved_by UUID,
+    resolved_at TIMESTAMPTZ,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- ==========================
+-- CONTENT REPORT TABLE
+-- ==========================
+
+CREATE TABLE content_report (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    case_id UUID NOT NULL REFERENCES moderation_case(id) ON DELETE CASCADE,
+    reporter_id UUID NOT NULL,  -- Người báo cáo (identify service user ID)
+    reason report_reason NOT NULL,
+    details TEXT,               -- Mô tả tự do của người báo cáo
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    -- Một người chỉ báo cáo một lần cho mỗi case
+    UNIQUE (case_id, reporter_id)
+);
+
+-- ==========================
+-- MODERATION AUDIT LOG TABLE
+-- ==========================
+
+CREATE TABLE moderation_audit_log (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    case_id UUID NOT NULL REFERENCES moderation_case(id) ON DELETE CASCADE,
+    actor_id UUID NOT NULL,       -- User thực hiện hành động
+    event VARCHAR(50) NOT NULL,   -- REPORTED, CLAIMED, ASSIGNED, RESOLVED, DISMISSED
+    details JSONB,                -- Dữ liệu bổ sung (action, note, assignee...)
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- ==========================
+-- USER WARNING TABLE
+-- ==========================
+
+CREATE TABLE user_warning (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    user_id UUID NOT NULL,        -- Người bị cảnh cáo
+    case_id UUID REFERENCES moderation_case(id) ON DELETE SET NULL,
+    moderator_id UUID NOT NULL,
+    message TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- ====================
+-- INDEXES
+-- ====================
+
+-- Chỉ một case đang mở cho mỗi target
+CREATE UNIQUE INDEX idx_moderation_case_open_target ON moderation_case(target_type, target_id)
+    WHERE status IN ('OPEN', 'IN_REVIEW');
+CREATE INDEX idx_moderation_case_queue ON moderation_case(status, report_count DESC, last_reported_at DESC);
+CREATE INDEX idx_moderation_case_assigned ON moderation_case(assigned_to) WHERE assigned_to IS NOT NULL;
+CREATE INDEX idx_content_report_reporter ON content_report(reporter_id);
+CREATE INDEX idx_moderation_audit_log_case ON moderation_audit_log(case_id, created_at);
+CREATE INDEX idx_user_warning_user ON user_warning(user_id, created_at DESC);
+
+-- ====================
+-- COMMENTS
+-- ====================
+
+COMMENT ON TABLE moderation_case IS 'Case kiểm duyệt gom các báo cáo trùng nhau trên cùng một đối tượng';
+COMMENT ON TABLE content_report IS 'Báo cáo vi phạm của người dùng, thuộc về một moderation case';
+COMMENT ON TABLE moderation_audit_log IS 'Lịch sử thao tác kiểm duyệt trên từng case';
+COMMENT ON TABLE user_warning IS 'Cảnh cáo moderator gửi tới người dùng';
+COMMENT ON COLUMN moderation_case.report_count IS 'Số báo cáo đã gom vào case';
+COMMENT ON COLUMN moderation_case.assigned_to IS 'Moderator đang nhận xử lý case';
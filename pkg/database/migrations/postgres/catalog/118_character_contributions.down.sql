@@ -0,0 +1,11 @@
+-- Rollback Migration 118: Remove Character Contribution and Approval Workflow
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_character_contributions_user;
+DROP INDEX IF EXISTS idx_character_contributions_character;
+DROP INDEX IF EXISTS idx_character_contributions_status;
+DROP INDEX IF EXISTS idx_character_translation_character;
+
+-- Drop tables
+DROP TABLE IF EXISTS character_contributions;
+DROP TABLE IF EXISTS character_translation;
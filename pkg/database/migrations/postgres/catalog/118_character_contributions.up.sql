@@ -0,0 +1,74 @@
+-- Migration 118: Character Contribution and Approval Workflow
+-- Localized character descriptions and user-submitted character proposals reviewed by moderators
+
+-- ==========================
+-- CHARACTER TRANSLATION TABLE
+-- ==========================
+
+-- Mô tả nhân vật theo ngôn ngữ (character.description là mô tả mặc định)
+CREATE TABLE character_translation (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    character_id UUID NOT NULL REFERENCES character(id) ON DELETE CASCADE,
+    language_code VARCHAR(5) NOT NULL,
+    description TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(character_id, language_code)
+);
+
+-- ==========================
+-- CHARACTER CONTRIBUTION TABLE
+-- ==========================
+
+-- Đề xuất tạo mới hoặc chỉnh sửa nhân vật của người dùng
+CREATE TABLE character_contributions (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+
+    -- Target: NULL for 'create' until the proposal is approved and merged
+    contribution_type VARCHAR(10) NOT NULL CHECK (contribution_type IN ('create', 'update')),
+    character_id UUID REFERENCES character(id) ON DELETE CASCADE,
+
+    -- Proposed values (NULL = unchanged for 'update')
+    name TEXT,
+    description TEXT,
+    image_url TEXT,
+    translations JSONB,        -- [{"language_code": "vi", "description": "..."}]
+    add_novel_ids UUID[],      -- Novel cần thêm vào novel_character
+    remove_novel_ids UUID[],   -- Novel cần gỡ khỏi novel_character
+    note TEXT,                 -- Ghi chú/nguồn của người đóng góp
+
+    -- Contributor information
+    user_id UUID NOT NULL,
+    tenant_id UUID,
+
+    -- Review workflow
+    status VARCHAR(20) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'approved', 'rejected')),
+    rejection_reason TEXT,
+    reviewer_id UUID,
+    reviewed_at TIMESTAMP,
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    CHECK (contribution_type = 'create' AND name IS NOT NULL OR contribution_type = 'update' AND character_id IS NOT NULL)
+);
+
+-- ====================
+-- INDEXES
+-- ====================
+
+CREATE INDEX idx_character_translation_character ON character_translation(character_id);
+CREATE INDEX idx_character_contributions_status ON character_contributions(status, created_at);
+CREATE INDEX idx_character_contributions_character ON character_contributions(character_id);
+CREATE INDEX idx_character_contributions_user ON character_contributions(user_id);
+
+-- ====================
+-- COMMENTS
+-- ====================
+
+COMMENT ON TABLE character_translation IS 'Mô tả nhân vật theo từng ngôn ngữ';
+COMMENT ON TABLE character_contributions IS 'Đề xuất tạo/sửa nhân vật của người dùng, chờ moderator duyệt';
+COMMENT ON COLUMN character_contributions.character_id IS 'Nhân vật được sửa; với đề xuất tạo mới được gán khi duyệt';
+COMMENT ON COLUMN character_contributions.translations IS 'Mô tả theo ngôn ngữ được đề xuất';
+COMMENT ON COLUMN character_contributions.user_id IS 'Người đóng góp, được ghi nhận khi đề xuất được duyệt';
@@ -0,0 +1,2 @@
+-- Rollback Migration 119: Remove Inverse Content Relation Types
+-- PostgreSQL cannot drop enum values; rows using them are removed by the rollback of migration 120.
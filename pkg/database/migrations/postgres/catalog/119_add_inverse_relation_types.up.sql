@@ -0,0 +1,7 @@
+-- Migration 119: Add Inverse Content Relation Types
+-- Inverse labels stored alongside every relation (SEQUEL <-> PREQUEL, ADAPTATION <-> SOURCE, SPINOFF <-> PARENT_STORY).
+-- Kept in a separate migration because new enum values cannot be used in the transaction that adds them.
+
+ALTER TYPE content_relation_type ADD VALUE IF NOT EXISTS 'PREQUEL';       -- target là phần trước của source
+ALTER TYPE content_relation_type ADD VALUE IF NOT EXISTS 'SOURCE';        -- target là nguyên tác của source
+ALTER TYPE content_relation_type ADD VALUE IF NOT EXISTS 'PARENT_STORY';  -- target là truyện gốc của ngoại truyện source
@@ -0,0 +1,8 @@
+-- Rollback Migration 120: Remove Content Relation Integrity
+
+-- Drop inverse-only rows
+DELETE FROM content_relation WHERE relation_type IN ('PREQUEL', 'SOURCE', 'PARENT_STORY');
+
+-- Drop constraints
+ALTER TABLE content_relation DROP CONSTRAINT IF EXISTS content_relation_not_self;
+ALTER TABLE content_relation DROP CONSTRAINT IF EXISTS content_relation_unique;
@@ -0,0 +1,54 @@
+-- Migration 120: Content Relation Integrity
+-- Deduplicate relations, enforce uniqueness and backfill inverse rows so every relation is readable from both ends
+
+-- ====================
+-- DEDUPLICATE
+-- ====================
+
+DELETE FROM content_relation a
+USING content_relation b
+WHERE a.source_type = b.source_type
+  AND a.source_id = b.source_id
+  AND a.target_type = b.target_type
+  AND a.target_id = b.target_id
+  AND a.relation_type = b.relation_type
+  AND a.id > b.id;
+
+-- Quan hệ tự trỏ về chính nó không có ý nghĩa
+DELETE FROM content_relation
+WHERE source_type = target_type AND source_id = target_id;
+
+-- ====================
+-- CONSTRAINTS
+-- ====================
+
+ALTER TABLE content_relation
+    ADD CONSTRAINT content_relation_unique UNIQUE (source_type, source_id, target_type, target_id, relation_type);
+
+ALTER TABLE content_relation
+    ADD CONSTRAINT content_relation_not_self CHECK (NOT (source_type = target_type AND source_id = target_id));
+
+-- ====================
+-- BACKFILL INVERSE RELATIONS
+-- ====================
+
+INSERT INTO content_relation (source_id, source_type, target_id, target_type, relation_type)
+SELECT target_id, target_type, source_id, source_type,
+       CASE relation_type
+           WHEN 'SEQUEL' THEN 'PREQUEL'::content_relation_type
+           WHEN 'PREQUEL' THEN 'SEQUEL'::content_relation_type
+           WHEN 'ADAPTATION' THEN 'SOURCE'::content_relation_type
+           WHEN 'SOURCE' THEN 'ADAPTATION'::content_relation_type
+           WHEN 'SPINOFF' THEN 'PARENT_STORY'::content_relation_type
+           WHEN 'PARENT_STORY' THEN 'SPINOFF'::content_relation_type
+           ELSE 'RELATED'::content_relation_type
+       END
+FROM content_relation
+ON CONFLICT ON CONSTRAINT content_relation_unique DO NOTHING;
+
+-- ====================
+-- COMMENTS
+-- ====================
+
+COMMENT ON CONSTRAINT content_relation_unique ON content_relation IS 'Mỗi cặp nội dung chỉ có một quan hệ cùng loại theo mỗi chiều';
+COMMENT ON COLUMN content_relation.relation_type IS 'Target là <relation_type> của source (vd. SEQUEL: target là phần tiếp theo); mỗi quan hệ có một dòng nghịch đảo';
@@ -0,0 +1,32 @@
+-- Rollback Migration 121: Remove Anime Module Columns
+-- Note: the 'ANIME' value added to content_type cannot be removed without recreating the type
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_anime_episode_published;
+DROP INDEX IF EXISTS idx_anime_broadcast;
+DROP INDEX IF EXISTS idx_anime_public_listing;
+DROP INDEX IF EXISTS idx_anime_owner_type_composite;
+DROP INDEX IF EXISTS idx_anime_primary_owner_id;
+
+-- Drop episode columns
+ALTER TABLE anime_episode
+    DROP COLUMN IF EXISTS last_modified_by_user_id,
+    DROP COLUMN IF EXISTS published_at,
+    DROP COLUMN IF EXISTS is_draft;
+
+-- Drop anime columns
+ALTER TABLE anime
+    DROP COLUMN IF EXISTS deleted_by_user_id,
+    DROP COLUMN IF EXISTS deleted_at,
+    DROP COLUMN IF EXISTS is_deleted,
+    DROP COLUMN IF EXISTS published_at,
+    DROP COLUMN IF EXISTS is_public,
+    DROP COLUMN IF EXISTS mature_content,
+    DROP COLUMN IF EXISTS age_rating,
+    DROP COLUMN IF EXISTS original_language,
+    DROP COLUMN IF EXISTS ownership_transferred_at,
+    DROP COLUMN IF EXISTS last_modified_by_user_id,
+    DROP COLUMN IF EXISTS access_level,
+    DROP COLUMN IF EXISTS original_creator_id,
+    DROP COLUMN IF EXISTS primary_owner_id,
+    DROP COLUMN IF EXISTS ownership_type;
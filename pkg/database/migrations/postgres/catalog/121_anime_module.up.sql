@@ -0,0 +1,59 @@
+-- Migration 121: Anime Module
+-- Brings anime to parity with novel: ownership, visibility, soft delete and episode publishing
+
+-- Collaborators and transfers can target anime
+ALTER TYPE content_type ADD VALUE IF NOT EXISTS 'ANIME';
+
+-- ====================
+-- ANIME TABLE CHANGES
+-- ====================
+
+-- Ownership (same model as novel, see migration 111). Rows created before this
+-- migration have no owner and stay admin-managed.
+ALTER TABLE anime
+    ADD COLUMN ownership_type ownership_type NOT NULL DEFAULT 'PERSONAL',
+    ADD COLUMN primary_owner_id UUID,
+    ADD COLUMN original_creator_id UUID,
+    ADD COLUMN access_level access_level NOT NULL DEFAULT 'PRIVATE',
+    ADD COLUMN last_modified_by_user_id UUID,
+    ADD COLUMN ownership_transferred_at TIMESTAMPTZ;
+
+-- Publishing, rating and visibility
+ALTER TABLE anime
+    ADD COLUMN original_language VARCHAR(5) DEFAULT 'ja', -- Ngôn ngữ gốc (ISO 639-1)
+    ADD COLUMN age_rating VARCHAR(10),                     -- G, PG, PG-13, R, NC-17
+    ADD COLUMN mature_content BOOLEAN DEFAULT FALSE,       -- Nội dung người lớn
+    ADD COLUMN is_public BOOLEAN DEFAULT FALSE,            -- Công khai hay riêng tư
+    ADD COLUMN published_at TIMESTAMP,                     -- Ngày phát hành trên hệ thống
+    ADD COLUMN is_deleted BOOLEAN DEFAULT FALSE,           -- Đã xóa (soft delete)
+    ADD COLUMN deleted_at TIMESTAMP,                       -- Thời gian xóa
+    ADD COLUMN deleted_by_user_id UUID;                    -- User thực hiện xóa
+
+CREATE INDEX idx_anime_primary_owner_id ON anime(primary_owner_id);
+CREATE INDEX idx_anime_owner_type_composite ON anime(ownership_type, primary_owner_id);
+CREATE INDEX idx_anime_public_listing ON anime(is_public, access_level) WHERE is_deleted = FALSE;
+CREATE INDEX idx_anime_broadcast ON anime(broadcast_year, broadcast_season);
+
+-- ============================
+-- ANIME EPISODE TABLE CHANGES
+-- ============================
+
+-- is_public keeps its meaning (free to watch); drafts are hidden from readers
+ALTER TABLE anime_episode
+    ADD COLUMN is_draft BOOLEAN DEFAULT TRUE,   -- Bản nháp
+    ADD COLUMN published_at TIMESTAMP,          -- Ngày phát hành tập
+    ADD COLUMN last_modified_by_user_id UUID;   -- User cập nhật cuối
+
+CREATE INDEX idx_anime_episode_published ON anime_episode(season_id, episode_number) WHERE is_draft = FALSE;
+
+-- ====================
+-- COMMENTS
+-- ====================
+
+COMMENT ON COLUMN anime.ownership_type IS 'Type of ownership: PERSONAL (individual), TENANT (organization), COLLABORATIVE (shared)';
+COMMENT ON COLUMN anime.primary_owner_id IS 'UUID of primary owner - user_id for PERSONAL, tenant_id for TENANT/COLLABORATIVE; NULL for legacy admin-managed rows';
+COMMENT ON COLUMN anime.original_creator_id IS 'UUID of the user who originally created the anime entry - never changes';
+COMMENT ON COLUMN anime.access_level IS 'Access level: PRIVATE (owner only), TENANT_ONLY (tenant members), PUBLIC (everyone)';
+COMMENT ON COLUMN anime.is_public IS 'Whether the anime is listed publicly (requires access_level PUBLIC as well)';
+COMMENT ON COLUMN anime_episode.is_draft IS 'Draft episodes are only visible to owners and collaborators';
+COMMENT ON COLUMN anime_episode.published_at IS 'When the episode was published';
@@ -0,0 +1,56 @@
+-- Migration 122: Manga Module
+-- Brings manga to parity with novel: ownership, visibility, soft delete and chapter publishing
+
+-- Collaborators and transfers can target manga
+ALTER TYPE content_type ADD VALUE IF NOT EXISTS 'MANGA';
+
+-- ====================
+-- MANGA TABLE CHANGES
+-- ====================
+
+-- Ownership (same model as novel, see migration 111). Rows created before this
+-- migration have no owner and stay admin-managed.
+ALTER TABLE manga
+    ADD COLUMN ownership_type ownership_type NOT NULL DEFAULT 'PERSONAL',
+    ADD COLUMN primary_owner_id UUID,
+    ADD COLUMN original_creator_id UUID,
+    ADD COLUMN access_level access_level NOT NULL DEFAULT 'PRIVATE',
+    ADD COLUMN last_modified_by_user_id UUID,
+    ADD COLUMN ownership_transferred_at TIMESTAMPTZ;
+
+-- Publishing, rating and visibility
+ALTER TABLE manga
+    ADD COLUMN original_language VARCHAR(5) DEFAULT 'ja', -- Ngôn ngữ gốc (ISO 639-1)
+    ADD COLUMN age_rating VARCHAR(10),                     -- G, PG, PG-13, R, NC-17
+    ADD COLUMN mature_content BOOLEAN DEFAULT FALSE,       -- Nội dung người lớn
+    ADD COLUMN is_public BOOLEAN DEFAULT FALSE,            -- Công khai hay riêng tư
+    ADD COLUMN published_at TIMESTAMP,                     -- Ngày phát hành trên hệ thống
+    ADD COLUMN is_deleted BOOLEAN DEFAULT FALSE,           -- Đã xóa (soft delete)
+    ADD COLUMN deleted_at TIMESTAMP,                       -- Thời gian xóa
+    ADD COLUMN deleted_by_user_id UUID;                    -- User thực hiện xóa
+
+CREATE INDEX idx_manga_primary_owner_id ON manga(primary_owner_id);
+CREATE INDEX idx_manga_owner_type_composite ON manga(ownership_type, primary_owner_id);
+CREATE INDEX idx_manga_public_listing ON manga(is_public, access_level) WHERE is_deleted = FALSE;
+
+-- ============================
+-- MANGA CHAPTER TABLE CHANGES
+-- ============================
+
+-- is_public keeps its meaning (free to read); released_at doubles as the publish time
+ALTER TABLE manga_chapter
+    ADD COLUMN is_draft BOOLEAN DEFAULT TRUE,   -- Bản nháp
+    ADD COLUMN last_modified_by_user_id UUID;   -- User cập nhật cuối
+
+CREATE INDEX idx_manga_chapter_published ON manga_chapter(volume_id, chapter_number) WHERE is_draft = FALSE;
+
+-- ====================
+-- COMMENTS
+-- ====================
+
+COMMENT ON COLUMN manga.ownership_type IS 'Type of ownership: PERSONAL (individual), TENANT (organization), COLLABORATIVE (shared)';
+COMMENT ON COLUMN manga.primary_owner_id IS 'UUID of primary owner - user_id for PERSONAL, tenant_id for TENANT/COLLABORATIVE; NULL for legacy admin-managed rows';
+COMMENT ON COLUMN manga.original_creator_id IS 'UUID of the user who originally created the manga entry - never changes';
+COMMENT ON COLUMN manga.access_level IS 'Access level: PRIVATE (owner only), TENANT_ONLY (tenant members), PUBLIC (everyone)';
+COMMENT ON COLUMN manga.is_public IS 'Whether the manga is listed publicly (requires access_level PUBLIC as well)';
+COMMENT ON COLUMN manga_chapter.is_draft IS 'Draft chapters are only visible to owners and collaborators';
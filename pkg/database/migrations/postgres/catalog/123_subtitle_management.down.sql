@@ -0,0 +1,13 @@
+-- Rollback Migration 123: Remove Subtitle Management
+
+DROP INDEX IF EXISTS idx_subtitle_contributions_user;
+DROP INDEX IF EXISTS idx_subtitle_contributions_status;
+DROP INDEX IF EXISTS idx_subtitle_contributions_episode;
+
+DROP TABLE IF EXISTS subtitle_contributions;
+
+ALTER TABLE episode_subtitle
+    DROP COLUMN IF EXISTS uploaded_by_user_id,
+    DROP COLUMN IF EXISTS cue_count,
+    DROP COLUMN IF EXISTS content,
+    DROP COLUMN IF EXISTS source_format;
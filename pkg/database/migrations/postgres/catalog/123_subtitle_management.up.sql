@@ -0,0 +1,62 @@
+-- Migration 123: Subtitle Management
+-- Hosted subtitles normalized to WebVTT, and community subtitle contributions reviewed by the anime's managers
+
+-- ==========================
+-- EPISODE SUBTITLE: HOSTED CONTENT
+-- ==========================
+
+-- subtitle_url vẫn dùng cho phụ đề lưu bên ngoài; content chứa phụ đề đã chuẩn hóa sang WebVTT
+ALTER TABLE episode_subtitle
+    ADD COLUMN source_format VARCHAR(10) CHECK (source_format IN ('srt', 'vtt', 'ass')),
+    ADD COLUMN content TEXT,
+    ADD COLUMN cue_count INTEGER CHECK (cue_count >= 0),
+    ADD COLUMN uploaded_by_user_id UUID;
+
+-- ==========================
+-- SUBTITLE CONTRIBUTION TABLE
+-- ==========================
+
+-- Phụ đề do cộng đồng đóng góp, chờ chủ sở hữu / cộng tác viên của anime duyệt
+CREATE TABLE subtitle_contributions (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    episode_id UUID NOT NULL REFERENCES anime_episode(id) ON DELETE CASCADE,
+    language_code VARCHAR(10) NOT NULL,
+
+    -- Proposed subtitle, already parsed, validated and normalized to WebVTT
+    source_format VARCHAR(10) NOT NULL CHECK (source_format IN ('srt', 'vtt', 'ass')),
+    content TEXT NOT NULL,
+    cue_count INTEGER NOT NULL CHECK (cue_count > 0),
+    note TEXT,                 -- Ghi chú/nguồn của người đóng góp
+
+    -- Contributor information
+    user_id UUID NOT NULL,
+    tenant_id UUID,
+
+    -- Review workflow
+    status VARCHAR(20) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'approved', 'rejected')),
+    rejection_reason TEXT,
+    reviewer_id UUID,
+    reviewed_at TIMESTAMP,
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- ====================
+-- INDEXES
+-- ====================
+
+CREATE INDEX idx_subtitle_contributions_episode ON subtitle_contributions(episode_id, status);
+CREATE INDEX idx_subtitle_contributions_status ON subtitle_contributions(status, created_at);
+CREATE INDEX idx_subtitle_contributions_user ON subtitle_contributions(user_id);
+
+-- ====================
+-- COMMENTS
+-- ====================
+
+COMMENT ON COLUMN episode_subtitle.source_format IS 'Định dạng file gốc được tải lên (srt, vtt, ass)';
+COMMENT ON COLUMN episode_subtitle.content IS 'Phụ đề đã chuẩn hóa sang WebVTT; NULL khi chỉ lưu subtitle_url';
+COMMENT ON COLUMN episode_subtitle.uploaded_by_user_id IS 'Người tải lên hoặc người đóng góp được duyệt';
+COMMENT ON TABLE subtitle_contributions IS 'Phụ đề do người dùng đóng góp, chờ chủ sở hữu hoặc cộng tác viên anime duyệt';
+COMMENT ON COLUMN subtitle_contributions.content IS 'Phụ đề đề xuất, đã chuẩn hóa sang WebVTT';
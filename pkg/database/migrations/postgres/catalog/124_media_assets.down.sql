@@ -0,0 +1,5 @@
+-- Rollback Migration 124: Remove Media Assets
+
+DROP INDEX IF EXISTS idx_media_assets_uploader;
+
+DROP TABLE IF EXISTS media_assets;
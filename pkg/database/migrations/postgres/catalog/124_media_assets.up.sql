@@ -0,0 +1,35 @@
+-- Migration 124: Media Assets
+-- Uploaded files kept in object storage (local filesystem or S3-compatible), deduplicated by checksum
+
+-- ==========================
+-- MEDIA ASSET TABLE
+-- ==========================
+
+-- Mỗi file chỉ lưu một lần: upload trùng checksum dùng lại asset có sẵn
+CREATE TABLE media_assets (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    checksum_sha256 CHAR(64) NOT NULL UNIQUE,
+    storage_key VARCHAR(512) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,   -- Nhận diện từ nội dung file, không tin header của client
+    size_bytes BIGINT NOT NULL CHECK (size_bytes > 0),
+    original_filename VARCHAR(255),
+
+    -- Uploader information
+    uploaded_by_user_id UUID NOT NULL,
+    tenant_id UUID,
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- ====================
+-- INDEXES
+-- ====================
+
+CREATE INDEX idx_media_assets_uploader ON media_assets(uploaded_by_user_id, created_at DESC);
+
+-- ====================
+-- COMMENTS
+-- ====================
+
+COMMENT ON TABLE media_assets IS 'File tải lên được lưu trong object storage, khử trùng lặp theo SHA-256';
+COMMENT ON COLUMN media_assets.storage_key IS 'Khóa object trong storage backend, suy ra từ checksum';
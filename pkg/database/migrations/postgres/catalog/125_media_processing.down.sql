@@ -0,0 +1,17 @@
+-- Rollback Migration 125: Remove Media Processing
+
+DROP INDEX IF EXISTS idx_media_attachments_asset;
+DROP INDEX IF EXISTS idx_media_assets_processing;
+
+DROP TABLE IF EXISTS media_attachments;
+DROP TABLE IF EXISTS media_variants;
+
+ALTER TABLE media_assets
+    DROP COLUMN IF EXISTS processed_at,
+    DROP COLUMN IF EXISTS processing_started_at,
+    DROP COLUMN IF EXISTS processing_error,
+    DROP COLUMN IF EXISTS attempts,
+    DROP COLUMN IF EXISTS processing_status,
+    DROP COLUMN IF EXISTS purposes,
+    DROP COLUMN IF EXISTS height,
+    DROP COLUMN IF EXISTS width;
@@ -0,0 +1,71 @@
+-- Migration 125: Media Processing
+-- Image dimensions, asynchronous rendition queue, derived variants and entity image attachments
+
+-- ==========================
+-- MEDIA ASSET PROCESSING COLUMNS
+-- ==========================
+
+ALTER TABLE media_assets
+    ADD COLUMN width INTEGER CHECK (width > 0),
+    ADD COLUMN height INTEGER CHECK (height > 0),
+    ADD COLUMN purposes VARCHAR(20)[] NOT NULL DEFAULT ARRAY['generic']::VARCHAR(20)[],  -- generic, cover, avatar, page
+    ADD COLUMN processing_status VARCHAR(20) NOT NULL DEFAULT 'skipped'
+        CHECK (processing_status IN ('pending', 'processing', 'ready', 'failed', 'skipped')),
+    ADD COLUMN attempts INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN processing_error TEXT,
+    ADD COLUMN processing_started_at TIMESTAMP,
+    ADD COLUMN processed_at TIMESTAMP;
+
+-- Ảnh upload trước migration này được xếp hàng để sinh bản thu nhỏ
+UPDATE media_assets SET processing_status = 'pending'
+WHERE content_type IN ('image/jpeg', 'image/png', 'image/gif');
+
+-- ==========================
+-- MEDIA VARIANT TABLE
+-- ==========================
+
+-- Bản thu nhỏ / bản resize sinh từ ảnh gốc, mỗi tên một bản
+CREATE TABLE media_variants (
+    asset_id UUID NOT NULL REFERENCES media_assets(id) ON DELETE CASCADE,
+    name VARCHAR(50) NOT NULL,            -- cover_small, avatar_128, page_display...
+    storage_key VARCHAR(512) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    width INTEGER NOT NULL CHECK (width > 0),
+    height INTEGER NOT NULL CHECK (height > 0),
+    size_bytes BIGINT NOT NULL CHECK (size_bytes > 0),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (asset_id, name)
+);
+
+-- ==========================
+-- MEDIA ATTACHMENT TABLE
+-- ==========================
+
+-- Ảnh đang gắn vào một vị trí của entity (bìa novel, bìa volume, ảnh nhân vật)
+CREATE TABLE media_attachments (
+    kind VARCHAR(30) NOT NULL CHECK (kind IN ('novel_cover', 'volume_cover', 'character_image')),
+    entity_id UUID NOT NULL,
+    asset_id UUID NOT NULL REFERENCES media_assets(id) ON DELETE CASCADE,
+    attached_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (kind, entity_id)
+);
+
+-- ====================
+-- INDEXES
+-- ====================
+
+-- Hàng đợi xử lý: chỉ quét các asset chưa xong
+CREATE INDEX idx_media_assets_processing ON media_assets(processing_status, created_at)
+    WHERE processing_status IN ('pending', 'processing');
+CREATE INDEX idx_media_attachments_asset ON media_attachments(asset_id);
+
+-- ====================
+-- COMMENTS
+-- ====================
+
+COMMENT ON COLUMN media_assets.purposes IS 'Mục đích upload, quyết định quy tắc kích thước và các bản thu nhỏ cần sinh';
+COMMENT ON COLUMN media_assets.processing_status IS 'pending -> processing -> ready | failed; skipped với file không phải ảnh hoặc không giải mã được';
+COMMENT ON TABLE media_variants IS 'Các bản thu nhỏ / resize của ảnh, sinh bởi job xử lý media';
+COMMENT ON TABLE media_attachments IS 'Ảnh đã upload được gắn vào novel, volume hoặc nhân vật';
@@ -0,0 +1,5 @@
+-- Rollback Migration 126: Remove Chapter Translations
+
+DROP INDEX IF EXISTS idx_novel_chapter_translation_language;
+
+DROP TABLE IF EXISTS novel_chapter_translation;
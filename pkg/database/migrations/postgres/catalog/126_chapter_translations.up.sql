@@ -0,0 +1,36 @@
+-- Migration 126: Chapter Translations
+-- Translated chapter bodies per language; localized novel titles and summaries stay in novel_translation
+
+-- ==========================
+-- NOVEL CHAPTER TRANSLATION TABLE
+-- ==========================
+
+-- Bản dịch của một chapter theo từng ngôn ngữ; ngôn ngữ gốc nằm trong novel_chapter
+CREATE TABLE novel_chapter_translation (
+    chapter_id UUID NOT NULL REFERENCES novel_chapter(id) ON DELETE CASCADE,
+    language_code VARCHAR(5) NOT NULL,
+
+    -- Translated content
+    title TEXT,
+    content JSONB NOT NULL,    -- Nội dung đã dịch (Plate editor)
+
+    -- Content metadata, tính lại mỗi lần lưu
+    word_count INTEGER CHECK (word_count >= 0),
+    character_count INTEGER CHECK (character_count >= 0),
+    reading_time_minutes INTEGER CHECK (reading_time_minutes >= 0),
+
+    translated_by_user_id UUID, -- Người cập nhật bản dịch gần nhất
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (chapter_id, language_code)
+);
+
+COMMENT ON TABLE novel_chapter_translation IS 'Translated chapter bodies, one row per chapter and language.';
+
+-- ====================
+-- INDEXES
+-- ====================
+
+CREATE INDEX idx_novel_chapter_translation_language ON novel_chapter_translation(language_code);
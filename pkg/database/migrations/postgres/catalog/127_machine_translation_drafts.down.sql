@@ -0,0 +1,7 @@
+-- Rollback Migration 127: Remove Machine Translation Drafts
+
+DROP INDEX IF EXISTS idx_novel_glossary_terms_unique;
+
+ALTER TABLE translation_contributions DROP COLUMN IF EXISTS machine_provider;
+
+DROP TABLE IF EXISTS novel_glossary_terms;
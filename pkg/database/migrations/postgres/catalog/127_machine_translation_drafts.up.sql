@@ -0,0 +1,37 @@
+-- Migration 127: Machine Translation Drafts
+-- Per-novel glossary of fixed terms and provider tracking for machine-made contributions
+
+-- ==========================
+-- NOVEL GLOSSARY TERMS TABLE
+-- ==========================
+
+-- Bảng thuật ngữ cố định của một novel theo ngôn ngữ đích (tên nhân vật, địa danh, chiêu thức...)
+CREATE TABLE novel_glossary_terms (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    novel_id UUID NOT NULL REFERENCES novel(id) ON DELETE CASCADE,
+    language_code VARCHAR(5) NOT NULL, -- Ngôn ngữ đích của bản dịch
+
+    source_term TEXT NOT NULL CHECK (length(trim(source_term)) > 0), -- Thuật ngữ trong ngôn ngữ gốc
+    translation TEXT NOT NULL CHECK (length(trim(translation)) > 0), -- Bản dịch bắt buộc
+
+    created_by_user_id UUID, -- Người cập nhật bảng thuật ngữ gần nhất
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+COMMENT ON TABLE novel_glossary_terms IS 'Fixed translations of novel terms, honoured by machine-translated drafts.';
+
+-- ==========================
+-- TRANSLATION CONTRIBUTIONS
+-- ==========================
+
+-- Provider đã sinh bản nháp máy dịch (NULL với bản dịch thủ công)
+ALTER TABLE translation_contributions ADD COLUMN machine_provider VARCHAR(50);
+
+-- ====================
+-- INDEXES
+-- ====================
+
+-- Mỗi thuật ngữ chỉ có một bản dịch trong một ngôn ngữ (không phân biệt hoa thường)
+CREATE UNIQUE INDEX idx_novel_glossary_terms_unique ON novel_glossary_terms(novel_id, language_code, lower(source_term));
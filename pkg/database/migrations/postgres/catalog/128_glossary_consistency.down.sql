@@ -0,0 +1,11 @@
+-- Rollback Migration 128: Remove Glossary Consistency
+
+DROP INDEX IF EXISTS idx_novel_glossary_terms_character;
+
+ALTER TABLE translation_contributions
+    DROP COLUMN IF EXISTS glossary_violation_count,
+    DROP COLUMN IF EXISTS reviewed_at;
+
+ALTER TABLE novel_glossary_terms
+    DROP COLUMN IF EXISTS character_id,
+    DROP COLUMN IF EXISTS notes;
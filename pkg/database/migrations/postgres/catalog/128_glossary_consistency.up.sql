@@ -0,0 +1,24 @@
+-- Migration 128: Glossary Consistency
+-- Notes and character links on glossary terms, review metadata and glossary checks on translation contributions
+
+-- ==========================
+-- NOVEL GLOSSARY TERMS
+-- ==========================
+
+ALTER TABLE novel_glossary_terms
+    ADD COLUMN notes TEXT, -- Ghi chú cho người dịch (ngữ cảnh, cách xưng hô...)
+    ADD COLUMN character_id UUID REFERENCES character(id) ON DELETE SET NULL; -- Nhân vật mà thuật ngữ chỉ tới (nếu có)
+
+-- ==========================
+-- TRANSLATION CONTRIBUTIONS
+-- ==========================
+
+ALTER TABLE translation_contributions
+    ADD COLUMN reviewed_at TIMESTAMP, -- Thời điểm duyệt hoặc từ chối
+    ADD COLUMN glossary_violation_count INTEGER NOT NULL DEFAULT 0 CHECK (glossary_violation_count >= 0); -- Số lỗi thuật ngữ lúc gửi/cập nhật gần nhất
+
+-- ====================
+-- INDEXES
+-- ====================
+
+CREATE INDEX idx_novel_glossary_terms_character ON novel_glossary_terms(character_id) WHERE character_id IS NOT NULL;
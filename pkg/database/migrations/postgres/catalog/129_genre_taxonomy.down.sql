@@ -0,0 +1,12 @@
+-- Rollback Migration 129: Remove Genre Taxonomy
+
+DROP INDEX IF EXISTS idx_genre_sort;
+DROP INDEX IF EXISTS idx_genre_parent;
+
+DROP TABLE IF EXISTS genre_translation;
+
+ALTER TABLE genre
+    DROP CONSTRAINT IF EXISTS genre_parent_not_self,
+    DROP COLUMN IF EXISTS sort_order,
+    DROP COLUMN IF EXISTS description,
+    DROP COLUMN IF EXISTS parent_id;
@@ -0,0 +1,37 @@
+-- Migration 129: Genre Taxonomy
+-- Parent/child genres, ordering weight, descriptions and per-language genre names
+
+-- ====================
+-- GENRE HIERARCHY
+-- ====================
+
+ALTER TABLE genre
+    ADD COLUMN parent_id UUID REFERENCES genre(id), -- Thể loại cha (NULL: thể loại gốc), ví dụ Fantasy > Isekai
+    ADD COLUMN description TEXT, -- Mô tả thể loại (ngôn ngữ mặc định)
+    ADD COLUMN sort_order INTEGER NOT NULL DEFAULT 0, -- Trọng số sắp xếp giữa các thể loại cùng cấp (nhỏ trước)
+    ADD CONSTRAINT genre_parent_not_self CHECK (parent_id IS NULL OR parent_id <> id);
+
+COMMENT ON COLUMN genre.parent_id IS 'Parent genre; NULL for top-level genres.';
+COMMENT ON COLUMN genre.sort_order IS 'Ordering weight among sibling genres, lowest first.';
+
+-- ====================
+-- GENRE TRANSLATIONS
+-- ====================
+
+CREATE TABLE genre_translation (
+    genre_id UUID NOT NULL REFERENCES genre(id) ON DELETE CASCADE,
+    language_code VARCHAR(5) NOT NULL, -- Mã ngôn ngữ (vi, en, ja...)
+    name TEXT NOT NULL, -- Tên thể loại theo ngôn ngữ
+    description TEXT, -- Mô tả theo ngôn ngữ
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (genre_id, language_code)
+);
+COMMENT ON TABLE genre_translation IS 'Localized genre names and descriptions.';
+
+-- ====================
+-- INDEXES
+-- ====================
+
+CREATE INDEX idx_genre_parent ON genre(parent_id) WHERE parent_id IS NOT NULL;
+CREATE INDEX idx_genre_sort ON genre(sort_order, name);
@@ -0,0 +1,10 @@
+-- Rollback Migration 130: Remove Tag Registry
+-- novel.tags keeps the canonical names written by the registry
+
+DROP INDEX IF EXISTS idx_tag_alias_tag;
+DROP INDEX IF EXISTS idx_tag_alias_prefix;
+DROP INDEX IF EXISTS idx_tag_usage;
+DROP INDEX IF EXISTS idx_tag_normalized_prefix;
+
+DROP TABLE IF EXISTS tag_alias;
+DROP TABLE IF EXISTS tag;
@@ -0,0 +1,78 @@
+-- Migration 130: Tag Registry
+-- Canonical tags with aliases and cross-language synonyms; novel.tags holds canonical names only
+
+-- ====================
+-- TAGS
+-- ====================
+
+CREATE TABLE tag (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    name VARCHAR(100) NOT NULL, -- Tên chuẩn, đúng chuỗi được lưu trong novel.tags
+    normalized_name VARCHAR(100) NOT NULL UNIQUE, -- Tên chuẩn viết thường, gộp khoảng trắng (khóa so khớp)
+    language_code VARCHAR(5), -- Ngôn ngữ của tên chuẩn (NULL: không xác định)
+    description TEXT, -- Mô tả tag cho người kiểm duyệt và tác giả
+    usage_count INTEGER NOT NULL DEFAULT 0, -- Số novel (chưa xóa) đang dùng tag, dùng để xếp hạng gợi ý
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+COMMENT ON TABLE tag IS 'Registry of canonical content tags.';
+COMMENT ON COLUMN tag.normalized_name IS 'Lowercased name with collapsed whitespace; the lookup key shared with tag_alias.';
+
+-- ====================
+-- TAG ALIASES
+-- ====================
+
+CREATE TABLE tag_alias (
+    normalized_alias VARCHAR(100) PRIMARY KEY, -- Bí danh viết thường, gộp khoảng trắng
+    tag_id UUID NOT NULL REFERENCES tag(id) ON DELETE CASCADE,
+    alias VARCHAR(100) NOT NULL, -- Bí danh như người dùng nhập (ví dụ "Tái sinh" cho "Reincarnation")
+    language_code VARCHAR(5), -- Có mã ngôn ngữ: từ đồng nghĩa trong ngôn ngữ đó; NULL: biến thể chính tả
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+COMMENT ON TABLE tag_alias IS 'Alternative spellings and other-language synonyms resolving to a canonical tag.';
+
+-- ====================
+-- INDEXES
+-- ====================
+
+CREATE INDEX idx_tag_normalized_prefix ON tag(normalized_name text_pattern_ops);
+CREATE INDEX idx_tag_usage ON tag(usage_count DESC, name);
+CREATE INDEX idx_tag_alias_prefix ON tag_alias(normalized_alias text_pattern_ops);
+CREATE INDEX idx_tag_alias_tag ON tag_alias(tag_id);
+
+-- ====================
+-- BACKFILL
+-- ====================
+
+-- Đăng ký các tag đang dùng; biến thể chỉ khác hoa/thường hoặc khoảng trắng gộp về một tag
+INSERT INTO tag (name, normalized_name)
+SELECT DISTINCT ON (normalized_name) name, normalized_name
+FROM (
+    SELECT
+        regexp_replace(btrim(t.value), '\s+', ' ', 'g') AS name,
+        lower(regexp_replace(btrim(t.value), '\s+', ' ', 'g')) AS normalized_name
+    FROM novel n
+    CROSS JOIN LATERAL jsonb_array_elements_text(
+        CASE WHEN jsonb_typeof(n.tags) = 'array' THEN n.tags ELSE '[]'::jsonb END
+    ) AS t(value)
+) used
+WHERE normalized_name <> '' AND length(normalized_name) <= 100
+ORDER BY normalized_name, name;
+
+-- Ghi lại novel.tags bằng tên chuẩn, bỏ trùng và giữ thứ tự xuất hiện đầu tiên
+UPDATE novel n
+SET tags = (
+    SELECT COALESCE(jsonb_agg(canonical.name ORDER BY canonical.position), '[]'::jsonb)
+    FROM (
+        SELECT DISTINCT ON (tg.name) tg.name, e.position
+        FROM jsonb_array_elements_text(n.tags) WITH ORDINALITY AS e(value, position)
+        JOIN tag tg ON tg.normalized_name = lower(regexp_replace(btrim(e.value), '\s+', ' ', 'g'))
+        ORDER BY tg.name, e.position
+    ) canonical
+)
+WHERE jsonb_typeof(n.tags) = 'array';
+
+UPDATE tag t
+SET usage_count = (
+    SELECT COUNT(*) FROM novel n WHERE n.is_deleted = false AND n.tags ? t.name
+);
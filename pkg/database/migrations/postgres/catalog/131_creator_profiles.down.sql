@@ -0,0 +1,21 @@
+-- Rollback Migration 131: Remove Creator Profiles
+
+DROP INDEX IF EXISTS idx_anime_creator_creator;
+DROP INDEX IF EXISTS idx_manga_creator_creator;
+DROP INDEX IF EXISTS idx_novel_creator_creator;
+DROP INDEX IF EXISTS idx_creator_claim_pending;
+DROP INDEX IF EXISTS idx_creator_claim_user;
+DROP INDEX IF EXISTS idx_creator_claim_queue;
+DROP INDEX IF EXISTS idx_creator_alias_lookup;
+DROP INDEX IF EXISTS idx_creator_tenant;
+DROP INDEX IF EXISTS idx_creator_user;
+
+DROP TABLE IF EXISTS creator_claim;
+DROP TABLE IF EXISTS creator_alias;
+DROP TABLE IF EXISTS creator_translation;
+
+ALTER TABLE creator
+    DROP COLUMN IF EXISTS social_links,
+    DROP COLUMN IF EXISTS claimed_at,
+    DROP COLUMN IF EXISTS tenant_id,
+    DROP COLUMN IF EXISTS user_id;
@@ -0,0 +1,78 @@
+-- Migration 131: Creator Profiles
+-- Claimable creator pages: account links, localized biographies, social links, pen names and claim review
+
+-- ====================
+-- CREATOR ACCOUNT LINK
+-- ====================
+
+ALTER TABLE creator
+    ADD COLUMN user_id UUID, -- Tài khoản đã nhận creator (được duyệt)
+    ADD COLUMN tenant_id UUID, -- Tenant đã nhận creator (studio, nhà xuất bản)
+    ADD COLUMN claimed_at TIMESTAMP, -- Thời điểm yêu cầu nhận được duyệt
+    ADD COLUMN social_links JSONB NOT NULL DEFAULT '[]'::jsonb; -- [{platform, url}]
+
+COMMENT ON COLUMN creator.user_id IS 'User account that manages this creator page, set by an approved claim.';
+COMMENT ON COLUMN creator.tenant_id IS 'Tenant that manages this creator page, set by an approved claim made on its behalf.';
+
+-- ====================
+-- CREATOR BIOGRAPHIES
+-- ====================
+
+CREATE TABLE creator_translation (
+    creator_id UUID NOT NULL REFERENCES creator(id) ON DELETE CASCADE,
+    language_code VARCHAR(5) NOT NULL, -- Mã ngôn ngữ (vi, en, ja...)
+    biography TEXT NOT NULL, -- Tiểu sử theo ngôn ngữ; creator.description là tiểu sử mặc định
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (creator_id, language_code)
+);
+COMMENT ON TABLE creator_translation IS 'Localized creator biographies.';
+
+-- ====================
+-- CREATOR ALIASES
+-- ====================
+
+CREATE TABLE creator_alias (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    creator_id UUID NOT NULL REFERENCES creator(id) ON DELETE CASCADE,
+    alias TEXT NOT NULL, -- Bút danh hoặc tên khác (tên gốc, phiên âm...)
+    alias_type VARCHAR(20) NOT NULL DEFAULT 'ALIAS' CHECK (alias_type IN ('ALIAS', 'PEN_NAME')),
+    language_code VARCHAR(5), -- Ngôn ngữ/hệ chữ của tên (NULL: không xác định)
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (creator_id, alias)
+);
+COMMENT ON TABLE creator_alias IS 'Pen names and alternative names of a creator.';
+
+-- ====================
+-- CREATOR CLAIMS
+-- ====================
+
+CREATE TABLE creator_claim (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    creator_id UUID NOT NULL REFERENCES creator(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL, -- Người gửi yêu cầu
+    tenant_id UUID, -- Có giá trị: nhận creator cho tenant của người gửi
+    evidence TEXT NOT NULL, -- Bằng chứng xác minh (liên kết mạng xã hội, email nhà xuất bản...)
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'rejected')),
+    rejection_reason TEXT,
+    reviewer_id UUID,
+    reviewed_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+COMMENT ON TABLE creator_claim IS 'Requests to link a creator page to a user or tenant, reviewed by moderators.';
+
+-- ====================
+-- INDEXES
+-- ====================
+
+CREATE INDEX idx_creator_user ON creator(user_id) WHERE user_id IS NOT NULL;
+CREATE INDEX idx_creator_tenant ON creator(tenant_id) WHERE tenant_id IS NOT NULL;
+CREATE INDEX idx_creator_alias_lookup ON creator_alias(LOWER(alias));
+CREATE INDEX idx_creator_claim_queue ON creator_claim(status, created_at);
+CREATE INDEX idx_creator_claim_user ON creator_claim(user_id, created_at DESC);
+-- Mỗi người chỉ có một yêu cầu đang chờ cho một creator
+CREATE UNIQUE INDEX idx_creator_claim_pending ON creator_claim(creator_id, user_id) WHERE status = 'pending';
+CREATE INDEX idx_novel_creator_creator ON novel_creator(creator_id);
+CREATE INDEX idx_manga_creator_creator ON manga_creator(creator_id);
+CREATE INDEX idx_anime_creator_creator ON anime_creator(creator_id);
@@ -0,0 +1,17 @@
+-- Rollback Migration 132: Remove Duplicate Detection and Merge
+
+DROP INDEX IF EXISTS idx_character_redirect_target;
+DROP INDEX IF EXISTS idx_creator_redirect_target;
+DROP INDEX IF EXISTS idx_character_name_trgm;
+DROP INDEX IF EXISTS idx_character_name_key;
+DROP INDEX IF EXISTS idx_creator_alias_trgm;
+DROP INDEX IF EXISTS idx_creator_alias_key;
+DROP INDEX IF EXISTS idx_creator_name_trgm;
+DROP INDEX IF EXISTS idx_creator_name_key;
+
+DROP TABLE IF EXISTS character_redirect;
+DROP TABLE IF EXISTS creator_redirect;
+
+DROP FUNCTION IF EXISTS entity_name_key(TEXT);
+
+-- pg_trgm có thể được dùng ở nơi khác nên không gỡ extension
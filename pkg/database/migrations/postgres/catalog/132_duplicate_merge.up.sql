@@ -0,0 +1,47 @@
+-- Migration 132: Duplicate Detection and Merge
+-- Fuzzy lookup of duplicate creators and characters, and redirects from merged IDs
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- ====================
+-- NAME KEY
+-- ====================
+
+-- Khóa so khớp tên: viết thường, bỏ dấu câu và khoảng trắng ("Tanaka, Taro." -> "tanakataro")
+CREATE OR REPLACE FUNCTION entity_name_key(name TEXT) RETURNS TEXT AS $$
+    SELECT regexp_replace(lower(name), '[^[:alnum:]]+', '', 'g')
+$$ LANGUAGE SQL IMMUTABLE STRICT PARALLEL SAFE;
+COMMENT ON FUNCTION entity_name_key(TEXT) IS 'Lowercased name without punctuation or whitespace, used to spot duplicate creators and characters.';
+
+-- ====================
+-- REDIRECTS
+-- ====================
+
+CREATE TABLE creator_redirect (
+    old_id UUID PRIMARY KEY, -- ID của creator đã bị gộp (không còn tồn tại)
+    creator_id UUID NOT NULL REFERENCES creator(id) ON DELETE CASCADE, -- Creator được giữ lại
+    merged_by UUID, -- Admin thực hiện gộp
+    merged_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+COMMENT ON TABLE creator_redirect IS 'Maps IDs of merged creators to the creator they were merged into.';
+
+CREATE TABLE character_redirect (
+    old_id UUID PRIMARY KEY, -- ID của nhân vật đã bị gộp (không còn tồn tại)
+    character_id UUID NOT NULL REFERENCES character(id) ON DELETE CASCADE, -- Nhân vật được giữ lại
+    merged_by UUID, -- Admin thực hiện gộp
+    merged_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+COMMENT ON TABLE character_redirect IS 'Maps IDs of merged characters to the character they were merged into.';
+
+-- ====================
+-- INDEXES
+-- ====================
+
+CREATE INDEX idx_creator_name_key ON creator(entity_name_key(name));
+CREATE INDEX idx_creator_name_trgm ON creator USING GIN (lower(name) gin_trgm_ops);
+CREATE INDEX idx_creator_alias_key ON creator_alias(entity_name_key(alias));
+CREATE INDEX idx_creator_alias_trgm ON creator_alias USING GIN (lower(alias) gin_trgm_ops);
+CREATE INDEX idx_character_name_key ON character(entity_name_key(name));
+CREATE INDEX idx_character_name_trgm ON character USING GIN (lower(name) gin_trgm_ops);
+CREATE INDEX idx_creator_redirect_target ON creator_redirect(creator_id);
+CREATE INDEX idx_character_redirect_target ON character_redirect(character_id);
@@ -0,0 +1,14 @@
+-- Rollback Migration 133: Remove Trash Bin
+
+DROP INDEX IF EXISTS idx_novel_chapter_deletion_id;
+DROP INDEX IF EXISTS idx_novel_volume_deletion_id;
+DROP INDEX IF EXISTS idx_novel_chapter_trash;
+DROP INDEX IF EXISTS idx_novel_volume_trash;
+DROP INDEX IF EXISTS idx_novel_trash;
+
+ALTER TABLE novel_chapter DROP COLUMN IF EXISTS archived_at;
+ALTER TABLE novel_chapter DROP COLUMN IF EXISTS deletion_id;
+ALTER TABLE novel_volume DROP COLUMN IF EXISTS archived_at;
+ALTER TABLE novel_volume DROP COLUMN IF EXISTS deletion_id;
+ALTER TABLE novel DROP COLUMN IF EXISTS archived_at;
+ALTER TABLE novel DROP COLUMN IF EXISTS deletion_id;
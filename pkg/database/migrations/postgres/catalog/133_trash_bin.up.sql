@@ -0,0 +1,51 @@
+-- Migration 133: Trash Bin
+-- Groups rows soft-deleted by one operation so they can be restored together, and marks
+-- purged content that buyers still own as a read-only archive
+
+-- ====================
+-- DELETION GROUPS
+-- ====================
+
+-- Mỗi thao tác xóa gán cùng một deletion_id cho bản ghi gốc và các bản ghi con bị xóa theo
+ALTER TABLE novel ADD COLUMN deletion_id UUID; -- Nhóm thao tác xóa
+ALTER TABLE novel ADD COLUMN archived_at TIMESTAMP; -- Thời gian lưu trữ chỉ đọc cho người mua
+ALTER TABLE novel_volume ADD COLUMN deletion_id UUID; -- Nhóm thao tác xóa
+ALTER TABLE novel_volume ADD COLUMN archived_at TIMESTAMP; -- Thời gian lưu trữ chỉ đọc cho người mua
+ALTER TABLE novel_chapter ADD COLUMN deletion_id UUID; -- Nhóm thao tác xóa
+ALTER TABLE novel_chapter ADD COLUMN archived_at TIMESTAMP; -- Thời gian lưu trữ chỉ đọc cho người mua
+
+COMMENT ON COLUMN novel.deletion_id IS 'Shared by every row soft-deleted in the same operation; restore brings the group back together.';
+COMMENT ON COLUMN novel.archived_at IS 'Set when the retention period ended but buyers still own the content; archived rows are read-only and never restored.';
+COMMENT ON COLUMN novel_volume.deletion_id IS 'Shared by every row soft-deleted in the same operation; restore brings the group back together.';
+COMMENT ON COLUMN novel_volume.archived_at IS 'Set when the retention period ended but buyers still own the content; archived rows are read-only and never restored.';
+COMMENT ON COLUMN novel_chapter.deletion_id IS 'Shared by every row soft-deleted in the same operation; restore brings the group back together.';
+COMMENT ON COLUMN novel_chapter.archived_at IS 'Set when the retention period ended but buyers still own the content; archived rows are read-only and never restored.';
+
+-- ====================
+-- BACKFILL
+-- ====================
+
+-- Bản ghi đã xóa trước migration: con xóa cùng thời điểm với cha được xem là cùng một thao tác
+UPDATE novel SET deletion_id = uuidv7() WHERE is_deleted = TRUE;
+
+UPDATE novel_volume v
+SET deletion_id = COALESCE(
+    (SELECT n.deletion_id FROM novel n WHERE n.id = v.novel_id AND n.deleted_at = v.deleted_at),
+    uuidv7())
+WHERE v.is_deleted = TRUE;
+
+UPDATE novel_chapter c
+SET deletion_id = COALESCE(
+    (SELECT v.deletion_id FROM novel_volume v WHERE v.id = c.volume_id AND v.deleted_at = c.deleted_at),
+    uuidv7())
+WHERE c.is_deleted = TRUE;
+
+-- ====================
+-- INDEXES
+-- ====================
+
+CREATE INDEX idx_novel_trash ON novel(deleted_at) WHERE is_deleted = TRUE AND archived_at IS NULL;
+CREATE INDEX idx_novel_volume_trash ON novel_volume(deleted_at) WHERE is_deleted = TRUE AND archived_at IS NULL;
+CREATE INDEX idx_novel_chapter_trash ON novel_chapter(deleted_at) WHERE is_deleted = TRUE AND archived_at IS NULL;
+CREATE INDEX idx_novel_volume_deletion_id ON novel_volume(deletion_id) WHERE deletion_id IS NOT NULL;
+CREATE INDEX idx_novel_chapter_deletion_id ON novel_chapter(deletion_id) WHERE deletion_id IS NOT NULL;
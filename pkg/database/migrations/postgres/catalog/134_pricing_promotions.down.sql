@@ -0,0 +1,5 @@
+-- Rollback Migration 134: Remove Pricing Promotions
+
+DROP TABLE IF EXISTS novel_bundle_volume;
+DROP TABLE IF EXISTS novel_bundle;
+DROP TABLE IF EXISTS discount_campaign;
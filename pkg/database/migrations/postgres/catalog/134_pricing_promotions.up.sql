@@ -0,0 +1,63 @@
+-- Migration 134: Pricing Promotions
+-- Time-limited discount campaigns scoped to a novel, tenant or genre, and fixed-price volume bundles
+
+-- ====================
+-- DISCOUNT CAMPAIGNS
+-- ====================
+
+CREATE TABLE discount_campaign (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    name VARCHAR(255) NOT NULL, -- Tên chương trình khuyến mãi
+    description TEXT,
+    discount_type VARCHAR(20) NOT NULL CHECK (discount_type IN ('PERCENTAGE', 'FIXED')),
+    discount_value INT NOT NULL CHECK (discount_value > 0), -- Phần trăm (1-100) hoặc số coins giảm
+    scope_type VARCHAR(20) NOT NULL CHECK (scope_type IN ('NOVEL', 'TENANT', 'GENRE')),
+    scope_id UUID NOT NULL, -- Novel, tenant hoặc genre được giảm giá
+    starts_at TIMESTAMP NOT NULL,
+    ends_at TIMESTAMP NOT NULL,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE, -- Tạm dừng thủ công
+    created_by_user_id UUID NOT NULL,
+    tenant_id UUID, -- Tenant của người tạo
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CHECK (ends_at > starts_at),
+    CHECK (discount_type <> 'PERCENTAGE' OR discount_value <= 100)
+);
+COMMENT ON TABLE discount_campaign IS 'Time-limited discounts on novel chapter, volume and series purchases.';
+COMMENT ON COLUMN discount_campaign.scope_id IS 'Novel, tenant (tenant-owned novels) or genre the discount applies to; not a foreign key since the scope is polymorphic.';
+COMMENT ON COLUMN discount_campaign.discount_value IS 'Percentage off (1-100) for PERCENTAGE campaigns, coins off for FIXED campaigns.';
+
+CREATE INDEX idx_discount_campaign_scope ON discount_campaign(scope_type, scope_id, ends_at) WHERE is_active = TRUE;
+CREATE INDEX idx_discount_campaign_creator ON discount_campaign(created_by_user_id);
+CREATE INDEX idx_discount_campaign_tenant ON discount_campaign(tenant_id) WHERE tenant_id IS NOT NULL;
+
+-- ====================
+-- BUNDLES
+-- ====================
+
+CREATE TABLE novel_bundle (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    novel_id UUID NOT NULL REFERENCES novel(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL, -- Ví dụ: "Tập 1-3"
+    description TEXT,
+    price_coins INT NOT NULL CHECK (price_coins >= 0), -- Giá trọn gói
+    starts_at TIMESTAMP, -- NULL: bán ngay
+    ends_at TIMESTAMP, -- NULL: không giới hạn
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_by_user_id UUID NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CHECK (starts_at IS NULL OR ends_at IS NULL OR ends_at > starts_at)
+);
+COMMENT ON TABLE novel_bundle IS 'Fixed-price bundles of volumes of one novel; buying a bundle grants each of its volumes.';
+
+CREATE INDEX idx_novel_bundle_novel ON novel_bundle(novel_id);
+
+CREATE TABLE novel_bundle_volume (
+    bundle_id UUID NOT NULL REFERENCES novel_bundle(id) ON DELETE CASCADE,
+    volume_id UUID NOT NULL REFERENCES novel_volume(id) ON DELETE CASCADE,
+    PRIMARY KEY (bundle_id, volume_id)
+);
+COMMENT ON TABLE novel_bundle_volume IS 'Volumes included in a bundle.';
+
+CREATE INDEX idx_novel_bundle_volume_volume ON novel_bundle_volume(volume_id);
@@ -0,0 +1,5 @@
+-- Rollback Migration 135: Remove Release Models
+
+DROP INDEX IF EXISTS idx_novel_chapter_release;
+DROP TABLE IF EXISTS novel_chapter_unlock;
+DROP TABLE IF EXISTS novel_release_policy;
@@ -0,0 +1,39 @@
+-- Migration 135: Release Models
+-- Per-novel wait-until-free (free after N days, or one free unlock every X hours) and early access for VIP subscribers
+
+-- ====================
+-- RELEASE POLICY
+-- ====================
+
+CREATE TABLE novel_release_policy (
+    novel_id UUID PRIMARY KEY REFERENCES novel(id) ON DELETE CASCADE,
+    free_after_days INT CHECK (free_after_days > 0), -- Chapter trả phí thành miễn phí sau N ngày kể từ published_at
+    unlock_interval_hours INT CHECK (unlock_interval_hours > 0), -- Mỗi độc giả mở khóa miễn phí 1 chapter mỗi X giờ
+    early_access_hours INT CHECK (early_access_hours > 0), -- VIP đọc trước published_at X giờ
+    updated_by_user_id UUID,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+COMMENT ON TABLE novel_release_policy IS 'Wait-until-free and early access settings of a novel; a NULL setting is disabled.';
+COMMENT ON COLUMN novel_release_policy.free_after_days IS 'Paid chapters become free for everyone this many days after published_at.';
+COMMENT ON COLUMN novel_release_policy.unlock_interval_hours IS 'Each reader may unlock one released paid chapter of the novel for free per this many hours.';
+COMMENT ON COLUMN novel_release_policy.early_access_hours IS 'Active VIP subscribers may read scheduled chapters this many hours before published_at.';
+
+-- ====================
+-- FREE UNLOCKS
+-- ====================
+
+CREATE TABLE novel_chapter_unlock (
+    user_id UUID NOT NULL,
+    chapter_id UUID NOT NULL REFERENCES novel_chapter(id) ON DELETE CASCADE,
+    novel_id UUID NOT NULL REFERENCES novel(id) ON DELETE CASCADE, -- Khoảng chờ tính theo từng novel
+    unlocked_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, chapter_id)
+);
+COMMENT ON TABLE novel_chapter_unlock IS 'Chapters a reader unlocked for free with the wait-until-free timer; unlocks are permanent.';
+
+CREATE INDEX idx_novel_chapter_unlock_wait ON novel_chapter_unlock(user_id, novel_id, unlocked_at DESC);
+
+-- Chapter lên lịch/đã xuất bản theo thời điểm phát hành, dùng cho danh sách chương của độc giả
+CREATE INDEX idx_novel_chapter_release ON novel_chapter(volume_id, published_at)
+    WHERE is_public = TRUE AND is_draft = FALSE AND is_deleted = FALSE;
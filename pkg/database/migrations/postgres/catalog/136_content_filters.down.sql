@@ -0,0 +1,4 @@
+-- Rollback Migration 136: Remove Content Filters
+
+DROP TABLE IF EXISTS tenant_content_policy;
+DROP TABLE IF EXISTS user_content_filter;
@@ -0,0 +1,33 @@
+-- Migration 136: Content Filters
+-- Reader content filters (hide mature content, blocked content warnings) and per-tenant age gating overrides
+
+-- ====================
+-- READER FILTERS
+-- ====================
+
+CREATE TABLE user_content_filter (
+    user_id UUID PRIMARY KEY,
+    hide_mature BOOLEAN NOT NULL DEFAULT FALSE, -- Ẩn/làm mờ nội dung người lớn dù đủ tuổi
+    blocked_warnings TEXT[] NOT NULL DEFAULT '{}', -- Cảnh báo nội dung độc giả không muốn thấy
+    restricted_display VARCHAR(10) NOT NULL DEFAULT 'BLUR' CHECK (restricted_display IN ('HIDE', 'BLUR')),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+COMMENT ON TABLE user_content_filter IS 'Content a reader chose not to see; restricted_display decides whether matching items are hidden or blurred.';
+
+-- ====================
+-- TENANT POLICIES
+-- ====================
+
+CREATE TABLE tenant_content_policy (
+    tenant_id UUID PRIMARY KEY,
+    min_age_pg13 INT CHECK (min_age_pg13 BETWEEN 0 AND 21), -- Mặc định 13
+    min_age_r INT CHECK (min_age_r BETWEEN 0 AND 21), -- Mặc định 17
+    min_age_nc17 INT CHECK (min_age_nc17 BETWEEN 0 AND 21), -- Mặc định 18
+    min_age_mature INT CHECK (min_age_mature BETWEEN 0 AND 21), -- Mặc định 18
+    restricted_display VARCHAR(10) CHECK (restricted_display IN ('HIDE', 'BLUR')), -- Mặc định HIDE
+    updated_by_user_id UUID,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+COMMENT ON TABLE tenant_content_policy IS 'Age gating overrides for content owned by a tenant; a NULL setting keeps the platform default.';
@@ -0,0 +1,5 @@
+-- Rollback Migration 137: Remove Content Warning Taxonomy
+
+ALTER TABLE user_content_filter DROP COLUMN IF EXISTS warned_warnings;
+DROP TABLE IF EXISTS content_warning_translation;
+DROP TABLE IF EXISTS content_warning;
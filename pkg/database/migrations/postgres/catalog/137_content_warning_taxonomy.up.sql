@@ -0,0 +1,74 @@
+-- Migration 137: Content Warning Taxonomy
+-- Managed content warnings with severity and localized labels; novel and chapter content_warnings
+-- store their codes. Readers choose warnings to never see and warnings to be warned about.
+
+-- ====================
+-- TAXONOMY
+-- ====================
+
+CREATE TABLE content_warning (
+    code VARCHAR(50) PRIMARY KEY CHECK (code ~ '^[a-z][a-z0-9_]*$'), -- Mã lưu trong content_warnings
+    severity VARCHAR(10) NOT NULL CHECK (severity IN ('MILD', 'MODERATE', 'SEVERE')),
+    sort_order INT NOT NULL DEFAULT 0,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE, -- Ngừng dùng: không gắn mới được nhưng vẫn lọc được
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+COMMENT ON TABLE content_warning IS 'Managed content warnings; novels and chapters may only carry active codes.';
+
+CREATE TABLE content_warning_translation (
+    warning_code VARCHAR(50) NOT NULL REFERENCES content_warning(code) ON DELETE CASCADE ON UPDATE CASCADE,
+    language_code VARCHAR(10) NOT NULL,
+    label VARCHAR(100) NOT NULL,
+    description TEXT,
+    PRIMARY KEY (warning_code, language_code)
+);
+
+INSERT INTO content_warning (code, severity, sort_order) VALUES
+    ('profanity', 'MILD', 10),
+    ('violence', 'MODERATE', 20),
+    ('gore', 'SEVERE', 30),
+    ('sexual_content', 'SEVERE', 40),
+    ('sexual_violence', 'SEVERE', 50),
+    ('self_harm', 'SEVERE', 60),
+    ('suicide', 'SEVERE', 70),
+    ('abuse', 'SEVERE', 80),
+    ('substance_use', 'MODERATE', 90),
+    ('death', 'MODERATE', 100),
+    ('horror', 'MODERATE', 110),
+    ('discrimination', 'MODERATE', 120);
+
+INSERT INTO content_warning_translation (warning_code, language_code, label) VALUES
+    ('profanity', 'en', 'Profanity'),
+    ('profanity', 'vi', 'Ngôn từ thô tục'),
+    ('violence', 'en', 'Violence'),
+    ('violence', 'vi', 'Bạo lực'),
+    ('gore', 'en', 'Gore'),
+    ('gore', 'vi', 'Máu me'),
+    ('sexual_content', 'en', 'Sexual content'),
+    ('sexual_content', 'vi', 'Nội dung tình dục'),
+    ('sexual_violence', 'en', 'Sexual violence'),
+    ('sexual_violence', 'vi', 'Bạo lực tình dục'),
+    ('self_harm', 'en', 'Self-harm'),
+    ('self_harm', 'vi', 'Tự gây thương tích'),
+    ('suicide', 'en', 'Suicide'),
+    ('suicide', 'vi', 'Tự sát'),
+    ('abuse', 'en', 'Abuse'),
+    ('abuse', 'vi', 'Ngược đãi'),
+    ('substance_use', 'en', 'Drug and alcohol use'),
+    ('substance_use', 'vi', 'Sử dụng chất kích thích'),
+    ('death', 'en', 'Character death'),
+    ('death', 'vi', 'Nhân vật tử vong'),
+    ('horror', 'en', 'Horror'),
+    ('horror', 'vi', 'Kinh dị'),
+    ('discrimination', 'en', 'Discrimination'),
+    ('discrimination', 'vi', 'Phân biệt đối xử');
+
+-- ====================
+-- READER PREFERENCES
+-- ====================
+
+-- blocked_warnings là "không bao giờ hiển thị"; warned_warnings là "cảnh báo trước khi xem"
+ALTER TABLE user_content_filter ADD COLUMN warned_warnings TEXT[] NOT NULL DEFAULT '{}';
+COMMENT ON COLUMN user_content_filter.blocked_warnings IS 'Warning codes the reader never wants to see; matching items are hidden.';
+COMMENT ON COLUMN user_content_filter.warned_warnings IS 'Warning codes the reader wants to be warned about before opening an item.';
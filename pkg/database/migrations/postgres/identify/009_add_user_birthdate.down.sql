@@ -0,0 +1,4 @@
+-- Remove birthdate
+ALTER TABLE users
+DROP CONSTRAINT IF EXISTS chk_users_birthdate,
+DROP COLUMN IF EXISTS birthdate;
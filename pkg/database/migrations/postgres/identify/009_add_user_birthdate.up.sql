@@ -0,0 +1,6 @@
+-- Add self-declared birthdate used for age verification of mature content
+ALTER TABLE users
+ADD COLUMN birthdate DATE;
+
+ALTER TABLE users
+ADD CONSTRAINT chk_users_birthdate CHECK (birthdate IS NULL OR birthdate >= DATE '1900-01-01');
@@ -341,6 +341,120 @@ func (x *GetUsersResponse) GetMissingUserIds() []string {
 	return nil
 }
 
+// SuspendUserRequest identifies the user to suspend and why
+type SuspendUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	ModeratorId   string                 `protobuf:"bytes,3,opt,name=moderator_id,json=moderatorId,proto3" json:"moderator_id,omitempty"` // User who applied the suspension
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendUserRequest) Reset() {
+	*x = SuspendUserRequest{}
+	mi := &file_user_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendUserRequest) ProtoMessage() {}
+
+func (x *SuspendUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendUserRequest.ProtoReflect.Descriptor instead.
+func (*SuspendUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SuspendUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SuspendUserRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SuspendUserRequest) GetModeratorId() string {
+	if x != nil {
+		return x.ModeratorId
+	}
+	return ""
+}
+
+// SuspendUserResponse reports whether the suspension was applied
+type SuspendUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendUserResponse) Reset() {
+	*x = SuspendUserResponse{}
+	mi := &file_user_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendUserResponse) ProtoMessage() {}
+
+func (x *SuspendUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendUserResponse.ProtoReflect.Descriptor instead.
+func (*SuspendUserResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SuspendUserResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SuspendUserResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 var File_user_service_proto protoreflect.FileDescriptor
 
 const file_user_service_proto_rawDesc = "" +
@@ -366,10 +480,18 @@ const file_user_service_proto_rawDesc = "" +
 	"\x10GetUsersResponse\x12'\n" +
 	"\x05users\x18\x01 \x03(\v2\x11.userservice.UserR\x05users\x12\x14\n" +
 	"\x05error\x18\x02 \x01(\tR\x05error\x12(\n" +
-	"\x10missing_user_ids\x18\x03 \x03(\tR\x0emissingUserIds2\x9c\x01\n" +
+	"\x10missing_user_ids\x18\x03 \x03(\tR\x0emissingUserIds\"h\n" +
+	"\x12SuspendUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12!\n" +
+	"\fmoderator_id\x18\x03 \x01(\tR\vmoderatorId\"E\n" +
+	"\x13SuspendUserResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error2\xee\x01\n" +
 	"\vUserService\x12D\n" +
 	"\aGetUser\x12\x1b.userservice.GetUserRequest\x1a\x1c.userservice.GetUserResponse\x12G\n" +
-	"\bGetUsers\x12\x1c.userservice.GetUsersRequest\x1a\x1d.userservice.GetUsersResponseB'Z%wibusystem/pkg/grpc/proto/userserviceb\x06proto3"
+	"\bGetUsers\x12\x1c.userservice.GetUsersRequest\x1a\x1d.userservice.GetUsersResponse\x12P\n" +
+	"\vSuspendUser\x12\x1f.userservice.SuspendUserRequest\x1a .userservice.SuspendUserResponseB'Z%wibusystem/pkg/grpc/proto/userserviceb\x06proto3"
 
 var (
 	file_user_service_proto_rawDescOnce sync.Once
@@ -383,25 +505,29 @@ func file_user_service_proto_rawDescGZIP() []byte {
 	return file_user_service_proto_rawDescData
 }
 
-var file_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_user_service_proto_goTypes = []any{
 	(*User)(nil),                  // 0: userservice.User
 	(*GetUserRequest)(nil),        // 1: userservice.GetUserRequest
 	(*GetUserResponse)(nil),       // 2: userservice.GetUserResponse
 	(*GetUsersRequest)(nil),       // 3: userservice.GetUsersRequest
 	(*GetUsersResponse)(nil),      // 4: userservice.GetUsersResponse
-	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+	(*SuspendUserRequest)(nil),    // 5: userservice.SuspendUserRequest
+	(*SuspendUserResponse)(nil),   // 6: userservice.SuspendUserResponse
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
 }
 var file_user_service_proto_depIdxs = []int32{
-	5, // 0: userservice.User.created_at:type_name -> google.protobuf.Timestamp
+	7, // 0: userservice.User.created_at:type_name -> google.protobuf.Timestamp
 	0, // 1: userservice.GetUserResponse.user:type_name -> userservice.User
 	0, // 2: userservice.GetUsersResponse.users:type_name -> userservice.User
 	1, // 3: userservice.UserService.GetUser:input_type -> userservice.GetUserRequest
 	3, // 4: userservice.UserService.GetUsers:input_type -> userservice.GetUsersRequest
-	2, // 5: userservice.UserService.GetUser:output_type -> userservice.GetUserResponse
-	4, // 6: userservice.UserService.GetUsers:output_type -> userservice.GetUsersResponse
-	5, // [5:7] is the sub-list for method output_type
-	3, // [3:5] is the sub-list for method input_type
+	5, // 5: userservice.UserService.SuspendUser:input_type -> userservice.SuspendUserRequest
+	2, // 6: userservice.UserService.GetUser:output_type -> userservice.GetUserResponse
+	4, // 7: userservice.UserService.GetUsers:output_type -> userservice.GetUsersResponse
+	6, // 8: userservice.UserService.SuspendUser:output_type -> userservice.SuspendUserResponse
+	6, // [6:9] is the sub-list for method output_type
+	3, // [3:6] is the sub-list for method input_type
 	3, // [3:3] is the sub-list for extension type_name
 	3, // [3:3] is the sub-list for extension extendee
 	0, // [0:3] is the sub-list for field type_name
@@ -422,7 +548,7 @@ func file_user_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_service_proto_rawDesc), len(file_user_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
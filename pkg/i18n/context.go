@@ -166,6 +166,32 @@ func ExtractLanguagePreferences(c *gin.Context, config Config) []string {
 	return languages
 }
 
+// ContentLanguages returns the languages in which user content should be served, best first:
+// the resolved UI language followed by every Accept-Language entry, lower-cased and de-duplicated.
+// Unlike the UI language, entries are not restricted to the supported locales.
+func ContentLanguages(c *gin.Context) []string {
+	headerName := DefaultConfig().HeaderName
+	if translator := GetTranslator(c); translator != nil && translator.GetConfig().HeaderName != "" {
+		headerName = translator.GetConfig().HeaderName
+	}
+
+	candidates := []string{LanguageFromContext(c)}
+	candidates = append(candidates, parseAcceptLanguage(c.GetHeader(headerName))...)
+
+	var languages []string
+	seen := make(map[string]bool)
+	for _, lang := range candidates {
+		lang = strings.ToLower(strings.TrimSpace(lang))
+		if lang == "" || lang == "*" || seen[lang] {
+			continue
+		}
+		seen[lang] = true
+		languages = append(languages, lang)
+	}
+
+	return languages
+}
+
 // parseAcceptLanguage parses the Accept-Language header and returns languages in preference order
 func parseAcceptLanguage(header string) []string {
 	var languages []string
@@ -1,10 +1,13 @@
 package auth
 
 import (
+	"strconv"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+
+	"wibusystem/pkg/common/oauth"
 )
 
 // UserContext represents authenticated user information in the request context
@@ -91,6 +94,19 @@ func (u *UserContext) IsAdmin() bool {
 	return u.HasScope("admin")
 }
 
+// Age returns the user's age claim; ok is false when the user has not declared a birthdate
+func (u *UserContext) Age() (age int, ok bool) {
+	value, exists := u.Extra[oauth.ClaimAge]
+	if !exists {
+		return 0, false
+	}
+	age, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, false
+	}
+	return age, true
+}
+
 // ValidationResult represents the result of token validation
 type ValidationResult struct {
 	Valid       bool         `json:"valid"`
@@ -4,6 +4,7 @@
 package config
 
 import (
+	"errors"
 	"log"
 	"os"
 	"strconv"
@@ -21,8 +22,10 @@ type Config struct {
 	Localization LocalizationConfig `json:"localization"`
 	Content      ContentConfig      `json:"content"`
 	Media        MediaConfig        `json:"media"`
+	Translation  TranslationConfig  `json:"translation"`
 	Security     SecurityConfig     `json:"security"`
 	Integrations IntegrationsConfig `json:"integrations"`
+	Jobs         JobsConfig         `json:"jobs"`
 }
 
 // ServerConfig holds HTTP server settings.
@@ -58,13 +61,49 @@ type ContentConfig struct {
 	EnableAnime bool `json:"enable_anime"`
 	EnableManga bool `json:"enable_manga"`
 	EnableNovel bool `json:"enable_novel"`
+	// TrashRetentionDays is how long deleted novels, volumes and chapters stay restorable.
+	TrashRetentionDays int `json:"trash_retention_days"`
 }
 
-// MediaConfig captures knobs for media delivery integration (CDN, image proxy).
+// MediaConfig captures knobs for media delivery integration (CDN, image proxy)
+// and the object storage backing uploads.
 type MediaConfig struct {
 	CDNBaseURL      string        `json:"cdn_base_url"`
 	ImageProxyURL   string        `json:"image_proxy_url"`
 	SignedURLExpiry time.Duration `json:"signed_url_expiry"`
+	// MaxUploadBytes caps a single uploaded file.
+	MaxUploadBytes int64 `json:"max_upload_bytes"`
+	// AllowedContentTypes lists the sniffed content types accepted for upload.
+	AllowedContentTypes []string      `json:"allowed_content_types"`
+	Storage             StorageConfig `json:"storage"`
+}
+
+// StorageConfig selects the object storage backend: "local" or "s3".
+type StorageConfig struct {
+	Driver string `json:"driver"`
+	// LocalRootDir is where the local backend writes files.
+	LocalRootDir string `json:"local_root_dir"`
+	// PublicBaseURL is the externally reachable /api/v1/media/files URL the
+	// local backend signs download links against.
+	PublicBaseURL string `json:"public_base_url"`
+	// SigningKey signs local download links; it must be set outside development.
+	SigningKey  string `json:"-"`
+	S3Endpoint  string `json:"s3_endpoint"`
+	S3Region    string `json:"s3_region"`
+	S3Bucket    string `json:"s3_bucket"`
+	S3AccessKey string `json:"-"`
+	S3SecretKey string `json:"-"`
+	S3PathStyle bool   `json:"s3_path_style"`
+}
+
+// TranslationConfig selects the machine-translation provider used for draft
+// chapter translations: "none" (disabled), "stub" or "libretranslate".
+type TranslationConfig struct {
+	Provider string `json:"provider"`
+	// BaseURL is the provider endpoint, e.g. a self-hosted LibreTranslate server.
+	BaseURL string        `json:"base_url"`
+	APIKey  string        `json:"-"`
+	Timeout time.Duration `json:"timeout"`
 }
 
 // SecurityConfig currently holds CORS settings for HTTP responses.
@@ -87,11 +126,30 @@ type IntegrationsConfig struct {
 	IdentifyGRPCURL string `json:"identify_grpc_url"`
 }
 
+// JobsConfig controls the in-process background job scheduler.
+type JobsConfig struct {
+	Enabled         bool          `json:"enabled"`
+	RankingInterval time.Duration `json:"ranking_interval"`
+	// RecommendationHour is the local hour (0-23) of the nightly neighbours rebuild.
+	RecommendationHour int `json:"recommendation_hour"`
+	// AnalyticsInterval is how often the owner analytics aggregates are rolled up.
+	AnalyticsInterval time.Duration `json:"analytics_interval"`
+	// MediaInterval is how often queued uploaded images are processed into renditions.
+	MediaInterval time.Duration `json:"media_interval"`
+	// CounterHour is the local hour (0-23) of the nightly counter reconciliation sweep.
+	CounterHour int `json:"counter_hour"`
+	// TrashPurgeHour is the local hour (0-23) of the nightly purge of expired trash.
+	TrashPurgeHour int `json:"trash_purge_hour"`
+}
+
+// developmentMediaSigningKey signs local download links in development when no key is configured.
+const developmentMediaSigningKey = "wibusystem-media-signing-key-development-only"
+
 // Load builds the config using environment variables with sensible defaults.
 func Load() *Config {
 	migrationsPath := getEnv("CONFIG_DB_MIGRATIONS_PATH", "../../pkg/database/migrations/postgres/catalog")
 
-	return &Config{
+	cfg := &Config{
 		Server: ServerConfig{
 			Host:         getEnv("CONFIG_SERVICE_HOST", "localhost"),
 			Port:         getEnvAsInt("CONFIG_SERVICE_PORT", 8082),
@@ -128,14 +186,36 @@ func Load() *Config {
 			CookieName:         getEnv("CONFIG_LOCALE_COOKIE", "locale"),
 		},
 		Content: ContentConfig{
-			EnableAnime: getEnvAsBool("CONFIG_FEATURE_ANIME", true),
-			EnableManga: getEnvAsBool("CONFIG_FEATURE_MANGA", true),
-			EnableNovel: getEnvAsBool("CONFIG_FEATURE_NOVEL", true),
+			EnableAnime:        getEnvAsBool("CONFIG_FEATURE_ANIME", true),
+			EnableManga:        getEnvAsBool("CONFIG_FEATURE_MANGA", true),
+			EnableNovel:        getEnvAsBool("CONFIG_FEATURE_NOVEL", true),
+			TrashRetentionDays: getEnvAsInt("CONFIG_TRASH_RETENTION_DAYS", 30),
 		},
 		Media: MediaConfig{
 			CDNBaseURL:      getEnv("CONFIG_MEDIA_CDN_BASE_URL", ""),
 			ImageProxyURL:   getEnv("CONFIG_MEDIA_IMAGE_PROXY_URL", ""),
 			SignedURLExpiry: getEnvAsDuration("CONFIG_MEDIA_SIGNED_URL_EXPIRY", 15*time.Minute),
+			MaxUploadBytes:  int64(getEnvAsInt("CONFIG_MEDIA_MAX_UPLOAD_BYTES", 10<<20)),
+			AllowedContentTypes: splitAndTrim(getEnv("CONFIG_MEDIA_ALLOWED_CONTENT_TYPES",
+				"image/jpeg,image/png,image/gif,image/webp")),
+			Storage: StorageConfig{
+				Driver:        getEnv("CONFIG_MEDIA_STORAGE_DRIVER", "local"),
+				LocalRootDir:  getEnv("CONFIG_MEDIA_LOCAL_ROOT_DIR", "./data/media"),
+				PublicBaseURL: getEnv("CONFIG_MEDIA_PUBLIC_BASE_URL", "http://localhost:8082/api/v1/media/files"),
+				SigningKey:    getEnv("CONFIG_MEDIA_SIGNING_KEY", ""),
+				S3Endpoint:    getEnv("CONFIG_MEDIA_S3_ENDPOINT", ""),
+				S3Region:      getEnv("CONFIG_MEDIA_S3_REGION", "us-east-1"),
+				S3Bucket:      getEnv("CONFIG_MEDIA_S3_BUCKET", ""),
+				S3AccessKey:   getEnv("CONFIG_MEDIA_S3_ACCESS_KEY", ""),
+				S3SecretKey:   getEnv("CONFIG_MEDIA_S3_SECRET_KEY", ""),
+				S3PathStyle:   getEnvAsBool("CONFIG_MEDIA_S3_PATH_STYLE", true),
+			},
+		},
+		Translation: TranslationConfig{
+			Provider: getEnv("CONFIG_MT_PROVIDER", "none"),
+			BaseURL:  getEnv("CONFIG_MT_BASE_URL", ""),
+			APIKey:   getEnv("CONFIG_MT_API_KEY", ""),
+			Timeout:  getEnvAsDuration("CONFIG_MT_TIMEOUT", time.Minute),
 		},
 		Security: SecurityConfig{
 			CORS: CORSConfig{
@@ -150,7 +230,31 @@ func Load() *Config {
 		Integrations: IntegrationsConfig{
 			IdentifyGRPCURL: getEnv("CONFIG_IDENTIFY_GRPC_URL", "localhost:9090"),
 		},
+		Jobs: JobsConfig{
+			Enabled:            getEnvAsBool("CONFIG_JOBS_ENABLED", true),
+			RankingInterval:    getEnvAsDuration("CONFIG_JOB_RANKING_INTERVAL", 15*time.Minute),
+			RecommendationHour: getEnvAsInt("CONFIG_JOB_RECOMMENDATION_HOUR", 3),
+			AnalyticsInterval:  getEnvAsDuration("CONFIG_JOB_ANALYTICS_INTERVAL", time.Hour),
+			MediaInterval:      getEnvAsDuration("CONFIG_JOB_MEDIA_INTERVAL", 30*time.Second),
+			CounterHour:        getEnvAsInt("CONFIG_JOB_COUNTER_HOUR", 4),
+			TrashPurgeHour:     getEnvAsInt("CONFIG_JOB_TRASH_PURGE_HOUR", 5),
+		},
+	}
+
+	if cfg.Media.Storage.SigningKey == "" && cfg.Server.Environment == "development" {
+		log.Printf("CONFIG_MEDIA_SIGNING_KEY is not set, using the development signing key")
+		cfg.Media.Storage.SigningKey = developmentMediaSigningKey
+	}
+
+	return cfg
+}
+
+// Validate rejects configurations that are unsafe to run with.
+func (c *Config) Validate() error {
+	if c.Media.Storage.Driver == "local" && c.Media.Storage.SigningKey == "" {
+		return errors.New("CONFIG_MEDIA_SIGNING_KEY must be set outside development when the local media storage driver is used")
 	}
+	return nil
 }
 
 // Helper functions ---------------------------------------------------------
@@ -147,6 +147,26 @@ func (c *ClientManager) GetTenants(ctx context.Context, tenantIDs []string) (map
 	return result, nil
 }
 
+// SuspendUser blocks a user account in the identify service on behalf of a moderator
+func (c *ClientManager) SuspendUser(ctx context.Context, userID, reason, moderatorID string) error {
+	req := &userpb.SuspendUserRequest{
+		UserId:      userID,
+		Reason:      reason,
+		ModeratorId: moderatorID,
+	}
+
+	resp, err := c.userClient.SuspendUser(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to suspend user via gRPC: %w", err)
+	}
+
+	if !resp.Success {
+		return fmt.Errorf("user service error: %s", resp.Error)
+	}
+
+	return nil
+}
+
 // Close closes all gRPC connections
 func (c *ClientManager) Close() error {
 	var errs []error
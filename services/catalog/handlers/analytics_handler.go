@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	d "wibusystem/pkg/common/dto"
+	r "wibusystem/pkg/common/response"
+	"wibusystem/pkg/i18n"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// AnalyticsHandler handles the owner analytics dashboard endpoints
+type AnalyticsHandler struct {
+	analyticsService interfaces.AnalyticsServiceInterface
+	loc              *i18n.Translator
+}
+
+// NewAnalyticsHandler creates a new analytics handler
+func NewAnalyticsHandler(analyticsService interfaces.AnalyticsServiceInterface, translator *i18n.Translator) *AnalyticsHandler {
+	return &AnalyticsHandler{
+		analyticsService: analyticsService,
+		loc:              translator,
+	}
+}
+
+// GetNovelAnalytics handles GET /novels/{novel_id}/analytics
+func (h *AnalyticsHandler) GetNovelAnalytics(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	var req d.NovelAnalyticsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_query_parameters", "Invalid query parameters")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	response, err := h.analyticsService.GetNovelAnalytics(ctx, c.Param("novel_id"), user.UserID, user.TenantID, user.IsAdmin(), req)
+	if err != nil {
+		status, code, message, description := mapAnalyticsServiceError(c, err, "get")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.analytics.get.success", "Novel analytics retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// mapAnalyticsServiceError maps service errors to appropriate HTTP responses for analytics operations
+func mapAnalyticsServiceError(c *gin.Context, err error, operation string) (int, string, string, string) {
+	errStr := err.Error()
+
+	switch {
+	case strings.Contains(errStr, "invalid novel ID format"):
+		message := i18n.Localize(c, "catalog.common.error.invalid_id_format", "Invalid ID format")
+		return http.StatusBadRequest, "invalid_id", message, errStr
+
+	case strings.Contains(errStr, "invalid date range"):
+		message := i18n.Localize(c, "catalog.analytics.error.invalid_range", "Invalid date range")
+		return http.StatusBadRequest, "invalid_date_range", message, errStr
+
+	case strings.Contains(errStr, "invalid granularity"):
+		message := i18n.Localize(c, "catalog.analytics.error.invalid_granularity", "Granularity must be day or week")
+		return http.StatusBadRequest, "invalid_granularity", message, errStr
+
+	case strings.Contains(errStr, "novel not found"):
+		message := i18n.Localize(c, "catalog.common.error.not_found", "Resource not found")
+		return http.StatusNotFound, "novel_not_found", message, errStr
+
+	case strings.Contains(errStr, "permission denied"):
+		message := i18n.Localize(c, "catalog.analytics.error.forbidden", "You do not have access to this novel's analytics")
+		return http.StatusForbidden, "forbidden", message, errStr
+
+	default:
+		message := i18n.Localize(c, "catalog.common.error.internal", "Internal server error")
+		return http.StatusInternalServerError, "internal_error", message, errStr
+	}
+}
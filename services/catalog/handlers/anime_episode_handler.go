@@ -0,0 +1,727 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+	r "wibusystem/pkg/common/response"
+	"wibusystem/pkg/i18n"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// AnimeEpisodeHandler handles anime season, episode and subtitle endpoints
+type AnimeEpisodeHandler struct {
+	episodeService       interfaces.AnimeEpisodeServiceInterface
+	contentFilterService interfaces.ContentFilterServiceInterface
+	loc                  *i18n.Translator
+}
+
+// NewAnimeEpisodeHandler creates a new anime episode handler
+func NewAnimeEpisodeHandler(episodeService interfaces.AnimeEpisodeServiceInterface, contentFilterService interfaces.ContentFilterServiceInterface, translator *i18n.Translator) *AnimeEpisodeHandler {
+	return &AnimeEpisodeHandler{
+		episodeService:       episodeService,
+		contentFilterService: contentFilterService,
+		loc:                  translator,
+	}
+}
+
+// CreateSeason handles POST /anime/{anime_id}/seasons
+func (h *AnimeEpisodeHandler) CreateSeason(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.CreateAnimeSeasonRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	response, err := h.episodeService.CreateSeason(ctx, c.Param("anime_id"), req, actor)
+	if err != nil {
+		status, code, message, description := mapAnimeServiceError(c, err, "create_season")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.anime_seasons.create.success", "Season created successfully")
+	c.JSON(http.StatusCreated, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// ListSeasons handles GET /anime/{anime_id}/seasons
+func (h *AnimeEpisodeHandler) ListSeasons(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	response, err := h.episodeService.ListSeasons(ctx, c.Param("anime_id"))
+	if err != nil {
+		status, code, message, description := mapAnimeServiceError(c, err, "list_seasons")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.anime_seasons.list.success", "Seasons retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// UpdateSeason handles PUT /anime/seasons/{season_id}
+func (h *AnimeEpisodeHandler) UpdateSeason(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.UpdateAnimeSeasonRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	response, err := h.episodeService.UpdateSeason(ctx, c.Param("season_id"), req, actor)
+	if err != nil {
+		status, code, message, description := mapAnimeServiceError(c, err, "update_season")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.anime_seasons.update.success", "Season updated successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// DeleteSeason handles DELETE /anime/seasons/{season_id}
+func (h *AnimeEpisodeHandler) DeleteSeason(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	if err := h.episodeService.DeleteSeason(ctx, c.Param("season_id"), actor); err != nil {
+		status, code, message, description := mapAnimeServiceError(c, err, "delete_season")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.anime_seasons.delete.success", "Season deleted successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    nil,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// CreateEpisode handles POST /anime/seasons/{season_id}/episodes
+func (h *AnimeEpisodeHandler) CreateEpisode(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.CreateAnimeEpisodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	response, err := h.episodeService.CreateEpisode(ctx, c.Param("season_id"), req, actor)
+	if err != nil {
+		status, code, message, description := mapAnimeServiceError(c, err, "create_episode")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.anime_episodes.create.success", "Episode created successfully")
+	c.JSON(http.StatusCreated, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// ListPublishedEpisodes handles GET /anime/seasons/{season_id}/episodes
+func (h *AnimeEpisodeHandler) ListPublishedEpisodes(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req d.ListAnimeEpisodesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_query_parameters", "Invalid query parameters")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	// Age gating and the viewer's filters on the anime
+	restriction, err := h.contentFilterService.CheckAnimeSeason(ctx, currentViewer(c), c.Param("season_id"))
+	if err != nil {
+		status, code, message, description := mapAnimeServiceError(c, err, "list_episodes")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+	if restriction != nil && restriction.Display == m.RestrictedDisplayHide {
+		respondContentRestricted(c, restriction)
+		return
+	}
+
+	response, err := h.episodeService.ListPublishedEpisodes(ctx, c.Param("season_id"), req)
+	if err != nil {
+		status, code, message, description := mapAnimeServiceError(c, err, "list_episodes")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.anime_episodes.list.success", "Episodes retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response.Episodes,
+		Error:   nil,
+		Meta: map[string]interface{}{
+			"pagination": response.Pagination,
+		},
+	})
+}
+
+// ListAllEpisodes handles GET /anime/seasons/{season_id}/episodes/all
+func (h *AnimeEpisodeHandler) ListAllEpisodes(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.ListAnimeEpisodesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_query_parameters", "Invalid query parameters")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	response, err := h.episodeService.ListAllEpisodes(ctx, c.Param("season_id"), req, actor)
+	if err != nil {
+		status, code, message, description := mapAnimeServiceError(c, err, "list_episodes")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.anime_episodes.list.success", "Episodes retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response.Episodes,
+		Error:   nil,
+		Meta: map[string]interface{}{
+			"pagination": response.Pagination,
+		},
+	})
+}
+
+// GetEpisode handles GET /anime/episodes/{episode_id}
+func (h *AnimeEpisodeHandler) GetEpisode(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// Age gating and the viewer's filters on the anime
+	restriction, err := h.contentFilterService.CheckAnimeEpisode(ctx, currentViewer(c), c.Param("episode_id"))
+	if err != nil {
+		status, code, message, description := mapAnimeServiceError(c, err, "get_episode")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+	if restriction != nil && restriction.Display == m.RestrictedDisplayHide {
+		respondContentRestricted(c, restriction)
+		return
+	}
+
+	response, err := h.episodeService.GetPublishedEpisode(ctx, c.Param("episode_id"))
+	if err != nil {
+		status, code, message, description := mapAnimeServiceError(c, err, "get_episode")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.anime_episodes.get.success", "Episode retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// GetEpisodeStream handles GET /anime/episodes/{episode_id}/stream
+func (h *AnimeEpisodeHandler) GetEpisodeStream(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	// Age gating and the viewer's filters; blurred episodes stay watchable
+	restriction, err := h.contentFilterService.CheckAnimeEpisode(ctx, currentViewer(c), c.Param("episode_id"))
+	if err != nil {
+		status, code, message, description := mapAnimeServiceError(c, err, "stream_episode")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+	if restriction != nil && restriction.Display == m.RestrictedDisplayHide {
+		respondContentRestricted(c, restriction)
+		return
+	}
+
+	response, err := h.episodeService.GetEpisodeStream(ctx, c.Param("episode_id"), actor)
+	if err != nil {
+		status, code, message, description := mapAnimeServiceError(c, err, "stream_episode")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.anime_episodes.stream.success", "Episode stream retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// UpdateEpisode handles PUT /anime/episodes/{episode_id}
+func (h *AnimeEpisodeHandler) UpdateEpisode(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.UpdateAnimeEpisodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	response, err := h.episodeService.UpdateEpisode(ctx, c.Param("episode_id"), req, actor)
+	if err != nil {
+		status, code, message, description := mapAnimeServiceError(c, err, "update_episode")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.anime_episodes.update.success", "Episode updated successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// DeleteEpisode handles DELETE /anime/episodes/{episode_id}
+func (h *AnimeEpisodeHandler) DeleteEpisode(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	if err := h.episodeService.DeleteEpisode(ctx, c.Param("episode_id"), actor); err != nil {
+		status, code, message, description := mapAnimeServiceError(c, err, "delete_episode")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.anime_episodes.delete.success", "Episode deleted successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    nil,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// PublishEpisode handles POST /anime/episodes/{episode_id}/publish
+func (h *AnimeEpisodeHandler) PublishEpisode(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	// The body is optional: publish now when omitted
+	var req d.PublishAnimeEpisodeRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+			c.JSON(http.StatusBadRequest, r.StandardResponse{
+				Success: false,
+				Message: message,
+				Data:    nil,
+				Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+				Meta:    map[string]interface{}{},
+			})
+			return
+		}
+	}
+
+	response, err := h.episodeService.PublishEpisode(ctx, c.Param("episode_id"), req, actor)
+	if err != nil {
+		status, code, message, description := mapAnimeServiceError(c, err, "publish_episode")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.anime_episodes.publish.success", "Episode published successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// UnpublishEpisode handles POST /anime/episodes/{episode_id}/unpublish
+func (h *AnimeEpisodeHandler) UnpublishEpisode(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	response, err := h.episodeService.UnpublishEpisode(ctx, c.Param("episode_id"), actor)
+	if err != nil {
+		status, code, message, description := mapAnimeServiceError(c, err, "unpublish_episode")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.anime_episodes.unpublish.success", "Episode unpublished successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// SetSubtitle handles PUT /anime/episodes/{episode_id}/subtitles/{language_code}
+func (h *AnimeEpisodeHandler) SetSubtitle(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.SetEpisodeSubtitleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	response, err := h.episodeService.SetSubtitle(ctx, c.Param("episode_id"), c.Param("language_code"), req, actor)
+	if err != nil {
+		status, code, message, description := mapAnimeServiceError(c, err, "set_subtitle")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.anime_episodes.subtitle.set.success", "Subtitle saved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// GetSubtitleFile handles GET /anime/episodes/{episode_id}/subtitles/{language_code}:
+// hosted subtitles are served as WebVTT, external ones are redirected to their URL
+func (h *AnimeEpisodeHandler) GetSubtitleFile(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	track, err := h.episodeService.GetSubtitleFile(ctx, c.Param("episode_id"), c.Param("language_code"))
+	if err != nil {
+		status, code, message, description := mapAnimeServiceError(c, err, "get_subtitle")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	if track.Content == nil {
+		c.Redirect(http.StatusFound, *track.SubtitleURL)
+		return
+	}
+
+	c.Data(http.StatusOK, "text/vtt; charset=utf-8", []byte(*track.Content))
+}
+
+// ShiftSubtitle handles POST /anime/episodes/{episode_id}/subtitles/{language_code}/shift
+func (h *AnimeEpisodeHandler) ShiftSubtitle(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.ShiftEpisodeSubtitleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	response, err := h.episodeService.ShiftSubtitle(ctx, c.Param("episode_id"), c.Param("language_code"), req, actor)
+	if err != nil {
+		status, code, message, description := mapAnimeServiceError(c, err, "shift_subtitle")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.anime_episodes.subtitle.shift.success", "Subtitle timing shifted successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// DeleteSubtitle handles DELETE /anime/episodes/{episode_id}/subtitles/{language_code}
+func (h *AnimeEpisodeHandler) DeleteSubtitle(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	if err := h.episodeService.DeleteSubtitle(ctx, c.Param("episode_id"), c.Param("language_code"), actor); err != nil {
+		status, code, message, description := mapAnimeServiceError(c, err, "delete_subtitle")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.anime_episodes.subtitle.delete.success", "Subtitle deleted successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    nil,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
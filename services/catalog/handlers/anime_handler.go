@@ -0,0 +1,449 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+	r "wibusystem/pkg/common/response"
+	"wibusystem/pkg/i18n"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// AnimeHandler handles anime series endpoints
+type AnimeHandler struct {
+	animeService         interfaces.AnimeServiceInterface
+	relationService      interfaces.ContentRelationServiceInterface
+	contentFilterService interfaces.ContentFilterServiceInterface
+	loc                  *i18n.Translator
+}
+
+// NewAnimeHandler creates a new anime handler
+func NewAnimeHandler(animeService interfaces.AnimeServiceInterface, relationService interfaces.ContentRelationServiceInterface, contentFilterService interfaces.ContentFilterServiceInterface, translator *i18n.Translator) *AnimeHandler {
+	return &AnimeHandler{
+		animeService:         animeService,
+		relationService:      relationService,
+		contentFilterService: contentFilterService,
+		loc:                  translator,
+	}
+}
+
+// CreateAnime handles POST /anime
+func (h *AnimeHandler) CreateAnime(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.CreateAnimeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	anime, err := h.animeService.CreateAnime(ctx, req, actor)
+	if err != nil {
+		status, code, message, description := mapAnimeServiceError(c, err, "create")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.anime.create.success", "Anime created successfully")
+	c.JSON(http.StatusCreated, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    anime,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// ListAnime handles GET /anime
+func (h *AnimeHandler) ListAnime(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req d.ListAnimeRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_query_parameters", "Invalid query parameters")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	// Age gating and the viewer's filters are applied by the list query itself
+	viewer := currentViewer(c)
+	visibility, err := h.contentFilterService.Visibility(ctx, viewer)
+	if err != nil {
+		status, code, message, description := mapAnimeServiceError(c, err, "list")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+	req.Visibility = visibility
+
+	response, err := h.animeService.ListAnime(ctx, req, preferredLanguage(c))
+	if err != nil {
+		status, code, message, description := mapAnimeServiceError(c, err, "list")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	// Mark anime the viewer's age or filters blur
+	items, err := h.contentFilterService.FilterAnime(ctx, viewer, response.Anime)
+	if err != nil {
+		status, code, message, description := mapAnimeServiceError(c, err, "list")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.anime.list.success", "Anime retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    items,
+		Error:   nil,
+		Meta: map[string]interface{}{
+			"pagination": response.Pagination,
+		},
+	})
+}
+
+// GetAnimeByID handles GET /anime/{anime_id}
+func (h *AnimeHandler) GetAnimeByID(c *gin.Context) {
+	ctx := c.Request.Context()
+	animeID := c.Param("anime_id")
+
+	includeRelations := c.DefaultQuery("include_relations", "false") == "true"
+	relationDepth, _ := strconv.Atoi(c.DefaultQuery("relation_depth", "1"))
+
+	// Age gating and the viewer's filters; blurred anime is returned with its restriction
+	restriction, err := h.contentFilterService.CheckAnime(ctx, currentViewer(c), animeID)
+	if err != nil {
+		status, code, message, description := mapAnimeServiceError(c, err, "get")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+	if restriction != nil && restriction.Display == m.RestrictedDisplayHide {
+		respondContentRestricted(c, restriction)
+		return
+	}
+
+	anime, err := h.animeService.GetAnimeByID(ctx, animeID, preferredLanguage(c))
+	if err != nil {
+		status, code, message, description := mapAnimeServiceError(c, err, "get")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	// Franchise graph (source novel, sequels, spin-offs...) around this anime
+	if includeRelations {
+		relations, err := h.relationService.GetGraph(ctx, d.ContentRelationGraphRequest{
+			ContentType: string(m.ContentTypeAnime),
+			ContentID:   animeID,
+			Depth:       relationDepth,
+		})
+		if err != nil {
+			status, code, message, description := mapContentRelationServiceError(c, err, "graph")
+			c.JSON(status, r.StandardResponse{
+				Success: false,
+				Message: message,
+				Data:    nil,
+				Error:   &r.ErrorDetail{Code: code, Description: description},
+				Meta:    map[string]interface{}{},
+			})
+			return
+		}
+		anime.Relations = relations
+	}
+
+	anime.Restriction = restriction
+
+	successMessage := i18n.Localize(c, "catalog.anime.get.success", "Anime retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    anime,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// UpdateAnime handles PUT /anime/{anime_id}
+func (h *AnimeHandler) UpdateAnime(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.UpdateAnimeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	anime, err := h.animeService.UpdateAnime(ctx, c.Param("anime_id"), req, actor)
+	if err != nil {
+		status, code, message, description := mapAnimeServiceError(c, err, "update")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.anime.update.success", "Anime updated successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    anime,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// DeleteAnime handles DELETE /anime/{anime_id}
+func (h *AnimeHandler) DeleteAnime(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	if err := h.animeService.DeleteAnime(ctx, c.Param("anime_id"), actor); err != nil {
+		status, code, message, description := mapAnimeServiceError(c, err, "delete")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.anime.delete.success", "Anime deleted successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    nil,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// SetTranslations handles PUT /anime/{anime_id}/translations
+func (h *AnimeHandler) SetTranslations(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.SetAnimeTranslationsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	if err := h.animeService.SetTranslations(ctx, c.Param("anime_id"), req, actor); err != nil {
+		status, code, message, description := mapAnimeServiceError(c, err, "translations")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.anime.translations.success", "Anime titles updated successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    req.Translations,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// SetCast handles PUT /anime/{anime_id}/cast
+func (h *AnimeHandler) SetCast(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.SetAnimeCastRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	if err := h.animeService.SetCast(ctx, c.Param("anime_id"), req, actor); err != nil {
+		status, code, message, description := mapAnimeServiceError(c, err, "cast")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.anime.cast.success", "Anime cast updated successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    req.Cast,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// preferredLanguage reads the client language from X-Language or Accept-Language (default: vi)
+func preferredLanguage(c *gin.Context) string {
+	language := c.GetHeader("X-Language")
+	if language == "" {
+		language = c.GetHeader("Accept-Language")
+		if language == "" {
+			language = "vi" // Default language
+		}
+	}
+	return language
+}
+
+// mapAnimeServiceError maps anime, season and episode service errors to HTTP responses
+func mapAnimeServiceError(c *gin.Context, err error, operation string) (int, string, string, string) {
+	errStr := err.Error()
+
+	switch {
+	case strings.Contains(errStr, "ID format"):
+		message := i18n.Localize(c, "catalog.common.error.invalid_id_format", "Invalid ID format")
+		return http.StatusBadRequest, "invalid_id", message, errStr
+
+	case strings.Contains(errStr, "permission denied"):
+		message := i18n.Localize(c, "catalog.anime.error.forbidden", "You do not have permission to manage this anime")
+		return http.StatusForbidden, "forbidden", message, errStr
+
+	case strings.Contains(errStr, "purchase required"):
+		message := i18n.Localize(c, "catalog.anime.error.purchase_required", "Purchase or rent this episode to watch it")
+		return http.StatusForbidden, "purchase_required", message, errStr
+
+	case strings.Contains(errStr, "users have purchased"):
+		message := i18n.Localize(c, "catalog.anime.error.has_purchases", "Content that users have purchased cannot be deleted")
+		return http.StatusConflict, "has_purchases", message, errStr
+
+	case strings.Contains(errStr, "genres do not exist"), strings.Contains(errStr, "character or voice actor not found"):
+		message := i18n.Localize(c, "catalog.anime.error.invalid_reference", "Some referenced genres, characters or voice actors do not exist")
+		return http.StatusBadRequest, "invalid_reference", message, errStr
+
+	case strings.Contains(errStr, "invalid"):
+		message := i18n.Localize(c, "catalog.common.error.validation", "Validation error")
+		return http.StatusBadRequest, "validation_error", message, errStr
+
+	case strings.Contains(errStr, "required"):
+		message := i18n.Localize(c, "catalog.common.error.required_field", "Required field missing")
+		return http.StatusBadRequest, "required_field", message, errStr
+
+	case strings.Contains(errStr, "not found"):
+		message := i18n.Localize(c, "catalog.common.error.not_found", "Resource not found")
+		return http.StatusNotFound, "not_found", message, errStr
+
+	case strings.Contains(errStr, "already reviewed"):
+		message := i18n.Localize(c, "catalog.anime.error.already_reviewed", "The subtitle contribution has already been reviewed")
+		return http.StatusConflict, "already_reviewed", message, errStr
+
+	case strings.Contains(errStr, "already exists"):
+		message := i18n.Localize(c, "catalog.anime.error.already_exists", "A season or episode with this number already exists")
+		return http.StatusConflict, "conflict", message, errStr
+
+	default:
+		message := i18n.Localize(c, "catalog.common.error.internal", "Internal server error")
+		return http.StatusInternalServerError, "internal_error", message, errStr
+	}
+}
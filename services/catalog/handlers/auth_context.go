@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	d "wibusystem/pkg/common/dto"
+	r "wibusystem/pkg/common/response"
+	"wibusystem/pkg/i18n"
+	authmw "wibusystem/pkg/middleware/auth"
+)
+
+// currentUser returns the authenticated user set by the auth middleware.
+// When it is missing the handler has already been sent a 401 response.
+func currentUser(c *gin.Context) (*authmw.UserContext, bool) {
+	user, ok := authmw.GetUserFromContext(c)
+	if !ok || user == nil || user.UserID == uuid.Nil {
+		message := i18n.Localize(c, "catalog.common.error.unauthorized", "Authentication required")
+		c.JSON(http.StatusUnauthorized, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "unauthorized", Description: "authenticated user not found in request context"},
+			Meta:    map[string]interface{}{},
+		})
+		return nil, false
+	}
+	return user, true
+}
+
+// currentUserID returns the authenticated user's ID, see currentUser.
+func currentUserID(c *gin.Context) (uuid.UUID, bool) {
+	user, ok := currentUser(c)
+	if !ok {
+		return uuid.Nil, false
+	}
+	return user.UserID, true
+}
+
+// currentActor returns the authenticated user as an owned-content actor, see currentUser.
+func currentActor(c *gin.Context) (d.ContentActor, bool) {
+	user, ok := currentUser(c)
+	if !ok {
+		return d.ContentActor{}, false
+	}
+	return d.ContentActor{UserID: user.UserID, TenantID: user.TenantID, IsAdmin: user.IsAdmin()}, true
+}
+
+// currentViewer returns whoever is looking at content, anonymous when the request carries no
+// valid token; it never writes a response.
+func currentViewer(c *gin.Context) d.ContentViewer {
+	user, ok := authmw.GetUserFromContext(c)
+	if !ok || user == nil || user.UserID == uuid.Nil {
+		return d.ContentViewer{}
+	}
+
+	userID := user.UserID
+	viewer := d.ContentViewer{UserID: &userID, IsAdmin: user.IsAdmin()}
+	if age, ok := user.Age(); ok {
+		viewer.Age = &age
+	}
+	return viewer
+}
@@ -1,29 +1,34 @@
 package handlers
 
 import (
+	"log"
 	"net/http"
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 
 	d "wibusystem/pkg/common/dto"
 	r "wibusystem/pkg/common/response"
 	"wibusystem/pkg/i18n"
+	authmw "wibusystem/pkg/middleware/auth"
 	"wibusystem/services/catalog/services/interfaces"
 )
 
 // ChapterHandler handles HTTP requests for chapter management operations.
 // This follows the API design spec from /services/catalog/api-design/novel.md sections 3.1-3.7
 type ChapterHandler struct {
-	service    interfaces.ChapterServiceInterface
-	translator *i18n.Translator
+	service          interfaces.ChapterServiceInterface
+	analyticsService interfaces.AnalyticsServiceInterface
+	translator       *i18n.Translator
 }
 
 // NewChapterHandler creates a new ChapterHandler instance with the given dependencies.
-func NewChapterHandler(service interfaces.ChapterServiceInterface, translator *i18n.Translator) *ChapterHandler {
+func NewChapterHandler(service interfaces.ChapterServiceInterface, analyticsService interfaces.AnalyticsServiceInterface, translator *i18n.Translator) *ChapterHandler {
 	return &ChapterHandler{
-		service:    service,
-		translator: translator,
+		service:          service,
+		analyticsService: analyticsService,
+		translator:       translator,
 	}
 }
 
@@ -79,7 +84,7 @@ func (h *ChapterHandler) GetChapterByID(c *gin.Context) {
 		includeContent = true
 	}
 
-	chapter, err := h.service.GetChapterByID(c.Request.Context(), id, includeContent)
+	chapter, err := h.service.GetChapterByID(c.Request.Context(), id, includeContent, i18n.ContentLanguages(c))
 	if err != nil {
 		status, code, message, detail := mapChapterServiceError(c, err, "get")
 		c.JSON(status, r.StandardResponse{
@@ -92,6 +97,17 @@ func (h *ChapterHandler) GetChapterByID(c *gin.Context) {
 		return
 	}
 
+	// Reading the content counts as a chapter read for owner analytics
+	if includeContent {
+		var readerID *uuid.UUID
+		if user, ok := authmw.GetUserFromContext(c); ok && user != nil && user.UserID != uuid.Nil {
+			readerID = &user.UserID
+		}
+		if err := h.analyticsService.RecordChapterRead(c.Request.Context(), id, readerID); err != nil {
+			log.Printf("failed to record chapter read for %s: %v", id, err)
+		}
+	}
+
 	successMessage := i18n.Localize(c, "catalog.chapters.get.success", "Chapter retrieved successfully")
 	c.JSON(http.StatusOK, r.StandardResponse{
 		Success: true,
@@ -316,6 +332,10 @@ func mapChapterServiceError(c *gin.Context, err error, operation string) (status
 		message := i18n.Localize(c, "catalog.chapters.error.cannot_publish_draft", "Cannot publish a draft chapter")
 		return http.StatusBadRequest, "CANNOT_PUBLISH_DRAFT", message, errMsg
 
+	case strings.Contains(lower, "invalid content warning"):
+		message := i18n.Localize(c, "catalog.content_warnings.error.invalid", "Content warnings must use codes from the content warning taxonomy")
+		return http.StatusBadRequest, "INVALID_CONTENT_WARNINGS", message, errMsg
+
 	case strings.Contains(lower, "invalid pagination") || strings.Contains(lower, "invalid query param"):
 		message := i18n.Localize(c, "catalog.chapters.error.invalid_pagination", "Invalid pagination parameters")
 		return http.StatusBadRequest, "INVALID_PAGINATION", message, errMsg
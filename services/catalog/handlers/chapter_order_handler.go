@@ -0,0 +1,246 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	d "wibusystem/pkg/common/dto"
+	r "wibusystem/pkg/common/response"
+	"wibusystem/pkg/i18n"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// ChapterOrderHandler handles reordering and moving chapters and splitting and merging volumes
+type ChapterOrderHandler struct {
+	chapterOrderService interfaces.ChapterOrderServiceInterface
+	loc                 *i18n.Translator
+}
+
+// NewChapterOrderHandler creates a new chapter order handler
+func NewChapterOrderHandler(chapterOrderService interfaces.ChapterOrderServiceInterface, translator *i18n.Translator) *ChapterOrderHandler {
+	return &ChapterOrderHandler{
+		chapterOrderService: chapterOrderService,
+		loc:                 translator,
+	}
+}
+
+// ReorderChapters handles PUT /volumes/{volume_id}/chapters/order
+func (h *ChapterOrderHandler) ReorderChapters(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.ReorderChaptersRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	response, err := h.chapterOrderService.ReorderChapters(ctx, c.Param("volume_id"), req, actor)
+	if err != nil {
+		status, code, message, description := mapChapterOrderServiceError(c, err, "reorder")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.chapter_order.reorder.success", "Chapters reordered successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// MoveChapters handles POST /volumes/{volume_id}/chapters/move
+func (h *ChapterOrderHandler) MoveChapters(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.MoveChaptersRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	response, err := h.chapterOrderService.MoveChapters(ctx, c.Param("volume_id"), req, actor)
+	if err != nil {
+		status, code, message, description := mapChapterOrderServiceError(c, err, "move")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.chapter_order.move.success", "Chapters moved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// SplitVolume handles POST /volumes/{volume_id}/split
+func (h *ChapterOrderHandler) SplitVolume(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.SplitVolumeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	response, err := h.chapterOrderService.SplitVolume(ctx, c.Param("volume_id"), req, actor)
+	if err != nil {
+		status, code, message, description := mapChapterOrderServiceError(c, err, "split")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.chapter_order.split.success", "Volume split successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// MergeVolume handles POST /volumes/{volume_id}/merge
+func (h *ChapterOrderHandler) MergeVolume(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.MergeVolumeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	response, err := h.chapterOrderService.MergeVolume(ctx, c.Param("volume_id"), req, actor)
+	if err != nil {
+		status, code, message, description := mapChapterOrderServiceError(c, err, "merge")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.chapter_order.merge.success", "Volumes merged successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// mapChapterOrderServiceError maps chapter order errors to HTTP responses
+func mapChapterOrderServiceError(c *gin.Context, err error, operation string) (int, string, string, string) {
+	errStr := err.Error()
+
+	switch {
+	case strings.Contains(errStr, "ID format"):
+		message := i18n.Localize(c, "catalog.common.error.invalid_id_format", "Invalid ID format")
+		return http.StatusBadRequest, "invalid_id", message, errStr
+
+	case strings.Contains(errStr, "invalid"):
+		message := i18n.Localize(c, "catalog.common.error.validation", "Validation error")
+		return http.StatusBadRequest, "validation_error", message, errStr
+
+	case strings.Contains(errStr, "permission denied"):
+		message := i18n.Localize(c, "catalog.chapter_order.error.forbidden", "You do not have permission to restructure this novel")
+		return http.StatusForbidden, "forbidden", message, errStr
+
+	case strings.Contains(errStr, "users have purchased"):
+		message := i18n.Localize(c, "catalog.chapter_order.error.purchased", "Chapters cannot leave a volume that users have purchased")
+		return http.StatusConflict, "volume_purchased", message, errStr
+
+	case strings.Contains(errStr, "changed while reordering"):
+		message := i18n.Localize(c, "catalog.chapter_order.error.stale", "The chapters changed in the meantime, reload and try again")
+		return http.StatusConflict, "stale_order", message, errStr
+
+	case strings.Contains(errStr, "already exists"):
+		message := i18n.Localize(c, "catalog.chapter_order.error.volume_number_taken", "A volume with this number already exists")
+		return http.StatusConflict, "volume_number_taken", message, errStr
+
+	case strings.Contains(errStr, "not found"):
+		message := i18n.Localize(c, "catalog.common.error.not_found", "Resource not found")
+		return http.StatusNotFound, "not_found", message, errStr
+
+	default:
+		message := i18n.Localize(c, "catalog.common.error.internal", "Internal server error")
+		return http.StatusInternalServerError, "internal_error", message, errStr
+	}
+}
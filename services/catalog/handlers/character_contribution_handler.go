@@ -0,0 +1,376 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"wibusystem/pkg/common/auth"
+	d "wibusystem/pkg/common/dto"
+	r "wibusystem/pkg/common/response"
+	"wibusystem/pkg/i18n"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// CharacterContributionHandler handles character proposals and their review
+type CharacterContributionHandler struct {
+	contributionService interfaces.CharacterContributionServiceInterface
+	loc                 *i18n.Translator
+}
+
+// NewCharacterContributionHandler creates a new character contribution handler
+func NewCharacterContributionHandler(contributionService interfaces.CharacterContributionServiceInterface, translator *i18n.Translator) *CharacterContributionHandler {
+	return &CharacterContributionHandler{
+		contributionService: contributionService,
+		loc:                 translator,
+	}
+}
+
+// CreateContribution handles POST /characters/contributions
+func (h *CharacterContributionHandler) CreateContribution(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	var req d.CreateCharacterContributionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	response, err := h.contributionService.CreateContribution(ctx, user.UserID, user.TenantID, req)
+	if err != nil {
+		status, code, message, description := mapCharacterContributionServiceError(c, err, "create")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.character_contributions.create.success", "Character contribution submitted for review")
+	c.JSON(http.StatusCreated, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// UpdateContribution handles PUT /characters/contributions/{contribution_id}
+func (h *CharacterContributionHandler) UpdateContribution(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req d.UpdateCharacterContributionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	response, err := h.contributionService.UpdateContribution(ctx, c.Param("contribution_id"), userID, req)
+	if err != nil {
+		status, code, message, description := mapCharacterContributionServiceError(c, err, "update")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.character_contributions.update.success", "Character contribution updated successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// GetContribution handles GET /characters/contributions/{contribution_id}
+func (h *CharacterContributionHandler) GetContribution(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	canReview := user.IsAdmin() || user.HasAnyScope(string(auth.PermCharacterApprove), string(auth.PermCharacterReject))
+
+	response, err := h.contributionService.GetContribution(ctx, c.Param("contribution_id"), user.UserID, canReview)
+	if err != nil {
+		status, code, message, description := mapCharacterContributionServiceError(c, err, "get")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.character_contributions.get.success", "Character contribution retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// ListContributions handles GET /characters/contributions (review queue)
+func (h *CharacterContributionHandler) ListContributions(c *gin.Context) {
+	h.list(c, false)
+}
+
+// ListMyContributions handles GET /characters/contributions/mine
+func (h *CharacterContributionHandler) ListMyContributions(c *gin.Context) {
+	h.list(c, true)
+}
+
+// list binds the query and returns either the review queue or the caller's own contributions
+func (h *CharacterContributionHandler) list(c *gin.Context, mine bool) {
+	ctx := c.Request.Context()
+
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req d.ListCharacterContributionsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_query_parameters", "Invalid query parameters")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	var response *d.PaginatedCharacterContributionsResponse
+	var err error
+	if mine {
+		response, err = h.contributionService.ListMyContributions(ctx, userID, req)
+	} else {
+		response, err = h.contributionService.ListContributions(ctx, req)
+	}
+	if err != nil {
+		status, code, message, description := mapCharacterContributionServiceError(c, err, "list")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.character_contributions.list.success", "Character contributions retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response.Contributions,
+		Error:   nil,
+		Meta: map[string]interface{}{
+			"pagination": response.Pagination,
+		},
+	})
+}
+
+// ApproveContribution handles POST /characters/contributions/{contribution_id}/approve
+func (h *CharacterContributionHandler) ApproveContribution(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	response, err := h.contributionService.ApproveContribution(ctx, c.Param("contribution_id"), userID)
+	if err != nil {
+		status, code, message, description := mapCharacterContributionServiceError(c, err, "approve")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.character_contributions.approve.success", "Character contribution approved and merged")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// RejectContribution handles POST /characters/contributions/{contribution_id}/reject
+func (h *CharacterContributionHandler) RejectContribution(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req d.RejectCharacterContributionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	if err := h.contributionService.RejectContribution(ctx, c.Param("contribution_id"), userID, req); err != nil {
+		status, code, message, description := mapCharacterContributionServiceError(c, err, "reject")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.character_contributions.reject.success", "Character contribution rejected")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    nil,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// ListContributors handles GET /characters/{id}/contributors
+func (h *CharacterContributionHandler) ListContributors(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	characterID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		message := i18n.Localize(c, "catalog.characters.error.invalid_id", "Invalid character ID")
+		detail := i18n.Localize(c, "catalog.characters.error.invalid_id_detail", "Character ID must be a valid UUID")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "invalid_id", Description: detail},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	contributors, err := h.contributionService.ListContributors(ctx, characterID)
+	if err != nil {
+		status, code, message, description := mapCharacterContributionServiceError(c, err, "contributors")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.character_contributions.contributors.success", "Character contributors retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    contributors,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// mapCharacterContributionServiceError maps service errors to appropriate HTTP responses for contribution operations
+func mapCharacterContributionServiceError(c *gin.Context, err error, operation string) (int, string, string, string) {
+	errStr := err.Error()
+
+	switch {
+	case strings.Contains(errStr, "ID format"):
+		message := i18n.Localize(c, "catalog.common.error.invalid_id_format", "Invalid ID format")
+		return http.StatusBadRequest, "invalid_id", message, errStr
+
+	case strings.Contains(errStr, "no changes proposed"):
+		message := i18n.Localize(c, "catalog.character_contributions.error.no_changes", "The contribution does not propose any change")
+		return http.StatusBadRequest, "no_changes", message, errStr
+
+	case strings.Contains(errStr, "invalid"):
+		message := i18n.Localize(c, "catalog.common.error.validation", "Validation error")
+		return http.StatusBadRequest, "validation_error", message, errStr
+
+	case strings.Contains(errStr, "required"):
+		message := i18n.Localize(c, "catalog.common.error.required_field", "Required field missing")
+		return http.StatusBadRequest, "required_field", message, errStr
+
+	case strings.Contains(errStr, "permission denied"):
+		message := i18n.Localize(c, "catalog.character_contributions.error.forbidden", "You do not have access to this contribution")
+		return http.StatusForbidden, "forbidden", message, errStr
+
+	case strings.Contains(errStr, "not found"):
+		message := i18n.Localize(c, "catalog.common.error.not_found", "Resource not found")
+		return http.StatusNotFound, "not_found", message, errStr
+
+	case strings.Contains(errStr, "already reviewed"):
+		message := i18n.Localize(c, "catalog.character_contributions.error.already_reviewed", "The contribution has already been reviewed")
+		return http.StatusConflict, "already_reviewed", message, errStr
+
+	case strings.Contains(errStr, "already exists"):
+		message := i18n.Localize(c, "catalog.common.error.conflict", "Resource already exists")
+		return http.StatusConflict, "conflict", message, errStr
+
+	default:
+		message := i18n.Localize(c, "catalog.common.error.internal", "Internal server error")
+		return http.StatusInternalServerError, "internal_error", message, errStr
+	}
+}
@@ -112,13 +112,19 @@ func (h *CharacterHandler) GetCharacter(c *gin.Context) {
 		return
 	}
 
+	// A merged character is served under the ID it was merged into
+	meta := map[string]interface{}{}
+	if character.ID != characterID {
+		meta["redirected_from"] = characterID
+	}
+
 	successMessage := i18n.Localize(c, "catalog.characters.get.success", "Character fetched successfully")
 	c.JSON(http.StatusOK, r.StandardResponse{
 		Success: true,
 		Message: successMessage,
 		Data:    character,
 		Error:   nil,
-		Meta:    map[string]interface{}{},
+		Meta:    meta,
 	})
 }
 
@@ -263,3 +269,156 @@ func (h *CharacterHandler) DeleteCharacter(c *gin.Context) {
 		Meta:    map[string]interface{}{},
 	})
 }
+
+// FindDuplicateCharacters handles GET /characters/duplicates?name=
+func (h *CharacterHandler) FindDuplicateCharacters(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req d.FindDuplicatesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_query_parameters", "Invalid query parameters")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	candidates, err := h.characterService.FindDuplicateCharacters(ctx, req)
+	if err != nil {
+		status, code, message, description := mapServiceError(c, err, "duplicates")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.characters.duplicates.success", "Possible duplicates fetched successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    candidates,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// GetCharacterDuplicates handles GET /characters/:id/duplicates
+func (h *CharacterHandler) GetCharacterDuplicates(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	characterID, ok := characterIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req d.FindDuplicatesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_query_parameters", "Invalid query parameters")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	candidates, err := h.characterService.FindCharacterDuplicatesOf(ctx, characterID, req)
+	if err != nil {
+		status, code, message, description := mapServiceError(c, err, "duplicates")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.characters.duplicates.success", "Possible duplicates fetched successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    candidates,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// MergeCharacter handles POST /characters/:id/merge
+func (h *CharacterHandler) MergeCharacter(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	characterID, ok := characterIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req d.MergeCharacterRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	result, err := h.characterService.MergeCharacters(ctx, characterID, req, actor)
+	if err != nil {
+		status, code, message, description := mapServiceError(c, err, "merge")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.characters.merge.success", "Characters merged successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    result,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// characterIDParam parses the :id path parameter, writing the error response when it is not a UUID
+func characterIDParam(c *gin.Context) (uuid.UUID, bool) {
+	characterID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		message := i18n.Localize(c, "catalog.characters.error.invalid_id", "Invalid character ID")
+		detail := i18n.Localize(c, "catalog.characters.error.invalid_id_detail", "Character ID must be a valid UUID")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "invalid_id", Description: detail},
+			Meta:    map[string]interface{}{},
+		})
+		return uuid.Nil, false
+	}
+	return characterID, true
+}
@@ -0,0 +1,222 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	d "wibusystem/pkg/common/dto"
+	r "wibusystem/pkg/common/response"
+	"wibusystem/pkg/i18n"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// ContentFilterHandler handles reader content filters and tenant age gating policies
+type ContentFilterHandler struct {
+	contentFilterService interfaces.ContentFilterServiceInterface
+	loc                  *i18n.Translator
+}
+
+// NewContentFilterHandler creates a new content filter handler
+func NewContentFilterHandler(contentFilterService interfaces.ContentFilterServiceInterface, translator *i18n.Translator) *ContentFilterHandler {
+	return &ContentFilterHandler{
+		contentFilterService: contentFilterService,
+		loc:                  translator,
+	}
+}
+
+// GetMyFilter handles GET /me/content-filters
+func (h *ContentFilterHandler) GetMyFilter(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	filter, err := h.contentFilterService.GetMyFilter(ctx, userID)
+	if err != nil {
+		status, code, message, description := mapContentFilterServiceError(c, err, "get_filter")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.content_filter.get.success", "Content filters retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    filter,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// UpdateMyFilter handles PUT /me/content-filters
+func (h *ContentFilterHandler) UpdateMyFilter(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req d.UpdateContentFilterRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	filter, err := h.contentFilterService.UpdateMyFilter(ctx, userID, req)
+	if err != nil {
+		status, code, message, description := mapContentFilterServiceError(c, err, "update_filter")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.content_filter.update.success", "Content filters updated successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    filter,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// GetTenantPolicy handles GET /tenants/{tenant_id}/content-policy
+func (h *ContentFilterHandler) GetTenantPolicy(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	policy, err := h.contentFilterService.GetTenantPolicy(ctx, c.Param("tenant_id"), actor)
+	if err != nil {
+		status, code, message, description := mapContentFilterServiceError(c, err, "get_policy")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.content_filter.policy.get.success", "Content policy retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    policy,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// UpdateTenantPolicy handles PUT /tenants/{tenant_id}/content-policy
+func (h *ContentFilterHandler) UpdateTenantPolicy(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.UpdateTenantContentPolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	policy, err := h.contentFilterService.UpdateTenantPolicy(ctx, c.Param("tenant_id"), req, actor)
+	if err != nil {
+		status, code, message, description := mapContentFilterServiceError(c, err, "update_policy")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.content_filter.policy.update.success", "Content policy updated successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    policy,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// respondContentRestricted rejects reading a hidden item with its structured reasons in meta
+func respondContentRestricted(c *gin.Context, restriction *d.ContentRestriction) {
+	message := i18n.Localize(c, "catalog.content_filter.error.restricted", "This content is restricted for your age or content filters")
+	c.JSON(http.StatusForbidden, r.StandardResponse{
+		Success: false,
+		Message: message,
+		Data:    nil,
+		Error:   &r.ErrorDetail{Code: "content_restricted", Description: "content restricted for the current viewer"},
+		Meta: map[string]interface{}{
+			"restriction": restriction,
+		},
+	})
+}
+
+// mapContentFilterServiceError maps content filter service errors to HTTP responses
+func mapContentFilterServiceError(c *gin.Context, err error, operation string) (int, string, string, string) {
+	errStr := err.Error()
+
+	switch {
+	case strings.Contains(errStr, "ID format"):
+		message := i18n.Localize(c, "catalog.common.error.invalid_id_format", "Invalid ID format")
+		return http.StatusBadRequest, "invalid_id", message, errStr
+
+	case strings.Contains(errStr, "invalid"):
+		message := i18n.Localize(c, "catalog.common.error.validation", "Validation error")
+		return http.StatusBadRequest, "validation_error", message, errStr
+
+	case strings.Contains(errStr, "permission denied"):
+		message := i18n.Localize(c, "catalog.content_filter.error.forbidden", "You do not have permission to manage the content policy of this tenant")
+		return http.StatusForbidden, "forbidden", message, errStr
+
+	case strings.Contains(errStr, "not found"):
+		message := i18n.Localize(c, "catalog.common.error.not_found", "Resource not found")
+		return http.StatusNotFound, "not_found", message, errStr
+
+	default:
+		message := i18n.Localize(c, "catalog.common.error.internal", "Internal server error")
+		return http.StatusInternalServerError, "internal_error", message, errStr
+	}
+}
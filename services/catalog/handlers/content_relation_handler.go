@@ -0,0 +1,275 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	d "wibusystem/pkg/common/dto"
+	r "wibusystem/pkg/common/response"
+	"wibusystem/pkg/i18n"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// ContentRelationHandler handles relations between works and the franchise graph
+type ContentRelationHandler struct {
+	relationService interfaces.ContentRelationServiceInterface
+	loc             *i18n.Translator
+}
+
+// NewContentRelationHandler creates a new content relation handler
+func NewContentRelationHandler(relationService interfaces.ContentRelationServiceInterface, translator *i18n.Translator) *ContentRelationHandler {
+	return &ContentRelationHandler{
+		relationService: relationService,
+		loc:             translator,
+	}
+}
+
+// CreateRelation handles POST /relations
+func (h *ContentRelationHandler) CreateRelation(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req d.CreateContentRelationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	relation, err := h.relationService.CreateRelation(ctx, req)
+	if err != nil {
+		status, code, message, description := mapContentRelationServiceError(c, err, "create")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.relations.create.success", "Relation created successfully")
+	c.JSON(http.StatusCreated, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    relation,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// ListRelations handles GET /relations
+func (h *ContentRelationHandler) ListRelations(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req d.ListContentRelationsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_query_parameters", "Invalid query parameters")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	relations, err := h.relationService.ListRelations(ctx, req)
+	if err != nil {
+		status, code, message, description := mapContentRelationServiceError(c, err, "list")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.relations.list.success", "Relations retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    relations,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// GetRelation handles GET /relations/{relation_id}
+func (h *ContentRelationHandler) GetRelation(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	relation, err := h.relationService.GetRelation(ctx, c.Param("relation_id"))
+	if err != nil {
+		status, code, message, description := mapContentRelationServiceError(c, err, "get")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.relations.get.success", "Relation retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    relation,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// UpdateRelation handles PUT /relations/{relation_id}
+func (h *ContentRelationHandler) UpdateRelation(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req d.UpdateContentRelationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	relation, err := h.relationService.UpdateRelation(ctx, c.Param("relation_id"), req)
+	if err != nil {
+		status, code, message, description := mapContentRelationServiceError(c, err, "update")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.relations.update.success", "Relation updated successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    relation,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// DeleteRelation handles DELETE /relations/{relation_id}
+func (h *ContentRelationHandler) DeleteRelation(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	if err := h.relationService.DeleteRelation(ctx, c.Param("relation_id")); err != nil {
+		status, code, message, description := mapContentRelationServiceError(c, err, "delete")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.relations.delete.success", "Relation deleted successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    nil,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// GetGraph handles GET /relations/graph
+func (h *ContentRelationHandler) GetGraph(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req d.ContentRelationGraphRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_query_parameters", "Invalid query parameters")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	graph, err := h.relationService.GetGraph(ctx, req)
+	if err != nil {
+		status, code, message, description := mapContentRelationServiceError(c, err, "graph")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.relations.graph.success", "Relation graph retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    graph,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// mapContentRelationServiceError maps service errors to HTTP status codes and messages
+func mapContentRelationServiceError(c *gin.Context, err error, operation string) (int, string, string, string) {
+	errStr := err.Error()
+
+	switch {
+	case strings.Contains(errStr, "ID format"):
+		message := i18n.Localize(c, "catalog.common.error.invalid_id_format", "Invalid ID format")
+		return http.StatusBadRequest, "invalid_id", message, errStr
+
+	case strings.Contains(errStr, "related to itself"):
+		message := i18n.Localize(c, "catalog.relations.error.self_relation", "A work cannot be related to itself")
+		return http.StatusBadRequest, "self_relation", message, errStr
+
+	case strings.Contains(errStr, "invalid"):
+		message := i18n.Localize(c, "catalog.common.error.validation", "Validation error")
+		return http.StatusBadRequest, "validation_error", message, errStr
+
+	case strings.Contains(errStr, "source content not found"), strings.Contains(errStr, "target content not found"):
+		message := i18n.Localize(c, "catalog.relations.error.content_not_found", "The related work does not exist")
+		return http.StatusNotFound, "content_not_found", message, errStr
+
+	case strings.Contains(errStr, "not found"):
+		message := i18n.Localize(c, "catalog.common.error.not_found", "Resource not found")
+		return http.StatusNotFound, "not_found", message, errStr
+
+	case strings.Contains(errStr, "already exists"):
+		message := i18n.Localize(c, "catalog.relations.error.already_exists", "The relation already exists")
+		return http.StatusConflict, "conflict", message, errStr
+
+	default:
+		message := i18n.Localize(c, "catalog.common.error.internal", "Internal server error")
+		return http.StatusInternalServerError, "internal_error", message, errStr
+	}
+}
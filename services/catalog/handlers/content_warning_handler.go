@@ -0,0 +1,170 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	d "wibusystem/pkg/common/dto"
+	r "wibusystem/pkg/common/response"
+	"wibusystem/pkg/i18n"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// ContentWarningHandler handles the content warning taxonomy
+type ContentWarningHandler struct {
+	contentWarningService interfaces.ContentWarningServiceInterface
+	loc                   *i18n.Translator
+}
+
+// NewContentWarningHandler creates a new content warning handler
+func NewContentWarningHandler(contentWarningService interfaces.ContentWarningServiceInterface, translator *i18n.Translator) *ContentWarningHandler {
+	return &ContentWarningHandler{
+		contentWarningService: contentWarningService,
+		loc:                   translator,
+	}
+}
+
+// ListWarnings handles GET /content-warnings
+func (h *ContentWarningHandler) ListWarnings(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req d.ListContentWarningsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_query_parameters", "Invalid query parameters")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	warnings, err := h.contentWarningService.ListWarnings(ctx, req, i18n.ContentLanguages(c))
+	if err != nil {
+		status, code, message, description := mapContentWarningServiceError(c, err, "list")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.content_warnings.list.success", "Content warnings retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    warnings,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// CreateWarning handles POST /content-warnings
+func (h *ContentWarningHandler) CreateWarning(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req d.CreateContentWarningRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	warning, err := h.contentWarningService.CreateWarning(ctx, req)
+	if err != nil {
+		status, code, message, description := mapContentWarningServiceError(c, err, "create")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.content_warnings.create.success", "Content warning created successfully")
+	c.JSON(http.StatusCreated, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    warning,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// UpdateWarning handles PUT /content-warnings/{code}
+func (h *ContentWarningHandler) UpdateWarning(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req d.UpdateContentWarningRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	warning, err := h.contentWarningService.UpdateWarning(ctx, c.Param("code"), req)
+	if err != nil {
+		status, code, message, description := mapContentWarningServiceError(c, err, "update")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.content_warnings.update.success", "Content warning updated successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    warning,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// mapContentWarningServiceError maps content warning service errors to HTTP responses
+func mapContentWarningServiceError(c *gin.Context, err error, operation string) (int, string, string, string) {
+	errStr := err.Error()
+
+	switch {
+	case strings.Contains(errStr, "invalid"):
+		message := i18n.Localize(c, "catalog.common.error.validation", "Validation error")
+		return http.StatusBadRequest, "validation_error", message, errStr
+
+	case strings.Contains(errStr, "already exists"):
+		message := i18n.Localize(c, "catalog.content_warnings.error.exists", "A content warning with this code already exists")
+		return http.StatusConflict, "conflict", message, errStr
+
+	case strings.Contains(errStr, "not found"):
+		message := i18n.Localize(c, "catalog.content_warnings.error.not_found", "Content warning not found")
+		return http.StatusNotFound, "not_found", message, errStr
+
+	default:
+		message := i18n.Localize(c, "catalog.common.error.internal", "Internal server error")
+		return http.StatusInternalServerError, "internal_error", message, errStr
+	}
+}
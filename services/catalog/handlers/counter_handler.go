@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	d "wibusystem/pkg/common/dto"
+	r "wibusystem/pkg/common/response"
+	"wibusystem/pkg/i18n"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// CounterHandler handles the admin reconciliation of denormalized counters
+type CounterHandler struct {
+	counterService interfaces.CounterServiceInterface
+	loc            *i18n.Translator
+}
+
+// NewCounterHandler creates a new counter handler
+func NewCounterHandler(counterService interfaces.CounterServiceInterface, translator *i18n.Translator) *CounterHandler {
+	return &CounterHandler{
+		counterService: counterService,
+		loc:            translator,
+	}
+}
+
+// ReconcileNovelCounters handles POST /novels/{novel_id}/counters/reconcile
+func (h *CounterHandler) ReconcileNovelCounters(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	report, err := h.counterService.ReconcileNovel(ctx, c.Param("novel_id"))
+	if err != nil {
+		status, code, message, description := mapServiceError(c, err, "reconcile")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.counters.reconcile.success", "Counters reconciled successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    report,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// ReconcileImportedNovels handles POST /novels/counters/reconcile
+func (h *CounterHandler) ReconcileImportedNovels(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req d.ReconcileCountersRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	report, err := h.counterService.ReconcileNovels(ctx, req.NovelIDs)
+	if err != nil {
+		status, code, message, description := mapServiceError(c, err, "reconcile")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.counters.reconcile.success", "Counters reconciled successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    report,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
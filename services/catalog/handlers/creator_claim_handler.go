@@ -0,0 +1,267 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"wibusystem/pkg/common/auth"
+	d "wibusystem/pkg/common/dto"
+	r "wibusystem/pkg/common/response"
+	"wibusystem/pkg/i18n"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// CreatorClaimHandler handles creator claim submission and moderator review endpoints
+type CreatorClaimHandler struct {
+	claimService interfaces.CreatorClaimServiceInterface
+	loc          *i18n.Translator
+}
+
+// NewCreatorClaimHandler creates a new creator claim handler
+func NewCreatorClaimHandler(claimService interfaces.CreatorClaimServiceInterface, translator *i18n.Translator) *CreatorClaimHandler {
+	return &CreatorClaimHandler{
+		claimService: claimService,
+		loc:          translator,
+	}
+}
+
+// CreateClaim handles POST /creators/:id/claims
+func (h *CreatorClaimHandler) CreateClaim(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	creatorID, ok := creatorIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req d.CreateCreatorClaimRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	claim, err := h.claimService.CreateClaim(ctx, creatorID, req, actor)
+	if err != nil {
+		status, code, message, description := mapCreatorClaimServiceError(c, err, "create")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.creator_claims.create.success", "Creator claim submitted for review")
+	c.JSON(http.StatusCreated, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    claim,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// GetClaim handles GET /creators/claims/:claim_id
+func (h *CreatorClaimHandler) GetClaim(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	actor := d.ContentActor{UserID: user.UserID, TenantID: user.TenantID, IsAdmin: user.IsAdmin()}
+	canReview := user.IsAdmin() || user.HasAnyScope(string(auth.PermModerationContentReview))
+
+	claim, err := h.claimService.GetClaim(ctx, c.Param("claim_id"), actor, canReview)
+	if err != nil {
+		status, code, message, description := mapCreatorClaimServiceError(c, err, "get")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.creator_claims.get.success", "Creator claim retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    claim,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// ListClaims handles GET /creators/claims (moderator review queue)
+func (h *CreatorClaimHandler) ListClaims(c *gin.Context) {
+	h.list(c, false)
+}
+
+// ListMyClaims handles GET /creators/claims/mine
+func (h *CreatorClaimHandler) ListMyClaims(c *gin.Context) {
+	h.list(c, true)
+}
+
+// list binds the query and returns either the review queue or the caller's own claims
+func (h *CreatorClaimHandler) list(c *gin.Context, mine bool) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.ListCreatorClaimsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_query_parameters", "Invalid query parameters")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	var response *d.PaginatedCreatorClaimsResponse
+	var err error
+	if mine {
+		response, err = h.claimService.ListMyClaims(ctx, actor.UserID, req)
+	} else {
+		response, err = h.claimService.ListClaims(ctx, req)
+	}
+	if err != nil {
+		status, code, message, description := mapCreatorClaimServiceError(c, err, "list")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.creator_claims.list.success", "Creator claims retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response.Claims,
+		Error:   nil,
+		Meta: map[string]interface{}{
+			"pagination": response.Pagination,
+		},
+	})
+}
+
+// ReviewClaim handles POST /creators/claims/:claim_id/review
+func (h *CreatorClaimHandler) ReviewClaim(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.ReviewCreatorClaimRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	claim, err := h.claimService.ReviewClaim(ctx, c.Param("claim_id"), req, actor)
+	if err != nil {
+		status, code, message, description := mapCreatorClaimServiceError(c, err, "review")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.creator_claims.approve.success", "Creator claim approved and the creator linked")
+	if req.Action == "reject" {
+		successMessage = i18n.Localize(c, "catalog.creator_claims.reject.success", "Creator claim rejected")
+	}
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    claim,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// mapCreatorClaimServiceError maps service errors to HTTP status codes and localized messages
+func mapCreatorClaimServiceError(c *gin.Context, err error, operation string) (int, string, string, string) {
+	errStr := err.Error()
+
+	switch {
+	case strings.Contains(errStr, "ID format"):
+		message := i18n.Localize(c, "catalog.common.error.invalid_id_format", "Invalid ID format")
+		return http.StatusBadRequest, "invalid_id", message, errStr
+
+	case strings.Contains(errStr, "invalid"):
+		message := i18n.Localize(c, "catalog.common.error.validation", "Validation error")
+		return http.StatusBadRequest, "validation_error", message, errStr
+
+	case strings.Contains(errStr, "required"):
+		message := i18n.Localize(c, "catalog.common.error.required_field", "Required field missing")
+		return http.StatusBadRequest, "required_field", message, errStr
+
+	case strings.Contains(errStr, "permission denied"):
+		message := i18n.Localize(c, "catalog.creator_claims.error.forbidden", "You do not have access to this claim")
+		return http.StatusForbidden, "forbidden", message, errStr
+
+	case strings.Contains(errStr, "not found"):
+		message := i18n.Localize(c, "catalog.common.error.not_found", "Resource not found")
+		return http.StatusNotFound, "not_found", message, errStr
+
+	case strings.Contains(errStr, "already claimed"):
+		message := i18n.Localize(c, "catalog.creator_claims.error.already_claimed", "The creator is already linked to an account")
+		return http.StatusConflict, "already_claimed", message, errStr
+
+	case strings.Contains(errStr, "already pending"):
+		message := i18n.Localize(c, "catalog.creator_claims.error.already_pending", "You already have a pending claim for this creator")
+		return http.StatusConflict, "claim_pending", message, errStr
+
+	case strings.Contains(errStr, "already reviewed"):
+		message := i18n.Localize(c, "catalog.creator_claims.error.already_reviewed", "The claim has already been reviewed")
+		return http.StatusConflict, "already_reviewed", message, errStr
+
+	default:
+		message := i18n.Localize(c, "catalog.common.error.internal", "Internal server error")
+		return http.StatusInternalServerError, "internal_error", message, errStr
+	}
+}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -99,7 +100,7 @@ func (h *CreatorHandler) GetCreator(c *gin.Context) {
 	}
 
 	// Get creator through service
-	creator, err := h.creatorService.GetCreatorByID(ctx, creatorID)
+	creator, err := h.creatorService.GetCreatorByID(ctx, creatorID, i18n.ContentLanguages(c))
 	if err != nil {
 		status, code, message, description := mapServiceError(c, err, "get")
 		c.JSON(status, r.StandardResponse{
@@ -112,13 +113,19 @@ func (h *CreatorHandler) GetCreator(c *gin.Context) {
 		return
 	}
 
+	// A merged creator is served under the ID it was merged into
+	meta := map[string]interface{}{}
+	if creator.ID != creatorID {
+		meta["redirected_from"] = creatorID
+	}
+
 	successMessage := i18n.Localize(c, "catalog.creators.get.success", "Creator fetched successfully")
 	c.JSON(http.StatusOK, r.StandardResponse{
 		Success: true,
 		Message: successMessage,
 		Data:    creator,
 		Error:   nil,
-		Meta:    map[string]interface{}{},
+		Meta:    meta,
 	})
 }
 
@@ -260,3 +267,273 @@ func (h *CreatorHandler) DeleteCreator(c *gin.Context) {
 		Meta:    map[string]interface{}{},
 	})
 }
+
+// GetCreatorWorks handles GET /creators/:id/works
+func (h *CreatorHandler) GetCreatorWorks(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	creatorID, ok := creatorIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req d.ListCreatorWorksRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_query_parameters", "Invalid query parameters")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	works, total, err := h.creatorService.ListCreatorWorks(ctx, creatorID, req)
+	if err != nil {
+		status, code, message, description := mapCreatorServiceError(c, err, "works")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	totalPages := int(total) / req.PageSize
+	if int(total)%req.PageSize > 0 {
+		totalPages++
+	}
+
+	successMessage := i18n.Localize(c, "catalog.creators.works.success", "Creator works fetched successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    works,
+		Error:   nil,
+		Meta: map[string]interface{}{
+			"page":        req.Page,
+			"page_size":   req.PageSize,
+			"total_pages": totalPages,
+			"total_items": total,
+		},
+	})
+}
+
+// UpdateCreatorProfile handles PUT /creators/:id/profile
+func (h *CreatorHandler) UpdateCreatorProfile(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	creatorID, ok := creatorIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req d.UpdateCreatorProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	creator, err := h.creatorService.UpdateCreatorProfile(ctx, creatorID, req, actor)
+	if err != nil {
+		status, code, message, description := mapCreatorServiceError(c, err, "update_profile")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.creators.profile.success", "Creator profile updated successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    creator,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// FindDuplicateCreators handles GET /creators/duplicates?name=
+func (h *CreatorHandler) FindDuplicateCreators(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req d.FindDuplicatesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_query_parameters", "Invalid query parameters")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	candidates, err := h.creatorService.FindDuplicateCreators(ctx, req)
+	if err != nil {
+		status, code, message, description := mapCreatorServiceError(c, err, "duplicates")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.creators.duplicates.success", "Possible duplicates fetched successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    candidates,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// GetCreatorDuplicates handles GET /creators/:id/duplicates
+func (h *CreatorHandler) GetCreatorDuplicates(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	creatorID, ok := creatorIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req d.FindDuplicatesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_query_parameters", "Invalid query parameters")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	candidates, err := h.creatorService.FindCreatorDuplicatesOf(ctx, creatorID, req)
+	if err != nil {
+		status, code, message, description := mapCreatorServiceError(c, err, "duplicates")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.creators.duplicates.success", "Possible duplicates fetched successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    candidates,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// MergeCreator handles POST /creators/:id/merge
+func (h *CreatorHandler) MergeCreator(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	creatorID, ok := creatorIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req d.MergeCreatorRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	result, err := h.creatorService.MergeCreators(ctx, creatorID, req, actor)
+	if err != nil {
+		status, code, message, description := mapCreatorServiceError(c, err, "merge")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.creators.merge.success", "Creators merged successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    result,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// creatorIDParam parses the :id path parameter, writing the error response when it is not a UUID
+func creatorIDParam(c *gin.Context) (uuid.UUID, bool) {
+	creatorID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		message := i18n.Localize(c, "catalog.creators.error.invalid_id", "Invalid creator ID")
+		detail := i18n.Localize(c, "catalog.creators.error.invalid_id_detail", "Creator ID must be a valid UUID")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "invalid_id", Description: detail},
+			Meta:    map[string]interface{}{},
+		})
+		return uuid.Nil, false
+	}
+	return creatorID, true
+}
+
+// mapCreatorServiceError adds the creator page ownership check to the shared service error mapping
+func mapCreatorServiceError(c *gin.Context, err error, operation string) (int, string, string, string) {
+	errStr := err.Error()
+
+	if strings.Contains(errStr, "permission denied") {
+		message := i18n.Localize(c, "catalog.creators.error.forbidden", "Only the claimed owner can edit this creator")
+		return http.StatusForbidden, "forbidden", message, errStr
+	}
+
+	return mapServiceError(c, err, operation)
+}
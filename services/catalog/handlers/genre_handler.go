@@ -46,7 +46,7 @@ func (h *GenreHandler) ListGenres(c *gin.Context) {
 	}
 
 	// Get genres through service
-	genres, total, err := h.genreService.ListGenres(ctx, req)
+	genres, total, err := h.genreService.ListGenres(ctx, req, i18n.ContentLanguages(c))
 	if err != nil {
 		status, code, message, description := mapServiceError(c, err, "list")
 		c.JSON(status, r.StandardResponse{
@@ -100,7 +100,7 @@ func (h *GenreHandler) GetGenre(c *gin.Context) {
 	}
 
 	// Get genre through service
-	genre, err := h.genreService.GetGenreByID(ctx, genreID)
+	genre, err := h.genreService.GetGenreByID(ctx, genreID, i18n.ContentLanguages(c))
 	if err != nil {
 		status, code, message, description := mapServiceError(c, err, "get")
 		c.JSON(status, r.StandardResponse{
@@ -265,6 +265,91 @@ func (h *GenreHandler) DeleteGenre(c *gin.Context) {
 	})
 }
 
+// GetGenreTree handles GET /genres/tree
+func (h *GenreHandler) GetGenreTree(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// Get genre taxonomy through service
+	tree, err := h.genreService.GetGenreTree(ctx, i18n.ContentLanguages(c))
+	if err != nil {
+		status, code, message, description := mapServiceError(c, err, "tree")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.genres.tree.success", "Genre tree fetched successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    tree,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// MergeGenre handles POST /genres/:id/merge
+func (h *GenreHandler) MergeGenre(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// Parse genre ID
+	genreID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		message := i18n.Localize(c, "catalog.genres.error.invalid_id", "Invalid genre ID")
+		detail := i18n.Localize(c, "catalog.genres.error.invalid_id_detail", "Genre ID must be a valid UUID")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "invalid_id", Description: detail},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	// Bind request body
+	var req d.MergeGenreRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	// Merge genres through service
+	result, err := h.genreService.MergeGenres(ctx, genreID, req)
+	if err != nil {
+		status, code, message, description := mapServiceError(c, err, "merge")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.genres.merge.success", "Genres merged successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    result,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
 // mapServiceError maps service errors to appropriate HTTP responses
 func mapServiceError(c *gin.Context, err error, operation string) (int, string, string, string) {
 	errStr := err.Error()
@@ -275,7 +360,11 @@ func mapServiceError(c *gin.Context, err error, operation string) (int, string,
 		message := i18n.Localize(c, "catalog.common.error.not_found", "Resource not found")
 		return http.StatusNotFound, "not_found", message, errStr
 
-	case strings.Contains(errStr, "already exists") || strings.Contains(errStr, "duplicate"):
+	case strings.Contains(errStr, "has child genres"):
+		message := i18n.Localize(c, "catalog.genres.error.has_children", "The genre still has sub-genres")
+		return http.StatusConflict, "genre_has_children", message, errStr
+
+	case strings.Contains(errStr, "already exists") || strings.Contains(errStr, "duplicate key"):
 		message := i18n.Localize(c, "catalog.common.error.conflict", "Resource already exists")
 		return http.StatusConflict, "conflict", message, errStr
 
@@ -9,24 +9,70 @@ import (
 
 // Handlers aggregates all HTTP handlers for dependency injection.
 type Handlers struct {
-	Health    *HealthHandler
-	Genre     *GenreHandler
-	Character *CharacterHandler
-	Creator   *CreatorHandler
-	Novel     *NovelHandler
-	Volume    *VolumeHandler
-	Chapter   *ChapterHandler
+	Health                  *HealthHandler
+	Genre                   *GenreHandler
+	Character               *CharacterHandler
+	Creator                 *CreatorHandler
+	Novel                   *NovelHandler
+	Volume                  *VolumeHandler
+	Chapter                 *ChapterHandler
+	Ranking                 *RankingHandler
+	Recommendation          *RecommendationHandler
+	Analytics               *AnalyticsHandler
+	Moderation              *ModerationHandler
+	CharacterContribution   *CharacterContributionHandler
+	Relation                *ContentRelationHandler
+	Anime                   *AnimeHandler
+	AnimeEpisode            *AnimeEpisodeHandler
+	Manga                   *MangaHandler
+	MangaChapter            *MangaChapterHandler
+	SubtitleContribution    *SubtitleContributionHandler
+	Media                   *MediaHandler
+	Translation             *TranslationHandler
+	TranslationContribution *TranslationContributionHandler
+	Tag                     *TagHandler
+	CreatorClaim            *CreatorClaimHandler
+	Counter                 *CounterHandler
+	Trash                   *TrashHandler
+	ChapterOrder            *ChapterOrderHandler
+	Pricing                 *PricingHandler
+	Release                 *ReleaseHandler
+	ContentFilter           *ContentFilterHandler
+	ContentWarning          *ContentWarningHandler
 }
 
 // NewHandlers wires handlers with their required dependencies.
 func NewHandlers(repos *repositories.Repositories, services *services.Services, translator *i18n.Translator) *Handlers {
 	return &Handlers{
-		Health:    NewHealthHandler(repos, translator),
-		Genre:     NewGenreHandler(services.Genre, translator),
-		Character: NewCharacterHandler(services.Character, translator),
-		Creator:   NewCreatorHandler(services.Creator, translator),
-		Novel:     NewNovelHandler(services.Novel, translator),
-		Volume:    NewVolumeHandler(services.Volume, translator),
-		Chapter:   NewChapterHandler(services.Chapter, translator),
+		Health:                  NewHealthHandler(repos, translator),
+		Genre:                   NewGenreHandler(services.Genre, translator),
+		Character:               NewCharacterHandler(services.Character, translator),
+		Creator:                 NewCreatorHandler(services.Creator, translator),
+		Novel:                   NewNovelHandler(services.Novel, services.Recommendation, services.Relation, services.ContentFilter, translator),
+		Volume:                  NewVolumeHandler(services.Volume, translator),
+		Chapter:                 NewChapterHandler(services.Chapter, services.Analytics, translator),
+		Ranking:                 NewRankingHandler(services.Ranking, services.ContentFilter, translator),
+		Recommendation:          NewRecommendationHandler(services.Recommendation, services.Bookmark, services.ContentFilter, translator),
+		Analytics:               NewAnalyticsHandler(services.Analytics, translator),
+		Moderation:              NewModerationHandler(services.Moderation, translator),
+		CharacterContribution:   NewCharacterContributionHandler(services.CharacterContribution, translator),
+		Relation:                NewContentRelationHandler(services.Relation, translator),
+		Anime:                   NewAnimeHandler(services.Anime, services.Relation, services.ContentFilter, translator),
+		AnimeEpisode:            NewAnimeEpisodeHandler(services.AnimeEpisode, services.ContentFilter, translator),
+		Manga:                   NewMangaHandler(services.Manga, services.Relation, services.ContentFilter, translator),
+		MangaChapter:            NewMangaChapterHandler(services.MangaChapter, services.ContentFilter, translator),
+		SubtitleContribution:    NewSubtitleContributionHandler(services.SubtitleContribution, translator),
+		Media:                   NewMediaHandler(services.Media, translator),
+		Translation:             NewTranslationHandler(services.Translation, translator),
+		TranslationContribution: NewTranslationContributionHandler(services.TranslationContribution, translator),
+		Tag:                     NewTagHandler(services.Tag, translator),
+		CreatorClaim:            NewCreatorClaimHandler(services.CreatorClaim, translator),
+		Counter:                 NewCounterHandler(services.Counter, translator),
+		Trash:                   NewTrashHandler(services.Trash, translator),
+		ChapterOrder:            NewChapterOrderHandler(services.ChapterOrder, translator),
+		Pricing:                 NewPricingHandler(services.Pricing, translator),
+		Release:                 NewReleaseHandler(services.Release, services.Chapter, services.Analytics, services.ContentFilter, translator),
+		ContentFilter:           NewContentFilterHandler(services.ContentFilter, translator),
+		ContentWarning:          NewContentWarningHandler(services.ContentWarning, translator),
 	}
 }
@@ -0,0 +1,746 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+	r "wibusystem/pkg/common/response"
+	"wibusystem/pkg/i18n"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// MangaChapterHandler handles manga volume, chapter and page endpoints
+type MangaChapterHandler struct {
+	chapterService       interfaces.MangaChapterServiceInterface
+	contentFilterService interfaces.ContentFilterServiceInterface
+	loc                  *i18n.Translator
+}
+
+// NewMangaChapterHandler creates a new manga chapter handler
+func NewMangaChapterHandler(chapterService interfaces.MangaChapterServiceInterface, contentFilterService interfaces.ContentFilterServiceInterface, translator *i18n.Translator) *MangaChapterHandler {
+	return &MangaChapterHandler{
+		chapterService:       chapterService,
+		contentFilterService: contentFilterService,
+		loc:                  translator,
+	}
+}
+
+// CreateVolume handles POST /manga/{manga_id}/volumes
+func (h *MangaChapterHandler) CreateVolume(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.CreateMangaVolumeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	response, err := h.chapterService.CreateVolume(ctx, c.Param("manga_id"), req, actor)
+	if err != nil {
+		status, code, message, description := mapMangaServiceError(c, err, "create_volume")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.manga_volumes.create.success", "Volume created successfully")
+	c.JSON(http.StatusCreated, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// ListVolumes handles GET /manga/{manga_id}/volumes
+func (h *MangaChapterHandler) ListVolumes(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	response, err := h.chapterService.ListVolumes(ctx, c.Param("manga_id"))
+	if err != nil {
+		status, code, message, description := mapMangaServiceError(c, err, "list_volumes")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.manga_volumes.list.success", "Volumes retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// UpdateVolume handles PUT /manga/volumes/{volume_id}
+func (h *MangaChapterHandler) UpdateVolume(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.UpdateMangaVolumeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	response, err := h.chapterService.UpdateVolume(ctx, c.Param("volume_id"), req, actor)
+	if err != nil {
+		status, code, message, description := mapMangaServiceError(c, err, "update_volume")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.manga_volumes.update.success", "Volume updated successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// DeleteVolume handles DELETE /manga/volumes/{volume_id}
+func (h *MangaChapterHandler) DeleteVolume(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	if err := h.chapterService.DeleteVolume(ctx, c.Param("volume_id"), actor); err != nil {
+		status, code, message, description := mapMangaServiceError(c, err, "delete_volume")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.manga_volumes.delete.success", "Volume deleted successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    nil,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// CreateChapter handles POST /manga/volumes/{volume_id}/chapters
+func (h *MangaChapterHandler) CreateChapter(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.CreateMangaChapterRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	response, err := h.chapterService.CreateChapter(ctx, c.Param("volume_id"), req, actor)
+	if err != nil {
+		status, code, message, description := mapMangaServiceError(c, err, "create_chapter")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.manga_chapters.create.success", "Chapter created successfully")
+	c.JSON(http.StatusCreated, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// ListPublishedChapters handles GET /manga/volumes/{volume_id}/chapters
+func (h *MangaChapterHandler) ListPublishedChapters(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req d.ListMangaChaptersRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_query_parameters", "Invalid query parameters")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	// Age gating and the viewer's filters on the manga
+	restriction, err := h.contentFilterService.CheckMangaVolume(ctx, currentViewer(c), c.Param("volume_id"))
+	if err != nil {
+		status, code, message, description := mapMangaServiceError(c, err, "list_chapters")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+	if restriction != nil && restriction.Display == m.RestrictedDisplayHide {
+		respondContentRestricted(c, restriction)
+		return
+	}
+
+	response, err := h.chapterService.ListPublishedChapters(ctx, c.Param("volume_id"), req)
+	if err != nil {
+		status, code, message, description := mapMangaServiceError(c, err, "list_chapters")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.manga_chapters.list.success", "Chapters retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response.Chapters,
+		Error:   nil,
+		Meta: map[string]interface{}{
+			"pagination": response.Pagination,
+		},
+	})
+}
+
+// ListAllChapters handles GET /manga/volumes/{volume_id}/chapters/all
+func (h *MangaChapterHandler) ListAllChapters(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.ListMangaChaptersRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_query_parameters", "Invalid query parameters")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	response, err := h.chapterService.ListAllChapters(ctx, c.Param("volume_id"), req, actor)
+	if err != nil {
+		status, code, message, description := mapMangaServiceError(c, err, "list_chapters")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.manga_chapters.list.success", "Chapters retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response.Chapters,
+		Error:   nil,
+		Meta: map[string]interface{}{
+			"pagination": response.Pagination,
+		},
+	})
+}
+
+// GetChapter handles GET /manga/chapters/{chapter_id}
+func (h *MangaChapterHandler) GetChapter(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// Age gating and the viewer's filters on the manga
+	restriction, err := h.contentFilterService.CheckMangaChapter(ctx, currentViewer(c), c.Param("chapter_id"))
+	if err != nil {
+		status, code, message, description := mapMangaServiceError(c, err, "get_chapter")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+	if restriction != nil && restriction.Display == m.RestrictedDisplayHide {
+		respondContentRestricted(c, restriction)
+		return
+	}
+
+	response, err := h.chapterService.GetPublishedChapter(ctx, c.Param("chapter_id"))
+	if err != nil {
+		status, code, message, description := mapMangaServiceError(c, err, "get_chapter")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.manga_chapters.get.success", "Chapter retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// ReadChapter handles GET /manga/chapters/{chapter_id}/read
+func (h *MangaChapterHandler) ReadChapter(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	// Age gating and the reader's filters; blurred chapters stay readable
+	restriction, err := h.contentFilterService.CheckMangaChapter(ctx, currentViewer(c), c.Param("chapter_id"))
+	if err != nil {
+		status, code, message, description := mapMangaServiceError(c, err, "read_chapter")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+	if restriction != nil && restriction.Display == m.RestrictedDisplayHide {
+		respondContentRestricted(c, restriction)
+		return
+	}
+
+	response, err := h.chapterService.ReadChapter(ctx, c.Param("chapter_id"), actor)
+	if err != nil {
+		status, code, message, description := mapMangaServiceError(c, err, "read_chapter")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.manga_chapters.read.success", "Chapter pages retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// UpdateChapter handles PUT /manga/chapters/{chapter_id}
+func (h *MangaChapterHandler) UpdateChapter(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.UpdateMangaChapterRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	response, err := h.chapterService.UpdateChapter(ctx, c.Param("chapter_id"), req, actor)
+	if err != nil {
+		status, code, message, description := mapMangaServiceError(c, err, "update_chapter")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.manga_chapters.update.success", "Chapter updated successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// DeleteChapter handles DELETE /manga/chapters/{chapter_id}
+func (h *MangaChapterHandler) DeleteChapter(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	if err := h.chapterService.DeleteChapter(ctx, c.Param("chapter_id"), actor); err != nil {
+		status, code, message, description := mapMangaServiceError(c, err, "delete_chapter")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.manga_chapters.delete.success", "Chapter deleted successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    nil,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// PublishChapter handles POST /manga/chapters/{chapter_id}/publish
+func (h *MangaChapterHandler) PublishChapter(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	// The body is optional: publish now when omitted
+	var req d.PublishMangaChapterRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+			c.JSON(http.StatusBadRequest, r.StandardResponse{
+				Success: false,
+				Message: message,
+				Data:    nil,
+				Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+				Meta:    map[string]interface{}{},
+			})
+			return
+		}
+	}
+
+	response, err := h.chapterService.PublishChapter(ctx, c.Param("chapter_id"), req, actor)
+	if err != nil {
+		status, code, message, description := mapMangaServiceError(c, err, "publish_chapter")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.manga_chapters.publish.success", "Chapter published successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// UnpublishChapter handles POST /manga/chapters/{chapter_id}/unpublish
+func (h *MangaChapterHandler) UnpublishChapter(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	response, err := h.chapterService.UnpublishChapter(ctx, c.Param("chapter_id"), actor)
+	if err != nil {
+		status, code, message, description := mapMangaServiceError(c, err, "unpublish_chapter")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.manga_chapters.unpublish.success", "Chapter unpublished successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// AddPages handles POST /manga/chapters/{chapter_id}/pages
+func (h *MangaChapterHandler) AddPages(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.AddMangaPagesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	response, err := h.chapterService.AddPages(ctx, c.Param("chapter_id"), req, actor)
+	if err != nil {
+		status, code, message, description := mapMangaServiceError(c, err, "add_pages")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.manga_pages.add.success", "Pages added successfully")
+	c.JSON(http.StatusCreated, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// ReorderPages handles PUT /manga/chapters/{chapter_id}/pages/order
+func (h *MangaChapterHandler) ReorderPages(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.ReorderMangaPagesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	response, err := h.chapterService.ReorderPages(ctx, c.Param("chapter_id"), req, actor)
+	if err != nil {
+		status, code, message, description := mapMangaServiceError(c, err, "reorder_pages")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.manga_pages.reorder.success", "Pages reordered successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// UpdatePage handles PUT /manga/pages/{page_id}
+func (h *MangaChapterHandler) UpdatePage(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.UpdateMangaPageRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	response, err := h.chapterService.UpdatePage(ctx, c.Param("page_id"), req, actor)
+	if err != nil {
+		status, code, message, description := mapMangaServiceError(c, err, "update_page")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.manga_pages.update.success", "Page updated successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// DeletePage handles DELETE /manga/pages/{page_id}
+func (h *MangaChapterHandler) DeletePage(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	if err := h.chapterService.DeletePage(ctx, c.Param("page_id"), actor); err != nil {
+		status, code, message, description := mapMangaServiceError(c, err, "delete_page")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.manga_pages.delete.success", "Page deleted successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    nil,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
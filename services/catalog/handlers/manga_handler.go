@@ -0,0 +1,385 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+	r "wibusystem/pkg/common/response"
+	"wibusystem/pkg/i18n"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// MangaHandler handles manga series endpoints
+type MangaHandler struct {
+	mangaService         interfaces.MangaServiceInterface
+	relationService      interfaces.ContentRelationServiceInterface
+	contentFilterService interfaces.ContentFilterServiceInterface
+	loc                  *i18n.Translator
+}
+
+// NewMangaHandler creates a new manga handler
+func NewMangaHandler(mangaService interfaces.MangaServiceInterface, relationService interfaces.ContentRelationServiceInterface, contentFilterService interfaces.ContentFilterServiceInterface, translator *i18n.Translator) *MangaHandler {
+	return &MangaHandler{
+		mangaService:         mangaService,
+		relationService:      relationService,
+		contentFilterService: contentFilterService,
+		loc:                  translator,
+	}
+}
+
+// CreateManga handles POST /manga
+func (h *MangaHandler) CreateManga(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.CreateMangaRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	manga, err := h.mangaService.CreateManga(ctx, req, actor)
+	if err != nil {
+		status, code, message, description := mapMangaServiceError(c, err, "create")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.manga.create.success", "Manga created successfully")
+	c.JSON(http.StatusCreated, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    manga,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// ListManga handles GET /manga
+func (h *MangaHandler) ListManga(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req d.ListMangaRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_query_parameters", "Invalid query parameters")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	// Age gating and the viewer's filters are applied by the list query itself
+	viewer := currentViewer(c)
+	visibility, err := h.contentFilterService.Visibility(ctx, viewer)
+	if err != nil {
+		status, code, message, description := mapMangaServiceError(c, err, "list")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+	req.Visibility = visibility
+
+	response, err := h.mangaService.ListManga(ctx, req, preferredLanguage(c))
+	if err != nil {
+		status, code, message, description := mapMangaServiceError(c, err, "list")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	// Mark manga the viewer's age or filters blur
+	items, err := h.contentFilterService.FilterManga(ctx, viewer, response.Manga)
+	if err != nil {
+		status, code, message, description := mapMangaServiceError(c, err, "list")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.manga.list.success", "Manga retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    items,
+		Error:   nil,
+		Meta: map[string]interface{}{
+			"pagination": response.Pagination,
+		},
+	})
+}
+
+// GetMangaByID handles GET /manga/{manga_id}
+func (h *MangaHandler) GetMangaByID(c *gin.Context) {
+	ctx := c.Request.Context()
+	mangaID := c.Param("manga_id")
+
+	includeRelations := c.DefaultQuery("include_relations", "false") == "true"
+	relationDepth, _ := strconv.Atoi(c.DefaultQuery("relation_depth", "1"))
+
+	// Age gating and the viewer's filters; blurred manga is returned with its restriction
+	restriction, err := h.contentFilterService.CheckManga(ctx, currentViewer(c), mangaID)
+	if err != nil {
+		status, code, message, description := mapMangaServiceError(c, err, "get")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+	if restriction != nil && restriction.Display == m.RestrictedDisplayHide {
+		respondContentRestricted(c, restriction)
+		return
+	}
+
+	manga, err := h.mangaService.GetMangaByID(ctx, mangaID, preferredLanguage(c))
+	if err != nil {
+		status, code, message, description := mapMangaServiceError(c, err, "get")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	// Franchise graph (source novel, anime adaptation, spin-offs...) around this manga
+	if includeRelations {
+		relations, err := h.relationService.GetGraph(ctx, d.ContentRelationGraphRequest{
+			ContentType: string(m.ContentTypeManga),
+			ContentID:   mangaID,
+			Depth:       relationDepth,
+		})
+		if err != nil {
+			status, code, message, description := mapContentRelationServiceError(c, err, "graph")
+			c.JSON(status, r.StandardResponse{
+				Success: false,
+				Message: message,
+				Data:    nil,
+				Error:   &r.ErrorDetail{Code: code, Description: description},
+				Meta:    map[string]interface{}{},
+			})
+			return
+		}
+		manga.Relations = relations
+	}
+
+	manga.Restriction = restriction
+
+	successMessage := i18n.Localize(c, "catalog.manga.get.success", "Manga retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    manga,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// UpdateManga handles PUT /manga/{manga_id}
+func (h *MangaHandler) UpdateManga(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.UpdateMangaRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	manga, err := h.mangaService.UpdateManga(ctx, c.Param("manga_id"), req, actor)
+	if err != nil {
+		status, code, message, description := mapMangaServiceError(c, err, "update")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.manga.update.success", "Manga updated successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    manga,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// DeleteManga handles DELETE /manga/{manga_id}
+func (h *MangaHandler) DeleteManga(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	if err := h.mangaService.DeleteManga(ctx, c.Param("manga_id"), actor); err != nil {
+		status, code, message, description := mapMangaServiceError(c, err, "delete")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.manga.delete.success", "Manga deleted successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    nil,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// SetTranslations handles PUT /manga/{manga_id}/translations
+func (h *MangaHandler) SetTranslations(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.SetMangaTranslationsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	if err := h.mangaService.SetTranslations(ctx, c.Param("manga_id"), req, actor); err != nil {
+		status, code, message, description := mapMangaServiceError(c, err, "translations")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.manga.translations.success", "Manga titles updated successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    req.Translations,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// mapMangaServiceError maps manga, volume, chapter and page service errors to HTTP responses
+func mapMangaServiceError(c *gin.Context, err error, operation string) (int, string, string, string) {
+	errStr := err.Error()
+
+	switch {
+	case strings.Contains(errStr, "ID format"):
+		message := i18n.Localize(c, "catalog.common.error.invalid_id_format", "Invalid ID format")
+		return http.StatusBadRequest, "invalid_id", message, errStr
+
+	case strings.Contains(errStr, "permission denied"):
+		message := i18n.Localize(c, "catalog.manga.error.forbidden", "You do not have permission to manage this manga")
+		return http.StatusForbidden, "forbidden", message, errStr
+
+	case strings.Contains(errStr, "purchase required"):
+		message := i18n.Localize(c, "catalog.manga.error.purchase_required", "Purchase this chapter or rent its volume to read it")
+		return http.StatusForbidden, "purchase_required", message, errStr
+
+	case strings.Contains(errStr, "users have purchased"):
+		message := i18n.Localize(c, "catalog.manga.error.has_purchases", "Content that users have purchased cannot be deleted")
+		return http.StatusConflict, "has_purchases", message, errStr
+
+	case strings.Contains(errStr, "genres do not exist"):
+		message := i18n.Localize(c, "catalog.manga.error.invalid_reference", "Some referenced genres do not exist")
+		return http.StatusBadRequest, "invalid_reference", message, errStr
+
+	case strings.Contains(errStr, "invalid"):
+		message := i18n.Localize(c, "catalog.common.error.validation", "Validation error")
+		return http.StatusBadRequest, "validation_error", message, errStr
+
+	case strings.Contains(errStr, "not found"):
+		message := i18n.Localize(c, "catalog.common.error.not_found", "Resource not found")
+		return http.StatusNotFound, "not_found", message, errStr
+
+	case strings.Contains(errStr, "already exists"):
+		message := i18n.Localize(c, "catalog.manga.error.already_exists", "A volume or chapter with this number already exists")
+		return http.StatusConflict, "conflict", message, errStr
+
+	default:
+		message := i18n.Localize(c, "catalog.common.error.internal", "Internal server error")
+		return http.StatusInternalServerError, "internal_error", message, errStr
+	}
+}
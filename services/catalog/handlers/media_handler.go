@@ -0,0 +1,206 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	r "wibusystem/pkg/common/response"
+	"wibusystem/pkg/i18n"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// multipartOverhead is the room left for multipart headers on top of the file size limit
+const multipartOverhead = 1 << 20
+
+// MediaHandler handles file upload and signed download endpoints
+type MediaHandler struct {
+	mediaService interfaces.MediaServiceInterface
+	loc          *i18n.Translator
+}
+
+// NewMediaHandler creates a new media handler
+func NewMediaHandler(mediaService interfaces.MediaServiceInterface, translator *i18n.Translator) *MediaHandler {
+	return &MediaHandler{
+		mediaService: mediaService,
+		loc:          translator,
+	}
+}
+
+// Upload handles POST /media (multipart/form-data, field "file", optional field "purpose")
+func (h *MediaHandler) Upload(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.mediaService.UploadLimit()+multipartOverhead)
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			status, code, message, description := mapMediaServiceError(c, errors.New("file too large"), "upload")
+			c.JSON(status, r.StandardResponse{
+				Success: false,
+				Message: message,
+				Data:    nil,
+				Error:   &r.ErrorDetail{Code: code, Description: description},
+				Meta:    map[string]interface{}{},
+			})
+			return
+		}
+
+		message := i18n.Localize(c, "catalog.media.error.file_required", "A file is required in the \"file\" form field")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		status, code, message, description := mapMediaServiceError(c, err, "upload")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+	defer file.Close()
+
+	response, err := h.mediaService.Upload(ctx, file, fileHeader.Filename, c.PostForm("purpose"), actor)
+	if err != nil {
+		status, code, message, description := mapMediaServiceError(c, err, "upload")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	status := http.StatusCreated
+	successMessage := i18n.Localize(c, "catalog.media.upload.success", "File uploaded successfully")
+	if response.Deduplicated {
+		status = http.StatusOK
+		successMessage = i18n.Localize(c, "catalog.media.upload.deduplicated", "File already uploaded; the existing copy is reused")
+	}
+	c.JSON(status, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// GetMedia handles GET /media/{media_id}
+func (h *MediaHandler) GetMedia(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	response, err := h.mediaService.GetMedia(ctx, c.Param("media_id"))
+	if err != nil {
+		status, code, message, description := mapMediaServiceError(c, err, "get")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.media.get.success", "Media retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// ServeFile handles GET /media/files/{key}?expires=&signature=, the signed URLs of local storage
+func (h *MediaHandler) ServeFile(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	key := strings.TrimPrefix(c.Param("key"), "/")
+	expires, err := strconv.ParseInt(c.Query("expires"), 10, 64)
+	if err != nil {
+		expires = 0
+	}
+
+	body, info, err := h.mediaService.OpenSignedFile(ctx, key, expires, c.Query("signature"))
+	if err != nil {
+		status, code, message, description := mapMediaServiceError(c, err, "download")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+	defer body.Close()
+
+	c.DataFromReader(http.StatusOK, info.Size, info.ContentType, body, map[string]string{
+		"Cache-Control":          "private, max-age=300",
+		"X-Content-Type-Options": "nosniff",
+	})
+}
+
+// mapMediaServiceError maps service errors to appropriate HTTP responses for media operations
+func mapMediaServiceError(c *gin.Context, err error, operation string) (int, string, string, string) {
+	errStr := err.Error()
+
+	switch {
+	case strings.Contains(errStr, "ID format"):
+		message := i18n.Localize(c, "catalog.common.error.invalid_id_format", "Invalid ID format")
+		return http.StatusBadRequest, "invalid_id", message, errStr
+
+	case strings.Contains(errStr, "file too large"):
+		message := i18n.Localize(c, "catalog.media.error.too_large", "The file exceeds the upload size limit")
+		return http.StatusRequestEntityTooLarge, "file_too_large", message, errStr
+
+	case strings.Contains(errStr, "invalid file type"):
+		message := i18n.Localize(c, "catalog.media.error.unsupported_type", "This file type is not allowed")
+		return http.StatusUnsupportedMediaType, "unsupported_media_type", message, errStr
+
+	case strings.Contains(errStr, "invalid image"):
+		message := i18n.Localize(c, "catalog.media.error.invalid_image", "The image does not meet the requirements for this purpose")
+		return http.StatusBadRequest, "invalid_image", message, errStr
+
+	case strings.Contains(errStr, "permission denied"):
+		message := i18n.Localize(c, "catalog.media.error.invalid_signature", "The download link is invalid or has expired")
+		return http.StatusForbidden, "invalid_signature", message, errStr
+
+	case strings.Contains(errStr, "invalid"):
+		message := i18n.Localize(c, "catalog.common.error.validation", "Validation error")
+		return http.StatusBadRequest, "validation_error", message, errStr
+
+	case strings.Contains(errStr, "not found"):
+		message := i18n.Localize(c, "catalog.common.error.not_found", "Resource not found")
+		return http.StatusNotFound, "not_found", message, errStr
+
+	default:
+		message := i18n.Localize(c, "catalog.common.error.internal", "Internal server error")
+		return http.StatusInternalServerError, "internal_error", message, errStr
+	}
+}
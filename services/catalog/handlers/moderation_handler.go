@@ -0,0 +1,336 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"wibusystem/pkg/common/auth"
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+	r "wibusystem/pkg/common/response"
+	"wibusystem/pkg/i18n"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// ModerationHandler handles content reports and the moderation queue
+type ModerationHandler struct {
+	moderationService interfaces.ModerationServiceInterface
+	loc               *i18n.Translator
+}
+
+// NewModerationHandler creates a new moderation handler
+func NewModerationHandler(moderationService interfaces.ModerationServiceInterface, translator *i18n.Translator) *ModerationHandler {
+	return &ModerationHandler{
+		moderationService: moderationService,
+		loc:               translator,
+	}
+}
+
+// CreateReport handles POST /reports
+func (h *ModerationHandler) CreateReport(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req d.CreateReportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	response, err := h.moderationService.CreateReport(ctx, userID, req)
+	if err != nil {
+		status, code, message, description := mapModerationServiceError(c, err, "report")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.reports.create.success", "Report submitted successfully")
+	c.JSON(http.StatusCreated, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// ListCases handles GET /moderation/cases
+func (h *ModerationHandler) ListCases(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req d.ListModerationCasesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_query_parameters", "Invalid query parameters")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	response, err := h.moderationService.ListCases(ctx, userID, req)
+	if err != nil {
+		status, code, message, description := mapModerationServiceError(c, err, "list")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.moderation.list.success", "Moderation queue retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response.Cases,
+		Error:   nil,
+		Meta: map[string]interface{}{
+			"pagination": response.Pagination,
+		},
+	})
+}
+
+// GetCase handles GET /moderation/cases/{case_id}
+func (h *ModerationHandler) GetCase(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	response, err := h.moderationService.GetCase(ctx, c.Param("case_id"))
+	if err != nil {
+		status, code, message, description := mapModerationServiceError(c, err, "get")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.moderation.get.success", "Moderation case retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// ClaimCase handles POST /moderation/cases/{case_id}/claim
+func (h *ModerationHandler) ClaimCase(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.moderationService.ClaimCase(ctx, c.Param("case_id"), userID); err != nil {
+		status, code, message, description := mapModerationServiceError(c, err, "claim")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.moderation.claim.success", "Moderation case claimed successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    nil,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// AssignCase handles POST /moderation/cases/{case_id}/assign
+func (h *ModerationHandler) AssignCase(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req d.AssignModerationCaseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	if err := h.moderationService.AssignCase(ctx, c.Param("case_id"), userID, req); err != nil {
+		status, code, message, description := mapModerationServiceError(c, err, "assign")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.moderation.assign.success", "Moderation case assigned successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    nil,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// ResolveCase handles POST /moderation/cases/{case_id}/resolve
+func (h *ModerationHandler) ResolveCase(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	var req d.ResolveModerationCaseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	// Suspending an account needs a stronger permission than reviewing content
+	if m.ModerationAction(req.Action) == m.ModerationActionSuspendUser && !user.IsAdmin() &&
+		!user.HasAnyScope(string(auth.PermModerationUserSuspend), string(auth.PermModerationBan)) {
+		message := i18n.Localize(c, "catalog.moderation.error.suspend_forbidden", "You are not allowed to suspend users")
+		c.JSON(http.StatusForbidden, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "forbidden", Description: "missing moderation:user_suspend scope"},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	if err := h.moderationService.ResolveCase(ctx, c.Param("case_id"), user.UserID, req); err != nil {
+		status, code, message, description := mapModerationServiceError(c, err, "resolve")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.moderation.resolve.success", "Moderation case resolved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    nil,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// mapModerationServiceError maps service errors to appropriate HTTP responses for moderation operations
+func mapModerationServiceError(c *gin.Context, err error, operation string) (int, string, string, string) {
+	errStr := err.Error()
+
+	switch {
+	case strings.Contains(errStr, "failed to suspend user"):
+		message := i18n.Localize(c, "catalog.moderation.error.suspend_failed", "Failed to suspend the user account")
+		return http.StatusBadGateway, "suspend_failed", message, errStr
+
+	case strings.Contains(errStr, "ID format"):
+		message := i18n.Localize(c, "catalog.common.error.invalid_id_format", "Invalid ID format")
+		return http.StatusBadRequest, "invalid_id", message, errStr
+
+	case strings.Contains(errStr, "invalid report target type"), strings.Contains(errStr, "invalid report target"):
+		message := i18n.Localize(c, "catalog.reports.error.invalid_target", "Invalid report target")
+		return http.StatusBadRequest, "invalid_report_target", message, errStr
+
+	case strings.Contains(errStr, "invalid report reason"):
+		message := i18n.Localize(c, "catalog.reports.error.invalid_reason", "Invalid report reason")
+		return http.StatusBadRequest, "invalid_report_reason", message, errStr
+
+	case strings.Contains(errStr, "invalid moderation action"), strings.Contains(errStr, "not supported for target type"):
+		message := i18n.Localize(c, "catalog.moderation.error.invalid_action", "Action is not valid for this case")
+		return http.StatusBadRequest, "invalid_action", message, errStr
+
+	case strings.Contains(errStr, "target user is required"):
+		message := i18n.Localize(c, "catalog.moderation.error.user_required", "A target user is required for this action")
+		return http.StatusBadRequest, "user_required", message, errStr
+
+	case strings.Contains(errStr, "invalid"):
+		message := i18n.Localize(c, "catalog.common.error.validation", "Validation failed")
+		return http.StatusBadRequest, "validation_error", message, errStr
+
+	case strings.Contains(errStr, "already reported"):
+		message := i18n.Localize(c, "catalog.reports.error.duplicate", "You have already reported this content")
+		return http.StatusConflict, "already_reported", message, errStr
+
+	case strings.Contains(errStr, "report target not found"), strings.Contains(errStr, "moderation case not found"):
+		message := i18n.Localize(c, "catalog.common.error.not_found", "Resource not found")
+		return http.StatusNotFound, "not_found", message, errStr
+
+	case strings.Contains(errStr, "already closed"):
+		message := i18n.Localize(c, "catalog.moderation.error.closed", "Moderation case is already closed")
+		return http.StatusConflict, "case_closed", message, errStr
+
+	case strings.Contains(errStr, "already claimed"):
+		message := i18n.Localize(c, "catalog.moderation.error.claimed", "Moderation case is handled by another moderator")
+		return http.StatusConflict, "case_claimed", message, errStr
+
+	default:
+		message := i18n.Localize(c, "catalog.common.error.internal", "Internal server error")
+		return http.StatusInternalServerError, "internal_error", message, errStr
+	}
+}
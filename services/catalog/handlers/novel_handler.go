@@ -2,11 +2,13 @@ package handlers
 
 import (
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 
 	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
 	r "wibusystem/pkg/common/response"
 	"wibusystem/pkg/i18n"
 	"wibusystem/services/catalog/services/interfaces"
@@ -14,14 +16,20 @@ import (
 
 // NovelHandler handles novel management endpoints
 type NovelHandler struct {
-	novelService interfaces.NovelServiceInterface
-	loc          *i18n.Translator
+	novelService          interfaces.NovelServiceInterface
+	recommendationService interfaces.RecommendationServiceInterface
+	relationService       interfaces.ContentRelationServiceInterface
+	contentFilterService  interfaces.ContentFilterServiceInterface
+	loc                   *i18n.Translator
 }
 
-func NewNovelHandler(novelService interfaces.NovelServiceInterface, translator *i18n.Translator) *NovelHandler {
+func NewNovelHandler(novelService interfaces.NovelServiceInterface, recommendationService interfaces.RecommendationServiceInterface, relationService interfaces.ContentRelationServiceInterface, contentFilterService interfaces.ContentFilterServiceInterface, translator *i18n.Translator) *NovelHandler {
 	return &NovelHandler{
-		novelService: novelService,
-		loc:          translator,
+		novelService:          novelService,
+		recommendationService: recommendationService,
+		relationService:       relationService,
+		contentFilterService:  contentFilterService,
+		loc:                   translator,
 	}
 }
 
@@ -105,6 +113,22 @@ func (h *NovelHandler) ListNovels(c *gin.Context) {
 		return
 	}
 
+	// Age gating and the viewer's filters are applied by the list query itself
+	viewer := currentViewer(c)
+	visibility, err := h.contentFilterService.Visibility(ctx, viewer)
+	if err != nil {
+		status, code, message, description := mapNovelServiceError(c, err, "list")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+	req.Visibility = visibility
+
 	// List novels through service
 	response, err := h.novelService.ListNovels(ctx, req)
 	if err != nil {
@@ -124,6 +148,20 @@ func (h *NovelHandler) ListNovels(c *gin.Context) {
 		data = response.Novels
 	}
 
+	// Mark novels the viewer's age or filters blur or warn about
+	data, err = h.contentFilterService.FilterNovels(ctx, viewer, data)
+	if err != nil {
+		status, code, message, description := mapNovelServiceError(c, err, "list")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
 	meta := map[string]interface{}{}
 	if response != nil {
 		meta["pagination"] = response.Pagination
@@ -158,21 +196,40 @@ func (h *NovelHandler) GetNovelByID(c *gin.Context) {
 		return
 	}
 
+	// Age gating and the viewer's filters; blurred novels are returned with their restriction
+	viewer := currentViewer(c)
+	restriction, err := h.contentFilterService.CheckNovel(ctx, viewer, novelID)
+	if err != nil {
+		status, code, message, description := mapNovelServiceError(c, err, "get")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+	if restriction != nil && restriction.Display == m.RestrictedDisplayHide {
+		respondContentRestricted(c, restriction)
+		return
+	}
+
 	// Parse query parameters
 	includeTranslations := c.DefaultQuery("include_translations", "false") == "true"
 	includeStats := c.DefaultQuery("include_stats", "false") == "true"
-
-	// Get language preference from headers (theo API design)
-	language := c.GetHeader("X-Language")
-	if language == "" {
-		language = c.GetHeader("Accept-Language")
-		if language == "" {
-			language = "vi" // Default language
-		}
+	includeSimilar := c.DefaultQuery("include_similar", "false") == "true"
+	includeRelations := c.DefaultQuery("include_relations", "false") == "true"
+	relationDepth, _ := strconv.Atoi(c.DefaultQuery("relation_depth", "1"))
+
+	// Content language preference: X-Language header (theo API design), then UI language and Accept-Language
+	languages := i18n.ContentLanguages(c)
+	if language := c.GetHeader("X-Language"); language != "" {
+		languages = append([]string{language}, languages...)
 	}
 
 	// Get novel through service
-	novel, err := h.novelService.GetNovelByID(ctx, novelID, includeTranslations, includeStats, language)
+	novel, err := h.novelService.GetNovelByID(ctx, novelID, includeTranslations, includeStats, languages)
 	if err != nil {
 		status, code, message, description := mapNovelServiceError(c, err, "get")
 		c.JSON(status, r.StandardResponse{
@@ -185,6 +242,69 @@ func (h *NovelHandler) GetNovelByID(c *gin.Context) {
 		return
 	}
 
+	// Related-content section from the pre-computed neighbours table
+	if includeSimilar {
+		visibility, err := h.contentFilterService.Visibility(ctx, viewer)
+		if err != nil {
+			status, code, message, description := mapNovelServiceError(c, err, "get")
+			c.JSON(status, r.StandardResponse{
+				Success: false,
+				Message: message,
+				Data:    nil,
+				Error:   &r.ErrorDetail{Code: code, Description: description},
+				Meta:    map[string]interface{}{},
+			})
+			return
+		}
+		similar, err := h.recommendationService.GetSimilarNovels(ctx, novelID, d.ListRecommendationsRequest{Visibility: visibility})
+		if err != nil {
+			status, code, message, description := mapNovelServiceError(c, err, "get")
+			c.JSON(status, r.StandardResponse{
+				Success: false,
+				Message: message,
+				Data:    nil,
+				Error:   &r.ErrorDetail{Code: code, Description: description},
+				Meta:    map[string]interface{}{},
+			})
+			return
+		}
+		similar, err = h.contentFilterService.FilterRecommendations(ctx, viewer, similar)
+		if err != nil {
+			status, code, message, description := mapNovelServiceError(c, err, "get")
+			c.JSON(status, r.StandardResponse{
+				Success: false,
+				Message: message,
+				Data:    nil,
+				Error:   &r.ErrorDetail{Code: code, Description: description},
+				Meta:    map[string]interface{}{},
+			})
+			return
+		}
+		novel.Similar = localizeRecommendations(c, similar)
+	}
+
+	// Franchise graph (sequel, adaptation, spin-off...) around this novel
+	if includeRelations {
+		relations, err := h.relationService.GetGraph(ctx, d.ContentRelationGraphRequest{
+			ContentType: string(m.ContentTypeNovel),
+			ContentID:   novelID,
+			Depth:       relationDepth,
+		})
+		if err != nil {
+			status, code, message, description := mapContentRelationServiceError(c, err, "graph")
+			c.JSON(status, r.StandardResponse{
+				Success: false,
+				Message: message,
+				Data:    nil,
+				Error:   &r.ErrorDetail{Code: code, Description: description},
+				Meta:    map[string]interface{}{},
+			})
+			return
+		}
+		novel.Relations = relations
+	}
+	novel.Restriction = restriction
+
 	successMessage := i18n.Localize(c, "catalog.novels.get.success", "Novel retrieved successfully")
 	c.JSON(http.StatusOK, r.StandardResponse{
 		Success: true,
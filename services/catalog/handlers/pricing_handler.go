@@ -0,0 +1,480 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	d "wibusystem/pkg/common/dto"
+	r "wibusystem/pkg/common/response"
+	"wibusystem/pkg/i18n"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// PricingHandler handles discount campaigns, bundles and price quotes
+type PricingHandler struct {
+	pricingService interfaces.PricingServiceInterface
+	loc            *i18n.Translator
+}
+
+// NewPricingHandler creates a new pricing handler
+func NewPricingHandler(pricingService interfaces.PricingServiceInterface, translator *i18n.Translator) *PricingHandler {
+	return &PricingHandler{
+		pricingService: pricingService,
+		loc:            translator,
+	}
+}
+
+// QuotePrice handles GET /pricing/quote
+func (h *PricingHandler) QuotePrice(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req d.PriceQuoteRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_query_parameters", "Invalid query parameters")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	quote, err := h.pricingService.QuotePrice(ctx, userID, req)
+	if err != nil {
+		status, code, message, description := mapPricingServiceError(c, err, "quote")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.pricing.quote.success", "Price quote computed successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    quote,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// ListCampaigns handles GET /pricing/campaigns
+func (h *PricingHandler) ListCampaigns(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.ListDiscountCampaignsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_query_parameters", "Invalid query parameters")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	response, err := h.pricingService.ListCampaigns(ctx, req, actor)
+	if err != nil {
+		status, code, message, description := mapPricingServiceError(c, err, "list_campaigns")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.pricing.campaigns.list.success", "Discount campaigns retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response.Campaigns,
+		Error:   nil,
+		Meta: map[string]interface{}{
+			"pagination": response.Pagination,
+		},
+	})
+}
+
+// CreateCampaign handles POST /pricing/campaigns
+func (h *PricingHandler) CreateCampaign(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.CreateDiscountCampaignRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	campaign, err := h.pricingService.CreateCampaign(ctx, req, actor)
+	if err != nil {
+		status, code, message, description := mapPricingServiceError(c, err, "create_campaign")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.pricing.campaigns.create.success", "Discount campaign created successfully")
+	c.JSON(http.StatusCreated, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    campaign,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// GetCampaign handles GET /pricing/campaigns/{campaign_id}
+func (h *PricingHandler) GetCampaign(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	campaign, err := h.pricingService.GetCampaign(ctx, c.Param("campaign_id"), actor)
+	if err != nil {
+		status, code, message, description := mapPricingServiceError(c, err, "get_campaign")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.pricing.campaigns.get.success", "Discount campaign retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    campaign,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// UpdateCampaign handles PUT /pricing/campaigns/{campaign_id}
+func (h *PricingHandler) UpdateCampaign(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.UpdateDiscountCampaignRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	campaign, err := h.pricingService.UpdateCampaign(ctx, c.Param("campaign_id"), req, actor)
+	if err != nil {
+		status, code, message, description := mapPricingServiceError(c, err, "update_campaign")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.pricing.campaigns.update.success", "Discount campaign updated successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    campaign,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// DeleteCampaign handles DELETE /pricing/campaigns/{campaign_id}
+func (h *PricingHandler) DeleteCampaign(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	err := h.pricingService.DeleteCampaign(ctx, c.Param("campaign_id"), actor)
+	if err != nil {
+		status, code, message, description := mapPricingServiceError(c, err, "delete_campaign")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.pricing.campaigns.delete.success", "Discount campaign deleted successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    nil,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// ListNovelBundles handles GET /novels/{novel_id}/bundles
+func (h *PricingHandler) ListNovelBundles(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	bundles, err := h.pricingService.ListNovelBundles(ctx, c.Param("novel_id"))
+	if err != nil {
+		status, code, message, description := mapPricingServiceError(c, err, "list_bundles")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.pricing.bundles.list.success", "Bundles retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    bundles,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// GetBundle handles GET /bundles/{bundle_id}
+func (h *PricingHandler) GetBundle(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	bundle, err := h.pricingService.GetBundle(ctx, c.Param("bundle_id"))
+	if err != nil {
+		status, code, message, description := mapPricingServiceError(c, err, "get_bundle")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.pricing.bundles.get.success", "Bundle retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    bundle,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// CreateBundle handles POST /novels/{novel_id}/bundles
+func (h *PricingHandler) CreateBundle(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.CreateBundleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	bundle, err := h.pricingService.CreateBundle(ctx, c.Param("novel_id"), req, actor)
+	if err != nil {
+		status, code, message, description := mapPricingServiceError(c, err, "create_bundle")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.pricing.bundles.create.success", "Bundle created successfully")
+	c.JSON(http.StatusCreated, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    bundle,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// UpdateBundle handles PUT /bundles/{bundle_id}
+func (h *PricingHandler) UpdateBundle(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.UpdateBundleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	bundle, err := h.pricingService.UpdateBundle(ctx, c.Param("bundle_id"), req, actor)
+	if err != nil {
+		status, code, message, description := mapPricingServiceError(c, err, "update_bundle")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.pricing.bundles.update.success", "Bundle updated successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    bundle,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// DeleteBundle handles DELETE /bundles/{bundle_id}
+func (h *PricingHandler) DeleteBundle(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	err := h.pricingService.DeleteBundle(ctx, c.Param("bundle_id"), actor)
+	if err != nil {
+		status, code, message, description := mapPricingServiceError(c, err, "delete_bundle")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.pricing.bundles.delete.success", "Bundle deleted successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    nil,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// mapPricingServiceError maps pricing errors to HTTP responses
+func mapPricingServiceError(c *gin.Context, err error, operation string) (int, string, string, string) {
+	errStr := err.Error()
+
+	switch {
+	case strings.Contains(errStr, "ID format"):
+		message := i18n.Localize(c, "catalog.common.error.invalid_id_format", "Invalid ID format")
+		return http.StatusBadRequest, "invalid_id", message, errStr
+
+	case strings.Contains(errStr, "invalid"):
+		message := i18n.Localize(c, "catalog.common.error.validation", "Validation error")
+		return http.StatusBadRequest, "validation_error", message, errStr
+
+	case strings.Contains(errStr, "permission denied"):
+		message := i18n.Localize(c, "catalog.pricing.error.forbidden", "You do not have permission to manage pricing for this content")
+		return http.StatusForbidden, "forbidden", message, errStr
+
+	case strings.Contains(errStr, "not for sale"):
+		message := i18n.Localize(c, "catalog.pricing.error.not_for_sale", "This item is not for sale")
+		return http.StatusConflict, "not_for_sale", message, errStr
+
+	case strings.Contains(errStr, "not found"):
+		message := i18n.Localize(c, "catalog.common.error.not_found", "Resource not found")
+		return http.StatusNotFound, "not_found", message, errStr
+
+	default:
+		message := i18n.Localize(c, "catalog.common.error.internal", "Internal server error")
+		return http.StatusInternalServerError, "internal_error", message, errStr
+	}
+}
@@ -0,0 +1,150 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	d "wibusystem/pkg/common/dto"
+	r "wibusystem/pkg/common/response"
+	"wibusystem/pkg/i18n"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// RankingHandler handles novel trending and ranking endpoints
+type RankingHandler struct {
+	rankingService       interfaces.RankingServiceInterface
+	contentFilterService interfaces.ContentFilterServiceInterface
+	loc                  *i18n.Translator
+}
+
+// NewRankingHandler creates a new ranking handler
+func NewRankingHandler(rankingService interfaces.RankingServiceInterface, contentFilterService interfaces.ContentFilterServiceInterface, translator *i18n.Translator) *RankingHandler {
+	return &RankingHandler{
+		rankingService:       rankingService,
+		contentFilterService: contentFilterService,
+		loc:                  translator,
+	}
+}
+
+// ListRanking handles GET /novels/rankings/{ranking_type}
+func (h *RankingHandler) ListRanking(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// Bind query parameters
+	var req d.ListNovelRankingRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_query_parameters", "Invalid query parameters")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	// Age gating and the viewer's filters are applied by the list query itself
+	viewer := currentViewer(c)
+	visibility, err := h.contentFilterService.Visibility(ctx, viewer)
+	if err != nil {
+		status, code, message, description := mapRankingServiceError(c, err, "list")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+	req.Visibility = visibility
+
+	response, err := h.rankingService.ListRanking(ctx, c.Param("ranking_type"), req)
+	if err != nil {
+		status, code, message, description := mapRankingServiceError(c, err, "list")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	// Mark novels the viewer's age or filters blur or warn about
+	novels, err := h.contentFilterService.FilterRankedNovels(ctx, viewer, response.Novels)
+	if err != nil {
+		status, code, message, description := mapRankingServiceError(c, err, "list")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.rankings.list.success", "Ranking retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    novels,
+		Error:   nil,
+		Meta: map[string]interface{}{
+			"ranking_type": response.RankingType,
+			"computed_at":  response.ComputedAt,
+			"pagination":   response.Pagination,
+		},
+	})
+}
+
+// RebuildRankings handles POST /novels/rankings/rebuild
+func (h *RankingHandler) RebuildRankings(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	response, err := h.rankingService.RebuildRankings(ctx)
+	if err != nil {
+		status, code, message, description := mapRankingServiceError(c, err, "rebuild")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    response,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.rankings.rebuild.success", "Rankings rebuilt successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// mapRankingServiceError maps service errors to appropriate HTTP responses for ranking operations
+func mapRankingServiceError(c *gin.Context, err error, operation string) (int, string, string, string) {
+	errStr := err.Error()
+
+	switch {
+	case strings.Contains(errStr, "invalid ranking type"):
+		message := i18n.Localize(c, "catalog.rankings.error.invalid_type", "Unknown ranking type")
+		return http.StatusBadRequest, "invalid_ranking_type", message, errStr
+
+	case strings.Contains(errStr, "invalid genre ID format"):
+		message := i18n.Localize(c, "catalog.common.error.invalid_id_format", "Invalid ID format")
+		return http.StatusBadRequest, "invalid_id", message, errStr
+
+	default:
+		message := i18n.Localize(c, "catalog.common.error.internal", "Internal server error")
+		return http.StatusInternalServerError, "internal_error", message, errStr
+	}
+}
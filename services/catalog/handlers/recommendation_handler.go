@@ -0,0 +1,288 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	d "wibusystem/pkg/common/dto"
+	r "wibusystem/pkg/common/response"
+	"wibusystem/pkg/i18n"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// RecommendationHandler handles similar-novel recommendation and bookmark endpoints
+type RecommendationHandler struct {
+	recommendationService interfaces.RecommendationServiceInterface
+	bookmarkService       interfaces.BookmarkServiceInterface
+	contentFilterService  interfaces.ContentFilterServiceInterface
+	loc                   *i18n.Translator
+}
+
+// NewRecommendationHandler creates a new recommendation handler
+func NewRecommendationHandler(recommendationService interfaces.RecommendationServiceInterface, bookmarkService interfaces.BookmarkServiceInterface, contentFilterService interfaces.ContentFilterServiceInterface, translator *i18n.Translator) *RecommendationHandler {
+	return &RecommendationHandler{
+		recommendationService: recommendationService,
+		bookmarkService:       bookmarkService,
+		contentFilterService:  contentFilterService,
+		loc:                   translator,
+	}
+}
+
+// GetSimilarNovels handles GET /novels/{novel_id}/similar
+func (h *RecommendationHandler) GetSimilarNovels(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req d.ListRecommendationsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_query_parameters", "Invalid query parameters")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	// Age gating and the viewer's filters are applied by the list query itself
+	viewer := currentViewer(c)
+	visibility, err := h.contentFilterService.Visibility(ctx, viewer)
+	if err != nil {
+		status, code, message, description := mapRecommendationServiceError(c, err, "similar")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+	req.Visibility = visibility
+
+	novels, err := h.recommendationService.GetSimilarNovels(ctx, c.Param("novel_id"), req)
+	if err != nil {
+		status, code, message, description := mapRecommendationServiceError(c, err, "similar")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	// Mark novels the viewer's age or filters blur or warn about
+	novels, err = h.contentFilterService.FilterRecommendations(ctx, viewer, novels)
+	if err != nil {
+		status, code, message, description := mapRecommendationServiceError(c, err, "similar")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.recommendations.similar.success", "Similar novels retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    localizeRecommendations(c, novels),
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// GetMyRecommendations handles GET /novels/recommendations
+func (h *RecommendationHandler) GetMyRecommendations(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req d.ListRecommendationsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_query_parameters", "Invalid query parameters")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	// Age gating and the viewer's filters are applied by the list query itself
+	viewer := currentViewer(c)
+	visibility, err := h.contentFilterService.Visibility(ctx, viewer)
+	if err != nil {
+		status, code, message, description := mapRecommendationServiceError(c, err, "personal")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+	req.Visibility = visibility
+
+	novels, err := h.recommendationService.GetRecommendationsForUser(ctx, userID, req)
+	if err != nil {
+		status, code, message, description := mapRecommendationServiceError(c, err, "personal")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	// Mark novels the viewer's age or filters blur or warn about
+	novels, err = h.contentFilterService.FilterRecommendations(ctx, viewer, novels)
+	if err != nil {
+		status, code, message, description := mapRecommendationServiceError(c, err, "personal")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.recommendations.personal.success", "Recommendations retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    localizeRecommendations(c, novels),
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// RebuildNeighbours handles POST /novels/recommendations/rebuild
+func (h *RecommendationHandler) RebuildNeighbours(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	count, err := h.recommendationService.RebuildNeighbours(ctx)
+	if err != nil {
+		status, code, message, description := mapRecommendationServiceError(c, err, "rebuild")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.recommendations.rebuild.success", "Recommendations rebuilt successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    map[string]interface{}{"neighbours": count},
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// AddBookmark handles POST /novels/{novel_id}/bookmark
+func (h *RecommendationHandler) AddBookmark(c *gin.Context) {
+	h.handleBookmark(c, true)
+}
+
+// RemoveBookmark handles DELETE /novels/{novel_id}/bookmark
+func (h *RecommendationHandler) RemoveBookmark(c *gin.Context) {
+	h.handleBookmark(c, false)
+}
+
+func (h *RecommendationHandler) handleBookmark(c *gin.Context, add bool) {
+	ctx := c.Request.Context()
+
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var response *d.BookmarkResponse
+	var err error
+	if add {
+		response, err = h.bookmarkService.AddBookmark(ctx, userID, c.Param("novel_id"))
+	} else {
+		response, err = h.bookmarkService.RemoveBookmark(ctx, userID, c.Param("novel_id"))
+	}
+	if err != nil {
+		status, code, message, description := mapRecommendationServiceError(c, err, "bookmark")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.bookmarks.remove.success", "Bookmark removed successfully")
+	if add {
+		successMessage = i18n.Localize(c, "catalog.bookmarks.add.success", "Novel bookmarked successfully")
+	}
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// localizeRecommendations fills the human-readable "because you read X" explanation
+func localizeRecommendations(c *gin.Context, novels []d.RecommendedNovelResponse) []d.RecommendedNovelResponse {
+	for i := range novels {
+		novels[i].Explanation = i18n.LocalizeWithData(c,
+			"catalog.recommendations.because_you_read",
+			"Because you read {{.Title}}",
+			map[string]any{"Title": novels[i].BecauseYouRead.Name},
+		)
+	}
+	return novels
+}
+
+// mapRecommendationServiceError maps service errors to appropriate HTTP responses
+func mapRecommendationServiceError(c *gin.Context, err error, operation string) (int, string, string, string) {
+	errStr := err.Error()
+
+	switch {
+	case strings.Contains(errStr, "invalid novel ID format"):
+		message := i18n.Localize(c, "catalog.common.error.invalid_id_format", "Invalid ID format")
+		return http.StatusBadRequest, "invalid_id", message, errStr
+
+	case strings.Contains(errStr, "not found"):
+		message := i18n.Localize(c, "catalog.common.error.not_found", "Resource not found")
+		return http.StatusNotFound, "not_found", message, errStr
+
+	case strings.Contains(errStr, "required"):
+		message := i18n.Localize(c, "catalog.common.error.required_field", "Required field missing")
+		return http.StatusBadRequest, "required_field", message, errStr
+
+	default:
+		message := i18n.Localize(c, "catalog.common.error.internal", "Internal server error")
+		return http.StatusInternalServerError, "internal_error", message, errStr
+	}
+}
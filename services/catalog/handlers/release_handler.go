@@ -0,0 +1,315 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+	r "wibusystem/pkg/common/response"
+	"wibusystem/pkg/i18n"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// ReleaseHandler handles release policies, the reader's chapter list, reading and free unlocks
+type ReleaseHandler struct {
+	releaseService       interfaces.ReleaseServiceInterface
+	chapterService       interfaces.ChapterServiceInterface
+	analyticsService     interfaces.AnalyticsServiceInterface
+	contentFilterService interfaces.ContentFilterServiceInterface
+	loc                  *i18n.Translator
+}
+
+// NewReleaseHandler creates a new release handler
+func NewReleaseHandler(releaseService interfaces.ReleaseServiceInterface, chapterService interfaces.ChapterServiceInterface, analyticsService interfaces.AnalyticsServiceInterface, contentFilterService interfaces.ContentFilterServiceInterface, translator *i18n.Translator) *ReleaseHandler {
+	return &ReleaseHandler{
+		releaseService:       releaseService,
+		chapterService:       chapterService,
+		analyticsService:     analyticsService,
+		contentFilterService: contentFilterService,
+		loc:                  translator,
+	}
+}
+
+// GetPolicy handles GET /novels/{novel_id}/release-policy
+func (h *ReleaseHandler) GetPolicy(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	policy, err := h.releaseService.GetPolicy(ctx, c.Param("novel_id"))
+	if err != nil {
+		status, code, message, description := mapReleaseServiceError(c, err, "get_policy")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.release.policy.get.success", "Release policy retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    policy,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// UpdatePolicy handles PUT /novels/{novel_id}/release-policy
+func (h *ReleaseHandler) UpdatePolicy(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.UpdateReleasePolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	policy, err := h.releaseService.UpdatePolicy(ctx, c.Param("novel_id"), req, actor)
+	if err != nil {
+		status, code, message, description := mapReleaseServiceError(c, err, "update_policy")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.release.policy.update.success", "Release policy updated successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    policy,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// ListReaderChapters handles GET /novels/volumes/{volume_id}/chapters
+func (h *ReleaseHandler) ListReaderChapters(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.ListReaderChaptersRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_query_parameters", "Invalid query parameters")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	response, err := h.releaseService.ListReaderChapters(ctx, c.Param("volume_id"), req, actor)
+	if err != nil {
+		status, code, message, description := mapReleaseServiceError(c, err, "list_chapters")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	chapters, hidden, err := h.contentFilterService.FilterReaderChapters(ctx, currentViewer(c), c.Param("volume_id"), response.Chapters)
+	if err != nil {
+		status, code, message, description := mapReleaseServiceError(c, err, "list_chapters")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.release.chapters.list.success", "Chapters retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    chapters,
+		Error:   nil,
+		Meta: map[string]interface{}{
+			"pagination":   response.Pagination,
+			"release":      response.Release,
+			"hidden_count": hidden,
+		},
+	})
+}
+
+// ReadChapter handles GET /novels/chapters/{chapter_id}/read
+func (h *ReleaseHandler) ReadChapter(c *gin.Context) {
+	ctx := c.Request.Context()
+	chapterID := c.Param("chapter_id")
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	// Age gating and the reader's filters come before the purchase check
+	restriction, err := h.contentFilterService.CheckNovelChapter(ctx, currentViewer(c), chapterID)
+	if err != nil {
+		status, code, message, description := mapReleaseServiceError(c, err, "read_chapter")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+	if restriction != nil && restriction.Display == m.RestrictedDisplayHide {
+		respondContentRestricted(c, restriction)
+		return
+	}
+
+	if err := h.releaseService.AuthorizeChapterRead(ctx, chapterID, actor); err != nil {
+		status, code, message, description := mapReleaseServiceError(c, err, "read_chapter")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	chapter, err := h.chapterService.GetChapterByID(ctx, chapterID, true, i18n.ContentLanguages(c))
+	if err != nil {
+		status, code, message, description := mapReleaseServiceError(c, err, "read_chapter")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	if err := h.analyticsService.RecordChapterRead(ctx, chapterID, &actor.UserID); err != nil {
+		log.Printf("failed to record chapter read for %s: %v", chapterID, err)
+	}
+
+	successMessage := i18n.Localize(c, "catalog.release.chapters.read.success", "Chapter content retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    chapter,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// UnlockChapter handles POST /novels/chapters/{chapter_id}/unlock
+func (h *ReleaseHandler) UnlockChapter(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	response, err := h.releaseService.UnlockChapter(ctx, c.Param("chapter_id"), actor)
+	if err != nil {
+		status, code, message, description := mapReleaseServiceError(c, err, "unlock_chapter")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.release.unlock.success", "Chapter unlocked successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// mapReleaseServiceError maps release service errors to HTTP responses
+func mapReleaseServiceError(c *gin.Context, err error, operation string) (int, string, string, string) {
+	errStr := err.Error()
+
+	switch {
+	case strings.Contains(errStr, "ID format"):
+		message := i18n.Localize(c, "catalog.common.error.invalid_id_format", "Invalid ID format")
+		return http.StatusBadRequest, "invalid_id", message, errStr
+
+	case strings.Contains(errStr, "invalid"):
+		message := i18n.Localize(c, "catalog.common.error.validation", "Validation error")
+		return http.StatusBadRequest, "validation_error", message, errStr
+
+	case strings.Contains(errStr, "permission denied"):
+		message := i18n.Localize(c, "catalog.release.error.forbidden", "You do not have permission to manage the release policy of this novel")
+		return http.StatusForbidden, "forbidden", message, errStr
+
+	case strings.Contains(errStr, "purchase required"):
+		message := i18n.Localize(c, "catalog.release.error.purchase_required", "This chapter must be purchased or unlocked before reading")
+		return http.StatusForbidden, "purchase_required", message, errStr
+
+	case strings.Contains(errStr, "not released yet"):
+		message := i18n.Localize(c, "catalog.release.error.not_released", "This chapter has not been released yet")
+		return http.StatusForbidden, "not_released", message, errStr
+
+	case strings.Contains(errStr, "not offered"):
+		message := i18n.Localize(c, "catalog.release.error.unlock_not_offered", "This novel does not offer free unlocks")
+		return http.StatusConflict, "unlock_not_offered", message, errStr
+
+	case strings.Contains(errStr, "not available until"):
+		message := i18n.Localize(c, "catalog.release.error.unlock_cooldown", "Your next free unlock is not available yet")
+		return http.StatusTooManyRequests, "unlock_cooldown", message, errStr
+
+	case strings.Contains(errStr, "already"):
+		message := i18n.Localize(c, "catalog.release.error.already_readable", "This chapter can already be read")
+		return http.StatusConflict, "already_readable", message, errStr
+
+	case strings.Contains(errStr, "not found"):
+		message := i18n.Localize(c, "catalog.common.error.not_found", "Resource not found")
+		return http.StatusNotFound, "not_found", message, errStr
+
+	default:
+		message := i18n.Localize(c, "catalog.common.error.internal", "Internal server error")
+		return http.StatusInternalServerError, "internal_error", message, errStr
+	}
+}
@@ -0,0 +1,287 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	d "wibusystem/pkg/common/dto"
+	r "wibusystem/pkg/common/response"
+	"wibusystem/pkg/i18n"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// SubtitleContributionHandler handles community subtitle submission and review endpoints
+type SubtitleContributionHandler struct {
+	contributionService interfaces.SubtitleContributionServiceInterface
+	loc                 *i18n.Translator
+}
+
+// NewSubtitleContributionHandler creates a new subtitle contribution handler
+func NewSubtitleContributionHandler(contributionService interfaces.SubtitleContributionServiceInterface, translator *i18n.Translator) *SubtitleContributionHandler {
+	return &SubtitleContributionHandler{
+		contributionService: contributionService,
+		loc:                 translator,
+	}
+}
+
+// CreateContribution handles POST /anime/episodes/{episode_id}/subtitles/contributions
+func (h *SubtitleContributionHandler) CreateContribution(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.CreateSubtitleContributionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	response, err := h.contributionService.CreateContribution(ctx, c.Param("episode_id"), req, actor)
+	if err != nil {
+		status, code, message, description := mapAnimeServiceError(c, err, "create_subtitle_contribution")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.subtitle_contributions.create.success", "Subtitle submitted for review")
+	c.JSON(http.StatusCreated, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// UpdateContribution handles PUT /anime/subtitles/contributions/{contribution_id}
+func (h *SubtitleContributionHandler) UpdateContribution(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.UpdateSubtitleContributionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	response, err := h.contributionService.UpdateContribution(ctx, c.Param("contribution_id"), req, actor)
+	if err != nil {
+		status, code, message, description := mapAnimeServiceError(c, err, "update_subtitle_contribution")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.subtitle_contributions.update.success", "Subtitle contribution updated successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// GetContribution handles GET /anime/subtitles/contributions/{contribution_id}
+func (h *SubtitleContributionHandler) GetContribution(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	response, err := h.contributionService.GetContribution(ctx, c.Param("contribution_id"), actor)
+	if err != nil {
+		status, code, message, description := mapAnimeServiceError(c, err, "get_subtitle_contribution")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.subtitle_contributions.get.success", "Subtitle contribution retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// ListContributions handles GET /anime/{anime_id}/subtitles/contributions (review queue)
+func (h *SubtitleContributionHandler) ListContributions(c *gin.Context) {
+	h.list(c, false)
+}
+
+// ListMyContributions handles GET /anime/subtitles/contributions/mine
+func (h *SubtitleContributionHandler) ListMyContributions(c *gin.Context) {
+	h.list(c, true)
+}
+
+// list binds the query and returns either an anime's review queue or the caller's own contributions
+func (h *SubtitleContributionHandler) list(c *gin.Context, mine bool) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.ListSubtitleContributionsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_query_parameters", "Invalid query parameters")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	var response *d.PaginatedSubtitleContributionsResponse
+	var err error
+	if mine {
+		response, err = h.contributionService.ListMyContributions(ctx, req, actor)
+	} else {
+		response, err = h.contributionService.ListContributions(ctx, c.Param("anime_id"), req, actor)
+	}
+	if err != nil {
+		status, code, message, description := mapAnimeServiceError(c, err, "list_subtitle_contributions")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.subtitle_contributions.list.success", "Subtitle contributions retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response.Contributions,
+		Error:   nil,
+		Meta: map[string]interface{}{
+			"pagination": response.Pagination,
+		},
+	})
+}
+
+// ApproveContribution handles POST /anime/subtitles/contributions/{contribution_id}/approve
+func (h *SubtitleContributionHandler) ApproveContribution(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	response, err := h.contributionService.ApproveContribution(ctx, c.Param("contribution_id"), actor)
+	if err != nil {
+		status, code, message, description := mapAnimeServiceError(c, err, "approve_subtitle_contribution")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.subtitle_contributions.approve.success", "Subtitle contribution approved and published")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// RejectContribution handles POST /anime/subtitles/contributions/{contribution_id}/reject
+func (h *SubtitleContributionHandler) RejectContribution(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.RejectSubtitleContributionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	if err := h.contributionService.RejectContribution(ctx, c.Param("contribution_id"), req, actor); err != nil {
+		status, code, message, description := mapAnimeServiceError(c, err, "reject_subtitle_contribution")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.subtitle_contributions.reject.success", "Subtitle contribution rejected")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    nil,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
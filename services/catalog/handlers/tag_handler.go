@@ -0,0 +1,329 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	d "wibusystem/pkg/common/dto"
+	r "wibusystem/pkg/common/response"
+	"wibusystem/pkg/i18n"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// TagHandler handles tag registry endpoints
+type TagHandler struct {
+	tagService interfaces.TagServiceInterface
+	loc        *i18n.Translator
+}
+
+// NewTagHandler creates a new tag handler
+func NewTagHandler(tagService interfaces.TagServiceInterface, translator *i18n.Translator) *TagHandler {
+	return &TagHandler{
+		tagService: tagService,
+		loc:        translator,
+	}
+}
+
+// ListTags handles GET /tags
+func (h *TagHandler) ListTags(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req d.ListTagsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_query_parameters", "Invalid query parameters")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	tags, total, err := h.tagService.ListTags(ctx, req)
+	if err != nil {
+		status, code, message, description := mapTagServiceError(c, err, "list")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	totalPages := int(total) / req.PageSize
+	if int(total)%req.PageSize > 0 {
+		totalPages++
+	}
+
+	successMessage := i18n.Localize(c, "catalog.tags.list.success", "Tags fetched successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    tags,
+		Error:   nil,
+		Meta: map[string]interface{}{
+			"page":        req.Page,
+			"page_size":   req.PageSize,
+			"total_pages": totalPages,
+			"total_items": total,
+		},
+	})
+}
+
+// AutocompleteTags handles GET /tags/autocomplete
+func (h *TagHandler) AutocompleteTags(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req d.AutocompleteTagsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_query_parameters", "Invalid query parameters")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	suggestions, err := h.tagService.AutocompleteTags(ctx, req, i18n.ContentLanguages(c))
+	if err != nil {
+		status, code, message, description := mapTagServiceError(c, err, "autocomplete")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.tags.autocomplete.success", "Tag suggestions fetched successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    suggestions,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// GetTag handles GET /tags/:id
+func (h *TagHandler) GetTag(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	tagID, ok := tagIDParam(c)
+	if !ok {
+		return
+	}
+
+	tag, err := h.tagService.GetTagByID(ctx, tagID)
+	if err != nil {
+		status, code, message, description := mapTagServiceError(c, err, "get")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.tags.get.success", "Tag fetched successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    tag,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// CreateTag handles POST /tags
+func (h *TagHandler) CreateTag(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req d.CreateTagRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	tag, err := h.tagService.CreateTag(ctx, req)
+	if err != nil {
+		status, code, message, description := mapTagServiceError(c, err, "create")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.tags.create.success", "Tag created successfully")
+	c.JSON(http.StatusCreated, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    tag,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// UpdateTag handles PUT /tags/:id
+func (h *TagHandler) UpdateTag(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	tagID, ok := tagIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req d.UpdateTagRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	tag, err := h.tagService.UpdateTag(ctx, tagID, req)
+	if err != nil {
+		status, code, message, description := mapTagServiceError(c, err, "update")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.tags.update.success", "Tag updated successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    tag,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// MergeTag handles POST /tags/:id/merge
+func (h *TagHandler) MergeTag(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	tagID, ok := tagIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req d.MergeTagRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	result, err := h.tagService.MergeTags(ctx, tagID, req)
+	if err != nil {
+		status, code, message, description := mapTagServiceError(c, err, "merge")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.tags.merge.success", "Tags merged successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    result,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// tagIDParam parses the :id path parameter, writing the error response when it is not a UUID
+func tagIDParam(c *gin.Context) (uuid.UUID, bool) {
+	tagID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		message := i18n.Localize(c, "catalog.tags.error.invalid_id", "Invalid tag ID")
+		detail := i18n.Localize(c, "catalog.tags.error.invalid_id_detail", "Tag ID must be a valid UUID")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "invalid_id", Description: detail},
+			Meta:    map[string]interface{}{},
+		})
+		return uuid.Nil, false
+	}
+	return tagID, true
+}
+
+// mapTagServiceError maps tag service errors to HTTP status codes and localized messages
+func mapTagServiceError(c *gin.Context, err error, operation string) (int, string, string, string) {
+	errStr := err.Error()
+
+	switch {
+	case strings.Contains(errStr, "not found"):
+		message := i18n.Localize(c, "catalog.common.error.not_found", "Resource not found")
+		return http.StatusNotFound, "not_found", message, errStr
+
+	case strings.Contains(errStr, "already exists"):
+		message := i18n.Localize(c, "catalog.tags.error.conflict", "The tag or alias is already registered")
+		return http.StatusConflict, "conflict", message, errStr
+
+	case strings.Contains(errStr, "invalid language code"):
+		message := i18n.Localize(c, "catalog.translations.error.invalid_language", "Invalid language code")
+		return http.StatusBadRequest, "invalid_language", message, errStr
+
+	case strings.Contains(errStr, "invalid"):
+		message := i18n.Localize(c, "catalog.common.error.validation", "Validation error")
+		return http.StatusBadRequest, "validation_error", message, errStr
+
+	case strings.Contains(errStr, "required"):
+		message := i18n.Localize(c, "catalog.common.error.required_field", "Required field missing")
+		return http.StatusBadRequest, "required_field", message, errStr
+
+	default:
+		message := i18n.Localize(c, "catalog.common.error.internal", "Internal server error")
+		return http.StatusInternalServerError, "internal_error", message, errStr
+	}
+}
@@ -0,0 +1,303 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"wibusystem/pkg/common/auth"
+	d "wibusystem/pkg/common/dto"
+	r "wibusystem/pkg/common/response"
+	"wibusystem/pkg/i18n"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// TranslationContributionHandler handles community translation submission and moderator review endpoints
+type TranslationContributionHandler struct {
+	contributionService interfaces.TranslationContributionServiceInterface
+	loc                 *i18n.Translator
+}
+
+// NewTranslationContributionHandler creates a new translation contribution handler
+func NewTranslationContributionHandler(contributionService interfaces.TranslationContributionServiceInterface, translator *i18n.Translator) *TranslationContributionHandler {
+	return &TranslationContributionHandler{
+		contributionService: contributionService,
+		loc:                 translator,
+	}
+}
+
+// CreateContribution handles POST /translations/contribute
+func (h *TranslationContributionHandler) CreateContribution(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.CreateTranslationContributionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	response, err := h.contributionService.CreateContribution(ctx, req, actor)
+	if err != nil {
+		status, code, message, description := mapTranslationContributionServiceError(c, err, "create")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.translation_contributions.create.success", "Translation submitted for review")
+	c.JSON(http.StatusCreated, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// UpdateContribution handles PUT /translations/contributions/{contribution_id}
+func (h *TranslationContributionHandler) UpdateContribution(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.UpdateTranslationContributionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	response, err := h.contributionService.UpdateContribution(ctx, c.Param("contribution_id"), req, actor)
+	if err != nil {
+		status, code, message, description := mapTranslationContributionServiceError(c, err, "update")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.translation_contributions.update.success", "Translation contribution updated successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// GetContribution handles GET /translations/contributions/{contribution_id}
+func (h *TranslationContributionHandler) GetContribution(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	user, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	actor := d.ContentActor{UserID: user.UserID, TenantID: user.TenantID, IsAdmin: user.IsAdmin()}
+	canReview := user.IsAdmin() || user.HasAnyScope(string(auth.PermModerationContentReview))
+
+	response, err := h.contributionService.GetContribution(ctx, c.Param("contribution_id"), actor, canReview)
+	if err != nil {
+		status, code, message, description := mapTranslationContributionServiceError(c, err, "get")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.translation_contributions.get.success", "Translation contribution retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// ListContributions handles GET /translations/pending (moderator review queue)
+func (h *TranslationContributionHandler) ListContributions(c *gin.Context) {
+	h.list(c, false)
+}
+
+// ListMyContributions handles GET /translations/my-contributions
+func (h *TranslationContributionHandler) ListMyContributions(c *gin.Context) {
+	h.list(c, true)
+}
+
+// list binds the query and returns either the review queue or the caller's own contributions
+func (h *TranslationContributionHandler) list(c *gin.Context, mine bool) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.ListTranslationContributionsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_query_parameters", "Invalid query parameters")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	var response *d.PaginatedTranslationContributionsResponse
+	var err error
+	if mine {
+		response, err = h.contributionService.ListMyContributions(ctx, req, actor)
+	} else {
+		response, err = h.contributionService.ListContributions(ctx, req)
+	}
+	if err != nil {
+		status, code, message, description := mapTranslationContributionServiceError(c, err, "list")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.translation_contributions.list.success", "Translation contributions retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response.Contributions,
+		Error:   nil,
+		Meta: map[string]interface{}{
+			"pagination": response.Pagination,
+		},
+	})
+}
+
+// ReviewContribution handles POST /translations/contributions/{contribution_id}/review
+func (h *TranslationContributionHandler) ReviewContribution(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.ReviewTranslationContributionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	response, err := h.contributionService.ReviewContribution(ctx, c.Param("contribution_id"), req, actor)
+	if err != nil {
+		status, code, message, description := mapTranslationContributionServiceError(c, err, "review")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.translation_contributions.approve.success", "Translation contribution approved and published")
+	if req.Action == "reject" {
+		successMessage = i18n.Localize(c, "catalog.translation_contributions.reject.success", "Translation contribution rejected")
+	}
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// mapTranslationContributionServiceError maps service errors to HTTP status codes and localized messages
+func mapTranslationContributionServiceError(c *gin.Context, err error, operation string) (int, string, string, string) {
+	errStr := err.Error()
+
+	switch {
+	case strings.Contains(errStr, "ID format"):
+		message := i18n.Localize(c, "catalog.common.error.invalid_id_format", "Invalid ID format")
+		return http.StatusBadRequest, "invalid_id", message, errStr
+
+	case strings.Contains(errStr, "invalid language code"):
+		message := i18n.Localize(c, "catalog.translations.error.invalid_language", "Invalid language code")
+		return http.StatusBadRequest, "invalid_language", message, errStr
+
+	case strings.Contains(errStr, "invalid"):
+		message := i18n.Localize(c, "catalog.common.error.validation", "Validation error")
+		return http.StatusBadRequest, "validation_error", message, errStr
+
+	case strings.Contains(errStr, "required"):
+		message := i18n.Localize(c, "catalog.common.error.required_field", "Required field missing")
+		return http.StatusBadRequest, "required_field", message, errStr
+
+	case strings.Contains(errStr, "permission denied"):
+		message := i18n.Localize(c, "catalog.translation_contributions.error.forbidden", "You do not have access to this contribution")
+		return http.StatusForbidden, "forbidden", message, errStr
+
+	case strings.Contains(errStr, "not found"):
+		message := i18n.Localize(c, "catalog.common.error.not_found", "Resource not found")
+		return http.StatusNotFound, "not_found", message, errStr
+
+	case strings.Contains(errStr, "already reviewed"):
+		message := i18n.Localize(c, "catalog.translation_contributions.error.already_reviewed", "The contribution has already been reviewed")
+		return http.StatusConflict, "already_reviewed", message, errStr
+
+	default:
+		message := i18n.Localize(c, "catalog.common.error.internal", "Internal server error")
+		return http.StatusInternalServerError, "internal_error", message, errStr
+	}
+}
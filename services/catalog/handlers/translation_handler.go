@@ -0,0 +1,390 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	d "wibusystem/pkg/common/dto"
+	r "wibusystem/pkg/common/response"
+	"wibusystem/pkg/i18n"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// TranslationHandler handles localized novel metadata and translated chapter endpoints
+type TranslationHandler struct {
+	translationService interfaces.TranslationServiceInterface
+	loc                *i18n.Translator
+}
+
+// NewTranslationHandler creates a new translation handler
+func NewTranslationHandler(translationService interfaces.TranslationServiceInterface, translator *i18n.Translator) *TranslationHandler {
+	return &TranslationHandler{
+		translationService: translationService,
+		loc:                translator,
+	}
+}
+
+// ListNovelTranslations handles GET /novels/{novel_id}/translations
+func (h *TranslationHandler) ListNovelTranslations(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	response, err := h.translationService.ListNovelTranslations(ctx, c.Param("novel_id"))
+	if err != nil {
+		status, code, message, description := mapTranslationServiceError(c, err, "list")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.translations.novel.list.success", "Novel translations retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// SetNovelTranslations handles PUT /novels/{novel_id}/translations
+func (h *TranslationHandler) SetNovelTranslations(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.SetNovelTranslationsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	response, err := h.translationService.SetNovelTranslations(ctx, c.Param("novel_id"), req, actor)
+	if err != nil {
+		status, code, message, description := mapTranslationServiceError(c, err, "update")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.translations.novel.update.success", "Novel translations updated successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// ListChapterTranslations handles GET /chapters/{id}/translations
+func (h *TranslationHandler) ListChapterTranslations(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	response, err := h.translationService.ListChapterTranslations(ctx, c.Param("id"), actor)
+	if err != nil {
+		status, code, message, description := mapTranslationServiceError(c, err, "list")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.translations.chapter.list.success", "Chapter translations retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// GetChapterTranslation handles GET /chapters/{id}/translations/{language_code}
+func (h *TranslationHandler) GetChapterTranslation(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	response, err := h.translationService.GetChapterTranslation(ctx, c.Param("id"), c.Param("language_code"), actor)
+	if err != nil {
+		status, code, message, description := mapTranslationServiceError(c, err, "get")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.translations.chapter.get.success", "Chapter translation retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// UpsertChapterTranslation handles PUT /chapters/{id}/translations/{language_code}
+func (h *TranslationHandler) UpsertChapterTranslation(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.UpsertChapterTranslationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	response, err := h.translationService.UpsertChapterTranslation(ctx, c.Param("id"), c.Param("language_code"), req, actor)
+	if err != nil {
+		status, code, message, description := mapTranslationServiceError(c, err, "update")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.translations.chapter.update.success", "Chapter translation saved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// DeleteChapterTranslation handles DELETE /chapters/{id}/translations/{language_code}
+func (h *TranslationHandler) DeleteChapterTranslation(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	if err := h.translationService.DeleteChapterTranslation(ctx, c.Param("id"), c.Param("language_code"), actor); err != nil {
+		status, code, message, description := mapTranslationServiceError(c, err, "delete")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.translations.chapter.delete.success", "Chapter translation deleted successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    nil,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// GetGlossary handles GET /novels/{novel_id}/glossary/{language_code}
+func (h *TranslationHandler) GetGlossary(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	response, err := h.translationService.GetGlossary(ctx, c.Param("novel_id"), c.Param("language_code"))
+	if err != nil {
+		status, code, message, description := mapTranslationServiceError(c, err, "get")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.translations.glossary.get.success", "Glossary retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// SetGlossary handles PUT /novels/{novel_id}/glossary/{language_code}
+func (h *TranslationHandler) SetGlossary(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.SetGlossaryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_request_body", "Invalid request body")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	response, err := h.translationService.SetGlossary(ctx, c.Param("novel_id"), c.Param("language_code"), req, actor)
+	if err != nil {
+		status, code, message, description := mapTranslationServiceError(c, err, "update")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.translations.glossary.update.success", "Glossary updated successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// GenerateMachineDraft handles POST /chapters/{id}/translations/{language_code}/machine-draft
+func (h *TranslationHandler) GenerateMachineDraft(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	response, err := h.translationService.GenerateMachineDraft(ctx, c.Param("id"), c.Param("language_code"), actor)
+	if err != nil {
+		status, code, message, description := mapTranslationServiceError(c, err, "create")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.translations.machine_draft.success", "Machine translation draft submitted for review")
+	c.JSON(http.StatusCreated, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// mapTranslationServiceError maps translation service errors to appropriate HTTP responses
+func mapTranslationServiceError(c *gin.Context, err error, operation string) (int, string, string, string) {
+	errStr := err.Error()
+
+	switch {
+	case strings.Contains(errStr, "ID format"):
+		message := i18n.Localize(c, "catalog.common.error.invalid_id_format", "Invalid ID format")
+		return http.StatusBadRequest, "invalid_id", message, errStr
+
+	case strings.Contains(errStr, "permission denied"):
+		message := i18n.Localize(c, "catalog.translations.error.forbidden", "You do not have permission to manage translations of this novel")
+		return http.StatusForbidden, "forbidden", message, errStr
+
+	case strings.Contains(errStr, "machine translation is not configured"):
+		message := i18n.Localize(c, "catalog.translations.error.machine_unavailable", "Machine translation is not available")
+		return http.StatusServiceUnavailable, "machine_translation_unavailable", message, errStr
+
+	case strings.Contains(errStr, "machine translation failed"):
+		message := i18n.Localize(c, "catalog.translations.error.machine_failed", "The machine translation provider failed to translate this chapter")
+		return http.StatusBadGateway, "machine_translation_failed", message, errStr
+
+	case strings.Contains(errStr, "machine draft already exists"):
+		message := i18n.Localize(c, "catalog.translations.error.machine_draft_pending", "You already have a pending machine draft of this chapter in this language")
+		return http.StatusConflict, "conflict", message, errStr
+
+	case strings.Contains(errStr, "invalid language code"):
+		message := i18n.Localize(c, "catalog.translations.error.invalid_language", "Invalid language code")
+		return http.StatusBadRequest, "invalid_language", message, errStr
+
+	case strings.Contains(errStr, "invalid"):
+		message := i18n.Localize(c, "catalog.common.error.validation", "Validation error")
+		return http.StatusBadRequest, "validation_error", message, errStr
+
+	case strings.Contains(errStr, "translation not found"):
+		message := i18n.Localize(c, "catalog.translations.error.not_found", "No translation exists in this language")
+		return http.StatusNotFound, "translation_not_found", message, errStr
+
+	case strings.Contains(errStr, "not found"):
+		message := i18n.Localize(c, "catalog.common.error.not_found", "Resource not found")
+		return http.StatusNotFound, "not_found", message, errStr
+
+	default:
+		message := i18n.Localize(c, "catalog.common.error.internal", "Internal server error")
+		return http.StatusInternalServerError, "internal_error", message, errStr
+	}
+}
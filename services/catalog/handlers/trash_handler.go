@@ -0,0 +1,237 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	d "wibusystem/pkg/common/dto"
+	r "wibusystem/pkg/common/response"
+	"wibusystem/pkg/i18n"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// TrashHandler handles the trash of deleted novels, volumes and chapters and the buyers' archive
+type TrashHandler struct {
+	trashService interfaces.TrashServiceInterface
+	loc          *i18n.Translator
+}
+
+// NewTrashHandler creates a new trash handler
+func NewTrashHandler(trashService interfaces.TrashServiceInterface, translator *i18n.Translator) *TrashHandler {
+	return &TrashHandler{
+		trashService: trashService,
+		loc:          translator,
+	}
+}
+
+// ListTrash handles GET /trash
+func (h *TrashHandler) ListTrash(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	var req d.ListTrashRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_query_parameters", "Invalid query parameters")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	response, err := h.trashService.ListTrash(ctx, req, actor)
+	if err != nil {
+		status, code, message, description := mapTrashServiceError(c, err, "list")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.trash.list.success", "Trash retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response.Items,
+		Error:   nil,
+		Meta: map[string]interface{}{
+			"pagination": response.Pagination,
+		},
+	})
+}
+
+// RestoreNovel handles POST /trash/novels/{novel_id}/restore
+func (h *TrashHandler) RestoreNovel(c *gin.Context) {
+	h.restore(c, c.Param("novel_id"), h.trashService.RestoreNovel)
+}
+
+// RestoreVolume handles POST /trash/volumes/{volume_id}/restore
+func (h *TrashHandler) RestoreVolume(c *gin.Context) {
+	h.restore(c, c.Param("volume_id"), h.trashService.RestoreVolume)
+}
+
+// RestoreChapter handles POST /trash/chapters/{chapter_id}/restore
+func (h *TrashHandler) RestoreChapter(c *gin.Context) {
+	h.restore(c, c.Param("chapter_id"), h.trashService.RestoreChapter)
+}
+
+// restore runs one of the restore operations for the current user
+func (h *TrashHandler) restore(c *gin.Context, id string,
+	restoreFn func(context.Context, string, d.ContentActor) (*d.TrashRestoreResponse, error)) {
+	ctx := c.Request.Context()
+
+	actor, ok := currentActor(c)
+	if !ok {
+		return
+	}
+
+	result, err := restoreFn(ctx, id, actor)
+	if err != nil {
+		status, code, message, description := mapTrashServiceError(c, err, "restore")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.trash.restore.success", "Content restored successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    result,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// ListArchived handles GET /library/archived
+func (h *TrashHandler) ListArchived(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req d.ListArchivedRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		message := i18n.Localize(c, "catalog.common.error.invalid_query_parameters", "Invalid query parameters")
+		c.JSON(http.StatusBadRequest, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: "validation_error", Description: err.Error()},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	response, err := h.trashService.ListArchived(ctx, userID, req)
+	if err != nil {
+		status, code, message, description := mapTrashServiceError(c, err, "list_archived")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.trash.archived.list.success", "Archived chapters retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    response.Chapters,
+		Error:   nil,
+		Meta: map[string]interface{}{
+			"pagination": response.Pagination,
+		},
+	})
+}
+
+// ReadArchivedChapter handles GET /library/archived/chapters/{chapter_id}
+func (h *TrashHandler) ReadArchivedChapter(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	chapter, err := h.trashService.ReadArchivedChapter(ctx, userID, c.Param("chapter_id"))
+	if err != nil {
+		status, code, message, description := mapTrashServiceError(c, err, "read_archived")
+		c.JSON(status, r.StandardResponse{
+			Success: false,
+			Message: message,
+			Data:    nil,
+			Error:   &r.ErrorDetail{Code: code, Description: description},
+			Meta:    map[string]interface{}{},
+		})
+		return
+	}
+
+	successMessage := i18n.Localize(c, "catalog.trash.archived.read.success", "Archived chapter retrieved successfully")
+	c.JSON(http.StatusOK, r.StandardResponse{
+		Success: true,
+		Message: successMessage,
+		Data:    chapter,
+		Error:   nil,
+		Meta:    map[string]interface{}{},
+	})
+}
+
+// mapTrashServiceError maps trash errors to HTTP responses
+func mapTrashServiceError(c *gin.Context, err error, operation string) (int, string, string, string) {
+	errStr := err.Error()
+
+	switch {
+	case strings.Contains(errStr, "ID format"):
+		message := i18n.Localize(c, "catalog.common.error.invalid_id_format", "Invalid ID format")
+		return http.StatusBadRequest, "invalid_id", message, errStr
+
+	case strings.Contains(errStr, "invalid"):
+		message := i18n.Localize(c, "catalog.common.error.validation", "Validation error")
+		return http.StatusBadRequest, "validation_error", message, errStr
+
+	case strings.Contains(errStr, "permission denied"):
+		message := i18n.Localize(c, "catalog.trash.error.forbidden", "You do not have access to this content")
+		return http.StatusForbidden, "forbidden", message, errStr
+
+	case strings.Contains(errStr, "archived content is read-only"):
+		message := i18n.Localize(c, "catalog.trash.error.archived", "Archived content can no longer be restored")
+		return http.StatusConflict, "archived", message, errStr
+
+	case strings.Contains(errStr, "is in trash"):
+		message := i18n.Localize(c, "catalog.trash.error.parent_in_trash", "Restore the parent novel or volume first")
+		return http.StatusConflict, "parent_in_trash", message, errStr
+
+	case strings.Contains(errStr, "not found"):
+		message := i18n.Localize(c, "catalog.common.error.not_found", "Resource not found")
+		return http.StatusNotFound, "not_found", message, errStr
+
+	default:
+		message := i18n.Localize(c, "catalog.common.error.internal", "Internal server error")
+		return http.StatusInternalServerError, "internal_error", message, errStr
+	}
+}
@@ -0,0 +1,27 @@
+package jobs
+
+import (
+	"context"
+
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// AnalyticsRollupJob refreshes the pre-aggregated owner analytics tables.
+type AnalyticsRollupJob struct {
+	analyticsService interfaces.AnalyticsServiceInterface
+}
+
+// NewAnalyticsRollupJob creates an analytics rollup job.
+func NewAnalyticsRollupJob(analyticsService interfaces.AnalyticsServiceInterface) *AnalyticsRollupJob {
+	return &AnalyticsRollupJob{analyticsService: analyticsService}
+}
+
+// Name identifies the job in logs.
+func (j *AnalyticsRollupJob) Name() string {
+	return "novel-analytics-rollup"
+}
+
+// Run rolls up unique readers, purchases, rentals and revenue of the recent days.
+func (j *AnalyticsRollupJob) Run(ctx context.Context) error {
+	return j.analyticsService.RollupAnalytics(ctx)
+}
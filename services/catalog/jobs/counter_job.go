@@ -0,0 +1,43 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"sort"
+
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// CounterReconcileJob recomputes the denormalized novel and volume counters.
+type CounterReconcileJob struct {
+	counterService interfaces.CounterServiceInterface
+}
+
+// NewCounterReconcileJob creates a counter reconciliation job.
+func NewCounterReconcileJob(counterService interfaces.CounterServiceInterface) *CounterReconcileJob {
+	return &CounterReconcileJob{counterService: counterService}
+}
+
+// Name identifies the job in logs.
+func (j *CounterReconcileJob) Name() string {
+	return "novel-counter-reconcile"
+}
+
+// Run sweeps every novel and logs the drift it corrected, per counter.
+func (j *CounterReconcileJob) Run(ctx context.Context) error {
+	report, err := j.counterService.ReconcileAll(ctx)
+	if report != nil && (report.NovelsCorrected > 0 || report.VolumesCorrected > 0) {
+		log.Printf("novel-counter-reconcile: %d novel(s) checked, %d novel(s) and %d volume(s) corrected",
+			report.NovelsChecked, report.NovelsCorrected, report.VolumesCorrected)
+
+		fields := make([]string, 0, len(report.Fields))
+		for field := range report.Fields {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+		for _, field := range fields {
+			log.Printf("novel-counter-reconcile: %s drifted on %d row(s)", field, report.Fields[field])
+		}
+	}
+	return err
+}
@@ -0,0 +1,32 @@
+package jobs
+
+import (
+	"context"
+	"log"
+
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// MediaProcessingJob derives thumbnails and resized renditions of uploaded images.
+type MediaProcessingJob struct {
+	mediaService interfaces.MediaServiceInterface
+}
+
+// NewMediaProcessingJob creates a media processing job.
+func NewMediaProcessingJob(mediaService interfaces.MediaServiceInterface) *MediaProcessingJob {
+	return &MediaProcessingJob{mediaService: mediaService}
+}
+
+// Name identifies the job in logs.
+func (j *MediaProcessingJob) Name() string {
+	return "media-processing"
+}
+
+// Run processes one batch of the queue; failures are retried by later runs.
+func (j *MediaProcessingJob) Run(ctx context.Context) error {
+	processed, err := j.mediaService.ProcessPending(ctx)
+	if processed > 0 {
+		log.Printf("media-processing: %d image(s) processed", processed)
+	}
+	return err
+}
@@ -0,0 +1,28 @@
+package jobs
+
+import (
+	"context"
+
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// RankingJob recomputes the novel ranking snapshots served by the rankings endpoints.
+type RankingJob struct {
+	rankingService interfaces.RankingServiceInterface
+}
+
+// NewRankingJob creates a ranking snapshot job.
+func NewRankingJob(rankingService interfaces.RankingServiceInterface) *RankingJob {
+	return &RankingJob{rankingService: rankingService}
+}
+
+// Name identifies the job in logs.
+func (j *RankingJob) Name() string {
+	return "novel-rankings"
+}
+
+// Run rebuilds every ranking snapshot.
+func (j *RankingJob) Run(ctx context.Context) error {
+	_, err := j.rankingService.RebuildRankings(ctx)
+	return err
+}
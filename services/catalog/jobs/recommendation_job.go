@@ -0,0 +1,28 @@
+package jobs
+
+import (
+	"context"
+
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// RecommendationJob recomputes the similar-novel neighbours table.
+type RecommendationJob struct {
+	recommendationService interfaces.RecommendationServiceInterface
+}
+
+// NewRecommendationJob creates a similar-novel neighbours job.
+func NewRecommendationJob(recommendationService interfaces.RecommendationServiceInterface) *RecommendationJob {
+	return &RecommendationJob{recommendationService: recommendationService}
+}
+
+// Name identifies the job in logs.
+func (j *RecommendationJob) Name() string {
+	return "novel-recommendations"
+}
+
+// Run rebuilds the neighbours of every eligible novel.
+func (j *RecommendationJob) Run(ctx context.Context) error {
+	_, err := j.recommendationService.RebuildNeighbours(ctx)
+	return err
+}
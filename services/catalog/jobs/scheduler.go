@@ -0,0 +1,109 @@
+// Package jobs runs periodic background work for the Catalog service, such as
+// recomputing ranking snapshots. Jobs run in-process on fixed intervals.
+package jobs
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+// Job is a unit of periodic background work.
+type Job interface {
+	// Name identifies the job in logs.
+	Name() string
+	// Run performs one execution of the job.
+	Run(ctx context.Context) error
+}
+
+type scheduledJob struct {
+	job Job
+	// runAtStart executes the job immediately when the scheduler starts.
+	runAtStart bool
+	// next returns the next execution time after now.
+	next func(now time.Time) time.Time
+}
+
+// Scheduler runs registered jobs on their intervals until its context is cancelled.
+type Scheduler struct {
+	jobs []scheduledJob
+	wg   sync.WaitGroup
+}
+
+// NewScheduler creates an empty scheduler.
+func NewScheduler() *Scheduler {
+	return &Scheduler{}
+}
+
+// Register adds a job that runs once at start and then every interval.
+func (s *Scheduler) Register(job Job, interval time.Duration) {
+	if interval <= 0 {
+		log.Printf("Job %s not scheduled: interval must be positive", job.Name())
+		return
+	}
+	s.jobs = append(s.jobs, scheduledJob{
+		job:        job,
+		runAtStart: true,
+		next:       func(now time.Time) time.Time { return now.Add(interval) },
+	})
+}
+
+// RegisterDaily adds a job that runs once a day at the given local hour (0-23).
+func (s *Scheduler) RegisterDaily(job Job, hour int) {
+	if hour < 0 || hour > 23 {
+		log.Printf("Job %s not scheduled: hour must be between 0 and 23", job.Name())
+		return
+	}
+	s.jobs = append(s.jobs, scheduledJob{
+		job: job,
+		next: func(now time.Time) time.Time {
+			next := time.Date(now.Year(), now.Month(), now.Day(), hour, 0, 0, 0, now.Location())
+			if !next.After(now) {
+				next = next.AddDate(0, 0, 1)
+			}
+			return next
+		},
+	})
+}
+
+// Start launches one goroutine per registered job.
+func (s *Scheduler) Start(ctx context.Context) {
+	for _, sj := range s.jobs {
+		s.wg.Add(1)
+		go s.loop(ctx, sj)
+	}
+}
+
+// Wait blocks until every job goroutine has exited after cancellation.
+func (s *Scheduler) Wait() {
+	s.wg.Wait()
+}
+
+func (s *Scheduler) loop(ctx context.Context, sj scheduledJob) {
+	defer s.wg.Done()
+
+	if sj.runAtStart {
+		s.runOnce(ctx, sj.job)
+	}
+
+	for {
+		timer := time.NewTimer(time.Until(sj.next(time.Now())))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+			s.runOnce(ctx, sj.job)
+		}
+	}
+}
+
+func (s *Scheduler) runOnce(ctx context.Context, job Job) {
+	start := time.Now()
+	if err := job.Run(ctx); err != nil {
+		log.Printf("Job %s failed after %s: %v", job.Name(), time.Since(start), err)
+		return
+	}
+	log.Printf("Job %s completed in %s", job.Name(), time.Since(start))
+}
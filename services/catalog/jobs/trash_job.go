@@ -0,0 +1,33 @@
+package jobs
+
+import (
+	"context"
+	"log"
+
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// TrashPurgeJob purges trashed novels, volumes and chapters past the retention period.
+type TrashPurgeJob struct {
+	trashService interfaces.TrashServiceInterface
+}
+
+// NewTrashPurgeJob creates a trash purge job.
+func NewTrashPurgeJob(trashService interfaces.TrashServiceInterface) *TrashPurgeJob {
+	return &TrashPurgeJob{trashService: trashService}
+}
+
+// Name identifies the job in logs.
+func (j *TrashPurgeJob) Name() string {
+	return "novel-trash-purge"
+}
+
+// Run hard-deletes expired items, archiving the ones buyers still own.
+func (j *TrashPurgeJob) Run(ctx context.Context) error {
+	report, err := j.trashService.PurgeExpired(ctx)
+	if report != nil && (report.Purged > 0 || report.Archived > 0 || report.Failed > 0) {
+		log.Printf("novel-trash-purge: %d item(s) purged, %d archived for buyers, %d failed",
+			report.Purged, report.Archived, report.Failed)
+	}
+	return err
+}
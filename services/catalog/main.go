@@ -23,6 +23,7 @@ import (
 	"wibusystem/pkg/database/factory"
 	"wibusystem/pkg/i18n"
 	"wibusystem/services/catalog/config"
+	"wibusystem/services/catalog/jobs"
 	"wibusystem/services/catalog/routes"
 )
 
@@ -32,6 +33,9 @@ func main() {
 	loadEnvFiles()
 
 	cfg := config.Load()
+	if err := cfg.Validate(); err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
 
 	serviceDir := getServiceDir()
 
@@ -84,6 +88,9 @@ func main() {
 
 	router := routes.SetupRouter(deps)
 
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	scheduler := startJobs(jobsCtx, cfg, deps)
+
 	server := &http.Server{
 		Addr:         fmt.Sprintf("%s:%d", cfg.Server.Host, cfg.Server.Port),
 		Handler:      router,
@@ -112,9 +119,31 @@ func main() {
 		log.Printf("HTTP server forced to shutdown: %v", err)
 	}
 
+	stopJobs()
+	scheduler.Wait()
+
 	log.Println("Catalog Service stopped")
 }
 
+// startJobs registers background jobs and starts them unless disabled by config.
+func startJobs(ctx context.Context, cfg *config.Config, deps *routes.Dependencies) *jobs.Scheduler {
+	scheduler := jobs.NewScheduler()
+	if !cfg.Jobs.Enabled {
+		log.Println("Background jobs disabled")
+		return scheduler
+	}
+
+	scheduler.Register(jobs.NewRankingJob(deps.Services.Ranking), cfg.Jobs.RankingInterval)
+	scheduler.RegisterDaily(jobs.NewRecommendationJob(deps.Services.Recommendation), cfg.Jobs.RecommendationHour)
+	scheduler.Register(jobs.NewAnalyticsRollupJob(deps.Services.Analytics), cfg.Jobs.AnalyticsInterval)
+	scheduler.Register(jobs.NewMediaProcessingJob(deps.Services.Media), cfg.Jobs.MediaInterval)
+	scheduler.RegisterDaily(jobs.NewCounterReconcileJob(deps.Services.Counter), cfg.Jobs.CounterHour)
+	scheduler.RegisterDaily(jobs.NewTrashPurgeJob(deps.Services.Trash), cfg.Jobs.TrashPurgeHour)
+	scheduler.Start(ctx)
+
+	return scheduler
+}
+
 func loadEnvFiles() {
 	_, thisFile, _, ok := runtime.Caller(0)
 	if !ok {
@@ -63,6 +63,16 @@ func (a *AuthMiddleware) SetupAdminAPIMiddleware() []gin.HandlerFunc {
 	}
 }
 
+// SetupScopedAPIMiddleware returns middleware for routes restricted to holders of any of the
+// given scopes. Admins are always allowed.
+func (a *AuthMiddleware) SetupScopedAPIMiddleware(scopes ...string) []gin.HandlerFunc {
+	allowed := append([]string{"admin"}, scopes...)
+	return []gin.HandlerFunc{
+		a.RequireAuth(),
+		a.RequireAnyScope(allowed...),
+	}
+}
+
 // SetupOptionalAuthMiddleware returns middleware for routes with optional authentication
 func (a *AuthMiddleware) SetupOptionalAuthMiddleware() []gin.HandlerFunc {
 	return []gin.HandlerFunc{
@@ -102,3 +102,29 @@ func (m *Manager) SetupAdminAPIMiddleware() []gin.HandlerFunc {
 
 	return middleware
 }
+
+// SetupOptionalAuthMiddleware returns middleware for public routes that adapt to the caller when a token is present.
+func (m *Manager) SetupOptionalAuthMiddleware() []gin.HandlerFunc {
+	middleware := []gin.HandlerFunc{
+		ValidateContentType(),
+	}
+
+	if m.Auth != nil {
+		middleware = append(middleware, m.Auth.SetupOptionalAuthMiddleware()...)
+	}
+
+	return middleware
+}
+
+// SetupScopedAPIMiddleware returns middleware for API routes restricted to the given scopes.
+func (m *Manager) SetupScopedAPIMiddleware(scopes ...string) []gin.HandlerFunc {
+	middleware := []gin.HandlerFunc{
+		ValidateContentType(),
+	}
+
+	if m.Auth != nil {
+		middleware = append(middleware, m.Auth.SetupScopedAPIMiddleware(scopes...)...)
+	}
+
+	return middleware
+}
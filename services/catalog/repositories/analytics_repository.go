@@ -0,0 +1,377 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+)
+
+// novelTransactionMapping resolves the novel of purchase/rental items of any novel level.
+// The caller selects from a table aliased "t" with item_type and item_id columns.
+const novelTransactionMapping = `
+	LEFT JOIN novel tn ON t.item_type = 'NOVEL_SERIES' AND tn.id = t.item_id
+	LEFT JOIN novel_volume tv ON t.item_type = 'NOVEL_VOLUME' AND tv.id = t.item_id
+	LEFT JOIN novel_chapter tc ON t.item_type = 'NOVEL_CHAPTER' AND tc.id = t.item_id
+	LEFT JOIN novel_volume tcv ON tcv.id = tc.volume_id`
+
+// novelTransactionNovelID picks the resolved novel ID for a row of novelTransactionMapping
+const novelTransactionNovelID = `
+	CASE t.item_type
+		WHEN 'NOVEL_SERIES' THEN t.item_id
+		WHEN 'NOVEL_VOLUME' THEN tv.novel_id
+		WHEN 'NOVEL_CHAPTER' THEN tcv.novel_id
+	END`
+
+// novelPurchasePrice is the coins paid for a purchase row of novelTransactionMapping, falling back
+// to the item's list price when the purchase was recorded without a price
+const novelPurchasePrice = `
+	COALESCE(t.price_coins, CASE t.item_type
+		WHEN 'NOVEL_SERIES' THEN tn.price_coins
+		WHEN 'NOVEL_VOLUME' THEN tv.price_coins
+		WHEN 'NOVEL_CHAPTER' THEN tc.price_coins
+	END, 0)`
+
+// novelRentalPrice is the coins paid for a rental row of novelTransactionMapping, falling back
+// to the item's rental price when the rental was recorded without a price
+const novelRentalPrice = `
+	COALESCE(t.price_coins, CASE t.item_type
+		WHEN 'NOVEL_SERIES' THEN tn.rental_price_coins
+		WHEN 'NOVEL_VOLUME' THEN tv.rental_price_coins
+	END, 0)`
+
+// AnalyticsRepository defines data access for the owner analytics aggregates
+type AnalyticsRepository interface {
+	// RecordChapterRead counts a chapter read; userID is nil for anonymous readers
+	RecordChapterRead(ctx context.Context, chapterID uuid.UUID, userID *uuid.UUID) error
+	// RollupAnalytics recomputes unique readers, purchases, rentals and revenue for a date range
+	RollupAnalytics(ctx context.Context, from, to time.Time) error
+	// GetRollupWatermark returns the last day covered by the rollup, or nil before the first rollup
+	GetRollupWatermark(ctx context.Context) (*time.Time, error)
+	// GetEarliestActivityDate returns the day of the oldest novel purchase, rental or reader activity, or nil when there is none
+	GetEarliestActivityDate(ctx context.Context) (*time.Time, error)
+	// PurgeReaderActivity removes raw reader activity older than retentionDays
+	PurgeReaderActivity(ctx context.Context, retentionDays int) (int64, error)
+	// CanViewAnalytics reports whether a user owns the novel or holds VIEW_ANALYTICS on it
+	CanViewAnalytics(ctx context.Context, novelID, userID uuid.UUID, tenantID *uuid.UUID) (bool, error)
+	// GetNovelSeries reads the analytics series of a novel from the aggregate tables
+	GetNovelSeries(ctx context.Context, novelID uuid.UUID, from, to time.Time, granularity m.AnalyticsGranularity) ([]d.AnalyticsPoint, error)
+	// GetChapterDropoff reads per-chapter reader counts of a novel in reading order
+	GetChapterDropoff(ctx context.Context, novelID uuid.UUID, from, to time.Time) ([]d.ChapterDropoffResponse, error)
+}
+
+// analyticsRepository implements AnalyticsRepository interface
+type analyticsRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewAnalyticsRepository creates a new analytics repository instance
+func NewAnalyticsRepository(pool *pgxpool.Pool) AnalyticsRepository {
+	return &analyticsRepository{pool: pool}
+}
+
+// RecordChapterRead bumps the chapter counter and today's chapter aggregate, and
+// remembers the reader so the rollup can count unique readers.
+func (r *analyticsRepository) RecordChapterRead(ctx context.Context, chapterID uuid.UUID, userID *uuid.UUID) error {
+	query := `
+		WITH ch AS (
+			SELECT c.id, v.novel_id
+			FROM novel_chapter c
+			JOIN novel_volume v ON v.id = c.volume_id
+			WHERE c.id = $1
+		), chapter_views AS (
+			INSERT INTO novel_chapter_daily_stats (chapter_id, stat_date, novel_id, view_count, updated_at)
+			SELECT id, CURRENT_DATE, novel_id, 1, NOW() FROM ch
+			ON CONFLICT (chapter_id, stat_date) DO UPDATE SET
+				view_count = novel_chapter_daily_stats.view_count + 1,
+				updated_at = NOW()
+		), reader AS (
+			INSERT INTO novel_reader_activity (chapter_id, user_id, stat_date, novel_id)
+			SELECT id, $2::uuid, CURRENT_DATE, novel_id FROM ch
+			WHERE $2::uuid IS NOT NULL
+			ON CONFLICT (chapter_id, user_id, stat_date) DO NOTHING
+		)
+		UPDATE novel_chapter SET view_count = view_count + 1
+		WHERE id IN (SELECT id FROM ch)`
+
+	if _, err := r.pool.Exec(ctx, query, chapterID, userID); err != nil {
+		return fmt.Errorf("failed to record chapter read: %w", err)
+	}
+
+	return nil
+}
+
+// RollupAnalytics overwrites the derived columns of the aggregates for [from, to] and moves
+// the watermark up to to. It is idempotent, so the job can safely re-run overlapping ranges.
+func (r *analyticsRepository) RollupAnalytics(ctx context.Context, from, to time.Time) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Unique readers per chapter per day
+	_, err = tx.Exec(ctx, `
+		UPDATE novel_chapter_daily_stats cs
+		SET unique_readers = a.readers, updated_at = NOW()
+		FROM (
+			SELECT chapter_id, stat_date, COUNT(*) AS readers
+			FROM novel_reader_activity
+			WHERE stat_date BETWEEN $1::date AND $2::date
+			GROUP BY chapter_id, stat_date
+		) a
+		WHERE cs.chapter_id = a.chapter_id AND cs.stat_date = a.stat_date`, from, to)
+	if err != nil {
+		return fmt.Errorf("failed to roll up chapter readers: %w", err)
+	}
+
+	// Unique readers, purchases, rentals and revenue per novel per day
+	_, err = tx.Exec(ctx, `
+		WITH readers AS (
+			SELECT novel_id, stat_date, COUNT(DISTINCT user_id) AS unique_readers
+			FROM novel_reader_activity
+			WHERE stat_date BETWEEN $1::date AND $2::date
+			GROUP BY novel_id, stat_date
+		), purchases AS (
+			SELECT `+novelTransactionNovelID+` AS novel_id, t.purchase_date::date AS stat_date,
+				COUNT(*) AS purchase_count, SUM(`+novelPurchasePrice+`) AS revenue_coins
+			FROM user_content_purchases t `+novelTransactionMapping+`
+			WHERE t.item_type IN ('NOVEL_SERIES', 'NOVEL_VOLUME', 'NOVEL_CHAPTER')
+			  AND t.purchase_date >= $1::date AND t.purchase_date < $2::date + 1
+			GROUP BY 1, 2
+		), rentals AS (
+			SELECT `+novelTransactionNovelID+` AS novel_id, t.rent_date::date AS stat_date,
+				COUNT(*) AS rental_count, SUM(`+novelRentalPrice+`) AS revenue_coins
+			FROM user_content_rentals t `+novelTransactionMapping+`
+			WHERE t.item_type IN ('NOVEL_SERIES', 'NOVEL_VOLUME')
+			  AND t.rent_date >= $1::date AND t.rent_date < $2::date + 1
+			GROUP BY 1, 2
+		), keys AS (
+			SELECT novel_id, stat_date FROM readers
+			UNION SELECT novel_id, stat_date FROM purchases
+			UNION SELECT novel_id, stat_date FROM rentals
+		)
+		INSERT INTO novel_daily_stats (
+			novel_id, stat_date, unique_readers, purchase_count, rental_count, revenue_coins, updated_at
+		)
+		SELECT k.novel_id, k.stat_date,
+			COALESCE(rd.unique_readers, 0),
+			COALESCE(p.purchase_count, 0),
+			COALESCE(rt.rental_count, 0),
+			COALESCE(p.revenue_coins, 0) + COALESCE(rt.revenue_coins, 0),
+			NOW()
+		FROM keys k
+		JOIN novel n ON n.id = k.novel_id
+		LEFT JOIN readers rd ON rd.novel_id = k.novel_id AND rd.stat_date = k.stat_date
+		LEFT JOIN purchases p ON p.novel_id = k.novel_id AND p.stat_date = k.stat_date
+		LEFT JOIN rentals rt ON rt.novel_id = k.novel_id AND rt.stat_date = k.stat_date
+		ON CONFLICT (novel_id, stat_date) DO UPDATE SET
+			unique_readers = EXCLUDED.unique_readers,
+			purchase_count = EXCLUDED.purchase_count,
+			rental_count = EXCLUDED.rental_count,
+			revenue_coins = EXCLUDED.revenue_coins,
+			updated_at = NOW()`, from, to)
+	if err != nil {
+		return fmt.Errorf("failed to roll up novel daily stats: %w", err)
+	}
+
+	// Unique readers per novel per ISO week touched by the range
+	_, err = tx.Exec(ctx, `
+		INSERT INTO novel_weekly_stats (novel_id, week_start, unique_readers, updated_at)
+		SELECT novel_id, date_trunc('week', stat_date)::date, COUNT(DISTINCT user_id), NOW()
+		FROM novel_reader_activity
+		WHERE stat_date >= date_trunc('week', $1::date)::date
+		  AND stat_date < date_trunc('week', $2::date)::date + 7
+		GROUP BY novel_id, date_trunc('week', stat_date)::date
+		ON CONFLICT (novel_id, week_start) DO UPDATE SET
+			unique_readers = EXCLUDED.unique_readers,
+			updated_at = NOW()`, from, to)
+	if err != nil {
+		return fmt.Errorf("failed to roll up novel weekly stats: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO novel_analytics_rollup_state (id, rolled_up_through, updated_at)
+		VALUES (TRUE, $1::date, NOW())
+		ON CONFLICT (id) DO UPDATE SET
+			rolled_up_through = GREATEST(novel_analytics_rollup_state.rolled_up_through, EXCLUDED.rolled_up_through),
+			updated_at = NOW()`, to)
+	if err != nil {
+		return fmt.Errorf("failed to update analytics rollup watermark: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetRollupWatermark reads the last day covered by the rollup
+func (r *analyticsRepository) GetRollupWatermark(ctx context.Context) (*time.Time, error) {
+	var watermark time.Time
+	err := r.pool.QueryRow(ctx, `SELECT rolled_up_through FROM novel_analytics_rollup_state WHERE id`).Scan(&watermark)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get analytics rollup watermark: %w", err)
+	}
+
+	return &watermark, nil
+}
+
+// GetEarliestActivityDate finds the oldest day with a novel purchase, rental or reader activity
+func (r *analyticsRepository) GetEarliestActivityDate(ctx context.Context) (*time.Time, error) {
+	query := `
+		SELECT LEAST(
+			(SELECT MIN(purchase_date)::date FROM user_content_purchases
+			 WHERE item_type IN ('NOVEL_SERIES', 'NOVEL_VOLUME', 'NOVEL_CHAPTER')),
+			(SELECT MIN(rent_date)::date FROM user_content_rentals
+			 WHERE item_type IN ('NOVEL_SERIES', 'NOVEL_VOLUME')),
+			(SELECT MIN(stat_date) FROM novel_reader_activity)
+		)`
+
+	var earliest *time.Time
+	if err := r.pool.QueryRow(ctx, query).Scan(&earliest); err != nil {
+		return nil, fmt.Errorf("failed to get earliest analytics activity: %w", err)
+	}
+
+	return earliest, nil
+}
+
+// PurgeReaderActivity deletes reader activity rows that have already been rolled up
+func (r *analyticsRepository) PurgeReaderActivity(ctx context.Context, retentionDays int) (int64, error) {
+	tag, err := r.pool.Exec(ctx, `DELETE FROM novel_reader_activity WHERE stat_date < CURRENT_DATE - $1::int`, retentionDays)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge reader activity: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+// CanViewAnalytics checks ownership (user for PERSONAL/COLLABORATIVE, tenant for TENANT)
+// and falls back to the collaborator VIEW_ANALYTICS permission.
+func (r *analyticsRepository) CanViewAnalytics(ctx context.Context, novelID, userID uuid.UUID, tenantID *uuid.UUID) (bool, error) {
+	query := `
+		SELECT
+			(n.ownership_type = 'TENANT' AND $3::uuid IS NOT NULL AND n.primary_owner_id = $3::uuid)
+			OR (n.ownership_type <> 'TENANT' AND n.primary_owner_id = $2)
+			OR has_collaborator_permission('NOVEL', n.id, $2, 'VIEW_ANALYTICS')
+		FROM novel n
+		WHERE n.id = $1 AND n.is_deleted = false`
+
+	var allowed bool
+	err := r.pool.QueryRow(ctx, query, novelID, userID, tenantID).Scan(&allowed)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return false, fmt.Errorf("novel not found")
+		}
+		return false, fmt.Errorf("failed to check analytics permission: %w", err)
+	}
+
+	return allowed, nil
+}
+
+// GetNovelSeries returns one point per day or week of the range, filling gaps with zeros
+func (r *analyticsRepository) GetNovelSeries(ctx context.Context, novelID uuid.UUID, from, to time.Time, granularity m.AnalyticsGranularity) ([]d.AnalyticsPoint, error) {
+	var query string
+	switch granularity {
+	case m.AnalyticsGranularityWeek:
+		// Additive metrics are summed from daily rows; unique readers come from the weekly table
+		query = `
+			SELECT w::date AS period,
+				COALESCE(SUM(ds.view_count), 0),
+				COALESCE(MAX(ws.unique_readers), 0),
+				COALESCE(SUM(ds.purchase_count), 0),
+				COALESCE(SUM(ds.rental_count), 0),
+				COALESCE(SUM(ds.revenue_coins), 0),
+				COALESCE(SUM(ds.bookmark_count), 0)
+			FROM generate_series(date_trunc('week', $2::date), date_trunc('week', $3::date), interval '1 week') w
+			LEFT JOIN novel_daily_stats ds ON ds.novel_id = $1
+				AND ds.stat_date >= w::date AND ds.stat_date < w::date + 7
+				AND ds.stat_date BETWEEN $2::date AND $3::date
+			LEFT JOIN novel_weekly_stats ws ON ws.novel_id = $1 AND ws.week_start = w::date
+			GROUP BY w
+			ORDER BY w`
+	default:
+		query = `
+			SELECT g::date AS period,
+				COALESCE(ds.view_count, 0),
+				COALESCE(ds.unique_readers, 0),
+				COALESCE(ds.purchase_count, 0),
+				COALESCE(ds.rental_count, 0),
+				COALESCE(ds.revenue_coins, 0),
+				COALESCE(ds.bookmark_count, 0)
+			FROM generate_series($2::date, $3::date, interval '1 day') g
+			LEFT JOIN novel_daily_stats ds ON ds.novel_id = $1 AND ds.stat_date = g::date
+			ORDER BY g`
+	}
+
+	rows, err := r.pool.Query(ctx, query, novelID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query novel analytics series: %w", err)
+	}
+	defer rows.Close()
+
+	series := make([]d.AnalyticsPoint, 0)
+	for rows.Next() {
+		var p d.AnalyticsPoint
+		if err := rows.Scan(&p.Period, &p.Views, &p.UniqueReaders, &p.Purchases, &p.Rentals, &p.RevenueCoins, &p.Bookmarks); err != nil {
+			return nil, fmt.Errorf("failed to scan analytics point: %w", err)
+		}
+		series = append(series, p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating analytics series: %w", err)
+	}
+
+	return series, nil
+}
+
+// GetChapterDropoff sums chapter aggregates over the range and orders chapters by
+// volume then chapter number; retention ratios are computed by the service.
+func (r *analyticsRepository) GetChapterDropoff(ctx context.Context, novelID uuid.UUID, from, to time.Time) ([]d.ChapterDropoffResponse, error) {
+	query := `
+		SELECT c.id, v.volume_number, c.chapter_number, c.title,
+			COALESCE(SUM(cs.view_count), 0),
+			COALESCE(SUM(cs.unique_readers), 0)
+		FROM novel_chapter c
+		JOIN novel_volume v ON v.id = c.volume_id
+		LEFT JOIN novel_chapter_daily_stats cs ON cs.chapter_id = c.id
+			AND cs.stat_date BETWEEN $2::date AND $3::date
+		WHERE v.novel_id = $1
+		  AND c.is_deleted = false
+		  AND COALESCE(v.is_deleted, false) = false
+		GROUP BY c.id, v.volume_number, c.chapter_number, c.title
+		ORDER BY v.volume_number, c.chapter_number`
+
+	rows, err := r.pool.Query(ctx, query, novelID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query chapter drop-off: %w", err)
+	}
+	defer rows.Close()
+
+	chapters := make([]d.ChapterDropoffResponse, 0)
+	for rows.Next() {
+		var ch d.ChapterDropoffResponse
+		var chapterID uuid.UUID
+		if err := rows.Scan(&chapterID, &ch.VolumeNumber, &ch.ChapterNumber, &ch.Title, &ch.Views, &ch.Readers); err != nil {
+			return nil, fmt.Errorf("failed to scan chapter drop-off: %w", err)
+		}
+		ch.ChapterID = chapterID.String()
+		chapters = append(chapters, ch)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating chapter drop-off: %w", err)
+	}
+
+	return chapters, nil
+}
@@ -0,0 +1,638 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+)
+
+// AnimeEpisodeRepository defines data access for anime seasons, episodes and subtitles
+type AnimeEpisodeRepository interface {
+	// Seasons
+	CreateSeason(ctx context.Context, animeID uuid.UUID, req d.CreateAnimeSeasonRequest) (*m.AnimeSeason, error)
+	GetSeasonByID(ctx context.Context, id uuid.UUID) (*m.AnimeSeason, error)
+	ListSeasons(ctx context.Context, animeID uuid.UUID) ([]m.AnimeSeason, error)
+	UpdateSeason(ctx context.Context, id uuid.UUID, req d.UpdateAnimeSeasonRequest) (*m.AnimeSeason, error)
+	DeleteSeason(ctx context.Context, id uuid.UUID) error
+
+	// Episodes
+	CreateEpisode(ctx context.Context, seasonID uuid.UUID, req d.CreateAnimeEpisodeRequest, userID uuid.UUID) (*m.AnimeEpisode, error)
+	GetEpisodeByID(ctx context.Context, id uuid.UUID) (*m.AnimeEpisode, error)
+	// ListEpisodes lists episodes of a season; drafts are only included when includeDrafts is true
+	ListEpisodes(ctx context.Context, seasonID uuid.UUID, includeDrafts bool, req d.ListAnimeEpisodesRequest) ([]m.AnimeEpisode, int64, error)
+	UpdateEpisode(ctx context.Context, id uuid.UUID, req d.UpdateAnimeEpisodeRequest, userID uuid.UUID) (*m.AnimeEpisode, error)
+	DeleteEpisode(ctx context.Context, id uuid.UUID) error
+	PublishEpisode(ctx context.Context, id uuid.UUID, publishAt time.Time, userID uuid.UUID) (*m.AnimeEpisode, error)
+	UnpublishEpisode(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*m.AnimeEpisode, error)
+
+	// Subtitles
+	ListSubtitles(ctx context.Context, episodeID uuid.UUID) ([]m.EpisodeSubtitle, error)
+	GetSubtitle(ctx context.Context, episodeID uuid.UUID, languageCode string) (*m.EpisodeSubtitle, error)
+	SetSubtitle(ctx context.Context, subtitle *m.EpisodeSubtitle) (*m.EpisodeSubtitle, error)
+	DeleteSubtitle(ctx context.Context, episodeID uuid.UUID, languageCode string) error
+
+	// Ownership and entitlement helpers
+	GetSeasonAnimeID(ctx context.Context, seasonID uuid.UUID) (uuid.UUID, error)
+	GetEpisodeAnimeID(ctx context.Context, episodeID uuid.UUID) (uuid.UUID, error)
+	// IsAnimePubliclyVisible reports whether the anime is public, PUBLIC access and not deleted
+	IsAnimePubliclyVisible(ctx context.Context, animeID uuid.UUID) (bool, error)
+	// CanWatchEpisode reports whether the user purchased the episode or its season,
+	// or holds an unexpired rental of the season or series
+	CanWatchEpisode(ctx context.Context, episodeID, userID uuid.UUID) (bool, error)
+}
+
+// animeEpisodeRepository implements AnimeEpisodeRepository interface
+type animeEpisodeRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewAnimeEpisodeRepository creates a new anime episode repository instance
+func NewAnimeEpisodeRepository(pool *pgxpool.Pool) AnimeEpisodeRepository {
+	return &animeEpisodeRepository{pool: pool}
+}
+
+const animeSeasonColumns = `
+	id, anime_id, season_number, season_title, price_coins, rental_price_coins, rental_duration_days,
+	created_at, updated_at`
+
+const animeEpisodeColumns = `
+	id, season_id, episode_number, title, duration_seconds, video_url, COALESCE(is_public, FALSE), price_coins,
+	COALESCE(is_draft, TRUE), published_at, last_modified_by_user_id, created_at, updated_at`
+
+func scanAnimeSeason(row pgx.Row) (*m.AnimeSeason, error) {
+	var season m.AnimeSeason
+	err := row.Scan(&season.ID, &season.AnimeID, &season.SeasonNumber, &season.SeasonTitle, &season.PriceCoins,
+		&season.RentalPriceCoins, &season.RentalDurationDays, &season.CreatedAt, &season.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &season, nil
+}
+
+func scanAnimeEpisode(row pgx.Row) (*m.AnimeEpisode, error) {
+	var episode m.AnimeEpisode
+	err := row.Scan(&episode.ID, &episode.SeasonID, &episode.EpisodeNumber, &episode.Title, &episode.DurationSeconds,
+		&episode.VideoURL, &episode.IsPublic, &episode.PriceCoins, &episode.IsDraft, &episode.PublishedAt,
+		&episode.LastModifiedByUserID, &episode.CreatedAt, &episode.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &episode, nil
+}
+
+// CreateSeason creates a season; season numbers are unique per anime
+func (r *animeEpisodeRepository) CreateSeason(ctx context.Context, animeID uuid.UUID, req d.CreateAnimeSeasonRequest) (*m.AnimeSeason, error) {
+	query := `
+		INSERT INTO anime_season (anime_id, season_number, season_title, price_coins, rental_price_coins, rental_duration_days, created_at, updated_at)
+		SELECT a.id, $2, $3, $4, $5, $6, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP
+		FROM anime a
+		WHERE a.id = $1 AND a.is_deleted = FALSE
+		RETURNING` + animeSeasonColumns
+
+	season, err := scanAnimeSeason(r.pool.QueryRow(ctx, query, animeID, req.SeasonNumber, req.SeasonTitle,
+		req.PriceCoins, req.RentalPriceCoins, req.RentalDurationDays))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("anime not found")
+		}
+		if strings.Contains(err.Error(), "duplicate key") {
+			return nil, fmt.Errorf("season %d already exists", req.SeasonNumber)
+		}
+		return nil, fmt.Errorf("failed to create season: %w", err)
+	}
+
+	return season, nil
+}
+
+// GetSeasonByID retrieves a season of a non-deleted anime
+func (r *animeEpisodeRepository) GetSeasonByID(ctx context.Context, id uuid.UUID) (*m.AnimeSeason, error) {
+	query := `
+		SELECT` + animeSeasonColumns + `
+		FROM anime_season
+		WHERE id = $1
+		  AND anime_id IN (SELECT a.id FROM anime a WHERE a.is_deleted = FALSE)`
+
+	season, err := scanAnimeSeason(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("season not found")
+		}
+		return nil, fmt.Errorf("failed to get season: %w", err)
+	}
+	return season, nil
+}
+
+// ListSeasons lists the seasons of an anime ordered by season number
+func (r *animeEpisodeRepository) ListSeasons(ctx context.Context, animeID uuid.UUID) ([]m.AnimeSeason, error) {
+	rows, err := r.pool.Query(ctx, `SELECT`+animeSeasonColumns+` FROM anime_season WHERE anime_id = $1 ORDER BY season_number`, animeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list seasons: %w", err)
+	}
+	defer rows.Close()
+
+	seasons := make([]m.AnimeSeason, 0)
+	for rows.Next() {
+		season, err := scanAnimeSeason(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan season: %w", err)
+		}
+		seasons = append(seasons, *season)
+	}
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate seasons: %w", rows.Err())
+	}
+
+	return seasons, nil
+}
+
+// UpdateSeason applies the provided fields
+func (r *animeEpisodeRepository) UpdateSeason(ctx context.Context, id uuid.UUID, req d.UpdateAnimeSeasonRequest) (*m.AnimeSeason, error) {
+	updateFields := []string{"updated_at = CURRENT_TIMESTAMP"}
+	args := []interface{}{}
+	argIndex := 1
+
+	if req.SeasonNumber != nil {
+		updateFields = append(updateFields, fmt.Sprintf("season_number = $%d", argIndex))
+		args = append(args, *req.SeasonNumber)
+		argIndex++
+	}
+
+	if req.SeasonTitle != nil {
+		updateFields = append(updateFields, fmt.Sprintf("season_title = $%d", argIndex))
+		args = append(args, *req.SeasonTitle)
+		argIndex++
+	}
+
+	if req.PriceCoins != nil {
+		updateFields = append(updateFields, fmt.Sprintf("price_coins = $%d", argIndex))
+		args = append(args, *req.PriceCoins)
+		argIndex++
+	}
+
+	if req.RentalPriceCoins != nil {
+		updateFields = append(updateFields, fmt.Sprintf("rental_price_coins = $%d", argIndex))
+		args = append(args, *req.RentalPriceCoins)
+		argIndex++
+	}
+
+	if req.RentalDurationDays != nil {
+		updateFields = append(updateFields, fmt.Sprintf("rental_duration_days = $%d", argIndex))
+		args = append(args, *req.RentalDurationDays)
+		argIndex++
+	}
+
+	query := fmt.Sprintf(`
+		UPDATE anime_season
+		SET %s
+		WHERE id = $%d
+		RETURNING%s`, strings.Join(updateFields, ", "), argIndex, animeSeasonColumns)
+	args = append(args, id)
+
+	season, err := scanAnimeSeason(r.pool.QueryRow(ctx, query, args...))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("season not found")
+		}
+		if strings.Contains(err.Error(), "duplicate key") {
+			return nil, fmt.Errorf("season %d already exists", *req.SeasonNumber)
+		}
+		return nil, fmt.Errorf("failed to update season: %w", err)
+	}
+
+	return season, nil
+}
+
+// DeleteSeason deletes a season and its episodes unless users bought or rented any of it
+func (r *animeEpisodeRepository) DeleteSeason(ctx context.Context, id uuid.UUID) error {
+	query := `
+		SELECT EXISTS(
+			SELECT 1 FROM user_content_purchases ucp
+			WHERE (ucp.item_type = 'ANIME_SEASON' AND ucp.item_id = $1)
+				OR (ucp.item_type = 'ANIME_EPISODE' AND ucp.item_id IN (
+					SELECT id FROM anime_episode WHERE season_id = $1
+				))
+			UNION
+			SELECT 1 FROM user_content_rentals ucr
+			WHERE ucr.item_type = 'ANIME_SEASON' AND ucr.item_id = $1
+		)`
+
+	var hasPurchases bool
+	if err := r.pool.QueryRow(ctx, query, id).Scan(&hasPurchases); err != nil {
+		return fmt.Errorf("failed to check season purchases: %w", err)
+	}
+	if hasPurchases {
+		return fmt.Errorf("cannot delete season: users have purchased content from this season")
+	}
+
+	tag, err := r.pool.Exec(ctx, `DELETE FROM anime_season WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete season: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("season not found")
+	}
+
+	return nil
+}
+
+// CreateEpisode creates a draft episode; episode numbers are unique per season
+func (r *animeEpisodeRepository) CreateEpisode(ctx context.Context, seasonID uuid.UUID, req d.CreateAnimeEpisodeRequest, userID uuid.UUID) (*m.AnimeEpisode, error) {
+	query := `
+		INSERT INTO anime_episode (
+			season_id, episode_number, title, duration_seconds, video_url, is_public, price_coins,
+			is_draft, last_modified_by_user_id, created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, TRUE, $8, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+		RETURNING` + animeEpisodeColumns
+
+	episode, err := scanAnimeEpisode(r.pool.QueryRow(ctx, query, seasonID, req.EpisodeNumber, req.Title,
+		req.DurationSeconds, req.VideoURL, req.IsPublic, req.PriceCoins, userID))
+	if err != nil {
+		if strings.Contains(err.Error(), "duplicate key") {
+			return nil, fmt.Errorf("episode %d already exists in this season", req.EpisodeNumber)
+		}
+		if strings.Contains(err.Error(), "foreign key") {
+			return nil, fmt.Errorf("season not found")
+		}
+		return nil, fmt.Errorf("failed to create episode: %w", err)
+	}
+
+	return episode, nil
+}
+
+// GetEpisodeByID retrieves an episode of a non-deleted anime
+func (r *animeEpisodeRepository) GetEpisodeByID(ctx context.Context, id uuid.UUID) (*m.AnimeEpisode, error) {
+	query := `
+		SELECT` + animeEpisodeColumns + `
+		FROM anime_episode
+		WHERE id = $1
+		  AND season_id IN (
+			SELECT s.id FROM anime_season s JOIN anime a ON a.id = s.anime_id WHERE a.is_deleted = FALSE
+		  )`
+
+	episode, err := scanAnimeEpisode(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("episode not found")
+		}
+		return nil, fmt.Errorf("failed to get episode: %w", err)
+	}
+	return episode, nil
+}
+
+// ListEpisodes lists the episodes of a season ordered by episode number
+func (r *animeEpisodeRepository) ListEpisodes(ctx context.Context, seasonID uuid.UUID, includeDrafts bool, req d.ListAnimeEpisodesRequest) ([]m.AnimeEpisode, int64, error) {
+	whereClause := "season_id = $1"
+	if !includeDrafts {
+		// Scheduled episodes stay hidden until their publish time
+		whereClause += " AND is_draft = FALSE AND (published_at IS NULL OR published_at <= CURRENT_TIMESTAMP)"
+	}
+
+	var total int64
+	if err := r.pool.QueryRow(ctx, `SELECT COUNT(*) FROM anime_episode WHERE `+whereClause, seasonID).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count episodes: %w", err)
+	}
+
+	offset := (req.Page - 1) * req.PageSize
+	query := `SELECT` + animeEpisodeColumns + ` FROM anime_episode WHERE ` + whereClause + `
+		ORDER BY episode_number
+		LIMIT $2 OFFSET $3`
+
+	rows, err := r.pool.Query(ctx, query, seasonID, req.PageSize, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list episodes: %w", err)
+	}
+	defer rows.Close()
+
+	episodes := make([]m.AnimeEpisode, 0)
+	for rows.Next() {
+		episode, err := scanAnimeEpisode(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan episode: %w", err)
+		}
+		episodes = append(episodes, *episode)
+	}
+	if rows.Err() != nil {
+		return nil, 0, fmt.Errorf("failed to iterate episodes: %w", rows.Err())
+	}
+
+	return episodes, total, nil
+}
+
+// UpdateEpisode applies the provided fields
+func (r *animeEpisodeRepository) UpdateEpisode(ctx context.Context, id uuid.UUID, req d.UpdateAnimeEpisodeRequest, userID uuid.UUID) (*m.AnimeEpisode, error) {
+	updateFields := []string{"updated_at = CURRENT_TIMESTAMP", "last_modified_by_user_id = $1"}
+	args := []interface{}{userID}
+	argIndex := 2
+
+	if req.EpisodeNumber != nil {
+		updateFields = append(updateFields, fmt.Sprintf("episode_number = $%d", argIndex))
+		args = append(args, *req.EpisodeNumber)
+		argIndex++
+	}
+
+	if req.Title != nil {
+		updateFields = append(updateFields, fmt.Sprintf("title = $%d", argIndex))
+		args = append(args, *req.Title)
+		argIndex++
+	}
+
+	if req.DurationSeconds != nil {
+		updateFields = append(updateFields, fmt.Sprintf("duration_seconds = $%d", argIndex))
+		args = append(args, *req.DurationSeconds)
+		argIndex++
+	}
+
+	if req.VideoURL != nil {
+		updateFields = append(updateFields, fmt.Sprintf("video_url = $%d", argIndex))
+		args = append(args, *req.VideoURL)
+		argIndex++
+	}
+
+	if req.IsPublic != nil {
+		updateFields = append(updateFields, fmt.Sprintf("is_public = $%d", argIndex))
+		args = append(args, *req.IsPublic)
+		argIndex++
+	}
+
+	if req.PriceCoins != nil {
+		updateFields = append(updateFields, fmt.Sprintf("price_coins = $%d", argIndex))
+		args = append(args, *req.PriceCoins)
+		argIndex++
+	}
+
+	query := fmt.Sprintf(`
+		UPDATE anime_episode
+		SET %s
+		WHERE id = $%d
+		RETURNING%s`, strings.Join(updateFields, ", "), argIndex, animeEpisodeColumns)
+	args = append(args, id)
+
+	episode, err := scanAnimeEpisode(r.pool.QueryRow(ctx, query, args...))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("episode not found")
+		}
+		if strings.Contains(err.Error(), "duplicate key") {
+			return nil, fmt.Errorf("episode %d already exists in this season", *req.EpisodeNumber)
+		}
+		return nil, fmt.Errorf("failed to update episode: %w", err)
+	}
+
+	return episode, nil
+}
+
+// DeleteEpisode deletes an episode unless users bought it
+func (r *animeEpisodeRepository) DeleteEpisode(ctx context.Context, id uuid.UUID) error {
+	var hasPurchases bool
+	err := r.pool.QueryRow(ctx, `
+		SELECT EXISTS(
+			SELECT 1 FROM user_content_purchases
+			WHERE item_type = 'ANIME_EPISODE' AND item_id = $1
+		)`, id).Scan(&hasPurchases)
+	if err != nil {
+		return fmt.Errorf("failed to check episode purchases: %w", err)
+	}
+	if hasPurchases {
+		return fmt.Errorf("cannot delete episode: users have purchased this episode")
+	}
+
+	tag, err := r.pool.Exec(ctx, `DELETE FROM anime_episode WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete episode: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("episode not found")
+	}
+
+	return nil
+}
+
+// PublishEpisode makes an episode visible from publishAt on
+func (r *animeEpisodeRepository) PublishEpisode(ctx context.Context, id uuid.UUID, publishAt time.Time, userID uuid.UUID) (*m.AnimeEpisode, error) {
+	query := `
+		UPDATE anime_episode
+		SET is_draft = FALSE, published_at = $2, last_modified_by_user_id = $3, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1
+		RETURNING` + animeEpisodeColumns
+
+	episode, err := scanAnimeEpisode(r.pool.QueryRow(ctx, query, id, publishAt, userID))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("episode not found")
+		}
+		return nil, fmt.Errorf("failed to publish episode: %w", err)
+	}
+	return episode, nil
+}
+
+// UnpublishEpisode turns an episode back into a draft
+func (r *animeEpisodeRepository) UnpublishEpisode(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*m.AnimeEpisode, error) {
+	query := `
+		UPDATE anime_episode
+		SET is_draft = TRUE, published_at = NULL, last_modified_by_user_id = $2, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1
+		RETURNING` + animeEpisodeColumns
+
+	episode, err := scanAnimeEpisode(r.pool.QueryRow(ctx, query, id, userID))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("episode not found")
+		}
+		return nil, fmt.Errorf("failed to unpublish episode: %w", err)
+	}
+	return episode, nil
+}
+
+// ListSubtitles lists the subtitle tracks of an episode without their content
+func (r *animeEpisodeRepository) ListSubtitles(ctx context.Context, episodeID uuid.UUID) ([]m.EpisodeSubtitle, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT id, episode_id, language_code, subtitle_url, source_format, cue_count,
+			uploaded_by_user_id, created_at, updated_at
+		FROM episode_subtitle
+		WHERE episode_id = $1
+		ORDER BY language_code`, episodeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list subtitles: %w", err)
+	}
+	defer rows.Close()
+
+	subtitles := make([]m.EpisodeSubtitle, 0)
+	for rows.Next() {
+		var subtitle m.EpisodeSubtitle
+		if err := rows.Scan(&subtitle.ID, &subtitle.EpisodeID, &subtitle.LanguageCode, &subtitle.SubtitleURL,
+			&subtitle.SourceFormat, &subtitle.CueCount, &subtitle.UploadedByUserID,
+			&subtitle.CreatedAt, &subtitle.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan subtitle: %w", err)
+		}
+		subtitles = append(subtitles, subtitle)
+	}
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate subtitles: %w", rows.Err())
+	}
+
+	return subtitles, nil
+}
+
+// GetSubtitle retrieves the subtitle track of one language with its WebVTT content
+func (r *animeEpisodeRepository) GetSubtitle(ctx context.Context, episodeID uuid.UUID, languageCode string) (*m.EpisodeSubtitle, error) {
+	subtitle, err := scanEpisodeSubtitle(r.pool.QueryRow(ctx, `
+		SELECT `+episodeSubtitleColumns+`
+		FROM episode_subtitle
+		WHERE episode_id = $1 AND language_code = $2`, episodeID, languageCode))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("subtitle not found")
+		}
+		return nil, fmt.Errorf("failed to get subtitle: %w", err)
+	}
+	return subtitle, nil
+}
+
+// SetSubtitle creates or replaces the subtitle track of one language
+func (r *animeEpisodeRepository) SetSubtitle(ctx context.Context, subtitle *m.EpisodeSubtitle) (*m.EpisodeSubtitle, error) {
+	saved, err := upsertEpisodeSubtitle(ctx, r.pool, subtitle)
+	if err != nil {
+		if strings.Contains(err.Error(), "foreign key") {
+			return nil, fmt.Errorf("episode not found")
+		}
+		return nil, fmt.Errorf("failed to set subtitle: %w", err)
+	}
+	return saved, nil
+}
+
+// DeleteSubtitle removes the subtitle track of one language
+func (r *animeEpisodeRepository) DeleteSubtitle(ctx context.Context, episodeID uuid.UUID, languageCode string) error {
+	tag, err := r.pool.Exec(ctx, `DELETE FROM episode_subtitle WHERE episode_id = $1 AND language_code = $2`, episodeID, languageCode)
+	if err != nil {
+		return fmt.Errorf("failed to delete subtitle: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("subtitle not found")
+	}
+	return nil
+}
+
+// GetSeasonAnimeID returns the anime a season belongs to
+func (r *animeEpisodeRepository) GetSeasonAnimeID(ctx context.Context, seasonID uuid.UUID) (uuid.UUID, error) {
+	var animeID uuid.UUID
+	err := r.pool.QueryRow(ctx, `
+		SELECT s.anime_id
+		FROM anime_season s
+		JOIN anime a ON a.id = s.anime_id
+		WHERE s.id = $1 AND a.is_deleted = FALSE`, seasonID).Scan(&animeID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return uuid.Nil, fmt.Errorf("season not found")
+		}
+		return uuid.Nil, fmt.Errorf("failed to get season anime: %w", err)
+	}
+	return animeID, nil
+}
+
+// GetEpisodeAnimeID returns the anime an episode belongs to
+func (r *animeEpisodeRepository) GetEpisodeAnimeID(ctx context.Context, episodeID uuid.UUID) (uuid.UUID, error) {
+	var animeID uuid.UUID
+	err := r.pool.QueryRow(ctx, `
+		SELECT s.anime_id
+		FROM anime_episode e
+		JOIN anime_season s ON s.id = e.season_id
+		JOIN anime a ON a.id = s.anime_id
+		WHERE e.id = $1 AND a.is_deleted = FALSE`, episodeID).Scan(&animeID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return uuid.Nil, fmt.Errorf("episode not found")
+		}
+		return uuid.Nil, fmt.Errorf("failed to get episode anime: %w", err)
+	}
+	return animeID, nil
+}
+
+// IsAnimePubliclyVisible reports whether readers without ownership can see the anime
+func (r *animeEpisodeRepository) IsAnimePubliclyVisible(ctx context.Context, animeID uuid.UUID) (bool, error) {
+	var visible bool
+	err := r.pool.QueryRow(ctx, `
+		SELECT EXISTS(
+			SELECT 1 FROM anime
+			WHERE id = $1 AND is_deleted = FALSE AND is_public = TRUE AND access_level = 'PUBLIC'
+		)`, animeID).Scan(&visible)
+	if err != nil {
+		return false, fmt.Errorf("failed to check anime visibility: %w", err)
+	}
+	return visible, nil
+}
+
+// CanWatchEpisode checks episode/season purchases and unexpired season/series rentals
+func (r *animeEpisodeRepository) CanWatchEpisode(ctx context.Context, episodeID, userID uuid.UUID) (bool, error) {
+	query := `
+		WITH ep AS (
+			SELECT e.id AS episode_id, s.id AS season_id, s.anime_id
+			FROM anime_episode e
+			JOIN anime_season s ON s.id = e.season_id
+			WHERE e.id = $1
+		)
+		SELECT EXISTS(
+			SELECT 1 FROM user_content_purchases ucp, ep
+			WHERE ucp.user_id = $2
+			  AND ((ucp.item_type = 'ANIME_EPISODE' AND ucp.item_id = ep.episode_id)
+				OR (ucp.item_type = 'ANIME_SEASON' AND ucp.item_id = ep.season_id))
+			UNION
+			SELECT 1 FROM user_content_rentals ucr, ep
+			WHERE ucr.user_id = $2
+			  AND ucr.expiry_date > CURRENT_TIMESTAMP
+			  AND ((ucr.item_type = 'ANIME_SEASON' AND ucr.item_id = ep.season_id)
+				OR (ucr.item_type = 'ANIME_SERIES' AND ucr.item_id = ep.anime_id))
+		)`
+
+	var allowed bool
+	if err := r.pool.QueryRow(ctx, query, episodeID, userID).Scan(&allowed); err != nil {
+		return false, fmt.Errorf("failed to check episode entitlement: %w", err)
+	}
+	return allowed, nil
+}
+
+// episodeSubtitleColumns lists the episode_subtitle columns read into m.EpisodeSubtitle
+const episodeSubtitleColumns = `
+	id, episode_id, language_code, subtitle_url, source_format, content, cue_count,
+	uploaded_by_user_id, created_at, updated_at`
+
+// scanEpisodeSubtitle scans one episode_subtitle row selected with episodeSubtitleColumns
+func scanEpisodeSubtitle(row pgx.Row) (*m.EpisodeSubtitle, error) {
+	var subtitle m.EpisodeSubtitle
+	if err := row.Scan(&subtitle.ID, &subtitle.EpisodeID, &subtitle.LanguageCode, &subtitle.SubtitleURL,
+		&subtitle.SourceFormat, &subtitle.Content, &subtitle.CueCount, &subtitle.UploadedByUserID,
+		&subtitle.CreatedAt, &subtitle.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return &subtitle, nil
+}
+
+// episodeSubtitleWriter is satisfied by both the pool and a transaction
+type episodeSubtitleWriter interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// upsertEpisodeSubtitle writes every column of a subtitle track; shared with contribution approval
+func upsertEpisodeSubtitle(ctx context.Context, q episodeSubtitleWriter, subtitle *m.EpisodeSubtitle) (*m.EpisodeSubtitle, error) {
+	return scanEpisodeSubtitle(q.QueryRow(ctx, `
+		INSERT INTO episode_subtitle (
+			episode_id, language_code, subtitle_url, source_format, content, cue_count,
+			uploaded_by_user_id, created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+		ON CONFLICT (episode_id, language_code)
+		DO UPDATE SET subtitle_url = EXCLUDED.subtitle_url, source_format = EXCLUDED.source_format,
+			content = EXCLUDED.content, cue_count = EXCLUDED.cue_count,
+			uploaded_by_user_id = EXCLUDED.uploaded_by_user_id, updated_at = CURRENT_TIMESTAMP
+		RETURNING`+episodeSubtitleColumns,
+		subtitle.EpisodeID, subtitle.LanguageCode, subtitle.SubtitleURL, subtitle.SourceFormat,
+		subtitle.Content, subtitle.CueCount, subtitle.UploadedByUserID))
+}
@@ -0,0 +1,864 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+)
+
+// AnimeRepository defines data access for anime series, their localized titles and cast
+type AnimeRepository interface {
+	CreateAnime(ctx context.Context, req d.CreateAnimeRequest) (*m.Anime, error)
+	GetAnimeByID(ctx context.Context, id uuid.UUID) (*m.Anime, error)
+	// GetAnimeDetail loads an anime with genres, creators, cast, seasons and translations
+	GetAnimeDetail(ctx context.Context, id uuid.UUID, language string) (*d.AnimeDetailResponse, error)
+	// ListAnime lists publicly visible anime
+	ListAnime(ctx context.Context, req d.ListAnimeRequest, language string) (*d.PaginatedAnimeResponse, error)
+	UpdateAnime(ctx context.Context, id uuid.UUID, req d.UpdateAnimeRequest) (*m.Anime, error)
+	DeleteAnime(ctx context.Context, id uuid.UUID, deletedByUserID uuid.UUID) error
+	CheckAnimePurchases(ctx context.Context, animeID uuid.UUID) (bool, error)
+	// SetTranslations replaces all localized titles of an anime
+	SetTranslations(ctx context.Context, animeID uuid.UUID, translations []d.AnimeTranslationInput) error
+	// SetCast replaces the characters (and voice actors) of an anime
+	SetCast(ctx context.Context, animeID uuid.UUID, cast []d.AnimeCastInput) error
+	// CanManageAnime checks ownership and falls back to the given collaborator permission
+	CanManageAnime(ctx context.Context, animeID, userID uuid.UUID, tenantID *uuid.UUID, permission string) (bool, error)
+}
+
+// animeRepository implements AnimeRepository interface
+type animeRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewAnimeRepository creates a new anime repository instance
+func NewAnimeRepository(pool *pgxpool.Pool) AnimeRepository {
+	return &animeRepository{pool: pool}
+}
+
+// animeColumns lists the anime columns in the order scanned by scanAnime
+const animeColumns = `
+	id, name, status, cover_image, broadcast_season, broadcast_year, summary,
+	ownership_type, primary_owner_id, original_creator_id, access_level,
+	last_modified_by_user_id, ownership_transferred_at,
+	original_language, age_rating, mature_content, is_public, published_at,
+	is_deleted, deleted_at, deleted_by_user_id,
+	created_at, updated_at`
+
+// scanAnime scans a row selected with animeColumns
+func scanAnime(row pgx.Row) (*m.Anime, error) {
+	var anime m.Anime
+	err := row.Scan(
+		&anime.ID, &anime.Name, &anime.Status, &anime.CoverImage, &anime.BroadcastSeason, &anime.BroadcastYear, &anime.Summary,
+		&anime.OwnershipType, &anime.PrimaryOwnerID, &anime.OriginalCreatorID, &anime.AccessLevel,
+		&anime.LastModifiedByUserID, &anime.OwnershipTransferredAt,
+		&anime.OriginalLanguage, &anime.AgeRating, &anime.MatureContent, &anime.IsPublic, &anime.PublishedAt,
+		&anime.IsDeleted, &anime.DeletedAt, &anime.DeletedByUserID,
+		&anime.CreatedAt, &anime.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &anime, nil
+}
+
+// CreateAnime creates an anime with its genres, creators and translations
+func (r *animeRepository) CreateAnime(ctx context.Context, req d.CreateAnimeRequest) (*m.Anime, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Prepare nullable values
+	var coverImage, broadcastSeason, ageRating *string
+	if req.CoverImage != "" {
+		coverImage = &req.CoverImage
+	}
+	if req.BroadcastSeason != "" {
+		broadcastSeason = &req.BroadcastSeason
+	}
+	if req.AgeRating != "" {
+		ageRating = &req.AgeRating
+	}
+	var summaryBytes []byte
+	if req.Summary != nil {
+		summaryBytes = *req.Summary
+	}
+	originalLanguage := req.OriginalLanguage
+	if originalLanguage == "" {
+		originalLanguage = "ja"
+	}
+
+	query := `
+		INSERT INTO anime (
+			name, status, cover_image, summary, broadcast_season, broadcast_year,
+			ownership_type, primary_owner_id, original_creator_id, access_level, last_modified_by_user_id,
+			original_language, age_rating, mature_content, is_public, published_at,
+			created_at, updated_at
+		)
+		VALUES (
+			$1, $2, $3, $4, $5, $6,
+			$7, $8, $9, $10, $9,
+			$11, $12, $13, $14, CASE WHEN $14::boolean THEN CURRENT_TIMESTAMP END,
+			CURRENT_TIMESTAMP, CURRENT_TIMESTAMP
+		)
+		RETURNING` + animeColumns
+
+	anime, err := scanAnime(tx.QueryRow(ctx, query,
+		req.Title, req.Status, coverImage, summaryBytes, broadcastSeason, req.BroadcastYear,
+		req.OwnershipType, req.PrimaryOwnerID, req.OriginalCreatorID, req.AccessLevel,
+		originalLanguage, ageRating, req.MatureContent, req.IsPublic,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create anime: %w", err)
+	}
+
+	if err := replaceAnimeGenres(ctx, tx, anime.ID, req.Genres); err != nil {
+		return nil, err
+	}
+
+	// Create anime-creator associations
+	for _, creator := range req.Creators {
+		creatorID, err := uuid.Parse(creator.CreatorID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid creator ID %s: %w", creator.CreatorID, err)
+		}
+
+		_, err = tx.Exec(ctx, `
+			INSERT INTO anime_creator (anime_id, creator_id, role, created_at, updated_at)
+			VALUES ($1, $2, $3, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+			ON CONFLICT (anime_id, creator_id, role) DO NOTHING
+		`, anime.ID, creatorID, creator.Role)
+		if err != nil {
+			return nil, fmt.Errorf("failed to associate anime with creator %s: %w", creatorID, err)
+		}
+	}
+
+	if err := replaceAnimeTranslations(ctx, tx, anime.ID, req.Translations); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return anime, nil
+}
+
+// GetAnimeByID retrieves a non-deleted anime by ID
+func (r *animeRepository) GetAnimeByID(ctx context.Context, id uuid.UUID) (*m.Anime, error) {
+	anime, err := scanAnime(r.pool.QueryRow(ctx, `SELECT`+animeColumns+` FROM anime WHERE id = $1 AND is_deleted = FALSE`, id))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("anime not found")
+		}
+		return nil, fmt.Errorf("failed to get anime: %w", err)
+	}
+	return anime, nil
+}
+
+// GetAnimeDetail loads the anime and its associations with a few focused queries
+func (r *animeRepository) GetAnimeDetail(ctx context.Context, id uuid.UUID, language string) (*d.AnimeDetailResponse, error) {
+	anime, err := r.GetAnimeByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &d.AnimeDetailResponse{
+		ID:               anime.ID.String(),
+		OriginalName:     anime.Name,
+		CoverImage:       anime.CoverImage,
+		Summary:          anime.Summary,
+		Status:           anime.Status,
+		BroadcastSeason:  anime.BroadcastSeason,
+		BroadcastYear:    anime.BroadcastYear,
+		OriginalLanguage: anime.OriginalLanguage,
+		CurrentLanguage:  language,
+		AgeRating:        anime.AgeRating,
+		MatureContent:    anime.MatureContent,
+		IsPublic:         anime.IsPublic,
+		AccessLevel:      anime.AccessLevel,
+		OwnershipType:    anime.OwnershipType,
+		PublishedAt:      anime.PublishedAt,
+		Genres:           make([]d.GenreInfo, 0),
+		Creators:         make([]d.CreatorInfo, 0),
+		Cast:             make([]d.AnimeCastResponse, 0),
+		Seasons:          make([]d.AnimeSeasonSummary, 0),
+		Translations:     make([]d.AnimeTranslationResponse, 0),
+		CreatedAt:        anime.CreatedAt,
+		UpdatedAt:        anime.UpdatedAt,
+	}
+	if anime.Name != nil {
+		response.Name = *anime.Name
+	}
+	if anime.PrimaryOwnerID != nil {
+		ownerID := anime.PrimaryOwnerID.String()
+		response.PrimaryOwnerID = &ownerID
+	}
+
+	// Translations: the primary title of the client language replaces the default name
+	rows, err := r.pool.Query(ctx, `
+		SELECT language_code, title, description, is_primary
+		FROM anime_translation
+		WHERE anime_id = $1
+		ORDER BY language_code, is_primary DESC, title`, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load anime translations: %w", err)
+	}
+	defer rows.Close()
+
+	localized := false
+	for rows.Next() {
+		var translation d.AnimeTranslationResponse
+		if err := rows.Scan(&translation.LanguageCode, &translation.Title, &translation.Description, &translation.IsPrimary); err != nil {
+			return nil, fmt.Errorf("failed to scan anime translation: %w", err)
+		}
+		if !localized && translation.LanguageCode == language {
+			response.Name = translation.Title
+			response.Description = translation.Description
+			localized = true
+		}
+		response.Translations = append(response.Translations, translation)
+	}
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate anime translations: %w", rows.Err())
+	}
+
+	// Genres
+	genreRows, err := r.pool.Query(ctx, `
+		SELECT g.id, g.name
+		FROM anime_genre ag
+		JOIN genre g ON g.id = ag.genre_id
+		WHERE ag.anime_id = $1
+		ORDER BY g.name`, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load anime genres: %w", err)
+	}
+	defer genreRows.Close()
+
+	for genreRows.Next() {
+		var genreID uuid.UUID
+		var genre d.GenreInfo
+		if err := genreRows.Scan(&genreID, &genre.Name); err != nil {
+			return nil, fmt.Errorf("failed to scan anime genre: %w", err)
+		}
+		genre.ID = genreID.String()
+		response.Genres = append(response.Genres, genre)
+	}
+	if genreRows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate anime genres: %w", genreRows.Err())
+	}
+
+	// Creators (studio, staff)
+	creatorRows, err := r.pool.Query(ctx, `
+		SELECT c.id, c.name, ac.role
+		FROM anime_creator ac
+		JOIN creator c ON c.id = ac.creator_id
+		WHERE ac.anime_id = $1
+		ORDER BY ac.role, c.name`, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load anime creators: %w", err)
+	}
+	defer creatorRows.Close()
+
+	for creatorRows.Next() {
+		var creatorID uuid.UUID
+		var creator d.CreatorInfo
+		if err := creatorRows.Scan(&creatorID, &creator.Name, &creator.Role); err != nil {
+			return nil, fmt.Errorf("failed to scan anime creator: %w", err)
+		}
+		creator.ID = creatorID.String()
+		response.Creators = append(response.Creators, creator)
+	}
+	if creatorRows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate anime creators: %w", creatorRows.Err())
+	}
+
+	// Cast with voice actors
+	castRows, err := r.pool.Query(ctx, `
+		SELECT ch.id, ch.name, ch.image_url, va.id, va.name
+		FROM anime_character ac
+		JOIN character ch ON ch.id = ac.character_id
+		LEFT JOIN creator va ON va.id = ac.voice_actor_id
+		WHERE ac.anime_id = $1
+		ORDER BY ch.name`, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load anime cast: %w", err)
+	}
+	defer castRows.Close()
+
+	for castRows.Next() {
+		var characterID uuid.UUID
+		var voiceActorID *uuid.UUID
+		var cast d.AnimeCastResponse
+		if err := castRows.Scan(&characterID, &cast.CharacterName, &cast.CharacterImage, &voiceActorID, &cast.VoiceActorName); err != nil {
+			return nil, fmt.Errorf("failed to scan anime cast: %w", err)
+		}
+		cast.CharacterID = characterID.String()
+		if voiceActorID != nil {
+			voiceActor := voiceActorID.String()
+			cast.VoiceActorID = &voiceActor
+		}
+		response.Cast = append(response.Cast, cast)
+	}
+	if castRows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate anime cast: %w", castRows.Err())
+	}
+
+	// Seasons with their published episode count
+	seasonRows, err := r.pool.Query(ctx, `
+		SELECT s.id, s.season_number, s.season_title, s.price_coins, s.rental_price_coins, s.rental_duration_days,
+			COUNT(e.id) FILTER (WHERE e.is_draft = FALSE)
+		FROM anime_season s
+		LEFT JOIN anime_episode e ON e.season_id = s.id
+		WHERE s.anime_id = $1
+		GROUP BY s.id
+		ORDER BY s.season_number`, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load anime seasons: %w", err)
+	}
+	defer seasonRows.Close()
+
+	for seasonRows.Next() {
+		var seasonID uuid.UUID
+		var season d.AnimeSeasonSummary
+		if err := seasonRows.Scan(&seasonID, &season.SeasonNumber, &season.SeasonTitle, &season.PriceCoins,
+			&season.RentalPriceCoins, &season.RentalDurationDays, &season.EpisodeCount); err != nil {
+			return nil, fmt.Errorf("failed to scan anime season: %w", err)
+		}
+		season.ID = seasonID.String()
+		response.Seasons = append(response.Seasons, season)
+	}
+	if seasonRows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate anime seasons: %w", seasonRows.Err())
+	}
+
+	return response, nil
+}
+
+// ListAnime lists public anime with filtering, sorting and pagination
+func (r *animeRepository) ListAnime(ctx context.Context, req d.ListAnimeRequest, language string) (*d.PaginatedAnimeResponse, error) {
+	// Set pagination defaults
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 20
+	}
+	if req.PageSize > 100 {
+		req.PageSize = 100
+	}
+
+	// Set default sorting
+	if req.SortBy == "" {
+		req.SortBy = "created_at"
+	}
+	if req.SortOrder == "" {
+		req.SortOrder = "desc"
+	}
+
+	conditions := []string{"a.is_deleted = FALSE", "a.is_public = TRUE", "a.access_level = 'PUBLIC'"}
+	args := []interface{}{}
+	argIndex := 1
+
+	if req.Status != "" {
+		conditions = append(conditions, fmt.Sprintf("a.status = $%d", argIndex))
+		args = append(args, req.Status)
+		argIndex++
+	}
+
+	if req.BroadcastSeason != "" {
+		conditions = append(conditions, fmt.Sprintf("a.broadcast_season = $%d", argIndex))
+		args = append(args, req.BroadcastSeason)
+		argIndex++
+	}
+
+	if req.BroadcastYear != nil {
+		conditions = append(conditions, fmt.Sprintf("a.broadcast_year = $%d", argIndex))
+		args = append(args, *req.BroadcastYear)
+		argIndex++
+	}
+
+	if req.GenreID != nil {
+		conditions = append(conditions, fmt.Sprintf("EXISTS (SELECT 1 FROM anime_genre ag WHERE ag.anime_id = a.id AND ag.genre_id = $%d)", argIndex))
+		args = append(args, *req.GenreID)
+		argIndex++
+	}
+
+	if req.PrimaryOwnerID != nil {
+		conditions = append(conditions, fmt.Sprintf("a.primary_owner_id = $%d", argIndex))
+		args = append(args, *req.PrimaryOwnerID)
+		argIndex++
+	}
+
+	if req.MatureContent != nil {
+		conditions = append(conditions, fmt.Sprintf("a.mature_content = $%d", argIndex))
+		args = append(args, *req.MatureContent)
+		argIndex++
+	}
+
+	if req.Search != "" {
+		conditions = append(conditions, fmt.Sprintf(`(a.name ILIKE $%d OR EXISTS (
+			SELECT 1 FROM anime_translation t WHERE t.anime_id = a.id AND t.title ILIKE $%d))`, argIndex, argIndex))
+		args = append(args, "%"+req.Search+"%")
+		argIndex++
+	}
+
+	// Age gating and the viewer's filters, applied in the query so pages and totals only count visible anime
+	var visibility string
+	visibility, args, argIndex = contentVisibilityCondition(req.Visibility, "a", "", args, argIndex)
+	if visibility != "" {
+		conditions = append(conditions, visibility)
+	}
+
+	whereClause := strings.Join(conditions, " AND ")
+
+	// Count total
+	var total int64
+	if err := r.pool.QueryRow(ctx, `SELECT COUNT(*) FROM anime a WHERE `+whereClause, args...).Scan(&total); err != nil {
+		return nil, fmt.Errorf("failed to count anime: %w", err)
+	}
+
+	sortColumns := map[string]string{
+		"name":           "a.name",
+		"created_at":     "a.created_at",
+		"updated_at":     "a.updated_at",
+		"broadcast_year": "a.broadcast_year",
+	}
+	sortColumn, ok := sortColumns[req.SortBy]
+	if !ok {
+		sortColumn = "a.created_at"
+	}
+	sortOrder := "DESC"
+	if req.SortOrder == "asc" {
+		sortOrder = "ASC"
+	}
+
+	offset := (req.Page - 1) * req.PageSize
+	query := fmt.Sprintf(`
+		SELECT a.id,
+			COALESCE((
+				SELECT t.title FROM anime_translation t
+				WHERE t.anime_id = a.id AND t.language_code = $%d
+				ORDER BY t.is_primary DESC, t.title
+				LIMIT 1
+			), a.name, '') AS display_name,
+			a.cover_image, a.status, a.broadcast_season, a.broadcast_year, a.mature_content,
+			(SELECT COUNT(*) FROM anime_season s WHERE s.anime_id = a.id),
+			(SELECT COUNT(*) FROM anime_episode e JOIN anime_season s ON s.id = e.season_id
+			 WHERE s.anime_id = a.id AND e.is_draft = FALSE),
+			a.created_at
+		FROM anime a
+		WHERE %s
+		ORDER BY %s %s NULLS LAST, a.id
+		LIMIT $%d OFFSET $%d`, argIndex, whereClause, sortColumn, sortOrder, argIndex+1, argIndex+2)
+	args = append(args, language, req.PageSize, offset)
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list anime: %w", err)
+	}
+	defer rows.Close()
+
+	animeList := make([]d.AnimeSummaryResponse, 0)
+	for rows.Next() {
+		var animeID uuid.UUID
+		var item d.AnimeSummaryResponse
+		if err := rows.Scan(&animeID, &item.Name, &item.CoverImage, &item.Status, &item.BroadcastSeason, &item.BroadcastYear,
+			&item.MatureContent, &item.SeasonCount, &item.EpisodeCount, &item.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan anime: %w", err)
+		}
+		item.ID = animeID.String()
+		animeList = append(animeList, item)
+	}
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate anime: %w", rows.Err())
+	}
+
+	totalPages := int((total + int64(req.PageSize) - 1) / int64(req.PageSize))
+
+	return &d.PaginatedAnimeResponse{
+		Anime: animeList,
+		Pagination: d.PaginationMeta{
+			Page:        req.Page,
+			PageSize:    req.PageSize,
+			Total:       total,
+			TotalPages:  totalPages,
+			HasNext:     req.Page < totalPages,
+			HasPrevious: req.Page > 1,
+		},
+	}, nil
+}
+
+// UpdateAnime applies the provided fields; genres are replaced when given
+func (r *animeRepository) UpdateAnime(ctx context.Context, id uuid.UUID, req d.UpdateAnimeRequest) (*m.Anime, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Build dynamic update query
+	updateFields := []string{}
+	args := []interface{}{}
+	argIndex := 1
+
+	// Always update updated_at and the modifier
+	updateFields = append(updateFields, fmt.Sprintf("updated_at = $%d", argIndex))
+	args = append(args, time.Now())
+	argIndex++
+
+	updateFields = append(updateFields, fmt.Sprintf("last_modified_by_user_id = $%d", argIndex))
+	args = append(args, req.LastModifiedByUserID)
+	argIndex++
+
+	if req.Title != nil {
+		updateFields = append(updateFields, fmt.Sprintf("name = $%d", argIndex))
+		args = append(args, *req.Title)
+		argIndex++
+	}
+
+	if req.CoverImage != nil {
+		updateFields = append(updateFields, fmt.Sprintf("cover_image = $%d", argIndex))
+		args = append(args, *req.CoverImage)
+		argIndex++
+	}
+
+	if req.Summary != nil {
+		updateFields = append(updateFields, fmt.Sprintf("summary = $%d", argIndex))
+		args = append(args, *req.Summary)
+		argIndex++
+	}
+
+	if req.Status != nil {
+		updateFields = append(updateFields, fmt.Sprintf("status = $%d", argIndex))
+		args = append(args, *req.Status)
+		argIndex++
+	}
+
+	if req.BroadcastSeason != nil {
+		updateFields = append(updateFields, fmt.Sprintf("broadcast_season = $%d", argIndex))
+		args = append(args, *req.BroadcastSeason)
+		argIndex++
+	}
+
+	if req.BroadcastYear != nil {
+		updateFields = append(updateFields, fmt.Sprintf("broadcast_year = $%d", argIndex))
+		args = append(args, *req.BroadcastYear)
+		argIndex++
+	}
+
+	if req.OriginalLanguage != nil {
+		updateFields = append(updateFields, fmt.Sprintf("original_language = $%d", argIndex))
+		args = append(args, *req.OriginalLanguage)
+		argIndex++
+	}
+
+	if req.AgeRating != nil {
+		updateFields = append(updateFields, fmt.Sprintf("age_rating = $%d", argIndex))
+		args = append(args, *req.AgeRating)
+		argIndex++
+	}
+
+	if req.MatureContent != nil {
+		updateFields = append(updateFields, fmt.Sprintf("mature_content = $%d", argIndex))
+		args = append(args, *req.MatureContent)
+		argIndex++
+	}
+
+	if req.IsPublic != nil {
+		updateFields = append(updateFields, fmt.Sprintf("is_public = $%d", argIndex))
+		args = append(args, *req.IsPublic)
+		argIndex++
+
+		// First publication is recorded once
+		if *req.IsPublic {
+			updateFields = append(updateFields, "published_at = COALESCE(published_at, CURRENT_TIMESTAMP)")
+		}
+	}
+
+	if req.AccessLevel != nil {
+		updateFields = append(updateFields, fmt.Sprintf("access_level = $%d", argIndex))
+		args = append(args, *req.AccessLevel)
+		argIndex++
+	}
+
+	query := fmt.Sprintf(`
+		UPDATE anime
+		SET %s
+		WHERE id = $%d AND is_deleted = FALSE
+		RETURNING%s`, strings.Join(updateFields, ", "), argIndex, animeColumns)
+	args = append(args, id)
+
+	anime, err := scanAnime(tx.QueryRow(ctx, query, args...))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("anime not found")
+		}
+		return nil, fmt.Errorf("failed to update anime: %w", err)
+	}
+
+	if req.Genres != nil {
+		if err := replaceAnimeGenres(ctx, tx, id, req.Genres); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return anime, nil
+}
+
+// CheckAnimePurchases checks if any users have purchased or rented content from this anime
+func (r *animeRepository) CheckAnimePurchases(ctx context.Context, animeID uuid.UUID) (bool, error) {
+	query := `
+		SELECT EXISTS(
+			SELECT 1 FROM user_content_purchases ucp
+			WHERE (ucp.item_type = 'ANIME_SEASON' AND ucp.item_id IN (
+					SELECT id FROM anime_season WHERE anime_id = $1
+				))
+				OR (ucp.item_type = 'ANIME_EPISODE' AND ucp.item_id IN (
+					SELECT e.id FROM anime_episode e
+					JOIN anime_season s ON s.id = e.season_id
+					WHERE s.anime_id = $1
+				))
+			UNION
+			SELECT 1 FROM user_content_rentals ucr
+			WHERE (ucr.item_type = 'ANIME_SERIES' AND ucr.item_id = $1)
+				OR (ucr.item_type = 'ANIME_SEASON' AND ucr.item_id IN (
+					SELECT id FROM anime_season WHERE anime_id = $1
+				))
+		)
+	`
+
+	var hasPurchases bool
+	if err := r.pool.QueryRow(ctx, query, animeID).Scan(&hasPurchases); err != nil {
+		return false, fmt.Errorf("failed to check anime purchases: %w", err)
+	}
+
+	return hasPurchases, nil
+}
+
+// DeleteAnime performs soft delete on an anime after checking for purchases
+func (r *animeRepository) DeleteAnime(ctx context.Context, id uuid.UUID, deletedByUserID uuid.UUID) error {
+	hasPurchases, err := r.CheckAnimePurchases(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to check purchases: %w", err)
+	}
+	if hasPurchases {
+		return fmt.Errorf("cannot delete anime: users have purchased content from this anime")
+	}
+
+	tag, err := r.pool.Exec(ctx, `
+		UPDATE anime
+		SET is_deleted = TRUE, deleted_at = CURRENT_TIMESTAMP, deleted_by_user_id = $2, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND is_deleted = FALSE
+	`, id, deletedByUserID)
+	if err != nil {
+		return fmt.Errorf("failed to delete anime: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("anime not found or already deleted")
+	}
+
+	return nil
+}
+
+// SetTranslations replaces all localized titles of an anime
+func (r *animeRepository) SetTranslations(ctx context.Context, animeID uuid.UUID, translations []d.AnimeTranslationInput) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := lockAnime(ctx, tx, animeID); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM anime_translation WHERE anime_id = $1`, animeID); err != nil {
+		return fmt.Errorf("failed to clear anime translations: %w", err)
+	}
+
+	if err := replaceAnimeTranslations(ctx, tx, animeID, translations); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// SetCast replaces the characters of an anime; characters and voice actors must exist
+func (r *animeRepository) SetCast(ctx context.Context, animeID uuid.UUID, cast []d.AnimeCastInput) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := lockAnime(ctx, tx, animeID); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM anime_character WHERE anime_id = $1`, animeID); err != nil {
+		return fmt.Errorf("failed to clear anime cast: %w", err)
+	}
+
+	for _, member := range cast {
+		characterID, err := uuid.Parse(member.CharacterID)
+		if err != nil {
+			return fmt.Errorf("invalid character ID %s: %w", member.CharacterID, err)
+		}
+
+		var voiceActorID *uuid.UUID
+		if member.VoiceActorID != nil && *member.VoiceActorID != "" {
+			parsed, err := uuid.Parse(*member.VoiceActorID)
+			if err != nil {
+				return fmt.Errorf("invalid voice actor ID %s: %w", *member.VoiceActorID, err)
+			}
+			voiceActorID = &parsed
+		}
+
+		// INSERT ... SELECT only inserts when the character (and voice actor, if any) exist
+		tag, err := tx.Exec(ctx, `
+			INSERT INTO anime_character (anime_id, character_id, voice_actor_id, created_at, updated_at)
+			SELECT $1, ch.id, $3, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP
+			FROM character ch
+			WHERE ch.id = $2
+			  AND ($3::uuid IS NULL OR EXISTS (SELECT 1 FROM creator c WHERE c.id = $3::uuid))
+		`, animeID, characterID, voiceActorID)
+		if err != nil {
+			if strings.Contains(err.Error(), "duplicate key") {
+				return fmt.Errorf("invalid cast: character %s is listed twice", characterID)
+			}
+			return fmt.Errorf("failed to cast character %s: %w", characterID, err)
+		}
+		if tag.RowsAffected() == 0 {
+			return fmt.Errorf("character or voice actor not found for %s", characterID)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// CanManageAnime checks ownership (user for PERSONAL/COLLABORATIVE, tenant for TENANT)
+// and falls back to the collaborator permission.
+func (r *animeRepository) CanManageAnime(ctx context.Context, animeID, userID uuid.UUID, tenantID *uuid.UUID, permission string) (bool, error) {
+	query := `
+		SELECT
+			(a.ownership_type = 'TENANT' AND $3::uuid IS NOT NULL AND a.primary_owner_id = $3::uuid)
+			OR (a.ownership_type <> 'TENANT' AND a.primary_owner_id = $2)
+			OR has_collaborator_permission('ANIME', a.id, $2, $4::collaborator_permission)
+		FROM anime a
+		WHERE a.id = $1 AND a.is_deleted = FALSE`
+
+	var allowed *bool
+	err := r.pool.QueryRow(ctx, query, animeID, userID, tenantID, permission).Scan(&allowed)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return false, fmt.Errorf("anime not found")
+		}
+		return false, fmt.Errorf("failed to check anime permission: %w", err)
+	}
+
+	return allowed != nil && *allowed, nil
+}
+
+// lockAnime locks a non-deleted anime row for the rest of the transaction
+func lockAnime(ctx context.Context, tx pgx.Tx, animeID uuid.UUID) error {
+	var id uuid.UUID
+	err := tx.QueryRow(ctx, `SELECT id FROM anime WHERE id = $1 AND is_deleted = FALSE FOR UPDATE`, animeID).Scan(&id)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return fmt.Errorf("anime not found")
+		}
+		return fmt.Errorf("failed to lock anime: %w", err)
+	}
+	return nil
+}
+
+// replaceAnimeGenres validates the genres and replaces the anime's genre associations
+func replaceAnimeGenres(ctx context.Context, tx pgx.Tx, animeID uuid.UUID, genres []string) error {
+	genreIDs := make([]uuid.UUID, 0, len(genres))
+	for _, genreStr := range genres {
+		genreID, err := uuid.Parse(genreStr)
+		if err != nil {
+			return fmt.Errorf("invalid genre ID %s: %w", genreStr, err)
+		}
+		genreIDs = append(genreIDs, genreID)
+	}
+
+	if len(genreIDs) > 0 {
+		var existing int
+		if err := tx.QueryRow(ctx, `SELECT COUNT(*) FROM genre WHERE id = ANY($1)`, genreIDs).Scan(&existing); err != nil {
+			return fmt.Errorf("failed to validate genres: %w", err)
+		}
+		if existing != len(uniqueUUIDs(genreIDs)) {
+			return fmt.Errorf("some genres do not exist")
+		}
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM anime_genre WHERE anime_id = $1`, animeID); err != nil {
+		return fmt.Errorf("failed to clear anime genres: %w", err)
+	}
+
+	for _, genreID := range genreIDs {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO anime_genre (anime_id, genre_id, created_at, updated_at)
+			VALUES ($1, $2, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+			ON CONFLICT (anime_id, genre_id) DO NOTHING
+		`, animeID, genreID)
+		if err != nil {
+			return fmt.Errorf("failed to associate anime with genre %s: %w", genreID, err)
+		}
+	}
+
+	return nil
+}
+
+// replaceAnimeTranslations inserts localized titles; callers clear existing rows first
+func replaceAnimeTranslations(ctx context.Context, tx pgx.Tx, animeID uuid.UUID, translations []d.AnimeTranslationInput) error {
+	for _, translation := range translations {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO anime_translation (anime_id, language_code, title, description, is_primary, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+		`, animeID, translation.LanguageCode, translation.Title, translation.Description, translation.IsPrimary)
+		if err != nil {
+			if strings.Contains(err.Error(), "duplicate key") {
+				return fmt.Errorf("invalid translations: duplicate title or primary title for language %s", translation.LanguageCode)
+			}
+			return fmt.Errorf("failed to save anime translation: %w", err)
+		}
+	}
+	return nil
+}
+
+// uniqueUUIDs returns the distinct IDs of a slice
+func uniqueUUIDs(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	unique := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,95 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// BookmarkRepository defines data access for reader bookmarks
+type BookmarkRepository interface {
+	// AddBookmark bookmarks a novel; returns false if it was already bookmarked
+	AddBookmark(ctx context.Context, userID, novelID uuid.UUID) (bool, error)
+	// RemoveBookmark removes a bookmark; returns false if none existed
+	RemoveBookmark(ctx context.Context, userID, novelID uuid.UUID) (bool, error)
+}
+
+// bookmarkRepository implements BookmarkRepository interface
+type bookmarkRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewBookmarkRepository creates a new bookmark repository instance
+func NewBookmarkRepository(pool *pgxpool.Pool) BookmarkRepository {
+	return &bookmarkRepository{pool: pool}
+}
+
+// AddBookmark inserts the bookmark and keeps novel.bookmark_count in sync
+func (r *bookmarkRepository) AddBookmark(ctx context.Context, userID, novelID uuid.UUID) (bool, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var exists bool
+	err = tx.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM novel WHERE id = $1 AND is_deleted = false)`, novelID).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to check novel existence: %w", err)
+	}
+	if !exists {
+		return false, fmt.Errorf("novel not found")
+	}
+
+	tag, err := tx.Exec(ctx, `
+		INSERT INTO novel_bookmark (user_id, novel_id, created_at)
+		VALUES ($1, $2, NOW())
+		ON CONFLICT (user_id, novel_id) DO NOTHING`, userID, novelID)
+	if err != nil {
+		return false, fmt.Errorf("failed to insert bookmark: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return false, nil
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE novel SET bookmark_count = bookmark_count + 1 WHERE id = $1`, novelID); err != nil {
+		return false, fmt.Errorf("failed to update bookmark count: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return true, nil
+}
+
+// RemoveBookmark deletes the bookmark and decrements novel.bookmark_count
+func (r *bookmarkRepository) RemoveBookmark(ctx context.Context, userID, novelID uuid.UUID) (bool, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, `DELETE FROM novel_bookmark WHERE user_id = $1 AND novel_id = $2`, userID, novelID)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete bookmark: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return false, nil
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE novel SET bookmark_count = GREATEST(bookmark_count - 1, 0) WHERE id = $1`, novelID); err != nil {
+		return false, fmt.Errorf("failed to update bookmark count: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,379 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	d "wibusystem/pkg/common/dto"
+)
+
+// ChapterOrderRepository restructures the chapters of a novel: reordering, moving chapters
+// between volumes, splitting and merging volumes
+type ChapterOrderRepository interface {
+	// GetVolumeNovelID returns the novel of a live volume
+	GetVolumeNovelID(ctx context.Context, volumeID uuid.UUID) (uuid.UUID, error)
+	// ListVolumeChapterIDs lists the live chapters of a volume in reading order
+	ListVolumeChapterIDs(ctx context.Context, volumeID uuid.UUID) ([]uuid.UUID, error)
+	// GetChapterVolumes maps each live chapter to its volume and returns the volumes' novels
+	GetChapterVolumes(ctx context.Context, chapterIDs []uuid.UUID) (map[uuid.UUID]uuid.UUID, map[uuid.UUID]uuid.UUID, error)
+
+	// ApplyChapterOrder rewrites the volumes and numbers of the chapters so each listed volume
+	// holds exactly its chapters in the given order
+	ApplyChapterOrder(ctx context.Context, novelID, actorID uuid.UUID, orders []d.VolumeChapterOrder) error
+	// SplitVolume creates a volume in the source's novel and moves the given chapters into it
+	SplitVolume(ctx context.Context, novelID, actorID, sourceID uuid.UUID, req d.SplitVolumeRequest, keep, moved []uuid.UUID) (uuid.UUID, error)
+	// MergeVolumes moves every live chapter of the source into the target in the given order and
+	// soft-deletes the source
+	MergeVolumes(ctx context.Context, novelID, actorID, sourceID, targetID uuid.UUID, targetOrder []uuid.UUID) error
+}
+
+// chapterOrderRepository implements ChapterOrderRepository interface
+type chapterOrderRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewChapterOrderRepository creates a new chapter order repository instance
+func NewChapterOrderRepository(pool *pgxpool.Pool) ChapterOrderRepository {
+	return &chapterOrderRepository{pool: pool}
+}
+
+// GetVolumeNovelID returns the novel of a live volume
+func (r *chapterOrderRepository) GetVolumeNovelID(ctx context.Context, volumeID uuid.UUID) (uuid.UUID, error) {
+	var novelID uuid.UUID
+	err := r.pool.QueryRow(ctx, `
+		SELECT v.novel_id
+		FROM novel_volume v
+		JOIN novel n ON n.id = v.novel_id
+		WHERE v.id = $1 AND v.is_deleted = FALSE AND n.is_deleted = FALSE`, volumeID).Scan(&novelID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return uuid.Nil, fmt.Errorf("volume not found")
+		}
+		return uuid.Nil, fmt.Errorf("failed to get volume: %w", err)
+	}
+
+	return novelID, nil
+}
+
+// ListVolumeChapterIDs lists the live chapters of a volume by chapter number
+func (r *chapterOrderRepository) ListVolumeChapterIDs(ctx context.Context, volumeID uuid.UUID) ([]uuid.UUID, error) {
+	return listVolumeChapterIDs(ctx, r.pool, volumeID)
+}
+
+// GetChapterVolumes looks up live chapters; missing ones are simply absent from the result
+func (r *chapterOrderRepository) GetChapterVolumes(ctx context.Context, chapterIDs []uuid.UUID) (map[uuid.UUID]uuid.UUID, map[uuid.UUID]uuid.UUID, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT c.id, c.volume_id, v.novel_id
+		FROM novel_chapter c
+		JOIN novel_volume v ON v.id = c.volume_id
+		WHERE c.id = ANY($1) AND c.is_deleted = FALSE AND v.is_deleted = FALSE`, chapterIDs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get chapter volumes: %w", err)
+	}
+	defer rows.Close()
+
+	chapterVolumes := make(map[uuid.UUID]uuid.UUID, len(chapterIDs))
+	volumeNovels := make(map[uuid.UUID]uuid.UUID)
+	for rows.Next() {
+		var chapterID, volumeID, novelID uuid.UUID
+		if err := rows.Scan(&chapterID, &volumeID, &novelID); err != nil {
+			return nil, nil, fmt.Errorf("failed to scan chapter volume: %w", err)
+		}
+		chapterVolumes[chapterID] = volumeID
+		volumeNovels[volumeID] = novelID
+	}
+
+	if rows.Err() != nil {
+		return nil, nil, fmt.Errorf("failed to iterate chapter volumes: %w", rows.Err())
+	}
+
+	return chapterVolumes, volumeNovels, nil
+}
+
+// ApplyChapterOrder runs the reorder in one transaction
+func (r *chapterOrderRepository) ApplyChapterOrder(ctx context.Context, novelID, actorID uuid.UUID, orders []d.VolumeChapterOrder) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := applyChapterOrder(ctx, tx, novelID, actorID, orders); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// SplitVolume inserts the new volume, copying availability from the source, then moves the
+// chapters in the same transaction so a failure leaves no empty volume behind
+func (r *chapterOrderRepository) SplitVolume(ctx context.Context, novelID, actorID, sourceID uuid.UUID, req d.SplitVolumeRequest, keep, moved []uuid.UUID) (uuid.UUID, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Volume numbers stay reserved by deleted volumes, the unique constraint covers them too
+	var numberTaken bool
+	err = tx.QueryRow(ctx,
+		"SELECT EXISTS(SELECT 1 FROM novel_volume WHERE novel_id = $1 AND volume_number = $2)",
+		novelID, req.VolumeNumber,
+	).Scan(&numberTaken)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to check volume number: %w", err)
+	}
+	if numberTaken {
+		return uuid.Nil, fmt.Errorf("volume number %d already exists for this novel", req.VolumeNumber)
+	}
+
+	volumeID := uuid.New()
+	tag, err := tx.Exec(ctx, `
+		INSERT INTO novel_volume (
+			id, novel_id, volume_number, volume_title, is_available, chapter_count,
+			last_modified_by_user_id, created_at, updated_at
+		)
+		SELECT $1, novel_id, $3, $4, is_available, 0, $5, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP
+		FROM novel_volume
+		WHERE id = $2 AND is_deleted = FALSE`,
+		volumeID, sourceID, req.VolumeNumber, req.VolumeTitle, actorID)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to create volume: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return uuid.Nil, fmt.Errorf("volume not found")
+	}
+
+	err = applyChapterOrder(ctx, tx, novelID, actorID, []d.VolumeChapterOrder{
+		{VolumeID: sourceID, ChapterIDs: keep},
+		{VolumeID: volumeID, ChapterIDs: moved},
+	})
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return uuid.Nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return volumeID, nil
+}
+
+// MergeVolumes empties the source into the target, then moves the source to the trash like
+// DeleteVolume does. Chapters of the source already in the trash stay with it.
+func (r *chapterOrderRepository) MergeVolumes(ctx context.Context, novelID, actorID, sourceID, targetID uuid.UUID, targetOrder []uuid.UUID) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	err = applyChapterOrder(ctx, tx, novelID, actorID, []d.VolumeChapterOrder{
+		{VolumeID: sourceID, ChapterIDs: []uuid.UUID{}},
+		{VolumeID: targetID, ChapterIDs: targetOrder},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE novel_volume
+		SET is_deleted = TRUE, deleted_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP,
+			deletion_id = uuidv7(), last_modified_by_user_id = $2
+		WHERE id = $1`, sourceID, actorID)
+	if err != nil {
+		return fmt.Errorf("failed to delete merged volume: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// chapterOrderQuerier is satisfied by both the pool and a transaction
+type chapterOrderQuerier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+// listVolumeChapterIDs lists the live chapters of a volume by chapter number
+func listVolumeChapterIDs(ctx context.Context, q chapterOrderQuerier, volumeID uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := q.Query(ctx, `
+		SELECT id FROM novel_chapter
+		WHERE volume_id = $1 AND is_deleted = FALSE
+		ORDER BY chapter_number, id`, volumeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list volume chapters: %w", err)
+	}
+	defer rows.Close()
+
+	ids := make([]uuid.UUID, 0)
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan chapter ID: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate volume chapters: %w", rows.Err())
+	}
+
+	return ids, nil
+}
+
+// applyChapterOrder renumbers in two phases because UNIQUE(volume_id, chapter_number) is checked
+// row by row and also covers deleted chapters. Every chapter of the touched volumes, deleted
+// ones included, first gets a distinct negative number; the listed chapters then take 1..n in
+// their volume and the deleted ones follow after n in their previous order, so they still have
+// a free number when restored from the trash.
+func applyChapterOrder(ctx context.Context, tx pgx.Tx, novelID, actorID uuid.UUID, orders []d.VolumeChapterOrder) error {
+	volumeIDs := make([]uuid.UUID, 0, len(orders))
+	for _, order := range orders {
+		volumeIDs = append(volumeIDs, order.VolumeID)
+	}
+
+	// Lock the volumes so concurrent restructures of the same volumes run one after another
+	var lockedVolumes int
+	err := tx.QueryRow(ctx, `
+		SELECT COUNT(*) FROM (
+			SELECT id FROM novel_volume
+			WHERE id = ANY($1) AND novel_id = $2 AND is_deleted = FALSE
+			ORDER BY id
+			FOR UPDATE
+		) locked`, volumeIDs, novelID).Scan(&lockedVolumes)
+	if err != nil {
+		return fmt.Errorf("failed to lock volumes: %w", err)
+	}
+	if lockedVolumes != len(volumeIDs) {
+		return fmt.Errorf("volume not found")
+	}
+
+	// The lists must cover exactly the live chapters currently in these volumes
+	listed := make(map[uuid.UUID]uuid.UUID)
+	for _, order := range orders {
+		for _, chapterID := range order.ChapterIDs {
+			if _, duplicate := listed[chapterID]; duplicate {
+				return fmt.Errorf("invalid chapter order: chapter %s is listed twice", chapterID)
+			}
+			listed[chapterID] = order.VolumeID
+		}
+	}
+
+	rows, err := tx.Query(ctx, `
+		SELECT id, volume_id FROM novel_chapter
+		WHERE volume_id = ANY($1) AND is_deleted = FALSE`, volumeIDs)
+	if err != nil {
+		return fmt.Errorf("failed to list volume chapters: %w", err)
+	}
+	current := make(map[uuid.UUID]uuid.UUID)
+	for rows.Next() {
+		var chapterID, volumeID uuid.UUID
+		if err := rows.Scan(&chapterID, &volumeID); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan chapter: %w", err)
+		}
+		current[chapterID] = volumeID
+	}
+	rows.Close()
+	if rows.Err() != nil {
+		return fmt.Errorf("failed to iterate volume chapters: %w", rows.Err())
+	}
+	if len(current) != len(listed) {
+		return fmt.Errorf("chapters changed while reordering, reload and try again")
+	}
+
+	losing := make([]uuid.UUID, 0)
+	for chapterID, volumeID := range current {
+		target, ok := listed[chapterID]
+		if !ok {
+			return fmt.Errorf("chapters changed while reordering, reload and try again")
+		}
+		if target != volumeID {
+			losing = append(losing, volumeID)
+		}
+	}
+
+	// Buyers of a volume keep what they paid for: chapters cannot leave a purchased or rented volume
+	if len(losing) > 0 {
+		var purchased bool
+		err = tx.QueryRow(ctx, `
+			SELECT EXISTS(
+				SELECT 1 FROM user_content_purchases
+				WHERE item_type = 'NOVEL_VOLUME' AND item_id = ANY($1)
+				UNION ALL
+				SELECT 1 FROM user_content_rentals
+				WHERE item_type = 'NOVEL_VOLUME' AND item_id = ANY($1)
+			)`, losing).Scan(&purchased)
+		if err != nil {
+			return fmt.Errorf("failed to check purchases: %w", err)
+		}
+		if purchased {
+			return fmt.Errorf("cannot move chapters: users have purchased the volume they belong to")
+		}
+	}
+
+	// Phase one: distinct negative numbers across every touched volume
+	_, err = tx.Exec(ctx, `
+		UPDATE novel_chapter c
+		SET chapter_number = -o.ord
+		FROM (
+			SELECT id, ROW_NUMBER() OVER (ORDER BY volume_id, chapter_number, id) AS ord
+			FROM novel_chapter
+			WHERE volume_id = ANY($1)
+		) o
+		WHERE c.id = o.id`, volumeIDs)
+	if err != nil {
+		return fmt.Errorf("failed to renumber chapters: %w", err)
+	}
+
+	// Phase two: final volume and number of the listed chapters, then the deleted ones after them
+	for _, order := range orders {
+		if len(order.ChapterIDs) > 0 {
+			_, err = tx.Exec(ctx, `
+				UPDATE novel_chapter c
+				SET volume_id = $1, chapter_number = o.ord,
+					last_modified_by_user_id = CASE WHEN c.volume_id <> $1 THEN $3 ELSE c.last_modified_by_user_id END
+				FROM unnest($2::uuid[]) WITH ORDINALITY AS o(id, ord)
+				WHERE c.id = o.id`, order.VolumeID, order.ChapterIDs, actorID)
+			if err != nil {
+				return fmt.Errorf("failed to renumber chapters: %w", err)
+			}
+		}
+
+		_, err = tx.Exec(ctx, `
+			UPDATE novel_chapter c
+			SET chapter_number = $2 + o.ord
+			FROM (
+				SELECT id, ROW_NUMBER() OVER (ORDER BY chapter_number DESC, id) AS ord
+				FROM novel_chapter
+				WHERE volume_id = $1 AND is_deleted = TRUE
+			) o
+			WHERE c.id = o.id`, order.VolumeID, len(order.ChapterIDs))
+		if err != nil {
+			return fmt.Errorf("failed to renumber deleted chapters: %w", err)
+		}
+
+		_, err = tx.Exec(ctx, `
+			UPDATE novel_volume
+			SET chapter_count = (SELECT COUNT(*) FROM novel_chapter WHERE volume_id = $1 AND is_deleted = FALSE),
+			    last_modified_by_user_id = $2,
+			    updated_at = CURRENT_TIMESTAMP
+			WHERE id = $1`, order.VolumeID, actorID)
+		if err != nil {
+			return fmt.Errorf("failed to update volume chapter count: %w", err)
+		}
+	}
+
+	return nil
+}
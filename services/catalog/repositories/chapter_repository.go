@@ -531,7 +531,7 @@ func (r *chapterRepository) DeleteChapter(ctx context.Context, id uuid.UUID) err
 	now := time.Now()
 	_, err = tx.Exec(ctx, `
 		UPDATE novel_chapter
-		SET is_deleted = TRUE, deleted_at = $2, updated_at = $2
+		SET is_deleted = TRUE, deleted_at = $2, updated_at = $2, deletion_id = uuidv7()
 		WHERE id = $1
 	`, id, now)
 	if err != nil {
@@ -0,0 +1,430 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+)
+
+// characterContributionColumns lists the character_contributions columns read into m.CharacterContribution
+const characterContributionColumns = `
+	id, contribution_type, character_id, name, description, image_url,
+	translations, add_novel_ids, remove_novel_ids, note,
+	user_id, tenant_id, status, rejection_reason, reviewer_id, reviewed_at,
+	created_at, updated_at`
+
+// CharacterContributionRepository defines data access for character proposals and their review
+type CharacterContributionRepository interface {
+	// Create stores a new pending contribution
+	Create(ctx context.Context, contribution *m.CharacterContribution) error
+	// GetByID retrieves a contribution by ID
+	GetByID(ctx context.Context, id uuid.UUID) (*m.CharacterContribution, error)
+	// UpdatePending replaces the proposed values of a pending contribution owned by its contributor
+	UpdatePending(ctx context.Context, contribution *m.CharacterContribution) error
+	// List retrieves a page of contributions; userID restricts to one contributor
+	List(ctx context.Context, req d.ListCharacterContributionsRequest, userID *uuid.UUID) ([]m.CharacterContribution, *d.PaginationMeta, error)
+	// Approve merges a pending contribution into the character tables and returns the character ID
+	Approve(ctx context.Context, id, reviewerID uuid.UUID) (uuid.UUID, error)
+	// Reject closes a pending contribution with a reason
+	Reject(ctx context.Context, id, reviewerID uuid.UUID, reason string) error
+
+	// GetTranslations retrieves the localized descriptions of a character
+	GetTranslations(ctx context.Context, characterID uuid.UUID) ([]m.CharacterTranslation, error)
+	// GetNovelIDs retrieves the novels a character appears in
+	GetNovelIDs(ctx context.Context, characterID uuid.UUID) ([]uuid.UUID, error)
+	// ListContributors aggregates approved contributions of a character per user
+	ListContributors(ctx context.Context, characterID uuid.UUID) ([]d.CharacterContributorResponse, error)
+}
+
+// characterContributionRepository implements CharacterContributionRepository interface
+type characterContributionRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewCharacterContributionRepository creates a new character contribution repository instance
+func NewCharacterContributionRepository(pool *pgxpool.Pool) CharacterContributionRepository {
+	return &characterContributionRepository{pool: pool}
+}
+
+// Create inserts a pending contribution and fills its ID, status and timestamps
+func (r *characterContributionRepository) Create(ctx context.Context, contribution *m.CharacterContribution) error {
+	query := `
+		INSERT INTO character_contributions (
+			contribution_type, character_id, name, description, image_url,
+			translations, add_novel_ids, remove_novel_ids, note, user_id, tenant_id
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING id, status, created_at, updated_at`
+
+	err := r.pool.QueryRow(ctx, query,
+		contribution.ContributionType, contribution.CharacterID, contribution.Name, contribution.Description, contribution.ImageURL,
+		contribution.Translations, contribution.AddNovelIDs, contribution.RemoveNovelIDs, contribution.Note,
+		contribution.UserID, contribution.TenantID,
+	).Scan(&contribution.ID, &contribution.Status, &contribution.CreatedAt, &contribution.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create character contribution: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a contribution by its ID
+func (r *characterContributionRepository) GetByID(ctx context.Context, id uuid.UUID) (*m.CharacterContribution, error) {
+	row := r.pool.QueryRow(ctx, `SELECT `+characterContributionColumns+` FROM character_contributions WHERE id = $1`, id)
+
+	contribution, err := scanCharacterContribution(row)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("character contribution not found")
+		}
+		return nil, fmt.Errorf("failed to get character contribution: %w", err)
+	}
+
+	return contribution, nil
+}
+
+// UpdatePending overwrites the proposed values while the contribution is still pending
+func (r *characterContributionRepository) UpdatePending(ctx context.Context, contribution *m.CharacterContribution) error {
+	query := `
+		UPDATE character_contributions
+		SET name = $3, description = $4, image_url = $5, translations = $6,
+			add_novel_ids = $7, remove_novel_ids = $8, note = $9, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND user_id = $2 AND status = 'pending'
+		RETURNING updated_at`
+
+	err := r.pool.QueryRow(ctx, query,
+		contribution.ID, contribution.UserID, contribution.Name, contribution.Description, contribution.ImageURL,
+		contribution.Translations, contribution.AddNovelIDs, contribution.RemoveNovelIDs, contribution.Note,
+	).Scan(&contribution.UpdatedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return fmt.Errorf("character contribution already reviewed")
+		}
+		return fmt.Errorf("failed to update character contribution: %w", err)
+	}
+
+	return nil
+}
+
+// List retrieves contributions, oldest first for the review queue
+func (r *characterContributionRepository) List(ctx context.Context, req d.ListCharacterContributionsRequest, userID *uuid.UUID) ([]m.CharacterContribution, *d.PaginationMeta, error) {
+	// Set pagination defaults
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 20
+	}
+	if req.PageSize > 100 {
+		req.PageSize = 100
+	}
+
+	conditions := []string{}
+	args := []interface{}{}
+	argIndex := 1
+
+	if req.Status != "" {
+		conditions = append(conditions, fmt.Sprintf("status = $%d", argIndex))
+		args = append(args, req.Status)
+		argIndex++
+	}
+
+	if req.CharacterID != "" {
+		characterID, err := uuid.Parse(req.CharacterID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid character ID format: %w", err)
+		}
+		conditions = append(conditions, fmt.Sprintf("character_id = $%d", argIndex))
+		args = append(args, characterID)
+		argIndex++
+	}
+
+	if userID != nil {
+		conditions = append(conditions, fmt.Sprintf("user_id = $%d", argIndex))
+		args = append(args, *userID)
+		argIndex++
+	}
+
+	whereClause := ""
+	if len(conditions) > 0 {
+		whereClause = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	var total int64
+	if err := r.pool.QueryRow(ctx, `SELECT COUNT(*) FROM character_contributions `+whereClause, args...).Scan(&total); err != nil {
+		return nil, nil, fmt.Errorf("failed to count character contributions: %w", err)
+	}
+
+	offset := (req.Page - 1) * req.PageSize
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM character_contributions
+		%s
+		ORDER BY created_at ASC
+		LIMIT $%d OFFSET $%d`, characterContributionColumns, whereClause, argIndex, argIndex+1)
+	args = append(args, req.PageSize, offset)
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list character contributions: %w", err)
+	}
+	defer rows.Close()
+
+	contributions := make([]m.CharacterContribution, 0)
+	for rows.Next() {
+		contribution, err := scanCharacterContribution(rows)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to scan character contribution: %w", err)
+		}
+		contributions = append(contributions, *contribution)
+	}
+
+	if rows.Err() != nil {
+		return nil, nil, fmt.Errorf("failed to iterate character contributions: %w", rows.Err())
+	}
+
+	totalPages := int((total + int64(req.PageSize) - 1) / int64(req.PageSize))
+
+	return contributions, &d.PaginationMeta{
+		Page:        req.Page,
+		PageSize:    req.PageSize,
+		Total:       total,
+		TotalPages:  totalPages,
+		HasNext:     req.Page < totalPages,
+		HasPrevious: req.Page > 1,
+	}, nil
+}
+
+// Approve applies the proposal in one transaction: creates or updates the character,
+// upserts the localized descriptions, adjusts novel_character and marks the contribution
+// approved so it is attributed to its contributor.
+func (r *characterContributionRepository) Approve(ctx context.Context, id, reviewerID uuid.UUID) (uuid.UUID, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	row := tx.QueryRow(ctx, `SELECT `+characterContributionColumns+` FROM character_contributions WHERE id = $1 FOR UPDATE`, id)
+	contribution, err := scanCharacterContribution(row)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return uuid.Nil, fmt.Errorf("character contribution not found")
+		}
+		return uuid.Nil, fmt.Errorf("failed to get character contribution: %w", err)
+	}
+
+	if contribution.Status != m.CharacterContributionPending {
+		return uuid.Nil, fmt.Errorf("character contribution already reviewed")
+	}
+
+	var characterID uuid.UUID
+	if contribution.ContributionType == m.CharacterContributionCreate {
+		err = tx.QueryRow(ctx, `
+			INSERT INTO character (name, description, image_url)
+			VALUES ($1, $2, $3)
+			RETURNING id`, contribution.Name, contribution.Description, contribution.ImageURL).Scan(&characterID)
+		if err != nil {
+			if strings.Contains(err.Error(), "character_name_unique") {
+				return uuid.Nil, fmt.Errorf("character with name '%s' already exists", *contribution.Name)
+			}
+			return uuid.Nil, fmt.Errorf("failed to create character: %w", err)
+		}
+	} else {
+		characterID = *contribution.CharacterID
+		tag, err := tx.Exec(ctx, `
+			UPDATE character
+			SET name = COALESCE($2, name),
+				description = COALESCE($3, description),
+				image_url = COALESCE($4, image_url),
+				updated_at = CURRENT_TIMESTAMP
+			WHERE id = $1`, characterID, contribution.Name, contribution.Description, contribution.ImageURL)
+		if err != nil {
+			if strings.Contains(err.Error(), "character_name_unique") {
+				return uuid.Nil, fmt.Errorf("character with name '%s' already exists", *contribution.Name)
+			}
+			return uuid.Nil, fmt.Errorf("failed to update character: %w", err)
+		}
+		if tag.RowsAffected() == 0 {
+			return uuid.Nil, fmt.Errorf("character not found")
+		}
+	}
+
+	for _, translation := range contribution.Translations {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO character_translation (character_id, language_code, description)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (character_id, language_code)
+			DO UPDATE SET description = EXCLUDED.description, updated_at = CURRENT_TIMESTAMP`,
+			characterID, translation.LanguageCode, translation.Description)
+		if err != nil {
+			return uuid.Nil, fmt.Errorf("failed to save character translation: %w", err)
+		}
+	}
+
+	if len(contribution.AddNovelIDs) > 0 {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO novel_character (novel_id, character_id)
+			SELECT n.id, $2 FROM novel n WHERE n.id = ANY($1) AND n.is_deleted = false
+			ON CONFLICT (novel_id, character_id) DO NOTHING`, contribution.AddNovelIDs, characterID)
+		if err != nil {
+			return uuid.Nil, fmt.Errorf("failed to add novel appearances: %w", err)
+		}
+	}
+
+	if len(contribution.RemoveNovelIDs) > 0 {
+		_, err := tx.Exec(ctx, `DELETE FROM novel_character WHERE character_id = $1 AND novel_id = ANY($2)`,
+			characterID, contribution.RemoveNovelIDs)
+		if err != nil {
+			return uuid.Nil, fmt.Errorf("failed to remove novel appearances: %w", err)
+		}
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE character_contributions
+		SET status = 'approved', character_id = $2, reviewer_id = $3, reviewed_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1`, id, characterID, reviewerID)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to approve character contribution: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return uuid.Nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return characterID, nil
+}
+
+// Reject marks a pending contribution as rejected
+func (r *characterContributionRepository) Reject(ctx context.Context, id, reviewerID uuid.UUID, reason string) error {
+	tag, err := r.pool.Exec(ctx, `
+		UPDATE character_contributions
+		SET status = 'rejected', rejection_reason = $3, reviewer_id = $2, reviewed_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND status = 'pending'`, id, reviewerID, reason)
+	if err != nil {
+		return fmt.Errorf("failed to reject character contribution: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		var exists bool
+		if err := r.pool.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM character_contributions WHERE id = $1)`, id).Scan(&exists); err != nil {
+			return fmt.Errorf("failed to check character contribution: %w", err)
+		}
+		if !exists {
+			return fmt.Errorf("character contribution not found")
+		}
+		return fmt.Errorf("character contribution already reviewed")
+	}
+
+	return nil
+}
+
+// GetTranslations retrieves the localized descriptions of a character ordered by language
+func (r *characterContributionRepository) GetTranslations(ctx context.Context, characterID uuid.UUID) ([]m.CharacterTranslation, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT language_code, description
+		FROM character_translation
+		WHERE character_id = $1
+		ORDER BY language_code`, characterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get character translations: %w", err)
+	}
+	defer rows.Close()
+
+	translations := make([]m.CharacterTranslation, 0)
+	for rows.Next() {
+		var translation m.CharacterTranslation
+		if err := rows.Scan(&translation.LanguageCode, &translation.Description); err != nil {
+			return nil, fmt.Errorf("failed to scan character translation: %w", err)
+		}
+		translations = append(translations, translation)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate character translations: %w", rows.Err())
+	}
+
+	return translations, nil
+}
+
+// GetNovelIDs retrieves the novels a character appears in
+func (r *characterContributionRepository) GetNovelIDs(ctx context.Context, characterID uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := r.pool.Query(ctx, `SELECT novel_id FROM novel_character WHERE character_id = $1 ORDER BY novel_id`, characterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get character novels: %w", err)
+	}
+	defer rows.Close()
+
+	novelIDs := make([]uuid.UUID, 0)
+	for rows.Next() {
+		var novelID uuid.UUID
+		if err := rows.Scan(&novelID); err != nil {
+			return nil, fmt.Errorf("failed to scan character novel: %w", err)
+		}
+		novelIDs = append(novelIDs, novelID)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate character novels: %w", rows.Err())
+	}
+
+	return novelIDs, nil
+}
+
+// ListContributors returns the users whose contributions were merged into a character
+func (r *characterContributionRepository) ListContributors(ctx context.Context, characterID uuid.UUID) ([]d.CharacterContributorResponse, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT user_id, COUNT(*), MAX(reviewed_at)
+		FROM character_contributions
+		WHERE character_id = $1 AND status = 'approved'
+		GROUP BY user_id
+		ORDER BY MIN(reviewed_at)`, characterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list character contributors: %w", err)
+	}
+	defer rows.Close()
+
+	contributors := make([]d.CharacterContributorResponse, 0)
+	for rows.Next() {
+		var contributor d.CharacterContributorResponse
+		var lastContributedAt *time.Time
+		if err := rows.Scan(&contributor.UserID, &contributor.ContributionCount, &lastContributedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan character contributor: %w", err)
+		}
+		if lastContributedAt != nil {
+			contributor.LastContributedAt = *lastContributedAt
+		}
+		contributors = append(contributors, contributor)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate character contributors: %w", rows.Err())
+	}
+
+	return contributors, nil
+}
+
+// scanCharacterContribution scans a row selected with characterContributionColumns
+func scanCharacterContribution(row pgx.Row) (*m.CharacterContribution, error) {
+	var contribution m.CharacterContribution
+	err := row.Scan(
+		&contribution.ID, &contribution.ContributionType, &contribution.CharacterID,
+		&contribution.Name, &contribution.Description, &contribution.ImageURL,
+		&contribution.Translations, &contribution.AddNovelIDs, &contribution.RemoveNovelIDs, &contribution.Note,
+		&contribution.UserID, &contribution.TenantID, &contribution.Status, &contribution.RejectionReason,
+		&contribution.ReviewerID, &contribution.ReviewedAt,
+		&contribution.CreatedAt, &contribution.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &contribution, nil
+}
@@ -6,9 +6,11 @@ import (
 	"strings"
 	"time"
 
+	d "wibusystem/pkg/common/dto"
 	m "wibusystem/pkg/common/model"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -24,6 +26,14 @@ type CharacterRepository interface {
 	// Query methods for novel relations
 	GetCharactersByNovelID(ctx context.Context, novelID uuid.UUID) ([]m.Character, error)
 	GetCharactersByNovelIDs(ctx context.Context, novelIDs []uuid.UUID) (map[uuid.UUID][]m.Character, error)
+
+	// Duplicate handling
+	// FindDuplicates returns characters whose name matches any of names, strongest match first
+	FindDuplicates(ctx context.Context, names []string, excludeID *uuid.UUID, limit int) ([]d.DuplicateCandidate, error)
+	// GetRedirect returns the character a merged character ID now points to
+	GetRedirect(ctx context.Context, oldID uuid.UUID) (*uuid.UUID, error)
+	// Merge moves every link of the source character to the target and deletes the source
+	Merge(ctx context.Context, sourceID, targetID, mergedBy uuid.UUID) (*d.CharacterMergeResponse, error)
 }
 
 type characterRepository struct {
@@ -293,4 +303,208 @@ func (r *characterRepository) GetCharactersByNovelIDs(ctx context.Context, novel
 	}
 
 	return result, nil
-}
\ No newline at end of file
+}
+
+// FindDuplicates matches the normalized name key exactly and the lowercased name by trigram
+// similarity; each character is reported once with its best score
+func (r *characterRepository) FindDuplicates(ctx context.Context, names []string, excludeID *uuid.UUID, limit int) ([]d.DuplicateCandidate, error) {
+	query := `
+		WITH input AS (
+			SELECT lower(n) AS name, entity_name_key(n) AS name_key FROM unnest($1::text[]) AS n
+		),
+		scored AS (
+			SELECT c.id, c.name,
+				bool_or(entity_name_key(c.name) = i.name_key) AS same_key,
+				MAX(similarity(lower(c.name), i.name)) AS score
+			FROM character c, input i
+			WHERE (entity_name_key(c.name) = i.name_key OR lower(c.name) % i.name)
+				AND ($2::uuid IS NULL OR c.id <> $2)
+			GROUP BY c.id, c.name
+		)
+		SELECT id, name, CASE WHEN same_key THEN 'name' ELSE 'similar' END, score
+		FROM scored
+		ORDER BY same_key DESC, score DESC, name ASC
+		LIMIT $3
+	`
+
+	rows, err := r.pool.Query(ctx, query, names, excludeID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find duplicate characters: %w", err)
+	}
+	defer rows.Close()
+
+	candidates := []d.DuplicateCandidate{}
+	for rows.Next() {
+		var candidate d.DuplicateCandidate
+		var id uuid.UUID
+		var score float32
+		if err := rows.Scan(&id, &candidate.Name, &candidate.MatchType, &score); err != nil {
+			return nil, fmt.Errorf("failed to scan duplicate character: %w", err)
+		}
+		candidate.ID = id.String()
+		candidate.MatchedName = candidate.Name
+		candidate.Similarity = float64(score)
+		candidates = append(candidates, candidate)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate duplicate characters: %w", rows.Err())
+	}
+
+	return candidates, nil
+}
+
+// GetRedirect looks up the character a merged ID was folded into; nil when the ID was never merged
+func (r *characterRepository) GetRedirect(ctx context.Context, oldID uuid.UUID) (*uuid.UUID, error) {
+	var characterID uuid.UUID
+	err := r.pool.QueryRow(ctx, `SELECT character_id FROM character_redirect WHERE old_id = $1`, oldID).Scan(&characterID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get character redirect: %w", err)
+	}
+	return &characterID, nil
+}
+
+// Merge folds a duplicate character into the target in one transaction. Appearances are
+// re-pointed unless the target already appears in the same title (a missing voice actor is
+// then taken from the source), translations, contributions, glossary terms and the image the
+// target lacks are moved, and the source ID keeps redirecting to the target.
+func (r *characterRepository) Merge(ctx context.Context, sourceID, targetID, mergedBy uuid.UUID) (*d.CharacterMergeResponse, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Lock both characters in a stable order so concurrent merges cannot deadlock
+	rows, err := tx.Query(ctx, `SELECT id FROM character WHERE id = ANY($1) ORDER BY id FOR UPDATE`, []uuid.UUID{sourceID, targetID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock characters: %w", err)
+	}
+	locked := make(map[uuid.UUID]bool, 2)
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan character: %w", err)
+		}
+		locked[id] = true
+	}
+	rows.Close()
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to lock characters: %w", rows.Err())
+	}
+	if !locked[sourceID] {
+		return nil, fmt.Errorf("character not found")
+	}
+	if !locked[targetID] {
+		return nil, fmt.Errorf("target character not found")
+	}
+
+	response := &d.CharacterMergeResponse{
+		MergedCharacterID: sourceID.String(),
+		TargetCharacterID: targetID.String(),
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE anime_character t SET voice_actor_id = s.voice_actor_id
+		FROM anime_character s
+		WHERE t.character_id = $2 AND s.character_id = $1 AND s.anime_id = t.anime_id
+			AND t.voice_actor_id IS NULL AND s.voice_actor_id IS NOT NULL
+	`, sourceID, targetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge voice actors: %w", err)
+	}
+
+	links := []struct {
+		table, column string
+		moved         *int64
+	}{
+		{"novel_character", "novel_id", &response.NovelLinks},
+		{"manga_character", "manga_id", &response.MangaLinks},
+		{"anime_character", "anime_id", &response.AnimeLinks},
+	}
+	for _, link := range links {
+		tag, err := tx.Exec(ctx, fmt.Sprintf(`
+			UPDATE %[1]s l SET character_id = $2
+			WHERE l.character_id = $1 AND NOT EXISTS (
+				SELECT 1 FROM %[1]s t WHERE t.%[2]s = l.%[2]s AND t.character_id = $2
+			)
+		`, link.table, link.column), sourceID, targetID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to move %s links: %w", link.table, err)
+		}
+		*link.moved = tag.RowsAffected()
+
+		if _, err := tx.Exec(ctx, fmt.Sprintf(`DELETE FROM %s WHERE character_id = $1`, link.table), sourceID); err != nil {
+			return nil, fmt.Errorf("failed to remove %s links: %w", link.table, err)
+		}
+	}
+
+	tag, err := tx.Exec(ctx, `
+		UPDATE character_translation s SET character_id = $2, updated_at = CURRENT_TIMESTAMP
+		WHERE s.character_id = $1 AND NOT EXISTS (
+			SELECT 1 FROM character_translation t WHERE t.character_id = $2 AND t.language_code = s.language_code
+		)
+	`, sourceID, targetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to move character translations: %w", err)
+	}
+	response.Translations = tag.RowsAffected()
+
+	// Contributors stay credited and glossary terms keep pointing at the character
+	if _, err := tx.Exec(ctx, `UPDATE character_contributions SET character_id = $2 WHERE character_id = $1`, sourceID, targetID); err != nil {
+		return nil, fmt.Errorf("failed to move character contributions: %w", err)
+	}
+	if _, err := tx.Exec(ctx, `UPDATE novel_glossary_terms SET character_id = $2 WHERE character_id = $1`, sourceID, targetID); err != nil {
+		return nil, fmt.Errorf("failed to move glossary terms: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE media_attachments s SET entity_id = $2
+		WHERE s.kind = 'character_image' AND s.entity_id = $1 AND NOT EXISTS (
+			SELECT 1 FROM media_attachments t WHERE t.kind = 'character_image' AND t.entity_id = $2
+		)
+	`, sourceID, targetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to move character image: %w", err)
+	}
+	if _, err := tx.Exec(ctx, `DELETE FROM media_attachments WHERE kind = 'character_image' AND entity_id = $1`, sourceID); err != nil {
+		return nil, fmt.Errorf("failed to remove character image: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE character t SET
+			description = COALESCE(t.description, s.description),
+			image_url = COALESCE(t.image_url, s.image_url),
+			updated_at = CURRENT_TIMESTAMP
+		FROM character s
+		WHERE t.id = $2 AND s.id = $1
+	`, sourceID, targetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge character details: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE character_redirect SET character_id = $2 WHERE character_id = $1`, sourceID, targetID); err != nil {
+		return nil, fmt.Errorf("failed to update character redirects: %w", err)
+	}
+	_, err = tx.Exec(ctx, `
+		INSERT INTO character_redirect (old_id, character_id, merged_by)
+		VALUES ($1, $2, $3)
+	`, sourceID, targetID, mergedBy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create character redirect: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM character WHERE id = $1`, sourceID); err != nil {
+		return nil, fmt.Errorf("failed to delete merged character: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return response, nil
+}
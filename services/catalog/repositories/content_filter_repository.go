@@ -0,0 +1,401 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+)
+
+// ContentFilterRepository defines data access for reader content filters, tenant age gating
+// policies and the rating facts gating depends on
+type ContentFilterRepository interface {
+	// GetUserFilter returns the user's filters; a user without any gets the defaults
+	GetUserFilter(ctx context.Context, userID uuid.UUID) (*m.UserContentFilter, error)
+	// UpsertUserFilter creates or replaces the user's filters
+	UpsertUserFilter(ctx context.Context, filter *m.UserContentFilter) error
+
+	// GetTenantPolicies returns the policies of the given tenants; tenants without one are absent
+	GetTenantPolicies(ctx context.Context, tenantIDs []uuid.UUID) (map[uuid.UUID]*m.TenantContentPolicy, error)
+	// UpsertTenantPolicy creates or replaces a tenant's policy
+	UpsertTenantPolicy(ctx context.Context, policy *m.TenantContentPolicy) error
+
+	// ListNovelRatings returns the rating facts of live novels keyed by novel ID
+	ListNovelRatings(ctx context.Context, novelIDs []uuid.UUID) (map[uuid.UUID]d.ContentRatingFacts, error)
+	// GetNovelChapterRating returns the novel's rating facts merged with the chapter's own
+	GetNovelChapterRating(ctx context.Context, chapterID uuid.UUID) (*d.ContentRatingFacts, error)
+	// GetMangaChapterRating returns the rating facts of the manga a chapter belongs to
+	GetMangaChapterRating(ctx context.Context, chapterID uuid.UUID) (*d.ContentRatingFacts, error)
+
+	// ListMangaRatings returns the rating facts of live manga keyed by manga ID
+	ListMangaRatings(ctx context.Context, mangaIDs []uuid.UUID) (map[uuid.UUID]d.ContentRatingFacts, error)
+	// GetMangaVolumeRating returns the rating facts of the manga a volume belongs to
+	GetMangaVolumeRating(ctx context.Context, volumeID uuid.UUID) (*d.ContentRatingFacts, error)
+	// ListAnimeRatings returns the rating facts of live anime keyed by anime ID
+	ListAnimeRatings(ctx context.Context, animeIDs []uuid.UUID) (map[uuid.UUID]d.ContentRatingFacts, error)
+	// GetAnimeSeasonRating returns the rating facts of the anime a season belongs to
+	GetAnimeSeasonRating(ctx context.Context, seasonID uuid.UUID) (*d.ContentRatingFacts, error)
+	// GetAnimeEpisodeRating returns the rating facts of the anime an episode belongs to
+	GetAnimeEpisodeRating(ctx context.Context, episodeID uuid.UUID) (*d.ContentRatingFacts, error)
+}
+
+// contentFilterRepository implements ContentFilterRepository interface
+type contentFilterRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewContentFilterRepository creates a new content filter repository instance
+func NewContentFilterRepository(pool *pgxpool.Pool) ContentFilterRepository {
+	return &contentFilterRepository{pool: pool}
+}
+
+// warningsArray reads a content_warnings JSONB column as text[]; anything but a JSON array
+// (NULL or legacy objects) reads as empty
+func warningsArray(column string) string {
+	return fmt.Sprintf(`CASE WHEN jsonb_typeof(%[1]s) = 'array'
+		THEN ARRAY(SELECT jsonb_array_elements_text(%[1]s)) ELSE '{}'::text[] END`, column)
+}
+
+// ownerTenantColumn is the owning tenant of an ownership-tracked row aliased as the given table
+func ownerTenantColumn(alias string) string {
+	return fmt.Sprintf(`CASE WHEN %[1]s.ownership_type = 'TENANT' THEN %[1]s.primary_owner_id END`, alias)
+}
+
+// contentVisibilityCondition is the SQL form of the HIDE outcomes of the content gate for a work
+// aliased as alias: age gating under a tenant display of HIDE, mature content the viewer hides and
+// never-shown warnings. warningsColumn is the work's content_warnings column, empty when it has none.
+// It returns "" when visibility is nil, otherwise the condition with its arguments appended and the
+// next free placeholder index.
+func contentVisibilityCondition(visibility *d.ContentVisibility, alias, warningsColumn string, args []interface{}, argIndex int) (string, []interface{}, int) {
+	if visibility == nil {
+		return "", args, argIndex
+	}
+
+	// A viewer without a declared age sees what needs at most UnverifiedMaxAge
+	threshold := m.UnverifiedMaxAge
+	if visibility.Age != nil {
+		threshold = *visibility.Age
+	}
+	conditions := []string{fmt.Sprintf(`NOT EXISTS (
+			SELECT 1
+			FROM (VALUES (1)) AS one(x)
+			LEFT JOIN tenant_content_policy p ON p.tenant_id = %[1]s
+			WHERE COALESCE(p.restricted_display, '%[2]s') = '%[2]s'
+			  AND GREATEST(
+				CASE UPPER(TRIM(COALESCE(%[3]s.age_rating, '')))
+					WHEN 'PG-13' THEN COALESCE(p.min_age_pg13, %[4]d)
+					WHEN 'R' THEN COALESCE(p.min_age_r, %[5]d)
+					WHEN 'NC-17' THEN COALESCE(p.min_age_nc17, %[6]d)
+					ELSE 0
+				END,
+				CASE WHEN COALESCE(%[3]s.mature_content, FALSE) THEN COALESCE(p.min_age_mature, %[7]d) ELSE 0 END
+			  ) > $%[8]d
+		)`,
+		ownerTenantColumn(alias), m.RestrictedDisplayHide, alias,
+		m.DefaultMinAgePG13, m.DefaultMinAgeR, m.DefaultMinAgeNC17, m.DefaultMinAgeMature, argIndex)}
+	args = append(args, threshold)
+	argIndex++
+
+	if visibility.HideMature {
+		conditions = append(conditions, fmt.Sprintf("COALESCE(%s.mature_content, FALSE) = FALSE", alias))
+	}
+
+	if warningsColumn != "" && len(visibility.BlockedWarnings) > 0 {
+		conditions = append(conditions, fmt.Sprintf(
+			`NOT (ARRAY(SELECT REPLACE(REPLACE(LOWER(TRIM(w)), ' ', '_'), '-', '_') FROM unnest(%s) AS w) && $%d::text[])`,
+			warningsArray(warningsColumn), argIndex))
+		args = append(args, visibility.BlockedWarnings)
+		argIndex++
+	}
+
+	return strings.Join(conditions, " AND "), args, argIndex
+}
+
+// GetUserFilter reads the user's filters, falling back to the column defaults
+func (r *contentFilterRepository) GetUserFilter(ctx context.Context, userID uuid.UUID) (*m.UserContentFilter, error) {
+	query := `
+		SELECT user_id, hide_mature, blocked_warnings, warned_warnings, restricted_display, created_at, updated_at
+		FROM user_content_filter
+		WHERE user_id = $1`
+
+	var filter m.UserContentFilter
+	err := r.pool.QueryRow(ctx, query, userID).Scan(
+		&filter.UserID, &filter.HideMature, &filter.BlockedWarnings, &filter.WarnedWarnings, &filter.RestrictedDisplay,
+		&filter.CreatedAt, &filter.UpdatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return &m.UserContentFilter{
+				UserID:            userID,
+				BlockedWarnings:   []string{},
+				WarnedWarnings:    []string{},
+				RestrictedDisplay: m.RestrictedDisplayBlur,
+			}, nil
+		}
+		return nil, fmt.Errorf("failed to get content filter: %w", err)
+	}
+
+	return &filter, nil
+}
+
+// UpsertUserFilter writes every field of the filter and fills its timestamps
+func (r *contentFilterRepository) UpsertUserFilter(ctx context.Context, filter *m.UserContentFilter) error {
+	query := `
+		INSERT INTO user_content_filter (user_id, hide_mature, blocked_warnings, warned_warnings, restricted_display)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id) DO UPDATE
+		SET hide_mature = EXCLUDED.hide_mature,
+			blocked_warnings = EXCLUDED.blocked_warnings,
+			warned_warnings = EXCLUDED.warned_warnings,
+			restricted_display = EXCLUDED.restricted_display,
+			updated_at = CURRENT_TIMESTAMP
+		RETURNING created_at, updated_at`
+
+	err := r.pool.QueryRow(ctx, query,
+		filter.UserID, filter.HideMature, filter.BlockedWarnings, filter.WarnedWarnings, filter.RestrictedDisplay,
+	).Scan(&filter.CreatedAt, &filter.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save content filter: %w", err)
+	}
+
+	return nil
+}
+
+// GetTenantPolicies reads the policies of several tenants in one query
+func (r *contentFilterRepository) GetTenantPolicies(ctx context.Context, tenantIDs []uuid.UUID) (map[uuid.UUID]*m.TenantContentPolicy, error) {
+	policies := make(map[uuid.UUID]*m.TenantContentPolicy, len(tenantIDs))
+	if len(tenantIDs) == 0 {
+		return policies, nil
+	}
+
+	query := `
+		SELECT tenant_id, min_age_pg13, min_age_r, min_age_nc17, min_age_mature, restricted_display,
+			updated_by_user_id, created_at, updated_at
+		FROM tenant_content_policy
+		WHERE tenant_id = ANY($1)`
+
+	rows, err := r.pool.Query(ctx, query, tenantIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant content policies: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var policy m.TenantContentPolicy
+		err := rows.Scan(
+			&policy.TenantID, &policy.MinAgePG13, &policy.MinAgeR, &policy.MinAgeNC17, &policy.MinAgeMature,
+			&policy.RestrictedDisplay, &policy.UpdatedByUserID, &policy.CreatedAt, &policy.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan tenant content policy: %w", err)
+		}
+		policies[policy.TenantID] = &policy
+	}
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate tenant content policies: %w", rows.Err())
+	}
+
+	return policies, nil
+}
+
+// UpsertTenantPolicy writes every setting of the policy and fills its timestamps
+func (r *contentFilterRepository) UpsertTenantPolicy(ctx context.Context, policy *m.TenantContentPolicy) error {
+	query := `
+		INSERT INTO tenant_content_policy (tenant_id, min_age_pg13, min_age_r, min_age_nc17, min_age_mature,
+			restricted_display, updated_by_user_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (tenant_id) DO UPDATE
+		SET min_age_pg13 = EXCLUDED.min_age_pg13,
+			min_age_r = EXCLUDED.min_age_r,
+			min_age_nc17 = EXCLUDED.min_age_nc17,
+			min_age_mature = EXCLUDED.min_age_mature,
+			restricted_display = EXCLUDED.restricted_display,
+			updated_by_user_id = EXCLUDED.updated_by_user_id,
+			updated_at = CURRENT_TIMESTAMP
+		RETURNING created_at, updated_at`
+
+	err := r.pool.QueryRow(ctx, query,
+		policy.TenantID, policy.MinAgePG13, policy.MinAgeR, policy.MinAgeNC17, policy.MinAgeMature,
+		policy.RestrictedDisplay, policy.UpdatedByUserID,
+	).Scan(&policy.CreatedAt, &policy.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save tenant content policy: %w", err)
+	}
+
+	return nil
+}
+
+// ListNovelRatings reads the rating facts of a page of novels
+func (r *contentFilterRepository) ListNovelRatings(ctx context.Context, novelIDs []uuid.UUID) (map[uuid.UUID]d.ContentRatingFacts, error) {
+	ratings := make(map[uuid.UUID]d.ContentRatingFacts, len(novelIDs))
+	if len(novelIDs) == 0 {
+		return ratings, nil
+	}
+
+	query := `
+		SELECT n.id, n.age_rating, COALESCE(n.mature_content, FALSE), ` + warningsArray("n.content_warnings") + `,
+			` + ownerTenantColumn("n") + `
+		FROM novel n
+		WHERE n.id = ANY($1) AND n.is_deleted = FALSE`
+
+	rows, err := r.pool.Query(ctx, query, novelIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get novel ratings: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var facts d.ContentRatingFacts
+		if err := rows.Scan(&facts.ID, &facts.AgeRating, &facts.Mature, &facts.Warnings, &facts.OwnerTenantID); err != nil {
+			return nil, fmt.Errorf("failed to scan novel rating: %w", err)
+		}
+		ratings[facts.ID] = facts
+	}
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate novel ratings: %w", rows.Err())
+	}
+
+	return ratings, nil
+}
+
+// GetNovelChapterRating combines the novel's rating with the chapter's mature flag and warnings
+func (r *contentFilterRepository) GetNovelChapterRating(ctx context.Context, chapterID uuid.UUID) (*d.ContentRatingFacts, error) {
+	query := `
+		SELECT c.id, n.age_rating,
+			COALESCE(n.mature_content, FALSE) OR COALESCE(c.has_mature_content, FALSE),
+			ARRAY(
+				SELECT DISTINCT w FROM unnest(` + warningsArray("n.content_warnings") + ` || ` + warningsArray("c.content_warnings") + `) AS w
+			),
+			` + ownerTenantColumn("n") + `
+		FROM novel_chapter c
+		JOIN novel_volume v ON v.id = c.volume_id AND v.is_deleted = FALSE
+		JOIN novel n ON n.id = v.novel_id AND n.is_deleted = FALSE
+		WHERE c.id = $1 AND c.is_deleted = FALSE`
+
+	var facts d.ContentRatingFacts
+	err := r.pool.QueryRow(ctx, query, chapterID).Scan(
+		&facts.ID, &facts.AgeRating, &facts.Mature, &facts.Warnings, &facts.OwnerTenantID,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("chapter not found")
+		}
+		return nil, fmt.Errorf("failed to get chapter rating: %w", err)
+	}
+
+	return &facts, nil
+}
+
+// GetMangaChapterRating reads the manga's rating for a chapter; manga has no content warnings
+func (r *contentFilterRepository) GetMangaChapterRating(ctx context.Context, chapterID uuid.UUID) (*d.ContentRatingFacts, error) {
+	query := `
+		SELECT c.id, mg.age_rating, COALESCE(mg.mature_content, FALSE), ` + ownerTenantColumn("mg") + `
+		FROM manga_chapter c
+		JOIN manga_volume v ON v.id = c.volume_id
+		JOIN manga mg ON mg.id = v.manga_id AND mg.is_deleted = FALSE
+		WHERE c.id = $1`
+
+	return r.getWorkRating(ctx, query, chapterID, "chapter")
+}
+
+// ListMangaRatings reads the rating facts of a page of manga
+func (r *contentFilterRepository) ListMangaRatings(ctx context.Context, mangaIDs []uuid.UUID) (map[uuid.UUID]d.ContentRatingFacts, error) {
+	query := `
+		SELECT mg.id, mg.age_rating, COALESCE(mg.mature_content, FALSE), ` + ownerTenantColumn("mg") + `
+		FROM manga mg
+		WHERE mg.id = ANY($1) AND mg.is_deleted = FALSE`
+
+	return r.listWorkRatings(ctx, query, mangaIDs, "manga")
+}
+
+// GetMangaVolumeRating reads the manga's rating for a volume
+func (r *contentFilterRepository) GetMangaVolumeRating(ctx context.Context, volumeID uuid.UUID) (*d.ContentRatingFacts, error) {
+	query := `
+		SELECT v.id, mg.age_rating, COALESCE(mg.mature_content, FALSE), ` + ownerTenantColumn("mg") + `
+		FROM manga_volume v
+		JOIN manga mg ON mg.id = v.manga_id AND mg.is_deleted = FALSE
+		WHERE v.id = $1`
+
+	return r.getWorkRating(ctx, query, volumeID, "volume")
+}
+
+// ListAnimeRatings reads the rating facts of a page of anime
+func (r *contentFilterRepository) ListAnimeRatings(ctx context.Context, animeIDs []uuid.UUID) (map[uuid.UUID]d.ContentRatingFacts, error) {
+	query := `
+		SELECT a.id, a.age_rating, COALESCE(a.mature_content, FALSE), ` + ownerTenantColumn("a") + `
+		FROM anime a
+		WHERE a.id = ANY($1) AND a.is_deleted = FALSE`
+
+	return r.listWorkRatings(ctx, query, animeIDs, "anime")
+}
+
+// GetAnimeSeasonRating reads the anime's rating for a season
+func (r *contentFilterRepository) GetAnimeSeasonRating(ctx context.Context, seasonID uuid.UUID) (*d.ContentRatingFacts, error) {
+	query := `
+		SELECT s.id, a.age_rating, COALESCE(a.mature_content, FALSE), ` + ownerTenantColumn("a") + `
+		FROM anime_season s
+		JOIN anime a ON a.id = s.anime_id AND a.is_deleted = FALSE
+		WHERE s.id = $1`
+
+	return r.getWorkRating(ctx, query, seasonID, "season")
+}
+
+// GetAnimeEpisodeRating reads the anime's rating for an episode
+func (r *contentFilterRepository) GetAnimeEpisodeRating(ctx context.Context, episodeID uuid.UUID) (*d.ContentRatingFacts, error) {
+	query := `
+		SELECT e.id, a.age_rating, COALESCE(a.mature_content, FALSE), ` + ownerTenantColumn("a") + `
+		FROM anime_episode e
+		JOIN anime_season s ON s.id = e.season_id
+		JOIN anime a ON a.id = s.anime_id AND a.is_deleted = FALSE
+		WHERE e.id = $1`
+
+	return r.getWorkRating(ctx, query, episodeID, "episode")
+}
+
+// listWorkRatings runs a rating query of works without content warnings; query must yield
+// (id, age_rating, mature, owner tenant) and take the IDs as $1
+func (r *contentFilterRepository) listWorkRatings(ctx context.Context, query string, ids []uuid.UUID, entity string) (map[uuid.UUID]d.ContentRatingFacts, error) {
+	ratings := make(map[uuid.UUID]d.ContentRatingFacts, len(ids))
+	if len(ids) == 0 {
+		return ratings, nil
+	}
+
+	rows, err := r.pool.Query(ctx, query, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s ratings: %w", entity, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		facts := d.ContentRatingFacts{Warnings: []string{}}
+		if err := rows.Scan(&facts.ID, &facts.AgeRating, &facts.Mature, &facts.OwnerTenantID); err != nil {
+			return nil, fmt.Errorf("failed to scan %s rating: %w", entity, err)
+		}
+		ratings[facts.ID] = facts
+	}
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate %s ratings: %w", entity, rows.Err())
+	}
+
+	return ratings, nil
+}
+
+// getWorkRating runs a single-row rating query of a work without content warnings, see listWorkRatings
+func (r *contentFilterRepository) getWorkRating(ctx context.Context, query string, id uuid.UUID, entity string) (*d.ContentRatingFacts, error) {
+	facts := d.ContentRatingFacts{Warnings: []string{}}
+	err := r.pool.QueryRow(ctx, query, id).Scan(&facts.ID, &facts.AgeRating, &facts.Mature, &facts.OwnerTenantID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("%s not found", entity)
+		}
+		return nil, fmt.Errorf("failed to get %s rating: %w", entity, err)
+	}
+
+	return &facts, nil
+}
@@ -0,0 +1,360 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+)
+
+// ContentRelationRepository defines data access for relations between works.
+// Every relation is stored twice (source->target and its inverse) and both rows are
+// written and removed together.
+type ContentRelationRepository interface {
+	// ContentExists checks that a work of the given type exists
+	ContentExists(ctx context.Context, contentType m.ContentType, id uuid.UUID) (bool, error)
+	// Create inserts a relation and its inverse
+	Create(ctx context.Context, relation *m.ContentRelation) error
+	// GetByID retrieves a relation by ID
+	GetByID(ctx context.Context, id uuid.UUID) (*m.ContentRelation, error)
+	// ListByContent retrieves the relations of a work, as seen from that work
+	ListByContent(ctx context.Context, contentType m.ContentType, id uuid.UUID) ([]m.ContentRelation, error)
+	// UpdateType changes the type of a relation and of its inverse
+	UpdateType(ctx context.Context, id uuid.UUID, relationType m.ContentRelationType) (*m.ContentRelation, error)
+	// Delete removes a relation and its inverse
+	Delete(ctx context.Context, id uuid.UUID) error
+	// GetGraph walks the relations of a work up to the given depth
+	GetGraph(ctx context.Context, contentType m.ContentType, id uuid.UUID, depth int) (*d.ContentRelationGraphResponse, error)
+}
+
+// contentRelationRepository implements ContentRelationRepository interface
+type contentRelationRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewContentRelationRepository creates a new content relation repository instance
+func NewContentRelationRepository(pool *pgxpool.Pool) ContentRelationRepository {
+	return &contentRelationRepository{pool: pool}
+}
+
+// ContentExists checks the table of the given content type; deleted novels do not count
+func (r *contentRelationRepository) ContentExists(ctx context.Context, contentType m.ContentType, id uuid.UUID) (bool, error) {
+	var query string
+	switch contentType {
+	case m.ContentTypeAnime:
+		query = `SELECT EXISTS(SELECT 1 FROM anime WHERE id = $1 AND is_deleted = FALSE)`
+	case m.ContentTypeManga:
+		query = `SELECT EXISTS(SELECT 1 FROM manga WHERE id = $1 AND is_deleted = FALSE)`
+	case m.ContentTypeNovel:
+		query = `SELECT EXISTS(SELECT 1 FROM novel WHERE id = $1 AND is_deleted = false)`
+	default:
+		return false, fmt.Errorf("invalid content type: %s", contentType)
+	}
+
+	var exists bool
+	if err := r.pool.QueryRow(ctx, query, id).Scan(&exists); err != nil {
+		return false, fmt.Errorf("failed to check content existence: %w", err)
+	}
+	return exists, nil
+}
+
+// Create inserts the relation and its mirrored inverse in one transaction
+func (r *contentRelationRepository) Create(ctx context.Context, relation *m.ContentRelation) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	err = tx.QueryRow(ctx, `
+		INSERT INTO content_relation (source_id, source_type, target_id, target_type, relation_type)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at, updated_at`,
+		relation.SourceID, relation.SourceType, relation.TargetID, relation.TargetType, relation.RelationType,
+	).Scan(&relation.ID, &relation.CreatedAt, &relation.UpdatedAt)
+	if err != nil {
+		if strings.Contains(err.Error(), "content_relation_unique") {
+			return fmt.Errorf("relation already exists")
+		}
+		return fmt.Errorf("failed to create relation: %w", err)
+	}
+
+	if err := insertInverseRelation(ctx, tx, relation.SourceType, relation.SourceID, relation.TargetType, relation.TargetID, relation.RelationType); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a relation by its ID
+func (r *contentRelationRepository) GetByID(ctx context.Context, id uuid.UUID) (*m.ContentRelation, error) {
+	var relation m.ContentRelation
+	err := r.pool.QueryRow(ctx, `
+		SELECT id, source_id, source_type, target_id, target_type, relation_type, created_at, updated_at
+		FROM content_relation
+		WHERE id = $1`, id).Scan(
+		&relation.ID, &relation.SourceID, &relation.SourceType, &relation.TargetID, &relation.TargetType,
+		&relation.RelationType, &relation.CreatedAt, &relation.UpdatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("relation not found")
+		}
+		return nil, fmt.Errorf("failed to get relation: %w", err)
+	}
+
+	return &relation, nil
+}
+
+// ListByContent retrieves the outgoing rows of a work; inverse rows make this complete
+func (r *contentRelationRepository) ListByContent(ctx context.Context, contentType m.ContentType, id uuid.UUID) ([]m.ContentRelation, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT id, source_id, source_type, target_id, target_type, relation_type, created_at, updated_at
+		FROM content_relation
+		WHERE source_type = $1 AND source_id = $2
+		ORDER BY relation_type, created_at`, contentType, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list relations: %w", err)
+	}
+	defer rows.Close()
+
+	relations := make([]m.ContentRelation, 0)
+	for rows.Next() {
+		var relation m.ContentRelation
+		if err := rows.Scan(
+			&relation.ID, &relation.SourceID, &relation.SourceType, &relation.TargetID, &relation.TargetType,
+			&relation.RelationType, &relation.CreatedAt, &relation.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan relation: %w", err)
+		}
+		relations = append(relations, relation)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate relations: %w", rows.Err())
+	}
+
+	return relations, nil
+}
+
+// UpdateType rewrites the relation type and replaces the inverse row accordingly
+func (r *contentRelationRepository) UpdateType(ctx context.Context, id uuid.UUID, relationType m.ContentRelationType) (*m.ContentRelation, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var relation m.ContentRelation
+	err = tx.QueryRow(ctx, `
+		SELECT id, source_id, source_type, target_id, target_type, relation_type, created_at, updated_at
+		FROM content_relation
+		WHERE id = $1
+		FOR UPDATE`, id).Scan(
+		&relation.ID, &relation.SourceID, &relation.SourceType, &relation.TargetID, &relation.TargetType,
+		&relation.RelationType, &relation.CreatedAt, &relation.UpdatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("relation not found")
+		}
+		return nil, fmt.Errorf("failed to get relation: %w", err)
+	}
+
+	if relation.RelationType == relationType {
+		return &relation, nil
+	}
+
+	if err := deleteInverseRelation(ctx, tx, &relation); err != nil {
+		return nil, err
+	}
+
+	err = tx.QueryRow(ctx, `
+		UPDATE content_relation
+		SET relation_type = $2, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1
+		RETURNING updated_at`, id, relationType).Scan(&relation.UpdatedAt)
+	if err != nil {
+		if strings.Contains(err.Error(), "content_relation_unique") {
+			return nil, fmt.Errorf("relation already exists")
+		}
+		return nil, fmt.Errorf("failed to update relation: %w", err)
+	}
+	relation.RelationType = relationType
+
+	if err := insertInverseRelation(ctx, tx, relation.SourceType, relation.SourceID, relation.TargetType, relation.TargetID, relationType); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return &relation, nil
+}
+
+// Delete removes the relation together with its inverse
+func (r *contentRelationRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var relation m.ContentRelation
+	err = tx.QueryRow(ctx, `
+		DELETE FROM content_relation
+		WHERE id = $1
+		RETURNING source_id, source_type, target_id, target_type, relation_type`, id).Scan(
+		&relation.SourceID, &relation.SourceType, &relation.TargetID, &relation.TargetType, &relation.RelationType,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return fmt.Errorf("relation not found")
+		}
+		return fmt.Errorf("failed to delete relation: %w", err)
+	}
+
+	if err := deleteInverseRelation(ctx, tx, &relation); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetGraph walks outgoing relations breadth-first from the root (inverse rows make the
+// walk undirected) and returns every reached work with its distance, plus the relations
+// between reached works in their canonical direction. Only public works are returned or
+// walked through, so deleted, private or restricted works never surface in the graph.
+func (r *contentRelationRepository) GetGraph(ctx context.Context, contentType m.ContentType, id uuid.UUID, depth int) (*d.ContentRelationGraphResponse, error) {
+	rows, err := r.pool.Query(ctx, `
+		WITH RECURSIVE walk(node_type, node_id, depth, path) AS (
+			SELECT $1::content_type_enum, $2::uuid, 0, ARRAY[$2::uuid]
+			UNION ALL
+			SELECT cr.target_type, cr.target_id, w.depth + 1, w.path || cr.target_id
+			FROM walk w
+			JOIN content_relation cr ON cr.source_type = w.node_type AND cr.source_id = w.node_id
+			WHERE w.depth < $3 AND NOT cr.target_id = ANY(w.path)
+			  AND CASE cr.target_type
+			      WHEN 'NOVEL' THEN EXISTS (SELECT 1 FROM novel n WHERE n.id = cr.target_id
+			          AND n.is_deleted = FALSE AND n.is_public = TRUE AND n.access_level = 'PUBLIC')
+			      WHEN 'MANGA' THEN EXISTS (SELECT 1 FROM manga mg WHERE mg.id = cr.target_id
+			          AND mg.is_deleted = FALSE AND mg.is_public = TRUE AND mg.access_level = 'PUBLIC')
+			      WHEN 'ANIME' THEN EXISTS (SELECT 1 FROM anime a WHERE a.id = cr.target_id
+			          AND a.is_deleted = FALSE AND a.is_public = TRUE AND a.access_level = 'PUBLIC')
+			      ELSE FALSE
+			  END
+		),
+		nodes AS (
+			SELECT node_type, node_id, MIN(depth) AS depth
+			FROM walk
+			GROUP BY node_type, node_id
+		)
+		SELECT nodes.node_type, nodes.node_id, nodes.depth,
+			COALESCE(n.name, a.name, mg.name) AS name,
+			COALESCE(n.cover_image, a.cover_image, mg.cover_image) AS cover_image
+		FROM nodes
+		LEFT JOIN novel n ON nodes.node_type = 'NOVEL' AND n.id = nodes.node_id
+			AND n.is_deleted = FALSE AND n.is_public = TRUE AND n.access_level = 'PUBLIC'
+		LEFT JOIN anime a ON nodes.node_type = 'ANIME' AND a.id = nodes.node_id
+			AND a.is_deleted = FALSE AND a.is_public = TRUE AND a.access_level = 'PUBLIC'
+		LEFT JOIN manga mg ON nodes.node_type = 'MANGA' AND mg.id = nodes.node_id
+			AND mg.is_deleted = FALSE AND mg.is_public = TRUE AND mg.access_level = 'PUBLIC'
+		WHERE COALESCE(n.id, a.id, mg.id) IS NOT NULL
+		ORDER BY nodes.depth, name`, contentType, id, depth)
+	if err != nil {
+		return nil, fmt.Errorf("failed to walk relation graph: %w", err)
+	}
+	defer rows.Close()
+
+	graph := &d.ContentRelationGraphResponse{
+		RootID: id.String(),
+		Depth:  depth,
+		Nodes:  make([]d.ContentRelationNode, 0),
+		Edges:  make([]d.ContentRelationEdge, 0),
+	}
+	nodeIDs := make([]uuid.UUID, 0)
+	for rows.Next() {
+		var node d.ContentRelationNode
+		var nodeID uuid.UUID
+		if err := rows.Scan(&node.ContentType, &nodeID, &node.Depth, &node.Name, &node.CoverImage); err != nil {
+			return nil, fmt.Errorf("failed to scan graph node: %w", err)
+		}
+		node.ID = nodeID.String()
+		graph.Nodes = append(graph.Nodes, node)
+		nodeIDs = append(nodeIDs, nodeID)
+	}
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate graph nodes: %w", rows.Err())
+	}
+
+	// Only the forward row of each pair is returned; RELATED is symmetric so the
+	// lower ID is used as source
+	edgeRows, err := r.pool.Query(ctx, `
+		SELECT id, source_id, target_id, relation_type
+		FROM content_relation
+		WHERE source_id = ANY($1) AND target_id = ANY($1)
+		  AND (relation_type IN ('ADAPTATION', 'SEQUEL', 'SPINOFF')
+		       OR (relation_type = 'RELATED' AND source_id < target_id))
+		ORDER BY relation_type, source_id`, nodeIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load graph edges: %w", err)
+	}
+	defer edgeRows.Close()
+
+	for edgeRows.Next() {
+		var edgeID, sourceID, targetID uuid.UUID
+		var edge d.ContentRelationEdge
+		if err := edgeRows.Scan(&edgeID, &sourceID, &targetID, &edge.RelationType); err != nil {
+			return nil, fmt.Errorf("failed to scan graph edge: %w", err)
+		}
+		edge.ID = edgeID.String()
+		edge.SourceID = sourceID.String()
+		edge.TargetID = targetID.String()
+		graph.Edges = append(graph.Edges, edge)
+	}
+	if edgeRows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate graph edges: %w", edgeRows.Err())
+	}
+
+	return graph, nil
+}
+
+// insertInverseRelation writes the mirrored row of a relation
+func insertInverseRelation(ctx context.Context, tx pgx.Tx, sourceType m.ContentType, sourceID uuid.UUID, targetType m.ContentType, targetID uuid.UUID, relationType m.ContentRelationType) error {
+	_, err := tx.Exec(ctx, `
+		INSERT INTO content_relation (source_id, source_type, target_id, target_type, relation_type)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT ON CONSTRAINT content_relation_unique DO NOTHING`,
+		targetID, targetType, sourceID, sourceType, relationType.Inverse())
+	if err != nil {
+		return fmt.Errorf("failed to create inverse relation: %w", err)
+	}
+	return nil
+}
+
+// deleteInverseRelation removes the mirrored row of a relation
+func deleteInverseRelation(ctx context.Context, tx pgx.Tx, relation *m.ContentRelation) error {
+	_, err := tx.Exec(ctx, `
+		DELETE FROM content_relation
+		WHERE source_type = $1 AND source_id = $2 AND target_type = $3 AND target_id = $4 AND relation_type = $5`,
+		relation.TargetType, relation.TargetID, relation.SourceType, relation.SourceID, relation.RelationType.Inverse())
+	if err != nil {
+		return fmt.Errorf("failed to delete inverse relation: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,243 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	m "wibusystem/pkg/common/model"
+)
+
+// ContentWarningRepository defines data access for the managed content warning taxonomy
+type ContentWarningRepository interface {
+	// List returns the taxonomy with every translation, in display order
+	List(ctx context.Context, includeInactive bool) ([]*m.ContentWarning, error)
+	// GetByCode returns one warning with every translation
+	GetByCode(ctx context.Context, code string) (*m.ContentWarning, error)
+	// Create inserts a warning with its translations
+	Create(ctx context.Context, warning *m.ContentWarning) error
+	// Update writes a warning's settings; translations are replaced when replaceTranslations is set
+	Update(ctx context.Context, warning *m.ContentWarning, replaceTranslations bool) error
+	// GetStatuses reports which of the codes exist and whether each is active
+	GetStatuses(ctx context.Context, codes []string) (map[string]bool, error)
+}
+
+// contentWarningRepository implements ContentWarningRepository interface
+type contentWarningRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewContentWarningRepository creates a new content warning repository instance
+func NewContentWarningRepository(pool *pgxpool.Pool) ContentWarningRepository {
+	return &contentWarningRepository{pool: pool}
+}
+
+// List reads the warnings, then their translations in a second query
+func (r *contentWarningRepository) List(ctx context.Context, includeInactive bool) ([]*m.ContentWarning, error) {
+	query := `
+		SELECT code, severity, sort_order, is_active, created_at, updated_at
+		FROM content_warning
+		WHERE $1 OR is_active
+		ORDER BY sort_order, code`
+
+	rows, err := r.pool.Query(ctx, query, includeInactive)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list content warnings: %w", err)
+	}
+	defer rows.Close()
+
+	var warnings []*m.ContentWarning
+	byCode := make(map[string]*m.ContentWarning)
+	for rows.Next() {
+		var warning m.ContentWarning
+		err := rows.Scan(&warning.Code, &warning.Severity, &warning.SortOrder, &warning.IsActive,
+			&warning.CreatedAt, &warning.UpdatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan content warning: %w", err)
+		}
+		warnings = append(warnings, &warning)
+		byCode[warning.Code] = &warning
+	}
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate content warnings: %w", rows.Err())
+	}
+
+	if len(warnings) == 0 {
+		return warnings, nil
+	}
+	codes := make([]string, 0, len(warnings))
+	for _, warning := range warnings {
+		codes = append(codes, warning.Code)
+	}
+	if err := r.loadTranslations(ctx, codes, byCode); err != nil {
+		return nil, err
+	}
+
+	return warnings, nil
+}
+
+// GetByCode reads one warning and its translations
+func (r *contentWarningRepository) GetByCode(ctx context.Context, code string) (*m.ContentWarning, error) {
+	query := `
+		SELECT code, severity, sort_order, is_active, created_at, updated_at
+		FROM content_warning
+		WHERE code = $1`
+
+	var warning m.ContentWarning
+	err := r.pool.QueryRow(ctx, query, code).Scan(&warning.Code, &warning.Severity, &warning.SortOrder,
+		&warning.IsActive, &warning.CreatedAt, &warning.UpdatedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("content warning not found")
+		}
+		return nil, fmt.Errorf("failed to get content warning: %w", err)
+	}
+
+	if err := r.loadTranslations(ctx, []string{code}, map[string]*m.ContentWarning{code: &warning}); err != nil {
+		return nil, err
+	}
+	return &warning, nil
+}
+
+// Create inserts the warning and its translations in one transaction
+func (r *contentWarningRepository) Create(ctx context.Context, warning *m.ContentWarning) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	err = tx.QueryRow(ctx, `
+		INSERT INTO content_warning (code, severity, sort_order, is_active)
+		VALUES ($1, $2, $3, TRUE)
+		RETURNING is_active, created_at, updated_at
+	`, warning.Code, warning.Severity, warning.SortOrder).Scan(&warning.IsActive, &warning.CreatedAt, &warning.UpdatedAt)
+	if err != nil {
+		if strings.Contains(err.Error(), "duplicate key") {
+			return fmt.Errorf("content warning already exists: %q", warning.Code)
+		}
+		return fmt.Errorf("failed to create content warning: %w", err)
+	}
+
+	if err := insertContentWarningTranslations(ctx, tx, warning); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// Update writes the warning's settings and optionally replaces its translations
+func (r *contentWarningRepository) Update(ctx context.Context, warning *m.ContentWarning, replaceTranslations bool) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	err = tx.QueryRow(ctx, `
+		UPDATE content_warning
+		SET severity = $2, sort_order = $3, is_active = $4, updated_at = CURRENT_TIMESTAMP
+		WHERE code = $1
+		RETURNING updated_at
+	`, warning.Code, warning.Severity, warning.SortOrder, warning.IsActive).Scan(&warning.UpdatedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return fmt.Errorf("content warning not found")
+		}
+		return fmt.Errorf("failed to update content warning: %w", err)
+	}
+
+	if replaceTranslations {
+		if _, err := tx.Exec(ctx, `DELETE FROM content_warning_translation WHERE warning_code = $1`, warning.Code); err != nil {
+			return fmt.Errorf("failed to clear content warning translations: %w", err)
+		}
+		if err := insertContentWarningTranslations(ctx, tx, warning); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetStatuses looks up the codes; codes missing from the result are not in the taxonomy
+func (r *contentWarningRepository) GetStatuses(ctx context.Context, codes []string) (map[string]bool, error) {
+	statuses := make(map[string]bool, len(codes))
+	if len(codes) == 0 {
+		return statuses, nil
+	}
+
+	rows, err := r.pool.Query(ctx, `SELECT code, is_active FROM content_warning WHERE code = ANY($1)`, codes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up content warnings: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var code string
+		var active bool
+		if err := rows.Scan(&code, &active); err != nil {
+			return nil, fmt.Errorf("failed to scan content warning: %w", err)
+		}
+		statuses[code] = active
+	}
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate content warnings: %w", rows.Err())
+	}
+
+	return statuses, nil
+}
+
+// loadTranslations attaches the translations of the given warnings, ordered by language
+func (r *contentWarningRepository) loadTranslations(ctx context.Context, codes []string, byCode map[string]*m.ContentWarning) error {
+	rows, err := r.pool.Query(ctx, `
+		SELECT warning_code, language_code, label, description
+		FROM content_warning_translation
+		WHERE warning_code = ANY($1)
+		ORDER BY warning_code, language_code
+	`, codes)
+	if err != nil {
+		return fmt.Errorf("failed to get content warning translations: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var code string
+		var translation m.ContentWarningTranslation
+		if err := rows.Scan(&code, &translation.LanguageCode, &translation.Label, &translation.Description); err != nil {
+			return fmt.Errorf("failed to scan content warning translation: %w", err)
+		}
+		if warning, ok := byCode[code]; ok {
+			warning.Translations = append(warning.Translations, translation)
+		}
+	}
+	if rows.Err() != nil {
+		return fmt.Errorf("failed to iterate content warning translations: %w", rows.Err())
+	}
+
+	return nil
+}
+
+// insertContentWarningTranslations inserts every translation of the warning inside tx
+func insertContentWarningTranslations(ctx context.Context, tx pgx.Tx, warning *m.ContentWarning) error {
+	for _, translation := range warning.Translations {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO content_warning_translation (warning_code, language_code, label, description)
+			VALUES ($1, $2, $3, $4)
+		`, warning.Code, translation.LanguageCode, translation.Label, translation.Description)
+		if err != nil {
+			return fmt.Errorf("failed to save content warning translation: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,235 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	d "wibusystem/pkg/common/dto"
+)
+
+// volumeCounterFields lists the novel_volume counters in the order ReconcileNovels returns them
+var volumeCounterFields = []string{"chapter_count", "word_count", "estimated_reading_time"}
+
+// novelCounterFields lists the novel counters in the order ReconcileNovels returns them
+// Views, likes and ratings are left alone: novel_daily_stats only holds views and bookmarks recorded
+// since it was introduced, so summing it would wipe the older totals
+var novelCounterFields = []string{
+	"total_volumes", "total_chapters", "word_count", "estimated_reading_time", "bookmark_count",
+}
+
+// CounterRepository recomputes the denormalized counters of novels and volumes from their source rows
+type CounterRepository interface {
+	// ListNovelIDs returns up to limit novel IDs ordered by ID, starting after the cursor when given
+	ListNovelIDs(ctx context.Context, after *uuid.UUID, limit int) ([]uuid.UUID, error)
+	// ReconcileNovels rewrites the counters of the novels and their volumes that drifted and
+	// returns how many of the novels exist together with every corrected value
+	ReconcileNovels(ctx context.Context, novelIDs []uuid.UUID) (int, []d.CounterDrift, error)
+}
+
+// counterRepository implements CounterRepository interface
+type counterRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewCounterRepository creates a new counter repository instance
+func NewCounterRepository(pool *pgxpool.Pool) CounterRepository {
+	return &counterRepository{pool: pool}
+}
+
+// ListNovelIDs pages through novels with a keyset cursor so batches stay cheap on large tables
+func (r *counterRepository) ListNovelIDs(ctx context.Context, after *uuid.UUID, limit int) ([]uuid.UUID, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT id FROM novel
+		WHERE $1::uuid IS NULL OR id > $1
+		ORDER BY id
+		LIMIT $2`, after, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list novel IDs: %w", err)
+	}
+	defer rows.Close()
+
+	ids := make([]uuid.UUID, 0, limit)
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan novel ID: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate novel IDs: %w", rows.Err())
+	}
+
+	return ids, nil
+}
+
+// ReconcileNovels recomputes the batch in one transaction. Volumes are fixed first from their
+// live chapters, then novels from volumes, chapters and bookmarks.
+// Only rows whose stored value differs are updated; the joined prev row still holds the value
+// read before the update, which is what the drift report shows.
+func (r *counterRepository) ReconcileNovels(ctx context.Context, novelIDs []uuid.UUID) (int, []d.CounterDrift, error) {
+	if len(novelIDs) == 0 {
+		return 0, nil, nil
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var checked int
+	if err := tx.QueryRow(ctx, `SELECT COUNT(*) FROM novel WHERE id = ANY($1)`, novelIDs).Scan(&checked); err != nil {
+		return 0, nil, fmt.Errorf("failed to count novels: %w", err)
+	}
+
+	rows, err := tx.Query(ctx, `
+		WITH computed AS (
+			SELECT v.id,
+				COUNT(c.id) AS chapter_count,
+				COALESCE(SUM(c.word_count), 0) AS word_count,
+				COALESCE(SUM(c.reading_time_minutes), 0) AS reading_time
+			FROM novel_volume v
+			LEFT JOIN novel_chapter c ON c.volume_id = v.id AND c.is_deleted = FALSE
+			WHERE v.novel_id = ANY($1) AND v.is_deleted = FALSE
+			GROUP BY v.id
+		)
+		UPDATE novel_volume v
+		SET chapter_count = computed.chapter_count,
+			word_count = computed.word_count,
+			estimated_reading_time = computed.reading_time,
+			updated_at = CURRENT_TIMESTAMP
+		FROM computed
+		JOIN novel_volume prev ON prev.id = computed.id
+		WHERE v.id = computed.id
+		  AND (v.chapter_count IS DISTINCT FROM computed.chapter_count
+			OR v.word_count IS DISTINCT FROM computed.word_count
+			OR v.estimated_reading_time IS DISTINCT FROM computed.reading_time)
+		RETURNING v.id, v.novel_id,
+			prev.chapter_count::float8, v.chapter_count::float8,
+			prev.word_count::float8, v.word_count::float8,
+			prev.estimated_reading_time::float8, v.estimated_reading_time::float8`, novelIDs)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to reconcile volume counters: %w", err)
+	}
+	drifts, err := scanCounterDrifts(rows, d.CounterEntityVolume, volumeCounterFields)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to reconcile volume counters: %w", err)
+	}
+
+	rows, err = tx.Query(ctx, `
+		WITH computed AS (
+			SELECT n.id,
+				vol.total_volumes,
+				ch.total_chapters,
+				COALESCE(ch.word_count, 0) AS word_count,
+				COALESCE(ch.reading_time, 0) AS reading_time,
+				bm.bookmark_count
+			FROM novel n
+			CROSS JOIN LATERAL (
+				SELECT COUNT(*) AS total_volumes
+				FROM novel_volume v
+				WHERE v.novel_id = n.id AND v.is_deleted = FALSE
+			) vol
+			CROSS JOIN LATERAL (
+				SELECT COUNT(*) AS total_chapters,
+					SUM(c.word_count) AS word_count,
+					SUM(c.reading_time_minutes) AS reading_time
+				FROM novel_volume v
+				JOIN novel_chapter c ON c.volume_id = v.id AND c.is_deleted = FALSE
+				WHERE v.novel_id = n.id AND v.is_deleted = FALSE
+			) ch
+			CROSS JOIN LATERAL (
+				SELECT COUNT(*) AS bookmark_count FROM novel_bookmark b WHERE b.novel_id = n.id
+			) bm
+			WHERE n.id = ANY($1)
+		)
+		UPDATE novel n
+		SET total_volumes = computed.total_volumes,
+			total_chapters = computed.total_chapters,
+			word_count = computed.word_count,
+			estimated_reading_time = computed.reading_time,
+			bookmark_count = computed.bookmark_count,
+			updated_at = CURRENT_TIMESTAMP
+		FROM computed
+		JOIN novel prev ON prev.id = computed.id
+		WHERE n.id = computed.id
+		  AND (n.total_volumes IS DISTINCT FROM computed.total_volumes
+			OR n.total_chapters IS DISTINCT FROM computed.total_chapters
+			OR n.word_count IS DISTINCT FROM computed.word_count
+			OR n.estimated_reading_time IS DISTINCT FROM computed.reading_time
+			OR n.bookmark_count IS DISTINCT FROM computed.bookmark_count)
+		RETURNING n.id, n.id,
+			prev.total_volumes::float8, n.total_volumes::float8,
+			prev.total_chapters::float8, n.total_chapters::float8,
+			prev.word_count::float8, n.word_count::float8,
+			prev.estimated_reading_time::float8, n.estimated_reading_time::float8,
+			prev.bookmark_count::float8, n.bookmark_count::float8`, novelIDs)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to reconcile novel counters: %w", err)
+	}
+	novelDrifts, err := scanCounterDrifts(rows, d.CounterEntityNovel, novelCounterFields)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to reconcile novel counters: %w", err)
+	}
+	drifts = append(drifts, novelDrifts...)
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return checked, drifts, nil
+}
+
+// scanCounterDrifts reads rows of (id, novel_id, previous, current, previous, current, ...) in
+// the order of fields and keeps only the values that changed
+func scanCounterDrifts(rows pgx.Rows, entityType string, fields []string) ([]d.CounterDrift, error) {
+	defer rows.Close()
+
+	drifts := make([]d.CounterDrift, 0)
+	for rows.Next() {
+		var entityID, novelID uuid.UUID
+		values := make([]*float64, len(fields)*2)
+		dest := []interface{}{&entityID, &novelID}
+		for i := range values {
+			dest = append(dest, &values[i])
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, fmt.Errorf("failed to scan counter drift: %w", err)
+		}
+
+		for i, field := range fields {
+			previous, current := values[i*2], values[i*2+1]
+			if sameCounterValue(previous, current) {
+				continue
+			}
+			drifts = append(drifts, d.CounterDrift{
+				EntityType: entityType,
+				EntityID:   entityID.String(),
+				NovelID:    novelID.String(),
+				Field:      field,
+				Previous:   previous,
+				Current:    current,
+			})
+		}
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate counter drifts: %w", rows.Err())
+	}
+
+	return drifts, nil
+}
+
+// sameCounterValue reports whether two nullable counter values are equal
+func sameCounterValue(a, b *float64) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
@@ -0,0 +1,269 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+)
+
+// creatorClaimColumns lists the creator_claim columns read into m.CreatorClaim, joined with the creator name
+const creatorClaimColumns = `
+	cc.id, cc.creator_id, c.name, cc.user_id, cc.tenant_id, cc.evidence,
+	cc.status, cc.rejection_reason, cc.reviewer_id, cc.reviewed_at,
+	cc.created_at, cc.updated_at`
+
+// CreatorClaimRepository defines data access for creator page claims and their review
+type CreatorClaimRepository interface {
+	// Create stores a new pending claim
+	Create(ctx context.Context, claim *m.CreatorClaim) error
+	// GetByID retrieves a claim by ID
+	GetByID(ctx context.Context, id uuid.UUID) (*m.CreatorClaim, error)
+	// List retrieves a page of claims; userID restricts to one claimant
+	List(ctx context.Context, req d.ListCreatorClaimsRequest, userID *uuid.UUID) ([]m.CreatorClaim, *d.PaginationMeta, error)
+	// Approve links the creator to the claimant or their tenant and closes competing claims
+	Approve(ctx context.Context, id, reviewerID uuid.UUID) (*m.CreatorClaim, error)
+	// Reject closes a pending claim with a reason
+	Reject(ctx context.Context, id, reviewerID uuid.UUID, reason string) error
+}
+
+// creatorClaimRepository implements CreatorClaimRepository interface
+type creatorClaimRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewCreatorClaimRepository creates a new creator claim repository instance
+func NewCreatorClaimRepository(pool *pgxpool.Pool) CreatorClaimRepository {
+	return &creatorClaimRepository{pool: pool}
+}
+
+// Create inserts a pending claim and fills its ID, status and timestamps
+func (r *creatorClaimRepository) Create(ctx context.Context, claim *m.CreatorClaim) error {
+	query := `
+		INSERT INTO creator_claim (creator_id, user_id, tenant_id, evidence)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, status, created_at, updated_at`
+
+	err := r.pool.QueryRow(ctx, query, claim.CreatorID, claim.UserID, claim.TenantID, claim.Evidence).
+		Scan(&claim.ID, &claim.Status, &claim.CreatedAt, &claim.UpdatedAt)
+	if err != nil {
+		if strings.Contains(err.Error(), "duplicate key") {
+			return fmt.Errorf("creator claim already pending")
+		}
+		if strings.Contains(err.Error(), "foreign key") {
+			return fmt.Errorf("creator not found")
+		}
+		return fmt.Errorf("failed to create creator claim: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a claim by its ID
+func (r *creatorClaimRepository) GetByID(ctx context.Context, id uuid.UUID) (*m.CreatorClaim, error) {
+	row := r.pool.QueryRow(ctx, `
+		SELECT `+creatorClaimColumns+`
+		FROM creator_claim cc
+		JOIN creator c ON c.id = cc.creator_id
+		WHERE cc.id = $1`, id)
+
+	claim, err := scanCreatorClaim(row)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("creator claim not found")
+		}
+		return nil, fmt.Errorf("failed to get creator claim: %w", err)
+	}
+
+	return claim, nil
+}
+
+// List retrieves claims, oldest first for the review queue
+func (r *creatorClaimRepository) List(ctx context.Context, req d.ListCreatorClaimsRequest, userID *uuid.UUID) ([]m.CreatorClaim, *d.PaginationMeta, error) {
+	// Set pagination defaults
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 20
+	}
+	if req.PageSize > 100 {
+		req.PageSize = 100
+	}
+
+	conditions := []string{}
+	args := []interface{}{}
+	argIndex := 1
+
+	if req.Status != "" {
+		conditions = append(conditions, fmt.Sprintf("cc.status = $%d", argIndex))
+		args = append(args, req.Status)
+		argIndex++
+	}
+
+	if userID != nil {
+		conditions = append(conditions, fmt.Sprintf("cc.user_id = $%d", argIndex))
+		args = append(args, *userID)
+		argIndex++
+	}
+
+	whereClause := ""
+	if len(conditions) > 0 {
+		whereClause = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	var total int64
+	if err := r.pool.QueryRow(ctx, `SELECT COUNT(*) FROM creator_claim cc `+whereClause, args...).Scan(&total); err != nil {
+		return nil, nil, fmt.Errorf("failed to count creator claims: %w", err)
+	}
+
+	offset := (req.Page - 1) * req.PageSize
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM creator_claim cc
+		JOIN creator c ON c.id = cc.creator_id
+		%s
+		ORDER BY cc.created_at ASC
+		LIMIT $%d OFFSET $%d`, creatorClaimColumns, whereClause, argIndex, argIndex+1)
+	args = append(args, req.PageSize, offset)
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list creator claims: %w", err)
+	}
+	defer rows.Close()
+
+	claims := make([]m.CreatorClaim, 0)
+	for rows.Next() {
+		claim, err := scanCreatorClaim(rows)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to scan creator claim: %w", err)
+		}
+		claims = append(claims, *claim)
+	}
+
+	if rows.Err() != nil {
+		return nil, nil, fmt.Errorf("failed to iterate creator claims: %w", rows.Err())
+	}
+
+	totalPages := int((total + int64(req.PageSize) - 1) / int64(req.PageSize))
+
+	return claims, &d.PaginationMeta{
+		Page:        req.Page,
+		PageSize:    req.PageSize,
+		Total:       total,
+		TotalPages:  totalPages,
+		HasNext:     req.Page < totalPages,
+		HasPrevious: req.Page > 1,
+	}, nil
+}
+
+// Approve links the creator in one transaction: the claim and creator rows are locked,
+// the creator gets the claimant's account (or tenant) and every other pending claim
+// on the same creator is rejected since the page now has an owner.
+func (r *creatorClaimRepository) Approve(ctx context.Context, id, reviewerID uuid.UUID) (*m.CreatorClaim, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	row := tx.QueryRow(ctx, `
+		SELECT `+creatorClaimColumns+`
+		FROM creator_claim cc
+		JOIN creator c ON c.id = cc.creator_id
+		WHERE cc.id = $1
+		FOR UPDATE OF cc, c`, id)
+	claim, err := scanCreatorClaim(row)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("creator claim not found")
+		}
+		return nil, fmt.Errorf("failed to get creator claim: %w", err)
+	}
+
+	if claim.Status != m.CreatorClaimPending {
+		return nil, fmt.Errorf("creator claim already reviewed")
+	}
+
+	tag, err := tx.Exec(ctx, `
+		UPDATE creator
+		SET user_id = CASE WHEN $3::uuid IS NULL THEN $2::uuid END,
+			tenant_id = $3, claimed_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND user_id IS NULL AND tenant_id IS NULL`, claim.CreatorID, claim.UserID, claim.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to link creator: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, fmt.Errorf("creator already claimed")
+	}
+
+	err = tx.QueryRow(ctx, `
+		UPDATE creator_claim
+		SET status = 'approved', reviewer_id = $2, reviewed_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1
+		RETURNING status, reviewer_id, reviewed_at, updated_at`, id, reviewerID).
+		Scan(&claim.Status, &claim.ReviewerID, &claim.ReviewedAt, &claim.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to approve creator claim: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE creator_claim
+		SET status = 'rejected', rejection_reason = 'Creator was claimed by another account',
+			reviewer_id = $3, reviewed_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+		WHERE creator_id = $1 AND id <> $2 AND status = 'pending'`, claim.CreatorID, id, reviewerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to close competing creator claims: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return claim, nil
+}
+
+// Reject marks a pending claim as rejected
+func (r *creatorClaimRepository) Reject(ctx context.Context, id, reviewerID uuid.UUID, reason string) error {
+	tag, err := r.pool.Exec(ctx, `
+		UPDATE creator_claim
+		SET status = 'rejected', rejection_reason = $3, reviewer_id = $2, reviewed_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND status = 'pending'`, id, reviewerID, reason)
+	if err != nil {
+		return fmt.Errorf("failed to reject creator claim: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		var exists bool
+		if err := r.pool.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM creator_claim WHERE id = $1)`, id).Scan(&exists); err != nil {
+			return fmt.Errorf("failed to check creator claim: %w", err)
+		}
+		if !exists {
+			return fmt.Errorf("creator claim not found")
+		}
+		return fmt.Errorf("creator claim already reviewed")
+	}
+
+	return nil
+}
+
+// scanCreatorClaim reads a row selected with creatorClaimColumns
+func scanCreatorClaim(row pgx.Row) (*m.CreatorClaim, error) {
+	var claim m.CreatorClaim
+	err := row.Scan(
+		&claim.ID, &claim.CreatorID, &claim.CreatorName, &claim.UserID, &claim.TenantID, &claim.Evidence,
+		&claim.Status, &claim.RejectionReason, &claim.ReviewerID, &claim.ReviewedAt,
+		&claim.CreatedAt, &claim.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &claim, nil
+}
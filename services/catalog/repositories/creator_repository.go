@@ -6,9 +6,11 @@ import (
 	"strings"
 	"time"
 
+	d "wibusystem/pkg/common/dto"
 	m "wibusystem/pkg/common/model"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -24,6 +26,21 @@ type CreatorRepository interface {
 	// Query methods for novel relations
 	GetCreatorsByNovelID(ctx context.Context, novelID uuid.UUID) ([]m.CreatorWithRole, error)
 	GetCreatorsByNovelIDs(ctx context.Context, novelIDs []uuid.UUID) (map[uuid.UUID][]m.CreatorWithRole, error)
+
+	// Profile methods
+	GetAliases(ctx context.Context, creatorID uuid.UUID) ([]m.CreatorAlias, error)
+	GetBiographies(ctx context.Context, creatorID uuid.UUID) ([]m.CreatorBiography, error)
+	// UpdateProfile writes the description and social links; biographies and aliases are replaced when not nil
+	UpdateProfile(ctx context.Context, creator *m.Creator, biographies []m.CreatorBiography, aliases []m.CreatorAlias) error
+	ListWorks(ctx context.Context, creatorID uuid.UUID, req d.ListCreatorWorksRequest) ([]d.CreatorWork, int64, error)
+
+	// Duplicate handling
+	// FindDuplicates returns creators whose name or alias matches any of names, strongest match first
+	FindDuplicates(ctx context.Context, names []string, excludeID *uuid.UUID, limit int) ([]d.DuplicateCandidate, error)
+	// GetRedirect returns the creator a merged creator ID now points to
+	GetRedirect(ctx context.Context, oldID uuid.UUID) (*uuid.UUID, error)
+	// Merge moves every link of the source creator to the target and deletes the source
+	Merge(ctx context.Context, sourceID, targetID, mergedBy uuid.UUID) (*d.CreatorMergeResponse, error)
 }
 
 type creatorRepository struct {
@@ -35,6 +52,29 @@ func NewCreatorRepository(pool *pgxpool.Pool) CreatorRepository {
 	return &creatorRepository{pool: pool}
 }
 
+// creatorColumns lists the creator page columns read by scanCreator
+const creatorColumns = `id, name, description, user_id, tenant_id, claimed_at, social_links, created_at, updated_at`
+
+// scanCreator reads a row selected with creatorColumns
+func scanCreator(row pgx.Row) (*m.Creator, error) {
+	var creator m.Creator
+	err := row.Scan(
+		&creator.ID,
+		&creator.Name,
+		&creator.Description,
+		&creator.UserID,
+		&creator.TenantID,
+		&creator.ClaimedAt,
+		&creator.SocialLinks,
+		&creator.CreatedAt,
+		&creator.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &creator, nil
+}
+
 // Create inserts a new creator and returns the assigned ID and timestamps
 func (r *creatorRepository) Create(ctx context.Context, creator *m.Creator) error {
 	query := `
@@ -59,49 +99,33 @@ func (r *creatorRepository) Create(ctx context.Context, creator *m.Creator) erro
 // GetByID retrieves a creator by its ID
 func (r *creatorRepository) GetByID(ctx context.Context, id uuid.UUID) (*m.Creator, error) {
 	query := `
-		SELECT id, name, description, created_at, updated_at
+		SELECT ` + creatorColumns + `
 		FROM creator
 		WHERE id = $1
 	`
 
-	var creator m.Creator
-	err := r.pool.QueryRow(ctx, query, id).Scan(
-		&creator.ID,
-		&creator.Name,
-		&creator.Description,
-		&creator.CreatedAt,
-		&creator.UpdatedAt,
-	)
-
+	creator, err := scanCreator(r.pool.QueryRow(ctx, query, id))
 	if err != nil {
 		return nil, fmt.Errorf("failed to get creator by ID: %w", err)
 	}
 
-	return &creator, nil
+	return creator, nil
 }
 
 // GetByName retrieves a creator by its name
 func (r *creatorRepository) GetByName(ctx context.Context, name string) (*m.Creator, error) {
 	query := `
-		SELECT id, name, description, created_at, updated_at
+		SELECT ` + creatorColumns + `
 		FROM creator
 		WHERE LOWER(name) = LOWER($1)
 	`
 
-	var creator m.Creator
-	err := r.pool.QueryRow(ctx, query, name).Scan(
-		&creator.ID,
-		&creator.Name,
-		&creator.Description,
-		&creator.CreatedAt,
-		&creator.UpdatedAt,
-	)
-
+	creator, err := scanCreator(r.pool.QueryRow(ctx, query, name))
 	if err != nil {
 		return nil, fmt.Errorf("failed to get creator by name: %w", err)
 	}
 
-	return &creator, nil
+	return creator, nil
 }
 
 // Update modifies an existing creator
@@ -152,12 +176,14 @@ func (r *creatorRepository) List(ctx context.Context, limit, offset int, search
 	args := []interface{}{limit, offset}
 
 	if search != "" {
-		whereClause = "WHERE LOWER(name) LIKE LOWER($3) OR LOWER(description) LIKE LOWER($3)"
+		// Pen names and alternative names find the creator too
+		whereClause = `WHERE LOWER(name) LIKE LOWER($3) OR LOWER(description) LIKE LOWER($3)
+			OR EXISTS (SELECT 1 FROM creator_alias ca WHERE ca.creator_id = creator.id AND LOWER(ca.alias) LIKE LOWER($3))`
 		args = append(args, "%"+strings.ToLower(search)+"%")
 	}
 
 	// Count query
-	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM creator %s", whereClause)
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM creator %s", strings.ReplaceAll(whereClause, "$3", "$1"))
 	var countArgs []interface{}
 	if search != "" {
 		countArgs = []interface{}{"%"+strings.ToLower(search)+"%"}
@@ -170,12 +196,12 @@ func (r *creatorRepository) List(ctx context.Context, limit, offset int, search
 
 	// Data query
 	query := fmt.Sprintf(`
-		SELECT id, name, description, created_at, updated_at
+		SELECT %s
 		FROM creator
 		%s
 		ORDER BY name ASC
 		LIMIT $1 OFFSET $2
-	`, whereClause)
+	`, creatorColumns, whereClause)
 
 	rows, err := r.pool.Query(ctx, query, args...)
 	if err != nil {
@@ -184,18 +210,11 @@ func (r *creatorRepository) List(ctx context.Context, limit, offset int, search
 	defer rows.Close()
 
 	for rows.Next() {
-		var creator m.Creator
-		err := rows.Scan(
-			&creator.ID,
-			&creator.Name,
-			&creator.Description,
-			&creator.CreatedAt,
-			&creator.UpdatedAt,
-		)
+		creator, err := scanCreator(rows)
 		if err != nil {
 			return nil, 0, fmt.Errorf("failed to scan creator: %w", err)
 		}
-		creators = append(creators, &creator)
+		creators = append(creators, creator)
 	}
 
 	if rows.Err() != nil {
@@ -290,4 +309,459 @@ func (r *creatorRepository) GetCreatorsByNovelIDs(ctx context.Context, novelIDs
 	}
 
 	return result, nil
-}
\ No newline at end of file
+}
+// GetAliases retrieves the pen names and alternative names of a creator
+func (r *creatorRepository) GetAliases(ctx context.Context, creatorID uuid.UUID) ([]m.CreatorAlias, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT creator_id, alias, alias_type, language_code
+		FROM creator_alias
+		WHERE creator_id = $1
+		ORDER BY alias_type DESC, alias ASC
+	`, creatorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get creator aliases: %w", err)
+	}
+	defer rows.Close()
+
+	aliases := []m.CreatorAlias{}
+	for rows.Next() {
+		var alias m.CreatorAlias
+		if err := rows.Scan(&alias.CreatorID, &alias.Alias, &alias.AliasType, &alias.LanguageCode); err != nil {
+			return nil, fmt.Errorf("failed to scan creator alias: %w", err)
+		}
+		aliases = append(aliases, alias)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate creator aliases: %w", rows.Err())
+	}
+
+	return aliases, nil
+}
+
+// GetBiographies retrieves the localized biographies of a creator
+func (r *creatorRepository) GetBiographies(ctx context.Context, creatorID uuid.UUID) ([]m.CreatorBiography, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT creator_id, language_code, biography, updated_at
+		FROM creator_translation
+		WHERE creator_id = $1
+		ORDER BY language_code ASC
+	`, creatorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get creator biographies: %w", err)
+	}
+	defer rows.Close()
+
+	biographies := []m.CreatorBiography{}
+	for rows.Next() {
+		var biography m.CreatorBiography
+		if err := rows.Scan(&biography.CreatorID, &biography.LanguageCode, &biography.Biography, &biography.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan creator biography: %w", err)
+		}
+		biographies = append(biographies, biography)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate creator biographies: %w", rows.Err())
+	}
+
+	return biographies, nil
+}
+
+// UpdateProfile writes the editable profile of a creator in one transaction
+func (r *creatorRepository) UpdateProfile(ctx context.Context, creator *m.Creator, biographies []m.CreatorBiography, aliases []m.CreatorAlias) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	err = tx.QueryRow(ctx, `
+		UPDATE creator
+		SET description = $2, social_links = $3, updated_at = NOW()
+		WHERE id = $1
+		RETURNING updated_at
+	`, creator.ID, creator.Description, creator.SocialLinks).Scan(&creator.UpdatedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return fmt.Errorf("creator not found")
+		}
+		return fmt.Errorf("failed to update creator profile: %w", err)
+	}
+
+	if biographies != nil {
+		if _, err := tx.Exec(ctx, `DELETE FROM creator_translation WHERE creator_id = $1`, creator.ID); err != nil {
+			return fmt.Errorf("failed to clear creator biographies: %w", err)
+		}
+		for _, biography := range biographies {
+			_, err := tx.Exec(ctx, `
+				INSERT INTO creator_translation (creator_id, language_code, biography)
+				VALUES ($1, $2, $3)
+			`, creator.ID, biography.LanguageCode, biography.Biography)
+			if err != nil {
+				if strings.Contains(err.Error(), "duplicate key") {
+					return fmt.Errorf("biography for language %s already exists", biography.LanguageCode)
+				}
+				return fmt.Errorf("failed to insert creator biography: %w", err)
+			}
+		}
+	}
+
+	if aliases != nil {
+		if _, err := tx.Exec(ctx, `DELETE FROM creator_alias WHERE creator_id = $1`, creator.ID); err != nil {
+			return fmt.Errorf("failed to clear creator aliases: %w", err)
+		}
+		for _, alias := range aliases {
+			_, err := tx.Exec(ctx, `
+				INSERT INTO creator_alias (creator_id, alias, alias_type, language_code)
+				VALUES ($1, $2, $3, $4)
+			`, creator.ID, alias.Alias, alias.AliasType, alias.LanguageCode)
+			if err != nil {
+				if strings.Contains(err.Error(), "duplicate key") {
+					return fmt.Errorf("alias %q already exists", alias.Alias)
+				}
+				return fmt.Errorf("failed to insert creator alias: %w", err)
+			}
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// creatorWorksCTE collects the public titles of every content type with the roles of each creator on them
+const creatorWorksCTE = `
+	WITH works AS (
+		SELECT 'NOVEL' AS content_type, nc.creator_id, n.id, n.name, n.cover_image, n.created_at,
+			array_agg(nc.role::text ORDER BY nc.role) AS roles
+		FROM novel_creator nc
+		JOIN novel n ON n.id = nc.novel_id
+		WHERE n.is_deleted = FALSE AND n.is_public = TRUE AND n.access_level = 'PUBLIC'
+		GROUP BY nc.creator_id, n.id
+		UNION ALL
+		SELECT 'MANGA', mc.creator_id, mg.id, mg.name, mg.cover_image, mg.created_at,
+			array_agg(mc.role::text ORDER BY mc.role)
+		FROM manga_creator mc
+		JOIN manga mg ON mg.id = mc.manga_id
+		WHERE mg.is_deleted = FALSE AND mg.is_public = TRUE AND mg.access_level = 'PUBLIC'
+		GROUP BY mc.creator_id, mg.id
+		UNION ALL
+		SELECT 'ANIME', ac.creator_id, a.id, a.name, a.cover_image, a.created_at,
+			array_agg(ac.role::text ORDER BY ac.role)
+		FROM anime_creator ac
+		JOIN anime a ON a.id = ac.anime_id
+		WHERE a.is_deleted = FALSE AND a.is_public = TRUE AND a.access_level = 'PUBLIC'
+		GROUP BY ac.creator_id, a.id
+	)
+`
+
+// ListWorks lists the public novels, manga and anime a creator worked on, newest first
+func (r *creatorRepository) ListWorks(ctx context.Context, creatorID uuid.UUID, req d.ListCreatorWorksRequest) ([]d.CreatorWork, int64, error) {
+	conditions := []string{"creator_id = $1"}
+	args := []interface{}{creatorID}
+	argIndex := 2
+
+	if req.ContentType != "" {
+		conditions = append(conditions, fmt.Sprintf("content_type = $%d", argIndex))
+		args = append(args, req.ContentType)
+		argIndex++
+	}
+
+	if req.Role != "" {
+		conditions = append(conditions, fmt.Sprintf("$%d = ANY(roles)", argIndex))
+		args = append(args, req.Role)
+		argIndex++
+	}
+
+	whereClause := strings.Join(conditions, " AND ")
+
+	var total int64
+	if err := r.pool.QueryRow(ctx, creatorWorksCTE+`SELECT COUNT(*) FROM works WHERE `+whereClause, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count creator works: %w", err)
+	}
+
+	query := creatorWorksCTE + fmt.Sprintf(`
+		SELECT content_type, id, name, cover_image, roles, created_at
+		FROM works
+		WHERE %s
+		ORDER BY created_at DESC, id DESC
+		LIMIT $%d OFFSET $%d
+	`, whereClause, argIndex, argIndex+1)
+	args = append(args, req.PageSize, (req.Page-1)*req.PageSize)
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list creator works: %w", err)
+	}
+	defer rows.Close()
+
+	works := []d.CreatorWork{}
+	for rows.Next() {
+		var work d.CreatorWork
+		var id uuid.UUID
+		if err := rows.Scan(&work.ContentType, &id, &work.Name, &work.CoverImage, &work.Roles, &work.CreatedAt); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan creator work: %w", err)
+		}
+		work.ID = id.String()
+		works = append(works, work)
+	}
+
+	if rows.Err() != nil {
+		return nil, 0, fmt.Errorf("failed to iterate creator works: %w", rows.Err())
+	}
+
+	return works, total, nil
+}
+
+// FindDuplicates matches the normalized name key exactly and the lowercased name by trigram
+// similarity, against creator names and aliases; each creator is reported once with its best match
+func (r *creatorRepository) FindDuplicates(ctx context.Context, names []string, excludeID *uuid.UUID, limit int) ([]d.DuplicateCandidate, error) {
+	query := `
+		WITH input AS (
+			SELECT lower(n) AS name, entity_name_key(n) AS name_key FROM unnest($1::text[]) AS n
+		),
+		matches AS (
+			SELECT c.id AS creator_id, c.name AS matched_name, FALSE AS is_alias
+			FROM creator c, input i
+			WHERE entity_name_key(c.name) = i.name_key OR lower(c.name) % i.name
+			UNION
+			SELECT ca.creator_id, ca.alias, TRUE
+			FROM creator_alias ca, input i
+			WHERE entity_name_key(ca.alias) = i.name_key OR lower(ca.alias) % i.name
+		),
+		scored AS (
+			SELECT DISTINCT ON (c.id)
+				c.id, c.name, m.matched_name,
+				CASE
+					WHEN entity_name_key(m.matched_name) IN (SELECT name_key FROM input) AND m.is_alias THEN 'alias'
+					WHEN entity_name_key(m.matched_name) IN (SELECT name_key FROM input) THEN 'name'
+					ELSE 'similar'
+				END AS match_type,
+				(SELECT MAX(similarity(lower(m.matched_name), i.name)) FROM input i) AS score
+			FROM matches m
+			JOIN creator c ON c.id = m.creator_id
+			WHERE $2::uuid IS NULL OR c.id <> $2
+			ORDER BY c.id, (entity_name_key(m.matched_name) IN (SELECT name_key FROM input)) DESC, score DESC
+		)
+		SELECT id, name, matched_name, match_type, score
+		FROM scored
+		ORDER BY (match_type <> 'similar') DESC, score DESC, name ASC
+		LIMIT $3
+	`
+
+	rows, err := r.pool.Query(ctx, query, names, excludeID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find duplicate creators: %w", err)
+	}
+	defer rows.Close()
+
+	candidates := []d.DuplicateCandidate{}
+	for rows.Next() {
+		var candidate d.DuplicateCandidate
+		var id uuid.UUID
+		var score float32
+		if err := rows.Scan(&id, &candidate.Name, &candidate.MatchedName, &candidate.MatchType, &score); err != nil {
+			return nil, fmt.Errorf("failed to scan duplicate creator: %w", err)
+		}
+		candidate.ID = id.String()
+		candidate.Similarity = float64(score)
+		candidates = append(candidates, candidate)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate duplicate creators: %w", rows.Err())
+	}
+
+	return candidates, nil
+}
+
+// GetRedirect looks up the creator a merged ID was folded into; nil when the ID was never merged
+func (r *creatorRepository) GetRedirect(ctx context.Context, oldID uuid.UUID) (*uuid.UUID, error) {
+	var creatorID uuid.UUID
+	err := r.pool.QueryRow(ctx, `SELECT creator_id FROM creator_redirect WHERE old_id = $1`, oldID).Scan(&creatorID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get creator redirect: %w", err)
+	}
+	return &creatorID, nil
+}
+
+// Merge folds a duplicate creator into the target in one transaction. Content links are
+// re-pointed unless the target already holds the same role on the same title, biographies
+// and aliases the target lacks are moved, the source name becomes an alias and the source ID
+// keeps redirecting to the target.
+func (r *creatorRepository) Merge(ctx context.Context, sourceID, targetID, mergedBy uuid.UUID) (*d.CreatorMergeResponse, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Lock both creators in a stable order so concurrent merges cannot deadlock
+	rows, err := tx.Query(ctx, `SELECT id, name, user_id, tenant_id FROM creator WHERE id = ANY($1) ORDER BY id FOR UPDATE`, []uuid.UUID{sourceID, targetID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock creators: %w", err)
+	}
+	locked := make(map[uuid.UUID]*m.Creator, 2)
+	for rows.Next() {
+		var creator m.Creator
+		if err := rows.Scan(&creator.ID, &creator.Name, &creator.UserID, &creator.TenantID); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan creator: %w", err)
+		}
+		locked[creator.ID] = &creator
+	}
+	rows.Close()
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to lock creators: %w", rows.Err())
+	}
+	source, target := locked[sourceID], locked[targetID]
+	if source == nil {
+		return nil, fmt.Errorf("creator not found")
+	}
+	if target == nil {
+		return nil, fmt.Errorf("target creator not found")
+	}
+	if creatorClaimed(source) && creatorClaimed(target) &&
+		(!sameUUID(source.UserID, target.UserID) || !sameUUID(source.TenantID, target.TenantID)) {
+		return nil, fmt.Errorf("invalid merge: the creators are claimed by different owners")
+	}
+
+	response := &d.CreatorMergeResponse{
+		MergedCreatorID: sourceID.String(),
+		TargetCreatorID: targetID.String(),
+	}
+
+	links := []struct {
+		table, column string
+		moved         *int64
+	}{
+		{"novel_creator", "novel_id", &response.NovelLinks},
+		{"manga_creator", "manga_id", &response.MangaLinks},
+		{"anime_creator", "anime_id", &response.AnimeLinks},
+	}
+	for _, link := range links {
+		tag, err := tx.Exec(ctx, fmt.Sprintf(`
+			UPDATE %[1]s l SET creator_id = $2, updated_at = CURRENT_TIMESTAMP
+			WHERE l.creator_id = $1 AND NOT EXISTS (
+				SELECT 1 FROM %[1]s t WHERE t.%[2]s = l.%[2]s AND t.creator_id = $2 AND t.role = l.role
+			)
+		`, link.table, link.column), sourceID, targetID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to move %s links: %w", link.table, err)
+		}
+		*link.moved = tag.RowsAffected()
+
+		if _, err := tx.Exec(ctx, fmt.Sprintf(`DELETE FROM %s WHERE creator_id = $1`, link.table), sourceID); err != nil {
+			return nil, fmt.Errorf("failed to remove %s links: %w", link.table, err)
+		}
+	}
+
+	tag, err := tx.Exec(ctx, `UPDATE anime_character SET voice_actor_id = $2 WHERE voice_actor_id = $1`, sourceID, targetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to move voice roles: %w", err)
+	}
+	response.VoiceRoles = tag.RowsAffected()
+
+	_, err = tx.Exec(ctx, `
+		UPDATE creator_translation s SET creator_id = $2, updated_at = CURRENT_TIMESTAMP
+		WHERE s.creator_id = $1 AND NOT EXISTS (
+			SELECT 1 FROM creator_translation t WHERE t.creator_id = $2 AND t.language_code = s.language_code
+		)
+	`, sourceID, targetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to move creator biographies: %w", err)
+	}
+
+	tag, err = tx.Exec(ctx, `
+		UPDATE creator_alias s SET creator_id = $2
+		WHERE s.creator_id = $1 AND s.alias <> $3 AND NOT EXISTS (
+			SELECT 1 FROM creator_alias t WHERE t.creator_id = $2 AND t.alias = s.alias
+		)
+	`, sourceID, targetID, target.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to move creator aliases: %w", err)
+	}
+	response.Aliases = tag.RowsAffected()
+
+	if source.Name != target.Name {
+		tag, err = tx.Exec(ctx, `
+			INSERT INTO creator_alias (creator_id, alias, alias_type)
+			VALUES ($1, $2, 'ALIAS')
+			ON CONFLICT (creator_id, alias) DO NOTHING
+		`, targetID, source.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to keep merged creator name: %w", err)
+		}
+		response.Aliases += tag.RowsAffected()
+	}
+
+	// The target keeps its own profile; empty fields and an unclaimed page take the source's values
+	_, err = tx.Exec(ctx, `
+		UPDATE creator t SET
+			description = COALESCE(t.description, s.description),
+			social_links = t.social_links || COALESCE((
+				SELECT jsonb_agg(link) FROM jsonb_array_elements(s.social_links) AS link
+				WHERE NOT t.social_links @> jsonb_build_array(link)
+			), '[]'::jsonb),
+			user_id = CASE WHEN t.user_id IS NULL AND t.tenant_id IS NULL THEN s.user_id ELSE t.user_id END,
+			tenant_id = CASE WHEN t.user_id IS NULL AND t.tenant_id IS NULL THEN s.tenant_id ELSE t.tenant_id END,
+			claimed_at = CASE WHEN t.user_id IS NULL AND t.tenant_id IS NULL THEN s.claimed_at ELSE t.claimed_at END,
+			updated_at = CURRENT_TIMESTAMP
+		FROM creator s
+		WHERE t.id = $2 AND s.id = $1
+	`, sourceID, targetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge creator profile: %w", err)
+	}
+
+	// Claim history follows the creator; a second pending claim by the same user is dropped
+	_, err = tx.Exec(ctx, `
+		UPDATE creator_claim s SET creator_id = $2, updated_at = CURRENT_TIMESTAMP
+		WHERE s.creator_id = $1 AND NOT (s.status = 'pending' AND EXISTS (
+			SELECT 1 FROM creator_claim t WHERE t.creator_id = $2 AND t.user_id = s.user_id AND t.status = 'pending'
+		))
+	`, sourceID, targetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to move creator claims: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE creator_redirect SET creator_id = $2 WHERE creator_id = $1`, sourceID, targetID); err != nil {
+		return nil, fmt.Errorf("failed to update creator redirects: %w", err)
+	}
+	_, err = tx.Exec(ctx, `
+		INSERT INTO creator_redirect (old_id, creator_id, merged_by)
+		VALUES ($1, $2, $3)
+	`, sourceID, targetID, mergedBy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create creator redirect: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM creator WHERE id = $1`, sourceID); err != nil {
+		return nil, fmt.Errorf("failed to delete merged creator: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return response, nil
+}
+
+// creatorClaimed reports whether a creator page is linked to an account or tenant
+func creatorClaimed(creator *m.Creator) bool {
+	return creator.UserID != nil || creator.TenantID != nil
+}
+
+// sameUUID compares two optional IDs
+func sameUUID(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	d "wibusystem/pkg/common/dto"
 	m "wibusystem/pkg/common/model"
 
 	"github.com/google/uuid"
@@ -24,6 +25,13 @@ type GenreRepository interface {
 	// Query methods for novel relations
 	GetGenresByNovelID(ctx context.Context, novelID uuid.UUID) ([]m.Genre, error)
 	GetGenresByNovelIDs(ctx context.Context, novelIDs []uuid.UUID) (map[uuid.UUID][]m.Genre, error)
+
+	// Taxonomy methods
+	ListAll(ctx context.Context) ([]*m.Genre, error)
+	IsDescendant(ctx context.Context, genreID, ancestorID uuid.UUID) (bool, error)
+	GetTranslations(ctx context.Context, genreIDs []uuid.UUID) (map[uuid.UUID][]m.GenreTranslation, error)
+	SetTranslations(ctx context.Context, genreID uuid.UUID, translations []m.GenreTranslation) error
+	Merge(ctx context.Context, sourceID, targetID uuid.UUID) (*d.GenreMergeResponse, error)
 }
 
 type genreRepository struct {
@@ -38,12 +46,12 @@ func NewGenreRepository(pool *pgxpool.Pool) GenreRepository {
 // Create inserts a new genre and returns the assigned ID and timestamps
 func (r *genreRepository) Create(ctx context.Context, genre *m.Genre) error {
 	query := `
-		INSERT INTO genre (name)
-		VALUES ($1)
+		INSERT INTO genre (name, parent_id, description, sort_order)
+		VALUES ($1, $2, $3, $4)
 		RETURNING id, created_at, updated_at
 	`
 
-	err := r.pool.QueryRow(ctx, query, genre.Name).Scan(
+	err := r.pool.QueryRow(ctx, query, genre.Name, genre.ParentID, genre.Description, genre.SortOrder).Scan(
 		&genre.ID,
 		&genre.CreatedAt,
 		&genre.UpdatedAt,
@@ -61,7 +69,10 @@ func (r *genreRepository) GetByID(ctx context.Context, id uuid.UUID) (*m.Genre,
 	query := `
 		SELECT
 			g.id,
+			g.parent_id,
 			g.name,
+			g.description,
+			g.sort_order,
 			g.created_at,
 			g.updated_at,
 			COALESCE(ac.anime_count, 0) as anime_count,
@@ -93,7 +104,10 @@ func (r *genreRepository) GetByID(ctx context.Context, id uuid.UUID) (*m.Genre,
 	var animeCount, mangaCount, novelCount int
 	err := r.pool.QueryRow(ctx, query, id).Scan(
 		&genre.ID,
+		&genre.ParentID,
 		&genre.Name,
+		&genre.Description,
+		&genre.SortOrder,
 		&genre.CreatedAt,
 		&genre.UpdatedAt,
 		&animeCount,
@@ -116,7 +130,7 @@ func (r *genreRepository) GetByID(ctx context.Context, id uuid.UUID) (*m.Genre,
 // GetByName retrieves a genre by its name
 func (r *genreRepository) GetByName(ctx context.Context, name string) (*m.Genre, error) {
 	query := `
-		SELECT id, name, created_at, updated_at
+		SELECT id, parent_id, name, description, sort_order, created_at, updated_at
 		FROM genre
 		WHERE LOWER(name) = LOWER($1)
 	`
@@ -124,7 +138,10 @@ func (r *genreRepository) GetByName(ctx context.Context, name string) (*m.Genre,
 	var genre m.Genre
 	err := r.pool.QueryRow(ctx, query, name).Scan(
 		&genre.ID,
+		&genre.ParentID,
 		&genre.Name,
+		&genre.Description,
+		&genre.SortOrder,
 		&genre.CreatedAt,
 		&genre.UpdatedAt,
 	)
@@ -140,14 +157,14 @@ func (r *genreRepository) GetByName(ctx context.Context, name string) (*m.Genre,
 func (r *genreRepository) Update(ctx context.Context, genre *m.Genre) error {
 	query := `
 		UPDATE genre
-		SET name = $2, updated_at = $3
+		SET name = $2, parent_id = $3, description = $4, sort_order = $5, updated_at = $6
 		WHERE id = $1
 		RETURNING updated_at
 	`
 
 	genre.UpdatedAt = time.Now()
 
-	err := r.pool.QueryRow(ctx, query, genre.ID, genre.Name, genre.UpdatedAt).Scan(
+	err := r.pool.QueryRow(ctx, query, genre.ID, genre.Name, genre.ParentID, genre.Description, genre.SortOrder, genre.UpdatedAt).Scan(
 		&genre.UpdatedAt,
 	)
 
@@ -164,6 +181,9 @@ func (r *genreRepository) Delete(ctx context.Context, id uuid.UUID) error {
 
 	commandTag, err := r.pool.Exec(ctx, query, id)
 	if err != nil {
+		if strings.Contains(err.Error(), "genre_parent_id_fkey") {
+			return fmt.Errorf("genre has child genres: move or merge them first")
+		}
 		return fmt.Errorf("failed to delete genre: %w", err)
 	}
 
@@ -204,7 +224,10 @@ func (r *genreRepository) List(ctx context.Context, limit, offset int, search st
 	query := fmt.Sprintf(`
 		SELECT
 			g.id,
+			g.parent_id,
 			g.name,
+			g.description,
+			g.sort_order,
 			g.created_at,
 			g.updated_at,
 			COALESCE(ac.anime_count, 0) as anime_count,
@@ -227,7 +250,7 @@ func (r *genreRepository) List(ctx context.Context, limit, offset int, search st
 			GROUP BY genre_id
 		) nc ON g.id = nc.genre_id
 		%s
-		ORDER BY g.name ASC
+		ORDER BY g.sort_order ASC, g.name ASC
 		LIMIT $1 OFFSET $2
 	`, whereClause)
 
@@ -242,7 +265,10 @@ func (r *genreRepository) List(ctx context.Context, limit, offset int, search st
 		var animeCount, mangaCount, novelCount int
 		err := rows.Scan(
 			&genre.ID,
+			&genre.ParentID,
 			&genre.Name,
+			&genre.Description,
+			&genre.SortOrder,
 			&genre.CreatedAt,
 			&genre.UpdatedAt,
 			&animeCount,
@@ -349,4 +375,235 @@ func (r *genreRepository) GetGenresByNovelIDs(ctx context.Context, novelIDs []uu
 	}
 
 	return result, nil
-}
\ No newline at end of file
+}
+
+// ListAll retrieves every genre without content counts, ordered for building the taxonomy tree
+func (r *genreRepository) ListAll(ctx context.Context) ([]*m.Genre, error) {
+	query := `
+		SELECT id, parent_id, name, description, sort_order, created_at, updated_at
+		FROM genre
+		ORDER BY sort_order ASC, name ASC
+	`
+
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list genres: %w", err)
+	}
+	defer rows.Close()
+
+	var genres []*m.Genre
+	for rows.Next() {
+		var genre m.Genre
+		err := rows.Scan(
+			&genre.ID,
+			&genre.ParentID,
+			&genre.Name,
+			&genre.Description,
+			&genre.SortOrder,
+			&genre.CreatedAt,
+			&genre.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan genre: %w", err)
+		}
+		genres = append(genres, &genre)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate genres: %w", rows.Err())
+	}
+
+	return genres, nil
+}
+
+// IsDescendant reports whether genreID sits below ancestorID in the taxonomy
+func (r *genreRepository) IsDescendant(ctx context.Context, genreID, ancestorID uuid.UUID) (bool, error) {
+	query := `
+		WITH RECURSIVE descendants AS (
+			SELECT id FROM genre WHERE parent_id = $2
+			UNION
+			SELECT g.id FROM genre g INNER JOIN descendants d ON g.parent_id = d.id
+		)
+		SELECT EXISTS (SELECT 1 FROM descendants WHERE id = $1)
+	`
+
+	var descendant bool
+	if err := r.pool.QueryRow(ctx, query, genreID, ancestorID).Scan(&descendant); err != nil {
+		return false, fmt.Errorf("failed to check genre ancestry: %w", err)
+	}
+
+	return descendant, nil
+}
+
+// GetTranslations retrieves the localized names of several genres in a single query
+func (r *genreRepository) GetTranslations(ctx context.Context, genreIDs []uuid.UUID) (map[uuid.UUID][]m.GenreTranslation, error) {
+	result := make(map[uuid.UUID][]m.GenreTranslation)
+	if len(genreIDs) == 0 {
+		return result, nil
+	}
+
+	query := `
+		SELECT genre_id, language_code, name, description, COALESCE(updated_at, CURRENT_TIMESTAMP)
+		FROM genre_translation
+		WHERE genre_id = ANY($1)
+		ORDER BY genre_id, language_code
+	`
+
+	rows, err := r.pool.Query(ctx, query, genreIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get genre translations: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var translation m.GenreTranslation
+		err := rows.Scan(
+			&translation.GenreID,
+			&translation.LanguageCode,
+			&translation.Name,
+			&translation.Description,
+			&translation.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan genre translation: %w", err)
+		}
+		result[translation.GenreID] = append(result[translation.GenreID], translation)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate genre translations: %w", rows.Err())
+	}
+
+	return result, nil
+}
+
+// SetTranslations replaces the localized names of a genre
+func (r *genreRepository) SetTranslations(ctx context.Context, genreID uuid.UUID, translations []m.GenreTranslation) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `DELETE FROM genre_translation WHERE genre_id = $1`, genreID); err != nil {
+		return fmt.Errorf("failed to clear genre translations: %w", err)
+	}
+
+	for _, translation := range translations {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO genre_translation (genre_id, language_code, name, description, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+		`, genreID, translation.LanguageCode, translation.Name, translation.Description)
+		if err != nil {
+			if strings.Contains(err.Error(), "duplicate key") {
+				return fmt.Errorf("invalid translations: duplicate language %s", translation.LanguageCode)
+			}
+			return fmt.Errorf("failed to save genre translation: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// Merge folds a duplicate genre into the target: content links move to the target (links the
+// content already has to the target are dropped), sub-genres are re-attached to the target and
+// the duplicate is deleted with its translations. A target below the duplicate first takes the
+// duplicate's place in the tree so no cycle is created.
+func (r *genreRepository) Merge(ctx context.Context, sourceID, targetID uuid.UUID) (*d.GenreMergeResponse, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Lock both genres in a stable order so concurrent merges cannot deadlock
+	rows, err := tx.Query(ctx, `SELECT id, parent_id FROM genre WHERE id = ANY($1) ORDER BY id FOR UPDATE`, []uuid.UUID{sourceID, targetID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock genres: %w", err)
+	}
+	parents := make(map[uuid.UUID]*uuid.UUID, 2)
+	for rows.Next() {
+		var id uuid.UUID
+		var parentID *uuid.UUID
+		if err := rows.Scan(&id, &parentID); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan genre: %w", err)
+		}
+		parents[id] = parentID
+	}
+	rows.Close()
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to lock genres: %w", rows.Err())
+	}
+	if _, ok := parents[sourceID]; !ok {
+		return nil, fmt.Errorf("genre not found")
+	}
+	if _, ok := parents[targetID]; !ok {
+		return nil, fmt.Errorf("target genre not found")
+	}
+
+	response := &d.GenreMergeResponse{
+		MergedGenreID: sourceID.String(),
+		TargetGenreID: targetID.String(),
+	}
+
+	links := []struct {
+		table, column string
+		moved         *int64
+	}{
+		{"novel_genre", "novel_id", &response.NovelLinks},
+		{"manga_genre", "manga_id", &response.MangaLinks},
+		{"anime_genre", "anime_id", &response.AnimeLinks},
+	}
+	for _, link := range links {
+		tag, err := tx.Exec(ctx, fmt.Sprintf(`
+			INSERT INTO %[1]s (%[2]s, genre_id)
+			SELECT %[2]s, $2 FROM %[1]s WHERE genre_id = $1
+			ON CONFLICT (%[2]s, genre_id) DO NOTHING
+		`, link.table, link.column), sourceID, targetID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to move %s links: %w", link.table, err)
+		}
+		*link.moved = tag.RowsAffected()
+
+		if _, err := tx.Exec(ctx, fmt.Sprintf(`DELETE FROM %s WHERE genre_id = $1`, link.table), sourceID); err != nil {
+			return nil, fmt.Errorf("failed to remove %s links: %w", link.table, err)
+		}
+	}
+
+	_, err = tx.Exec(ctx, `
+		WITH RECURSIVE descendants AS (
+			SELECT id FROM genre WHERE parent_id = $1
+			UNION
+			SELECT g.id FROM genre g INNER JOIN descendants d ON g.parent_id = d.id
+		)
+		UPDATE genre SET parent_id = $3, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $2 AND id IN (SELECT id FROM descendants)
+	`, sourceID, targetID, parents[sourceID])
+	if err != nil {
+		return nil, fmt.Errorf("failed to move target genre: %w", err)
+	}
+
+	tag, err := tx.Exec(ctx, `
+		UPDATE genre SET parent_id = $2, updated_at = CURRENT_TIMESTAMP
+		WHERE parent_id = $1 AND id <> $2
+	`, sourceID, targetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to move child genres: %w", err)
+	}
+	response.Children = tag.RowsAffected()
+
+	if _, err := tx.Exec(ctx, `DELETE FROM genre WHERE id = $1`, sourceID); err != nil {
+		return nil, fmt.Errorf("failed to delete merged genre: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,784 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+)
+
+// MangaChapterRepository defines data access for manga volumes, chapters and pages
+type MangaChapterRepository interface {
+	// Volumes
+	CreateVolume(ctx context.Context, mangaID uuid.UUID, req d.CreateMangaVolumeRequest) (*m.MangaVolume, error)
+	UpdateVolume(ctx context.Context, id uuid.UUID, req d.UpdateMangaVolumeRequest) (*m.MangaVolume, error)
+	DeleteVolume(ctx context.Context, id uuid.UUID) error
+
+	// Chapters
+	CreateChapter(ctx context.Context, volumeID uuid.UUID, req d.CreateMangaChapterRequest, userID uuid.UUID) (*m.MangaChapter, error)
+	GetChapterByID(ctx context.Context, id uuid.UUID) (*m.MangaChapter, error)
+	// ListChapters lists chapters of a volume; drafts are only included when includeDrafts is true
+	ListChapters(ctx context.Context, volumeID uuid.UUID, includeDrafts bool, req d.ListMangaChaptersRequest) ([]m.MangaChapter, int64, error)
+	UpdateChapter(ctx context.Context, id uuid.UUID, req d.UpdateMangaChapterRequest, userID uuid.UUID) (*m.MangaChapter, error)
+	DeleteChapter(ctx context.Context, id uuid.UUID) error
+	PublishChapter(ctx context.Context, id uuid.UUID, publishAt time.Time, userID uuid.UUID) (*m.MangaChapter, error)
+	UnpublishChapter(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*m.MangaChapter, error)
+
+	// Pages
+	ListPages(ctx context.Context, chapterID uuid.UUID) ([]m.MangaPage, error)
+	// CountPages returns the page count of each chapter
+	CountPages(ctx context.Context, chapterIDs []uuid.UUID) (map[uuid.UUID]int, error)
+	// AddPages inserts pages after afterPage (nil appends) and renumbers the following pages
+	AddPages(ctx context.Context, chapterID uuid.UUID, imageURLs []string, afterPage *int) ([]m.MangaPage, error)
+	// ReorderPages renumbers the pages of a chapter from 1 in the given order; every page must be listed
+	ReorderPages(ctx context.Context, chapterID uuid.UUID, pageIDs []uuid.UUID) ([]m.MangaPage, error)
+	UpdatePage(ctx context.Context, pageID uuid.UUID, imageURL string) (*m.MangaPage, error)
+	// DeletePage removes a page and closes the gap in the numbering
+	DeletePage(ctx context.Context, pageID uuid.UUID) error
+
+	// Ownership and entitlement helpers
+	GetVolumeMangaID(ctx context.Context, volumeID uuid.UUID) (uuid.UUID, error)
+	GetChapterMangaID(ctx context.Context, chapterID uuid.UUID) (uuid.UUID, error)
+	// GetPageChapter returns the chapter and manga a page belongs to
+	GetPageChapter(ctx context.Context, pageID uuid.UUID) (uuid.UUID, uuid.UUID, error)
+	// IsMangaPubliclyVisible reports whether the manga is public, PUBLIC access and not deleted
+	IsMangaPubliclyVisible(ctx context.Context, mangaID uuid.UUID) (bool, error)
+	// CanReadChapter reports whether the user purchased the chapter, its volume or the series,
+	// or holds an unexpired rental of the volume or series
+	CanReadChapter(ctx context.Context, chapterID, userID uuid.UUID) (bool, error)
+}
+
+// mangaChapterRepository implements MangaChapterRepository interface
+type mangaChapterRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewMangaChapterRepository creates a new manga chapter repository instance
+func NewMangaChapterRepository(pool *pgxpool.Pool) MangaChapterRepository {
+	return &mangaChapterRepository{pool: pool}
+}
+
+const mangaVolumeColumns = `
+	id, manga_id, volume_number, volume_title, cover_image, description,
+	price_coins, rental_price_coins, rental_duration_days, created_at, updated_at`
+
+const mangaChapterColumns = `
+	id, volume_id, chapter_number, title, released_at, COALESCE(is_public, FALSE), price_coins,
+	COALESCE(is_draft, TRUE), last_modified_by_user_id, created_at, updated_at`
+
+const mangaPageColumns = `id, chapter_id, page_number, image_url, created_at, updated_at`
+
+func scanMangaVolume(row pgx.Row) (*m.MangaVolume, error) {
+	var volume m.MangaVolume
+	err := row.Scan(&volume.ID, &volume.MangaID, &volume.VolumeNumber, &volume.VolumeTitle, &volume.CoverImage,
+		&volume.Description, &volume.PriceCoins, &volume.RentalPriceCoins, &volume.RentalDurationDays,
+		&volume.CreatedAt, &volume.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &volume, nil
+}
+
+func scanMangaChapter(row pgx.Row) (*m.MangaChapter, error) {
+	var chapter m.MangaChapter
+	err := row.Scan(&chapter.ID, &chapter.VolumeID, &chapter.ChapterNumber, &chapter.Title, &chapter.ReleasedAt,
+		&chapter.IsPublic, &chapter.PriceCoins, &chapter.IsDraft, &chapter.LastModifiedByUserID,
+		&chapter.CreatedAt, &chapter.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &chapter, nil
+}
+
+func scanMangaPage(row pgx.Row) (*m.MangaPage, error) {
+	var page m.MangaPage
+	err := row.Scan(&page.ID, &page.ChapterID, &page.PageNumber, &page.ImageURL, &page.CreatedAt, &page.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &page, nil
+}
+
+// CreateVolume creates a volume; volume numbers are unique per manga
+func (r *mangaChapterRepository) CreateVolume(ctx context.Context, mangaID uuid.UUID, req d.CreateMangaVolumeRequest) (*m.MangaVolume, error) {
+	query := `
+		INSERT INTO manga_volume (
+			manga_id, volume_number, volume_title, cover_image, description,
+			price_coins, rental_price_coins, rental_duration_days, created_at, updated_at
+		)
+		SELECT mg.id, $2, $3, $4, $5, $6, $7, $8, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP
+		FROM manga mg
+		WHERE mg.id = $1 AND mg.is_deleted = FALSE
+		RETURNING` + mangaVolumeColumns
+
+	volume, err := scanMangaVolume(r.pool.QueryRow(ctx, query, mangaID, req.VolumeNumber, req.VolumeTitle, req.CoverImage,
+		req.Description, req.PriceCoins, req.RentalPriceCoins, req.RentalDurationDays))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("manga not found")
+		}
+		if strings.Contains(err.Error(), "duplicate key") {
+			return nil, fmt.Errorf("volume %d already exists", req.VolumeNumber)
+		}
+		return nil, fmt.Errorf("failed to create volume: %w", err)
+	}
+
+	return volume, nil
+}
+
+// UpdateVolume applies the provided fields
+func (r *mangaChapterRepository) UpdateVolume(ctx context.Context, id uuid.UUID, req d.UpdateMangaVolumeRequest) (*m.MangaVolume, error) {
+	updateFields := []string{"updated_at = CURRENT_TIMESTAMP"}
+	args := []interface{}{}
+	argIndex := 1
+
+	if req.VolumeNumber != nil {
+		updateFields = append(updateFields, fmt.Sprintf("volume_number = $%d", argIndex))
+		args = append(args, *req.VolumeNumber)
+		argIndex++
+	}
+
+	if req.VolumeTitle != nil {
+		updateFields = append(updateFields, fmt.Sprintf("volume_title = $%d", argIndex))
+		args = append(args, *req.VolumeTitle)
+		argIndex++
+	}
+
+	if req.CoverImage != nil {
+		updateFields = append(updateFields, fmt.Sprintf("cover_image = $%d", argIndex))
+		args = append(args, *req.CoverImage)
+		argIndex++
+	}
+
+	if req.Description != nil {
+		updateFields = append(updateFields, fmt.Sprintf("description = $%d", argIndex))
+		args = append(args, *req.Description)
+		argIndex++
+	}
+
+	if req.PriceCoins != nil {
+		updateFields = append(updateFields, fmt.Sprintf("price_coins = $%d", argIndex))
+		args = append(args, *req.PriceCoins)
+		argIndex++
+	}
+
+	if req.RentalPriceCoins != nil {
+		updateFields = append(updateFields, fmt.Sprintf("rental_price_coins = $%d", argIndex))
+		args = append(args, *req.RentalPriceCoins)
+		argIndex++
+	}
+
+	if req.RentalDurationDays != nil {
+		updateFields = append(updateFields, fmt.Sprintf("rental_duration_days = $%d", argIndex))
+		args = append(args, *req.RentalDurationDays)
+		argIndex++
+	}
+
+	query := fmt.Sprintf(`
+		UPDATE manga_volume
+		SET %s
+		WHERE id = $%d
+		RETURNING%s`, strings.Join(updateFields, ", "), argIndex, mangaVolumeColumns)
+	args = append(args, id)
+
+	volume, err := scanMangaVolume(r.pool.QueryRow(ctx, query, args...))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("volume not found")
+		}
+		if strings.Contains(err.Error(), "duplicate key") {
+			return nil, fmt.Errorf("volume %d already exists", *req.VolumeNumber)
+		}
+		return nil, fmt.Errorf("failed to update volume: %w", err)
+	}
+
+	return volume, nil
+}
+
+// DeleteVolume deletes a volume, its chapters and pages unless users bought or rented any of it
+func (r *mangaChapterRepository) DeleteVolume(ctx context.Context, id uuid.UUID) error {
+	query := `
+		SELECT EXISTS(
+			SELECT 1 FROM user_content_purchases ucp
+			WHERE (ucp.item_type = 'MANGA_VOLUME' AND ucp.item_id = $1)
+				OR (ucp.item_type = 'MANGA_CHAPTER' AND ucp.item_id IN (
+					SELECT id FROM manga_chapter WHERE volume_id = $1
+				))
+			UNION
+			SELECT 1 FROM user_content_rentals ucr
+			WHERE ucr.item_type = 'MANGA_VOLUME' AND ucr.item_id = $1
+		)`
+
+	var hasPurchases bool
+	if err := r.pool.QueryRow(ctx, query, id).Scan(&hasPurchases); err != nil {
+		return fmt.Errorf("failed to check volume purchases: %w", err)
+	}
+	if hasPurchases {
+		return fmt.Errorf("cannot delete volume: users have purchased content from this volume")
+	}
+
+	tag, err := r.pool.Exec(ctx, `DELETE FROM manga_volume WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete volume: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("volume not found")
+	}
+
+	return nil
+}
+
+// CreateChapter creates a draft chapter; chapter numbers are unique per volume
+func (r *mangaChapterRepository) CreateChapter(ctx context.Context, volumeID uuid.UUID, req d.CreateMangaChapterRequest, userID uuid.UUID) (*m.MangaChapter, error) {
+	query := `
+		INSERT INTO manga_chapter (
+			volume_id, chapter_number, title, is_public, price_coins,
+			is_draft, last_modified_by_user_id, created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, TRUE, $6, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+		RETURNING` + mangaChapterColumns
+
+	chapter, err := scanMangaChapter(r.pool.QueryRow(ctx, query, volumeID, req.ChapterNumber, req.Title,
+		req.IsPublic, req.PriceCoins, userID))
+	if err != nil {
+		if strings.Contains(err.Error(), "duplicate key") {
+			return nil, fmt.Errorf("chapter %d already exists in this volume", req.ChapterNumber)
+		}
+		if strings.Contains(err.Error(), "foreign key") {
+			return nil, fmt.Errorf("volume not found")
+		}
+		return nil, fmt.Errorf("failed to create chapter: %w", err)
+	}
+
+	return chapter, nil
+}
+
+// GetChapterByID retrieves a chapter of a non-deleted manga
+func (r *mangaChapterRepository) GetChapterByID(ctx context.Context, id uuid.UUID) (*m.MangaChapter, error) {
+	query := `
+		SELECT` + mangaChapterColumns + `
+		FROM manga_chapter
+		WHERE id = $1
+		  AND volume_id IN (
+			SELECT v.id FROM manga_volume v JOIN manga mg ON mg.id = v.manga_id WHERE mg.is_deleted = FALSE
+		  )`
+
+	chapter, err := scanMangaChapter(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("chapter not found")
+		}
+		return nil, fmt.Errorf("failed to get chapter: %w", err)
+	}
+	return chapter, nil
+}
+
+// ListChapters lists the chapters of a volume ordered by chapter number
+func (r *mangaChapterRepository) ListChapters(ctx context.Context, volumeID uuid.UUID, includeDrafts bool, req d.ListMangaChaptersRequest) ([]m.MangaChapter, int64, error) {
+	whereClause := "volume_id = $1"
+	if !includeDrafts {
+		// Scheduled chapters stay hidden until their release time
+		whereClause += " AND is_draft = FALSE AND (released_at IS NULL OR released_at <= CURRENT_TIMESTAMP)"
+	}
+
+	var total int64
+	if err := r.pool.QueryRow(ctx, `SELECT COUNT(*) FROM manga_chapter WHERE `+whereClause, volumeID).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count chapters: %w", err)
+	}
+
+	offset := (req.Page - 1) * req.PageSize
+	query := `SELECT` + mangaChapterColumns + ` FROM manga_chapter WHERE ` + whereClause + `
+		ORDER BY chapter_number
+		LIMIT $2 OFFSET $3`
+
+	rows, err := r.pool.Query(ctx, query, volumeID, req.PageSize, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list chapters: %w", err)
+	}
+	defer rows.Close()
+
+	chapters := make([]m.MangaChapter, 0)
+	for rows.Next() {
+		chapter, err := scanMangaChapter(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan chapter: %w", err)
+		}
+		chapters = append(chapters, *chapter)
+	}
+	if rows.Err() != nil {
+		return nil, 0, fmt.Errorf("failed to iterate chapters: %w", rows.Err())
+	}
+
+	return chapters, total, nil
+}
+
+// UpdateChapter applies the provided fields
+func (r *mangaChapterRepository) UpdateChapter(ctx context.Context, id uuid.UUID, req d.UpdateMangaChapterRequest, userID uuid.UUID) (*m.MangaChapter, error) {
+	updateFields := []string{"updated_at = CURRENT_TIMESTAMP", "last_modified_by_user_id = $1"}
+	args := []interface{}{userID}
+	argIndex := 2
+
+	if req.ChapterNumber != nil {
+		updateFields = append(updateFields, fmt.Sprintf("chapter_number = $%d", argIndex))
+		args = append(args, *req.ChapterNumber)
+		argIndex++
+	}
+
+	if req.Title != nil {
+		updateFields = append(updateFields, fmt.Sprintf("title = $%d", argIndex))
+		args = append(args, *req.Title)
+		argIndex++
+	}
+
+	if req.IsPublic != nil {
+		updateFields = append(updateFields, fmt.Sprintf("is_public = $%d", argIndex))
+		args = append(args, *req.IsPublic)
+		argIndex++
+	}
+
+	if req.PriceCoins != nil {
+		updateFields = append(updateFields, fmt.Sprintf("price_coins = $%d", argIndex))
+		args = append(args, *req.PriceCoins)
+		argIndex++
+	}
+
+	query := fmt.Sprintf(`
+		UPDATE manga_chapter
+		SET %s
+		WHERE id = $%d
+		RETURNING%s`, strings.Join(updateFields, ", "), argIndex, mangaChapterColumns)
+	args = append(args, id)
+
+	chapter, err := scanMangaChapter(r.pool.QueryRow(ctx, query, args...))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("chapter not found")
+		}
+		if strings.Contains(err.Error(), "duplicate key") {
+			return nil, fmt.Errorf("chapter %d already exists in this volume", *req.ChapterNumber)
+		}
+		return nil, fmt.Errorf("failed to update chapter: %w", err)
+	}
+
+	return chapter, nil
+}
+
+// DeleteChapter deletes a chapter and its pages unless users bought it
+func (r *mangaChapterRepository) DeleteChapter(ctx context.Context, id uuid.UUID) error {
+	var hasPurchases bool
+	err := r.pool.QueryRow(ctx, `
+		SELECT EXISTS(
+			SELECT 1 FROM user_content_purchases
+			WHERE item_type = 'MANGA_CHAPTER' AND item_id = $1
+		)`, id).Scan(&hasPurchases)
+	if err != nil {
+		return fmt.Errorf("failed to check chapter purchases: %w", err)
+	}
+	if hasPurchases {
+		return fmt.Errorf("cannot delete chapter: users have purchased this chapter")
+	}
+
+	tag, err := r.pool.Exec(ctx, `DELETE FROM manga_chapter WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete chapter: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("chapter not found")
+	}
+
+	return nil
+}
+
+// PublishChapter makes a chapter visible from publishAt on
+func (r *mangaChapterRepository) PublishChapter(ctx context.Context, id uuid.UUID, publishAt time.Time, userID uuid.UUID) (*m.MangaChapter, error) {
+	query := `
+		UPDATE manga_chapter
+		SET is_draft = FALSE, released_at = $2, last_modified_by_user_id = $3, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1
+		RETURNING` + mangaChapterColumns
+
+	chapter, err := scanMangaChapter(r.pool.QueryRow(ctx, query, id, publishAt, userID))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("chapter not found")
+		}
+		return nil, fmt.Errorf("failed to publish chapter: %w", err)
+	}
+	return chapter, nil
+}
+
+// UnpublishChapter turns a chapter back into a draft
+func (r *mangaChapterRepository) UnpublishChapter(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*m.MangaChapter, error) {
+	query := `
+		UPDATE manga_chapter
+		SET is_draft = TRUE, released_at = NULL, last_modified_by_user_id = $2, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1
+		RETURNING` + mangaChapterColumns
+
+	chapter, err := scanMangaChapter(r.pool.QueryRow(ctx, query, id, userID))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("chapter not found")
+		}
+		return nil, fmt.Errorf("failed to unpublish chapter: %w", err)
+	}
+	return chapter, nil
+}
+
+// ListPages lists the pages of a chapter in reading order
+func (r *mangaChapterRepository) ListPages(ctx context.Context, chapterID uuid.UUID) ([]m.MangaPage, error) {
+	return listMangaPages(ctx, r.pool, chapterID)
+}
+
+// CountPages counts the pages of several chapters with one query
+func (r *mangaChapterRepository) CountPages(ctx context.Context, chapterIDs []uuid.UUID) (map[uuid.UUID]int, error) {
+	counts := make(map[uuid.UUID]int, len(chapterIDs))
+	if len(chapterIDs) == 0 {
+		return counts, nil
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT chapter_id, COUNT(*)
+		FROM manga_page
+		WHERE chapter_id = ANY($1)
+		GROUP BY chapter_id`, chapterIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count pages: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var chapterID uuid.UUID
+		var count int
+		if err := rows.Scan(&chapterID, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan page count: %w", err)
+		}
+		counts[chapterID] = count
+	}
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate page counts: %w", rows.Err())
+	}
+
+	return counts, nil
+}
+
+// AddPages inserts the pages in the given order after afterPage, moving the following pages back
+func (r *mangaChapterRepository) AddPages(ctx context.Context, chapterID uuid.UUID, imageURLs []string, afterPage *int) ([]m.MangaPage, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := lockMangaChapter(ctx, tx, chapterID); err != nil {
+		return nil, err
+	}
+
+	var lastPage int
+	if err := tx.QueryRow(ctx, `SELECT COALESCE(MAX(page_number), 0) FROM manga_page WHERE chapter_id = $1`, chapterID).Scan(&lastPage); err != nil {
+		return nil, fmt.Errorf("failed to get last page: %w", err)
+	}
+
+	// Re-checked under the chapter lock in case pages were removed since the service validated
+	if afterPage != nil && (*afterPage < 0 || *afterPage > lastPage) {
+		return nil, fmt.Errorf("invalid after_page: chapter has %d pages", lastPage)
+	}
+
+	position := lastPage
+	if afterPage != nil && *afterPage < lastPage {
+		position = *afterPage
+		if err := shiftMangaPages(ctx, tx, chapterID, position, len(imageURLs)); err != nil {
+			return nil, err
+		}
+	}
+
+	for i, imageURL := range imageURLs {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO manga_page (chapter_id, page_number, image_url, created_at, updated_at)
+			VALUES ($1, $2, $3, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+		`, chapterID, position+i+1, imageURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to add page %d: %w", position+i+1, err)
+		}
+	}
+
+	pages, err := listMangaPages(ctx, tx, chapterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return pages, nil
+}
+
+// ReorderPages renumbers every page of the chapter following pageIDs
+func (r *mangaChapterRepository) ReorderPages(ctx context.Context, chapterID uuid.UUID, pageIDs []uuid.UUID) ([]m.MangaPage, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := lockMangaChapter(ctx, tx, chapterID); err != nil {
+		return nil, err
+	}
+
+	// The new order must be a permutation of the chapter's pages
+	var total, matched int
+	err = tx.QueryRow(ctx, `
+		SELECT COUNT(*), COUNT(*) FILTER (WHERE id = ANY($2))
+		FROM manga_page
+		WHERE chapter_id = $1`, chapterID, pageIDs).Scan(&total, &matched)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check pages: %w", err)
+	}
+	if len(uniqueUUIDs(pageIDs)) != len(pageIDs) || matched != len(pageIDs) || total != len(pageIDs) {
+		return nil, fmt.Errorf("invalid page order: every page of the chapter must be listed exactly once")
+	}
+
+	// Flip to negative numbers first so UNIQUE(chapter_id, page_number) holds after every row
+	if _, err := tx.Exec(ctx, `UPDATE manga_page SET page_number = -page_number WHERE chapter_id = $1`, chapterID); err != nil {
+		return nil, fmt.Errorf("failed to reorder pages: %w", err)
+	}
+	_, err = tx.Exec(ctx, `
+		UPDATE manga_page p
+		SET page_number = o.ord, updated_at = CURRENT_TIMESTAMP
+		FROM unnest($2::uuid[]) WITH ORDINALITY AS o(id, ord)
+		WHERE p.id = o.id AND p.chapter_id = $1`, chapterID, pageIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reorder pages: %w", err)
+	}
+
+	pages, err := listMangaPages(ctx, tx, chapterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return pages, nil
+}
+
+// UpdatePage replaces the image of a page
+func (r *mangaChapterRepository) UpdatePage(ctx context.Context, pageID uuid.UUID, imageURL string) (*m.MangaPage, error) {
+	page, err := scanMangaPage(r.pool.QueryRow(ctx, `
+		UPDATE manga_page
+		SET image_url = $2, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1
+		RETURNING `+mangaPageColumns, pageID, imageURL))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("page not found")
+		}
+		return nil, fmt.Errorf("failed to update page: %w", err)
+	}
+	return page, nil
+}
+
+// DeletePage removes a page and moves the following pages forward
+func (r *mangaChapterRepository) DeletePage(ctx context.Context, pageID uuid.UUID) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var chapterID uuid.UUID
+	if err := tx.QueryRow(ctx, `SELECT chapter_id FROM manga_page WHERE id = $1`, pageID).Scan(&chapterID); err != nil {
+		if err == pgx.ErrNoRows {
+			return fmt.Errorf("page not found")
+		}
+		return fmt.Errorf("failed to get page: %w", err)
+	}
+
+	if err := lockMangaChapter(ctx, tx, chapterID); err != nil {
+		return err
+	}
+
+	var pageNumber int
+	if err := tx.QueryRow(ctx, `DELETE FROM manga_page WHERE id = $1 RETURNING page_number`, pageID).Scan(&pageNumber); err != nil {
+		if err == pgx.ErrNoRows {
+			return fmt.Errorf("page not found")
+		}
+		return fmt.Errorf("failed to delete page: %w", err)
+	}
+
+	if err := shiftMangaPages(ctx, tx, chapterID, pageNumber, -1); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetVolumeMangaID returns the manga a volume belongs to
+func (r *mangaChapterRepository) GetVolumeMangaID(ctx context.Context, volumeID uuid.UUID) (uuid.UUID, error) {
+	var mangaID uuid.UUID
+	err := r.pool.QueryRow(ctx, `
+		SELECT v.manga_id
+		FROM manga_volume v
+		JOIN manga mg ON mg.id = v.manga_id
+		WHERE v.id = $1 AND mg.is_deleted = FALSE`, volumeID).Scan(&mangaID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return uuid.Nil, fmt.Errorf("volume not found")
+		}
+		return uuid.Nil, fmt.Errorf("failed to get volume manga: %w", err)
+	}
+	return mangaID, nil
+}
+
+// GetChapterMangaID returns the manga a chapter belongs to
+func (r *mangaChapterRepository) GetChapterMangaID(ctx context.Context, chapterID uuid.UUID) (uuid.UUID, error) {
+	var mangaID uuid.UUID
+	err := r.pool.QueryRow(ctx, `
+		SELECT v.manga_id
+		FROM manga_chapter c
+		JOIN manga_volume v ON v.id = c.volume_id
+		JOIN manga mg ON mg.id = v.manga_id
+		WHERE c.id = $1 AND mg.is_deleted = FALSE`, chapterID).Scan(&mangaID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return uuid.Nil, fmt.Errorf("chapter not found")
+		}
+		return uuid.Nil, fmt.Errorf("failed to get chapter manga: %w", err)
+	}
+	return mangaID, nil
+}
+
+// GetPageChapter returns the chapter and manga a page belongs to
+func (r *mangaChapterRepository) GetPageChapter(ctx context.Context, pageID uuid.UUID) (uuid.UUID, uuid.UUID, error) {
+	var chapterID, mangaID uuid.UUID
+	err := r.pool.QueryRow(ctx, `
+		SELECT p.chapter_id, v.manga_id
+		FROM manga_page p
+		JOIN manga_chapter c ON c.id = p.chapter_id
+		JOIN manga_volume v ON v.id = c.volume_id
+		JOIN manga mg ON mg.id = v.manga_id
+		WHERE p.id = $1 AND mg.is_deleted = FALSE`, pageID).Scan(&chapterID, &mangaID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return uuid.Nil, uuid.Nil, fmt.Errorf("page not found")
+		}
+		return uuid.Nil, uuid.Nil, fmt.Errorf("failed to get page chapter: %w", err)
+	}
+	return chapterID, mangaID, nil
+}
+
+// IsMangaPubliclyVisible reports whether readers without ownership can see the manga
+func (r *mangaChapterRepository) IsMangaPubliclyVisible(ctx context.Context, mangaID uuid.UUID) (bool, error) {
+	var visible bool
+	err := r.pool.QueryRow(ctx, `
+		SELECT EXISTS(
+			SELECT 1 FROM manga
+			WHERE id = $1 AND is_deleted = FALSE AND is_public = TRUE AND access_level = 'PUBLIC'
+		)`, mangaID).Scan(&visible)
+	if err != nil {
+		return false, fmt.Errorf("failed to check manga visibility: %w", err)
+	}
+	return visible, nil
+}
+
+// CanReadChapter checks chapter/volume/series purchases and unexpired volume/series rentals
+func (r *mangaChapterRepository) CanReadChapter(ctx context.Context, chapterID, userID uuid.UUID) (bool, error) {
+	query := `
+		WITH ch AS (
+			SELECT c.id AS chapter_id, v.id AS volume_id, v.manga_id
+			FROM manga_chapter c
+			JOIN manga_volume v ON v.id = c.volume_id
+			WHERE c.id = $1
+		)
+		SELECT EXISTS(
+			SELECT 1 FROM user_content_purchases ucp, ch
+			WHERE ucp.user_id = $2
+			  AND ((ucp.item_type = 'MANGA_CHAPTER' AND ucp.item_id = ch.chapter_id)
+				OR (ucp.item_type = 'MANGA_VOLUME' AND ucp.item_id = ch.volume_id)
+				OR (ucp.item_type = 'MANGA_SERIES' AND ucp.item_id = ch.manga_id))
+			UNION
+			SELECT 1 FROM user_content_rentals ucr, ch
+			WHERE ucr.user_id = $2
+			  AND ucr.expiry_date > CURRENT_TIMESTAMP
+			  AND ((ucr.item_type = 'MANGA_VOLUME' AND ucr.item_id = ch.volume_id)
+				OR (ucr.item_type = 'MANGA_SERIES' AND ucr.item_id = ch.manga_id))
+		)`
+
+	var allowed bool
+	if err := r.pool.QueryRow(ctx, query, chapterID, userID).Scan(&allowed); err != nil {
+		return false, fmt.Errorf("failed to check chapter entitlement: %w", err)
+	}
+	return allowed, nil
+}
+
+// mangaPageQuerier is satisfied by both the pool and a transaction
+type mangaPageQuerier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+// listMangaPages lists the pages of a chapter in reading order
+func listMangaPages(ctx context.Context, q mangaPageQuerier, chapterID uuid.UUID) ([]m.MangaPage, error) {
+	rows, err := q.Query(ctx, `SELECT `+mangaPageColumns+` FROM manga_page WHERE chapter_id = $1 ORDER BY page_number`, chapterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pages: %w", err)
+	}
+	defer rows.Close()
+
+	pages := make([]m.MangaPage, 0)
+	for rows.Next() {
+		page, err := scanMangaPage(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan page: %w", err)
+		}
+		pages = append(pages, *page)
+	}
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate pages: %w", rows.Err())
+	}
+
+	return pages, nil
+}
+
+// lockMangaChapter locks a chapter row so concurrent page edits are serialized
+func lockMangaChapter(ctx context.Context, tx pgx.Tx, chapterID uuid.UUID) error {
+	var id uuid.UUID
+	err := tx.QueryRow(ctx, `SELECT id FROM manga_chapter WHERE id = $1 FOR UPDATE`, chapterID).Scan(&id)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return fmt.Errorf("chapter not found")
+		}
+		return fmt.Errorf("failed to lock chapter: %w", err)
+	}
+	return nil
+}
+
+// shiftMangaPages moves every page after the given page number by delta. The
+// non-deferrable UNIQUE(chapter_id, page_number) is checked row by row, so the
+// pages are parked on negative numbers before taking their final position.
+func shiftMangaPages(ctx context.Context, tx pgx.Tx, chapterID uuid.UUID, after, delta int) error {
+	_, err := tx.Exec(ctx, `
+		UPDATE manga_page
+		SET page_number = -(page_number + $3), updated_at = CURRENT_TIMESTAMP
+		WHERE chapter_id = $1 AND page_number > $2`, chapterID, after, delta)
+	if err != nil {
+		return fmt.Errorf("failed to renumber pages: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE manga_page SET page_number = -page_number WHERE chapter_id = $1 AND page_number < 0`, chapterID); err != nil {
+		return fmt.Errorf("failed to renumber pages: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,750 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+)
+
+// MangaRepository defines data access for manga series and their localized titles
+type MangaRepository interface {
+	CreateManga(ctx context.Context, req d.CreateMangaRequest) (*m.Manga, error)
+	GetMangaByID(ctx context.Context, id uuid.UUID) (*m.Manga, error)
+	// GetMangaDetail loads a manga with genres, creators, characters, volumes and translations
+	GetMangaDetail(ctx context.Context, id uuid.UUID, language string) (*d.MangaDetailResponse, error)
+	// ListManga lists publicly visible manga
+	ListManga(ctx context.Context, req d.ListMangaRequest, language string) (*d.PaginatedMangaResponse, error)
+	UpdateManga(ctx context.Context, id uuid.UUID, req d.UpdateMangaRequest) (*m.Manga, error)
+	DeleteManga(ctx context.Context, id uuid.UUID, deletedByUserID uuid.UUID) error
+	CheckMangaPurchases(ctx context.Context, mangaID uuid.UUID) (bool, error)
+	// SetTranslations replaces all localized titles of a manga
+	SetTranslations(ctx context.Context, mangaID uuid.UUID, translations []d.MangaTranslationInput) error
+	// CanManageManga checks ownership and falls back to the given collaborator permission
+	CanManageManga(ctx context.Context, mangaID, userID uuid.UUID, tenantID *uuid.UUID, permission string) (bool, error)
+}
+
+// mangaRepository implements MangaRepository interface
+type mangaRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewMangaRepository creates a new manga repository instance
+func NewMangaRepository(pool *pgxpool.Pool) MangaRepository {
+	return &mangaRepository{pool: pool}
+}
+
+// mangaColumns lists the manga columns in the order scanned by scanManga
+const mangaColumns = `
+	id, name, status, cover_image, summary,
+	ownership_type, primary_owner_id, original_creator_id, access_level,
+	last_modified_by_user_id, ownership_transferred_at,
+	original_language, age_rating, mature_content, is_public, published_at,
+	is_deleted, deleted_at, deleted_by_user_id,
+	created_at, updated_at`
+
+// scanManga scans a row selected with mangaColumns
+func scanManga(row pgx.Row) (*m.Manga, error) {
+	var manga m.Manga
+	err := row.Scan(
+		&manga.ID, &manga.Name, &manga.Status, &manga.CoverImage, &manga.Summary,
+		&manga.OwnershipType, &manga.PrimaryOwnerID, &manga.OriginalCreatorID, &manga.AccessLevel,
+		&manga.LastModifiedByUserID, &manga.OwnershipTransferredAt,
+		&manga.OriginalLanguage, &manga.AgeRating, &manga.MatureContent, &manga.IsPublic, &manga.PublishedAt,
+		&manga.IsDeleted, &manga.DeletedAt, &manga.DeletedByUserID,
+		&manga.CreatedAt, &manga.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &manga, nil
+}
+
+// CreateManga creates a manga with its genres, creators and translations
+func (r *mangaRepository) CreateManga(ctx context.Context, req d.CreateMangaRequest) (*m.Manga, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Prepare nullable values
+	var coverImage, ageRating *string
+	if req.CoverImage != "" {
+		coverImage = &req.CoverImage
+	}
+	if req.AgeRating != "" {
+		ageRating = &req.AgeRating
+	}
+	var summaryBytes []byte
+	if req.Summary != nil {
+		summaryBytes = *req.Summary
+	}
+	originalLanguage := req.OriginalLanguage
+	if originalLanguage == "" {
+		originalLanguage = "ja"
+	}
+
+	query := `
+		INSERT INTO manga (
+			name, status, cover_image, summary,
+			ownership_type, primary_owner_id, original_creator_id, access_level, last_modified_by_user_id,
+			original_language, age_rating, mature_content, is_public, published_at,
+			created_at, updated_at
+		)
+		VALUES (
+			$1, $2, $3, $4,
+			$5, $6, $7, $8, $7,
+			$9, $10, $11, $12, CASE WHEN $12::boolean THEN CURRENT_TIMESTAMP END,
+			CURRENT_TIMESTAMP, CURRENT_TIMESTAMP
+		)
+		RETURNING` + mangaColumns
+
+	manga, err := scanManga(tx.QueryRow(ctx, query,
+		req.Title, req.Status, coverImage, summaryBytes,
+		req.OwnershipType, req.PrimaryOwnerID, req.OriginalCreatorID, req.AccessLevel,
+		originalLanguage, ageRating, req.MatureContent, req.IsPublic,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create manga: %w", err)
+	}
+
+	if err := replaceMangaGenres(ctx, tx, manga.ID, req.Genres); err != nil {
+		return nil, err
+	}
+
+	// Create manga-creator associations
+	for _, creator := range req.Creators {
+		creatorID, err := uuid.Parse(creator.CreatorID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid creator ID %s: %w", creator.CreatorID, err)
+		}
+
+		_, err = tx.Exec(ctx, `
+			INSERT INTO manga_creator (manga_id, creator_id, role, created_at, updated_at)
+			VALUES ($1, $2, $3, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+			ON CONFLICT (manga_id, creator_id, role) DO NOTHING
+		`, manga.ID, creatorID, creator.Role)
+		if err != nil {
+			return nil, fmt.Errorf("failed to associate manga with creator %s: %w", creatorID, err)
+		}
+	}
+
+	if err := replaceMangaTranslations(ctx, tx, manga.ID, req.Translations); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return manga, nil
+}
+
+// GetMangaByID retrieves a non-deleted manga by ID
+func (r *mangaRepository) GetMangaByID(ctx context.Context, id uuid.UUID) (*m.Manga, error) {
+	manga, err := scanManga(r.pool.QueryRow(ctx, `SELECT`+mangaColumns+` FROM manga WHERE id = $1 AND is_deleted = FALSE`, id))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("manga not found")
+		}
+		return nil, fmt.Errorf("failed to get manga: %w", err)
+	}
+	return manga, nil
+}
+
+// GetMangaDetail loads the manga and its associations with a few focused queries
+func (r *mangaRepository) GetMangaDetail(ctx context.Context, id uuid.UUID, language string) (*d.MangaDetailResponse, error) {
+	manga, err := r.GetMangaByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &d.MangaDetailResponse{
+		ID:               manga.ID.String(),
+		OriginalName:     manga.Name,
+		CoverImage:       manga.CoverImage,
+		Summary:          manga.Summary,
+		Status:           manga.Status,
+		OriginalLanguage: manga.OriginalLanguage,
+		CurrentLanguage:  language,
+		AgeRating:        manga.AgeRating,
+		MatureContent:    manga.MatureContent,
+		IsPublic:         manga.IsPublic,
+		AccessLevel:      manga.AccessLevel,
+		OwnershipType:    manga.OwnershipType,
+		PublishedAt:      manga.PublishedAt,
+		Genres:           make([]d.GenreInfo, 0),
+		Creators:         make([]d.CreatorInfo, 0),
+		Characters:       make([]d.CharacterInfo, 0),
+		Volumes:          make([]d.MangaVolumeSummary, 0),
+		Translations:     make([]d.MangaTranslationResponse, 0),
+		CreatedAt:        manga.CreatedAt,
+		UpdatedAt:        manga.UpdatedAt,
+	}
+	if manga.Name != nil {
+		response.Name = *manga.Name
+	}
+	if manga.PrimaryOwnerID != nil {
+		ownerID := manga.PrimaryOwnerID.String()
+		response.PrimaryOwnerID = &ownerID
+	}
+
+	// Translations: the primary title of the client language replaces the default name
+	rows, err := r.pool.Query(ctx, `
+		SELECT language_code, title, description, is_primary
+		FROM manga_translation
+		WHERE manga_id = $1
+		ORDER BY language_code, is_primary DESC, title`, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load manga translations: %w", err)
+	}
+	defer rows.Close()
+
+	localized := false
+	for rows.Next() {
+		var translation d.MangaTranslationResponse
+		if err := rows.Scan(&translation.LanguageCode, &translation.Title, &translation.Description, &translation.IsPrimary); err != nil {
+			return nil, fmt.Errorf("failed to scan manga translation: %w", err)
+		}
+		if !localized && translation.LanguageCode == language {
+			response.Name = translation.Title
+			response.Description = translation.Description
+			localized = true
+		}
+		response.Translations = append(response.Translations, translation)
+	}
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate manga translations: %w", rows.Err())
+	}
+
+	// Genres
+	genreRows, err := r.pool.Query(ctx, `
+		SELECT g.id, g.name
+		FROM manga_genre mg
+		JOIN genre g ON g.id = mg.genre_id
+		WHERE mg.manga_id = $1
+		ORDER BY g.name`, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load manga genres: %w", err)
+	}
+	defer genreRows.Close()
+
+	for genreRows.Next() {
+		var genreID uuid.UUID
+		var genre d.GenreInfo
+		if err := genreRows.Scan(&genreID, &genre.Name); err != nil {
+			return nil, fmt.Errorf("failed to scan manga genre: %w", err)
+		}
+		genre.ID = genreID.String()
+		response.Genres = append(response.Genres, genre)
+	}
+	if genreRows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate manga genres: %w", genreRows.Err())
+	}
+
+	// Creators (author, artist)
+	creatorRows, err := r.pool.Query(ctx, `
+		SELECT c.id, c.name, mc.role
+		FROM manga_creator mc
+		JOIN creator c ON c.id = mc.creator_id
+		WHERE mc.manga_id = $1
+		ORDER BY mc.role, c.name`, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load manga creators: %w", err)
+	}
+	defer creatorRows.Close()
+
+	for creatorRows.Next() {
+		var creatorID uuid.UUID
+		var creator d.CreatorInfo
+		if err := creatorRows.Scan(&creatorID, &creator.Name, &creator.Role); err != nil {
+			return nil, fmt.Errorf("failed to scan manga creator: %w", err)
+		}
+		creator.ID = creatorID.String()
+		response.Creators = append(response.Creators, creator)
+	}
+	if creatorRows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate manga creators: %w", creatorRows.Err())
+	}
+
+	// Characters
+	characterRows, err := r.pool.Query(ctx, `
+		SELECT ch.id, ch.name
+		FROM manga_character mc
+		JOIN character ch ON ch.id = mc.character_id
+		WHERE mc.manga_id = $1
+		ORDER BY ch.name`, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load manga characters: %w", err)
+	}
+	defer characterRows.Close()
+
+	for characterRows.Next() {
+		var characterID uuid.UUID
+		var character d.CharacterInfo
+		if err := characterRows.Scan(&characterID, &character.Name); err != nil {
+			return nil, fmt.Errorf("failed to scan manga character: %w", err)
+		}
+		character.ID = characterID.String()
+		response.Characters = append(response.Characters, character)
+	}
+	if characterRows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate manga characters: %w", characterRows.Err())
+	}
+
+	// Volumes with their published chapter count
+	volumeRows, err := r.pool.Query(ctx, `
+		SELECT v.id, v.volume_number, v.volume_title, v.cover_image, v.description,
+			v.price_coins, v.rental_price_coins, v.rental_duration_days,
+			COUNT(c.id) FILTER (WHERE c.is_draft = FALSE)
+		FROM manga_volume v
+		LEFT JOIN manga_chapter c ON c.volume_id = v.id
+		WHERE v.manga_id = $1
+		GROUP BY v.id
+		ORDER BY v.volume_number`, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load manga volumes: %w", err)
+	}
+	defer volumeRows.Close()
+
+	for volumeRows.Next() {
+		var volumeID uuid.UUID
+		var volume d.MangaVolumeSummary
+		if err := volumeRows.Scan(&volumeID, &volume.VolumeNumber, &volume.VolumeTitle, &volume.CoverImage, &volume.Description,
+			&volume.PriceCoins, &volume.RentalPriceCoins, &volume.RentalDurationDays, &volume.ChapterCount); err != nil {
+			return nil, fmt.Errorf("failed to scan manga volume: %w", err)
+		}
+		volume.ID = volumeID.String()
+		response.Volumes = append(response.Volumes, volume)
+	}
+	if volumeRows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate manga volumes: %w", volumeRows.Err())
+	}
+
+	return response, nil
+}
+
+// ListManga lists public manga with filtering, sorting and pagination
+func (r *mangaRepository) ListManga(ctx context.Context, req d.ListMangaRequest, language string) (*d.PaginatedMangaResponse, error) {
+	// Set pagination defaults
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 20
+	}
+	if req.PageSize > 100 {
+		req.PageSize = 100
+	}
+
+	// Set default sorting
+	if req.SortBy == "" {
+		req.SortBy = "created_at"
+	}
+	if req.SortOrder == "" {
+		req.SortOrder = "desc"
+	}
+
+	conditions := []string{"mg.is_deleted = FALSE", "mg.is_public = TRUE", "mg.access_level = 'PUBLIC'"}
+	args := []interface{}{}
+	argIndex := 1
+
+	if req.Status != "" {
+		conditions = append(conditions, fmt.Sprintf("mg.status = $%d", argIndex))
+		args = append(args, req.Status)
+		argIndex++
+	}
+
+	if req.GenreID != nil {
+		conditions = append(conditions, fmt.Sprintf("EXISTS (SELECT 1 FROM manga_genre g WHERE g.manga_id = mg.id AND g.genre_id = $%d)", argIndex))
+		args = append(args, *req.GenreID)
+		argIndex++
+	}
+
+	if req.PrimaryOwnerID != nil {
+		conditions = append(conditions, fmt.Sprintf("mg.primary_owner_id = $%d", argIndex))
+		args = append(args, *req.PrimaryOwnerID)
+		argIndex++
+	}
+
+	if req.MatureContent != nil {
+		conditions = append(conditions, fmt.Sprintf("mg.mature_content = $%d", argIndex))
+		args = append(args, *req.MatureContent)
+		argIndex++
+	}
+
+	if req.Search != "" {
+		conditions = append(conditions, fmt.Sprintf(`(mg.name ILIKE $%d OR EXISTS (
+			SELECT 1 FROM manga_translation t WHERE t.manga_id = mg.id AND t.title ILIKE $%d))`, argIndex, argIndex))
+		args = append(args, "%"+req.Search+"%")
+		argIndex++
+	}
+
+	// Age gating and the viewer's filters, applied in the query so pages and totals only count visible manga
+	var visibility string
+	visibility, args, argIndex = contentVisibilityCondition(req.Visibility, "mg", "", args, argIndex)
+	if visibility != "" {
+		conditions = append(conditions, visibility)
+	}
+
+	whereClause := strings.Join(conditions, " AND ")
+
+	// Count total
+	var total int64
+	if err := r.pool.QueryRow(ctx, `SELECT COUNT(*) FROM manga mg WHERE `+whereClause, args...).Scan(&total); err != nil {
+		return nil, fmt.Errorf("failed to count manga: %w", err)
+	}
+
+	sortColumns := map[string]string{
+		"name":       "mg.name",
+		"created_at": "mg.created_at",
+		"updated_at": "mg.updated_at",
+	}
+	sortColumn, ok := sortColumns[req.SortBy]
+	if !ok {
+		sortColumn = "mg.created_at"
+	}
+	sortOrder := "DESC"
+	if req.SortOrder == "asc" {
+		sortOrder = "ASC"
+	}
+
+	offset := (req.Page - 1) * req.PageSize
+	query := fmt.Sprintf(`
+		SELECT mg.id,
+			COALESCE((
+				SELECT t.title FROM manga_translation t
+				WHERE t.manga_id = mg.id AND t.language_code = $%d
+				ORDER BY t.is_primary DESC, t.title
+				LIMIT 1
+			), mg.name, '') AS display_name,
+			mg.cover_image, mg.status, mg.mature_content,
+			(SELECT COUNT(*) FROM manga_volume v WHERE v.manga_id = mg.id),
+			(SELECT COUNT(*) FROM manga_chapter c JOIN manga_volume v ON v.id = c.volume_id
+			 WHERE v.manga_id = mg.id AND c.is_draft = FALSE),
+			mg.created_at
+		FROM manga mg
+		WHERE %s
+		ORDER BY %s %s NULLS LAST, mg.id
+		LIMIT $%d OFFSET $%d`, argIndex, whereClause, sortColumn, sortOrder, argIndex+1, argIndex+2)
+	args = append(args, language, req.PageSize, offset)
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list manga: %w", err)
+	}
+	defer rows.Close()
+
+	mangaList := make([]d.MangaSummaryResponse, 0)
+	for rows.Next() {
+		var mangaID uuid.UUID
+		var item d.MangaSummaryResponse
+		if err := rows.Scan(&mangaID, &item.Name, &item.CoverImage, &item.Status, &item.MatureContent,
+			&item.VolumeCount, &item.ChapterCount, &item.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan manga: %w", err)
+		}
+		item.ID = mangaID.String()
+		mangaList = append(mangaList, item)
+	}
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate manga: %w", rows.Err())
+	}
+
+	totalPages := int((total + int64(req.PageSize) - 1) / int64(req.PageSize))
+
+	return &d.PaginatedMangaResponse{
+		Manga: mangaList,
+		Pagination: d.PaginationMeta{
+			Page:        req.Page,
+			PageSize:    req.PageSize,
+			Total:       total,
+			TotalPages:  totalPages,
+			HasNext:     req.Page < totalPages,
+			HasPrevious: req.Page > 1,
+		},
+	}, nil
+}
+
+// UpdateManga applies the provided fields; genres are replaced when given
+func (r *mangaRepository) UpdateManga(ctx context.Context, id uuid.UUID, req d.UpdateMangaRequest) (*m.Manga, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Build dynamic update query
+	updateFields := []string{}
+	args := []interface{}{}
+	argIndex := 1
+
+	// Always update updated_at and the modifier
+	updateFields = append(updateFields, fmt.Sprintf("updated_at = $%d", argIndex))
+	args = append(args, time.Now())
+	argIndex++
+
+	updateFields = append(updateFields, fmt.Sprintf("last_modified_by_user_id = $%d", argIndex))
+	args = append(args, req.LastModifiedByUserID)
+	argIndex++
+
+	if req.Title != nil {
+		updateFields = append(updateFields, fmt.Sprintf("name = $%d", argIndex))
+		args = append(args, *req.Title)
+		argIndex++
+	}
+
+	if req.CoverImage != nil {
+		updateFields = append(updateFields, fmt.Sprintf("cover_image = $%d", argIndex))
+		args = append(args, *req.CoverImage)
+		argIndex++
+	}
+
+	if req.Summary != nil {
+		updateFields = append(updateFields, fmt.Sprintf("summary = $%d", argIndex))
+		args = append(args, *req.Summary)
+		argIndex++
+	}
+
+	if req.Status != nil {
+		updateFields = append(updateFields, fmt.Sprintf("status = $%d", argIndex))
+		args = append(args, *req.Status)
+		argIndex++
+	}
+
+	if req.OriginalLanguage != nil {
+		updateFields = append(updateFields, fmt.Sprintf("original_language = $%d", argIndex))
+		args = append(args, *req.OriginalLanguage)
+		argIndex++
+	}
+
+	if req.AgeRating != nil {
+		updateFields = append(updateFields, fmt.Sprintf("age_rating = $%d", argIndex))
+		args = append(args, *req.AgeRating)
+		argIndex++
+	}
+
+	if req.MatureContent != nil {
+		updateFields = append(updateFields, fmt.Sprintf("mature_content = $%d", argIndex))
+		args = append(args, *req.MatureContent)
+		argIndex++
+	}
+
+	if req.IsPublic != nil {
+		updateFields = append(updateFields, fmt.Sprintf("is_public = $%d", argIndex))
+		args = append(args, *req.IsPublic)
+		argIndex++
+
+		// First publication is recorded once
+		if *req.IsPublic {
+			updateFields = append(updateFields, "published_at = COALESCE(published_at, CURRENT_TIMESTAMP)")
+		}
+	}
+
+	if req.AccessLevel != nil {
+		updateFields = append(updateFields, fmt.Sprintf("access_level = $%d", argIndex))
+		args = append(args, *req.AccessLevel)
+		argIndex++
+	}
+
+	query := fmt.Sprintf(`
+		UPDATE manga
+		SET %s
+		WHERE id = $%d AND is_deleted = FALSE
+		RETURNING%s`, strings.Join(updateFields, ", "), argIndex, mangaColumns)
+	args = append(args, id)
+
+	manga, err := scanManga(tx.QueryRow(ctx, query, args...))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("manga not found")
+		}
+		return nil, fmt.Errorf("failed to update manga: %w", err)
+	}
+
+	if req.Genres != nil {
+		if err := replaceMangaGenres(ctx, tx, id, req.Genres); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return manga, nil
+}
+
+// CheckMangaPurchases checks if any users have purchased or rented content from this manga
+func (r *mangaRepository) CheckMangaPurchases(ctx context.Context, mangaID uuid.UUID) (bool, error) {
+	query := `
+		SELECT EXISTS(
+			SELECT 1 FROM user_content_purchases ucp
+			WHERE (ucp.item_type = 'MANGA_SERIES' AND ucp.item_id = $1)
+				OR (ucp.item_type = 'MANGA_VOLUME' AND ucp.item_id IN (
+					SELECT id FROM manga_volume WHERE manga_id = $1
+				))
+				OR (ucp.item_type = 'MANGA_CHAPTER' AND ucp.item_id IN (
+					SELECT c.id FROM manga_chapter c
+					JOIN manga_volume v ON v.id = c.volume_id
+					WHERE v.manga_id = $1
+				))
+			UNION
+			SELECT 1 FROM user_content_rentals ucr
+			WHERE (ucr.item_type = 'MANGA_SERIES' AND ucr.item_id = $1)
+				OR (ucr.item_type = 'MANGA_VOLUME' AND ucr.item_id IN (
+					SELECT id FROM manga_volume WHERE manga_id = $1
+				))
+		)
+	`
+
+	var hasPurchases bool
+	if err := r.pool.QueryRow(ctx, query, mangaID).Scan(&hasPurchases); err != nil {
+		return false, fmt.Errorf("failed to check manga purchases: %w", err)
+	}
+
+	return hasPurchases, nil
+}
+
+// DeleteManga performs soft delete on a manga after checking for purchases
+func (r *mangaRepository) DeleteManga(ctx context.Context, id uuid.UUID, deletedByUserID uuid.UUID) error {
+	hasPurchases, err := r.CheckMangaPurchases(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to check purchases: %w", err)
+	}
+	if hasPurchases {
+		return fmt.Errorf("cannot delete manga: users have purchased content from this manga")
+	}
+
+	tag, err := r.pool.Exec(ctx, `
+		UPDATE manga
+		SET is_deleted = TRUE, deleted_at = CURRENT_TIMESTAMP, deleted_by_user_id = $2, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND is_deleted = FALSE
+	`, id, deletedByUserID)
+	if err != nil {
+		return fmt.Errorf("failed to delete manga: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("manga not found or already deleted")
+	}
+
+	return nil
+}
+
+// SetTranslations replaces all localized titles of a manga
+func (r *mangaRepository) SetTranslations(ctx context.Context, mangaID uuid.UUID, translations []d.MangaTranslationInput) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var id uuid.UUID
+	err = tx.QueryRow(ctx, `SELECT id FROM manga WHERE id = $1 AND is_deleted = FALSE FOR UPDATE`, mangaID).Scan(&id)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return fmt.Errorf("manga not found")
+		}
+		return fmt.Errorf("failed to lock manga: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM manga_translation WHERE manga_id = $1`, mangaID); err != nil {
+		return fmt.Errorf("failed to clear manga translations: %w", err)
+	}
+
+	if err := replaceMangaTranslations(ctx, tx, mangaID, translations); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// CanManageManga checks ownership (user for PERSONAL/COLLABORATIVE, tenant for TENANT)
+// and falls back to the collaborator permission.
+func (r *mangaRepository) CanManageManga(ctx context.Context, mangaID, userID uuid.UUID, tenantID *uuid.UUID, permission string) (bool, error) {
+	query := `
+		SELECT
+			(mg.ownership_type = 'TENANT' AND $3::uuid IS NOT NULL AND mg.primary_owner_id = $3::uuid)
+			OR (mg.ownership_type <> 'TENANT' AND mg.primary_owner_id = $2)
+			OR has_collaborator_permission('MANGA', mg.id, $2, $4::collaborator_permission)
+		FROM manga mg
+		WHERE mg.id = $1 AND mg.is_deleted = FALSE`
+
+	var allowed *bool
+	err := r.pool.QueryRow(ctx, query, mangaID, userID, tenantID, permission).Scan(&allowed)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return false, fmt.Errorf("manga not found")
+		}
+		return false, fmt.Errorf("failed to check manga permission: %w", err)
+	}
+
+	return allowed != nil && *allowed, nil
+}
+
+// replaceMangaGenres validates the genres and replaces the manga's genre associations
+func replaceMangaGenres(ctx context.Context, tx pgx.Tx, mangaID uuid.UUID, genres []string) error {
+	genreIDs := make([]uuid.UUID, 0, len(genres))
+	for _, genreStr := range genres {
+		genreID, err := uuid.Parse(genreStr)
+		if err != nil {
+			return fmt.Errorf("invalid genre ID %s: %w", genreStr, err)
+		}
+		genreIDs = append(genreIDs, genreID)
+	}
+
+	if len(genreIDs) > 0 {
+		var existing int
+		if err := tx.QueryRow(ctx, `SELECT COUNT(*) FROM genre WHERE id = ANY($1)`, genreIDs).Scan(&existing); err != nil {
+			return fmt.Errorf("failed to validate genres: %w", err)
+		}
+		if existing != len(uniqueUUIDs(genreIDs)) {
+			return fmt.Errorf("some genres do not exist")
+		}
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM manga_genre WHERE manga_id = $1`, mangaID); err != nil {
+		return fmt.Errorf("failed to clear manga genres: %w", err)
+	}
+
+	for _, genreID := range genreIDs {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO manga_genre (manga_id, genre_id, created_at, updated_at)
+			VALUES ($1, $2, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+			ON CONFLICT (manga_id, genre_id) DO NOTHING
+		`, mangaID, genreID)
+		if err != nil {
+			return fmt.Errorf("failed to associate manga with genre %s: %w", genreID, err)
+		}
+	}
+
+	return nil
+}
+
+// replaceMangaTranslations inserts localized titles; callers clear existing rows first
+func replaceMangaTranslations(ctx context.Context, tx pgx.Tx, mangaID uuid.UUID, translations []d.MangaTranslationInput) error {
+	for _, translation := range translations {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO manga_translation (manga_id, language_code, title, description, is_primary, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+		`, mangaID, translation.LanguageCode, translation.Title, translation.Description, translation.IsPrimary)
+		if err != nil {
+			if strings.Contains(err.Error(), "duplicate key") {
+				return fmt.Errorf("invalid translations: duplicate title or primary title for language %s", translation.LanguageCode)
+			}
+			return fmt.Errorf("failed to save manga translation: %w", err)
+		}
+	}
+	return nil
+}
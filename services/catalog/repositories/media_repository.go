@@ -0,0 +1,336 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	m "wibusystem/pkg/common/model"
+)
+
+// mediaAssetColumns lists the media_assets columns read into m.MediaAsset
+const mediaAssetColumns = `
+	id, checksum_sha256, storage_key, content_type, size_bytes, original_filename,
+	uploaded_by_user_id, tenant_id, width, height, purposes, processing_status, attempts,
+	processing_error, processed_at, created_at`
+
+// mediaVariantColumns lists the media_variants columns read into m.MediaVariant
+const mediaVariantColumns = `asset_id, name, storage_key, content_type, width, height, size_bytes, created_at`
+
+// MediaRepository defines data access for uploaded files
+type MediaRepository interface {
+	// Create stores an asset; when the checksum already exists the existing asset is returned with created=false
+	Create(ctx context.Context, asset *m.MediaAsset) (*m.MediaAsset, bool, error)
+	// GetByID retrieves an asset by ID
+	GetByID(ctx context.Context, id uuid.UUID) (*m.MediaAsset, error)
+	// GetByChecksum retrieves the asset with the given SHA-256 checksum
+	GetByChecksum(ctx context.Context, checksum string) (*m.MediaAsset, error)
+	// GetByIDs retrieves assets keyed by ID; missing IDs are absent from the map
+	GetByIDs(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*m.MediaAsset, error)
+	// AddPurpose records another purpose for an asset and requeues processing for its renditions
+	AddPurpose(ctx context.Context, id uuid.UUID, purpose string) (*m.MediaAsset, error)
+
+	// Processing queue
+	// ClaimPending marks up to limit pending assets (or ones stuck in processing longer than staleAfter) as processing
+	ClaimPending(ctx context.Context, limit int, staleAfter time.Duration) ([]*m.MediaAsset, error)
+	// SaveVariant creates or replaces a rendition
+	SaveVariant(ctx context.Context, variant *m.MediaVariant) error
+	// MarkProcessed completes processing; the asset is requeued if purposes changed meanwhile
+	MarkProcessed(ctx context.Context, id uuid.UUID, purposes []string) error
+	// MarkFailed records a processing error, requeueing the asset until maxAttempts is reached
+	MarkFailed(ctx context.Context, id uuid.UUID, reason string, maxAttempts int) error
+	// ListVariants returns the renditions of the given assets keyed by asset ID
+	ListVariants(ctx context.Context, assetIDs []uuid.UUID) (map[uuid.UUID][]m.MediaVariant, error)
+
+	// Attachments
+	// SetAttachment attaches an asset to an entity image slot; a nil asset detaches it
+	SetAttachment(ctx context.Context, kind string, entityID uuid.UUID, assetID *uuid.UUID) error
+	// GetAttachments returns the attached asset IDs of the given entities keyed by entity ID
+	GetAttachments(ctx context.Context, kind string, entityIDs []uuid.UUID) (map[uuid.UUID]uuid.UUID, error)
+}
+
+// mediaRepository implements MediaRepository interface
+type mediaRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewMediaRepository creates a new media repository instance
+func NewMediaRepository(pool *pgxpool.Pool) MediaRepository {
+	return &mediaRepository{pool: pool}
+}
+
+// Create inserts the asset unless a concurrent upload of the same file already did
+func (r *mediaRepository) Create(ctx context.Context, asset *m.MediaAsset) (*m.MediaAsset, bool, error) {
+	query := `
+		INSERT INTO media_assets (
+			checksum_sha256, storage_key, content_type, size_bytes, original_filename, uploaded_by_user_id, tenant_id,
+			width, height, purposes, processing_status
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (checksum_sha256) DO NOTHING
+		RETURNING ` + mediaAssetColumns
+
+	row := r.pool.QueryRow(ctx, query,
+		asset.ChecksumSHA256, asset.StorageKey, asset.ContentType, asset.SizeBytes,
+		asset.OriginalFilename, asset.UploadedByUserID, asset.TenantID,
+		asset.Width, asset.Height, asset.Purposes, asset.ProcessingStatus,
+	)
+	created, err := scanMediaAsset(row)
+	if err == nil {
+		return created, true, nil
+	}
+	if err != pgx.ErrNoRows {
+		return nil, false, fmt.Errorf("failed to create media asset: %w", err)
+	}
+
+	existing, err := r.GetByChecksum(ctx, asset.ChecksumSHA256)
+	if err != nil {
+		return nil, false, err
+	}
+	return existing, false, nil
+}
+
+// GetByID retrieves an asset by its ID
+func (r *mediaRepository) GetByID(ctx context.Context, id uuid.UUID) (*m.MediaAsset, error) {
+	row := r.pool.QueryRow(ctx, `SELECT `+mediaAssetColumns+` FROM media_assets WHERE id = $1`, id)
+
+	asset, err := scanMediaAsset(row)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("media not found")
+		}
+		return nil, fmt.Errorf("failed to get media asset: %w", err)
+	}
+	return asset, nil
+}
+
+// GetByChecksum retrieves an asset by its content checksum
+func (r *mediaRepository) GetByChecksum(ctx context.Context, checksum string) (*m.MediaAsset, error) {
+	row := r.pool.QueryRow(ctx, `SELECT `+mediaAssetColumns+` FROM media_assets WHERE checksum_sha256 = $1`, checksum)
+
+	asset, err := scanMediaAsset(row)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("media not found")
+		}
+		return nil, fmt.Errorf("failed to get media asset: %w", err)
+	}
+	return asset, nil
+}
+
+// GetByIDs retrieves several assets in one query
+func (r *mediaRepository) GetByIDs(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*m.MediaAsset, error) {
+	assets := make(map[uuid.UUID]*m.MediaAsset, len(ids))
+	if len(ids) == 0 {
+		return assets, nil
+	}
+
+	rows, err := r.pool.Query(ctx, `SELECT `+mediaAssetColumns+` FROM media_assets WHERE id = ANY($1)`, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get media assets: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		asset, err := scanMediaAsset(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan media asset: %w", err)
+		}
+		assets[asset.ID] = asset
+	}
+	return assets, rows.Err()
+}
+
+// AddPurpose appends a purpose once; finished assets go back to pending so the new renditions are derived
+func (r *mediaRepository) AddPurpose(ctx context.Context, id uuid.UUID, purpose string) (*m.MediaAsset, error) {
+	query := `
+		UPDATE media_assets
+		SET purposes = CASE WHEN $2 = ANY(purposes) THEN purposes ELSE array_append(purposes, $2::VARCHAR(20)) END,
+			processing_status = CASE
+				WHEN $2 = ANY(purposes) OR processing_status NOT IN ('ready', 'failed') THEN processing_status
+				ELSE 'pending'
+			END,
+			attempts = CASE WHEN $2 = ANY(purposes) THEN attempts ELSE 0 END
+		WHERE id = $1
+		RETURNING ` + mediaAssetColumns
+
+	asset, err := scanMediaAsset(r.pool.QueryRow(ctx, query, id, purpose))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("media not found")
+		}
+		return nil, fmt.Errorf("failed to add media purpose: %w", err)
+	}
+	return asset, nil
+}
+
+// ClaimPending locks the oldest queued assets with SKIP LOCKED so several workers never share one
+func (r *mediaRepository) ClaimPending(ctx context.Context, limit int, staleAfter time.Duration) ([]*m.MediaAsset, error) {
+	query := `
+		UPDATE media_assets
+		SET processing_status = 'processing', processing_started_at = NOW(), attempts = attempts + 1
+		WHERE id IN (
+			SELECT id FROM media_assets
+			WHERE processing_status = 'pending'
+			   OR (processing_status = 'processing' AND processing_started_at < NOW() - make_interval(secs => $2))
+			ORDER BY created_at
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + mediaAssetColumns
+
+	rows, err := r.pool.Query(ctx, query, limit, staleAfter.Seconds())
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim pending media: %w", err)
+	}
+	defer rows.Close()
+
+	assets := make([]*m.MediaAsset, 0)
+	for rows.Next() {
+		asset, err := scanMediaAsset(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan media asset: %w", err)
+		}
+		assets = append(assets, asset)
+	}
+	return assets, rows.Err()
+}
+
+// SaveVariant upserts a rendition so reprocessing replaces earlier output
+func (r *mediaRepository) SaveVariant(ctx context.Context, variant *m.MediaVariant) error {
+	query := `
+		INSERT INTO media_variants (asset_id, name, storage_key, content_type, width, height, size_bytes)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (asset_id, name) DO UPDATE SET
+			storage_key = EXCLUDED.storage_key,
+			content_type = EXCLUDED.content_type,
+			width = EXCLUDED.width,
+			height = EXCLUDED.height,
+			size_bytes = EXCLUDED.size_bytes,
+			created_at = CURRENT_TIMESTAMP`
+
+	_, err := r.pool.Exec(ctx, query,
+		variant.AssetID, variant.Name, variant.StorageKey, variant.ContentType,
+		variant.Width, variant.Height, variant.SizeBytes,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save media variant: %w", err)
+	}
+	return nil
+}
+
+// MarkProcessed sets the asset ready unless a purpose was added while it was being processed
+func (r *mediaRepository) MarkProcessed(ctx context.Context, id uuid.UUID, purposes []string) error {
+	query := `
+		UPDATE media_assets
+		SET processing_status = CASE WHEN purposes = $2::VARCHAR(20)[] THEN 'ready' ELSE 'pending' END,
+			processing_error = NULL,
+			processed_at = NOW()
+		WHERE id = $1`
+
+	if _, err := r.pool.Exec(ctx, query, id, purposes); err != nil {
+		return fmt.Errorf("failed to mark media processed: %w", err)
+	}
+	return nil
+}
+
+// MarkFailed stores the error and retries until the attempt budget is spent
+func (r *mediaRepository) MarkFailed(ctx context.Context, id uuid.UUID, reason string, maxAttempts int) error {
+	query := `
+		UPDATE media_assets
+		SET processing_status = CASE WHEN attempts >= $3 THEN 'failed' ELSE 'pending' END,
+			processing_error = $2
+		WHERE id = $1`
+
+	if _, err := r.pool.Exec(ctx, query, id, reason, maxAttempts); err != nil {
+		return fmt.Errorf("failed to mark media failed: %w", err)
+	}
+	return nil
+}
+
+// ListVariants loads the renditions of several assets in one query
+func (r *mediaRepository) ListVariants(ctx context.Context, assetIDs []uuid.UUID) (map[uuid.UUID][]m.MediaVariant, error) {
+	variants := make(map[uuid.UUID][]m.MediaVariant, len(assetIDs))
+	if len(assetIDs) == 0 {
+		return variants, nil
+	}
+
+	rows, err := r.pool.Query(ctx,
+		`SELECT `+mediaVariantColumns+` FROM media_variants WHERE asset_id = ANY($1) ORDER BY asset_id, width`, assetIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list media variants: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var v m.MediaVariant
+		if err := rows.Scan(&v.AssetID, &v.Name, &v.StorageKey, &v.ContentType, &v.Width, &v.Height, &v.SizeBytes, &v.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan media variant: %w", err)
+		}
+		variants[v.AssetID] = append(variants[v.AssetID], v)
+	}
+	return variants, rows.Err()
+}
+
+// SetAttachment replaces the asset of an entity image slot, or clears it when assetID is nil
+func (r *mediaRepository) SetAttachment(ctx context.Context, kind string, entityID uuid.UUID, assetID *uuid.UUID) error {
+	if assetID == nil {
+		if _, err := r.pool.Exec(ctx, `DELETE FROM media_attachments WHERE kind = $1 AND entity_id = $2`, kind, entityID); err != nil {
+			return fmt.Errorf("failed to detach media: %w", err)
+		}
+		return nil
+	}
+
+	query := `
+		INSERT INTO media_attachments (kind, entity_id, asset_id)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (kind, entity_id) DO UPDATE SET asset_id = EXCLUDED.asset_id, attached_at = CURRENT_TIMESTAMP`
+
+	if _, err := r.pool.Exec(ctx, query, kind, entityID, *assetID); err != nil {
+		return fmt.Errorf("failed to attach media: %w", err)
+	}
+	return nil
+}
+
+// GetAttachments looks up the attached assets of several entities of one kind
+func (r *mediaRepository) GetAttachments(ctx context.Context, kind string, entityIDs []uuid.UUID) (map[uuid.UUID]uuid.UUID, error) {
+	attachments := make(map[uuid.UUID]uuid.UUID, len(entityIDs))
+	if len(entityIDs) == 0 {
+		return attachments, nil
+	}
+
+	rows, err := r.pool.Query(ctx,
+		`SELECT entity_id, asset_id FROM media_attachments WHERE kind = $1 AND entity_id = ANY($2)`, kind, entityIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get media attachments: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var entityID, assetID uuid.UUID
+		if err := rows.Scan(&entityID, &assetID); err != nil {
+			return nil, fmt.Errorf("failed to scan media attachment: %w", err)
+		}
+		attachments[entityID] = assetID
+	}
+	return attachments, rows.Err()
+}
+
+// scanMediaAsset scans one row selected with mediaAssetColumns
+func scanMediaAsset(row pgx.Row) (*m.MediaAsset, error) {
+	var asset m.MediaAsset
+	err := row.Scan(
+		&asset.ID, &asset.ChecksumSHA256, &asset.StorageKey, &asset.ContentType, &asset.SizeBytes,
+		&asset.OriginalFilename, &asset.UploadedByUserID, &asset.TenantID, &asset.Width, &asset.Height,
+		&asset.Purposes, &asset.ProcessingStatus, &asset.Attempts, &asset.ProcessingError, &asset.ProcessedAt,
+		&asset.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &asset, nil
+}
@@ -0,0 +1,534 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+)
+
+// moderationCaseColumns lists the moderation_case columns read into m.ModerationCase,
+// followed by the per-reason report counts of the case
+const moderationCaseColumns = `
+	mc.id, mc.target_type, mc.target_id, mc.status, mc.report_count,
+	mc.first_reported_at, mc.last_reported_at, mc.assigned_to, mc.assigned_at,
+	mc.resolution_action, mc.resolution_note, mc.resolved_by, mc.resolved_at,
+	mc.created_at, mc.updated_at,
+	COALESCE((
+		SELECT jsonb_object_agg(rc.reason, rc.cnt)
+		FROM (SELECT reason, COUNT(*) AS cnt FROM content_report WHERE case_id = mc.id GROUP BY reason) rc
+	), '{}'::jsonb)`
+
+// ModerationResolution describes how a case is closed
+type ModerationResolution struct {
+	Action      m.ModerationAction
+	Note        *string
+	ModeratorID uuid.UUID
+	UserID      *uuid.UUID // Người bị cảnh cáo/đình chỉ (WARN_USER, SUSPEND_USER)
+}
+
+// ModerationRepository defines data access for content reports and the moderation queue
+type ModerationRepository interface {
+	// CreateReport attaches a report to the open case of the target, creating the case if needed
+	CreateReport(ctx context.Context, report m.ContentReport, targetType m.ReportTargetType, targetID uuid.UUID) (*d.CreateReportResponse, error)
+	// ListCases reads a page of the moderation queue
+	ListCases(ctx context.Context, req d.ListModerationCasesRequest, assignedTo *uuid.UUID, unassigned bool) (*d.PaginatedModerationCasesResponse, error)
+	// GetCase reads a case with its reports and audit trail
+	GetCase(ctx context.Context, caseID uuid.UUID) (*d.ModerationCaseDetailResponse, error)
+	// AssignCase assigns an open case; when onlyIfFree is set it fails if another moderator holds it
+	AssignCase(ctx context.Context, caseID, actorID, moderatorID uuid.UUID, onlyIfFree bool) error
+	// ResolveCase applies the local effect of the action and closes the case
+	ResolveCase(ctx context.Context, caseID uuid.UUID, resolution ModerationResolution) error
+	// GetOpenCaseTarget returns the target of a case that is still OPEN or IN_REVIEW
+	GetOpenCaseTarget(ctx context.Context, caseID uuid.UUID) (m.ReportTargetType, uuid.UUID, error)
+	// FindTargetOwner resolves the user responsible for a target, if it can be derived locally
+	FindTargetOwner(ctx context.Context, targetType m.ReportTargetType, targetID uuid.UUID) (*uuid.UUID, error)
+}
+
+// moderationRepository implements ModerationRepository interface
+type moderationRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewModerationRepository creates a new moderation repository instance
+func NewModerationRepository(pool *pgxpool.Pool) ModerationRepository {
+	return &moderationRepository{pool: pool}
+}
+
+// CreateReport aggregates duplicate reports: every report on the same target joins the
+// single OPEN/IN_REVIEW case of that target, and a reporter may report a case only once.
+func (r *moderationRepository) CreateReport(ctx context.Context, report m.ContentReport, targetType m.ReportTargetType, targetID uuid.UUID) (*d.CreateReportResponse, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Targets stored in the catalog must exist; comments, reviews and users live elsewhere
+	var existsQuery string
+	switch targetType {
+	case m.ReportTargetNovel:
+		existsQuery = `SELECT EXISTS(SELECT 1 FROM novel WHERE id = $1 AND is_deleted = false)`
+	case m.ReportTargetChapter:
+		existsQuery = `SELECT EXISTS(SELECT 1 FROM novel_chapter WHERE id = $1 AND is_deleted = false)`
+	}
+	if existsQuery != "" {
+		var exists bool
+		if err := tx.QueryRow(ctx, existsQuery, targetID).Scan(&exists); err != nil {
+			return nil, fmt.Errorf("failed to check report target: %w", err)
+		}
+		if !exists {
+			return nil, fmt.Errorf("report target not found")
+		}
+	}
+
+	var caseID uuid.UUID
+	err = tx.QueryRow(ctx, `
+		INSERT INTO moderation_case (target_type, target_id)
+		VALUES ($1, $2)
+		ON CONFLICT (target_type, target_id) WHERE status IN ('OPEN', 'IN_REVIEW')
+		DO UPDATE SET updated_at = NOW()
+		RETURNING id`, targetType, targetID).Scan(&caseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open moderation case: %w", err)
+	}
+
+	var reportID uuid.UUID
+	err = tx.QueryRow(ctx, `
+		INSERT INTO content_report (case_id, reporter_id, reason, details)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (case_id, reporter_id) DO NOTHING
+		RETURNING id`, caseID, report.ReporterID, report.Reason, report.Details).Scan(&reportID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("target already reported by this user")
+		}
+		return nil, fmt.Errorf("failed to insert report: %w", err)
+	}
+
+	var reportCount int
+	err = tx.QueryRow(ctx, `
+		UPDATE moderation_case
+		SET report_count = report_count + 1, last_reported_at = NOW(), updated_at = NOW()
+		WHERE id = $1
+		RETURNING report_count`, caseID).Scan(&reportCount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update report count: %w", err)
+	}
+
+	if err := insertModerationAudit(ctx, tx, caseID, report.ReporterID, "REPORTED", map[string]interface{}{
+		"report_id": reportID,
+		"reason":    report.Reason,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return &d.CreateReportResponse{
+		ReportID:    reportID.String(),
+		CaseID:      caseID.String(),
+		ReportCount: reportCount,
+	}, nil
+}
+
+// ListCases orders the queue by report volume, then recency. Without a status filter
+// only cases still waiting for a decision are listed.
+func (r *moderationRepository) ListCases(ctx context.Context, req d.ListModerationCasesRequest, assignedTo *uuid.UUID, unassigned bool) (*d.PaginatedModerationCasesResponse, error) {
+	// Set pagination defaults
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 20
+	}
+	if req.PageSize > 100 {
+		req.PageSize = 100
+	}
+
+	conditions := []string{}
+	args := []interface{}{}
+	argIndex := 1
+
+	if req.Status != "" {
+		conditions = append(conditions, fmt.Sprintf("mc.status = $%d", argIndex))
+		args = append(args, req.Status)
+		argIndex++
+	} else {
+		conditions = append(conditions, "mc.status IN ('OPEN', 'IN_REVIEW')")
+	}
+
+	if req.TargetType != "" {
+		conditions = append(conditions, fmt.Sprintf("mc.target_type = $%d", argIndex))
+		args = append(args, req.TargetType)
+		argIndex++
+	}
+
+	if assignedTo != nil {
+		conditions = append(conditions, fmt.Sprintf("mc.assigned_to = $%d", argIndex))
+		args = append(args, *assignedTo)
+		argIndex++
+	} else if unassigned {
+		conditions = append(conditions, "mc.assigned_to IS NULL")
+	}
+
+	whereClause := strings.Join(conditions, " AND ")
+
+	var total int64
+	if err := r.pool.QueryRow(ctx, `SELECT COUNT(*) FROM moderation_case mc WHERE `+whereClause, args...).Scan(&total); err != nil {
+		return nil, fmt.Errorf("failed to count moderation cases: %w", err)
+	}
+
+	offset := (req.Page - 1) * req.PageSize
+	listQuery := fmt.Sprintf(`
+		SELECT %s
+		FROM moderation_case mc
+		WHERE %s
+		ORDER BY mc.report_count DESC, mc.last_reported_at DESC
+		LIMIT $%d OFFSET $%d`, moderationCaseColumns, whereClause, argIndex, argIndex+1)
+	args = append(args, req.PageSize, offset)
+
+	rows, err := r.pool.Query(ctx, listQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query moderation cases: %w", err)
+	}
+	defer rows.Close()
+
+	cases := make([]d.ModerationCaseSummary, 0)
+	for rows.Next() {
+		item, err := scanModerationCase(rows)
+		if err != nil {
+			return nil, err
+		}
+		cases = append(cases, *item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate moderation cases: %w", err)
+	}
+
+	totalPages := int((total + int64(req.PageSize) - 1) / int64(req.PageSize))
+
+	return &d.PaginatedModerationCasesResponse{
+		Cases: cases,
+		Pagination: d.PaginationMeta{
+			Page:        req.Page,
+			PageSize:    req.PageSize,
+			Total:       total,
+			TotalPages:  totalPages,
+			HasNext:     req.Page < totalPages,
+			HasPrevious: req.Page > 1,
+		},
+	}, nil
+}
+
+// GetCase reads a case, every report attached to it and its audit trail in order
+func (r *moderationRepository) GetCase(ctx context.Context, caseID uuid.UUID) (*d.ModerationCaseDetailResponse, error) {
+	row := r.pool.QueryRow(ctx, `SELECT `+moderationCaseColumns+` FROM moderation_case mc WHERE mc.id = $1`, caseID)
+	summary, err := scanModerationCase(row)
+	if err != nil {
+		if strings.Contains(err.Error(), pgx.ErrNoRows.Error()) {
+			return nil, fmt.Errorf("moderation case not found")
+		}
+		return nil, err
+	}
+
+	response := &d.ModerationCaseDetailResponse{
+		ModerationCaseSummary: *summary,
+		Reports:               make([]m.ContentReport, 0),
+		AuditTrail:            make([]m.ModerationAuditLog, 0),
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT id, case_id, reporter_id, reason, details, created_at
+		FROM content_report
+		WHERE case_id = $1
+		ORDER BY created_at`, caseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query case reports: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var report m.ContentReport
+		if err := rows.Scan(&report.ID, &report.CaseID, &report.ReporterID, &report.Reason, &report.Details, &report.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan case report: %w", err)
+		}
+		response.Reports = append(response.Reports, report)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate case reports: %w", err)
+	}
+
+	auditRows, err := r.pool.Query(ctx, `
+		SELECT id, case_id, actor_id, event, details, created_at
+		FROM moderation_audit_log
+		WHERE case_id = $1
+		ORDER BY created_at`, caseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query case audit trail: %w", err)
+	}
+	defer auditRows.Close()
+
+	for auditRows.Next() {
+		var entry m.ModerationAuditLog
+		if err := auditRows.Scan(&entry.ID, &entry.CaseID, &entry.ActorID, &entry.Event, &entry.Details, &entry.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan audit entry: %w", err)
+		}
+		response.AuditTrail = append(response.AuditTrail, entry)
+	}
+	if err := auditRows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate audit trail: %w", err)
+	}
+
+	return response, nil
+}
+
+// AssignCase moves an open case to IN_REVIEW under the given moderator
+func (r *moderationRepository) AssignCase(ctx context.Context, caseID, actorID, moderatorID uuid.UUID, onlyIfFree bool) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var status m.ModerationCaseStatus
+	var assignedTo *uuid.UUID
+	err = tx.QueryRow(ctx, `SELECT status, assigned_to FROM moderation_case WHERE id = $1 FOR UPDATE`, caseID).Scan(&status, &assignedTo)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return fmt.Errorf("moderation case not found")
+		}
+		return fmt.Errorf("failed to load moderation case: %w", err)
+	}
+
+	if status != m.ModerationCaseOpen && status != m.ModerationCaseInReview {
+		return fmt.Errorf("moderation case already closed")
+	}
+	if onlyIfFree && assignedTo != nil && *assignedTo != moderatorID {
+		return fmt.Errorf("moderation case already claimed by another moderator")
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE moderation_case
+		SET assigned_to = $2, assigned_at = NOW(), status = 'IN_REVIEW', updated_at = NOW()
+		WHERE id = $1`, caseID, moderatorID)
+	if err != nil {
+		return fmt.Errorf("failed to assign moderation case: %w", err)
+	}
+
+	event := "ASSIGNED"
+	if onlyIfFree {
+		event = "CLAIMED"
+	}
+	if err := insertModerationAudit(ctx, tx, caseID, actorID, event, map[string]interface{}{
+		"assigned_to":       moderatorID,
+		"previous_assignee": assignedTo,
+	}); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// ResolveCase applies the catalog-side effect of the action (hiding or unpublishing content,
+// recording a warning) and closes the case in the same transaction. Suspensions are applied
+// by the identify service before this is called; here they are only recorded.
+func (r *moderationRepository) ResolveCase(ctx context.Context, caseID uuid.UUID, resolution ModerationResolution) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var status m.ModerationCaseStatus
+	var targetType m.ReportTargetType
+	var targetID uuid.UUID
+	err = tx.QueryRow(ctx, `SELECT status, target_type, target_id FROM moderation_case WHERE id = $1 FOR UPDATE`, caseID).
+		Scan(&status, &targetType, &targetID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return fmt.Errorf("moderation case not found")
+		}
+		return fmt.Errorf("failed to load moderation case: %w", err)
+	}
+
+	if status != m.ModerationCaseOpen && status != m.ModerationCaseInReview {
+		return fmt.Errorf("moderation case already closed")
+	}
+
+	switch resolution.Action {
+	case m.ModerationActionHideContent:
+		var hideQuery string
+		switch targetType {
+		case m.ReportTargetNovel:
+			hideQuery = `UPDATE novel SET is_public = false, updated_at = NOW() WHERE id = $1`
+		case m.ReportTargetChapter:
+			hideQuery = `UPDATE novel_chapter SET is_public = false, is_draft = true, updated_at = NOW() WHERE id = $1`
+		default:
+			return fmt.Errorf("action %s not supported for target type %s", resolution.Action, targetType)
+		}
+		if _, err := tx.Exec(ctx, hideQuery, targetID); err != nil {
+			return fmt.Errorf("failed to hide reported content: %w", err)
+		}
+
+	case m.ModerationActionUnpublishChapter:
+		if targetType != m.ReportTargetChapter {
+			return fmt.Errorf("action %s not supported for target type %s", resolution.Action, targetType)
+		}
+		_, err := tx.Exec(ctx, `
+			UPDATE novel_chapter
+			SET is_public = false, published_at = NULL, updated_at = NOW()
+			WHERE id = $1`, targetID)
+		if err != nil {
+			return fmt.Errorf("failed to unpublish chapter: %w", err)
+		}
+
+	case m.ModerationActionWarnUser:
+		if resolution.UserID == nil {
+			return fmt.Errorf("user ID is required for action %s", resolution.Action)
+		}
+		message := "Your content was reported and found to violate the community guidelines"
+		if resolution.Note != nil && *resolution.Note != "" {
+			message = *resolution.Note
+		}
+		_, err := tx.Exec(ctx, `
+			INSERT INTO user_warning (user_id, case_id, moderator_id, message)
+			VALUES ($1, $2, $3, $4)`, *resolution.UserID, caseID, resolution.ModeratorID, message)
+		if err != nil {
+			return fmt.Errorf("failed to record user warning: %w", err)
+		}
+	}
+
+	newStatus := m.ModerationCaseResolved
+	event := "RESOLVED"
+	if resolution.Action == m.ModerationActionDismiss {
+		newStatus = m.ModerationCaseDismissed
+		event = "DISMISSED"
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE moderation_case
+		SET status = $2, resolution_action = $3, resolution_note = $4,
+			resolved_by = $5, resolved_at = NOW(),
+			assigned_to = COALESCE(assigned_to, $5), assigned_at = COALESCE(assigned_at, NOW()),
+			updated_at = NOW()
+		WHERE id = $1`, caseID, newStatus, resolution.Action, resolution.Note, resolution.ModeratorID)
+	if err != nil {
+		return fmt.Errorf("failed to resolve moderation case: %w", err)
+	}
+
+	if err := insertModerationAudit(ctx, tx, caseID, resolution.ModeratorID, event, map[string]interface{}{
+		"action":  resolution.Action,
+		"note":    resolution.Note,
+		"user_id": resolution.UserID,
+	}); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetOpenCaseTarget returns the reported target of a case that is still awaiting a decision
+func (r *moderationRepository) GetOpenCaseTarget(ctx context.Context, caseID uuid.UUID) (m.ReportTargetType, uuid.UUID, error) {
+	var status m.ModerationCaseStatus
+	var targetType m.ReportTargetType
+	var targetID uuid.UUID
+	err := r.pool.QueryRow(ctx, `SELECT status, target_type, target_id FROM moderation_case WHERE id = $1`, caseID).
+		Scan(&status, &targetType, &targetID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return "", uuid.Nil, fmt.Errorf("moderation case not found")
+		}
+		return "", uuid.Nil, fmt.Errorf("failed to load moderation case: %w", err)
+	}
+	if status != m.ModerationCaseOpen && status != m.ModerationCaseInReview {
+		return "", uuid.Nil, fmt.Errorf("moderation case already closed")
+	}
+	return targetType, targetID, nil
+}
+
+// FindTargetOwner returns the reported user itself, or the personal owner of a reported
+// novel/chapter. Tenant-owned content and targets of other services return nil.
+func (r *moderationRepository) FindTargetOwner(ctx context.Context, targetType m.ReportTargetType, targetID uuid.UUID) (*uuid.UUID, error) {
+	var query string
+	switch targetType {
+	case m.ReportTargetUser:
+		return &targetID, nil
+	case m.ReportTargetNovel:
+		query = `SELECT n.primary_owner_id FROM novel n WHERE n.id = $1 AND n.ownership_type <> 'TENANT'`
+	case m.ReportTargetChapter:
+		query = `
+			SELECT n.primary_owner_id
+			FROM novel_chapter c
+			JOIN novel_volume v ON v.id = c.volume_id
+			JOIN novel n ON n.id = v.novel_id
+			WHERE c.id = $1 AND n.ownership_type <> 'TENANT'`
+	default:
+		return nil, nil
+	}
+
+	var ownerID uuid.UUID
+	if err := r.pool.QueryRow(ctx, query, targetID).Scan(&ownerID); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to resolve target owner: %w", err)
+	}
+	return &ownerID, nil
+}
+
+// scanModerationCase scans a row selected with moderationCaseColumns
+func scanModerationCase(row pgx.Row) (*d.ModerationCaseSummary, error) {
+	var item d.ModerationCaseSummary
+	var reasonCounts []byte
+	err := row.Scan(
+		&item.ID, &item.TargetType, &item.TargetID, &item.Status, &item.ReportCount,
+		&item.FirstReportedAt, &item.LastReportedAt, &item.AssignedTo, &item.AssignedAt,
+		&item.ResolutionAction, &item.ResolutionNote, &item.ResolvedBy, &item.ResolvedAt,
+		&item.CreatedAt, &item.UpdatedAt,
+		&reasonCounts,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan moderation case: %w", err)
+	}
+
+	item.ReasonCounts = map[string]int{}
+	if err := json.Unmarshal(reasonCounts, &item.ReasonCounts); err != nil {
+		return nil, fmt.Errorf("failed to decode reason counts: %w", err)
+	}
+
+	return &item, nil
+}
+
+// insertModerationAudit appends an entry to the audit trail of a case
+func insertModerationAudit(ctx context.Context, tx pgx.Tx, caseID, actorID uuid.UUID, event string, details map[string]interface{}) error {
+	payload, err := json.Marshal(details)
+	if err != nil {
+		return fmt.Errorf("failed to encode audit details: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO moderation_audit_log (case_id, actor_id, event, details)
+		VALUES ($1, $2, $3, $4)`, caseID, actorID, event, payload)
+	if err != nil {
+		return fmt.Errorf("failed to write moderation audit log: %w", err)
+	}
+	return nil
+}
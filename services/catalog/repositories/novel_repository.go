@@ -23,8 +23,7 @@ type NovelRepository interface {
 	DeleteNovel(ctx context.Context, id uuid.UUID, deletedByUserID uuid.UUID) error
 	CheckNovelPurchases(ctx context.Context, novelID uuid.UUID) (bool, error)
 	ListNovels(ctx context.Context, req d.ListNovelsRequest) (*d.PaginatedNovelsResponse, error)
-	// Optional data loaders for translations and stats
-	GetNovelTranslations(ctx context.Context, novelID uuid.UUID, language string) ([]interface{}, error)
+	// Optional data loader for stats; translations live in TranslationRepository
 	GetNovelStats(ctx context.Context, novelID uuid.UUID) (map[string]interface{}, error)
 }
 
@@ -445,13 +444,32 @@ func (r *novelRepository) ListNovels(ctx context.Context, req d.ListNovelsReques
 				genreUUIDs[i] = genreUUID
 			}
 		}
-		if len(genreUUIDs) > 0 {
+		if len(genreUUIDs) > 0 && req.IncludeGenreDescendants {
+			// Match the requested genres and every genre below them in the taxonomy
+			conditions = append(conditions, fmt.Sprintf(`ng.genre_id IN (
+				WITH RECURSIVE genre_tree AS (
+					SELECT id FROM genre WHERE id = ANY($%d)
+					UNION
+					SELECT g.id FROM genre g INNER JOIN genre_tree gt ON g.parent_id = gt.id
+				)
+				SELECT id FROM genre_tree
+			)`, argIndex))
+			args = append(args, genreUUIDs)
+			argIndex++
+		} else if len(genreUUIDs) > 0 {
 			conditions = append(conditions, fmt.Sprintf("ng.genre_id = ANY($%d)", argIndex))
 			args = append(args, genreUUIDs)
 			argIndex++
 		}
 	}
 
+	if len(req.Tags) > 0 {
+		// Tags are stored by canonical name; the service resolves aliases beforehand
+		conditions = append(conditions, fmt.Sprintf("n.tags ?| $%d", argIndex))
+		args = append(args, req.Tags)
+		argIndex++
+	}
+
 	// Date filtering
 	if req.CreatedAfter != nil {
 		conditions = append(conditions, fmt.Sprintf("n.created_at >= $%d", argIndex))
@@ -501,6 +519,13 @@ func (r *novelRepository) ListNovels(ctx context.Context, req d.ListNovelsReques
 		argIndex++
 	}
 
+	// Age gating and the viewer's filters, applied in the query so pages and totals only count visible novels
+	var visibility string
+	visibility, args, argIndex = contentVisibilityCondition(req.Visibility, "n", "n.content_warnings", args, argIndex)
+	if visibility != "" {
+		conditions = append(conditions, visibility)
+	}
+
 	// Build complete query
 	completeQuery := baseQuery
 	if len(conditions) > 0 {
@@ -689,6 +714,12 @@ func (r *novelRepository) UpdateNovel(ctx context.Context, id uuid.UUID, req d.U
 		argIndex++
 	}
 
+	if req.Tags != nil {
+		updateFields = append(updateFields, fmt.Sprintf("tags = $%d", argIndex))
+		args = append(args, []byte(*req.Tags))
+		argIndex++
+	}
+
 	if req.Keywords != nil {
 		updateFields = append(updateFields, fmt.Sprintf("keywords = $%d", argIndex))
 		args = append(args, *req.Keywords)
@@ -900,13 +931,16 @@ func (r *novelRepository) DeleteNovel(ctx context.Context, id uuid.UUID, deleted
 		return fmt.Errorf("cannot delete novel: users have purchased content from this novel")
 	}
 
-	// Perform soft delete on the novel
+	// Perform soft delete on the novel; the deletion ID groups the rows removed by this
+	// operation so the trash can restore them together
 	now := time.Now()
-	_, err = tx.Exec(ctx, `
+	var deletionID uuid.UUID
+	err = tx.QueryRow(ctx, `
 		UPDATE novel
-		SET is_deleted = TRUE, deleted_at = $2, deleted_by_user_id = $3, updated_at = $2
+		SET is_deleted = TRUE, deleted_at = $2, deleted_by_user_id = $3, updated_at = $2, deletion_id = uuidv7()
 		WHERE id = $1
-	`, id, now, deletedByUserID)
+		RETURNING deletion_id
+	`, id, now, deletedByUserID).Scan(&deletionID)
 	if err != nil {
 		return fmt.Errorf("failed to delete novel: %w", err)
 	}
@@ -914,20 +948,20 @@ func (r *novelRepository) DeleteNovel(ctx context.Context, id uuid.UUID, deleted
 	// Also soft delete related volumes and chapters
 	_, err = tx.Exec(ctx, `
 		UPDATE novel_volume
-		SET is_deleted = TRUE, deleted_at = $2
+		SET is_deleted = TRUE, deleted_at = $2, deletion_id = $3
 		WHERE novel_id = $1 AND is_deleted = FALSE
-	`, id, now)
+	`, id, now, deletionID)
 	if err != nil {
 		return fmt.Errorf("failed to delete novel volumes: %w", err)
 	}
 
 	_, err = tx.Exec(ctx, `
 		UPDATE novel_chapter
-		SET is_deleted = TRUE, deleted_at = $2
+		SET is_deleted = TRUE, deleted_at = $2, deletion_id = $3
 		WHERE volume_id IN (
 			SELECT id FROM novel_volume WHERE novel_id = $1
 		) AND is_deleted = FALSE
-	`, id, now)
+	`, id, now, deletionID)
 	if err != nil {
 		return fmt.Errorf("failed to delete novel chapters: %w", err)
 	}
@@ -939,54 +973,6 @@ func (r *novelRepository) DeleteNovel(ctx context.Context, id uuid.UUID, deleted
 	return nil
 }
 
-// GetNovelTranslations retrieves translations for a novel
-func (r *novelRepository) GetNovelTranslations(ctx context.Context, novelID uuid.UUID, language string) ([]interface{}, error) {
-	query := `
-		SELECT nt.id, nt.language_code, nt.title, nt.description, nt.is_primary
-		FROM novel_translation nt
-		WHERE nt.novel_id = $1
-	`
-
-	args := []interface{}{novelID}
-	if language != "" {
-		query += " AND nt.language_code = $2"
-		args = append(args, language)
-	}
-	query += " ORDER BY nt.is_primary DESC, nt.language_code"
-
-	rows, err := r.pool.Query(ctx, query, args...)
-	if err != nil {
-		return nil, fmt.Errorf("failed to query novel translations: %w", err)
-	}
-	defer rows.Close()
-
-	var translations []interface{}
-	for rows.Next() {
-		var translation struct {
-			ID           string  `json:"id"`
-			LanguageCode string  `json:"language_code"`
-			Title        string  `json:"title"`
-			Description  *string `json:"description"`
-			IsPrimary    bool    `json:"is_primary"`
-		}
-
-		err := rows.Scan(
-			&translation.ID,
-			&translation.LanguageCode,
-			&translation.Title,
-			&translation.Description,
-			&translation.IsPrimary,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("failed to scan translation: %w", err)
-		}
-
-		translations = append(translations, translation)
-	}
-
-	return translations, nil
-}
-
 // GetNovelStats retrieves statistics for a novel
 func (r *novelRepository) GetNovelStats(ctx context.Context, novelID uuid.UUID) (map[string]interface{}, error) {
 	stats := make(map[string]interface{})
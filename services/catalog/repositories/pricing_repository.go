@@ -0,0 +1,720 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+)
+
+// discountCampaignColumns lists the discount_campaign columns read into m.DiscountCampaign
+const discountCampaignColumns = `
+	dc.id, dc.name, dc.description, dc.discount_type, dc.discount_value, dc.scope_type, dc.scope_id,
+	dc.starts_at, dc.ends_at, dc.is_active, dc.created_by_user_id, dc.tenant_id, dc.created_at, dc.updated_at`
+
+// novelBundleColumns lists the novel_bundle columns read into m.NovelBundle
+const novelBundleColumns = `
+	b.id, b.novel_id, b.name, b.description, b.price_coins, b.starts_at, b.ends_at,
+	b.is_active, b.created_by_user_id, b.created_at, b.updated_at`
+
+// bundleAvailableCondition holds for bundles that can be bought right now
+const bundleAvailableCondition = `b.is_active
+	AND (b.starts_at IS NULL OR b.starts_at <= CURRENT_TIMESTAMP)
+	AND (b.ends_at IS NULL OR b.ends_at > CURRENT_TIMESTAMP)`
+
+// PricingRepository defines data access for discount campaigns, bundles and price quotes
+type PricingRepository interface {
+	// CreateCampaign stores a new discount campaign
+	CreateCampaign(ctx context.Context, campaign *m.DiscountCampaign) error
+	// GetCampaign retrieves a discount campaign by ID
+	GetCampaign(ctx context.Context, id uuid.UUID) (*m.DiscountCampaign, error)
+	// ListCampaigns retrieves a page of campaigns; a userID restricts to campaigns created by the
+	// user or their tenant
+	ListCampaigns(ctx context.Context, req d.ListDiscountCampaignsRequest, userID, tenantID *uuid.UUID) ([]m.DiscountCampaign, *d.PaginationMeta, error)
+	// UpdateCampaign writes the editable fields of a campaign
+	UpdateCampaign(ctx context.Context, campaign *m.DiscountCampaign) error
+	// DeleteCampaign removes a campaign
+	DeleteCampaign(ctx context.Context, id uuid.UUID) error
+	// ScopeExists reports whether the novel or genre a campaign targets exists
+	ScopeExists(ctx context.Context, scopeType string, scopeID uuid.UUID) (bool, error)
+	// ListRunningCampaigns lists the active campaigns running now that cover the novel directly,
+	// through its owning tenant or through one of its genres
+	ListRunningCampaigns(ctx context.Context, novelID uuid.UUID) ([]m.DiscountCampaign, error)
+
+	// CreateBundle stores a bundle with its volumes
+	CreateBundle(ctx context.Context, bundle *m.NovelBundle) error
+	// GetBundle retrieves a bundle with its live volumes
+	GetBundle(ctx context.Context, id uuid.UUID) (*m.NovelBundle, error)
+	// ListNovelBundles lists the bundles of a novel that are on sale now
+	ListNovelBundles(ctx context.Context, novelID uuid.UUID) ([]m.NovelBundle, error)
+	// UpdateBundle writes the editable fields of a bundle, replacing its volumes when asked
+	UpdateBundle(ctx context.Context, bundle *m.NovelBundle, replaceVolumes bool) error
+	// DeleteBundle removes a bundle
+	DeleteBundle(ctx context.Context, id uuid.UUID) error
+
+	// GetQuoteTarget resolves the list price of a chapter, volume, series or bundle and the
+	// volumes its purchase grants
+	GetQuoteTarget(ctx context.Context, itemType string, itemID uuid.UUID) (*d.PriceQuoteTarget, error)
+	// GetOwnership reports what the user already owns of a quoted item
+	GetOwnership(ctx context.Context, userID uuid.UUID, itemType string, itemID uuid.UUID, target *d.PriceQuoteTarget) (*d.PriceQuoteOwnership, error)
+}
+
+// pricingRepository implements PricingRepository interface
+type pricingRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewPricingRepository creates a new pricing repository instance
+func NewPricingRepository(pool *pgxpool.Pool) PricingRepository {
+	return &pricingRepository{pool: pool}
+}
+
+// CreateCampaign inserts a campaign and fills its ID and timestamps
+func (r *pricingRepository) CreateCampaign(ctx context.Context, campaign *m.DiscountCampaign) error {
+	query := `
+		INSERT INTO discount_campaign (
+			name, description, discount_type, discount_value, scope_type, scope_id,
+			starts_at, ends_at, is_active, created_by_user_id, tenant_id
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING id, created_at, updated_at`
+
+	err := r.pool.QueryRow(ctx, query,
+		campaign.Name, campaign.Description, campaign.DiscountType, campaign.DiscountValue,
+		campaign.ScopeType, campaign.ScopeID, campaign.StartsAt, campaign.EndsAt, campaign.IsActive,
+		campaign.CreatedByUserID, campaign.TenantID,
+	).Scan(&campaign.ID, &campaign.CreatedAt, &campaign.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create discount campaign: %w", err)
+	}
+
+	return nil
+}
+
+// GetCampaign retrieves a campaign by its ID
+func (r *pricingRepository) GetCampaign(ctx context.Context, id uuid.UUID) (*m.DiscountCampaign, error) {
+	row := r.pool.QueryRow(ctx, `SELECT `+discountCampaignColumns+` FROM discount_campaign dc WHERE dc.id = $1`, id)
+
+	campaign, err := scanDiscountCampaign(row)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("discount campaign not found")
+		}
+		return nil, fmt.Errorf("failed to get discount campaign: %w", err)
+	}
+
+	return campaign, nil
+}
+
+// ListCampaigns retrieves campaigns, latest start first
+func (r *pricingRepository) ListCampaigns(ctx context.Context, req d.ListDiscountCampaignsRequest, userID, tenantID *uuid.UUID) ([]m.DiscountCampaign, *d.PaginationMeta, error) {
+	// Set pagination defaults
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 20
+	}
+	if req.PageSize > 100 {
+		req.PageSize = 100
+	}
+
+	conditions := []string{}
+	args := []interface{}{}
+	argIndex := 1
+
+	if req.ScopeType != "" {
+		conditions = append(conditions, fmt.Sprintf("dc.scope_type = $%d", argIndex))
+		args = append(args, req.ScopeType)
+		argIndex++
+	}
+
+	if req.ScopeID != "" {
+		conditions = append(conditions, fmt.Sprintf("dc.scope_id = $%d", argIndex))
+		args = append(args, req.ScopeID)
+		argIndex++
+	}
+
+	switch req.Status {
+	case "scheduled":
+		conditions = append(conditions, "dc.starts_at > CURRENT_TIMESTAMP")
+	case "running":
+		conditions = append(conditions, "dc.starts_at <= CURRENT_TIMESTAMP AND dc.ends_at > CURRENT_TIMESTAMP")
+	case "ended":
+		conditions = append(conditions, "dc.ends_at <= CURRENT_TIMESTAMP")
+	}
+
+	if userID != nil {
+		conditions = append(conditions, fmt.Sprintf(
+			"(dc.created_by_user_id = $%d OR ($%d::uuid IS NOT NULL AND dc.tenant_id = $%d::uuid))",
+			argIndex, argIndex+1, argIndex+1))
+		args = append(args, *userID, tenantID)
+		argIndex += 2
+	}
+
+	whereClause := ""
+	if len(conditions) > 0 {
+		whereClause = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	var total int64
+	if err := r.pool.QueryRow(ctx, `SELECT COUNT(*) FROM discount_campaign dc `+whereClause, args...).Scan(&total); err != nil {
+		return nil, nil, fmt.Errorf("failed to count discount campaigns: %w", err)
+	}
+
+	offset := (req.Page - 1) * req.PageSize
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM discount_campaign dc
+		%s
+		ORDER BY dc.starts_at DESC, dc.id DESC
+		LIMIT $%d OFFSET $%d`, discountCampaignColumns, whereClause, argIndex, argIndex+1)
+	args = append(args, req.PageSize, offset)
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list discount campaigns: %w", err)
+	}
+	defer rows.Close()
+
+	campaigns, err := collectDiscountCampaigns(rows)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	totalPages := int((total + int64(req.PageSize) - 1) / int64(req.PageSize))
+
+	return campaigns, &d.PaginationMeta{
+		Page:        req.Page,
+		PageSize:    req.PageSize,
+		Total:       total,
+		TotalPages:  totalPages,
+		HasNext:     req.Page < totalPages,
+		HasPrevious: req.Page > 1,
+	}, nil
+}
+
+// UpdateCampaign writes name, description, discount, schedule and the active flag
+func (r *pricingRepository) UpdateCampaign(ctx context.Context, campaign *m.DiscountCampaign) error {
+	query := `
+		UPDATE discount_campaign
+		SET name = $2, description = $3, discount_type = $4, discount_value = $5,
+			starts_at = $6, ends_at = $7, is_active = $8, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1
+		RETURNING updated_at`
+
+	err := r.pool.QueryRow(ctx, query,
+		campaign.ID, campaign.Name, campaign.Description, campaign.DiscountType, campaign.DiscountValue,
+		campaign.StartsAt, campaign.EndsAt, campaign.IsActive,
+	).Scan(&campaign.UpdatedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return fmt.Errorf("discount campaign not found")
+		}
+		return fmt.Errorf("failed to update discount campaign: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteCampaign removes a campaign; quotes stop applying it immediately
+func (r *pricingRepository) DeleteCampaign(ctx context.Context, id uuid.UUID) error {
+	tag, err := r.pool.Exec(ctx, `DELETE FROM discount_campaign WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete discount campaign: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("discount campaign not found")
+	}
+	return nil
+}
+
+// ScopeExists checks a live novel or a genre; tenants live in the identity service and are not checked
+func (r *pricingRepository) ScopeExists(ctx context.Context, scopeType string, scopeID uuid.UUID) (bool, error) {
+	var query string
+	switch scopeType {
+	case m.DiscountScopeNovel:
+		query = `SELECT EXISTS(SELECT 1 FROM novel WHERE id = $1 AND is_deleted = FALSE)`
+	case m.DiscountScopeGenre:
+		query = `SELECT EXISTS(SELECT 1 FROM genre WHERE id = $1)`
+	default:
+		return true, nil
+	}
+
+	var exists bool
+	if err := r.pool.QueryRow(ctx, query, scopeID).Scan(&exists); err != nil {
+		return false, fmt.Errorf("failed to check discount scope: %w", err)
+	}
+	return exists, nil
+}
+
+// ListRunningCampaigns lists the campaigns that apply to the novel now
+func (r *pricingRepository) ListRunningCampaigns(ctx context.Context, novelID uuid.UUID) ([]m.DiscountCampaign, error) {
+	query := `
+		SELECT ` + discountCampaignColumns + `
+		FROM discount_campaign dc
+		WHERE dc.is_active = TRUE
+			AND dc.starts_at <= CURRENT_TIMESTAMP AND dc.ends_at > CURRENT_TIMESTAMP
+			AND (
+				(dc.scope_type = 'NOVEL' AND dc.scope_id = $1)
+				OR (dc.scope_type = 'TENANT' AND dc.scope_id = (
+					SELECT n.primary_owner_id FROM novel n WHERE n.id = $1 AND n.ownership_type = 'TENANT'))
+				OR (dc.scope_type = 'GENRE' AND dc.scope_id IN (
+					SELECT ng.genre_id FROM novel_genre ng WHERE ng.novel_id = $1))
+			)
+		ORDER BY dc.ends_at ASC`
+
+	rows, err := r.pool.Query(ctx, query, novelID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list running discount campaigns: %w", err)
+	}
+	defer rows.Close()
+
+	return collectDiscountCampaigns(rows)
+}
+
+// CreateBundle inserts a bundle and its volumes in one transaction
+func (r *pricingRepository) CreateBundle(ctx context.Context, bundle *m.NovelBundle) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		INSERT INTO novel_bundle (novel_id, name, description, price_coins, starts_at, ends_at, is_active, created_by_user_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id, created_at, updated_at`
+
+	err = tx.QueryRow(ctx, query,
+		bundle.NovelID, bundle.Name, bundle.Description, bundle.PriceCoins,
+		bundle.StartsAt, bundle.EndsAt, bundle.IsActive, bundle.CreatedByUserID,
+	).Scan(&bundle.ID, &bundle.CreatedAt, &bundle.UpdatedAt)
+	if err != nil {
+		if strings.Contains(err.Error(), "foreign key") {
+			return fmt.Errorf("novel not found")
+		}
+		return fmt.Errorf("failed to create bundle: %w", err)
+	}
+
+	if err := setBundleVolumes(ctx, tx, bundle.ID, bundle.NovelID, bundle.VolumeIDs); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetBundle retrieves a bundle of a live novel
+func (r *pricingRepository) GetBundle(ctx context.Context, id uuid.UUID) (*m.NovelBundle, error) {
+	row := r.pool.QueryRow(ctx, `
+		SELECT `+novelBundleColumns+`
+		FROM novel_bundle b
+		JOIN novel n ON n.id = b.novel_id AND n.is_deleted = FALSE
+		WHERE b.id = $1`, id)
+
+	bundle, err := scanNovelBundle(row)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("bundle not found")
+		}
+		return nil, fmt.Errorf("failed to get bundle: %w", err)
+	}
+
+	if err := r.loadBundleVolumes(ctx, []*m.NovelBundle{bundle}); err != nil {
+		return nil, err
+	}
+
+	return bundle, nil
+}
+
+// ListNovelBundles lists the novel's bundles on sale now, cheapest first
+func (r *pricingRepository) ListNovelBundles(ctx context.Context, novelID uuid.UUID) ([]m.NovelBundle, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT `+novelBundleColumns+`
+		FROM novel_bundle b
+		JOIN novel n ON n.id = b.novel_id AND n.is_deleted = FALSE
+		WHERE b.novel_id = $1 AND `+bundleAvailableCondition+`
+		ORDER BY b.price_coins ASC, b.id ASC`, novelID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list bundles: %w", err)
+	}
+	defer rows.Close()
+
+	bundles := make([]m.NovelBundle, 0)
+	for rows.Next() {
+		bundle, err := scanNovelBundle(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan bundle: %w", err)
+		}
+		bundles = append(bundles, *bundle)
+	}
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate bundles: %w", rows.Err())
+	}
+
+	targets := make([]*m.NovelBundle, len(bundles))
+	for i := range bundles {
+		targets[i] = &bundles[i]
+	}
+	if err := r.loadBundleVolumes(ctx, targets); err != nil {
+		return nil, err
+	}
+
+	// A bundle whose volumes were all deleted has nothing left to sell
+	available := bundles[:0]
+	for _, bundle := range bundles {
+		if len(bundle.VolumeIDs) > 0 {
+			available = append(available, bundle)
+		}
+	}
+
+	return available, nil
+}
+
+// UpdateBundle writes name, description, price, schedule and the active flag
+func (r *pricingRepository) UpdateBundle(ctx context.Context, bundle *m.NovelBundle, replaceVolumes bool) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		UPDATE novel_bundle
+		SET name = $2, description = $3, price_coins = $4, starts_at = $5, ends_at = $6,
+			is_active = $7, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1
+		RETURNING updated_at`
+
+	err = tx.QueryRow(ctx, query,
+		bundle.ID, bundle.Name, bundle.Description, bundle.PriceCoins,
+		bundle.StartsAt, bundle.EndsAt, bundle.IsActive,
+	).Scan(&bundle.UpdatedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return fmt.Errorf("bundle not found")
+		}
+		return fmt.Errorf("failed to update bundle: %w", err)
+	}
+
+	if replaceVolumes {
+		if _, err := tx.Exec(ctx, `DELETE FROM novel_bundle_volume WHERE bundle_id = $1`, bundle.ID); err != nil {
+			return fmt.Errorf("failed to clear bundle volumes: %w", err)
+		}
+		if err := setBundleVolumes(ctx, tx, bundle.ID, bundle.NovelID, bundle.VolumeIDs); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteBundle removes a bundle; purchases already made keep their volumes
+func (r *pricingRepository) DeleteBundle(ctx context.Context, id uuid.UUID) error {
+	tag, err := r.pool.Exec(ctx, `DELETE FROM novel_bundle WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete bundle: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("bundle not found")
+	}
+	return nil
+}
+
+// GetQuoteTarget reads the list price of the item. Unavailable volumes and bundles that are not
+// on sale come back without a price.
+func (r *pricingRepository) GetQuoteTarget(ctx context.Context, itemType string, itemID uuid.UUID) (*d.PriceQuoteTarget, error) {
+	target := &d.PriceQuoteTarget{}
+
+	switch itemType {
+	case d.QuoteItemNovelChapter:
+		err := r.pool.QueryRow(ctx, `
+			SELECT v.novel_id, c.price_coins,
+				COALESCE(c.price_coins, 0) = 0 OR COALESCE(
+					c.published_at + make_interval(days => p.free_after_days) <= CURRENT_TIMESTAMP, FALSE)
+			FROM novel_chapter c
+			JOIN novel_volume v ON v.id = c.volume_id AND v.is_deleted = FALSE
+			JOIN novel n ON n.id = v.novel_id AND n.is_deleted = FALSE
+			LEFT JOIN novel_release_policy p ON p.novel_id = v.novel_id
+			WHERE c.id = $1 AND c.is_deleted = FALSE`, itemID).
+			Scan(&target.NovelID, &target.PriceCoins, &target.IsFree)
+		if err != nil {
+			if err == pgx.ErrNoRows {
+				return nil, fmt.Errorf("chapter not found")
+			}
+			return nil, fmt.Errorf("failed to get chapter price: %w", err)
+		}
+		return target, nil
+
+	case d.QuoteItemNovelVolume:
+		var available bool
+		err := r.pool.QueryRow(ctx, `
+			SELECT v.novel_id, v.price_coins, v.is_available
+			FROM novel_volume v
+			JOIN novel n ON n.id = v.novel_id AND n.is_deleted = FALSE
+			WHERE v.id = $1 AND v.is_deleted = FALSE`, itemID).
+			Scan(&target.NovelID, &target.PriceCoins, &available)
+		if err != nil {
+			if err == pgx.ErrNoRows {
+				return nil, fmt.Errorf("volume not found")
+			}
+			return nil, fmt.Errorf("failed to get volume price: %w", err)
+		}
+		if !available {
+			target.PriceCoins = nil
+		}
+		target.VolumeIDs = []uuid.UUID{itemID}
+		if target.PriceCoins != nil {
+			target.ListPriceCoins = *target.PriceCoins
+		}
+		return target, nil
+
+	case d.QuoteItemNovelSeries:
+		err := r.pool.QueryRow(ctx, `
+			SELECT n.id, n.price_coins
+			FROM novel n
+			WHERE n.id = $1 AND n.is_deleted = FALSE`, itemID).
+			Scan(&target.NovelID, &target.PriceCoins)
+		if err != nil {
+			if err == pgx.ErrNoRows {
+				return nil, fmt.Errorf("novel not found")
+			}
+			return nil, fmt.Errorf("failed to get series price: %w", err)
+		}
+		err = r.pool.QueryRow(ctx, `
+			SELECT COALESCE(array_agg(v.id ORDER BY v.volume_number), '{}'), COALESCE(SUM(v.price_coins), 0)
+			FROM novel_volume v
+			WHERE v.novel_id = $1 AND v.is_deleted = FALSE`, itemID).
+			Scan(&target.VolumeIDs, &target.ListPriceCoins)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list series volumes: %w", err)
+		}
+		return target, nil
+
+	case d.QuoteItemNovelBundle:
+		var price int
+		var available bool
+		err := r.pool.QueryRow(ctx, `
+			SELECT b.novel_id, b.price_coins, `+bundleAvailableCondition+`
+			FROM novel_bundle b
+			JOIN novel n ON n.id = b.novel_id AND n.is_deleted = FALSE
+			WHERE b.id = $1`, itemID).
+			Scan(&target.NovelID, &price, &available)
+		if err != nil {
+			if err == pgx.ErrNoRows {
+				return nil, fmt.Errorf("bundle not found")
+			}
+			return nil, fmt.Errorf("failed to get bundle price: %w", err)
+		}
+		err = r.pool.QueryRow(ctx, `
+			SELECT COALESCE(array_agg(v.id ORDER BY v.volume_number), '{}'), COALESCE(SUM(v.price_coins), 0)
+			FROM novel_bundle_volume bv
+			JOIN novel_volume v ON v.id = bv.volume_id AND v.is_deleted = FALSE
+			WHERE bv.bundle_id = $1`, itemID).
+			Scan(&target.VolumeIDs, &target.ListPriceCoins)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list bundle volumes: %w", err)
+		}
+		if available && len(target.VolumeIDs) > 0 {
+			target.PriceCoins = &price
+		}
+		return target, nil
+	}
+
+	return nil, fmt.Errorf("invalid item type: %s", itemType)
+}
+
+// GetOwnership checks the user's purchases against the item. A series purchase covers everything;
+// otherwise owned volumes and chapters bought one by one in the remaining volumes are credited at
+// their own list prices. Rentals are temporary and never count as ownership.
+func (r *pricingRepository) GetOwnership(ctx context.Context, userID uuid.UUID, itemType string, itemID uuid.UUID, target *d.PriceQuoteTarget) (*d.PriceQuoteOwnership, error) {
+	ownership := &d.PriceQuoteOwnership{}
+
+	if itemType == d.QuoteItemNovelChapter {
+		err := r.pool.QueryRow(ctx, `
+			SELECT EXISTS (
+				SELECT 1
+				FROM novel_chapter c
+				JOIN novel_volume v ON v.id = c.volume_id
+				JOIN user_content_purchases p ON p.user_id = $1 AND (
+					(p.item_type = 'NOVEL_CHAPTER' AND p.item_id = c.id)
+					OR (p.item_type = 'NOVEL_VOLUME' AND p.item_id = v.id)
+					OR (p.item_type = 'NOVEL_SERIES' AND p.item_id = v.novel_id))
+				WHERE c.id = $2)`, userID, itemID).Scan(&ownership.AlreadyOwned)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check chapter ownership: %w", err)
+		}
+		return ownership, nil
+	}
+
+	var ownsSeries bool
+	err := r.pool.QueryRow(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM user_content_purchases
+			WHERE user_id = $1 AND item_type = 'NOVEL_SERIES' AND item_id = $2)`, userID, target.NovelID).Scan(&ownsSeries)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check series ownership: %w", err)
+	}
+	if ownsSeries {
+		ownership.AlreadyOwned = true
+		return ownership, nil
+	}
+	if len(target.VolumeIDs) == 0 {
+		return ownership, nil
+	}
+
+	query := `
+		WITH vol AS (
+			SELECT v.id, COALESCE(v.price_coins, 0) AS price,
+				EXISTS (
+					SELECT 1 FROM user_content_purchases p
+					WHERE p.user_id = $1 AND p.item_type = 'NOVEL_VOLUME' AND p.item_id = v.id
+				) AS owned
+			FROM novel_volume v
+			WHERE v.id = ANY($2) AND v.is_deleted = FALSE
+		)
+		SELECT
+			(SELECT COUNT(*) FROM vol WHERE owned),
+			(SELECT COALESCE(SUM(price), 0) FROM vol WHERE owned),
+			COUNT(c.id),
+			COALESCE(SUM(c.price_coins), 0)
+		FROM novel_chapter c
+		JOIN vol ON vol.id = c.volume_id AND NOT vol.owned
+		WHERE c.is_deleted = FALSE AND c.price_coins > 0
+			AND EXISTS (
+				SELECT 1 FROM user_content_purchases p
+				WHERE p.user_id = $1 AND p.item_type = 'NOVEL_CHAPTER' AND p.item_id = c.id
+			)`
+
+	var volumeCredit, chapterCredit int
+	err = r.pool.QueryRow(ctx, query, userID, target.VolumeIDs).
+		Scan(&ownership.OwnedVolumes, &volumeCredit, &ownership.OwnedChapters, &chapterCredit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check ownership: %w", err)
+	}
+
+	ownership.CreditCoins = volumeCredit + chapterCredit
+	// A series stays open for future volumes, so only volumes and bundles can be fully owned
+	ownership.AlreadyOwned = itemType != d.QuoteItemNovelSeries && ownership.OwnedVolumes == len(target.VolumeIDs)
+
+	return ownership, nil
+}
+
+// setBundleVolumes links the volumes to the bundle after checking they are live volumes of its novel
+func setBundleVolumes(ctx context.Context, tx pgx.Tx, bundleID, novelID uuid.UUID, volumeIDs []uuid.UUID) error {
+	var matched int
+	err := tx.QueryRow(ctx, `
+		SELECT COUNT(*) FROM novel_volume
+		WHERE id = ANY($1) AND novel_id = $2 AND is_deleted = FALSE`, volumeIDs, novelID).Scan(&matched)
+	if err != nil {
+		return fmt.Errorf("failed to check bundle volumes: %w", err)
+	}
+	if matched != len(volumeIDs) {
+		return fmt.Errorf("invalid bundle: every volume must be a live volume of the novel")
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO novel_bundle_volume (bundle_id, volume_id)
+		SELECT $1, unnest($2::uuid[])`, bundleID, volumeIDs)
+	if err != nil {
+		return fmt.Errorf("failed to add bundle volumes: %w", err)
+	}
+
+	return nil
+}
+
+// loadBundleVolumes fills VolumeIDs with each bundle's live volumes in volume order
+func (r *pricingRepository) loadBundleVolumes(ctx context.Context, bundles []*m.NovelBundle) error {
+	if len(bundles) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, len(bundles))
+	byID := make(map[uuid.UUID]*m.NovelBundle, len(bundles))
+	for i, bundle := range bundles {
+		ids[i] = bundle.ID
+		bundle.VolumeIDs = []uuid.UUID{}
+		byID[bundle.ID] = bundle
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT bv.bundle_id, v.id
+		FROM novel_bundle_volume bv
+		JOIN novel_volume v ON v.id = bv.volume_id AND v.is_deleted = FALSE
+		WHERE bv.bundle_id = ANY($1)
+		ORDER BY v.volume_number`, ids)
+	if err != nil {
+		return fmt.Errorf("failed to load bundle volumes: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var bundleID, volumeID uuid.UUID
+		if err := rows.Scan(&bundleID, &volumeID); err != nil {
+			return fmt.Errorf("failed to scan bundle volume: %w", err)
+		}
+		byID[bundleID].VolumeIDs = append(byID[bundleID].VolumeIDs, volumeID)
+	}
+	if rows.Err() != nil {
+		return fmt.Errorf("failed to iterate bundle volumes: %w", rows.Err())
+	}
+
+	return nil
+}
+
+// collectDiscountCampaigns reads every row selected with discountCampaignColumns
+func collectDiscountCampaigns(rows pgx.Rows) ([]m.DiscountCampaign, error) {
+	campaigns := make([]m.DiscountCampaign, 0)
+	for rows.Next() {
+		campaign, err := scanDiscountCampaign(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan discount campaign: %w", err)
+		}
+		campaigns = append(campaigns, *campaign)
+	}
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate discount campaigns: %w", rows.Err())
+	}
+	return campaigns, nil
+}
+
+// scanDiscountCampaign reads a row selected with discountCampaignColumns
+func scanDiscountCampaign(row pgx.Row) (*m.DiscountCampaign, error) {
+	var campaign m.DiscountCampaign
+	err := row.Scan(
+		&campaign.ID, &campaign.Name, &campaign.Description, &campaign.DiscountType, &campaign.DiscountValue,
+		&campaign.ScopeType, &campaign.ScopeID, &campaign.StartsAt, &campaign.EndsAt, &campaign.IsActive,
+		&campaign.CreatedByUserID, &campaign.TenantID, &campaign.CreatedAt, &campaign.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &campaign, nil
+}
+
+// scanNovelBundle reads a row selected with novelBundleColumns
+func scanNovelBundle(row pgx.Row) (*m.NovelBundle, error) {
+	var bundle m.NovelBundle
+	err := row.Scan(
+		&bundle.ID, &bundle.NovelID, &bundle.Name, &bundle.Description, &bundle.PriceCoins,
+		&bundle.StartsAt, &bundle.EndsAt, &bundle.IsActive, &bundle.CreatedByUserID,
+		&bundle.CreatedAt, &bundle.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &bundle, nil
+}
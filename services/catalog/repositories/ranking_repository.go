@@ -0,0 +1,293 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+)
+
+// rankingEligibilityCondition restricts rankings to novels any anonymous reader may see
+const rankingEligibilityCondition = `n.is_deleted = false AND n.is_public = true AND n.access_level = 'PUBLIC'`
+
+// RankingRepository defines data access for engagement aggregates and ranking snapshots
+type RankingRepository interface {
+	// IncrementDailyStats adds engagement deltas to today's aggregate row of a novel
+	IncrementDailyStats(ctx context.Context, stats m.NovelDailyStats) error
+	// RebuildTrendingSnapshot recomputes a time-decayed trending ranking over the last windowDays
+	RebuildTrendingSnapshot(ctx context.Context, rankingType m.NovelRankingType, windowDays int, halfLifeDays float64, weights m.EngagementWeights) (int64, error)
+	// RebuildRisingSnapshot ranks novels by recent engagement growth against a baseline period
+	RebuildRisingSnapshot(ctx context.Context, recentDays, baselineDays int, weights m.EngagementWeights) (int64, error)
+	// RebuildMostBookmarkedSnapshot ranks novels by their all-time bookmark counter
+	RebuildMostBookmarkedSnapshot(ctx context.Context) (int64, error)
+	// ListRanking reads a page of a ranking snapshot with optional filters, ranked within the filtered novels
+	ListRanking(ctx context.Context, rankingType m.NovelRankingType, req d.ListNovelRankingRequest) (*d.PaginatedNovelRankingResponse, error)
+}
+
+// rankingRepository implements RankingRepository interface
+type rankingRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewRankingRepository creates a new ranking repository instance
+func NewRankingRepository(pool *pgxpool.Pool) RankingRepository {
+	return &rankingRepository{pool: pool}
+}
+
+// IncrementDailyStats upserts the (novel, today) row and adds the given deltas
+func (r *rankingRepository) IncrementDailyStats(ctx context.Context, stats m.NovelDailyStats) error {
+	query := `
+		INSERT INTO novel_daily_stats (
+			novel_id, stat_date, view_count, bookmark_count, updated_at
+		) VALUES ($1, CURRENT_DATE, $2, $3, NOW())
+		ON CONFLICT (novel_id, stat_date) DO UPDATE SET
+			view_count = novel_daily_stats.view_count + EXCLUDED.view_count,
+			bookmark_count = novel_daily_stats.bookmark_count + EXCLUDED.bookmark_count,
+			updated_at = NOW()`
+
+	_, err := r.pool.Exec(ctx, query, stats.NovelID, stats.ViewCount, stats.BookmarkCount)
+	if err != nil {
+		return fmt.Errorf("failed to increment novel daily stats: %w", err)
+	}
+
+	return nil
+}
+
+// RebuildTrendingSnapshot scores each eligible novel as the sum of its weighted daily
+// engagement, halving the contribution of a day every halfLifeDays.
+func (r *rankingRepository) RebuildTrendingSnapshot(ctx context.Context, rankingType m.NovelRankingType, windowDays int, halfLifeDays float64, weights m.EngagementWeights) (int64, error) {
+	scoreQuery := `
+		SELECT ds.novel_id,
+			SUM(
+				(ds.view_count * $2::float8 + ds.bookmark_count * $3::float8)
+				* POWER(0.5, (CURRENT_DATE - ds.stat_date)::float8 / $4)
+			) AS score
+		FROM novel_daily_stats ds
+		JOIN novel n ON n.id = ds.novel_id
+		WHERE ds.stat_date > CURRENT_DATE - $5::int
+		  AND ` + rankingEligibilityCondition + `
+		GROUP BY ds.novel_id`
+
+	args := []interface{}{
+		rankingType, weights.View, weights.Bookmark, halfLifeDays, windowDays,
+	}
+
+	return r.replaceSnapshot(ctx, rankingType, scoreQuery, args)
+}
+
+// RebuildRisingSnapshot compares the average daily engagement of the recent period with
+// the baseline period right before it; novels growing from a small base rank highest.
+func (r *rankingRepository) RebuildRisingSnapshot(ctx context.Context, recentDays, baselineDays int, weights m.EngagementWeights) (int64, error) {
+	scoreQuery := `
+		SELECT g.novel_id,
+			(g.recent_avg - g.baseline_avg) / (g.baseline_avg + 5) * LN(1 + g.recent_avg) AS score
+		FROM (
+			SELECT ds.novel_id,
+				SUM(CASE WHEN ds.stat_date > CURRENT_DATE - $4::int
+					THEN ds.view_count * $2::float8 + ds.bookmark_count * $3::float8
+					ELSE 0 END) / $4::float8 AS recent_avg,
+				SUM(CASE WHEN ds.stat_date <= CURRENT_DATE - $4::int
+					THEN ds.view_count * $2::float8 + ds.bookmark_count * $3::float8
+					ELSE 0 END) / $5::float8 AS baseline_avg
+			FROM novel_daily_stats ds
+			JOIN novel n ON n.id = ds.novel_id
+			WHERE ds.stat_date > CURRENT_DATE - ($4::int + $5::int)
+			  AND ` + rankingEligibilityCondition + `
+			GROUP BY ds.novel_id
+		) g
+		WHERE g.recent_avg > g.baseline_avg`
+
+	args := []interface{}{
+		m.RankingRising, weights.View, weights.Bookmark, recentDays, baselineDays,
+	}
+
+	return r.replaceSnapshot(ctx, m.RankingRising, scoreQuery, args)
+}
+
+// RebuildMostBookmarkedSnapshot ranks eligible novels by novel.bookmark_count
+func (r *rankingRepository) RebuildMostBookmarkedSnapshot(ctx context.Context) (int64, error) {
+	scoreQuery := `
+		SELECT n.id AS novel_id, n.bookmark_count::float8 AS score
+		FROM novel n
+		WHERE n.bookmark_count > 0
+		  AND ` + rankingEligibilityCondition
+
+	args := []interface{}{m.RankingMostBookmarked}
+
+	return r.replaceSnapshot(ctx, m.RankingMostBookmarked, scoreQuery, args)
+}
+
+// replaceSnapshot atomically swaps the rows of a ranking type with every positively scored entry
+// of scoreQuery. The snapshot is not cut to a top N so that filtered lists (genre, tag, language,
+// age gating) rank within every matching novel rather than within the global top.
+// scoreQuery must yield (novel_id, score); $1 is reserved for the ranking type.
+func (r *rankingRepository) replaceSnapshot(ctx context.Context, rankingType m.NovelRankingType, scoreQuery string, args []interface{}) (int64, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `DELETE FROM novel_ranking_snapshot WHERE ranking_type = $1`, rankingType); err != nil {
+		return 0, fmt.Errorf("failed to clear ranking snapshot: %w", err)
+	}
+
+	insertQuery := `
+		INSERT INTO novel_ranking_snapshot (ranking_type, novel_id, rank, score, computed_at)
+		SELECT $1::novel_ranking_type, s.novel_id, ROW_NUMBER() OVER (ORDER BY s.score DESC, s.novel_id), s.score, NOW()
+		FROM (` + scoreQuery + `) s
+		WHERE s.score > 0`
+
+	tag, err := tx.Exec(ctx, insertQuery, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert ranking snapshot: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// ListRanking reads a ranking page. Eligibility is re-checked against the live novel row so
+// novels hidden or deleted after the snapshot was computed drop out immediately. Ranks are
+// positions within the filtered list, so "top fantasy" starts at 1 like the global ranking.
+func (r *rankingRepository) ListRanking(ctx context.Context, rankingType m.NovelRankingType, req d.ListNovelRankingRequest) (*d.PaginatedNovelRankingResponse, error) {
+	// Set pagination defaults
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 20
+	}
+	if req.PageSize > 100 {
+		req.PageSize = 100
+	}
+
+	conditions := []string{"rs.ranking_type = $1", rankingEligibilityCondition}
+	args := []interface{}{rankingType}
+	argIndex := 2
+
+	if !req.IncludeMature {
+		conditions = append(conditions, "n.mature_content = false")
+	}
+
+	if req.AgeRating != "" {
+		conditions = append(conditions, fmt.Sprintf("n.age_rating = $%d", argIndex))
+		args = append(args, req.AgeRating)
+		argIndex++
+	}
+
+	if req.GenreID != "" {
+		genreUUID, err := uuid.Parse(req.GenreID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid genre ID format: %w", err)
+		}
+		conditions = append(conditions, fmt.Sprintf("EXISTS (SELECT 1 FROM novel_genre ng WHERE ng.novel_id = n.id AND ng.genre_id = $%d)", argIndex))
+		args = append(args, genreUUID)
+		argIndex++
+	}
+
+	if req.Tag != "" {
+		conditions = append(conditions, fmt.Sprintf("n.tags ? $%d", argIndex))
+		args = append(args, req.Tag)
+		argIndex++
+	}
+
+	if req.Language != "" {
+		conditions = append(conditions, fmt.Sprintf(
+			"(n.original_language = $%d OR EXISTS (SELECT 1 FROM novel_translation nt WHERE nt.novel_id = n.id AND nt.language_code = $%d))",
+			argIndex, argIndex))
+		args = append(args, req.Language)
+		argIndex++
+	}
+
+	// Age gating and the viewer's filters, applied in the query so pages and totals only count visible novels
+	var visibility string
+	visibility, args, argIndex = contentVisibilityCondition(req.Visibility, "n", "n.content_warnings", args, argIndex)
+	if visibility != "" {
+		conditions = append(conditions, visibility)
+	}
+
+	whereClause := strings.Join(conditions, " AND ")
+
+	// Total count for pagination
+	countQuery := `
+		SELECT COUNT(*)
+		FROM novel_ranking_snapshot rs
+		JOIN novel n ON n.id = rs.novel_id
+		WHERE ` + whereClause
+
+	var total int64
+	if err := r.pool.QueryRow(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, fmt.Errorf("failed to count ranking entries: %w", err)
+	}
+
+	var computedAt *time.Time
+	err := r.pool.QueryRow(ctx,
+		`SELECT MAX(computed_at) FROM novel_ranking_snapshot WHERE ranking_type = $1`, rankingType,
+	).Scan(&computedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ranking computed time: %w", err)
+	}
+
+	offset := (req.Page - 1) * req.PageSize
+	listQuery := fmt.Sprintf(`
+		SELECT rs.score, n.id, COALESCE(n.name, ''), n.cover_image, n.age_rating,
+			n.mature_content, n.view_count, n.bookmark_count, n.rating_average
+		FROM novel_ranking_snapshot rs
+		JOIN novel n ON n.id = rs.novel_id
+		WHERE %s
+		ORDER BY rs.rank ASC
+		LIMIT $%d OFFSET $%d`, whereClause, argIndex, argIndex+1)
+	args = append(args, req.PageSize, offset)
+
+	rows, err := r.pool.Query(ctx, listQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute ranking query: %w", err)
+	}
+	defer rows.Close()
+
+	novels := make([]d.RankedNovelResponse, 0)
+	position := offset
+	for rows.Next() {
+		var item d.RankedNovelResponse
+		var novelID uuid.UUID
+		if err := rows.Scan(
+			&item.Score, &novelID, &item.Name, &item.CoverImage, &item.AgeRating,
+			&item.MatureContent, &item.ViewCount, &item.BookmarkCount, &item.RatingAverage,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan ranking row: %w", err)
+		}
+		item.ID = novelID.String()
+		position++
+		item.Rank = position
+		novels = append(novels, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate ranking rows: %w", err)
+	}
+
+	totalPages := int((total + int64(req.PageSize) - 1) / int64(req.PageSize))
+
+	return &d.PaginatedNovelRankingResponse{
+		RankingType: string(rankingType),
+		ComputedAt:  computedAt,
+		Novels:      novels,
+		Pagination: d.PaginationMeta{
+			Page:        req.Page,
+			PageSize:    req.PageSize,
+			Total:       total,
+			TotalPages:  totalPages,
+			HasNext:     req.Page < totalPages,
+			HasPrevious: req.Page > 1,
+		},
+	}, nil
+}
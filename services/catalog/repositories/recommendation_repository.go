@@ -0,0 +1,286 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+)
+
+// RecommendationRepository defines data access for pre-computed similar-novel neighbours
+type RecommendationRepository interface {
+	// RebuildNeighbours recomputes novel_similarity for every eligible novel
+	RebuildNeighbours(ctx context.Context, weights m.SimilarityWeights, perNovelLimit int) (int64, error)
+	// ListSimilarNovels returns the neighbours of one novel
+	ListSimilarNovels(ctx context.Context, novelID uuid.UUID, limit int, includeMature bool, visibility *d.ContentVisibility) ([]d.RecommendedNovelResponse, error)
+	// ListRecommendationsForUser blends the neighbours of the reader's bookmarked novels
+	ListRecommendationsForUser(ctx context.Context, userID uuid.UUID, limit int, includeMature bool, visibility *d.ContentVisibility) ([]d.RecommendedNovelResponse, error)
+}
+
+// recommendationRepository implements RecommendationRepository interface
+type recommendationRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewRecommendationRepository creates a new recommendation repository instance
+func NewRecommendationRepository(pool *pgxpool.Pool) RecommendationRepository {
+	return &recommendationRepository{pool: pool}
+}
+
+// RebuildNeighbours scores every pair of eligible novels sharing at least one genre, tag,
+// creator or bookmarking reader, then keeps the top perNovelLimit neighbours of each novel.
+// Genre and tag scores are Jaccard indexes, co-bookmarks use cosine similarity.
+func (r *recommendationRepository) RebuildNeighbours(ctx context.Context, weights m.SimilarityWeights, perNovelLimit int) (int64, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `DELETE FROM novel_similarity`); err != nil {
+		return 0, fmt.Errorf("failed to clear novel similarity: %w", err)
+	}
+
+	query := `
+		WITH eligible AS (
+			SELECT n.id FROM novel n WHERE ` + rankingEligibilityCondition + `
+		),
+		genre_counts AS (
+			SELECT ng.novel_id, COUNT(*) AS c
+			FROM novel_genre ng JOIN eligible e ON e.id = ng.novel_id
+			GROUP BY ng.novel_id
+		),
+		genre_pairs AS (
+			SELECT a.novel_id, b.novel_id AS similar_novel_id,
+				array_agg(a.genre_id) AS shared_ids, COUNT(*) AS shared
+			FROM novel_genre a
+			JOIN novel_genre b ON b.genre_id = a.genre_id AND b.novel_id <> a.novel_id
+			JOIN eligible ea ON ea.id = a.novel_id
+			JOIN eligible eb ON eb.id = b.novel_id
+			GROUP BY a.novel_id, b.novel_id
+		),
+		novel_tags AS (
+			SELECT DISTINCT n.id AS novel_id, LOWER(TRIM(t.tag)) AS tag
+			FROM novel n
+			JOIN eligible e ON e.id = n.id
+			CROSS JOIN LATERAL jsonb_array_elements_text(
+				CASE WHEN jsonb_typeof(n.tags) = 'array' THEN n.tags ELSE '[]'::jsonb END
+			) AS t(tag)
+			WHERE TRIM(t.tag) <> ''
+		),
+		tag_counts AS (
+			SELECT novel_id, COUNT(*) AS c FROM novel_tags GROUP BY novel_id
+		),
+		tag_pairs AS (
+			SELECT a.novel_id, b.novel_id AS similar_novel_id,
+				array_agg(a.tag) AS shared_tags, COUNT(*) AS shared
+			FROM novel_tags a
+			JOIN novel_tags b ON b.tag = a.tag AND b.novel_id <> a.novel_id
+			GROUP BY a.novel_id, b.novel_id
+		),
+		creator_pairs AS (
+			SELECT a.novel_id, b.novel_id AS similar_novel_id,
+				array_agg(DISTINCT a.creator_id) AS shared_ids, COUNT(DISTINCT a.creator_id) AS shared
+			FROM novel_creator a
+			JOIN novel_creator b ON b.creator_id = a.creator_id AND b.novel_id <> a.novel_id
+			JOIN eligible ea ON ea.id = a.novel_id
+			JOIN eligible eb ON eb.id = b.novel_id
+			GROUP BY a.novel_id, b.novel_id
+		),
+		bookmark_counts AS (
+			SELECT nb.novel_id, COUNT(*) AS c
+			FROM novel_bookmark nb JOIN eligible e ON e.id = nb.novel_id
+			GROUP BY nb.novel_id
+		),
+		cobookmark_pairs AS (
+			SELECT a.novel_id, b.novel_id AS similar_novel_id, COUNT(*) AS shared
+			FROM novel_bookmark a
+			JOIN novel_bookmark b ON b.user_id = a.user_id AND b.novel_id <> a.novel_id
+			JOIN eligible ea ON ea.id = a.novel_id
+			JOIN eligible eb ON eb.id = b.novel_id
+			GROUP BY a.novel_id, b.novel_id
+		),
+		candidates AS (
+			SELECT novel_id, similar_novel_id FROM genre_pairs
+			UNION SELECT novel_id, similar_novel_id FROM tag_pairs
+			UNION SELECT novel_id, similar_novel_id FROM creator_pairs
+			UNION SELECT novel_id, similar_novel_id FROM cobookmark_pairs
+		),
+		components AS (
+			SELECT c.novel_id, c.similar_novel_id,
+				COALESCE(gp.shared::float8 / NULLIF(ga.c + gb.c - gp.shared, 0), 0) AS genre_score,
+				COALESCE(tp.shared::float8 / NULLIF(ta.c + tb.c - tp.shared, 0), 0) AS tag_score,
+				COALESCE(LEAST(cp.shared, 2)::float8 / 2, 0) AS creator_score,
+				COALESCE(bp.shared::float8 / NULLIF(SQRT(ba.c::float8 * bb.c::float8), 0), 0) AS cobookmark_score,
+				COALESCE(gp.shared_ids, '{}') AS shared_genre_ids,
+				COALESCE(tp.shared_tags, '{}') AS shared_tags,
+				COALESCE(cp.shared_ids, '{}') AS shared_creator_ids,
+				COALESCE(bp.shared, 0) AS cobookmark_count
+			FROM candidates c
+			LEFT JOIN genre_pairs gp ON gp.novel_id = c.novel_id AND gp.similar_novel_id = c.similar_novel_id
+			LEFT JOIN genre_counts ga ON ga.novel_id = c.novel_id
+			LEFT JOIN genre_counts gb ON gb.novel_id = c.similar_novel_id
+			LEFT JOIN tag_pairs tp ON tp.novel_id = c.novel_id AND tp.similar_novel_id = c.similar_novel_id
+			LEFT JOIN tag_counts ta ON ta.novel_id = c.novel_id
+			LEFT JOIN tag_counts tb ON tb.novel_id = c.similar_novel_id
+			LEFT JOIN creator_pairs cp ON cp.novel_id = c.novel_id AND cp.similar_novel_id = c.similar_novel_id
+			LEFT JOIN cobookmark_pairs bp ON bp.novel_id = c.novel_id AND bp.similar_novel_id = c.similar_novel_id
+			LEFT JOIN bookmark_counts ba ON ba.novel_id = c.novel_id
+			LEFT JOIN bookmark_counts bb ON bb.novel_id = c.similar_novel_id
+		),
+		scored AS (
+			SELECT components.*,
+				genre_score * $1::float8 + tag_score * $2::float8
+					+ creator_score * $3::float8 + cobookmark_score * $4::float8 AS score
+			FROM components
+		),
+		ranked AS (
+			SELECT scored.*,
+				ROW_NUMBER() OVER (PARTITION BY novel_id ORDER BY score DESC, similar_novel_id) AS rank
+			FROM scored
+			WHERE score > 0
+		)
+		INSERT INTO novel_similarity (
+			novel_id, similar_novel_id, rank, score,
+			genre_score, tag_score, creator_score, cobookmark_score,
+			shared_genre_ids, shared_tags, shared_creator_ids, cobookmark_count, computed_at
+		)
+		SELECT novel_id, similar_novel_id, rank, score,
+			genre_score, tag_score, creator_score, cobookmark_score,
+			shared_genre_ids, shared_tags, shared_creator_ids, cobookmark_count, NOW()
+		FROM ranked
+		WHERE rank <= $5`
+
+	tag, err := tx.Exec(ctx, query, weights.Genre, weights.Tag, weights.Creator, weights.CoBookmark, perNovelLimit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to compute novel similarity: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// recommendationSelectColumns resolves a novel_similarity row (alias ns) into response columns.
+// Target eligibility is re-checked on the live row (alias n); src is the source novel.
+const recommendationSelectColumns = `
+	n.id, COALESCE(n.name, ''), n.cover_image, n.age_rating, n.mature_content, n.rating_average,
+	ns.score, src.id, COALESCE(src.name, ''),
+	COALESCE((SELECT array_agg(g.name ORDER BY g.name) FROM genre g WHERE g.id = ANY(ns.shared_genre_ids)), '{}'),
+	ns.shared_tags,
+	COALESCE((SELECT array_agg(cr.name ORDER BY cr.name) FROM creator cr WHERE cr.id = ANY(ns.shared_creator_ids)), '{}'),
+	ns.cobookmark_count`
+
+// ListSimilarNovels returns the pre-computed neighbours of a novel in rank order
+func (r *recommendationRepository) ListSimilarNovels(ctx context.Context, novelID uuid.UUID, limit int, includeMature bool, visibility *d.ContentVisibility) ([]d.RecommendedNovelResponse, error) {
+	var exists bool
+	err := r.pool.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM novel WHERE id = $1 AND is_deleted = false)`, novelID).Scan(&exists)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check novel existence: %w", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("novel not found")
+	}
+
+	args := []interface{}{novelID, includeMature, limit}
+	query := `
+		SELECT ` + recommendationSelectColumns + `
+		FROM novel_similarity ns
+		JOIN novel n ON n.id = ns.similar_novel_id
+		JOIN novel src ON src.id = ns.novel_id
+		WHERE ns.novel_id = $1
+		  AND ` + rankingEligibilityCondition + `
+		  AND ($2 OR n.mature_content = false)` + recommendationVisibility(visibility, &args) + `
+		ORDER BY ns.rank ASC
+		LIMIT $3`
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query similar novels: %w", err)
+	}
+
+	return scanRecommendations(rows)
+}
+
+// ListRecommendationsForUser takes the neighbours of the reader's 50 most recent bookmarks,
+// drops novels already bookmarked, and keeps the strongest source for each candidate.
+func (r *recommendationRepository) ListRecommendationsForUser(ctx context.Context, userID uuid.UUID, limit int, includeMature bool, visibility *d.ContentVisibility) ([]d.RecommendedNovelResponse, error) {
+	args := []interface{}{userID, includeMature, limit}
+	query := `
+		WITH recent_bookmarks AS (
+			SELECT novel_id FROM novel_bookmark
+			WHERE user_id = $1
+			ORDER BY created_at DESC
+			LIMIT 50
+		),
+		best AS (
+			SELECT DISTINCT ON (ns.similar_novel_id) ns.*
+			FROM novel_similarity ns
+			JOIN recent_bookmarks rb ON rb.novel_id = ns.novel_id
+			WHERE NOT EXISTS (
+				SELECT 1 FROM novel_bookmark own
+				WHERE own.user_id = $1 AND own.novel_id = ns.similar_novel_id
+			)
+			ORDER BY ns.similar_novel_id, ns.score DESC
+		)
+		SELECT ` + recommendationSelectColumns + `
+		FROM best ns
+		JOIN novel n ON n.id = ns.similar_novel_id
+		JOIN novel src ON src.id = ns.novel_id
+		WHERE ` + rankingEligibilityCondition + `
+		  AND ($2 OR n.mature_content = false)` + recommendationVisibility(visibility, &args) + `
+		ORDER BY ns.score DESC, n.id
+		LIMIT $3`
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query user recommendations: %w", err)
+	}
+
+	return scanRecommendations(rows)
+}
+
+// recommendationVisibility applies the viewer's gating to candidate novels aliased as n; the
+// queries reserve $1 to $3, so further arguments are appended after them
+func recommendationVisibility(visibility *d.ContentVisibility, args *[]interface{}) string {
+	condition, extended, _ := contentVisibilityCondition(visibility, "n", "n.content_warnings", *args, len(*args)+1)
+	if condition == "" {
+		return ""
+	}
+	*args = extended
+	return "\n\t\t  AND " + condition
+}
+
+// scanRecommendations reads rows produced with recommendationSelectColumns
+func scanRecommendations(rows pgx.Rows) ([]d.RecommendedNovelResponse, error) {
+	defer rows.Close()
+
+	results := make([]d.RecommendedNovelResponse, 0)
+	for rows.Next() {
+		var item d.RecommendedNovelResponse
+		var id, sourceID uuid.UUID
+		if err := rows.Scan(
+			&id, &item.Name, &item.CoverImage, &item.AgeRating, &item.MatureContent, &item.RatingAverage,
+			&item.Score, &sourceID, &item.BecauseYouRead.Name,
+			&item.Reasons.SharedGenres, &item.Reasons.SharedTags, &item.Reasons.SharedCreators,
+			&item.Reasons.CoBookmarkCount,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan recommendation row: %w", err)
+		}
+		item.ID = id.String()
+		item.BecauseYouRead.ID = sourceID.String()
+		results = append(results, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate recommendation rows: %w", err)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,286 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+)
+
+// readerChapterColumns lists the chapter columns read into d.ReaderChapterRow; $1 is the reader
+var readerChapterColumns = `
+	c.id, c.volume_id, c.chapter_number, c.title, c.published_at, c.price_coins,
+	c.word_count, c.reading_time_minutes, c.has_mature_content, ` + warningsArray("c.content_warnings") + `,
+	EXISTS (
+		SELECT 1 FROM user_content_purchases ucp
+		WHERE ucp.user_id = $1
+		  AND ((ucp.item_type = 'NOVEL_CHAPTER' AND ucp.item_id = c.id)
+			OR (ucp.item_type = 'NOVEL_VOLUME' AND ucp.item_id = v.id)
+			OR (ucp.item_type = 'NOVEL_SERIES' AND ucp.item_id = v.novel_id))
+	) OR EXISTS (
+		SELECT 1 FROM user_content_rentals ucr
+		WHERE ucr.user_id = $1
+		  AND ucr.expiry_date > CURRENT_TIMESTAMP
+		  AND ((ucr.item_type = 'NOVEL_VOLUME' AND ucr.item_id = v.id)
+			OR (ucr.item_type = 'NOVEL_SERIES' AND ucr.item_id = v.novel_id))
+	),
+	EXISTS (SELECT 1 FROM novel_chapter_unlock u WHERE u.user_id = $1 AND u.chapter_id = c.id)`
+
+// ReleaseRepository defines data access for wait-until-free and early access release models
+type ReleaseRepository interface {
+	// GetPolicy returns the release policy of a live novel; a novel without one gets an empty policy
+	GetPolicy(ctx context.Context, novelID uuid.UUID) (*m.NovelReleasePolicy, error)
+	// UpsertPolicy creates or replaces the release policy of a novel
+	UpsertPolicy(ctx context.Context, policy *m.NovelReleasePolicy) error
+
+	// GetReaderFacts returns whether the user is an active VIP and when they last unlocked a chapter of the novel
+	GetReaderFacts(ctx context.Context, userID, novelID uuid.UUID) (*d.ReaderFacts, error)
+	// ListReaderChapters lists the published chapters of a volume released by now, or within
+	// earlyAccessHours from now, with the reader's ownership
+	ListReaderChapters(ctx context.Context, volumeID, userID uuid.UUID, earlyAccessHours int, req d.ListReaderChaptersRequest) ([]d.ReaderChapterRow, *d.PaginationMeta, error)
+	// GetChapterAccess returns a chapter of a live novel with the reader's ownership
+	GetChapterAccess(ctx context.Context, chapterID, userID uuid.UUID) (*d.ChapterAccessInfo, error)
+	// UnlockChapter records a free unlock unless the reader unlocked a chapter of the novel within interval
+	UnlockChapter(ctx context.Context, userID, chapterID, novelID uuid.UUID, interval time.Duration) (time.Time, error)
+}
+
+// releaseRepository implements ReleaseRepository interface
+type releaseRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewReleaseRepository creates a new release repository instance
+func NewReleaseRepository(pool *pgxpool.Pool) ReleaseRepository {
+	return &releaseRepository{pool: pool}
+}
+
+// GetPolicy reads the novel's policy; the settings stay nil when the novel has none
+func (r *releaseRepository) GetPolicy(ctx context.Context, novelID uuid.UUID) (*m.NovelReleasePolicy, error) {
+	query := `
+		SELECT n.id, p.free_after_days, p.unlock_interval_hours, p.early_access_hours,
+			p.updated_by_user_id, COALESCE(p.created_at, n.created_at), COALESCE(p.updated_at, n.created_at)
+		FROM novel n
+		LEFT JOIN novel_release_policy p ON p.novel_id = n.id
+		WHERE n.id = $1 AND n.is_deleted = FALSE`
+
+	var policy m.NovelReleasePolicy
+	err := r.pool.QueryRow(ctx, query, novelID).Scan(
+		&policy.NovelID, &policy.FreeAfterDays, &policy.UnlockIntervalHours, &policy.EarlyAccessHours,
+		&policy.UpdatedByUserID, &policy.CreatedAt, &policy.UpdatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("novel not found")
+		}
+		return nil, fmt.Errorf("failed to get release policy: %w", err)
+	}
+
+	return &policy, nil
+}
+
+// UpsertPolicy writes every setting of the policy and fills its timestamps
+func (r *releaseRepository) UpsertPolicy(ctx context.Context, policy *m.NovelReleasePolicy) error {
+	query := `
+		INSERT INTO novel_release_policy (novel_id, free_after_days, unlock_interval_hours, early_access_hours, updated_by_user_id)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (novel_id) DO UPDATE
+		SET free_after_days = EXCLUDED.free_after_days,
+			unlock_interval_hours = EXCLUDED.unlock_interval_hours,
+			early_access_hours = EXCLUDED.early_access_hours,
+			updated_by_user_id = EXCLUDED.updated_by_user_id,
+			updated_at = CURRENT_TIMESTAMP
+		RETURNING created_at, updated_at`
+
+	err := r.pool.QueryRow(ctx, query,
+		policy.NovelID, policy.FreeAfterDays, policy.UnlockIntervalHours, policy.EarlyAccessHours, policy.UpdatedByUserID,
+	).Scan(&policy.CreatedAt, &policy.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save release policy: %w", err)
+	}
+
+	return nil
+}
+
+// GetReaderFacts checks for a running VIP subscription and the latest free unlock in the novel
+func (r *releaseRepository) GetReaderFacts(ctx context.Context, userID, novelID uuid.UUID) (*d.ReaderFacts, error) {
+	query := `
+		SELECT
+			EXISTS (
+				SELECT 1 FROM user_subscriptions
+				WHERE user_id = $1 AND tier = 'VIP'
+				  AND start_date <= CURRENT_DATE
+				  AND (end_date IS NULL OR end_date >= CURRENT_DATE)
+			),
+			(SELECT MAX(unlocked_at) FROM novel_chapter_unlock WHERE user_id = $1 AND novel_id = $2)`
+
+	var facts d.ReaderFacts
+	if err := r.pool.QueryRow(ctx, query, userID, novelID).Scan(&facts.IsVIP, &facts.LastUnlockAt); err != nil {
+		return nil, fmt.Errorf("failed to get reader facts: %w", err)
+	}
+	return &facts, nil
+}
+
+// ListReaderChapters lists published chapters in reading order. Scheduled chapters inside the
+// early access window are included so every reader sees the countdown.
+func (r *releaseRepository) ListReaderChapters(ctx context.Context, volumeID, userID uuid.UUID, earlyAccessHours int, req d.ListReaderChaptersRequest) ([]d.ReaderChapterRow, *d.PaginationMeta, error) {
+	// Set pagination defaults
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.Limit <= 0 {
+		req.Limit = 50
+	}
+	if req.Limit > 100 {
+		req.Limit = 100
+	}
+
+	var total int64
+	countQuery := `
+		SELECT COUNT(*)
+		FROM novel_chapter c
+		WHERE c.volume_id = $1 AND c.is_deleted = FALSE
+		  AND c.is_public = TRUE AND c.is_draft = FALSE
+		  AND c.published_at IS NOT NULL
+		  AND c.published_at <= CURRENT_TIMESTAMP + make_interval(hours => $2)`
+	if err := r.pool.QueryRow(ctx, countQuery, volumeID, earlyAccessHours).Scan(&total); err != nil {
+		return nil, nil, fmt.Errorf("failed to count chapters: %w", err)
+	}
+
+	offset := (req.Page - 1) * req.Limit
+	rows, err := r.pool.Query(ctx, `
+		SELECT `+readerChapterColumns+`
+		FROM novel_chapter c
+		JOIN novel_volume v ON v.id = c.volume_id
+		WHERE c.volume_id = $2 AND c.is_deleted = FALSE
+		  AND c.is_public = TRUE AND c.is_draft = FALSE
+		  AND c.published_at IS NOT NULL
+		  AND c.published_at <= CURRENT_TIMESTAMP + make_interval(hours => $3)
+		ORDER BY c.chapter_number ASC
+		LIMIT $4 OFFSET $5`, userID, volumeID, earlyAccessHours, req.Limit, offset)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list chapters: %w", err)
+	}
+	defer rows.Close()
+
+	chapters := make([]d.ReaderChapterRow, 0)
+	for rows.Next() {
+		chapter, err := scanReaderChapter(rows)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to scan chapter: %w", err)
+		}
+		chapters = append(chapters, *chapter)
+	}
+	if rows.Err() != nil {
+		return nil, nil, fmt.Errorf("failed to iterate chapters: %w", rows.Err())
+	}
+
+	totalPages := int((total + int64(req.Limit) - 1) / int64(req.Limit))
+
+	return chapters, &d.PaginationMeta{
+		Page:        req.Page,
+		PageSize:    req.Limit,
+		Total:       total,
+		TotalPages:  totalPages,
+		HasNext:     req.Page < totalPages,
+		HasPrevious: req.Page > 1,
+	}, nil
+}
+
+// GetChapterAccess reads a chapter of a live volume and novel; drafts and unpublished chapters
+// come back with Released unset
+func (r *releaseRepository) GetChapterAccess(ctx context.Context, chapterID, userID uuid.UUID) (*d.ChapterAccessInfo, error) {
+	query := `
+		SELECT ` + readerChapterColumns + `,
+			v.novel_id, (c.is_public AND NOT c.is_draft AND c.published_at IS NOT NULL)
+		FROM novel_chapter c
+		JOIN novel_volume v ON v.id = c.volume_id AND v.is_deleted = FALSE
+		JOIN novel n ON n.id = v.novel_id AND n.is_deleted = FALSE
+		WHERE c.id = $2 AND c.is_deleted = FALSE`
+
+	var info d.ChapterAccessInfo
+	var publishedAt *time.Time
+	row := &info.Chapter
+	err := r.pool.QueryRow(ctx, query, userID, chapterID).Scan(
+		&row.ID, &row.VolumeID, &row.ChapterNumber, &row.Title, &publishedAt, &row.PriceCoins,
+		&row.WordCount, &row.ReadingTimeMinutes, &row.HasMatureContent, &row.ContentWarnings, &row.Owned, &row.Unlocked,
+		&info.NovelID, &info.Released,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("chapter not found")
+		}
+		return nil, fmt.Errorf("failed to get chapter access: %w", err)
+	}
+	if publishedAt != nil {
+		row.PublishedAt = *publishedAt
+	}
+
+	return &info, nil
+}
+
+// UnlockChapter serializes the reader's unlocks in the novel with an advisory lock so two
+// concurrent requests cannot both use the same wait period
+func (r *releaseRepository) UnlockChapter(ctx context.Context, userID, chapterID, novelID uuid.UUID, interval time.Duration) (time.Time, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtextextended($1::text || $2::text, 0))`, userID, novelID); err != nil {
+		return time.Time{}, fmt.Errorf("failed to lock reader unlocks: %w", err)
+	}
+
+	var alreadyUnlocked, waiting bool
+	var nextUnlockAt *time.Time
+	err = tx.QueryRow(ctx, `
+		SELECT
+			EXISTS (SELECT 1 FROM novel_chapter_unlock WHERE user_id = $1 AND chapter_id = $2),
+			COALESCE(MAX(unlocked_at) + make_interval(secs => $4) > CURRENT_TIMESTAMP, FALSE),
+			MAX(unlocked_at) + make_interval(secs => $4)
+		FROM novel_chapter_unlock
+		WHERE user_id = $1 AND novel_id = $3`, userID, chapterID, novelID, interval.Seconds()).Scan(&alreadyUnlocked, &waiting, &nextUnlockAt)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to check last unlock: %w", err)
+	}
+	if alreadyUnlocked {
+		return time.Time{}, fmt.Errorf("chapter already unlocked")
+	}
+	if waiting && nextUnlockAt != nil {
+		return time.Time{}, fmt.Errorf("free unlock not available until %s", nextUnlockAt.Format(time.RFC3339))
+	}
+
+	var unlockedAt time.Time
+	err = tx.QueryRow(ctx, `
+		INSERT INTO novel_chapter_unlock (user_id, chapter_id, novel_id)
+		VALUES ($1, $2, $3)
+		RETURNING unlocked_at`, userID, chapterID, novelID).Scan(&unlockedAt)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to unlock chapter: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return time.Time{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return unlockedAt, nil
+}
+
+// scanReaderChapter reads a row selected with readerChapterColumns
+func scanReaderChapter(row pgx.Row) (*d.ReaderChapterRow, error) {
+	var chapter d.ReaderChapterRow
+	err := row.Scan(
+		&chapter.ID, &chapter.VolumeID, &chapter.ChapterNumber, &chapter.Title, &chapter.PublishedAt, &chapter.PriceCoins,
+		&chapter.WordCount, &chapter.ReadingTimeMinutes, &chapter.HasMatureContent, &chapter.ContentWarnings, &chapter.Owned, &chapter.Unlocked,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &chapter, nil
+}
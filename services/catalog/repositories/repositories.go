@@ -5,26 +5,74 @@ import "github.com/jackc/pgx/v5/pgxpool"
 
 // Repositories aggregates repository interfaces used by handlers.
 type Repositories struct {
-	Health     HealthRepository
-	Genre      GenreRepository
-	Character  CharacterRepository
-	Creator    CreatorRepository
-	Novel      NovelRepository
-	NovelQuery NovelQueryRepository // CQRS: Query-side repository for complex reads
-	Volume     VolumeRepository     // Volume management repository
-	Chapter    ChapterRepository    // Chapter management repository
+	Health                  HealthRepository
+	Genre                   GenreRepository
+	Character               CharacterRepository
+	Creator                 CreatorRepository
+	Novel                   NovelRepository
+	NovelQuery              NovelQueryRepository              // CQRS: Query-side repository for complex reads
+	Volume                  VolumeRepository                  // Volume management repository
+	Chapter                 ChapterRepository                 // Chapter management repository
+	Ranking                 RankingRepository                 // Engagement aggregates and ranking snapshots
+	Bookmark                BookmarkRepository                // Reader bookmarks
+	Recommendation          RecommendationRepository          // Pre-computed similar-novel neighbours
+	Analytics               AnalyticsRepository               // Owner analytics aggregates
+	Moderation              ModerationRepository              // Content reports and moderation queue
+	CharacterContribution   CharacterContributionRepository   // Character proposals and review
+	ContentRelation         ContentRelationRepository         // Relations between works and franchise graph
+	Anime                   AnimeRepository                   // Anime series, localized titles and cast
+	AnimeEpisode            AnimeEpisodeRepository            // Anime seasons, episodes and subtitles
+	SubtitleContribution    SubtitleContributionRepository    // Community subtitles and review
+	Manga                   MangaRepository                   // Manga series and localized titles
+	MangaChapter            MangaChapterRepository            // Manga volumes, chapters and ordered pages
+	Media                   MediaRepository                   // Uploaded files deduplicated by checksum
+	Translation             TranslationRepository             // Localized novel metadata and translated chapters
+	TranslationContribution TranslationContributionRepository // Community and machine-made translations awaiting review
+	Tag                     TagRepository                     // Canonical tags, aliases and usage counts
+	CreatorClaim            CreatorClaimRepository            // Claims linking creator pages to accounts
+	Counter                 CounterRepository                 // Reconciliation of denormalized novel and volume counters
+	Trash                   TrashRepository                   // Restore, purge and buyer archive of soft-deleted novels
+	ChapterOrder            ChapterOrderRepository            // Chapter reordering, moves and volume split/merge
+	Pricing                 PricingRepository                 // Discount campaigns, bundles and price quotes
+	Release                 ReleaseRepository                 // Wait-until-free unlocks and early access
+	ContentFilter           ContentFilterRepository           // Reader content filters and tenant age gating
+	ContentWarning          ContentWarningRepository          // Managed content warning taxonomy
 }
 
 // NewRepositories instantiates concrete repository implementations.
 func NewRepositories(pool *pgxpool.Pool) *Repositories {
 	return &Repositories{
-		Health:     NewHealthRepository(pool),
-		Genre:      NewGenreRepository(pool),
-		Character:  NewCharacterRepository(pool),
-		Creator:    NewCreatorRepository(pool),
-		Novel:      NewNovelRepository(pool),
-		NovelQuery: NewNovelQueryRepository(pool),
-		Volume:     NewVolumeRepository(pool),
-		Chapter:    NewChapterRepository(pool),
+		Health:                  NewHealthRepository(pool),
+		Genre:                   NewGenreRepository(pool),
+		Character:               NewCharacterRepository(pool),
+		Creator:                 NewCreatorRepository(pool),
+		Novel:                   NewNovelRepository(pool),
+		NovelQuery:              NewNovelQueryRepository(pool),
+		Volume:                  NewVolumeRepository(pool),
+		Chapter:                 NewChapterRepository(pool),
+		Ranking:                 NewRankingRepository(pool),
+		Bookmark:                NewBookmarkRepository(pool),
+		Recommendation:          NewRecommendationRepository(pool),
+		Analytics:               NewAnalyticsRepository(pool),
+		Moderation:              NewModerationRepository(pool),
+		CharacterContribution:   NewCharacterContributionRepository(pool),
+		ContentRelation:         NewContentRelationRepository(pool),
+		Anime:                   NewAnimeRepository(pool),
+		AnimeEpisode:            NewAnimeEpisodeRepository(pool),
+		SubtitleContribution:    NewSubtitleContributionRepository(pool),
+		Manga:                   NewMangaRepository(pool),
+		MangaChapter:            NewMangaChapterRepository(pool),
+		Media:                   NewMediaRepository(pool),
+		Translation:             NewTranslationRepository(pool),
+		TranslationContribution: NewTranslationContributionRepository(pool),
+		Tag:                     NewTagRepository(pool),
+		CreatorClaim:            NewCreatorClaimRepository(pool),
+		Counter:                 NewCounterRepository(pool),
+		Trash:                   NewTrashRepository(pool),
+		ChapterOrder:            NewChapterOrderRepository(pool),
+		Pricing:                 NewPricingRepository(pool),
+		Release:                 NewReleaseRepository(pool),
+		ContentFilter:           NewContentFilterRepository(pool),
+		ContentWarning:          NewContentWarningRepository(pool),
 	}
 }
@@ -0,0 +1,297 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+)
+
+// subtitleContributionColumns lists the subtitle_contributions columns read into m.SubtitleContribution
+const subtitleContributionColumns = `
+	id, episode_id, language_code, source_format, content, cue_count, note,
+	user_id, tenant_id, status, rejection_reason, reviewer_id, reviewed_at,
+	created_at, updated_at`
+
+// SubtitleContributionRepository defines data access for community subtitles and their review
+type SubtitleContributionRepository interface {
+	// Create stores a new pending contribution
+	Create(ctx context.Context, contribution *m.SubtitleContribution) error
+	// GetByID retrieves a contribution by ID
+	GetByID(ctx context.Context, id uuid.UUID) (*m.SubtitleContribution, error)
+	// UpdatePending replaces the subtitle of a pending contribution owned by its contributor
+	UpdatePending(ctx context.Context, contribution *m.SubtitleContribution) error
+	// List retrieves a page of contributions; animeID restricts to one anime, userID to one contributor
+	List(ctx context.Context, req d.ListSubtitleContributionsRequest, animeID, userID *uuid.UUID) ([]m.SubtitleContribution, *d.PaginationMeta, error)
+	// Approve publishes a pending contribution as the episode subtitle of its language
+	Approve(ctx context.Context, id, reviewerID uuid.UUID) error
+	// Reject closes a pending contribution with a reason
+	Reject(ctx context.Context, id, reviewerID uuid.UUID, reason string) error
+}
+
+// subtitleContributionRepository implements SubtitleContributionRepository interface
+type subtitleContributionRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewSubtitleContributionRepository creates a new subtitle contribution repository instance
+func NewSubtitleContributionRepository(pool *pgxpool.Pool) SubtitleContributionRepository {
+	return &subtitleContributionRepository{pool: pool}
+}
+
+// Create inserts a pending contribution and fills its ID, status and timestamps
+func (r *subtitleContributionRepository) Create(ctx context.Context, contribution *m.SubtitleContribution) error {
+	query := `
+		INSERT INTO subtitle_contributions (
+			episode_id, language_code, source_format, content, cue_count, note, user_id, tenant_id
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id, status, created_at, updated_at`
+
+	err := r.pool.QueryRow(ctx, query,
+		contribution.EpisodeID, contribution.LanguageCode, contribution.SourceFormat, contribution.Content,
+		contribution.CueCount, contribution.Note, contribution.UserID, contribution.TenantID,
+	).Scan(&contribution.ID, &contribution.Status, &contribution.CreatedAt, &contribution.UpdatedAt)
+	if err != nil {
+		if strings.Contains(err.Error(), "foreign key") {
+			return fmt.Errorf("episode not found")
+		}
+		return fmt.Errorf("failed to create subtitle contribution: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a contribution by its ID
+func (r *subtitleContributionRepository) GetByID(ctx context.Context, id uuid.UUID) (*m.SubtitleContribution, error) {
+	row := r.pool.QueryRow(ctx, `SELECT `+subtitleContributionColumns+` FROM subtitle_contributions WHERE id = $1`, id)
+
+	contribution, err := scanSubtitleContribution(row)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("subtitle contribution not found")
+		}
+		return nil, fmt.Errorf("failed to get subtitle contribution: %w", err)
+	}
+
+	return contribution, nil
+}
+
+// UpdatePending overwrites the proposed subtitle while the contribution is still pending
+func (r *subtitleContributionRepository) UpdatePending(ctx context.Context, contribution *m.SubtitleContribution) error {
+	query := `
+		UPDATE subtitle_contributions
+		SET source_format = $3, content = $4, cue_count = $5, note = $6, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND user_id = $2 AND status = 'pending'
+		RETURNING updated_at`
+
+	err := r.pool.QueryRow(ctx, query,
+		contribution.ID, contribution.UserID, contribution.SourceFormat, contribution.Content,
+		contribution.CueCount, contribution.Note,
+	).Scan(&contribution.UpdatedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return fmt.Errorf("subtitle contribution already reviewed")
+		}
+		return fmt.Errorf("failed to update subtitle contribution: %w", err)
+	}
+
+	return nil
+}
+
+// List retrieves contributions, oldest first for the review queue
+func (r *subtitleContributionRepository) List(ctx context.Context, req d.ListSubtitleContributionsRequest, animeID, userID *uuid.UUID) ([]m.SubtitleContribution, *d.PaginationMeta, error) {
+	// Set pagination defaults
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 20
+	}
+	if req.PageSize > 100 {
+		req.PageSize = 100
+	}
+
+	conditions := []string{}
+	args := []interface{}{}
+	argIndex := 1
+
+	if req.Status != "" {
+		conditions = append(conditions, fmt.Sprintf("status = $%d", argIndex))
+		args = append(args, req.Status)
+		argIndex++
+	}
+
+	if req.EpisodeID != "" {
+		episodeID, err := uuid.Parse(req.EpisodeID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid episode ID format: %w", err)
+		}
+		conditions = append(conditions, fmt.Sprintf("episode_id = $%d", argIndex))
+		args = append(args, episodeID)
+		argIndex++
+	}
+
+	if req.LanguageCode != "" {
+		conditions = append(conditions, fmt.Sprintf("language_code = $%d", argIndex))
+		args = append(args, req.LanguageCode)
+		argIndex++
+	}
+
+	if animeID != nil {
+		conditions = append(conditions, fmt.Sprintf(`episode_id IN (
+			SELECT e.id FROM anime_episode e JOIN anime_season s ON s.id = e.season_id WHERE s.anime_id = $%d)`, argIndex))
+		args = append(args, *animeID)
+		argIndex++
+	}
+
+	if userID != nil {
+		conditions = append(conditions, fmt.Sprintf("user_id = $%d", argIndex))
+		args = append(args, *userID)
+		argIndex++
+	}
+
+	whereClause := ""
+	if len(conditions) > 0 {
+		whereClause = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	var total int64
+	if err := r.pool.QueryRow(ctx, `SELECT COUNT(*) FROM subtitle_contributions `+whereClause, args...).Scan(&total); err != nil {
+		return nil, nil, fmt.Errorf("failed to count subtitle contributions: %w", err)
+	}
+
+	offset := (req.Page - 1) * req.PageSize
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM subtitle_contributions
+		%s
+		ORDER BY created_at ASC
+		LIMIT $%d OFFSET $%d`, subtitleContributionColumns, whereClause, argIndex, argIndex+1)
+	args = append(args, req.PageSize, offset)
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list subtitle contributions: %w", err)
+	}
+	defer rows.Close()
+
+	contributions := make([]m.SubtitleContribution, 0)
+	for rows.Next() {
+		contribution, err := scanSubtitleContribution(rows)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to scan subtitle contribution: %w", err)
+		}
+		contributions = append(contributions, *contribution)
+	}
+
+	if rows.Err() != nil {
+		return nil, nil, fmt.Errorf("failed to iterate subtitle contributions: %w", rows.Err())
+	}
+
+	totalPages := int((total + int64(req.PageSize) - 1) / int64(req.PageSize))
+
+	return contributions, &d.PaginationMeta{
+		Page:        req.Page,
+		PageSize:    req.PageSize,
+		Total:       total,
+		TotalPages:  totalPages,
+		HasNext:     req.Page < totalPages,
+		HasPrevious: req.Page > 1,
+	}, nil
+}
+
+// Approve replaces the episode subtitle of the contribution's language with the proposal
+// and marks the contribution approved, crediting the contributor as uploader.
+func (r *subtitleContributionRepository) Approve(ctx context.Context, id, reviewerID uuid.UUID) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	row := tx.QueryRow(ctx, `SELECT `+subtitleContributionColumns+` FROM subtitle_contributions WHERE id = $1 FOR UPDATE`, id)
+	contribution, err := scanSubtitleContribution(row)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return fmt.Errorf("subtitle contribution not found")
+		}
+		return fmt.Errorf("failed to get subtitle contribution: %w", err)
+	}
+
+	if contribution.Status != m.SubtitleContributionPending {
+		return fmt.Errorf("subtitle contribution already reviewed")
+	}
+
+	_, err = upsertEpisodeSubtitle(ctx, tx, &m.EpisodeSubtitle{
+		EpisodeID:        contribution.EpisodeID,
+		LanguageCode:     contribution.LanguageCode,
+		SourceFormat:     &contribution.SourceFormat,
+		Content:          &contribution.Content,
+		CueCount:         &contribution.CueCount,
+		UploadedByUserID: &contribution.UserID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to publish subtitle: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE subtitle_contributions
+		SET status = 'approved', reviewer_id = $2, reviewed_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1`, id, reviewerID)
+	if err != nil {
+		return fmt.Errorf("failed to approve subtitle contribution: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// Reject marks a pending contribution as rejected
+func (r *subtitleContributionRepository) Reject(ctx context.Context, id, reviewerID uuid.UUID, reason string) error {
+	tag, err := r.pool.Exec(ctx, `
+		UPDATE subtitle_contributions
+		SET status = 'rejected', rejection_reason = $3, reviewer_id = $2, reviewed_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND status = 'pending'`, id, reviewerID, reason)
+	if err != nil {
+		return fmt.Errorf("failed to reject subtitle contribution: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		var exists bool
+		if err := r.pool.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM subtitle_contributions WHERE id = $1)`, id).Scan(&exists); err != nil {
+			return fmt.Errorf("failed to check subtitle contribution: %w", err)
+		}
+		if !exists {
+			return fmt.Errorf("subtitle contribution not found")
+		}
+		return fmt.Errorf("subtitle contribution already reviewed")
+	}
+
+	return nil
+}
+
+// scanSubtitleContribution scans one row selected with subtitleContributionColumns
+func scanSubtitleContribution(row pgx.Row) (*m.SubtitleContribution, error) {
+	var contribution m.SubtitleContribution
+	err := row.Scan(
+		&contribution.ID, &contribution.EpisodeID, &contribution.LanguageCode,
+		&contribution.SourceFormat, &contribution.Content, &contribution.CueCount, &contribution.Note,
+		&contribution.UserID, &contribution.TenantID, &contribution.Status, &contribution.RejectionReason,
+		&contribution.ReviewerID, &contribution.ReviewedAt,
+		&contribution.CreatedAt, &contribution.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &contribution, nil
+}
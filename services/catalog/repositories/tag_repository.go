@@ -0,0 +1,556 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// TagRepository defines the tag registry: canonical tags, their aliases and usage counts
+type TagRepository interface {
+	Create(ctx context.Context, tag *m.Tag, aliases []m.TagAlias) error
+	GetByID(ctx context.Context, id uuid.UUID) (*m.Tag, error)
+	// Update writes the tag and, when aliases is not nil, replaces its aliases; a new name is
+	// rewritten into novel.tags and the previous name is kept as an alias
+	Update(ctx context.Context, tag *m.Tag, previous *m.Tag, aliases []m.TagAlias) error
+	List(ctx context.Context, limit, offset int, search string) ([]*m.Tag, int64, error)
+	Autocomplete(ctx context.Context, prefix string, limit int) ([]d.TagSuggestion, error)
+	GetAliases(ctx context.Context, tagIDs []uuid.UUID) (map[uuid.UUID][]m.TagAlias, error)
+
+	// Resolve maps normalized names and aliases to canonical tag names; unknown keys are absent
+	Resolve(ctx context.Context, normalizedNames []string) (map[string]string, error)
+	// Register adds the tags that match neither a canonical name nor an alias, then resolves all of them
+	Register(ctx context.Context, tags []m.Tag) (map[string]string, error)
+	RefreshUsageCounts(ctx context.Context, names []string) error
+	Merge(ctx context.Context, sourceID, targetID uuid.UUID) (*d.TagMergeResponse, error)
+}
+
+type tagRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewTagRepository creates a Postgres-backed TagRepository
+func NewTagRepository(pool *pgxpool.Pool) TagRepository {
+	return &tagRepository{pool: pool}
+}
+
+// replaceNovelTagQuery swaps one canonical name for another in novel.tags, dropping the
+// duplicate when a novel already carries both and keeping the original order
+const replaceNovelTagQuery = `
+	UPDATE novel n
+	SET tags = (
+		SELECT COALESCE(jsonb_agg(renamed.value ORDER BY renamed.position), '[]'::jsonb)
+		FROM (
+			SELECT DISTINCT ON (value) value, position
+			FROM (
+				SELECT CASE WHEN e.value = $1 THEN $2 ELSE e.value END AS value, e.position
+				FROM jsonb_array_elements_text(n.tags) WITH ORDINALITY AS e(value, position)
+			) replaced
+			ORDER BY value, position
+		) renamed
+	)
+	WHERE jsonb_typeof(n.tags) = 'array' AND n.tags ? $1
+`
+
+// Create inserts a canonical tag with its aliases
+func (r *tagRepository) Create(ctx context.Context, tag *m.Tag, aliases []m.TagAlias) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var isAlias bool
+	if err := tx.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM tag_alias WHERE normalized_alias = $1)`, tag.NormalizedName).Scan(&isAlias); err != nil {
+		return fmt.Errorf("failed to check tag aliases: %w", err)
+	}
+	if isAlias {
+		return fmt.Errorf("tag already exists as an alias: %q", tag.Name)
+	}
+
+	err = tx.QueryRow(ctx, `
+		INSERT INTO tag (name, normalized_name, language_code, description)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, usage_count, created_at, updated_at
+	`, tag.Name, tag.NormalizedName, tag.LanguageCode, tag.Description).Scan(
+		&tag.ID, &tag.UsageCount, &tag.CreatedAt, &tag.UpdatedAt,
+	)
+	if err != nil {
+		if strings.Contains(err.Error(), "duplicate key") {
+			return fmt.Errorf("tag already exists: %q", tag.Name)
+		}
+		return fmt.Errorf("failed to create tag: %w", err)
+	}
+
+	if err := insertTagAliases(ctx, tx, tag.ID, aliases); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a tag with its aliases
+func (r *tagRepository) GetByID(ctx context.Context, id uuid.UUID) (*m.Tag, error) {
+	var tag m.Tag
+	err := r.pool.QueryRow(ctx, `
+		SELECT id, name, normalized_name, language_code, description, usage_count, created_at, updated_at
+		FROM tag
+		WHERE id = $1
+	`, id).Scan(
+		&tag.ID, &tag.Name, &tag.NormalizedName, &tag.LanguageCode, &tag.Description,
+		&tag.UsageCount, &tag.CreatedAt, &tag.UpdatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("tag not found")
+		}
+		return nil, fmt.Errorf("failed to get tag: %w", err)
+	}
+
+	aliases, err := r.GetAliases(ctx, []uuid.UUID{id})
+	if err != nil {
+		return nil, err
+	}
+	tag.Aliases = aliases[id]
+
+	return &tag, nil
+}
+
+// Update writes the tag fields, renames it in novel.tags and replaces its aliases
+func (r *tagRepository) Update(ctx context.Context, tag *m.Tag, previous *m.Tag, aliases []m.TagAlias) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if tag.NormalizedName != previous.NormalizedName {
+		var owner uuid.UUID
+		err := tx.QueryRow(ctx, `SELECT tag_id FROM tag_alias WHERE normalized_alias = $1`, tag.NormalizedName).Scan(&owner)
+		if err == nil && owner != tag.ID {
+			return fmt.Errorf("tag already exists as an alias: %q", tag.Name)
+		}
+		if err != nil && err != pgx.ErrNoRows {
+			return fmt.Errorf("failed to check tag aliases: %w", err)
+		}
+	}
+
+	err = tx.QueryRow(ctx, `
+		UPDATE tag
+		SET name = $2, normalized_name = $3, language_code = $4, description = $5, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1
+		RETURNING updated_at
+	`, tag.ID, tag.Name, tag.NormalizedName, tag.LanguageCode, tag.Description).Scan(&tag.UpdatedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return fmt.Errorf("tag not found")
+		}
+		if strings.Contains(err.Error(), "duplicate key") {
+			return fmt.Errorf("tag already exists: %q", tag.Name)
+		}
+		return fmt.Errorf("failed to update tag: %w", err)
+	}
+
+	if tag.Name != previous.Name {
+		if _, err := tx.Exec(ctx, replaceNovelTagQuery, previous.Name, tag.Name); err != nil {
+			return fmt.Errorf("failed to rename tag on novels: %w", err)
+		}
+	}
+
+	if aliases != nil {
+		if _, err := tx.Exec(ctx, `DELETE FROM tag_alias WHERE tag_id = $1`, tag.ID); err != nil {
+			return fmt.Errorf("failed to clear tag aliases: %w", err)
+		}
+		if err := insertTagAliases(ctx, tx, tag.ID, aliases); err != nil {
+			return err
+		}
+	}
+
+	if tag.NormalizedName != previous.NormalizedName {
+		// The new name is no longer an alias; the old one becomes one so earlier spellings still resolve
+		if _, err := tx.Exec(ctx, `DELETE FROM tag_alias WHERE normalized_alias = $1`, tag.NormalizedName); err != nil {
+			return fmt.Errorf("failed to remove renamed alias: %w", err)
+		}
+		_, err := tx.Exec(ctx, `
+			INSERT INTO tag_alias (normalized_alias, tag_id, alias, language_code)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT (normalized_alias) DO NOTHING
+		`, previous.NormalizedName, tag.ID, previous.Name, previous.LanguageCode)
+		if err != nil {
+			return fmt.Errorf("failed to keep previous tag name as alias: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// insertTagAliases adds aliases to a tag, rejecting keys that are canonical tag names
+func insertTagAliases(ctx context.Context, tx pgx.Tx, tagID uuid.UUID, aliases []m.TagAlias) error {
+	for _, alias := range aliases {
+		var isTag bool
+		if err := tx.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM tag WHERE normalized_name = $1)`, alias.NormalizedAlias).Scan(&isTag); err != nil {
+			return fmt.Errorf("failed to check tag names: %w", err)
+		}
+		if isTag {
+			return fmt.Errorf("invalid aliases: %q is already a tag, merge the tags instead", alias.Alias)
+		}
+
+		_, err := tx.Exec(ctx, `
+			INSERT INTO tag_alias (normalized_alias, tag_id, alias, language_code)
+			VALUES ($1, $2, $3, $4)
+		`, alias.NormalizedAlias, tagID, alias.Alias, alias.LanguageCode)
+		if err != nil {
+			if strings.Contains(err.Error(), "duplicate key") {
+				return fmt.Errorf("tag alias already exists: %q", alias.Alias)
+			}
+			return fmt.Errorf("failed to add tag alias: %w", err)
+		}
+	}
+	return nil
+}
+
+// List retrieves tags, most used first; search matches canonical names and aliases
+func (r *tagRepository) List(ctx context.Context, limit, offset int, search string) ([]*m.Tag, int64, error) {
+	whereClause := ""
+	var searchArgs []interface{}
+	if search != "" {
+		whereClause = `WHERE t.normalized_name LIKE $1
+			OR EXISTS (SELECT 1 FROM tag_alias a WHERE a.tag_id = t.id AND a.normalized_alias LIKE $1)`
+		searchArgs = append(searchArgs, "%"+search+"%")
+	}
+
+	var total int64
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM tag t %s", whereClause)
+	if err := r.pool.QueryRow(ctx, countQuery, searchArgs...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count tags: %w", err)
+	}
+
+	args := append(searchArgs, limit, offset)
+	query := fmt.Sprintf(`
+		SELECT t.id, t.name, t.normalized_name, t.language_code, t.description, t.usage_count, t.created_at, t.updated_at
+		FROM tag t
+		%s
+		ORDER BY t.usage_count DESC, t.name ASC
+		LIMIT $%d OFFSET $%d
+	`, whereClause, len(searchArgs)+1, len(searchArgs)+2)
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list tags: %w", err)
+	}
+	defer rows.Close()
+
+	tags := make([]*m.Tag, 0)
+	for rows.Next() {
+		var tag m.Tag
+		if err := rows.Scan(
+			&tag.ID, &tag.Name, &tag.NormalizedName, &tag.LanguageCode, &tag.Description,
+			&tag.UsageCount, &tag.CreatedAt, &tag.UpdatedAt,
+		); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan tag: %w", err)
+		}
+		tags = append(tags, &tag)
+	}
+
+	if rows.Err() != nil {
+		return nil, 0, fmt.Errorf("failed to iterate tags: %w", rows.Err())
+	}
+
+	return tags, total, nil
+}
+
+// Autocomplete returns the tags whose canonical name or an alias starts with the normalized
+// prefix, most used first; a tag matched only through an alias reports that alias
+func (r *tagRepository) Autocomplete(ctx context.Context, prefix string, limit int) ([]d.TagSuggestion, error) {
+	pattern := likePrefixEscaper.Replace(prefix) + "%"
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT t.id, t.name, t.language_code, t.usage_count, matched.alias
+		FROM (
+			SELECT DISTINCT ON (tag_id) tag_id, alias
+			FROM (
+				SELECT id AS tag_id, NULL::text AS alias, 0 AS rank
+				FROM tag WHERE normalized_name LIKE $1
+				UNION ALL
+				SELECT tag_id, alias, 1 AS rank
+				FROM tag_alias WHERE normalized_alias LIKE $1
+			) candidates
+			ORDER BY tag_id, rank, alias
+		) matched
+		JOIN tag t ON t.id = matched.tag_id
+		ORDER BY t.usage_count DESC, t.name ASC
+		LIMIT $2
+	`, pattern, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to autocomplete tags: %w", err)
+	}
+	defer rows.Close()
+
+	suggestions := make([]d.TagSuggestion, 0)
+	for rows.Next() {
+		var id uuid.UUID
+		var suggestion d.TagSuggestion
+		if err := rows.Scan(&id, &suggestion.Name, &suggestion.LanguageCode, &suggestion.UsageCount, &suggestion.MatchedAlias); err != nil {
+			return nil, fmt.Errorf("failed to scan tag suggestion: %w", err)
+		}
+		suggestion.ID = id.String()
+		suggestion.DisplayName = suggestion.Name
+		suggestions = append(suggestions, suggestion)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate tag suggestions: %w", rows.Err())
+	}
+
+	return suggestions, nil
+}
+
+// likePrefixEscaper escapes LIKE wildcards so a typed prefix matches literally
+var likePrefixEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// GetAliases retrieves the aliases of several tags
+func (r *tagRepository) GetAliases(ctx context.Context, tagIDs []uuid.UUID) (map[uuid.UUID][]m.TagAlias, error) {
+	result := make(map[uuid.UUID][]m.TagAlias)
+	if len(tagIDs) == 0 {
+		return result, nil
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT tag_id, alias, normalized_alias, language_code, created_at
+		FROM tag_alias
+		WHERE tag_id = ANY($1)
+		ORDER BY language_code NULLS FIRST, alias
+	`, tagIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tag aliases: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var alias m.TagAlias
+		if err := rows.Scan(&alias.TagID, &alias.Alias, &alias.NormalizedAlias, &alias.LanguageCode, &alias.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan tag alias: %w", err)
+		}
+		result[alias.TagID] = append(result[alias.TagID], alias)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate tag aliases: %w", rows.Err())
+	}
+
+	return result, nil
+}
+
+// Resolve maps normalized names and aliases to the canonical tag name
+func (r *tagRepository) Resolve(ctx context.Context, normalizedNames []string) (map[string]string, error) {
+	return resolveTags(ctx, r.pool, normalizedNames)
+}
+
+// Register adds the unknown tags and resolves every requested tag in the same transaction
+func (r *tagRepository) Register(ctx context.Context, tags []m.Tag) (map[string]string, error) {
+	if len(tags) == 0 {
+		return map[string]string{}, nil
+	}
+
+	names := make([]string, 0, len(tags))
+	keys := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		names = append(names, tag.Name)
+		keys = append(keys, tag.NormalizedName)
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO tag (name, normalized_name)
+		SELECT c.name, c.normalized_name
+		FROM unnest($1::text[], $2::text[]) AS c(name, normalized_name)
+		WHERE NOT EXISTS (SELECT 1 FROM tag_alias a WHERE a.normalized_alias = c.normalized_name)
+		ON CONFLICT (normalized_name) DO NOTHING
+	`, names, keys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to register tags: %w", err)
+	}
+
+	resolved, err := resolveTags(ctx, tx, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return resolved, nil
+}
+
+// tagQuerier is satisfied by both the pool and a transaction
+type tagQuerier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+// resolveTags looks normalized keys up among canonical names first, then among aliases
+func resolveTags(ctx context.Context, q tagQuerier, normalizedNames []string) (map[string]string, error) {
+	result := make(map[string]string, len(normalizedNames))
+	if len(normalizedNames) == 0 {
+		return result, nil
+	}
+
+	rows, err := q.Query(ctx, `
+		SELECT normalized_name, name, 0 AS rank FROM tag WHERE normalized_name = ANY($1)
+		UNION ALL
+		SELECT a.normalized_alias, t.name, 1 AS rank
+		FROM tag_alias a
+		JOIN tag t ON t.id = a.tag_id
+		WHERE a.normalized_alias = ANY($1)
+		ORDER BY rank
+	`, normalizedNames)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve tags: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var key, name string
+		var rank int
+		if err := rows.Scan(&key, &name, &rank); err != nil {
+			return nil, fmt.Errorf("failed to scan resolved tag: %w", err)
+		}
+		if _, ok := result[key]; !ok {
+			result[key] = name
+		}
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate resolved tags: %w", rows.Err())
+	}
+
+	return result, nil
+}
+
+// RefreshUsageCounts recounts the non-deleted novels carrying each of the given canonical names
+func (r *tagRepository) RefreshUsageCounts(ctx context.Context, names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+
+	_, err := r.pool.Exec(ctx, `
+		UPDATE tag t
+		SET usage_count = (
+			SELECT COUNT(*) FROM novel n WHERE n.is_deleted = false AND n.tags ? t.name
+		)
+		WHERE t.name = ANY($1)
+	`, names)
+	if err != nil {
+		return fmt.Errorf("failed to refresh tag usage counts: %w", err)
+	}
+
+	return nil
+}
+
+// Merge folds a duplicate tag into the target: novels switch to the target name, the duplicate's
+// aliases and its own name become aliases of the target, then the duplicate is deleted
+func (r *tagRepository) Merge(ctx context.Context, sourceID, targetID uuid.UUID) (*d.TagMergeResponse, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Lock both tags in a stable order so concurrent merges cannot deadlock
+	rows, err := tx.Query(ctx, `
+		SELECT id, name, normalized_name, language_code
+		FROM tag WHERE id = ANY($1) ORDER BY id FOR UPDATE
+	`, []uuid.UUID{sourceID, targetID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock tags: %w", err)
+	}
+	locked := make(map[uuid.UUID]m.Tag, 2)
+	for rows.Next() {
+		var tag m.Tag
+		if err := rows.Scan(&tag.ID, &tag.Name, &tag.NormalizedName, &tag.LanguageCode); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan tag: %w", err)
+		}
+		locked[tag.ID] = tag
+	}
+	rows.Close()
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to lock tags: %w", rows.Err())
+	}
+	source, ok := locked[sourceID]
+	if !ok {
+		return nil, fmt.Errorf("tag not found")
+	}
+	target, ok := locked[targetID]
+	if !ok {
+		return nil, fmt.Errorf("target tag not found")
+	}
+
+	response := &d.TagMergeResponse{
+		MergedTagID: sourceID.String(),
+		TargetTagID: targetID.String(),
+	}
+
+	tag, err := tx.Exec(ctx, replaceNovelTagQuery, source.Name, target.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to move novel tags: %w", err)
+	}
+	response.Novels = tag.RowsAffected()
+
+	tag, err = tx.Exec(ctx, `UPDATE tag_alias SET tag_id = $2 WHERE tag_id = $1`, sourceID, targetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to move tag aliases: %w", err)
+	}
+	response.Aliases = tag.RowsAffected()
+
+	if _, err := tx.Exec(ctx, `DELETE FROM tag WHERE id = $1`, sourceID); err != nil {
+		return nil, fmt.Errorf("failed to delete merged tag: %w", err)
+	}
+
+	tag, err = tx.Exec(ctx, `
+		INSERT INTO tag_alias (normalized_alias, tag_id, alias, language_code)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (normalized_alias) DO NOTHING
+	`, source.NormalizedName, targetID, source.Name, source.LanguageCode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to keep merged tag name as alias: %w", err)
+	}
+	response.Aliases += tag.RowsAffected()
+
+	_, err = tx.Exec(ctx, `
+		UPDATE tag t
+		SET usage_count = (
+			SELECT COUNT(*) FROM novel n WHERE n.is_deleted = false AND n.tags ? t.name
+		), updated_at = CURRENT_TIMESTAMP
+		WHERE t.id = $1
+	`, targetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to refresh tag usage count: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return response, nil
+}
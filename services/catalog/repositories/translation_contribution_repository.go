@@ -0,0 +1,356 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+)
+
+// translationContributionColumns lists the translation_contributions columns read into m.TranslationContribution
+const translationContributionColumns = `
+	id, reference_type, reference_id, title, content, source_language, target_language,
+	COALESCE(is_machine_translation, FALSE), machine_provider,
+	user_id, tenant_id, status, rejection_reason, reviewer_id, reviewed_at,
+	COALESCE(upvotes, 0), COALESCE(downvotes, 0), glossary_violation_count,
+	COALESCE(created_at, CURRENT_TIMESTAMP), COALESCE(updated_at, CURRENT_TIMESTAMP)`
+
+// TranslationContributionRepository defines data access for community and machine-made translations
+type TranslationContributionRepository interface {
+	// Create stores a new pending contribution
+	Create(ctx context.Context, contribution *m.TranslationContribution) error
+	// GetByID retrieves a contribution by ID
+	GetByID(ctx context.Context, id uuid.UUID) (*m.TranslationContribution, error)
+	// UpdatePending replaces the translation of a pending contribution owned by its contributor
+	UpdatePending(ctx context.Context, contribution *m.TranslationContribution) error
+	// List retrieves a page of contributions without their content; userID restricts to one contributor
+	List(ctx context.Context, req d.ListTranslationContributionsRequest, userID *uuid.UUID) ([]m.TranslationContribution, *d.PaginationMeta, error)
+	// HasPendingMachineDraft reports whether the user already has a pending machine draft of the content in the language
+	HasPendingMachineDraft(ctx context.Context, referenceType string, referenceID, userID uuid.UUID, targetLanguage string) (bool, error)
+	// Approve publishes a pending contribution as the translation of its novel or chapter
+	Approve(ctx context.Context, id, reviewerID uuid.UUID) error
+	// Reject closes a pending contribution with a reason
+	Reject(ctx context.Context, id, reviewerID uuid.UUID, reason string) error
+}
+
+// translationContributionRepository implements TranslationContributionRepository interface
+type translationContributionRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewTranslationContributionRepository creates a new translation contribution repository instance
+func NewTranslationContributionRepository(pool *pgxpool.Pool) TranslationContributionRepository {
+	return &translationContributionRepository{pool: pool}
+}
+
+// Create inserts a pending contribution and fills its ID, status and timestamps
+func (r *translationContributionRepository) Create(ctx context.Context, contribution *m.TranslationContribution) error {
+	query := `
+		INSERT INTO translation_contributions (
+			reference_type, reference_id, title, content, source_language, target_language,
+			is_machine_translation, machine_provider, user_id, tenant_id, glossary_violation_count
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING id, status, created_at, updated_at`
+
+	err := r.pool.QueryRow(ctx, query,
+		contribution.ReferenceType, contribution.ReferenceID, contribution.Title, contribution.Content,
+		contribution.SourceLanguage, contribution.TargetLanguage,
+		contribution.IsMachineTranslation, contribution.MachineProvider, contribution.UserID, contribution.TenantID,
+		contribution.GlossaryViolationCount,
+	).Scan(&contribution.ID, &contribution.Status, &contribution.CreatedAt, &contribution.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create translation contribution: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a contribution by its ID
+func (r *translationContributionRepository) GetByID(ctx context.Context, id uuid.UUID) (*m.TranslationContribution, error) {
+	row := r.pool.QueryRow(ctx, `
+		SELECT `+translationContributionColumns+`
+		FROM translation_contributions
+		WHERE id = $1 AND COALESCE(is_deleted, FALSE) = FALSE`, id)
+
+	contribution, err := scanTranslationContribution(row)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("translation contribution not found")
+		}
+		return nil, fmt.Errorf("failed to get translation contribution: %w", err)
+	}
+
+	return contribution, nil
+}
+
+// UpdatePending overwrites the proposed translation while the contribution is still pending
+func (r *translationContributionRepository) UpdatePending(ctx context.Context, contribution *m.TranslationContribution) error {
+	query := `
+		UPDATE translation_contributions
+		SET title = $3, content = $4, glossary_violation_count = $5, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND user_id = $2 AND status = 'pending'
+		RETURNING updated_at`
+
+	err := r.pool.QueryRow(ctx, query,
+		contribution.ID, contribution.UserID, contribution.Title, contribution.Content, contribution.GlossaryViolationCount,
+	).Scan(&contribution.UpdatedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return fmt.Errorf("translation contribution already reviewed")
+		}
+		return fmt.Errorf("failed to update translation contribution: %w", err)
+	}
+
+	return nil
+}
+
+// List retrieves contributions without their content, oldest first for the review queue
+func (r *translationContributionRepository) List(ctx context.Context, req d.ListTranslationContributionsRequest, userID *uuid.UUID) ([]m.TranslationContribution, *d.PaginationMeta, error) {
+	// Set pagination defaults
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 20
+	}
+	if req.PageSize > 100 {
+		req.PageSize = 100
+	}
+
+	conditions := []string{"COALESCE(is_deleted, FALSE) = FALSE"}
+	args := []interface{}{}
+	argIndex := 1
+
+	if req.Status != "" {
+		conditions = append(conditions, fmt.Sprintf("status = $%d", argIndex))
+		args = append(args, req.Status)
+		argIndex++
+	}
+
+	if req.ReferenceType != "" {
+		conditions = append(conditions, fmt.Sprintf("reference_type = $%d", argIndex))
+		args = append(args, req.ReferenceType)
+		argIndex++
+	}
+
+	if req.Language != "" {
+		conditions = append(conditions, fmt.Sprintf("target_language = $%d", argIndex))
+		args = append(args, req.Language)
+		argIndex++
+	}
+
+	if userID != nil {
+		conditions = append(conditions, fmt.Sprintf("user_id = $%d", argIndex))
+		args = append(args, *userID)
+		argIndex++
+	}
+
+	whereClause := "WHERE " + strings.Join(conditions, " AND ")
+
+	var total int64
+	if err := r.pool.QueryRow(ctx, `SELECT COUNT(*) FROM translation_contributions `+whereClause, args...).Scan(&total); err != nil {
+		return nil, nil, fmt.Errorf("failed to count translation contributions: %w", err)
+	}
+
+	offset := (req.Page - 1) * req.PageSize
+	columns := strings.Replace(translationContributionColumns, "content,", "NULL::jsonb AS content,", 1)
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM translation_contributions
+		%s
+		ORDER BY created_at ASC
+		LIMIT $%d OFFSET $%d`, columns, whereClause, argIndex, argIndex+1)
+	args = append(args, req.PageSize, offset)
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list translation contributions: %w", err)
+	}
+	defer rows.Close()
+
+	contributions := make([]m.TranslationContribution, 0)
+	for rows.Next() {
+		contribution, err := scanTranslationContribution(rows)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to scan translation contribution: %w", err)
+		}
+		contributions = append(contributions, *contribution)
+	}
+
+	if rows.Err() != nil {
+		return nil, nil, fmt.Errorf("failed to iterate translation contributions: %w", rows.Err())
+	}
+
+	totalPages := int((total + int64(req.PageSize) - 1) / int64(req.PageSize))
+
+	return contributions, &d.PaginationMeta{
+		Page:        req.Page,
+		PageSize:    req.PageSize,
+		Total:       total,
+		TotalPages:  totalPages,
+		HasNext:     req.Page < totalPages,
+		HasPrevious: req.Page > 1,
+	}, nil
+}
+
+// HasPendingMachineDraft reports whether the user already has a pending machine draft of the content in the language
+func (r *translationContributionRepository) HasPendingMachineDraft(ctx context.Context, referenceType string, referenceID, userID uuid.UUID, targetLanguage string) (bool, error) {
+	var exists bool
+	err := r.pool.QueryRow(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM translation_contributions
+			WHERE reference_type = $1 AND reference_id = $2 AND user_id = $3 AND target_language = $4
+				AND status = 'pending' AND is_machine_translation = TRUE
+				AND COALESCE(is_deleted, FALSE) = FALSE
+		)`, referenceType, referenceID, userID, targetLanguage).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to check pending machine drafts: %w", err)
+	}
+	return exists, nil
+}
+
+// Approve publishes the proposal and marks the contribution approved, crediting the contributor:
+// a chapter contribution replaces the chapter translation of its language, a novel contribution
+// adds its title and summary to the novel's localized titles.
+func (r *translationContributionRepository) Approve(ctx context.Context, id, reviewerID uuid.UUID) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	row := tx.QueryRow(ctx, `
+		SELECT `+translationContributionColumns+`
+		FROM translation_contributions
+		WHERE id = $1 AND COALESCE(is_deleted, FALSE) = FALSE
+		FOR UPDATE`, id)
+	contribution, err := scanTranslationContribution(row)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return fmt.Errorf("translation contribution not found")
+		}
+		return fmt.Errorf("failed to get translation contribution: %w", err)
+	}
+
+	if contribution.Status != m.TranslationContributionPending {
+		return fmt.Errorf("translation contribution already reviewed")
+	}
+
+	switch contribution.ReferenceType {
+	case m.TranslationReferenceNovelChapter:
+		content := contribution.Content
+		wordCount, charCount, readingTime := calculateContentMetadata(&content)
+
+		var title *string
+		if strings.TrimSpace(contribution.Title) != "" {
+			title = &contribution.Title
+		}
+
+		_, err = tx.Exec(ctx, `
+			INSERT INTO novel_chapter_translation (
+				chapter_id, language_code, title, content,
+				word_count, character_count, reading_time_minutes,
+				translated_by_user_id, created_at, updated_at
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+			ON CONFLICT (chapter_id, language_code) DO UPDATE SET
+				title = EXCLUDED.title,
+				content = EXCLUDED.content,
+				word_count = EXCLUDED.word_count,
+				character_count = EXCLUDED.character_count,
+				reading_time_minutes = EXCLUDED.reading_time_minutes,
+				translated_by_user_id = EXCLUDED.translated_by_user_id,
+				updated_at = CURRENT_TIMESTAMP`,
+			contribution.ReferenceID, contribution.TargetLanguage, title, content,
+			wordCount, charCount, readingTime, contribution.UserID,
+		)
+		if err != nil {
+			if strings.Contains(err.Error(), "foreign key") {
+				return fmt.Errorf("chapter not found")
+			}
+			return fmt.Errorf("failed to publish chapter translation: %w", err)
+		}
+
+	case m.TranslationReferenceNovel:
+		_, err = tx.Exec(ctx, `
+			INSERT INTO novel_translation (novel_id, language_code, title, summary, is_primary, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, FALSE, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+			ON CONFLICT (novel_id, language_code, title) DO UPDATE SET
+				summary = EXCLUDED.summary,
+				updated_at = CURRENT_TIMESTAMP`,
+			contribution.ReferenceID, contribution.TargetLanguage, contribution.Title, contribution.Content,
+		)
+		if err != nil {
+			if strings.Contains(err.Error(), "foreign key") {
+				return fmt.Errorf("novel not found")
+			}
+			return fmt.Errorf("failed to publish novel translation: %w", err)
+		}
+
+	default:
+		return fmt.Errorf("invalid reference type: %s", contribution.ReferenceType)
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE translation_contributions
+		SET status = 'approved', reviewer_id = $2, reviewed_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1`, id, reviewerID)
+	if err != nil {
+		return fmt.Errorf("failed to approve translation contribution: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// Reject marks a pending contribution as rejected
+func (r *translationContributionRepository) Reject(ctx context.Context, id, reviewerID uuid.UUID, reason string) error {
+	tag, err := r.pool.Exec(ctx, `
+		UPDATE translation_contributions
+		SET status = 'rejected', rejection_reason = $3, reviewer_id = $2, reviewed_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND status = 'pending' AND COALESCE(is_deleted, FALSE) = FALSE`, id, reviewerID, reason)
+	if err != nil {
+		return fmt.Errorf("failed to reject translation contribution: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		var exists bool
+		err := r.pool.QueryRow(ctx, `
+			SELECT EXISTS(SELECT 1 FROM translation_contributions WHERE id = $1 AND COALESCE(is_deleted, FALSE) = FALSE)`, id).Scan(&exists)
+		if err != nil {
+			return fmt.Errorf("failed to check translation contribution: %w", err)
+		}
+		if !exists {
+			return fmt.Errorf("translation contribution not found")
+		}
+		return fmt.Errorf("translation contribution already reviewed")
+	}
+
+	return nil
+}
+
+// scanTranslationContribution scans a row selected with translationContributionColumns
+func scanTranslationContribution(row pgx.Row) (*m.TranslationContribution, error) {
+	var contribution m.TranslationContribution
+	err := row.Scan(
+		&contribution.ID, &contribution.ReferenceType, &contribution.ReferenceID,
+		&contribution.Title, &contribution.Content, &contribution.SourceLanguage, &contribution.TargetLanguage,
+		&contribution.IsMachineTranslation, &contribution.MachineProvider,
+		&contribution.UserID, &contribution.TenantID, &contribution.Status, &contribution.RejectionReason,
+		&contribution.ReviewerID, &contribution.ReviewedAt, &contribution.Upvotes, &contribution.Downvotes,
+		&contribution.GlossaryViolationCount, &contribution.CreatedAt, &contribution.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &contribution, nil
+}
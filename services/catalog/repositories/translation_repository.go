@@ -0,0 +1,383 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+)
+
+// TranslationRepository defines data access for localized novel metadata and translated chapters
+type TranslationRepository interface {
+	// GetNovelLanguage returns the original language of a novel
+	GetNovelLanguage(ctx context.Context, novelID uuid.UUID) (string, error)
+	// ListNovelTranslations lists the localized titles and summaries of a novel, primary titles first
+	ListNovelTranslations(ctx context.Context, novelID uuid.UUID) ([]m.NovelTranslation, error)
+	// SetNovelTranslations replaces all localized titles and summaries of a novel
+	SetNovelTranslations(ctx context.Context, novelID uuid.UUID, translations []d.NovelTranslationInput) error
+
+	// GetChapterLanguage returns the novel a chapter belongs to and the novel's original language
+	GetChapterLanguage(ctx context.Context, chapterID uuid.UUID) (uuid.UUID, string, error)
+	// ListChapterTranslations lists the translations of a chapter; content is loaded only when requested
+	ListChapterTranslations(ctx context.Context, chapterID uuid.UUID, includeContent bool) ([]m.NovelChapterTranslation, error)
+	// GetChapterTranslation retrieves the translation of a chapter in one language
+	GetChapterTranslation(ctx context.Context, chapterID uuid.UUID, languageCode string) (*m.NovelChapterTranslation, error)
+	// UpsertChapterTranslation creates or replaces the translation of a chapter in one language
+	UpsertChapterTranslation(ctx context.Context, chapterID uuid.UUID, languageCode string, req d.UpsertChapterTranslationRequest, userID uuid.UUID) (*m.NovelChapterTranslation, error)
+	// DeleteChapterTranslation removes the translation of a chapter in one language
+	DeleteChapterTranslation(ctx context.Context, chapterID uuid.UUID, languageCode string) error
+
+	// ListGlossary lists the fixed term translations of a novel in one target language
+	ListGlossary(ctx context.Context, novelID uuid.UUID, languageCode string) ([]m.NovelGlossaryTerm, error)
+	// SetGlossary replaces the fixed term translations of a novel in one target language
+	SetGlossary(ctx context.Context, novelID uuid.UUID, languageCode string, terms []d.GlossaryTermInput, userID uuid.UUID) error
+
+	// CanManageNovel checks ownership and falls back to the given collaborator permission
+	CanManageNovel(ctx context.Context, novelID, userID uuid.UUID, tenantID *uuid.UUID, permission string) (bool, error)
+}
+
+// translationRepository implements TranslationRepository interface
+type translationRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewTranslationRepository creates a new translation repository instance
+func NewTranslationRepository(pool *pgxpool.Pool) TranslationRepository {
+	return &translationRepository{pool: pool}
+}
+
+// chapterTranslationColumns lists the chapter translation columns in the order scanned by scanChapterTranslation
+const chapterTranslationColumns = `
+	chapter_id, language_code, title, content,
+	word_count, character_count, reading_time_minutes,
+	translated_by_user_id, created_at, updated_at`
+
+// scanChapterTranslation scans a row selected with chapterTranslationColumns
+func scanChapterTranslation(row pgx.Row) (*m.NovelChapterTranslation, error) {
+	var translation m.NovelChapterTranslation
+	err := row.Scan(
+		&translation.ChapterID, &translation.LanguageCode, &translation.Title, &translation.Content,
+		&translation.WordCount, &translation.CharacterCount, &translation.ReadingTimeMinutes,
+		&translation.TranslatedByUserID, &translation.CreatedAt, &translation.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &translation, nil
+}
+
+// GetNovelLanguage returns the original language of a novel
+func (r *translationRepository) GetNovelLanguage(ctx context.Context, novelID uuid.UUID) (string, error) {
+	var language string
+	err := r.pool.QueryRow(ctx, `
+		SELECT COALESCE(original_language, '')
+		FROM novel
+		WHERE id = $1 AND is_deleted = FALSE
+	`, novelID).Scan(&language)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return "", fmt.Errorf("novel not found")
+		}
+		return "", fmt.Errorf("failed to get novel language: %w", err)
+	}
+	return language, nil
+}
+
+// ListNovelTranslations lists the localized titles and summaries of a novel, primary titles first
+func (r *translationRepository) ListNovelTranslations(ctx context.Context, novelID uuid.UUID) ([]m.NovelTranslation, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT id, novel_id, language_code, title, summary, COALESCE(is_primary, FALSE),
+			COALESCE(created_at, CURRENT_TIMESTAMP), COALESCE(updated_at, CURRENT_TIMESTAMP)
+		FROM novel_translation
+		WHERE novel_id = $1
+		ORDER BY language_code, is_primary DESC, created_at
+	`, novelID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query novel translations: %w", err)
+	}
+	defer rows.Close()
+
+	translations := []m.NovelTranslation{}
+	for rows.Next() {
+		var translation m.NovelTranslation
+		err := rows.Scan(
+			&translation.ID, &translation.NovelID, &translation.LanguageCode, &translation.Title,
+			&translation.Summary, &translation.IsPrimary, &translation.CreatedAt, &translation.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan novel translation: %w", err)
+		}
+		translations = append(translations, translation)
+	}
+
+	return translations, rows.Err()
+}
+
+// SetNovelTranslations replaces all localized titles and summaries of a novel
+func (r *translationRepository) SetNovelTranslations(ctx context.Context, novelID uuid.UUID, translations []d.NovelTranslationInput) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var id uuid.UUID
+	err = tx.QueryRow(ctx, `SELECT id FROM novel WHERE id = $1 AND is_deleted = FALSE FOR UPDATE`, novelID).Scan(&id)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return fmt.Errorf("novel not found")
+		}
+		return fmt.Errorf("failed to lock novel: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM novel_translation WHERE novel_id = $1`, novelID); err != nil {
+		return fmt.Errorf("failed to clear novel translations: %w", err)
+	}
+
+	for _, translation := range translations {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO novel_translation (novel_id, language_code, title, summary, is_primary, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+		`, novelID, translation.LanguageCode, translation.Title, translation.Summary, translation.IsPrimary)
+		if err != nil {
+			if strings.Contains(err.Error(), "duplicate key") {
+				return fmt.Errorf("invalid translations: duplicate title or primary title for language %s", translation.LanguageCode)
+			}
+			return fmt.Errorf("failed to save novel translation: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetChapterLanguage returns the novel a chapter belongs to and the novel's original language
+func (r *translationRepository) GetChapterLanguage(ctx context.Context, chapterID uuid.UUID) (uuid.UUID, string, error) {
+	var novelID uuid.UUID
+	var language string
+	err := r.pool.QueryRow(ctx, `
+		SELECT n.id, COALESCE(n.original_language, '')
+		FROM novel_chapter nc
+		JOIN novel_volume nv ON nv.id = nc.volume_id
+		JOIN novel n ON n.id = nv.novel_id
+		WHERE nc.id = $1 AND nc.is_deleted = FALSE AND n.is_deleted = FALSE
+	`, chapterID).Scan(&novelID, &language)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return uuid.Nil, "", fmt.Errorf("chapter not found")
+		}
+		return uuid.Nil, "", fmt.Errorf("failed to get chapter language: %w", err)
+	}
+	return novelID, language, nil
+}
+
+// ListChapterTranslations lists the translations of a chapter; content is loaded only when requested
+func (r *translationRepository) ListChapterTranslations(ctx context.Context, chapterID uuid.UUID, includeContent bool) ([]m.NovelChapterTranslation, error) {
+	columns := chapterTranslationColumns
+	if !includeContent {
+		columns = strings.Replace(columns, "content,", "NULL::jsonb AS content,", 1)
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT `+columns+`
+		FROM novel_chapter_translation
+		WHERE chapter_id = $1
+		ORDER BY language_code
+	`, chapterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query chapter translations: %w", err)
+	}
+	defer rows.Close()
+
+	translations := []m.NovelChapterTranslation{}
+	for rows.Next() {
+		translation, err := scanChapterTranslation(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan chapter translation: %w", err)
+		}
+		translations = append(translations, *translation)
+	}
+
+	return translations, rows.Err()
+}
+
+// GetChapterTranslation retrieves the translation of a chapter in one language
+func (r *translationRepository) GetChapterTranslation(ctx context.Context, chapterID uuid.UUID, languageCode string) (*m.NovelChapterTranslation, error) {
+	translation, err := scanChapterTranslation(r.pool.QueryRow(ctx, `
+		SELECT `+chapterTranslationColumns+`
+		FROM novel_chapter_translation
+		WHERE chapter_id = $1 AND language_code = $2
+	`, chapterID, languageCode))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("chapter translation not found")
+		}
+		return nil, fmt.Errorf("failed to get chapter translation: %w", err)
+	}
+	return translation, nil
+}
+
+// UpsertChapterTranslation creates or replaces the translation of a chapter in one language
+func (r *translationRepository) UpsertChapterTranslation(ctx context.Context, chapterID uuid.UUID, languageCode string, req d.UpsertChapterTranslationRequest, userID uuid.UUID) (*m.NovelChapterTranslation, error) {
+	content := req.Content
+	wordCount, charCount, readingTime := calculateContentMetadata(&content)
+
+	translation, err := scanChapterTranslation(r.pool.QueryRow(ctx, `
+		INSERT INTO novel_chapter_translation (
+			chapter_id, language_code, title, content,
+			word_count, character_count, reading_time_minutes,
+			translated_by_user_id, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+		ON CONFLICT (chapter_id, language_code) DO UPDATE SET
+			title = EXCLUDED.title,
+			content = EXCLUDED.content,
+			word_count = EXCLUDED.word_count,
+			character_count = EXCLUDED.character_count,
+			reading_time_minutes = EXCLUDED.reading_time_minutes,
+			translated_by_user_id = EXCLUDED.translated_by_user_id,
+			updated_at = CURRENT_TIMESTAMP
+		RETURNING `+chapterTranslationColumns,
+		chapterID, languageCode, req.Title, content,
+		wordCount, charCount, readingTime, userID,
+	))
+	if err != nil {
+		if strings.Contains(err.Error(), "foreign key") {
+			return nil, fmt.Errorf("chapter not found")
+		}
+		return nil, fmt.Errorf("failed to save chapter translation: %w", err)
+	}
+	return translation, nil
+}
+
+// DeleteChapterTranslation removes the translation of a chapter in one language
+func (r *translationRepository) DeleteChapterTranslation(ctx context.Context, chapterID uuid.UUID, languageCode string) error {
+	tag, err := r.pool.Exec(ctx, `
+		DELETE FROM novel_chapter_translation
+		WHERE chapter_id = $1 AND language_code = $2
+	`, chapterID, languageCode)
+	if err != nil {
+		return fmt.Errorf("failed to delete chapter translation: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("chapter translation not found")
+	}
+	return nil
+}
+
+// ListGlossary lists the fixed term translations of a novel in one target language
+func (r *translationRepository) ListGlossary(ctx context.Context, novelID uuid.UUID, languageCode string) ([]m.NovelGlossaryTerm, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT id, novel_id, language_code, source_term, translation, notes, character_id, created_by_user_id,
+			COALESCE(created_at, CURRENT_TIMESTAMP), COALESCE(updated_at, CURRENT_TIMESTAMP)
+		FROM novel_glossary_terms
+		WHERE novel_id = $1 AND language_code = $2
+		ORDER BY lower(source_term)
+	`, novelID, languageCode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query glossary: %w", err)
+	}
+	defer rows.Close()
+
+	terms := []m.NovelGlossaryTerm{}
+	for rows.Next() {
+		var term m.NovelGlossaryTerm
+		err := rows.Scan(
+			&term.ID, &term.NovelID, &term.LanguageCode, &term.SourceTerm, &term.Translation,
+			&term.Notes, &term.CharacterID, &term.CreatedByUserID, &term.CreatedAt, &term.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan glossary term: %w", err)
+		}
+		terms = append(terms, term)
+	}
+
+	return terms, rows.Err()
+}
+
+// SetGlossary replaces the fixed term translations of a novel in one target language
+func (r *translationRepository) SetGlossary(ctx context.Context, novelID uuid.UUID, languageCode string, terms []d.GlossaryTermInput, userID uuid.UUID) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var id uuid.UUID
+	err = tx.QueryRow(ctx, `SELECT id FROM novel WHERE id = $1 AND is_deleted = FALSE FOR UPDATE`, novelID).Scan(&id)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return fmt.Errorf("novel not found")
+		}
+		return fmt.Errorf("failed to lock novel: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM novel_glossary_terms WHERE novel_id = $1 AND language_code = $2`, novelID, languageCode); err != nil {
+		return fmt.Errorf("failed to clear glossary: %w", err)
+	}
+
+	for _, term := range terms {
+		var characterID *uuid.UUID
+		if term.CharacterID != nil {
+			id, err := uuid.Parse(*term.CharacterID)
+			if err != nil {
+				return fmt.Errorf("invalid glossary: invalid character ID for term %q", term.SourceTerm)
+			}
+			characterID = &id
+		}
+
+		_, err := tx.Exec(ctx, `
+			INSERT INTO novel_glossary_terms (
+				novel_id, language_code, source_term, translation, notes, character_id,
+				created_by_user_id, created_at, updated_at
+			)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+		`, novelID, languageCode, term.SourceTerm, term.Translation, term.Notes, characterID, userID)
+		if err != nil {
+			if strings.Contains(err.Error(), "duplicate key") {
+				return fmt.Errorf("invalid glossary: duplicate term %q", term.SourceTerm)
+			}
+			if strings.Contains(err.Error(), "foreign key") {
+				return fmt.Errorf("invalid glossary: character not found for term %q", term.SourceTerm)
+			}
+			return fmt.Errorf("failed to save glossary term: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// CanManageNovel checks ownership (user for PERSONAL/COLLABORATIVE, tenant for TENANT)
+// and falls back to the collaborator permission.
+func (r *translationRepository) CanManageNovel(ctx context.Context, novelID, userID uuid.UUID, tenantID *uuid.UUID, permission string) (bool, error) {
+	query := `
+		SELECT
+			(n.ownership_type = 'TENANT' AND $3::uuid IS NOT NULL AND n.primary_owner_id = $3::uuid)
+			OR (n.ownership_type <> 'TENANT' AND n.primary_owner_id = $2)
+			OR has_collaborator_permission('NOVEL', n.id, $2, $4::collaborator_permission)
+		FROM novel n
+		WHERE n.id = $1 AND n.is_deleted = FALSE`
+
+	var allowed *bool
+	err := r.pool.QueryRow(ctx, query, novelID, userID, tenantID, permission).Scan(&allowed)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return false, fmt.Errorf("novel not found")
+		}
+		return false, fmt.Errorf("failed to check novel permission: %w", err)
+	}
+
+	return allowed != nil && *allowed, nil
+}
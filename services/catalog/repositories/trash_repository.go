@@ -0,0 +1,722 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	d "wibusystem/pkg/common/dto"
+)
+
+// TrashRepository defines data access for soft-deleted novels, volumes and chapters
+type TrashRepository interface {
+	// List returns the delete operations still in the trash, newest first. Without a user every
+	// item is listed; otherwise only novels the user or tenant owns or may delete as collaborator.
+	List(ctx context.Context, req d.ListTrashRequest, userID, tenantID *uuid.UUID) ([]d.TrashItem, *d.PaginationMeta, error)
+	// ResolveNovelID returns the novel a trashed item belongs to
+	ResolveNovelID(ctx context.Context, itemType string, id uuid.UUID) (uuid.UUID, error)
+	// CanManageDeletedNovel reports whether the user owns the novel or may delete it as collaborator;
+	// unlike the live checks it also matches novels in the trash
+	CanManageDeletedNovel(ctx context.Context, novelID, userID uuid.UUID, tenantID *uuid.UUID) (bool, error)
+
+	// RestoreNovel restores a novel with the volumes and chapters deleted in the same operation
+	RestoreNovel(ctx context.Context, id uuid.UUID) (*d.TrashRestoreResponse, error)
+	// RestoreVolume restores a volume with the chapters deleted in the same operation
+	RestoreVolume(ctx context.Context, id uuid.UUID) (*d.TrashRestoreResponse, error)
+	// RestoreChapter restores a single chapter
+	RestoreChapter(ctx context.Context, id uuid.UUID) (*d.TrashRestoreResponse, error)
+
+	// ListExpired returns up to limit trash items deleted before the cutoff, oldest first
+	ListExpired(ctx context.Context, cutoff time.Time, limit int) ([]d.TrashExpiredItem, error)
+	// PurgeItem hard-deletes an expired item and its children, or archives them read-only when
+	// anyone purchased or is renting the content; it reports whether the item was archived
+	PurgeItem(ctx context.Context, item d.TrashExpiredItem) (bool, error)
+
+	// ListArchived lists the archived chapters the user still owns
+	ListArchived(ctx context.Context, userID uuid.UUID, req d.ListArchivedRequest) ([]d.ArchivedChapter, *d.PaginationMeta, error)
+	// GetArchivedChapter retrieves an archived chapter and whether the user owns it
+	GetArchivedChapter(ctx context.Context, chapterID, userID uuid.UUID) (*d.ArchivedChapterContent, bool, error)
+}
+
+// trashRepository implements TrashRepository interface
+type trashRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewTrashRepository creates a new trash repository instance
+func NewTrashRepository(pool *pgxpool.Pool) TrashRepository {
+	return &trashRepository{pool: pool}
+}
+
+// trashItemsCTE collects the root of every delete operation still in the trash: a deleted novel,
+// a volume deleted on its own (its deletion ID differs from its novel's) and a chapter deleted on
+// its own. Children removed by the same operation are counted on their root instead of listed.
+const trashItemsCTE = `
+	WITH items AS (
+		SELECT 'novel' AS item_type, n.id, n.id AS novel_id, COALESCE(n.name, '') AS novel_name,
+			NULL::uuid AS volume_id, NULL::text AS title, NULL::int AS number,
+			COALESCE(n.deleted_at, n.updated_at) AS deleted_at, n.deletion_id, n.ownership_type, n.primary_owner_id
+		FROM novel n
+		WHERE n.is_deleted = TRUE AND n.archived_at IS NULL
+		UNION ALL
+		SELECT 'volume', v.id, n.id, COALESCE(n.name, ''),
+			v.id, v.volume_title, v.volume_number,
+			COALESCE(v.deleted_at, v.updated_at), v.deletion_id, n.ownership_type, n.primary_owner_id
+		FROM novel_volume v
+		JOIN novel n ON n.id = v.novel_id
+		WHERE v.is_deleted = TRUE AND v.archived_at IS NULL
+		  AND v.deletion_id IS DISTINCT FROM n.deletion_id
+		UNION ALL
+		SELECT 'chapter', c.id, n.id, COALESCE(n.name, ''),
+			v.id, c.title, c.chapter_number,
+			COALESCE(c.deleted_at, c.updated_at), c.deletion_id, n.ownership_type, n.primary_owner_id
+		FROM novel_chapter c
+		JOIN novel_volume v ON v.id = c.volume_id
+		JOIN novel n ON n.id = v.novel_id
+		WHERE c.is_deleted = TRUE AND c.archived_at IS NULL
+		  AND c.deletion_id IS DISTINCT FROM v.deletion_id
+	)
+`
+
+// List returns the trash items visible to the user with the children removed alongside each one
+func (r *trashRepository) List(ctx context.Context, req d.ListTrashRequest, userID, tenantID *uuid.UUID) ([]d.TrashItem, *d.PaginationMeta, error) {
+	// Set pagination defaults
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 20
+	}
+	if req.PageSize > 100 {
+		req.PageSize = 100
+	}
+
+	conditions := []string{}
+	args := []interface{}{}
+	argIndex := 1
+
+	if req.Type != "" {
+		conditions = append(conditions, fmt.Sprintf("i.item_type = $%d", argIndex))
+		args = append(args, req.Type)
+		argIndex++
+	}
+
+	if userID != nil {
+		conditions = append(conditions, fmt.Sprintf(`(
+			(i.ownership_type = 'TENANT' AND $%[2]d::uuid IS NOT NULL AND i.primary_owner_id = $%[2]d::uuid)
+			OR (i.ownership_type <> 'TENANT' AND i.primary_owner_id = $%[1]d)
+			OR has_collaborator_permission('NOVEL', i.novel_id, $%[1]d, 'DELETE'))`, argIndex, argIndex+1))
+		args = append(args, *userID, tenantID)
+		argIndex += 2
+	}
+
+	whereClause := ""
+	if len(conditions) > 0 {
+		whereClause = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	var total int64
+	if err := r.pool.QueryRow(ctx, trashItemsCTE+`SELECT COUNT(*) FROM items i `+whereClause, args...).Scan(&total); err != nil {
+		return nil, nil, fmt.Errorf("failed to count trash items: %w", err)
+	}
+
+	offset := (req.Page - 1) * req.PageSize
+	query := trashItemsCTE + fmt.Sprintf(`
+		SELECT i.item_type, i.id, i.novel_id, i.novel_name, i.volume_id, i.title, i.number, i.deleted_at,
+			(SELECT COUNT(*) FROM novel_volume v WHERE v.deletion_id = i.deletion_id AND v.id <> i.id),
+			(SELECT COUNT(*) FROM novel_chapter c WHERE c.deletion_id = i.deletion_id AND c.id <> i.id)
+		FROM items i
+		%s
+		ORDER BY i.deleted_at DESC, i.id
+		LIMIT $%d OFFSET $%d`, whereClause, argIndex, argIndex+1)
+	args = append(args, req.PageSize, offset)
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list trash items: %w", err)
+	}
+	defer rows.Close()
+
+	items := make([]d.TrashItem, 0)
+	for rows.Next() {
+		var item d.TrashItem
+		var id, novelID uuid.UUID
+		var volumeID *uuid.UUID
+		if err := rows.Scan(&item.ItemType, &id, &novelID, &item.NovelName, &volumeID, &item.Title, &item.Number,
+			&item.DeletedAt, &item.VolumeCount, &item.ChapterCount); err != nil {
+			return nil, nil, fmt.Errorf("failed to scan trash item: %w", err)
+		}
+		item.ID = id.String()
+		item.NovelID = novelID.String()
+		if volumeID != nil {
+			volume := volumeID.String()
+			item.VolumeID = &volume
+		}
+		items = append(items, item)
+	}
+
+	if rows.Err() != nil {
+		return nil, nil, fmt.Errorf("failed to iterate trash items: %w", rows.Err())
+	}
+
+	totalPages := int((total + int64(req.PageSize) - 1) / int64(req.PageSize))
+
+	return items, &d.PaginationMeta{
+		Page:        req.Page,
+		PageSize:    req.PageSize,
+		Total:       total,
+		TotalPages:  totalPages,
+		HasNext:     req.Page < totalPages,
+		HasPrevious: req.Page > 1,
+	}, nil
+}
+
+// ResolveNovelID looks the item up among trashed rows only, archived ones included
+func (r *trashRepository) ResolveNovelID(ctx context.Context, itemType string, id uuid.UUID) (uuid.UUID, error) {
+	var query string
+	switch itemType {
+	case d.TrashItemNovel:
+		query = `SELECT id FROM novel WHERE id = $1 AND is_deleted = TRUE`
+	case d.TrashItemVolume:
+		query = `SELECT novel_id FROM novel_volume WHERE id = $1 AND is_deleted = TRUE`
+	case d.TrashItemChapter:
+		query = `
+			SELECT v.novel_id
+			FROM novel_chapter c
+			JOIN novel_volume v ON v.id = c.volume_id
+			WHERE c.id = $1 AND c.is_deleted = TRUE`
+	default:
+		return uuid.Nil, fmt.Errorf("invalid trash item type: %s", itemType)
+	}
+
+	var novelID uuid.UUID
+	if err := r.pool.QueryRow(ctx, query, id).Scan(&novelID); err != nil {
+		if err == pgx.ErrNoRows {
+			return uuid.Nil, fmt.Errorf("%s not found in trash", itemType)
+		}
+		return uuid.Nil, fmt.Errorf("failed to look up %s in trash: %w", itemType, err)
+	}
+
+	return novelID, nil
+}
+
+// CanManageDeletedNovel applies the owner and collaborator rules without filtering deleted novels
+func (r *trashRepository) CanManageDeletedNovel(ctx context.Context, novelID, userID uuid.UUID, tenantID *uuid.UUID) (bool, error) {
+	query := `
+		SELECT
+			(n.ownership_type = 'TENANT' AND $3::uuid IS NOT NULL AND n.primary_owner_id = $3::uuid)
+			OR (n.ownership_type <> 'TENANT' AND n.primary_owner_id = $2)
+			OR has_collaborator_permission('NOVEL', n.id, $2, 'DELETE')
+		FROM novel n
+		WHERE n.id = $1`
+
+	var allowed *bool
+	err := r.pool.QueryRow(ctx, query, novelID, userID, tenantID).Scan(&allowed)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return false, fmt.Errorf("novel not found")
+		}
+		return false, fmt.Errorf("failed to check novel permission: %w", err)
+	}
+
+	return allowed != nil && *allowed, nil
+}
+
+// RestoreNovel clears the deletion on the novel and every row sharing its deletion ID.
+// Volumes and chapters deleted earlier on their own stay in the trash.
+func (r *trashRepository) RestoreNovel(ctx context.Context, id uuid.UUID) (*d.TrashRestoreResponse, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var deletionID *uuid.UUID
+	var archived bool
+	err = tx.QueryRow(ctx, `
+		SELECT deletion_id, archived_at IS NOT NULL
+		FROM novel
+		WHERE id = $1 AND is_deleted = TRUE
+		FOR UPDATE`, id).Scan(&deletionID, &archived)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("novel not found in trash")
+		}
+		return nil, fmt.Errorf("failed to look up novel in trash: %w", err)
+	}
+	if archived {
+		return nil, fmt.Errorf("cannot restore novel: archived content is read-only")
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE novel
+		SET is_deleted = FALSE, deleted_at = NULL, deleted_by_user_id = NULL, deletion_id = NULL,
+			updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1`, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore novel: %w", err)
+	}
+
+	result := &d.TrashRestoreResponse{ItemType: d.TrashItemNovel, ID: id.String(), NovelID: id.String()}
+	if deletionID != nil {
+		tag, err := tx.Exec(ctx, `
+			UPDATE novel_volume
+			SET is_deleted = FALSE, deleted_at = NULL, deletion_id = NULL, updated_at = CURRENT_TIMESTAMP
+			WHERE novel_id = $1 AND deletion_id = $2`, id, *deletionID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to restore novel volumes: %w", err)
+		}
+		result.RestoredVolumes = int(tag.RowsAffected())
+
+		tag, err = tx.Exec(ctx, `
+			UPDATE novel_chapter
+			SET is_deleted = FALSE, deleted_at = NULL, deletion_id = NULL, updated_at = CURRENT_TIMESTAMP
+			WHERE deletion_id = $2 AND volume_id IN (
+				SELECT id FROM novel_volume WHERE novel_id = $1
+			)`, id, *deletionID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to restore novel chapters: %w", err)
+		}
+		result.RestoredChapters = int(tag.RowsAffected())
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return result, nil
+}
+
+// RestoreVolume clears the deletion on the volume and the chapters sharing its deletion ID;
+// a volume of a novel that is itself in the trash comes back with the novel instead
+func (r *trashRepository) RestoreVolume(ctx context.Context, id uuid.UUID) (*d.TrashRestoreResponse, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var novelID uuid.UUID
+	var deletionID *uuid.UUID
+	var archived, novelDeleted bool
+	err = tx.QueryRow(ctx, `
+		SELECT v.novel_id, v.deletion_id, v.archived_at IS NOT NULL, COALESCE(n.is_deleted, FALSE)
+		FROM novel_volume v
+		JOIN novel n ON n.id = v.novel_id
+		WHERE v.id = $1 AND v.is_deleted = TRUE
+		FOR UPDATE OF v`, id).Scan(&novelID, &deletionID, &archived, &novelDeleted)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("volume not found in trash")
+		}
+		return nil, fmt.Errorf("failed to look up volume in trash: %w", err)
+	}
+	if archived {
+		return nil, fmt.Errorf("cannot restore volume: archived content is read-only")
+	}
+	if novelDeleted {
+		return nil, fmt.Errorf("cannot restore volume: novel is in trash")
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE novel_volume
+		SET is_deleted = FALSE, deleted_at = NULL, deletion_id = NULL, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1`, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore volume: %w", err)
+	}
+
+	result := &d.TrashRestoreResponse{ItemType: d.TrashItemVolume, ID: id.String(), NovelID: novelID.String()}
+	if deletionID != nil {
+		tag, err := tx.Exec(ctx, `
+			UPDATE novel_chapter
+			SET is_deleted = FALSE, deleted_at = NULL, deletion_id = NULL, updated_at = CURRENT_TIMESTAMP
+			WHERE volume_id = $1 AND deletion_id = $2`, id, *deletionID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to restore volume chapters: %w", err)
+		}
+		result.RestoredChapters = int(tag.RowsAffected())
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return result, nil
+}
+
+// RestoreChapter clears the deletion on one chapter; its volume must not be in the trash
+func (r *trashRepository) RestoreChapter(ctx context.Context, id uuid.UUID) (*d.TrashRestoreResponse, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var novelID uuid.UUID
+	var archived, volumeDeleted bool
+	err = tx.QueryRow(ctx, `
+		SELECT v.novel_id, c.archived_at IS NOT NULL, COALESCE(v.is_deleted, FALSE)
+		FROM novel_chapter c
+		JOIN novel_volume v ON v.id = c.volume_id
+		WHERE c.id = $1 AND c.is_deleted = TRUE
+		FOR UPDATE OF c`, id).Scan(&novelID, &archived, &volumeDeleted)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("chapter not found in trash")
+		}
+		return nil, fmt.Errorf("failed to look up chapter in trash: %w", err)
+	}
+	if archived {
+		return nil, fmt.Errorf("cannot restore chapter: archived content is read-only")
+	}
+	if volumeDeleted {
+		return nil, fmt.Errorf("cannot restore chapter: volume is in trash")
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE novel_chapter
+		SET is_deleted = FALSE, deleted_at = NULL, deletion_id = NULL, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1`, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore chapter: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return &d.TrashRestoreResponse{ItemType: d.TrashItemChapter, ID: id.String(), NovelID: novelID.String()}, nil
+}
+
+// ListExpired returns the oldest trash items whose retention ended
+func (r *trashRepository) ListExpired(ctx context.Context, cutoff time.Time, limit int) ([]d.TrashExpiredItem, error) {
+	rows, err := r.pool.Query(ctx, trashItemsCTE+`
+		SELECT i.item_type, i.id, i.novel_id
+		FROM items i
+		WHERE i.deleted_at < $1
+		ORDER BY i.deleted_at, i.id
+		LIMIT $2`, cutoff, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list expired trash items: %w", err)
+	}
+	defer rows.Close()
+
+	items := make([]d.TrashExpiredItem, 0)
+	for rows.Next() {
+		var item d.TrashExpiredItem
+		if err := rows.Scan(&item.ItemType, &item.ID, &item.NovelID); err != nil {
+			return nil, fmt.Errorf("failed to scan expired trash item: %w", err)
+		}
+		items = append(items, item)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate expired trash items: %w", rows.Err())
+	}
+
+	return items, nil
+}
+
+// trashScopeQueries select every volume and chapter under a trashed item, whether deleted with
+// it or earlier, since a hard delete cascades to all of them
+var trashScopeQueries = map[string]struct{ volumes, chapters string }{
+	d.TrashItemNovel: {
+		volumes:  `SELECT id FROM novel_volume WHERE novel_id = $1`,
+		chapters: `SELECT c.id FROM novel_chapter c JOIN novel_volume v ON v.id = c.volume_id WHERE v.novel_id = $1`,
+	},
+	d.TrashItemVolume: {
+		volumes:  `SELECT id FROM novel_volume WHERE id = $1`,
+		chapters: `SELECT id FROM novel_chapter WHERE volume_id = $1`,
+	},
+	d.TrashItemChapter: {
+		volumes:  `SELECT volume_id FROM novel_chapter WHERE id = $1`,
+		chapters: `SELECT id FROM novel_chapter WHERE id = $1`,
+	},
+}
+
+// PurgeItem runs in one transaction. Entitlement covers the item, everything under it and, for
+// volumes and chapters, purchases of the enclosing volume or series: a series buyer still owns a
+// chapter the author deleted. Entitled content is archived with the rows of its delete operation;
+// otherwise the rows are hard-deleted and the tables without foreign keys are cleaned up.
+func (r *trashRepository) PurgeItem(ctx context.Context, item d.TrashExpiredItem) (bool, error) {
+	scope, ok := trashScopeQueries[item.ItemType]
+	if !ok {
+		return false, fmt.Errorf("invalid trash item type: %s", item.ItemType)
+	}
+	table := map[string]string{
+		d.TrashItemNovel:   "novel",
+		d.TrashItemVolume:  "novel_volume",
+		d.TrashItemChapter: "novel_chapter",
+	}[item.ItemType]
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var deletionID *uuid.UUID
+	err = tx.QueryRow(ctx, `
+		SELECT deletion_id FROM `+table+`
+		WHERE id = $1 AND is_deleted = TRUE AND archived_at IS NULL
+		FOR UPDATE`, item.ID).Scan(&deletionID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return false, fmt.Errorf("%s not found in trash", item.ItemType)
+		}
+		return false, fmt.Errorf("failed to look up %s in trash: %w", item.ItemType, err)
+	}
+
+	volumeIDs, err := collectTrashScope(ctx, tx, scope.volumes, item.ID)
+	if err != nil {
+		return false, err
+	}
+	chapterIDs, err := collectTrashScope(ctx, tx, scope.chapters, item.ID)
+	if err != nil {
+		return false, err
+	}
+
+	var entitled bool
+	err = tx.QueryRow(ctx, `
+		SELECT EXISTS(
+			SELECT 1 FROM user_content_purchases ucp
+			WHERE (ucp.item_type = 'NOVEL_SERIES' AND ucp.item_id = $1)
+			   OR (ucp.item_type = 'NOVEL_VOLUME' AND ucp.item_id = ANY($2))
+			   OR (ucp.item_type = 'NOVEL_CHAPTER' AND ucp.item_id = ANY($3))
+			UNION ALL
+			SELECT 1 FROM user_content_rentals ucr
+			WHERE ucr.expiry_date > CURRENT_TIMESTAMP
+			  AND ((ucr.item_type = 'NOVEL_SERIES' AND ucr.item_id = $1)
+				OR (ucr.item_type = 'NOVEL_VOLUME' AND ucr.item_id = ANY($2)))
+		)`, item.NovelID, volumeIDs, chapterIDs).Scan(&entitled)
+	if err != nil {
+		return false, fmt.Errorf("failed to check purchases: %w", err)
+	}
+
+	if entitled {
+		if err := archiveTrashItem(ctx, tx, table, item.ID, deletionID); err != nil {
+			return false, err
+		}
+	} else {
+		if err := hardDeleteTrashItem(ctx, tx, table, item, volumeIDs, chapterIDs); err != nil {
+			return false, err
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return entitled, nil
+}
+
+// collectTrashScope runs one of the trashScopeQueries
+func collectTrashScope(ctx context.Context, tx pgx.Tx, query string, id uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := tx.Query(ctx, query, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect purge scope: %w", err)
+	}
+	defer rows.Close()
+
+	ids := make([]uuid.UUID, 0)
+	for rows.Next() {
+		var scopeID uuid.UUID
+		if err := rows.Scan(&scopeID); err != nil {
+			return nil, fmt.Errorf("failed to scan purge scope: %w", err)
+		}
+		ids = append(ids, scopeID)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate purge scope: %w", rows.Err())
+	}
+
+	return ids, nil
+}
+
+// archiveTrashItem marks the item and the rows deleted with it as read-only archive
+func archiveTrashItem(ctx context.Context, tx pgx.Tx, table string, id uuid.UUID, deletionID *uuid.UUID) error {
+	if _, err := tx.Exec(ctx, `UPDATE `+table+` SET archived_at = CURRENT_TIMESTAMP WHERE id = $1`, id); err != nil {
+		return fmt.Errorf("failed to archive content: %w", err)
+	}
+	if deletionID == nil {
+		return nil
+	}
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE novel_volume SET archived_at = CURRENT_TIMESTAMP
+		WHERE deletion_id = $1 AND archived_at IS NULL`, *deletionID); err != nil {
+		return fmt.Errorf("failed to archive volumes: %w", err)
+	}
+	if _, err := tx.Exec(ctx, `
+		UPDATE novel_chapter SET archived_at = CURRENT_TIMESTAMP
+		WHERE deletion_id = $1 AND archived_at IS NULL`, *deletionID); err != nil {
+		return fmt.Errorf("failed to archive chapters: %w", err)
+	}
+
+	return nil
+}
+
+// hardDeleteTrashItem removes the rows that reference the content without a foreign key, then
+// deletes the item and lets the foreign keys cascade to its children and their side tables
+func hardDeleteTrashItem(ctx context.Context, tx pgx.Tx, table string, item d.TrashExpiredItem, volumeIDs, chapterIDs []uuid.UUID) error {
+	novelScope := []uuid.UUID{}
+	if item.ItemType == d.TrashItemNovel {
+		novelScope = append(novelScope, item.ID)
+	}
+	if item.ItemType == d.TrashItemChapter {
+		// The enclosing volume stays, only the chapter goes
+		volumeIDs = []uuid.UUID{}
+	}
+
+	cleanups := []struct {
+		query string
+		args  []interface{}
+	}{
+		{`DELETE FROM media_attachments
+			WHERE (kind = 'novel_cover' AND entity_id = ANY($1))
+			   OR (kind = 'volume_cover' AND entity_id = ANY($2))`, []interface{}{novelScope, volumeIDs}},
+		{`DELETE FROM content_collaborators
+			WHERE (content_type = 'NOVEL' AND content_id = ANY($1))
+			   OR (content_type = 'VOLUME' AND content_id = ANY($2))
+			   OR (content_type = 'CHAPTER' AND content_id = ANY($3))`, []interface{}{novelScope, volumeIDs, chapterIDs}},
+		{`DELETE FROM content_relation
+			WHERE (source_type = 'NOVEL' AND source_id = ANY($1))
+			   OR (target_type = 'NOVEL' AND target_id = ANY($1))`, []interface{}{novelScope}},
+	}
+	for _, cleanup := range cleanups {
+		if _, err := tx.Exec(ctx, cleanup.query, cleanup.args...); err != nil {
+			return fmt.Errorf("failed to clean up purged content: %w", err)
+		}
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM `+table+` WHERE id = $1`, item.ID); err != nil {
+		return fmt.Errorf("failed to purge %s: %w", item.ItemType, err)
+	}
+
+	return nil
+}
+
+// archivedChapterEntitlement matches purchases of the chapter, its volume or its series and
+// unexpired rentals of the volume or series by the user in $1
+const archivedChapterEntitlement = `
+	EXISTS(
+		SELECT 1 FROM user_content_purchases ucp
+		WHERE ucp.user_id = $1
+		  AND ((ucp.item_type = 'NOVEL_CHAPTER' AND ucp.item_id = c.id)
+			OR (ucp.item_type = 'NOVEL_VOLUME' AND ucp.item_id = v.id)
+			OR (ucp.item_type = 'NOVEL_SERIES' AND ucp.item_id = n.id))
+		UNION ALL
+		SELECT 1 FROM user_content_rentals ucr
+		WHERE ucr.user_id = $1
+		  AND ucr.expiry_date > CURRENT_TIMESTAMP
+		  AND ((ucr.item_type = 'NOVEL_VOLUME' AND ucr.item_id = v.id)
+			OR (ucr.item_type = 'NOVEL_SERIES' AND ucr.item_id = n.id))
+	)`
+
+// archivedChapterColumns lists the columns scanned by scanArchivedChapter
+const archivedChapterColumns = `c.id, c.chapter_number, c.title, v.id, v.volume_number, v.volume_title,
+	n.id, COALESCE(n.name, ''), c.archived_at`
+
+// ListArchived lists the archived chapters owned by the user in reading order
+func (r *trashRepository) ListArchived(ctx context.Context, userID uuid.UUID, req d.ListArchivedRequest) ([]d.ArchivedChapter, *d.PaginationMeta, error) {
+	// Set pagination defaults
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 20
+	}
+	if req.PageSize > 100 {
+		req.PageSize = 100
+	}
+
+	fromClause := `
+		FROM novel_chapter c
+		JOIN novel_volume v ON v.id = c.volume_id
+		JOIN novel n ON n.id = v.novel_id
+		WHERE c.archived_at IS NOT NULL AND ` + archivedChapterEntitlement
+
+	var total int64
+	if err := r.pool.QueryRow(ctx, `SELECT COUNT(*) `+fromClause, userID).Scan(&total); err != nil {
+		return nil, nil, fmt.Errorf("failed to count archived chapters: %w", err)
+	}
+
+	offset := (req.Page - 1) * req.PageSize
+	rows, err := r.pool.Query(ctx, `SELECT `+archivedChapterColumns+fromClause+`
+		ORDER BY n.name, n.id, v.volume_number, c.chapter_number
+		LIMIT $2 OFFSET $3`, userID, req.PageSize, offset)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list archived chapters: %w", err)
+	}
+	defer rows.Close()
+
+	chapters := make([]d.ArchivedChapter, 0)
+	for rows.Next() {
+		var chapter d.ArchivedChapter
+		if err := scanArchivedChapter(rows, &chapter); err != nil {
+			return nil, nil, fmt.Errorf("failed to scan archived chapter: %w", err)
+		}
+		chapters = append(chapters, chapter)
+	}
+
+	if rows.Err() != nil {
+		return nil, nil, fmt.Errorf("failed to iterate archived chapters: %w", rows.Err())
+	}
+
+	totalPages := int((total + int64(req.PageSize) - 1) / int64(req.PageSize))
+
+	return chapters, &d.PaginationMeta{
+		Page:        req.Page,
+		PageSize:    req.PageSize,
+		Total:       total,
+		TotalPages:  totalPages,
+		HasNext:     req.Page < totalPages,
+		HasPrevious: req.Page > 1,
+	}, nil
+}
+
+// GetArchivedChapter loads an archived chapter with its content and the user's entitlement
+func (r *trashRepository) GetArchivedChapter(ctx context.Context, chapterID, userID uuid.UUID) (*d.ArchivedChapterContent, bool, error) {
+	row := r.pool.QueryRow(ctx, `
+		SELECT `+archivedChapterColumns+`, c.content, `+archivedChapterEntitlement+`
+		FROM novel_chapter c
+		JOIN novel_volume v ON v.id = c.volume_id
+		JOIN novel n ON n.id = v.novel_id
+		WHERE c.id = $2 AND c.archived_at IS NOT NULL`, userID, chapterID)
+
+	var chapter d.ArchivedChapterContent
+	var entitled bool
+	if err := scanArchivedChapter(row, &chapter.ArchivedChapter, &chapter.Content, &entitled); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, false, fmt.Errorf("archived chapter not found")
+		}
+		return nil, false, fmt.Errorf("failed to get archived chapter: %w", err)
+	}
+
+	return &chapter, entitled, nil
+}
+
+// scanArchivedChapter scans archivedChapterColumns followed by any extra destinations
+func scanArchivedChapter(row pgx.Row, chapter *d.ArchivedChapter, extra ...interface{}) error {
+	var chapterID, volumeID, novelID uuid.UUID
+	dest := []interface{}{
+		&chapterID, &chapter.ChapterNumber, &chapter.Title,
+		&volumeID, &chapter.VolumeNumber, &chapter.VolumeTitle,
+		&novelID, &chapter.NovelName, &chapter.ArchivedAt,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return err
+	}
+
+	chapter.ChapterID = chapterID.String()
+	chapter.VolumeID = volumeID.String()
+	chapter.NovelID = novelID.String()
+	return nil
+}
@@ -36,7 +36,8 @@ type VolumeRepository interface {
 
 	// DeleteVolume performs soft delete on a volume
 	// Sets is_deleted=true and records deletion timestamp
-	DeleteVolume(ctx context.Context, id uuid.UUID) error
+	// Returns the parent novel ID so its counters can be reconciled
+	DeleteVolume(ctx context.Context, id uuid.UUID) (uuid.UUID, error)
 
 	// CheckVolumePurchases checks if any users have purchased content from this volume
 	// Used to prevent deletion of volumes that users have paid for
@@ -382,56 +383,59 @@ func (r *volumeRepository) CheckVolumePurchases(ctx context.Context, volumeID uu
 // DeleteVolume performs soft delete on a volume
 // This operation also soft-deletes all chapters within the volume
 // Fails if any users have purchased content from this volume
-func (r *volumeRepository) DeleteVolume(ctx context.Context, id uuid.UUID) error {
+func (r *volumeRepository) DeleteVolume(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
 	tx, err := r.pool.Begin(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to begin transaction: %w", err)
+		return uuid.Nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
 	defer tx.Rollback(ctx)
 
 	// Check if volume exists and is not already deleted
-	var exists bool
-	err = tx.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM novel_volume WHERE id = $1 AND is_deleted = FALSE)", id).Scan(&exists)
+	var novelID uuid.UUID
+	err = tx.QueryRow(ctx, "SELECT novel_id FROM novel_volume WHERE id = $1 AND is_deleted = FALSE", id).Scan(&novelID)
 	if err != nil {
-		return fmt.Errorf("failed to check volume existence: %w", err)
-	}
-	if !exists {
-		return fmt.Errorf("volume not found or already deleted")
+		if err == pgx.ErrNoRows {
+			return uuid.Nil, fmt.Errorf("volume not found or already deleted")
+		}
+		return uuid.Nil, fmt.Errorf("failed to check volume existence: %w", err)
 	}
 
 	// Check if any users have purchased content from this volume
 	hasPurchases, err := r.CheckVolumePurchases(ctx, id)
 	if err != nil {
-		return fmt.Errorf("failed to check purchases: %w", err)
+		return uuid.Nil, fmt.Errorf("failed to check purchases: %w", err)
 	}
 	if hasPurchases {
-		return fmt.Errorf("cannot delete volume: users have purchased content from this volume")
+		return uuid.Nil, fmt.Errorf("cannot delete volume: users have purchased content from this volume")
 	}
 
-	// Perform soft delete on the volume
+	// Perform soft delete on the volume; its chapters share the deletion ID so the
+	// trash can restore them together
 	now := time.Now()
-	_, err = tx.Exec(ctx, `
+	var deletionID uuid.UUID
+	err = tx.QueryRow(ctx, `
 		UPDATE novel_volume
-		SET is_deleted = TRUE, deleted_at = $2, updated_at = $2
+		SET is_deleted = TRUE, deleted_at = $2, updated_at = $2, deletion_id = uuidv7()
 		WHERE id = $1
-	`, id, now)
+		RETURNING deletion_id
+	`, id, now).Scan(&deletionID)
 	if err != nil {
-		return fmt.Errorf("failed to delete volume: %w", err)
+		return uuid.Nil, fmt.Errorf("failed to delete volume: %w", err)
 	}
 
 	// Also soft delete related chapters
 	_, err = tx.Exec(ctx, `
 		UPDATE novel_chapter
-		SET is_deleted = TRUE, deleted_at = $2
+		SET is_deleted = TRUE, deleted_at = $2, deletion_id = $3
 		WHERE volume_id = $1 AND is_deleted = FALSE
-	`, id, now)
+	`, id, now, deletionID)
 	if err != nil {
-		return fmt.Errorf("failed to delete volume chapters: %w", err)
+		return uuid.Nil, fmt.Errorf("failed to delete volume chapters: %w", err)
 	}
 
 	if err = tx.Commit(ctx); err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
+		return uuid.Nil, fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
-	return nil
+	return novelID, nil
 }
\ No newline at end of file
@@ -0,0 +1,93 @@
+package v1
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"wibusystem/pkg/common/auth"
+	"wibusystem/services/catalog/handlers"
+	"wibusystem/services/catalog/middleware"
+)
+
+// SetupAnimeRoutes registers anime series, season, episode and subtitle endpoints.
+// Ownership and collaborator permissions are checked by the services.
+func SetupAnimeRoutes(router *gin.RouterGroup, h *handlers.Handlers, m *middleware.Manager) {
+	// Public anime endpoints (no authentication required); a token, when sent, applies the
+	// viewer's age and content filters
+	animePublic := router.Group("/anime")
+	animePublic.Use(m.SetupOptionalAuthMiddleware()...)
+	animePublic.GET("", h.Anime.ListAnime)                                                            // GET /api/v1/anime
+	animePublic.GET("/:anime_id", h.Anime.GetAnimeByID)                                               // GET /api/v1/anime/:anime_id
+	animePublic.GET("/:anime_id/seasons", h.AnimeEpisode.ListSeasons)                                 // GET /api/v1/anime/:anime_id/seasons
+	animePublic.GET("/seasons/:season_id/episodes", h.AnimeEpisode.ListPublishedEpisodes)             // GET /api/v1/anime/seasons/:season_id/episodes
+	animePublic.GET("/episodes/:episode_id", h.AnimeEpisode.GetEpisode)                               // GET /api/v1/anime/episodes/:episode_id
+	animePublic.GET("/episodes/:episode_id/subtitles/:language_code", h.AnimeEpisode.GetSubtitleFile) // GET /api/v1/anime/episodes/:episode_id/subtitles/:language_code (WebVTT)
+
+	// Streaming requires an account; paid episodes also require a purchase or rental
+	animeStream := router.Group("/anime")
+	animeStream.Use(m.SetupScopedAPIMiddleware(string(auth.PermContentStreamAnime))...)
+	animeStream.GET("/episodes/:episode_id/stream", h.AnimeEpisode.GetEpisodeStream) // GET /api/v1/anime/episodes/:episode_id/stream
+
+	// Anime management
+	animeCreate := router.Group("/anime")
+	animeCreate.Use(m.SetupScopedAPIMiddleware(string(auth.PermContentCreateAnime))...)
+	animeCreate.POST("", h.Anime.CreateAnime) // POST /api/v1/anime
+
+	animeUpdate := router.Group("/anime")
+	animeUpdate.Use(m.SetupScopedAPIMiddleware(string(auth.PermContentUpdateAnime))...)
+	animeUpdate.PUT("/:anime_id", h.Anime.UpdateAnime)                  // PUT /api/v1/anime/:anime_id
+	animeUpdate.PUT("/:anime_id/translations", h.Anime.SetTranslations) // PUT /api/v1/anime/:anime_id/translations
+	animeUpdate.PUT("/:anime_id/cast", h.Anime.SetCast)                 // PUT /api/v1/anime/:anime_id/cast
+
+	animeDelete := router.Group("/anime")
+	animeDelete.Use(m.SetupScopedAPIMiddleware(string(auth.PermContentDeleteAnime))...)
+	animeDelete.DELETE("/:anime_id", h.Anime.DeleteAnime) // DELETE /api/v1/anime/:anime_id
+
+	// Season management
+	seasonCreate := router.Group("/anime")
+	seasonCreate.Use(m.SetupScopedAPIMiddleware(string(auth.PermAnimeSeasonCreate))...)
+	seasonCreate.POST("/:anime_id/seasons", h.AnimeEpisode.CreateSeason) // POST /api/v1/anime/:anime_id/seasons
+
+	seasonUpdate := router.Group("/anime")
+	seasonUpdate.Use(m.SetupScopedAPIMiddleware(string(auth.PermAnimeSeasonUpdate))...)
+	seasonUpdate.PUT("/seasons/:season_id", h.AnimeEpisode.UpdateSeason) // PUT /api/v1/anime/seasons/:season_id
+
+	seasonDelete := router.Group("/anime")
+	seasonDelete.Use(m.SetupScopedAPIMiddleware(string(auth.PermAnimeSeasonDelete))...)
+	seasonDelete.DELETE("/seasons/:season_id", h.AnimeEpisode.DeleteSeason) // DELETE /api/v1/anime/seasons/:season_id
+
+	// Episode management
+	episodeCreate := router.Group("/anime")
+	episodeCreate.Use(m.SetupScopedAPIMiddleware(string(auth.PermAnimeEpisodeCreate))...)
+	episodeCreate.POST("/seasons/:season_id/episodes", h.AnimeEpisode.CreateEpisode) // POST /api/v1/anime/seasons/:season_id/episodes
+
+	episodeUpdate := router.Group("/anime")
+	episodeUpdate.Use(m.SetupScopedAPIMiddleware(string(auth.PermAnimeEpisodeUpdate))...)
+	episodeUpdate.GET("/seasons/:season_id/episodes/all", h.AnimeEpisode.ListAllEpisodes)                    // GET /api/v1/anime/seasons/:season_id/episodes/all (incl. drafts)
+	episodeUpdate.PUT("/episodes/:episode_id", h.AnimeEpisode.UpdateEpisode)                                 // PUT /api/v1/anime/episodes/:episode_id
+	episodeUpdate.POST("/episodes/:episode_id/publish", h.AnimeEpisode.PublishEpisode)                       // POST /api/v1/anime/episodes/:episode_id/publish
+	episodeUpdate.POST("/episodes/:episode_id/unpublish", h.AnimeEpisode.UnpublishEpisode)                   // POST /api/v1/anime/episodes/:episode_id/unpublish
+	episodeUpdate.PUT("/episodes/:episode_id/subtitles/:language_code", h.AnimeEpisode.SetSubtitle)          // PUT /api/v1/anime/episodes/:episode_id/subtitles/:language_code
+	episodeUpdate.DELETE("/episodes/:episode_id/subtitles/:language_code", h.AnimeEpisode.DeleteSubtitle)    // DELETE /api/v1/anime/episodes/:episode_id/subtitles/:language_code
+	episodeUpdate.POST("/episodes/:episode_id/subtitles/:language_code/shift", h.AnimeEpisode.ShiftSubtitle) // POST /api/v1/anime/episodes/:episode_id/subtitles/:language_code/shift
+
+	episodeDelete := router.Group("/anime")
+	episodeDelete.Use(m.SetupScopedAPIMiddleware(string(auth.PermAnimeEpisodeDelete))...)
+	episodeDelete.DELETE("/episodes/:episode_id", h.AnimeEpisode.DeleteEpisode) // DELETE /api/v1/anime/episodes/:episode_id
+
+	// Community subtitles: contributors submit, the anime's owners and collaborators review
+	subtitleContribute := router.Group("/anime")
+	subtitleContribute.Use(m.SetupScopedAPIMiddleware(string(auth.PermSubtitleContribute))...)
+	subtitleContribute.POST("/episodes/:episode_id/subtitles/contributions", h.SubtitleContribution.CreateContribution) // POST /api/v1/anime/episodes/:episode_id/subtitles/contributions
+	subtitleContribute.PUT("/subtitles/contributions/:contribution_id", h.SubtitleContribution.UpdateContribution)      // PUT /api/v1/anime/subtitles/contributions/:contribution_id
+
+	subtitleProtected := router.Group("/anime")
+	subtitleProtected.Use(m.SetupProtectedAPIMiddleware()...)
+	subtitleProtected.GET("/subtitles/contributions/mine", h.SubtitleContribution.ListMyContributions)         // GET /api/v1/anime/subtitles/contributions/mine
+	subtitleProtected.GET("/subtitles/contributions/:contribution_id", h.SubtitleContribution.GetContribution) // GET /api/v1/anime/subtitles/contributions/:contribution_id
+
+	subtitleReview := router.Group("/anime")
+	subtitleReview.Use(m.SetupScopedAPIMiddleware(string(auth.PermAnimeEpisodeUpdate))...)
+	subtitleReview.GET("/:anime_id/subtitles/contributions", h.SubtitleContribution.ListContributions)                   // GET /api/v1/anime/:anime_id/subtitles/contributions
+	subtitleReview.POST("/subtitles/contributions/:contribution_id/approve", h.SubtitleContribution.ApproveContribution) // POST /api/v1/anime/subtitles/contributions/:contribution_id/approve
+	subtitleReview.POST("/subtitles/contributions/:contribution_id/reject", h.SubtitleContribution.RejectContribution)   // POST /api/v1/anime/subtitles/contributions/:contribution_id/reject
+}
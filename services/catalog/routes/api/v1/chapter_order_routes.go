@@ -0,0 +1,28 @@
+package v1
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"wibusystem/pkg/common/auth"
+	"wibusystem/services/catalog/handlers"
+	"wibusystem/services/catalog/middleware"
+)
+
+// SetupChapterOrderRoutes registers chapter reordering and volume split/merge endpoints
+func SetupChapterOrderRoutes(router *gin.RouterGroup, h *handlers.Handlers, m *middleware.Manager) {
+	// Chapter order within and across volumes - owners, MANAGE_CHAPTERS collaborators and admins
+	volumeChapters := router.Group("/volumes/:volume_id/chapters")
+	volumeChapters.Use(m.SetupScopedAPIMiddleware(string(auth.PermNovelChapterUpdate))...)
+	{
+		volumeChapters.PUT("/order", h.ChapterOrder.ReorderChapters) // PUT /api/v1/volumes/:volume_id/chapters/order
+		volumeChapters.POST("/move", h.ChapterOrder.MoveChapters)    // POST /api/v1/volumes/:volume_id/chapters/move
+	}
+
+	// Volume split and merge
+	volumes := router.Group("/volumes/:volume_id")
+	volumes.Use(m.SetupScopedAPIMiddleware(string(auth.PermNovelVolumeUpdate))...)
+	{
+		volumes.POST("/split", h.ChapterOrder.SplitVolume) // POST /api/v1/volumes/:volume_id/split
+		volumes.POST("/merge", h.ChapterOrder.MergeVolume) // POST /api/v1/volumes/:volume_id/merge
+	}
+}
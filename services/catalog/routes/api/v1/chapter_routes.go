@@ -3,6 +3,7 @@ package v1
 import (
 	"github.com/gin-gonic/gin"
 
+	"wibusystem/pkg/common/auth"
 	"wibusystem/services/catalog/handlers"
 	"wibusystem/services/catalog/middleware"
 )
@@ -18,6 +19,11 @@ import (
 //   - DELETE /chapters/{id}                        - Delete chapter
 //   - POST   /chapters/{id}/publish                - Publish chapter
 //   - POST   /chapters/{id}/unpublish              - Unpublish chapter
+//   - GET    /chapters/{id}/translations           - List chapter translations
+//   - GET    /chapters/{id}/translations/{lang}    - Get a chapter translation with content
+//   - PUT    /chapters/{id}/translations/{lang}    - Create or replace a chapter translation
+//   - DELETE /chapters/{id}/translations/{lang}    - Delete a chapter translation
+//   - POST   /chapters/{id}/translations/{lang}/machine-draft - Submit a machine-translated draft for review
 func SetupChapterRoutes(router *gin.RouterGroup, h *handlers.Handlers, m *middleware.Manager) {
 	// Chapter routes under /volumes/{volume_id}/chapters
 	// These routes handle listing and creating chapters within a specific volume
@@ -36,4 +42,21 @@ func SetupChapterRoutes(router *gin.RouterGroup, h *handlers.Handlers, m *middle
 		chapters.GET("/:id", h.Chapter.GetChapterByID)              // Get chapter details
 		chapters.PUT("/:id", h.Chapter.UpdateChapter)               // Update chapter
 	}
-}
\ No newline at end of file
+
+	// Translated chapter bodies - owners, MANAGE_CHAPTERS collaborators and admins
+	chapterTranslations := router.Group("/chapters/:id/translations")
+	chapterTranslations.Use(m.SetupScopedAPIMiddleware(string(auth.PermNovelChapterUpdate))...)
+	{
+		chapterTranslations.GET("", h.Translation.ListChapterTranslations)                    // GET /api/v1/chapters/:id/translations
+		chapterTranslations.GET("/:language_code", h.Translation.GetChapterTranslation)       // GET /api/v1/chapters/:id/translations/:language_code
+		chapterTranslations.PUT("/:language_code", h.Translation.UpsertChapterTranslation)    // PUT /api/v1/chapters/:id/translations/:language_code
+		chapterTranslations.DELETE("/:language_code", h.Translation.DeleteChapterTranslation) // DELETE /api/v1/chapters/:id/translations/:language_code
+	}
+
+	// Machine-translated drafts - translation contributors; stored as pending contributions
+	machineDrafts := router.Group("/chapters/:id/translations")
+	machineDrafts.Use(m.SetupScopedAPIMiddleware(string(auth.PermTranslationSubmit))...)
+	{
+		machineDrafts.POST("/:language_code/machine-draft", h.Translation.GenerateMachineDraft) // POST /api/v1/chapters/:id/translations/:language_code/machine-draft
+	}
+}
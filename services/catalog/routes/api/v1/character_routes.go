@@ -3,6 +3,7 @@ package v1
 import (
 	"github.com/gin-gonic/gin"
 
+	"wibusystem/pkg/common/auth"
 	"wibusystem/services/catalog/handlers"
 	"wibusystem/services/catalog/middleware"
 )
@@ -20,4 +21,36 @@ func SetupCharacterRoutes(router *gin.RouterGroup, h *handlers.Handlers, m *midd
 	characterProtected.POST("", h.Character.CreateCharacter)       // POST /api/v1/characters
 	characterProtected.PUT("/:id", h.Character.UpdateCharacter)    // PUT /api/v1/characters/:id
 	characterProtected.DELETE("/:id", h.Character.DeleteCharacter) // DELETE /api/v1/characters/:id
-}
\ No newline at end of file
+	characterProtected.GET("/duplicates", h.Character.FindDuplicateCharacters) // GET /api/v1/characters/duplicates?name=
+	characterProtected.GET("/:id/duplicates", h.Character.GetCharacterDuplicates) // GET /api/v1/characters/:id/duplicates
+	characterProtected.POST("/:id/merge", h.Character.MergeCharacter)             // POST /api/v1/characters/:id/merge
+
+	// Public attribution of approved contributions
+	characterPublic.GET("/:id/contributors", h.CharacterContribution.ListContributors) // GET /api/v1/characters/:id/contributors
+
+	// Contribution workflow: users propose, reviewers approve or reject
+	contributions := router.Group("/characters/contributions")
+	contributions.Use(m.SetupProtectedAPIMiddleware()...)
+	contributions.GET("/mine", h.CharacterContribution.ListMyContributions)           // GET /api/v1/characters/contributions/mine
+	contributions.GET("/:contribution_id", h.CharacterContribution.GetContribution) // GET /api/v1/characters/contributions/:contribution_id
+
+	contribute := router.Group("/characters/contributions")
+	contribute.Use(m.SetupScopedAPIMiddleware(string(auth.PermCharacterContribute))...)
+	contribute.POST("", h.CharacterContribution.CreateContribution) // POST /api/v1/characters/contributions
+
+	contributeUpdate := router.Group("/characters/contributions")
+	contributeUpdate.Use(m.SetupScopedAPIMiddleware(string(auth.PermCharacterContributeUpdateSelf))...)
+	contributeUpdate.PUT("/:contribution_id", h.CharacterContribution.UpdateContribution) // PUT /api/v1/characters/contributions/:contribution_id
+
+	review := router.Group("/characters/contributions")
+	review.Use(m.SetupScopedAPIMiddleware(string(auth.PermCharacterApprove), string(auth.PermCharacterReject))...)
+	review.GET("", h.CharacterContribution.ListContributions) // GET /api/v1/characters/contributions
+
+	approve := router.Group("/characters/contributions")
+	approve.Use(m.SetupScopedAPIMiddleware(string(auth.PermCharacterApprove))...)
+	approve.POST("/:contribution_id/approve", h.CharacterContribution.ApproveContribution) // POST /api/v1/characters/contributions/:contribution_id/approve
+
+	reject := router.Group("/characters/contributions")
+	reject.Use(m.SetupScopedAPIMiddleware(string(auth.PermCharacterReject))...)
+	reject.POST("/:contribution_id/reject", h.CharacterContribution.RejectContribution) // POST /api/v1/characters/contributions/:contribution_id/reject
+}
@@ -0,0 +1,28 @@
+package v1
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"wibusystem/pkg/common/auth"
+	"wibusystem/services/catalog/handlers"
+	"wibusystem/services/catalog/middleware"
+)
+
+// SetupContentFilterRoutes registers reader content filter and tenant age gating policy endpoints
+func SetupContentFilterRoutes(router *gin.RouterGroup, h *handlers.Handlers, m *middleware.Manager) {
+	// Reader content filters - any authenticated user
+	me := router.Group("/me/content-filters")
+	me.Use(m.SetupProtectedAPIMiddleware()...)
+	{
+		me.GET("", h.ContentFilter.GetMyFilter)    // GET /api/v1/me/content-filters
+		me.PUT("", h.ContentFilter.UpdateMyFilter) // PUT /api/v1/me/content-filters
+	}
+
+	// Tenant age gating policy - members of the tenant and admins
+	policy := router.Group("/tenants/:tenant_id/content-policy")
+	policy.Use(m.SetupScopedAPIMiddleware(string(auth.PermContentUpdateNovel))...)
+	{
+		policy.GET("", h.ContentFilter.GetTenantPolicy)    // GET /api/v1/tenants/:tenant_id/content-policy
+		policy.PUT("", h.ContentFilter.UpdateTenantPolicy) // PUT /api/v1/tenants/:tenant_id/content-policy
+	}
+}
@@ -0,0 +1,21 @@
+package v1
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"wibusystem/pkg/common/auth"
+	"wibusystem/services/catalog/handlers"
+	"wibusystem/services/catalog/middleware"
+)
+
+// SetupContentWarningRoutes registers content warning taxonomy endpoints
+// Browsing is public; curating the taxonomy requires the moderation:content_review scope
+func SetupContentWarningRoutes(router *gin.RouterGroup, h *handlers.Handlers, m *middleware.Manager) {
+	warningPublic := router.Group("/content-warnings")
+	warningPublic.GET("", h.ContentWarning.ListWarnings) // GET /api/v1/content-warnings
+
+	warningModeration := router.Group("/content-warnings")
+	warningModeration.Use(m.SetupScopedAPIMiddleware(string(auth.PermModerationContentReview))...)
+	warningModeration.POST("", h.ContentWarning.CreateWarning)      // POST /api/v1/content-warnings
+	warningModeration.PUT("/:code", h.ContentWarning.UpdateWarning) // PUT /api/v1/content-warnings/:code
+}
@@ -3,6 +3,7 @@ package v1
 import (
 	"github.com/gin-gonic/gin"
 
+	"wibusystem/pkg/common/auth"
 	"wibusystem/services/catalog/handlers"
 	"wibusystem/services/catalog/middleware"
 )
@@ -13,6 +14,7 @@ func SetupCreatorRoutes(router *gin.RouterGroup, h *handlers.Handlers, m *middle
 	creatorPublic := router.Group("/creators")
 	creatorPublic.GET("", h.Creator.ListCreators)      // GET /api/v1/creators
 	creatorPublic.GET("/:id", h.Creator.GetCreator)    // GET /api/v1/creators/:id
+	creatorPublic.GET("/:id/works", h.Creator.GetCreatorWorks) // GET /api/v1/creators/:id/works
 
 	// Protected creator endpoints (admin authentication required)
 	creatorProtected := router.Group("/creators")
@@ -20,4 +22,21 @@ func SetupCreatorRoutes(router *gin.RouterGroup, h *handlers.Handlers, m *middle
 	creatorProtected.POST("", h.Creator.CreateCreator)       // POST /api/v1/creators
 	creatorProtected.PUT("/:id", h.Creator.UpdateCreator)    // PUT /api/v1/creators/:id
 	creatorProtected.DELETE("/:id", h.Creator.DeleteCreator) // DELETE /api/v1/creators/:id
+	creatorProtected.GET("/duplicates", h.Creator.FindDuplicateCreators) // GET /api/v1/creators/duplicates?name=
+	creatorProtected.GET("/:id/duplicates", h.Creator.GetCreatorDuplicates) // GET /api/v1/creators/:id/duplicates
+	creatorProtected.POST("/:id/merge", h.Creator.MergeCreator)             // POST /api/v1/creators/:id/merge
+
+	// Claims and profile editing (authenticated users; the service checks page ownership)
+	creatorOwner := router.Group("/creators")
+	creatorOwner.Use(m.SetupProtectedAPIMiddleware()...)
+	creatorOwner.PUT("/:id/profile", h.Creator.UpdateCreatorProfile)        // PUT /api/v1/creators/:id/profile
+	creatorOwner.POST("/:id/claims", h.CreatorClaim.CreateClaim)            // POST /api/v1/creators/:id/claims
+	creatorOwner.GET("/claims/mine", h.CreatorClaim.ListMyClaims)           // GET /api/v1/creators/claims/mine
+	creatorOwner.GET("/claims/:claim_id", h.CreatorClaim.GetClaim)          // GET /api/v1/creators/claims/:claim_id
+
+	// Claim review queue (moderation:content_review scope)
+	creatorModeration := router.Group("/creators/claims")
+	creatorModeration.Use(m.SetupScopedAPIMiddleware(string(auth.PermModerationContentReview))...)
+	creatorModeration.GET("", h.CreatorClaim.ListClaims)                    // GET /api/v1/creators/claims
+	creatorModeration.POST("/:claim_id/review", h.CreatorClaim.ReviewClaim) // POST /api/v1/creators/claims/:claim_id/review
 }
\ No newline at end of file
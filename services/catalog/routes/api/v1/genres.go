@@ -11,13 +11,15 @@ import (
 func SetupGenreRoutes(router *gin.RouterGroup, h *handlers.Handlers, m *middleware.Manager) {
 	// Public genre endpoints (no authentication required)
 	genrePublic := router.Group("/genres")
-	genrePublic.GET("", h.Genre.ListGenres)      // GET /api/v1/genres
-	genrePublic.GET("/:id", h.Genre.GetGenre)    // GET /api/v1/genres/:id
+	genrePublic.GET("", h.Genre.ListGenres)        // GET /api/v1/genres
+	genrePublic.GET("/tree", h.Genre.GetGenreTree) // GET /api/v1/genres/tree
+	genrePublic.GET("/:id", h.Genre.GetGenre)      // GET /api/v1/genres/:id
 
 	// Protected genre endpoints (admin authentication required)
 	genreProtected := router.Group("/genres")
-	genreProtected.Use(m.SetupAdminAPIMiddleware()...) // Admin required for create/update/delete
-	genreProtected.POST("", h.Genre.CreateGenre)       // POST /api/v1/genres
-	genreProtected.PUT("/:id", h.Genre.UpdateGenre)    // PUT /api/v1/genres/:id
-	genreProtected.DELETE("/:id", h.Genre.DeleteGenre) // DELETE /api/v1/genres/:id
-}
\ No newline at end of file
+	genreProtected.Use(m.SetupAdminAPIMiddleware()...)    // Admin required for create/update/delete/merge
+	genreProtected.POST("", h.Genre.CreateGenre)          // POST /api/v1/genres
+	genreProtected.PUT("/:id", h.Genre.UpdateGenre)       // PUT /api/v1/genres/:id
+	genreProtected.DELETE("/:id", h.Genre.DeleteGenre)    // DELETE /api/v1/genres/:id
+	genreProtected.POST("/:id/merge", h.Genre.MergeGenre) // POST /api/v1/genres/:id/merge
+}
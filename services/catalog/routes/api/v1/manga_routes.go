@@ -0,0 +1,75 @@
+package v1
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"wibusystem/pkg/common/auth"
+	"wibusystem/services/catalog/handlers"
+	"wibusystem/services/catalog/middleware"
+)
+
+// SetupMangaRoutes registers manga series, volume, chapter and page endpoints.
+// Ownership and collaborator permissions are checked by the services.
+func SetupMangaRoutes(router *gin.RouterGroup, h *handlers.Handlers, m *middleware.Manager) {
+	// Public manga endpoints (no authentication required); a token, when sent, applies the
+	// viewer's age and content filters
+	mangaPublic := router.Group("/manga")
+	mangaPublic.Use(m.SetupOptionalAuthMiddleware()...)
+	mangaPublic.GET("", h.Manga.ListManga)                                                // GET /api/v1/manga
+	mangaPublic.GET("/:manga_id", h.Manga.GetMangaByID)                                   // GET /api/v1/manga/:manga_id
+	mangaPublic.GET("/:manga_id/volumes", h.MangaChapter.ListVolumes)                     // GET /api/v1/manga/:manga_id/volumes
+	mangaPublic.GET("/volumes/:volume_id/chapters", h.MangaChapter.ListPublishedChapters) // GET /api/v1/manga/volumes/:volume_id/chapters
+	mangaPublic.GET("/chapters/:chapter_id", h.MangaChapter.GetChapter)                   // GET /api/v1/manga/chapters/:chapter_id
+
+	// Reading requires an account; paid chapters also require a purchase or rental
+	mangaRead := router.Group("/manga")
+	mangaRead.Use(m.SetupScopedAPIMiddleware(string(auth.PermContentReadManga))...)
+	mangaRead.GET("/chapters/:chapter_id/read", h.MangaChapter.ReadChapter) // GET /api/v1/manga/chapters/:chapter_id/read
+
+	// Manga management
+	mangaCreate := router.Group("/manga")
+	mangaCreate.Use(m.SetupScopedAPIMiddleware(string(auth.PermContentCreateManga))...)
+	mangaCreate.POST("", h.Manga.CreateManga) // POST /api/v1/manga
+
+	mangaUpdate := router.Group("/manga")
+	mangaUpdate.Use(m.SetupScopedAPIMiddleware(string(auth.PermContentUpdateManga))...)
+	mangaUpdate.PUT("/:manga_id", h.Manga.UpdateManga)                  // PUT /api/v1/manga/:manga_id
+	mangaUpdate.PUT("/:manga_id/translations", h.Manga.SetTranslations) // PUT /api/v1/manga/:manga_id/translations
+
+	mangaDelete := router.Group("/manga")
+	mangaDelete.Use(m.SetupScopedAPIMiddleware(string(auth.PermContentDeleteManga))...)
+	mangaDelete.DELETE("/:manga_id", h.Manga.DeleteManga) // DELETE /api/v1/manga/:manga_id
+
+	// Volume management
+	volumeCreate := router.Group("/manga")
+	volumeCreate.Use(m.SetupScopedAPIMiddleware(string(auth.PermMangaVolumeCreate))...)
+	volumeCreate.POST("/:manga_id/volumes", h.MangaChapter.CreateVolume) // POST /api/v1/manga/:manga_id/volumes
+
+	volumeUpdate := router.Group("/manga")
+	volumeUpdate.Use(m.SetupScopedAPIMiddleware(string(auth.PermMangaVolumeUpdate))...)
+	volumeUpdate.PUT("/volumes/:volume_id", h.MangaChapter.UpdateVolume) // PUT /api/v1/manga/volumes/:volume_id
+
+	volumeDelete := router.Group("/manga")
+	volumeDelete.Use(m.SetupScopedAPIMiddleware(string(auth.PermMangaVolumeDelete))...)
+	volumeDelete.DELETE("/volumes/:volume_id", h.MangaChapter.DeleteVolume) // DELETE /api/v1/manga/volumes/:volume_id
+
+	// Chapter and page management
+	chapterCreate := router.Group("/manga")
+	chapterCreate.Use(m.SetupScopedAPIMiddleware(string(auth.PermMangaChapterCreate))...)
+	chapterCreate.POST("/volumes/:volume_id/chapters", h.MangaChapter.CreateChapter) // POST /api/v1/manga/volumes/:volume_id/chapters
+
+	chapterUpdate := router.Group("/manga")
+	chapterUpdate.Use(m.SetupScopedAPIMiddleware(string(auth.PermMangaChapterUpdate))...)
+	chapterUpdate.GET("/volumes/:volume_id/chapters/all", h.MangaChapter.ListAllChapters)  // GET /api/v1/manga/volumes/:volume_id/chapters/all (incl. drafts)
+	chapterUpdate.PUT("/chapters/:chapter_id", h.MangaChapter.UpdateChapter)               // PUT /api/v1/manga/chapters/:chapter_id
+	chapterUpdate.POST("/chapters/:chapter_id/publish", h.MangaChapter.PublishChapter)     // POST /api/v1/manga/chapters/:chapter_id/publish
+	chapterUpdate.POST("/chapters/:chapter_id/unpublish", h.MangaChapter.UnpublishChapter) // POST /api/v1/manga/chapters/:chapter_id/unpublish
+	chapterUpdate.POST("/chapters/:chapter_id/pages", h.MangaChapter.AddPages)             // POST /api/v1/manga/chapters/:chapter_id/pages
+	chapterUpdate.PUT("/chapters/:chapter_id/pages/order", h.MangaChapter.ReorderPages)    // PUT /api/v1/manga/chapters/:chapter_id/pages/order
+	chapterUpdate.PUT("/pages/:page_id", h.MangaChapter.UpdatePage)                        // PUT /api/v1/manga/pages/:page_id
+	chapterUpdate.DELETE("/pages/:page_id", h.MangaChapter.DeletePage)                     // DELETE /api/v1/manga/pages/:page_id
+
+	chapterDelete := router.Group("/manga")
+	chapterDelete.Use(m.SetupScopedAPIMiddleware(string(auth.PermMangaChapterDelete))...)
+	chapterDelete.DELETE("/chapters/:chapter_id", h.MangaChapter.DeleteChapter) // DELETE /api/v1/manga/chapters/:chapter_id
+}
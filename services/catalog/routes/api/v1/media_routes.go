@@ -0,0 +1,29 @@
+package v1
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"wibusystem/services/catalog/handlers"
+	"wibusystem/services/catalog/middleware"
+)
+
+// SetupMediaRoutes registers file upload and signed download endpoints
+// Any authenticated user can upload; downloads are authorized by the URL signature
+func SetupMediaRoutes(router *gin.RouterGroup, h *handlers.Handlers, m *middleware.Manager) {
+	mediaPublic := router.Group("/media")
+
+	// Get a media asset with a fresh signed download URL
+	// GET /api/v1/media/{media_id}
+	mediaPublic.GET("/:media_id", h.Media.GetMedia)
+
+	// Serve a file of the local storage backend through its signed URL
+	// GET /api/v1/media/files/{key}?expires=&signature=
+	mediaPublic.GET("/files/*key", h.Media.ServeFile)
+
+	mediaUpload := router.Group("/media")
+	mediaUpload.Use(m.SetupProtectedAPIMiddleware()...)
+
+	// Upload a file (multipart/form-data, field "file"); identical files are deduplicated
+	// POST /api/v1/media
+	mediaUpload.POST("", h.Media.Upload)
+}
@@ -0,0 +1,43 @@
+package v1
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"wibusystem/pkg/common/auth"
+	"wibusystem/services/catalog/handlers"
+	"wibusystem/services/catalog/middleware"
+)
+
+// SetupModerationRoutes registers content reporting and moderation queue endpoints
+// Any authenticated user can report; the queue requires the moderation:content_review scope
+func SetupModerationRoutes(router *gin.RouterGroup, h *handlers.Handlers, m *middleware.Manager) {
+	reports := router.Group("/reports")
+	reports.Use(m.SetupProtectedAPIMiddleware()...)
+
+	// Report a novel, chapter, comment, review or user
+	// POST /api/v1/reports
+	reports.POST("", h.Moderation.CreateReport)
+
+	cases := router.Group("/moderation/cases")
+	cases.Use(m.SetupScopedAPIMiddleware(string(auth.PermModerationContentReview))...)
+
+	// List the moderation queue (default: OPEN and IN_REVIEW cases, most reported first)
+	// GET /api/v1/moderation/cases
+	cases.GET("", h.Moderation.ListCases)
+
+	// Get a case with its reports and audit trail
+	// GET /api/v1/moderation/cases/{case_id}
+	cases.GET("/:case_id", h.Moderation.GetCase)
+
+	// Claim an unassigned case
+	// POST /api/v1/moderation/cases/{case_id}/claim
+	cases.POST("/:case_id/claim", h.Moderation.ClaimCase)
+
+	// Assign a case to a moderator
+	// POST /api/v1/moderation/cases/{case_id}/assign
+	cases.POST("/:case_id/assign", h.Moderation.AssignCase)
+
+	// Resolve a case (SUSPEND_USER additionally requires moderation:user_suspend)
+	// POST /api/v1/moderation/cases/{case_id}/resolve
+	cases.POST("/:case_id/resolve", h.Moderation.ResolveCase)
+}
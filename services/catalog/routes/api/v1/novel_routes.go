@@ -3,21 +3,51 @@ package v1
 import (
 	"github.com/gin-gonic/gin"
 
+	"wibusystem/pkg/common/auth"
 	"wibusystem/services/catalog/handlers"
 	"wibusystem/services/catalog/middleware"
 )
 
 // SetupNovelRoutes registers novel-related API endpoints
 func SetupNovelRoutes(router *gin.RouterGroup, h *handlers.Handlers, m *middleware.Manager) {
-	// Public novel endpoints (no authentication required)
+	// Public novel endpoints (no authentication required); a token, when sent, applies the
+	// reader's age and content filters
 	novelPublic := router.Group("/novels")
+	novelPublic.Use(m.SetupOptionalAuthMiddleware()...)
 
 	// List novels - public endpoint with optional filtering
 	novelPublic.GET("", h.Novel.ListNovels)
 
+	// Rankings - public, served from pre-computed snapshots
+	novelPublic.GET("/rankings/:ranking_type", h.Ranking.ListRanking)
+
 	// Get novel by ID - public endpoint
 	novelPublic.GET("/:novel_id", h.Novel.GetNovelByID)
 
+	// Similar novels - public, served from the nightly neighbours table
+	novelPublic.GET("/:novel_id/similar", h.Recommendation.GetSimilarNovels)
+
+	// Localized titles, summaries and translation glossaries - public
+	novelPublic.GET("/:novel_id/translations", h.Translation.ListNovelTranslations)  // GET /api/v1/novels/:novel_id/translations
+	novelPublic.GET("/:novel_id/glossary/:language_code", h.Translation.GetGlossary) // GET /api/v1/novels/:novel_id/glossary/:language_code
+
+	// Reader endpoints (authentication required)
+	novelReader := router.Group("/novels")
+	novelReader.Use(m.SetupProtectedAPIMiddleware()...)
+
+	novelReader.GET("/recommendations", h.Recommendation.GetMyRecommendations)
+	novelReader.POST("/:novel_id/bookmark", h.Recommendation.AddBookmark)
+	novelReader.DELETE("/:novel_id/bookmark", h.Recommendation.RemoveBookmark)
+
+	// Owner analytics - owners, VIEW_ANALYTICS collaborators and admins
+	novelReader.GET("/:novel_id/analytics", h.Analytics.GetNovelAnalytics)
+
+	// Localized titles, summaries and glossaries - owners, EDIT collaborators and admins
+	novelTranslation := router.Group("/novels")
+	novelTranslation.Use(m.SetupScopedAPIMiddleware(string(auth.PermContentUpdateNovel))...)
+	novelTranslation.PUT("/:novel_id/translations", h.Translation.SetNovelTranslations)   // PUT /api/v1/novels/:novel_id/translations
+	novelTranslation.PUT("/:novel_id/glossary/:language_code", h.Translation.SetGlossary) // PUT /api/v1/novels/:novel_id/glossary/:language_code
+
 	// Protected novel endpoints (admin authentication required)
 	novelProtected := router.Group("/novels")
 	novelProtected.Use(m.SetupAdminAPIMiddleware()...) // Admin required for create/update/delete
@@ -26,4 +56,12 @@ func SetupNovelRoutes(router *gin.RouterGroup, h *handlers.Handlers, m *middlewa
 	novelProtected.POST("", h.Novel.CreateNovel)
 	novelProtected.PUT("/:novel_id", h.Novel.UpdateNovel)
 	novelProtected.DELETE("/:novel_id", h.Novel.DeleteNovel)
+
+	// Force a ranking recompute outside the scheduled job
+	novelProtected.POST("/rankings/rebuild", h.Ranking.RebuildRankings)
+	novelProtected.POST("/recommendations/rebuild", h.Recommendation.RebuildNeighbours)
+
+	// Recompute a novel's denormalized counters outside the scheduled job
+	novelProtected.POST("/:novel_id/counters/reconcile", h.Counter.ReconcileNovelCounters)
+	novelProtected.POST("/counters/reconcile", h.Counter.ReconcileImportedNovels) // Called by import tooling after a bulk import
 }
@@ -0,0 +1,44 @@
+package v1
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"wibusystem/pkg/common/auth"
+	"wibusystem/services/catalog/handlers"
+	"wibusystem/services/catalog/middleware"
+)
+
+// SetupPricingRoutes registers discount campaign, bundle and price quote endpoints
+func SetupPricingRoutes(router *gin.RouterGroup, h *handlers.Handlers, m *middleware.Manager) {
+	// Price quote for the current user
+	pricing := router.Group("/pricing")
+	pricing.Use(m.SetupProtectedAPIMiddleware()...)
+	pricing.GET("/quote", h.Pricing.QuotePrice) // GET /api/v1/pricing/quote
+
+	// Discount campaigns - novel owners and MANAGE_PRICING collaborators, tenant members, admins
+	campaigns := router.Group("/pricing/campaigns")
+	campaigns.Use(m.SetupScopedAPIMiddleware(string(auth.PermContentUpdateNovel))...)
+	{
+		campaigns.GET("", h.Pricing.ListCampaigns)                  // GET /api/v1/pricing/campaigns
+		campaigns.POST("", h.Pricing.CreateCampaign)                // POST /api/v1/pricing/campaigns
+		campaigns.GET("/:campaign_id", h.Pricing.GetCampaign)       // GET /api/v1/pricing/campaigns/:campaign_id
+		campaigns.PUT("/:campaign_id", h.Pricing.UpdateCampaign)    // PUT /api/v1/pricing/campaigns/:campaign_id
+		campaigns.DELETE("/:campaign_id", h.Pricing.DeleteCampaign) // DELETE /api/v1/pricing/campaigns/:campaign_id
+	}
+
+	// Bundles on sale - public
+	router.GET("/novels/:novel_id/bundles", h.Pricing.ListNovelBundles) // GET /api/v1/novels/:novel_id/bundles
+	router.GET("/bundles/:bundle_id", h.Pricing.GetBundle)              // GET /api/v1/bundles/:bundle_id
+
+	// Bundle management - novel owners, MANAGE_PRICING collaborators and admins
+	novelBundles := router.Group("/novels/:novel_id/bundles")
+	novelBundles.Use(m.SetupScopedAPIMiddleware(string(auth.PermContentUpdateNovel))...)
+	novelBundles.POST("", h.Pricing.CreateBundle) // POST /api/v1/novels/:novel_id/bundles
+
+	bundles := router.Group("/bundles")
+	bundles.Use(m.SetupScopedAPIMiddleware(string(auth.PermContentUpdateNovel))...)
+	{
+		bundles.PUT("/:bundle_id", h.Pricing.UpdateBundle)    // PUT /api/v1/bundles/:bundle_id
+		bundles.DELETE("/:bundle_id", h.Pricing.DeleteBundle) // DELETE /api/v1/bundles/:bundle_id
+	}
+}
@@ -0,0 +1,31 @@
+package v1
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"wibusystem/pkg/common/auth"
+	"wibusystem/services/catalog/handlers"
+	"wibusystem/services/catalog/middleware"
+)
+
+// SetupRelationRoutes registers content relation and franchise graph endpoints
+func SetupRelationRoutes(router *gin.RouterGroup, h *handlers.Handlers, m *middleware.Manager) {
+	// Public relation endpoints (no authentication required)
+	relationPublic := router.Group("/relations")
+	relationPublic.GET("", h.Relation.ListRelations)            // GET /api/v1/relations?content_type=&content_id=
+	relationPublic.GET("/graph", h.Relation.GetGraph)           // GET /api/v1/relations/graph?content_type=&content_id=&depth=
+	relationPublic.GET("/:relation_id", h.Relation.GetRelation) // GET /api/v1/relations/:relation_id
+
+	// Relation management, one permission per operation
+	relationCreate := router.Group("/relations")
+	relationCreate.Use(m.SetupScopedAPIMiddleware(string(auth.PermRelationCreate))...)
+	relationCreate.POST("", h.Relation.CreateRelation) // POST /api/v1/relations
+
+	relationUpdate := router.Group("/relations")
+	relationUpdate.Use(m.SetupScopedAPIMiddleware(string(auth.PermRelationUpdate))...)
+	relationUpdate.PUT("/:relation_id", h.Relation.UpdateRelation) // PUT /api/v1/relations/:relation_id
+
+	relationDelete := router.Group("/relations")
+	relationDelete.Use(m.SetupScopedAPIMiddleware(string(auth.PermRelationDelete))...)
+	relationDelete.DELETE("/:relation_id", h.Relation.DeleteRelation) // DELETE /api/v1/relations/:relation_id
+}
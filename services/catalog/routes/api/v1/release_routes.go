@@ -0,0 +1,29 @@
+package v1
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"wibusystem/pkg/common/auth"
+	"wibusystem/services/catalog/handlers"
+	"wibusystem/services/catalog/middleware"
+)
+
+// SetupReleaseRoutes registers release policy, reader chapter list, reading and free unlock endpoints
+func SetupReleaseRoutes(router *gin.RouterGroup, h *handlers.Handlers, m *middleware.Manager) {
+	// Release policy - public
+	router.GET("/novels/:novel_id/release-policy", h.Release.GetPolicy) // GET /api/v1/novels/:novel_id/release-policy
+
+	// Reading requires an account; paid chapters also require a purchase, rental or free unlock
+	novelRead := router.Group("/novels")
+	novelRead.Use(m.SetupScopedAPIMiddleware(string(auth.PermContentReadNovel))...)
+	{
+		novelRead.GET("/volumes/:volume_id/chapters", h.Release.ListReaderChapters) // GET /api/v1/novels/volumes/:volume_id/chapters
+		novelRead.GET("/chapters/:chapter_id/read", h.Release.ReadChapter)          // GET /api/v1/novels/chapters/:chapter_id/read
+		novelRead.POST("/chapters/:chapter_id/unlock", h.Release.UnlockChapter)     // POST /api/v1/novels/chapters/:chapter_id/unlock
+	}
+
+	// Release policy management - novel owners, MANAGE_PRICING collaborators and admins
+	policy := router.Group("/novels/:novel_id/release-policy")
+	policy.Use(m.SetupScopedAPIMiddleware(string(auth.PermContentUpdateNovel))...)
+	policy.PUT("", h.Release.UpdatePolicy) // PUT /api/v1/novels/:novel_id/release-policy
+}
@@ -18,6 +18,7 @@ func SetupRoutes(router *gin.Engine, cfg *config.Config, h *handlers.Handlers, m
 
 	// Setup master data routes
 	SetupGenreRoutes(api, h, m)
+	SetupTagRoutes(api, h, m)
 	SetupCharacterRoutes(api, h, m)
 	SetupCreatorRoutes(api, h, m)
 
@@ -25,4 +26,28 @@ func SetupRoutes(router *gin.Engine, cfg *config.Config, h *handlers.Handlers, m
 	SetupNovelRoutes(api, h, m)
 	SetupVolumeRoutes(api, h, m)
 	SetupChapterRoutes(api, h, m)
+	SetupRelationRoutes(api, h, m)
+	SetupTranslationRoutes(api, h, m)
+	SetupTrashRoutes(api, h, m)
+	SetupChapterOrderRoutes(api, h, m)
+	SetupPricingRoutes(api, h, m)
+	SetupReleaseRoutes(api, h, m)
+	SetupContentFilterRoutes(api, h, m)
+	SetupContentWarningRoutes(api, h, m)
+
+	// Anime module can be switched off with CONFIG_FEATURE_ANIME=false
+	if cfg == nil || cfg.Content.EnableAnime {
+		SetupAnimeRoutes(api, h, m)
+	}
+
+	// Manga module can be switched off with CONFIG_FEATURE_MANGA=false
+	if cfg == nil || cfg.Content.EnableManga {
+		SetupMangaRoutes(api, h, m)
+	}
+
+	// Setup reporting and moderation routes
+	SetupModerationRoutes(api, h, m)
+
+	// Setup file upload and signed download routes
+	SetupMediaRoutes(api, h, m)
 }
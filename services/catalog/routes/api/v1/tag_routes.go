@@ -0,0 +1,24 @@
+package v1
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"wibusystem/pkg/common/auth"
+	"wibusystem/services/catalog/handlers"
+	"wibusystem/services/catalog/middleware"
+)
+
+// SetupTagRoutes registers tag registry endpoints
+// Browsing and autocomplete are public; curating tags requires the moderation:content_review scope
+func SetupTagRoutes(router *gin.RouterGroup, h *handlers.Handlers, m *middleware.Manager) {
+	tagPublic := router.Group("/tags")
+	tagPublic.GET("", h.Tag.ListTags)                      // GET /api/v1/tags
+	tagPublic.GET("/autocomplete", h.Tag.AutocompleteTags) // GET /api/v1/tags/autocomplete?q=
+	tagPublic.GET("/:id", h.Tag.GetTag)                    // GET /api/v1/tags/:id
+
+	tagModeration := router.Group("/tags")
+	tagModeration.Use(m.SetupScopedAPIMiddleware(string(auth.PermModerationContentReview))...)
+	tagModeration.POST("", h.Tag.CreateTag)          // POST /api/v1/tags
+	tagModeration.PUT("/:id", h.Tag.UpdateTag)       // PUT /api/v1/tags/:id
+	tagModeration.POST("/:id/merge", h.Tag.MergeTag) // POST /api/v1/tags/:id/merge
+}
@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"wibusystem/pkg/common/auth"
+	"wibusystem/services/catalog/handlers"
+	"wibusystem/services/catalog/middleware"
+)
+
+// SetupTranslationRoutes registers community translation contribution endpoints
+// Contributors need the translation scopes; the review queue requires the moderation:content_review scope
+func SetupTranslationRoutes(router *gin.RouterGroup, h *handlers.Handlers, m *middleware.Manager) {
+	submit := router.Group("/translations")
+	submit.Use(m.SetupScopedAPIMiddleware(string(auth.PermTranslationSubmit))...)
+
+	// Submit a translation of a novel (title and summary) or a chapter
+	// POST /api/v1/translations/contribute
+	submit.POST("/contribute", h.TranslationContribution.CreateContribution)
+
+	updates := router.Group("/translations/contributions")
+	updates.Use(m.SetupScopedAPIMiddleware(string(auth.PermTranslationUpdateSelf))...)
+
+	// Update own pending contribution
+	// PUT /api/v1/translations/contributions/{contribution_id}
+	updates.PUT("/:contribution_id", h.TranslationContribution.UpdateContribution)
+
+	contributions := router.Group("/translations")
+	contributions.Use(m.SetupProtectedAPIMiddleware()...)
+
+	// List own contributions in every status
+	// GET /api/v1/translations/my-contributions
+	contributions.GET("/my-contributions", h.TranslationContribution.ListMyContributions)
+
+	// Get a contribution with its glossary violations (contributor or moderator)
+	// GET /api/v1/translations/contributions/{contribution_id}
+	contributions.GET("/contributions/:contribution_id", h.TranslationContribution.GetContribution)
+
+	review := router.Group("/translations")
+	review.Use(m.SetupScopedAPIMiddleware(string(auth.PermModerationContentReview))...)
+
+	// List the review queue (default: pending contributions, oldest first)
+	// GET /api/v1/translations/pending
+	review.GET("/pending", h.TranslationContribution.ListContributions)
+
+	// Approve or reject a pending contribution
+	// POST /api/v1/translations/contributions/{contribution_id}/review
+	review.POST("/contributions/:contribution_id/review", h.TranslationContribution.ReviewContribution)
+}
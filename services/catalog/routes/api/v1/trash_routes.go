@@ -0,0 +1,25 @@
+package v1
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"wibusystem/services/catalog/handlers"
+	"wibusystem/services/catalog/middleware"
+)
+
+// SetupTrashRoutes registers the trash and buyers' archive endpoints
+func SetupTrashRoutes(router *gin.RouterGroup, h *handlers.Handlers, m *middleware.Manager) {
+	// Trash of deleted novels, volumes and chapters (the service checks novel ownership)
+	trash := router.Group("/trash")
+	trash.Use(m.SetupProtectedAPIMiddleware()...)
+	trash.GET("", h.Trash.ListTrash)                                    // GET /api/v1/trash
+	trash.POST("/novels/:novel_id/restore", h.Trash.RestoreNovel)       // POST /api/v1/trash/novels/:novel_id/restore
+	trash.POST("/volumes/:volume_id/restore", h.Trash.RestoreVolume)    // POST /api/v1/trash/volumes/:volume_id/restore
+	trash.POST("/chapters/:chapter_id/restore", h.Trash.RestoreChapter) // POST /api/v1/trash/chapters/:chapter_id/restore
+
+	// Purged content kept read-only for the users who bought it
+	archived := router.Group("/library/archived")
+	archived.Use(m.SetupProtectedAPIMiddleware()...)
+	archived.GET("", h.Trash.ListArchived)                             // GET /api/v1/library/archived
+	archived.GET("/chapters/:chapter_id", h.Trash.ReadArchivedChapter) // GET /api/v1/library/archived/chapters/:chapter_id
+}
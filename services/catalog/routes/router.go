@@ -3,6 +3,7 @@
 package routes
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
@@ -10,6 +11,8 @@ import (
 	"github.com/gin-gonic/gin"
 
 	commonHandlers "wibusystem/pkg/common/handlers"
+	"wibusystem/pkg/common/mt"
+	"wibusystem/pkg/common/storage"
 	"wibusystem/pkg/database/factory"
 	"wibusystem/pkg/database/providers/postgres"
 	"wibusystem/pkg/i18n"
@@ -92,8 +95,22 @@ func NewDependencies(dbManager *factory.DatabaseManager, cfg *config.Config, tra
 		return nil, fmt.Errorf("failed to create gRPC client manager: %w", err)
 	}
 
+	store, err := newMediaStorage(cfg.Media.Storage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create media storage: %w", err)
+	}
+
+	machineTranslator, err := newMachineTranslator(cfg.Translation)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create machine translator: %w", err)
+	}
+
 	repos := repositories.NewRepositories(pool)
-	services := services.NewServices(repos, grpcClients)
+	services := services.NewServices(repos, grpcClients, store, services.MediaSettings{
+		MaxUploadBytes:      cfg.Media.MaxUploadBytes,
+		AllowedContentTypes: cfg.Media.AllowedContentTypes,
+		SignedURLExpiry:     cfg.Media.SignedURLExpiry,
+	}, machineTranslator, time.Duration(cfg.Content.TrashRetentionDays)*24*time.Hour)
 	h := handlers.NewHandlers(repos, services, translator)
 	m := middleware.NewManager(cfg, translator)
 
@@ -109,6 +126,41 @@ func NewDependencies(dbManager *factory.DatabaseManager, cfg *config.Config, tra
 	}, nil
 }
 
+// newMediaStorage builds the object storage backend selected in the media config.
+func newMediaStorage(cfg config.StorageConfig) (storage.Storage, error) {
+	return storage.New(storage.Config{
+		Driver: cfg.Driver,
+		Local: storage.LocalConfig{
+			RootDir:    cfg.LocalRootDir,
+			BaseURL:    cfg.PublicBaseURL,
+			SigningKey: cfg.SigningKey,
+		},
+		S3: storage.S3Config{
+			Endpoint:        cfg.S3Endpoint,
+			Region:          cfg.S3Region,
+			Bucket:          cfg.S3Bucket,
+			AccessKeyID:     cfg.S3AccessKey,
+			SecretAccessKey: cfg.S3SecretKey,
+			UsePathStyle:    cfg.S3PathStyle,
+		},
+	})
+}
+
+// newMachineTranslator builds the machine-translation provider; it returns nil
+// when the provider is "none", which disables machine-translated drafts.
+func newMachineTranslator(cfg config.TranslationConfig) (mt.Translator, error) {
+	translator, err := mt.New(mt.Config{
+		Provider: cfg.Provider,
+		BaseURL:  cfg.BaseURL,
+		APIKey:   cfg.APIKey,
+		Timeout:  cfg.Timeout,
+	})
+	if errors.Is(err, mt.ErrNotConfigured) {
+		return nil, nil
+	}
+	return translator, err
+}
+
 func setupPublicRoutes(router *gin.Engine, deps *Dependencies) {
 	router.GET("/healthz", deps.Handlers.Health.Status)
 }
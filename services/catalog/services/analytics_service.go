@@ -0,0 +1,205 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+	"wibusystem/services/catalog/repositories"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+const (
+	// analyticsDateLayout is the format of the from/to query parameters
+	analyticsDateLayout = "2006-01-02"
+	// analyticsDefaultRangeDays is the range returned when from is omitted
+	analyticsDefaultRangeDays = 30
+	// analyticsMaxRangeDays caps the requested range
+	analyticsMaxRangeDays = 366
+	// readerActivityRetentionDays keeps raw activity long enough to re-run recent rollups
+	readerActivityRetentionDays = 35
+)
+
+// AnalyticsService implements owner analytics business logic
+type AnalyticsService struct {
+	repos *repositories.Repositories
+}
+
+// NewAnalyticsService creates a new analytics service
+func NewAnalyticsService(repos *repositories.Repositories) interfaces.AnalyticsServiceInterface {
+	return &AnalyticsService{
+		repos: repos,
+	}
+}
+
+// GetNovelAnalytics checks access and reads the pre-aggregated series of a novel
+func (s *AnalyticsService) GetNovelAnalytics(ctx context.Context, novelID string, userID uuid.UUID, tenantID *uuid.UUID, isAdmin bool, req d.NovelAnalyticsRequest) (*d.NovelAnalyticsResponse, error) {
+	novelUUID, err := uuid.Parse(novelID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid novel ID format: %w", err)
+	}
+
+	from, to, err := parseAnalyticsRange(req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	granularity := m.AnalyticsGranularityDay
+	if req.Granularity != "" {
+		granularity = m.AnalyticsGranularity(req.Granularity)
+		if !granularity.IsValid() {
+			return nil, fmt.Errorf("invalid granularity: %s", req.Granularity)
+		}
+	}
+
+	allowed, err := s.repos.Analytics.CanViewAnalytics(ctx, novelUUID, userID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed && !isAdmin {
+		return nil, fmt.Errorf("permission denied: analytics of this novel are restricted to owners and collaborators")
+	}
+
+	series, err := s.repos.Analytics.GetNovelSeries(ctx, novelUUID, from, to, granularity)
+	if err != nil {
+		return nil, err
+	}
+
+	dropoff, err := s.repos.Analytics.GetChapterDropoff(ctx, novelUUID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	var totals d.AnalyticsTotals
+	for _, p := range series {
+		totals.Views += p.Views
+		totals.Purchases += p.Purchases
+		totals.Rentals += p.Rentals
+		totals.RevenueCoins += p.RevenueCoins
+		totals.Bookmarks += p.Bookmarks
+	}
+
+	// Retention is relative to the first chapter and to the chapter right before
+	for i := range dropoff {
+		previous := dropoff[0].Readers
+		if i > 0 {
+			previous = dropoff[i-1].Readers
+		}
+		dropoff[i].RetentionFromFirst = retentionRatio(dropoff[i].Readers, dropoff[0].Readers)
+		dropoff[i].RetentionFromPrevious = retentionRatio(dropoff[i].Readers, previous)
+	}
+
+	return &d.NovelAnalyticsResponse{
+		NovelID:        novelUUID.String(),
+		From:           from.Format(analyticsDateLayout),
+		To:             to.Format(analyticsDateLayout),
+		Granularity:    string(granularity),
+		Series:         series,
+		Totals:         totals,
+		ChapterDropoff: dropoff,
+	}, nil
+}
+
+// RecordChapterRead validates the chapter ID and records the read
+func (s *AnalyticsService) RecordChapterRead(ctx context.Context, chapterID string, userID *uuid.UUID) error {
+	chapterUUID, err := uuid.Parse(chapterID)
+	if err != nil {
+		return fmt.Errorf("invalid chapter ID format: %w", err)
+	}
+
+	return s.repos.Analytics.RecordChapterRead(ctx, chapterUUID, userID)
+}
+
+// RollupAnalytics recomputes the aggregates from the start of yesterday's week until
+// today, so late reads of the previous day and the current weekly totals are covered.
+// Days missed since the last run are included, and the first run covers the whole history.
+func (s *AnalyticsService) RollupAnalytics(ctx context.Context) error {
+	today := truncateToDate(time.Now())
+	from, err := s.rollupStart(ctx, startOfWeek(today.AddDate(0, 0, -1)))
+	if err != nil {
+		return err
+	}
+
+	if err := s.repos.Analytics.RollupAnalytics(ctx, from, today); err != nil {
+		return fmt.Errorf("failed to roll up analytics: %w", err)
+	}
+
+	if _, err := s.repos.Analytics.PurgeReaderActivity(ctx, readerActivityRetentionDays); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// rollupStart moves the start of the rollup back to the week of the last rolled-up day, or of
+// the earliest purchase, rental or reader activity when nothing has been rolled up yet
+func (s *AnalyticsService) rollupStart(ctx context.Context, recent time.Time) (time.Time, error) {
+	since, err := s.repos.Analytics.GetRollupWatermark(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if since == nil {
+		if since, err = s.repos.Analytics.GetEarliestActivityDate(ctx); err != nil {
+			return time.Time{}, err
+		}
+	}
+
+	if since != nil && since.Before(recent) {
+		return startOfWeek(truncateToDate(*since)), nil
+	}
+	return recent, nil
+}
+
+// parseAnalyticsRange applies defaults (last 30 days) and validates the date range
+func parseAnalyticsRange(fromStr, toStr string) (time.Time, time.Time, error) {
+	to := truncateToDate(time.Now())
+	if toStr != "" {
+		parsed, err := time.Parse(analyticsDateLayout, toStr)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid date range: to must be YYYY-MM-DD")
+		}
+		to = parsed
+	}
+
+	from := to.AddDate(0, 0, -(analyticsDefaultRangeDays - 1))
+	if fromStr != "" {
+		parsed, err := time.Parse(analyticsDateLayout, fromStr)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid date range: from must be YYYY-MM-DD")
+		}
+		from = parsed
+	}
+
+	if from.After(to) {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid date range: from must not be after to")
+	}
+	if to.Sub(from) > analyticsMaxRangeDays*24*time.Hour {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid date range: range exceeds %d days", analyticsMaxRangeDays)
+	}
+
+	return from, to, nil
+}
+
+// retentionRatio returns part/base, or 0 when the base has no readers
+func retentionRatio(part, base int64) float64 {
+	if base == 0 {
+		return 0
+	}
+	return float64(part) / float64(base)
+}
+
+// truncateToDate keeps only the calendar date of t, matching DATE columns
+func truncateToDate(t time.Time) time.Time {
+	y, mo, day := t.Date()
+	return time.Date(y, mo, day, 0, 0, 0, 0, time.UTC)
+}
+
+// startOfWeek returns the Monday of the ISO week containing t
+func startOfWeek(t time.Time) time.Time {
+	offset := (int(t.Weekday()) + 6) % 7
+	return t.AddDate(0, 0, -offset)
+}
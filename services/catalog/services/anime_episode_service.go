@@ -0,0 +1,596 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+	"wibusystem/pkg/common/subtitle"
+	"wibusystem/services/catalog/repositories"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// AnimeEpisodeService implements anime season, episode and subtitle business logic
+type AnimeEpisodeService struct {
+	repos *repositories.Repositories
+}
+
+// NewAnimeEpisodeService creates a new anime episode service
+func NewAnimeEpisodeService(repos *repositories.Repositories) interfaces.AnimeEpisodeServiceInterface {
+	return &AnimeEpisodeService{
+		repos: repos,
+	}
+}
+
+// CreateSeason requires MANAGE_CHAPTERS, plus MANAGE_PRICING when prices are set
+func (s *AnimeEpisodeService) CreateSeason(ctx context.Context, animeID string, req d.CreateAnimeSeasonRequest, actor d.ContentActor) (*d.AnimeSeasonSummary, error) {
+	animeUUID, err := uuid.Parse(animeID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid anime ID format: %w", err)
+	}
+
+	if err := authorizeAnime(ctx, s.repos, animeUUID, actor, m.PermissionManageChapters); err != nil {
+		return nil, err
+	}
+	if req.PriceCoins != nil || req.RentalPriceCoins != nil || req.RentalDurationDays != nil {
+		if err := authorizeAnime(ctx, s.repos, animeUUID, actor, m.PermissionManagePricing); err != nil {
+			return nil, err
+		}
+	}
+
+	season, err := s.repos.AnimeEpisode.CreateSeason(ctx, animeUUID, req)
+	if err != nil {
+		return nil, err
+	}
+	return toAnimeSeasonSummary(season, 0), nil
+}
+
+// ListSeasons lists the seasons of a publicly visible anime
+func (s *AnimeEpisodeService) ListSeasons(ctx context.Context, animeID string) ([]d.AnimeSeasonSummary, error) {
+	animeUUID, err := uuid.Parse(animeID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid anime ID format: %w", err)
+	}
+
+	visible, err := s.repos.AnimeEpisode.IsAnimePubliclyVisible(ctx, animeUUID)
+	if err != nil {
+		return nil, err
+	}
+	if !visible {
+		return nil, fmt.Errorf("anime not found")
+	}
+
+	// The detail query already aggregates published episode counts per season
+	detail, err := s.repos.Anime.GetAnimeDetail(ctx, animeUUID, "")
+	if err != nil {
+		return nil, err
+	}
+	return detail.Seasons, nil
+}
+
+// UpdateSeason requires MANAGE_CHAPTERS, plus MANAGE_PRICING when prices change
+func (s *AnimeEpisodeService) UpdateSeason(ctx context.Context, seasonID string, req d.UpdateAnimeSeasonRequest, actor d.ContentActor) (*d.AnimeSeasonSummary, error) {
+	seasonUUID, animeUUID, err := s.resolveSeason(ctx, seasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeAnime(ctx, s.repos, animeUUID, actor, m.PermissionManageChapters); err != nil {
+		return nil, err
+	}
+	if req.PriceCoins != nil || req.RentalPriceCoins != nil || req.RentalDurationDays != nil {
+		if err := authorizeAnime(ctx, s.repos, animeUUID, actor, m.PermissionManagePricing); err != nil {
+			return nil, err
+		}
+	}
+
+	season, err := s.repos.AnimeEpisode.UpdateSeason(ctx, seasonUUID, req)
+	if err != nil {
+		return nil, err
+	}
+	return toAnimeSeasonSummary(season, 0), nil
+}
+
+// DeleteSeason deletes a season nobody has bought or rented
+func (s *AnimeEpisodeService) DeleteSeason(ctx context.Context, seasonID string, actor d.ContentActor) error {
+	seasonUUID, animeUUID, err := s.resolveSeason(ctx, seasonID)
+	if err != nil {
+		return err
+	}
+
+	if err := authorizeAnime(ctx, s.repos, animeUUID, actor, m.PermissionManageChapters); err != nil {
+		return err
+	}
+
+	return s.repos.AnimeEpisode.DeleteSeason(ctx, seasonUUID)
+}
+
+// CreateEpisode creates a draft episode
+func (s *AnimeEpisodeService) CreateEpisode(ctx context.Context, seasonID string, req d.CreateAnimeEpisodeRequest, actor d.ContentActor) (*d.AnimeEpisodeResponse, error) {
+	seasonUUID, animeUUID, err := s.resolveSeason(ctx, seasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeAnime(ctx, s.repos, animeUUID, actor, m.PermissionManageChapters); err != nil {
+		return nil, err
+	}
+	if req.PriceCoins != nil {
+		if err := authorizeAnime(ctx, s.repos, animeUUID, actor, m.PermissionManagePricing); err != nil {
+			return nil, err
+		}
+	}
+
+	episode, err := s.repos.AnimeEpisode.CreateEpisode(ctx, seasonUUID, req, actor.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return toAnimeEpisodeResponse(episode, true), nil
+}
+
+// ListPublishedEpisodes lists published episodes without their video URLs unless free
+func (s *AnimeEpisodeService) ListPublishedEpisodes(ctx context.Context, seasonID string, req d.ListAnimeEpisodesRequest) (*d.PaginatedAnimeEpisodesResponse, error) {
+	seasonUUID, animeUUID, err := s.resolveSeason(ctx, seasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	visible, err := s.repos.AnimeEpisode.IsAnimePubliclyVisible(ctx, animeUUID)
+	if err != nil {
+		return nil, err
+	}
+	if !visible {
+		return nil, fmt.Errorf("season not found")
+	}
+
+	return s.listEpisodes(ctx, seasonUUID, false, req)
+}
+
+// ListAllEpisodes lists drafts as well, with video URLs
+func (s *AnimeEpisodeService) ListAllEpisodes(ctx context.Context, seasonID string, req d.ListAnimeEpisodesRequest, actor d.ContentActor) (*d.PaginatedAnimeEpisodesResponse, error) {
+	seasonUUID, animeUUID, err := s.resolveSeason(ctx, seasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeAnime(ctx, s.repos, animeUUID, actor, m.PermissionManageChapters); err != nil {
+		return nil, err
+	}
+
+	return s.listEpisodes(ctx, seasonUUID, true, req)
+}
+
+// GetPublishedEpisode returns a published episode of a publicly visible anime
+func (s *AnimeEpisodeService) GetPublishedEpisode(ctx context.Context, episodeID string) (*d.AnimeEpisodeResponse, error) {
+	episode, _, err := s.resolvePublishedEpisode(ctx, episodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := toAnimeEpisodeResponse(episode, episode.IsPublic)
+	if err := s.attachSubtitles(ctx, response, episode.ID); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// GetEpisodeStream returns the video URL of free episodes, to entitled viewers and to managers
+func (s *AnimeEpisodeService) GetEpisodeStream(ctx context.Context, episodeID string, actor d.ContentActor) (*d.AnimeEpisodeResponse, error) {
+	episodeUUID, err := uuid.Parse(episodeID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid episode ID format: %w", err)
+	}
+
+	animeUUID, err := s.repos.AnimeEpisode.GetEpisodeAnimeID(ctx, episodeUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Owners and collaborators can watch drafts of their own anime
+	canManage, err := s.repos.Anime.CanManageAnime(ctx, animeUUID, actor.UserID, actor.TenantID, m.PermissionRead)
+	if err != nil {
+		return nil, err
+	}
+	if canManage || actor.IsAdmin {
+		episode, err := s.repos.AnimeEpisode.GetEpisodeByID(ctx, episodeUUID)
+		if err != nil {
+			return nil, err
+		}
+		response := toAnimeEpisodeResponse(episode, true)
+		if err := s.attachSubtitles(ctx, response, episode.ID); err != nil {
+			return nil, err
+		}
+		return response, nil
+	}
+
+	episode, _, err := s.resolvePublishedEpisode(ctx, episodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !episode.IsPublic {
+		entitled, err := s.repos.AnimeEpisode.CanWatchEpisode(ctx, episode.ID, actor.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if !entitled {
+			return nil, fmt.Errorf("purchase required: buy the episode or its season, or rent the season or series")
+		}
+	}
+
+	response := toAnimeEpisodeResponse(episode, true)
+	if err := s.attachSubtitles(ctx, response, episode.ID); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// UpdateEpisode requires MANAGE_CHAPTERS, plus MANAGE_PRICING when price or free access change
+func (s *AnimeEpisodeService) UpdateEpisode(ctx context.Context, episodeID string, req d.UpdateAnimeEpisodeRequest, actor d.ContentActor) (*d.AnimeEpisodeResponse, error) {
+	episodeUUID, animeUUID, err := s.resolveEpisode(ctx, episodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeAnime(ctx, s.repos, animeUUID, actor, m.PermissionManageChapters); err != nil {
+		return nil, err
+	}
+	if req.PriceCoins != nil || req.IsPublic != nil {
+		if err := authorizeAnime(ctx, s.repos, animeUUID, actor, m.PermissionManagePricing); err != nil {
+			return nil, err
+		}
+	}
+
+	episode, err := s.repos.AnimeEpisode.UpdateEpisode(ctx, episodeUUID, req, actor.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return toAnimeEpisodeResponse(episode, true), nil
+}
+
+// DeleteEpisode deletes an episode nobody has bought
+func (s *AnimeEpisodeService) DeleteEpisode(ctx context.Context, episodeID string, actor d.ContentActor) error {
+	episodeUUID, animeUUID, err := s.resolveEpisode(ctx, episodeID)
+	if err != nil {
+		return err
+	}
+
+	if err := authorizeAnime(ctx, s.repos, animeUUID, actor, m.PermissionManageChapters); err != nil {
+		return err
+	}
+
+	return s.repos.AnimeEpisode.DeleteEpisode(ctx, episodeUUID)
+}
+
+// PublishEpisode publishes an episode now or at the requested time
+func (s *AnimeEpisodeService) PublishEpisode(ctx context.Context, episodeID string, req d.PublishAnimeEpisodeRequest, actor d.ContentActor) (*d.AnimeEpisodeResponse, error) {
+	episodeUUID, animeUUID, err := s.resolveEpisode(ctx, episodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeAnime(ctx, s.repos, animeUUID, actor, m.PermissionPublish); err != nil {
+		return nil, err
+	}
+
+	publishAt := time.Now()
+	if req.PublishAt != nil {
+		publishAt = *req.PublishAt
+	}
+
+	episode, err := s.repos.AnimeEpisode.PublishEpisode(ctx, episodeUUID, publishAt, actor.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return toAnimeEpisodeResponse(episode, true), nil
+}
+
+// UnpublishEpisode turns an episode back into a draft
+func (s *AnimeEpisodeService) UnpublishEpisode(ctx context.Context, episodeID string, actor d.ContentActor) (*d.AnimeEpisodeResponse, error) {
+	episodeUUID, animeUUID, err := s.resolveEpisode(ctx, episodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeAnime(ctx, s.repos, animeUUID, actor, m.PermissionPublish); err != nil {
+		return nil, err
+	}
+
+	episode, err := s.repos.AnimeEpisode.UnpublishEpisode(ctx, episodeUUID, actor.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return toAnimeEpisodeResponse(episode, true), nil
+}
+
+// SetSubtitle sets the subtitle of one language: an external URL, or uploaded
+// SRT / WebVTT / ASS content that is validated and stored as WebVTT
+func (s *AnimeEpisodeService) SetSubtitle(ctx context.Context, episodeID, languageCode string, req d.SetEpisodeSubtitleRequest, actor d.ContentActor) (*d.EpisodeSubtitleResponse, error) {
+	if languageCode == "" || len(languageCode) > 10 {
+		return nil, fmt.Errorf("invalid language code: %s", languageCode)
+	}
+	if (req.SubtitleURL == "") == (req.Content == "") {
+		return nil, fmt.Errorf("invalid subtitle: provide either subtitle_url or content")
+	}
+
+	episodeUUID, animeUUID, err := s.resolveEpisode(ctx, episodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeAnime(ctx, s.repos, animeUUID, actor, m.PermissionManageChapters); err != nil {
+		return nil, err
+	}
+
+	track := &m.EpisodeSubtitle{EpisodeID: episodeUUID, LanguageCode: languageCode, UploadedByUserID: &actor.UserID}
+	if req.SubtitleURL != "" {
+		track.SubtitleURL = &req.SubtitleURL
+	} else {
+		content, sourceFormat, cueCount, err := normalizeSubtitle(req.Content, req.Format)
+		if err != nil {
+			return nil, err
+		}
+		format := string(sourceFormat)
+		track.Content, track.SourceFormat, track.CueCount = &content, &format, &cueCount
+	}
+
+	saved, err := s.repos.AnimeEpisode.SetSubtitle(ctx, track)
+	if err != nil {
+		return nil, err
+	}
+	return toEpisodeSubtitleResponse(saved), nil
+}
+
+// GetSubtitleFile returns a subtitle track of a published episode, with its WebVTT content when hosted
+func (s *AnimeEpisodeService) GetSubtitleFile(ctx context.Context, episodeID, languageCode string) (*m.EpisodeSubtitle, error) {
+	episode, _, err := s.resolvePublishedEpisode(ctx, episodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	track, err := s.repos.AnimeEpisode.GetSubtitle(ctx, episode.ID, languageCode)
+	if err != nil {
+		return nil, err
+	}
+	if track.Content == nil && track.SubtitleURL == nil {
+		return nil, fmt.Errorf("subtitle not found")
+	}
+	return track, nil
+}
+
+// ShiftSubtitle moves every cue of a hosted subtitle by an offset and stores the result
+func (s *AnimeEpisodeService) ShiftSubtitle(ctx context.Context, episodeID, languageCode string, req d.ShiftEpisodeSubtitleRequest, actor d.ContentActor) (*d.EpisodeSubtitleResponse, error) {
+	episodeUUID, animeUUID, err := s.resolveEpisode(ctx, episodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeAnime(ctx, s.repos, animeUUID, actor, m.PermissionManageChapters); err != nil {
+		return nil, err
+	}
+
+	track, err := s.repos.AnimeEpisode.GetSubtitle(ctx, episodeUUID, languageCode)
+	if err != nil {
+		return nil, err
+	}
+	if track.Content == nil {
+		return nil, fmt.Errorf("invalid subtitle: only uploaded subtitles can be shifted, not external subtitle_url tracks")
+	}
+
+	cues, err := subtitle.Parse(*track.Content, subtitle.FormatWebVTT)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read stored subtitle: %w", err)
+	}
+	if cues, err = subtitle.Shift(cues, time.Duration(req.OffsetMs)*time.Millisecond); err != nil {
+		return nil, err
+	}
+
+	content := subtitle.ToWebVTT(cues)
+	track.Content = &content
+	track.UploadedByUserID = &actor.UserID
+
+	saved, err := s.repos.AnimeEpisode.SetSubtitle(ctx, track)
+	if err != nil {
+		return nil, err
+	}
+	return toEpisodeSubtitleResponse(saved), nil
+}
+
+// DeleteSubtitle removes the subtitle file of one language
+func (s *AnimeEpisodeService) DeleteSubtitle(ctx context.Context, episodeID, languageCode string, actor d.ContentActor) error {
+	episodeUUID, animeUUID, err := s.resolveEpisode(ctx, episodeID)
+	if err != nil {
+		return err
+	}
+
+	if err := authorizeAnime(ctx, s.repos, animeUUID, actor, m.PermissionManageChapters); err != nil {
+		return err
+	}
+
+	return s.repos.AnimeEpisode.DeleteSubtitle(ctx, episodeUUID, languageCode)
+}
+
+// resolveSeason parses a season ID and returns it with its anime ID
+func (s *AnimeEpisodeService) resolveSeason(ctx context.Context, seasonID string) (uuid.UUID, uuid.UUID, error) {
+	seasonUUID, err := uuid.Parse(seasonID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("invalid season ID format: %w", err)
+	}
+
+	animeUUID, err := s.repos.AnimeEpisode.GetSeasonAnimeID(ctx, seasonUUID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	return seasonUUID, animeUUID, nil
+}
+
+// resolveEpisode parses an episode ID and returns it with its anime ID
+func (s *AnimeEpisodeService) resolveEpisode(ctx context.Context, episodeID string) (uuid.UUID, uuid.UUID, error) {
+	episodeUUID, err := uuid.Parse(episodeID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("invalid episode ID format: %w", err)
+	}
+
+	animeUUID, err := s.repos.AnimeEpisode.GetEpisodeAnimeID(ctx, episodeUUID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	return episodeUUID, animeUUID, nil
+}
+
+// resolvePublishedEpisode loads an episode that readers may see: published, of a publicly visible anime
+func (s *AnimeEpisodeService) resolvePublishedEpisode(ctx context.Context, episodeID string) (*m.AnimeEpisode, uuid.UUID, error) {
+	episodeUUID, animeUUID, err := s.resolveEpisode(ctx, episodeID)
+	if err != nil {
+		return nil, uuid.Nil, err
+	}
+
+	visible, err := s.repos.AnimeEpisode.IsAnimePubliclyVisible(ctx, animeUUID)
+	if err != nil {
+		return nil, uuid.Nil, err
+	}
+	if !visible {
+		return nil, uuid.Nil, fmt.Errorf("episode not found")
+	}
+
+	episode, err := s.repos.AnimeEpisode.GetEpisodeByID(ctx, episodeUUID)
+	if err != nil {
+		return nil, uuid.Nil, err
+	}
+	// Scheduled episodes stay hidden until their publish time
+	if episode.IsDraft || (episode.PublishedAt != nil && episode.PublishedAt.After(time.Now())) {
+		return nil, uuid.Nil, fmt.Errorf("episode not found")
+	}
+
+	return episode, animeUUID, nil
+}
+
+// listEpisodes applies pagination defaults and maps the episodes;
+// video URLs of paid episodes are only listed for managers
+func (s *AnimeEpisodeService) listEpisodes(ctx context.Context, seasonID uuid.UUID, includeDrafts bool, req d.ListAnimeEpisodesRequest) (*d.PaginatedAnimeEpisodesResponse, error) {
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 20
+	}
+	if req.PageSize > 100 {
+		req.PageSize = 100
+	}
+
+	episodes, total, err := s.repos.AnimeEpisode.ListEpisodes(ctx, seasonID, includeDrafts, req)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]d.AnimeEpisodeResponse, 0, len(episodes))
+	for i := range episodes {
+		responses = append(responses, *toAnimeEpisodeResponse(&episodes[i], includeDrafts || episodes[i].IsPublic))
+	}
+
+	totalPages := int((total + int64(req.PageSize) - 1) / int64(req.PageSize))
+
+	return &d.PaginatedAnimeEpisodesResponse{
+		Episodes: responses,
+		Pagination: d.PaginationMeta{
+			Page:        req.Page,
+			PageSize:    req.PageSize,
+			Total:       total,
+			TotalPages:  totalPages,
+			HasNext:     req.Page < totalPages,
+			HasPrevious: req.Page > 1,
+		},
+	}, nil
+}
+
+// attachSubtitles adds the subtitle tracks of an episode to the response
+func (s *AnimeEpisodeService) attachSubtitles(ctx context.Context, response *d.AnimeEpisodeResponse, episodeID uuid.UUID) error {
+	subtitles, err := s.repos.AnimeEpisode.ListSubtitles(ctx, episodeID)
+	if err != nil {
+		return err
+	}
+
+	response.Subtitles = make([]d.EpisodeSubtitleResponse, 0, len(subtitles))
+	for i := range subtitles {
+		response.Subtitles = append(response.Subtitles, *toEpisodeSubtitleResponse(&subtitles[i]))
+	}
+	return nil
+}
+
+// normalizeSubtitle parses, validates and converts uploaded subtitle content to WebVTT;
+// an empty format is detected from the content
+func normalizeSubtitle(content, format string) (string, subtitle.Format, int, error) {
+	var sourceFormat subtitle.Format
+	if format != "" {
+		parsed, err := subtitle.ParseFormat(format)
+		if err != nil {
+			return "", "", 0, err
+		}
+		sourceFormat = parsed
+	} else {
+		detected, err := subtitle.DetectFormat(content)
+		if err != nil {
+			return "", "", 0, err
+		}
+		sourceFormat = detected
+	}
+
+	cues, err := subtitle.Parse(content, sourceFormat)
+	if err != nil {
+		return "", "", 0, err
+	}
+	if err := subtitle.Validate(cues); err != nil {
+		return "", "", 0, err
+	}
+
+	return subtitle.ToWebVTT(cues), sourceFormat, len(cues), nil
+}
+
+// toEpisodeSubtitleResponse maps a subtitle track to its response
+func toEpisodeSubtitleResponse(track *m.EpisodeSubtitle) *d.EpisodeSubtitleResponse {
+	return &d.EpisodeSubtitleResponse{
+		LanguageCode: track.LanguageCode,
+		SubtitleURL:  track.SubtitleURL,
+		Hosted:       track.SourceFormat != nil,
+		SourceFormat: track.SourceFormat,
+		CueCount:     track.CueCount,
+	}
+}
+
+// toAnimeSeasonSummary maps a season to its response
+func toAnimeSeasonSummary(season *m.AnimeSeason, episodeCount int) *d.AnimeSeasonSummary {
+	return &d.AnimeSeasonSummary{
+		ID:                 season.ID.String(),
+		SeasonNumber:       season.SeasonNumber,
+		SeasonTitle:        season.SeasonTitle,
+		PriceCoins:         season.PriceCoins,
+		RentalPriceCoins:   season.RentalPriceCoins,
+		RentalDurationDays: season.RentalDurationDays,
+		EpisodeCount:       episodeCount,
+	}
+}
+
+// toAnimeEpisodeResponse maps an episode to its response; the video URL is only kept when includeVideo is true
+func toAnimeEpisodeResponse(episode *m.AnimeEpisode, includeVideo bool) *d.AnimeEpisodeResponse {
+	response := &d.AnimeEpisodeResponse{
+		ID:              episode.ID.String(),
+		SeasonID:        episode.SeasonID.String(),
+		EpisodeNumber:   episode.EpisodeNumber,
+		Title:           episode.Title,
+		DurationSeconds: episode.DurationSeconds,
+		IsPublic:        episode.IsPublic,
+		PriceCoins:      episode.PriceCoins,
+		IsDraft:         episode.IsDraft,
+		PublishedAt:     episode.PublishedAt,
+		CreatedAt:       episode.CreatedAt,
+		UpdatedAt:       episode.UpdatedAt,
+	}
+	if includeVideo {
+		response.VideoURL = episode.VideoURL
+	}
+	return response
+}
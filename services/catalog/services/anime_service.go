@@ -0,0 +1,151 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+	"wibusystem/services/catalog/repositories"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// AnimeService implements anime series business logic
+type AnimeService struct {
+	repos *repositories.Repositories
+}
+
+// NewAnimeService creates a new anime service
+func NewAnimeService(repos *repositories.Repositories) interfaces.AnimeServiceInterface {
+	return &AnimeService{
+		repos: repos,
+	}
+}
+
+// CreateAnime sets the owner from the actor and creates the anime
+func (s *AnimeService) CreateAnime(ctx context.Context, req d.CreateAnimeRequest, actor d.ContentActor) (*m.Anime, error) {
+	// PERSONAL/COLLABORATIVE anime belong to the user, TENANT anime to the current tenant
+	switch req.OwnershipType {
+	case "TENANT":
+		if actor.TenantID == nil {
+			return nil, fmt.Errorf("invalid ownership: tenant context required for TENANT ownership")
+		}
+		req.PrimaryOwnerID = *actor.TenantID
+	default:
+		req.PrimaryOwnerID = actor.UserID
+	}
+	req.OriginalCreatorID = actor.UserID
+
+	return s.repos.Anime.CreateAnime(ctx, req)
+}
+
+// ListAnime lists publicly visible anime
+func (s *AnimeService) ListAnime(ctx context.Context, req d.ListAnimeRequest, language string) (*d.PaginatedAnimeResponse, error) {
+	return s.repos.Anime.ListAnime(ctx, req, language)
+}
+
+// GetAnimeByID returns the detail of a publicly visible anime
+func (s *AnimeService) GetAnimeByID(ctx context.Context, id string, language string) (*d.AnimeDetailResponse, error) {
+	animeUUID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid anime ID format: %w", err)
+	}
+
+	visible, err := s.repos.AnimeEpisode.IsAnimePubliclyVisible(ctx, animeUUID)
+	if err != nil {
+		return nil, err
+	}
+	if !visible {
+		return nil, fmt.Errorf("anime not found")
+	}
+
+	return s.repos.Anime.GetAnimeDetail(ctx, animeUUID, language)
+}
+
+// UpdateAnime requires EDIT, plus PUBLISH when visibility changes
+func (s *AnimeService) UpdateAnime(ctx context.Context, id string, req d.UpdateAnimeRequest, actor d.ContentActor) (*m.Anime, error) {
+	animeUUID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid anime ID format: %w", err)
+	}
+
+	if err := authorizeAnime(ctx, s.repos, animeUUID, actor, m.PermissionEdit); err != nil {
+		return nil, err
+	}
+	if req.IsPublic != nil || req.AccessLevel != nil {
+		if err := authorizeAnime(ctx, s.repos, animeUUID, actor, m.PermissionPublish); err != nil {
+			return nil, err
+		}
+	}
+
+	req.LastModifiedByUserID = actor.UserID
+	return s.repos.Anime.UpdateAnime(ctx, animeUUID, req)
+}
+
+// DeleteAnime soft-deletes an anime nobody has bought or rented content from
+func (s *AnimeService) DeleteAnime(ctx context.Context, id string, actor d.ContentActor) error {
+	animeUUID, err := uuid.Parse(id)
+	if err != nil {
+		return fmt.Errorf("invalid anime ID format: %w", err)
+	}
+
+	if err := authorizeAnime(ctx, s.repos, animeUUID, actor, m.PermissionDelete); err != nil {
+		return err
+	}
+
+	return s.repos.Anime.DeleteAnime(ctx, animeUUID, actor.UserID)
+}
+
+// SetTranslations replaces the localized titles of an anime
+func (s *AnimeService) SetTranslations(ctx context.Context, id string, req d.SetAnimeTranslationsRequest, actor d.ContentActor) error {
+	animeUUID, err := uuid.Parse(id)
+	if err != nil {
+		return fmt.Errorf("invalid anime ID format: %w", err)
+	}
+
+	// The partial unique index only allows one primary title per language
+	primaries := make(map[string]bool)
+	for _, translation := range req.Translations {
+		if !translation.IsPrimary {
+			continue
+		}
+		if primaries[translation.LanguageCode] {
+			return fmt.Errorf("invalid translations: more than one primary title for language %s", translation.LanguageCode)
+		}
+		primaries[translation.LanguageCode] = true
+	}
+
+	if err := authorizeAnime(ctx, s.repos, animeUUID, actor, m.PermissionEdit); err != nil {
+		return err
+	}
+
+	return s.repos.Anime.SetTranslations(ctx, animeUUID, req.Translations)
+}
+
+// SetCast replaces the characters and voice actors of an anime
+func (s *AnimeService) SetCast(ctx context.Context, id string, req d.SetAnimeCastRequest, actor d.ContentActor) error {
+	animeUUID, err := uuid.Parse(id)
+	if err != nil {
+		return fmt.Errorf("invalid anime ID format: %w", err)
+	}
+
+	if err := authorizeAnime(ctx, s.repos, animeUUID, actor, m.PermissionEdit); err != nil {
+		return err
+	}
+
+	return s.repos.Anime.SetCast(ctx, animeUUID, req.Cast)
+}
+
+// authorizeAnime lets admins, owners and collaborators holding the permission act on an anime
+func authorizeAnime(ctx context.Context, repos *repositories.Repositories, animeID uuid.UUID, actor d.ContentActor, permission string) error {
+	allowed, err := repos.Anime.CanManageAnime(ctx, animeID, actor.UserID, actor.TenantID, permission)
+	if err != nil {
+		return err
+	}
+	if !allowed && !actor.IsAdmin {
+		return fmt.Errorf("permission denied: %s on this anime is restricted to owners and collaborators", permission)
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+	"wibusystem/services/catalog/repositories"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// BookmarkService implements reader bookmark logic
+type BookmarkService struct {
+	repos *repositories.Repositories
+}
+
+// NewBookmarkService creates a new bookmark service
+func NewBookmarkService(repos *repositories.Repositories) interfaces.BookmarkServiceInterface {
+	return &BookmarkService{
+		repos: repos,
+	}
+}
+
+// AddBookmark bookmarks a novel and records the engagement for trending
+func (s *BookmarkService) AddBookmark(ctx context.Context, userID uuid.UUID, novelID string) (*d.BookmarkResponse, error) {
+	novelUUID, err := uuid.Parse(novelID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid novel ID format: %w", err)
+	}
+
+	created, err := s.repos.Bookmark.AddBookmark(ctx, userID, novelUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Record the bookmark for trending; the bookmark is already saved, so a stats failure must not fail it
+	if created {
+		if err := s.repos.Ranking.IncrementDailyStats(ctx, m.NovelDailyStats{NovelID: novelUUID, BookmarkCount: 1}); err != nil {
+			log.Printf("failed to record bookmark for %s: %v", novelUUID, err)
+		}
+	}
+
+	return &d.BookmarkResponse{NovelID: novelUUID.String(), Bookmarked: true}, nil
+}
+
+// RemoveBookmark removes the reader's bookmark
+func (s *BookmarkService) RemoveBookmark(ctx context.Context, userID uuid.UUID, novelID string) (*d.BookmarkResponse, error) {
+	novelUUID, err := uuid.Parse(novelID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid novel ID format: %w", err)
+	}
+
+	if _, err := s.repos.Bookmark.RemoveBookmark(ctx, userID, novelUUID); err != nil {
+		return nil, err
+	}
+
+	return &d.BookmarkResponse{NovelID: novelUUID.String(), Bookmarked: false}, nil
+}
@@ -0,0 +1,305 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+	"wibusystem/services/catalog/repositories"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// ChapterOrderService implements reordering and moving chapters and splitting and merging volumes
+type ChapterOrderService struct {
+	repos *repositories.Repositories
+}
+
+// NewChapterOrderService creates a new chapter order service
+func NewChapterOrderService(repos *repositories.Repositories) interfaces.ChapterOrderServiceInterface {
+	return &ChapterOrderService{
+		repos: repos,
+	}
+}
+
+// ReorderChapters renumbers the chapters of a volume 1..n in the given order
+func (s *ChapterOrderService) ReorderChapters(ctx context.Context, volumeID string, req d.ReorderChaptersRequest, actor d.ContentActor) (*d.ChapterRestructureResponse, error) {
+	volumeUUID, err := uuid.Parse(volumeID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid volume ID format: %w", err)
+	}
+	chapterIDs, err := parseChapterIDs(req.ChapterIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	novelID, err := s.authorizeVolume(ctx, volumeUUID, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := s.repos.ChapterOrder.ListVolumeChapterIDs(ctx, volumeUUID)
+	if err != nil {
+		return nil, err
+	}
+	if len(current) != len(chapterIDs) {
+		return nil, fmt.Errorf("invalid chapter order: expected all %d chapters of the volume, got %d", len(current), len(chapterIDs))
+	}
+	inVolume := make(map[uuid.UUID]bool, len(current))
+	for _, id := range current {
+		inVolume[id] = true
+	}
+	for _, id := range chapterIDs {
+		if !inVolume[id] {
+			return nil, fmt.Errorf("invalid chapter order: chapter %s does not belong to this volume", id)
+		}
+	}
+
+	orders := []d.VolumeChapterOrder{{VolumeID: volumeUUID, ChapterIDs: chapterIDs}}
+	if err := s.repos.ChapterOrder.ApplyChapterOrder(ctx, novelID, actor.UserID, orders); err != nil {
+		return nil, err
+	}
+	reconcileNovelCounters(ctx, s.repos, novelID)
+
+	return s.buildResponse(ctx, novelID, []uuid.UUID{volumeUUID})
+}
+
+// MoveChapters moves chapters of the same novel into the target volume at the given position,
+// keeping their relative order; the source volumes close the gaps they leave
+func (s *ChapterOrderService) MoveChapters(ctx context.Context, targetVolumeID string, req d.MoveChaptersRequest, actor d.ContentActor) (*d.ChapterRestructureResponse, error) {
+	targetUUID, err := uuid.Parse(targetVolumeID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid volume ID format: %w", err)
+	}
+	chapterIDs, err := parseChapterIDs(req.ChapterIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	novelID, err := s.authorizeVolume(ctx, targetUUID, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	chapterVolumes, volumeNovels, err := s.repos.ChapterOrder.GetChapterVolumes(ctx, chapterIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	moving := make(map[uuid.UUID]bool, len(chapterIDs))
+	volumeIDs := []uuid.UUID{targetUUID}
+	touched := map[uuid.UUID]bool{targetUUID: true}
+	for _, id := range chapterIDs {
+		volumeID, ok := chapterVolumes[id]
+		if !ok {
+			return nil, fmt.Errorf("chapter %s not found", id)
+		}
+		if volumeNovels[volumeID] != novelID {
+			return nil, fmt.Errorf("invalid move: chapter %s belongs to another novel", id)
+		}
+		moving[id] = true
+		if !touched[volumeID] {
+			touched[volumeID] = true
+			volumeIDs = append(volumeIDs, volumeID)
+		}
+	}
+
+	orders := make([]d.VolumeChapterOrder, 0, len(volumeIDs))
+	for _, volumeID := range volumeIDs {
+		current, err := s.repos.ChapterOrder.ListVolumeChapterIDs(ctx, volumeID)
+		if err != nil {
+			return nil, err
+		}
+		remaining := make([]uuid.UUID, 0, len(current))
+		for _, id := range current {
+			if !moving[id] {
+				remaining = append(remaining, id)
+			}
+		}
+
+		if volumeID == targetUUID {
+			index := len(remaining)
+			if req.Position != nil && *req.Position-1 < index {
+				index = *req.Position - 1
+			}
+			if index < 0 {
+				return nil, fmt.Errorf("invalid position: must be at least 1")
+			}
+			inserted := make([]uuid.UUID, 0, len(remaining)+len(chapterIDs))
+			inserted = append(inserted, remaining[:index]...)
+			inserted = append(inserted, chapterIDs...)
+			remaining = append(inserted, remaining[index:]...)
+		}
+		orders = append(orders, d.VolumeChapterOrder{VolumeID: volumeID, ChapterIDs: remaining})
+	}
+
+	if err := s.repos.ChapterOrder.ApplyChapterOrder(ctx, novelID, actor.UserID, orders); err != nil {
+		return nil, err
+	}
+	reconcileNovelCounters(ctx, s.repos, novelID)
+
+	return s.buildResponse(ctx, novelID, volumeIDs)
+}
+
+// SplitVolume moves the given chapter and every chapter after it into a new volume
+func (s *ChapterOrderService) SplitVolume(ctx context.Context, volumeID string, req d.SplitVolumeRequest, actor d.ContentActor) (*d.ChapterRestructureResponse, error) {
+	volumeUUID, err := uuid.Parse(volumeID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid volume ID format: %w", err)
+	}
+	fromUUID, err := uuid.Parse(req.FromChapterID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid chapter ID format: %w", err)
+	}
+	if req.VolumeNumber < 1 {
+		return nil, fmt.Errorf("invalid volume number: must be at least 1")
+	}
+
+	novelID, err := s.authorizeVolume(ctx, volumeUUID, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := s.repos.ChapterOrder.ListVolumeChapterIDs(ctx, volumeUUID)
+	if err != nil {
+		return nil, err
+	}
+	split := -1
+	for i, id := range current {
+		if id == fromUUID {
+			split = i
+			break
+		}
+	}
+	if split < 0 {
+		return nil, fmt.Errorf("invalid split point: chapter %s does not belong to this volume", fromUUID)
+	}
+	if split == 0 {
+		return nil, fmt.Errorf("invalid split point: the first chapter cannot start a new volume")
+	}
+
+	createdID, err := s.repos.ChapterOrder.SplitVolume(ctx, novelID, actor.UserID, volumeUUID, req, current[:split], current[split:])
+	if err != nil {
+		return nil, err
+	}
+	reconcileNovelCounters(ctx, s.repos, novelID)
+
+	response, err := s.buildResponse(ctx, novelID, []uuid.UUID{volumeUUID, createdID})
+	if err != nil {
+		return nil, err
+	}
+	created := createdID.String()
+	response.CreatedVolumeID = &created
+	return response, nil
+}
+
+// MergeVolume appends the chapters of the volume in the URL to the target volume and moves the
+// emptied volume to the trash
+func (s *ChapterOrderService) MergeVolume(ctx context.Context, volumeID string, req d.MergeVolumeRequest, actor d.ContentActor) (*d.ChapterRestructureResponse, error) {
+	sourceUUID, err := uuid.Parse(volumeID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid volume ID format: %w", err)
+	}
+	targetUUID, err := uuid.Parse(req.TargetVolumeID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid target volume ID format: %w", err)
+	}
+	if sourceUUID == targetUUID {
+		return nil, fmt.Errorf("invalid merge: a volume cannot be merged into itself")
+	}
+
+	novelID, err := s.authorizeVolume(ctx, sourceUUID, actor)
+	if err != nil {
+		return nil, err
+	}
+	targetNovelID, err := s.repos.ChapterOrder.GetVolumeNovelID(ctx, targetUUID)
+	if err != nil {
+		return nil, err
+	}
+	if targetNovelID != novelID {
+		return nil, fmt.Errorf("invalid merge: volumes belong to different novels")
+	}
+
+	targetChapters, err := s.repos.ChapterOrder.ListVolumeChapterIDs(ctx, targetUUID)
+	if err != nil {
+		return nil, err
+	}
+	sourceChapters, err := s.repos.ChapterOrder.ListVolumeChapterIDs(ctx, sourceUUID)
+	if err != nil {
+		return nil, err
+	}
+	targetOrder := append(targetChapters, sourceChapters...)
+
+	if err := s.repos.ChapterOrder.MergeVolumes(ctx, novelID, actor.UserID, sourceUUID, targetUUID, targetOrder); err != nil {
+		return nil, err
+	}
+	reconcileNovelCounters(ctx, s.repos, novelID)
+
+	response, err := s.buildResponse(ctx, novelID, []uuid.UUID{targetUUID})
+	if err != nil {
+		return nil, err
+	}
+	deleted := sourceUUID.String()
+	response.DeletedVolumeID = &deleted
+	return response, nil
+}
+
+// authorizeVolume resolves the volume's novel and checks the MANAGE_CHAPTERS permission on it
+func (s *ChapterOrderService) authorizeVolume(ctx context.Context, volumeID uuid.UUID, actor d.ContentActor) (uuid.UUID, error) {
+	novelID, err := s.repos.ChapterOrder.GetVolumeNovelID(ctx, volumeID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if err := authorizeNovel(ctx, s.repos, novelID, actor, m.PermissionManageChapters); err != nil {
+		return uuid.Nil, err
+	}
+	return novelID, nil
+}
+
+// buildResponse lists the new order of the touched volumes
+func (s *ChapterOrderService) buildResponse(ctx context.Context, novelID uuid.UUID, volumeIDs []uuid.UUID) (*d.ChapterRestructureResponse, error) {
+	response := &d.ChapterRestructureResponse{
+		NovelID: novelID.String(),
+		Volumes: make([]d.RestructuredVolume, 0, len(volumeIDs)),
+	}
+	for _, volumeID := range volumeIDs {
+		chapterIDs, err := s.repos.ChapterOrder.ListVolumeChapterIDs(ctx, volumeID)
+		if err != nil {
+			return nil, err
+		}
+		ids := make([]string, len(chapterIDs))
+		for i, id := range chapterIDs {
+			ids[i] = id.String()
+		}
+		response.Volumes = append(response.Volumes, d.RestructuredVolume{
+			VolumeID:   volumeID.String(),
+			ChapterIDs: ids,
+		})
+	}
+	return response, nil
+}
+
+// parseChapterIDs parses a list of chapter IDs, rejecting duplicates since each chapter can
+// hold only one position
+func parseChapterIDs(ids []string) ([]uuid.UUID, error) {
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("invalid chapter list: at least one chapter is required")
+	}
+
+	seen := make(map[uuid.UUID]bool, len(ids))
+	parsed := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		chapterID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid chapter ID format: %w", err)
+		}
+		if seen[chapterID] {
+			return nil, fmt.Errorf("invalid chapter order: chapter %s is listed twice", chapterID)
+		}
+		seen[chapterID] = true
+		parsed = append(parsed, chapterID)
+	}
+	return parsed, nil
+}
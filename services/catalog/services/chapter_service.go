@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
 
@@ -34,6 +35,12 @@ func (s *ChapterService) CreateChapter(ctx context.Context, volumeID string, req
 		return nil, fmt.Errorf("invalid volume ID format: %w", err)
 	}
 
+	// Content warnings must be active codes of the warning taxonomy
+	req.ContentWarnings, err = normalizeContentWarnings(ctx, s.repos, req.ContentWarnings, nil)
+	if err != nil {
+		return nil, err
+	}
+
 	// Delegate to repository
 	chapter, err := s.repos.Chapter.CreateChapter(ctx, volumeUUID, req)
 	if err != nil {
@@ -56,7 +63,9 @@ func (s *ChapterService) CreateChapter(ctx context.Context, volumeID string, req
 
 // GetChapterByID retrieves a specific chapter by its ID.
 // Validates chapter UUID format and controls content inclusion based on the flag.
-func (s *ChapterService) GetChapterByID(ctx context.Context, id string, includeContent bool) (*d.ChapterResponse, error) {
+// Title and content are served in the first of the preferred languages that has a translation,
+// falling back to the novel's original language.
+func (s *ChapterService) GetChapterByID(ctx context.Context, id string, includeContent bool, languages []string) (*d.ChapterResponse, error) {
 	// Parse and validate chapter UUID
 	chapterUUID, err := uuid.Parse(id)
 	if err != nil {
@@ -104,9 +113,55 @@ func (s *ChapterService) GetChapterByID(ctx context.Context, id string, includeC
 		}
 	}
 
+	if err := s.localizeChapter(ctx, response, chapterUUID, includeContent, languages); err != nil {
+		return nil, err
+	}
+
 	return response, nil
 }
 
+// localizeChapter swaps the title, content and content metadata for the translation in the best
+// accepted language and reports the served and available languages.
+func (s *ChapterService) localizeChapter(ctx context.Context, response *d.ChapterResponse, chapterID uuid.UUID, includeContent bool, languages []string) error {
+	_, original, err := s.repos.Translation.GetChapterLanguage(ctx, chapterID)
+	if err != nil {
+		return err
+	}
+
+	translations, err := s.repos.Translation.ListChapterTranslations(ctx, chapterID, false)
+	if err != nil {
+		return err
+	}
+
+	codes := make([]string, 0, len(translations))
+	for _, translation := range translations {
+		codes = append(codes, translation.LanguageCode)
+	}
+
+	served := pickContentLanguage(languages, original, codes)
+	response.ServedLanguage = served
+	response.AvailableLanguages = availableContentLanguages(original, codes)
+	if served == strings.ToLower(original) {
+		return nil
+	}
+
+	translation, err := s.repos.Translation.GetChapterTranslation(ctx, chapterID, served)
+	if err != nil {
+		return err
+	}
+	if translation.Title != nil {
+		response.Title = translation.Title
+	}
+	response.WordCount = translation.WordCount
+	response.CharacterCount = translation.CharacterCount
+	response.ReadingTimeMinutes = translation.ReadingTimeMinutes
+	if includeContent {
+		response.Content = translation.Content
+	}
+
+	return nil
+}
+
 // ListChaptersByVolumeID retrieves a paginated list of chapters in a volume.
 // Validates volume UUID format and applies pagination settings.
 func (s *ChapterService) ListChaptersByVolumeID(ctx context.Context, volumeID string, req d.ListChaptersRequest) (*d.PaginatedChaptersResponse, error) {
@@ -145,6 +200,18 @@ func (s *ChapterService) UpdateChapter(ctx context.Context, id string, req d.Upd
 		return nil, fmt.Errorf("invalid chapter ID format: %w", err)
 	}
 
+	// Content warnings must be taxonomy codes; retired ones the chapter already carries may stay
+	if req.ContentWarnings != nil {
+		current, err := s.repos.Chapter.GetChapterByID(ctx, chapterUUID, false)
+		if err != nil {
+			return nil, err
+		}
+		req.ContentWarnings, err = normalizeContentWarnings(ctx, s.repos, req.ContentWarnings, decodeContentWarnings(current.ContentWarnings))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Delegate to repository
 	chapter, err := s.repos.Chapter.UpdateChapter(ctx, chapterUUID, req)
 	if err != nil {
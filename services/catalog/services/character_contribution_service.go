@@ -0,0 +1,417 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+	"wibusystem/services/catalog/repositories"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// CharacterContributionService implements the character proposal and review workflow
+type CharacterContributionService struct {
+	repos *repositories.Repositories
+}
+
+// NewCharacterContributionService creates a new character contribution service
+func NewCharacterContributionService(repos *repositories.Repositories) interfaces.CharacterContributionServiceInterface {
+	return &CharacterContributionService{
+		repos: repos,
+	}
+}
+
+// CreateContribution validates and stores a proposal for a new character or an edit
+func (s *CharacterContributionService) CreateContribution(ctx context.Context, userID uuid.UUID, tenantID *uuid.UUID, req d.CreateCharacterContributionRequest) (*d.CharacterContributionResponse, error) {
+	contribution := &m.CharacterContribution{
+		ContributionType: m.CharacterContributionCreate,
+		UserID:           userID,
+		TenantID:         tenantID,
+	}
+
+	if req.CharacterID != nil && *req.CharacterID != "" {
+		characterID, err := uuid.Parse(*req.CharacterID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid character ID format: %w", err)
+		}
+		if _, err := s.repos.Character.GetByID(ctx, characterID); err != nil {
+			if strings.Contains(err.Error(), "no rows") {
+				return nil, fmt.Errorf("character not found")
+			}
+			return nil, err
+		}
+		contribution.ContributionType = m.CharacterContributionUpdate
+		contribution.CharacterID = &characterID
+	}
+
+	if err := s.applyProposal(ctx, contribution, req.Name, req.Description, req.ImageURL, req.Translations, req.AddNovelIDs, req.RemoveNovelIDs, req.Note); err != nil {
+		return nil, err
+	}
+
+	if err := s.repos.CharacterContribution.Create(ctx, contribution); err != nil {
+		return nil, err
+	}
+
+	return s.withDiff(ctx, contribution)
+}
+
+// UpdateContribution lets a contributor revise their own pending proposal
+func (s *CharacterContributionService) UpdateContribution(ctx context.Context, contributionID string, userID uuid.UUID, req d.UpdateCharacterContributionRequest) (*d.CharacterContributionResponse, error) {
+	contribution, err := s.getContribution(ctx, contributionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if contribution.UserID != userID {
+		return nil, fmt.Errorf("permission denied: only the contributor can update this contribution")
+	}
+	if contribution.Status != m.CharacterContributionPending {
+		return nil, fmt.Errorf("character contribution already reviewed")
+	}
+
+	if err := s.applyProposal(ctx, contribution, req.Name, req.Description, req.ImageURL, req.Translations, req.AddNovelIDs, req.RemoveNovelIDs, req.Note); err != nil {
+		return nil, err
+	}
+
+	if err := s.repos.CharacterContribution.UpdatePending(ctx, contribution); err != nil {
+		return nil, err
+	}
+
+	return s.withDiff(ctx, contribution)
+}
+
+// GetContribution returns a contribution with its diff to its contributor or a reviewer
+func (s *CharacterContributionService) GetContribution(ctx context.Context, contributionID string, userID uuid.UUID, canReview bool) (*d.CharacterContributionResponse, error) {
+	contribution, err := s.getContribution(ctx, contributionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if contribution.UserID != userID && !canReview {
+		return nil, fmt.Errorf("permission denied: contribution belongs to another user")
+	}
+
+	return s.withDiff(ctx, contribution)
+}
+
+// ListContributions returns the review queue; pending contributions by default
+func (s *CharacterContributionService) ListContributions(ctx context.Context, req d.ListCharacterContributionsRequest) (*d.PaginatedCharacterContributionsResponse, error) {
+	if req.Status == "" {
+		req.Status = m.CharacterContributionPending
+	}
+
+	return s.list(ctx, req, nil)
+}
+
+// ListMyContributions returns the contributions of one user in every status
+func (s *CharacterContributionService) ListMyContributions(ctx context.Context, userID uuid.UUID, req d.ListCharacterContributionsRequest) (*d.PaginatedCharacterContributionsResponse, error) {
+	return s.list(ctx, req, &userID)
+}
+
+// ApproveContribution merges a pending proposal into the character
+func (s *CharacterContributionService) ApproveContribution(ctx context.Context, contributionID string, reviewerID uuid.UUID) (*d.CharacterContributionResponse, error) {
+	contributionUUID, err := uuid.Parse(contributionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid contribution ID format: %w", err)
+	}
+
+	if _, err := s.repos.CharacterContribution.Approve(ctx, contributionUUID, reviewerID); err != nil {
+		return nil, err
+	}
+
+	contribution, err := s.repos.CharacterContribution.GetByID(ctx, contributionUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The proposal is now part of the record, so there is nothing left to diff
+	return &d.CharacterContributionResponse{
+		CharacterContribution: *contribution,
+		Diff:                  []d.CharacterFieldDiff{},
+	}, nil
+}
+
+// RejectContribution closes a pending proposal with a reason for the contributor
+func (s *CharacterContributionService) RejectContribution(ctx context.Context, contributionID string, reviewerID uuid.UUID, req d.RejectCharacterContributionRequest) error {
+	contributionUUID, err := uuid.Parse(contributionID)
+	if err != nil {
+		return fmt.Errorf("invalid contribution ID format: %w", err)
+	}
+
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		return fmt.Errorf("rejection reason is required")
+	}
+	if len(reason) > 1000 {
+		return fmt.Errorf("invalid rejection reason: must not exceed 1000 characters")
+	}
+
+	return s.repos.CharacterContribution.Reject(ctx, contributionUUID, reviewerID, reason)
+}
+
+// ListContributors returns the users credited for a character
+func (s *CharacterContributionService) ListContributors(ctx context.Context, characterID uuid.UUID) ([]d.CharacterContributorResponse, error) {
+	return s.repos.CharacterContribution.ListContributors(ctx, characterID)
+}
+
+// list reads a page of contributions and attaches their diffs
+func (s *CharacterContributionService) list(ctx context.Context, req d.ListCharacterContributionsRequest, userID *uuid.UUID) (*d.PaginatedCharacterContributionsResponse, error) {
+	if req.Status != "" && req.Status != m.CharacterContributionPending &&
+		req.Status != m.CharacterContributionApproved && req.Status != m.CharacterContributionRejected {
+		return nil, fmt.Errorf("invalid contribution status: %s", req.Status)
+	}
+
+	contributions, pagination, err := s.repos.CharacterContribution.List(ctx, req, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &d.PaginatedCharacterContributionsResponse{
+		Contributions: make([]d.CharacterContributionResponse, 0, len(contributions)),
+		Pagination:    *pagination,
+	}
+
+	for i := range contributions {
+		item, err := s.withDiff(ctx, &contributions[i])
+		if err != nil {
+			return nil, err
+		}
+		response.Contributions = append(response.Contributions, *item)
+	}
+
+	return response, nil
+}
+
+// getContribution parses the ID and loads the contribution
+func (s *CharacterContributionService) getContribution(ctx context.Context, contributionID string) (*m.CharacterContribution, error) {
+	contributionUUID, err := uuid.Parse(contributionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid contribution ID format: %w", err)
+	}
+
+	return s.repos.CharacterContribution.GetByID(ctx, contributionUUID)
+}
+
+// applyProposal validates the proposed values and copies them onto the contribution
+func (s *CharacterContributionService) applyProposal(ctx context.Context, contribution *m.CharacterContribution, name, description, imageURL *string, translations []d.CharacterTranslationInput, addNovelIDs, removeNovelIDs []string, note *string) error {
+	contribution.Name = nil
+	if name != nil {
+		trimmed := strings.TrimSpace(*name)
+		if trimmed == "" {
+			return fmt.Errorf("character name is required")
+		}
+		if len(trimmed) > 255 {
+			return fmt.Errorf("character name must not exceed 255 characters")
+		}
+		contribution.Name = &trimmed
+	}
+	if contribution.ContributionType == m.CharacterContributionCreate && contribution.Name == nil {
+		return fmt.Errorf("character name is required")
+	}
+
+	if description != nil && len(*description) > 1000 {
+		return fmt.Errorf("invalid description: must not exceed 1000 characters")
+	}
+	if note != nil && len(*note) > 1000 {
+		return fmt.Errorf("invalid note: must not exceed 1000 characters")
+	}
+	contribution.Description = description
+	contribution.ImageURL = imageURL
+	contribution.Note = note
+
+	contribution.Translations = nil
+	seenLanguages := make(map[string]bool)
+	for _, translation := range translations {
+		languageCode := strings.TrimSpace(translation.LanguageCode)
+		if len(languageCode) < 2 || len(languageCode) > 5 {
+			return fmt.Errorf("invalid language code: %s", translation.LanguageCode)
+		}
+		if seenLanguages[languageCode] {
+			return fmt.Errorf("invalid translations: duplicate language code %s", languageCode)
+		}
+		if strings.TrimSpace(translation.Description) == "" || len(translation.Description) > 1000 {
+			return fmt.Errorf("invalid translation description for language %s", languageCode)
+		}
+		seenLanguages[languageCode] = true
+		contribution.Translations = append(contribution.Translations, m.CharacterTranslation{
+			LanguageCode: languageCode,
+			Description:  translation.Description,
+		})
+	}
+
+	var err error
+	if contribution.AddNovelIDs, err = parseNovelIDs(addNovelIDs); err != nil {
+		return err
+	}
+	if contribution.RemoveNovelIDs, err = parseNovelIDs(removeNovelIDs); err != nil {
+		return err
+	}
+	if contribution.ContributionType == m.CharacterContributionCreate && len(contribution.RemoveNovelIDs) > 0 {
+		return fmt.Errorf("invalid contribution: a new character has no novel appearances to remove")
+	}
+
+	if contribution.Name == nil && contribution.Description == nil && contribution.ImageURL == nil &&
+		len(contribution.Translations) == 0 && len(contribution.AddNovelIDs) == 0 && len(contribution.RemoveNovelIDs) == 0 {
+		return fmt.Errorf("invalid contribution: no changes proposed")
+	}
+
+	// Reject name clashes early; the unique constraint still guards the merge
+	if contribution.Name != nil {
+		existing, err := s.repos.Character.GetByName(ctx, *contribution.Name)
+		if err != nil && !strings.Contains(err.Error(), "no rows") {
+			return fmt.Errorf("failed to check character existence: %w", err)
+		}
+		if existing != nil && (contribution.CharacterID == nil || existing.ID != *contribution.CharacterID) {
+			return fmt.Errorf("character with name '%s' already exists", *contribution.Name)
+		}
+	}
+
+	return nil
+}
+
+// withDiff compares the proposal with the current character record field by field
+func (s *CharacterContributionService) withDiff(ctx context.Context, contribution *m.CharacterContribution) (*d.CharacterContributionResponse, error) {
+	response := &d.CharacterContributionResponse{
+		CharacterContribution: *contribution,
+		Diff:                  []d.CharacterFieldDiff{},
+	}
+
+	// Reviewed contributions are already merged or discarded
+	if contribution.Status != m.CharacterContributionPending {
+		return response, nil
+	}
+
+	current := &m.Character{}
+	currentTranslations := []m.CharacterTranslation{}
+	currentNovelIDs := []uuid.UUID{}
+
+	if contribution.CharacterID != nil {
+		character, err := s.repos.Character.GetByID(ctx, *contribution.CharacterID)
+		if err != nil {
+			if strings.Contains(err.Error(), "no rows") {
+				return nil, fmt.Errorf("character not found")
+			}
+			return nil, err
+		}
+		current = character
+
+		if currentTranslations, err = s.repos.CharacterContribution.GetTranslations(ctx, character.ID); err != nil {
+			return nil, err
+		}
+		if currentNovelIDs, err = s.repos.CharacterContribution.GetNovelIDs(ctx, character.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	if contribution.Name != nil && *contribution.Name != current.Name {
+		var currentName interface{}
+		if contribution.CharacterID != nil {
+			currentName = current.Name
+		}
+		response.Diff = append(response.Diff, d.CharacterFieldDiff{Field: "name", Current: currentName, Proposed: *contribution.Name})
+	}
+	if diff, changed := diffOptionalString("description", current.Description, contribution.Description); changed {
+		response.Diff = append(response.Diff, diff)
+	}
+	if diff, changed := diffOptionalString("image_url", current.ImageURL, contribution.ImageURL); changed {
+		response.Diff = append(response.Diff, diff)
+	}
+
+	translationByLanguage := make(map[string]string, len(currentTranslations))
+	for _, translation := range currentTranslations {
+		translationByLanguage[translation.LanguageCode] = translation.Description
+	}
+	for _, translation := range contribution.Translations {
+		existing, ok := translationByLanguage[translation.LanguageCode]
+		if ok && existing == translation.Description {
+			continue
+		}
+		var currentValue interface{}
+		if ok {
+			currentValue = existing
+		}
+		response.Diff = append(response.Diff, d.CharacterFieldDiff{
+			Field:    "translations." + translation.LanguageCode,
+			Current:  currentValue,
+			Proposed: translation.Description,
+		})
+	}
+
+	if len(contribution.AddNovelIDs) > 0 || len(contribution.RemoveNovelIDs) > 0 {
+		removed := make(map[uuid.UUID]bool, len(contribution.RemoveNovelIDs))
+		for _, id := range contribution.RemoveNovelIDs {
+			removed[id] = true
+		}
+
+		proposed := make([]uuid.UUID, 0, len(currentNovelIDs)+len(contribution.AddNovelIDs))
+		present := make(map[uuid.UUID]bool)
+		for _, id := range append(append([]uuid.UUID{}, currentNovelIDs...), contribution.AddNovelIDs...) {
+			if removed[id] || present[id] {
+				continue
+			}
+			present[id] = true
+			proposed = append(proposed, id)
+		}
+
+		if !sameUUIDSet(currentNovelIDs, proposed) {
+			response.Diff = append(response.Diff, d.CharacterFieldDiff{Field: "novels", Current: currentNovelIDs, Proposed: proposed})
+		}
+	}
+
+	return response, nil
+}
+
+// diffOptionalString reports a change when a proposed value differs from the current one
+func diffOptionalString(field string, current, proposed *string) (d.CharacterFieldDiff, bool) {
+	if proposed == nil || (current != nil && *current == *proposed) {
+		return d.CharacterFieldDiff{}, false
+	}
+
+	var currentValue interface{}
+	if current != nil {
+		currentValue = *current
+	}
+	return d.CharacterFieldDiff{Field: field, Current: currentValue, Proposed: *proposed}, true
+}
+
+// sameUUIDSet reports whether both slices contain the same IDs
+func sameUUIDSet(a, b []uuid.UUID) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	set := make(map[uuid.UUID]bool, len(a))
+	for _, id := range a {
+		set[id] = true
+	}
+	for _, id := range b {
+		if !set[id] {
+			return false
+		}
+	}
+	return true
+}
+
+// parseNovelIDs parses and de-duplicates a list of novel IDs
+func parseNovelIDs(ids []string) ([]uuid.UUID, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	seen := make(map[uuid.UUID]bool, len(ids))
+	parsed := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		novelID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid novel ID format: %w", err)
+		}
+		if !seen[novelID] {
+			seen[novelID] = true
+			parsed = append(parsed, novelID)
+		}
+	}
+	return parsed, nil
+}
@@ -15,13 +15,15 @@ import (
 
 // CharacterService implements character-related business logic
 type CharacterService struct {
-	repos *repositories.Repositories
+	repos  *repositories.Repositories
+	images *mediaResolver
 }
 
 // NewCharacterService creates a new character service
-func NewCharacterService(repos *repositories.Repositories) interfaces.CharacterServiceInterface {
+func NewCharacterService(repos *repositories.Repositories, images *mediaResolver) interfaces.CharacterServiceInterface {
 	return &CharacterService{
-		repos: repos,
+		repos:  repos,
+		images: images,
 	}
 }
 
@@ -40,6 +42,11 @@ func (s *CharacterService) CreateCharacter(ctx context.Context, req d.CreateChar
 		return nil, fmt.Errorf("character with name '%s' already exists", req.Name)
 	}
 
+	image, err := s.images.Prepare(ctx, m.MediaAttachmentCharacterImage, req.ImageMediaID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create character
 	character := &m.Character{
 		Name:        strings.TrimSpace(req.Name),
@@ -51,6 +58,13 @@ func (s *CharacterService) CreateCharacter(ctx context.Context, req d.CreateChar
 		return nil, fmt.Errorf("failed to create character: %w", err)
 	}
 
+	if err := s.images.Apply(ctx, character.ID, image); err != nil {
+		return nil, err
+	}
+	if err := s.attachImages(ctx, character); err != nil {
+		return nil, err
+	}
+
 	return character, nil
 }
 
@@ -61,10 +75,24 @@ func (s *CharacterService) GetCharacterByID(ctx context.Context, characterID uui
 	}
 
 	character, err := s.repos.Character.GetByID(ctx, characterID)
+	if err != nil && strings.Contains(err.Error(), "no rows") {
+		// A merged character keeps answering on its old ID
+		targetID, redirectErr := s.repos.Character.GetRedirect(ctx, characterID)
+		if redirectErr != nil {
+			return nil, redirectErr
+		}
+		if targetID != nil {
+			character, err = s.repos.Character.GetByID(ctx, *targetID)
+		}
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to get character by ID: %w", err)
 	}
 
+	if err := s.attachImages(ctx, character); err != nil {
+		return nil, err
+	}
+
 	return character, nil
 }
 
@@ -89,6 +117,10 @@ func (s *CharacterService) ListCharacters(ctx context.Context, req d.ListCharact
 		return nil, 0, fmt.Errorf("failed to list characters: %w", err)
 	}
 
+	if err := s.attachImages(ctx, characters...); err != nil {
+		return nil, 0, err
+	}
+
 	return characters, total, nil
 }
 
@@ -113,12 +145,12 @@ func (s *CharacterService) UpdateCharacter(ctx context.Context, characterID uuid
 
 		// Check if new name already exists (if different from current)
 		if strings.ToLower(name) != strings.ToLower(character.Name) {
-			exists, err := s.CheckCharacterExists(ctx, name)
+			conflict, err := s.findNameConflict(ctx, name, &characterID)
 			if err != nil {
 				return nil, fmt.Errorf("failed to check character existence: %w", err)
 			}
-			if exists {
-				return nil, fmt.Errorf("character with name '%s' already exists", name)
+			if conflict != nil {
+				return nil, fmt.Errorf("character with name '%s' already exists", conflict.MatchedName)
 			}
 		}
 
@@ -133,11 +165,23 @@ func (s *CharacterService) UpdateCharacter(ctx context.Context, characterID uuid
 		character.ImageURL = req.ImageURL
 	}
 
+	image, err := s.images.Prepare(ctx, m.MediaAttachmentCharacterImage, req.ImageMediaID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Update character
 	if err := s.repos.Character.Update(ctx, character); err != nil {
 		return nil, fmt.Errorf("failed to update character: %w", err)
 	}
 
+	if err := s.images.Apply(ctx, character.ID, image); err != nil {
+		return nil, err
+	}
+	if err := s.attachImages(ctx, character); err != nil {
+		return nil, err
+	}
+
 	return character, nil
 }
 
@@ -161,6 +205,23 @@ func (s *CharacterService) DeleteCharacter(ctx context.Context, characterID uuid
 	return nil
 }
 
+// attachImages fills ImageMedia of the characters with one batched lookup
+func (s *CharacterService) attachImages(ctx context.Context, characters ...*m.Character) error {
+	ids := make([]uuid.UUID, 0, len(characters))
+	for _, character := range characters {
+		ids = append(ids, character.ID)
+	}
+
+	images, err := s.images.Resolve(ctx, m.MediaAttachmentCharacterImage, ids)
+	if err != nil {
+		return fmt.Errorf("failed to load character images: %w", err)
+	}
+	for _, character := range characters {
+		character.ImageMedia = images[character.ID]
+	}
+	return nil
+}
+
 // ValidateCharacterName validates character name
 func (s *CharacterService) ValidateCharacterName(name string) error {
 	name = strings.TrimSpace(name)
@@ -180,15 +241,62 @@ func (s *CharacterService) ValidateCharacterName(name string) error {
 	return nil
 }
 
-// CheckCharacterExists checks if a character name already exists
+// CheckCharacterExists checks if a character name already exists, ignoring case, punctuation and spacing
 func (s *CharacterService) CheckCharacterExists(ctx context.Context, name string) (bool, error) {
-	_, err := s.repos.Character.GetByName(ctx, name)
+	conflict, err := s.findNameConflict(ctx, name, nil)
+	if err != nil {
+		return false, err
+	}
+	return conflict != nil, nil
+}
+
+// FindDuplicateCharacters lists characters that may be the same as the given name
+func (s *CharacterService) FindDuplicateCharacters(ctx context.Context, req d.FindDuplicatesRequest) ([]d.DuplicateCandidate, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+
+	return s.repos.Character.FindDuplicates(ctx, []string{name}, nil, duplicateLimit(req.Limit))
+}
+
+// FindCharacterDuplicatesOf lists other characters that may be the same as an existing one
+func (s *CharacterService) FindCharacterDuplicatesOf(ctx context.Context, characterID uuid.UUID, req d.FindDuplicatesRequest) ([]d.DuplicateCandidate, error) {
+	character, err := s.repos.Character.GetByID(ctx, characterID)
 	if err != nil {
-		// If error is "not found", character doesn't exist
 		if strings.Contains(err.Error(), "no rows") {
-			return false, nil
+			return nil, fmt.Errorf("character not found")
 		}
-		return false, err
+		return nil, fmt.Errorf("failed to get character: %w", err)
+	}
+
+	names := []string{character.Name}
+
+	return s.repos.Character.FindDuplicates(ctx, names, &characterID, duplicateLimit(req.Limit))
+}
+
+// MergeCharacters folds a duplicate character into the target; the duplicate's ID redirects afterwards
+func (s *CharacterService) MergeCharacters(ctx context.Context, characterID uuid.UUID, req d.MergeCharacterRequest, actor d.ContentActor) (*d.CharacterMergeResponse, error) {
+	targetID, err := uuid.Parse(req.TargetCharacterID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid target character ID format: %w", err)
+	}
+	if targetID == characterID {
+		return nil, fmt.Errorf("invalid merge: a character cannot be merged into itself")
+	}
+
+	return s.repos.Character.Merge(ctx, characterID, targetID, actor.UserID)
+}
+
+// findNameConflict returns another character whose name has the same name key
+func (s *CharacterService) findNameConflict(ctx context.Context, name string, excludeID *uuid.UUID) (*d.DuplicateCandidate, error) {
+	candidates, err := s.repos.Character.FindDuplicates(ctx, []string{strings.TrimSpace(name)}, excludeID, 1)
+	if err != nil {
+		return nil, err
+	}
+	// Candidates are ordered strongest first, so a merely similar name means no conflict
+	if len(candidates) == 0 || candidates[0].MatchType == d.DuplicateMatchSimilar {
+		return nil, nil
 	}
-	return true, nil
+	return &candidates[0], nil
 }
@@ -0,0 +1,631 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+	"wibusystem/services/catalog/repositories"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// maxFilterWarnings caps how many content warnings each of a reader's lists can hold
+const maxFilterWarnings = 50
+
+// ContentFilterService implements age gating and reader content filters
+type ContentFilterService struct {
+	repos *repositories.Repositories
+}
+
+// NewContentFilterService creates a new content filter service
+func NewContentFilterService(repos *repositories.Repositories) interfaces.ContentFilterServiceInterface {
+	return &ContentFilterService{
+		repos: repos,
+	}
+}
+
+// GetMyFilter returns the user's filters, or the defaults when none were saved
+func (s *ContentFilterService) GetMyFilter(ctx context.Context, userID uuid.UUID) (*m.UserContentFilter, error) {
+	return s.repos.ContentFilter.GetUserFilter(ctx, userID)
+}
+
+// UpdateMyFilter changes the provided filters. Warning lists must hold taxonomy codes and a
+// code cannot be both never shown and warned about.
+func (s *ContentFilterService) UpdateMyFilter(ctx context.Context, userID uuid.UUID, req d.UpdateContentFilterRequest) (*m.UserContentFilter, error) {
+	if err := validateRestrictedDisplay(req.RestrictedDisplay); err != nil {
+		return nil, err
+	}
+
+	filter, err := s.repos.ContentFilter.GetUserFilter(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.HideMature != nil {
+		filter.HideMature = *req.HideMature
+	}
+	if req.BlockedWarnings != nil {
+		warnings, err := s.filterWarnings("blocked_warnings", req.BlockedWarnings)
+		if err != nil {
+			return nil, err
+		}
+		filter.BlockedWarnings = warnings
+	}
+	if req.WarnedWarnings != nil {
+		warnings, err := s.filterWarnings("warned_warnings", req.WarnedWarnings)
+		if err != nil {
+			return nil, err
+		}
+		filter.WarnedWarnings = warnings
+	}
+	if overlap := intersectWarnings(filter.WarnedWarnings, filter.BlockedWarnings); len(overlap) > 0 {
+		return nil, fmt.Errorf("invalid warned_warnings: %s already never shown", strings.Join(overlap, ", "))
+	}
+	if err := s.checkWarningCodes(ctx, filter.BlockedWarnings, filter.WarnedWarnings); err != nil {
+		return nil, err
+	}
+	if req.RestrictedDisplay != nil {
+		filter.RestrictedDisplay = *req.RestrictedDisplay
+	}
+
+	if err := s.repos.ContentFilter.UpsertUserFilter(ctx, filter); err != nil {
+		return nil, err
+	}
+	return filter, nil
+}
+
+// GetTenantPolicy returns the tenant's policy; a tenant without one gets an empty policy,
+// meaning every platform default applies
+func (s *ContentFilterService) GetTenantPolicy(ctx context.Context, tenantID string, actor d.ContentActor) (*m.TenantContentPolicy, error) {
+	tenantUUID, err := parseTenantForActor(tenantID, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	policies, err := s.repos.ContentFilter.GetTenantPolicies(ctx, []uuid.UUID{tenantUUID})
+	if err != nil {
+		return nil, err
+	}
+	if policy, ok := policies[tenantUUID]; ok {
+		return policy, nil
+	}
+	return &m.TenantContentPolicy{TenantID: tenantUUID}, nil
+}
+
+// UpdateTenantPolicy replaces every setting of the policy; members of the tenant and admins may change it
+func (s *ContentFilterService) UpdateTenantPolicy(ctx context.Context, tenantID string, req d.UpdateTenantContentPolicyRequest, actor d.ContentActor) (*m.TenantContentPolicy, error) {
+	tenantUUID, err := parseTenantForActor(tenantID, actor)
+	if err != nil {
+		return nil, err
+	}
+	for name, value := range map[string]*int{
+		"min_age_pg13":   req.MinAgePG13,
+		"min_age_r":      req.MinAgeR,
+		"min_age_nc17":   req.MinAgeNC17,
+		"min_age_mature": req.MinAgeMature,
+	} {
+		if value != nil && (*value < 0 || *value > 21) {
+			return nil, fmt.Errorf("invalid %s: must be between 0 and 21", name)
+		}
+	}
+	if err := validateRestrictedDisplay(req.RestrictedDisplay); err != nil {
+		return nil, err
+	}
+
+	updatedBy := actor.UserID
+	policy := &m.TenantContentPolicy{
+		TenantID:          tenantUUID,
+		MinAgePG13:        req.MinAgePG13,
+		MinAgeR:           req.MinAgeR,
+		MinAgeNC17:        req.MinAgeNC17,
+		MinAgeMature:      req.MinAgeMature,
+		RestrictedDisplay: req.RestrictedDisplay,
+		UpdatedByUserID:   &updatedBy,
+	}
+	if err := s.repos.ContentFilter.UpsertTenantPolicy(ctx, policy); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// Visibility returns the gating list queries apply in SQL; admins see everything
+func (s *ContentFilterService) Visibility(ctx context.Context, viewer d.ContentViewer) (*d.ContentVisibility, error) {
+	if viewer.IsAdmin {
+		return nil, nil
+	}
+
+	visibility := &d.ContentVisibility{Age: viewer.Age}
+	if viewer.UserID != nil {
+		filter, err := s.repos.ContentFilter.GetUserFilter(ctx, *viewer.UserID)
+		if err != nil {
+			return nil, err
+		}
+		visibility.HideMature = filter.HideMature && filter.RestrictedDisplay == m.RestrictedDisplayHide
+		visibility.BlockedWarnings = filter.BlockedWarnings
+	}
+	return visibility, nil
+}
+
+// FilterNovels marks the blurred or warned novels of a page
+func (s *ContentFilterService) FilterNovels(ctx context.Context, viewer d.ContentViewer, novels []d.NovelSummaryResponse) ([]d.NovelSummaryResponse, error) {
+	return filterWorkList(ctx, s, viewer, novels, s.repos.ContentFilter.ListNovelRatings,
+		func(novel d.NovelSummaryResponse) string { return novel.ID },
+		func(novel *d.NovelSummaryResponse, restriction *d.ContentRestriction) {
+			novel.Restriction = restriction
+		})
+}
+
+// FilterRankedNovels marks the blurred or warned novels of a ranking page
+func (s *ContentFilterService) FilterRankedNovels(ctx context.Context, viewer d.ContentViewer, novels []d.RankedNovelResponse) ([]d.RankedNovelResponse, error) {
+	return filterWorkList(ctx, s, viewer, novels, s.repos.ContentFilter.ListNovelRatings,
+		func(novel d.RankedNovelResponse) string { return novel.ID },
+		func(novel *d.RankedNovelResponse, restriction *d.ContentRestriction) { novel.Restriction = restriction })
+}
+
+// FilterRecommendations marks the blurred or warned recommended novels
+func (s *ContentFilterService) FilterRecommendations(ctx context.Context, viewer d.ContentViewer, novels []d.RecommendedNovelResponse) ([]d.RecommendedNovelResponse, error) {
+	return filterWorkList(ctx, s, viewer, novels, s.repos.ContentFilter.ListNovelRatings,
+		func(novel d.RecommendedNovelResponse) string { return novel.ID },
+		func(novel *d.RecommendedNovelResponse, restriction *d.ContentRestriction) {
+			novel.Restriction = restriction
+		})
+}
+
+// FilterManga marks the blurred manga of a page
+func (s *ContentFilterService) FilterManga(ctx context.Context, viewer d.ContentViewer, manga []d.MangaSummaryResponse) ([]d.MangaSummaryResponse, error) {
+	return filterWorkList(ctx, s, viewer, manga, s.repos.ContentFilter.ListMangaRatings,
+		func(item d.MangaSummaryResponse) string { return item.ID },
+		func(item *d.MangaSummaryResponse, restriction *d.ContentRestriction) { item.Restriction = restriction })
+}
+
+// FilterAnime marks the blurred anime of a page
+func (s *ContentFilterService) FilterAnime(ctx context.Context, viewer d.ContentViewer, anime []d.AnimeSummaryResponse) ([]d.AnimeSummaryResponse, error) {
+	return filterWorkList(ctx, s, viewer, anime, s.repos.ContentFilter.ListAnimeRatings,
+		func(item d.AnimeSummaryResponse) string { return item.ID },
+		func(item *d.AnimeSummaryResponse, restriction *d.ContentRestriction) { item.Restriction = restriction })
+}
+
+// filterWorkList marks the blurred or warned works of a list. The list query already left out
+// what the viewer may not see (see Visibility); a work whose rating changed in between is still
+// dropped rather than shown. Works whose rating cannot be read are kept as they are.
+func filterWorkList[T any](ctx context.Context, s *ContentFilterService, viewer d.ContentViewer, items []T, loadRatings func(context.Context, []uuid.UUID) (map[uuid.UUID]d.ContentRatingFacts, error), itemID func(T) string, restrict func(*T, *d.ContentRestriction)) ([]T, error) {
+	if viewer.IsAdmin || len(items) == 0 {
+		return items, nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(items))
+	for _, item := range items {
+		if id, err := uuid.Parse(itemID(item)); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	ratings, err := loadRatings(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	gate, err := s.loadGate(ctx, viewer, ratingsOwners(ratings))
+	if err != nil {
+		return nil, err
+	}
+
+	visible := make([]T, 0, len(items))
+	for _, item := range items {
+		id, _ := uuid.Parse(itemID(item))
+		facts, ok := ratings[id]
+		if !ok {
+			visible = append(visible, item)
+			continue
+		}
+		restriction := gate.evaluate(facts)
+		if restriction != nil && restriction.Display == m.RestrictedDisplayHide {
+			continue
+		}
+		restrict(&item, restriction)
+		visible = append(visible, item)
+	}
+	return visible, nil
+}
+
+// CheckNovel evaluates one novel against the viewer's age and filters
+func (s *ContentFilterService) CheckNovel(ctx context.Context, viewer d.ContentViewer, novelID string) (*d.ContentRestriction, error) {
+	novelUUID, err := uuid.Parse(novelID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid novel ID format: %w", err)
+	}
+	if viewer.IsAdmin {
+		return nil, nil
+	}
+
+	ratings, err := s.repos.ContentFilter.ListNovelRatings(ctx, []uuid.UUID{novelUUID})
+	if err != nil {
+		return nil, err
+	}
+	facts, ok := ratings[novelUUID]
+	if !ok {
+		return nil, fmt.Errorf("novel not found")
+	}
+	return s.check(ctx, viewer, facts)
+}
+
+// FilterReaderChapters evaluates a volume's chapters, each with the novel's rating plus its own
+// mature flag and warnings
+func (s *ContentFilterService) FilterReaderChapters(ctx context.Context, viewer d.ContentViewer, volumeID string, chapters []d.ReaderChapter) ([]d.ReaderChapter, int, error) {
+	volumeUUID, err := uuid.Parse(volumeID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid volume ID format: %w", err)
+	}
+	if viewer.IsAdmin || len(chapters) == 0 {
+		return chapters, 0, nil
+	}
+
+	novelID, err := s.repos.ChapterOrder.GetVolumeNovelID(ctx, volumeUUID)
+	if err != nil {
+		return nil, 0, err
+	}
+	ratings, err := s.repos.ContentFilter.ListNovelRatings(ctx, []uuid.UUID{novelID})
+	if err != nil {
+		return nil, 0, err
+	}
+	novel, ok := ratings[novelID]
+	if !ok {
+		return nil, 0, fmt.Errorf("novel not found")
+	}
+	gate, err := s.loadGate(ctx, viewer, ratingsOwners(ratings))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	visible := make([]d.ReaderChapter, 0, len(chapters))
+	hidden := 0
+	for _, chapter := range chapters {
+		facts := novel
+		facts.Mature = novel.Mature || chapter.HasMatureContent
+		facts.Warnings = append(append([]string{}, novel.Warnings...), chapter.ContentWarnings...)
+
+		restriction := gate.evaluate(facts)
+		if restriction != nil && restriction.Display == m.RestrictedDisplayHide {
+			hidden++
+			continue
+		}
+		chapter.Restriction = restriction
+		visible = append(visible, chapter)
+	}
+	return visible, hidden, nil
+}
+
+// CheckNovelChapter evaluates one novel chapter against the viewer's age and filters
+func (s *ContentFilterService) CheckNovelChapter(ctx context.Context, viewer d.ContentViewer, chapterID string) (*d.ContentRestriction, error) {
+	chapterUUID, err := uuid.Parse(chapterID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid chapter ID format: %w", err)
+	}
+	if viewer.IsAdmin {
+		return nil, nil
+	}
+
+	facts, err := s.repos.ContentFilter.GetNovelChapterRating(ctx, chapterUUID)
+	if err != nil {
+		return nil, err
+	}
+	return s.check(ctx, viewer, *facts)
+}
+
+// CheckMangaChapter evaluates one manga chapter against the viewer's age and filters
+func (s *ContentFilterService) CheckMangaChapter(ctx context.Context, viewer d.ContentViewer, chapterID string) (*d.ContentRestriction, error) {
+	chapterUUID, err := uuid.Parse(chapterID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid chapter ID format: %w", err)
+	}
+	if viewer.IsAdmin {
+		return nil, nil
+	}
+
+	facts, err := s.repos.ContentFilter.GetMangaChapterRating(ctx, chapterUUID)
+	if err != nil {
+		return nil, err
+	}
+	return s.check(ctx, viewer, *facts)
+}
+
+// CheckManga evaluates one manga against the viewer's age and filters
+func (s *ContentFilterService) CheckManga(ctx context.Context, viewer d.ContentViewer, mangaID string) (*d.ContentRestriction, error) {
+	mangaUUID, err := uuid.Parse(mangaID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid manga ID format: %w", err)
+	}
+	if viewer.IsAdmin {
+		return nil, nil
+	}
+
+	ratings, err := s.repos.ContentFilter.ListMangaRatings(ctx, []uuid.UUID{mangaUUID})
+	if err != nil {
+		return nil, err
+	}
+	facts, ok := ratings[mangaUUID]
+	if !ok {
+		return nil, fmt.Errorf("manga not found")
+	}
+	return s.check(ctx, viewer, facts)
+}
+
+// CheckMangaVolume evaluates the manga a volume belongs to
+func (s *ContentFilterService) CheckMangaVolume(ctx context.Context, viewer d.ContentViewer, volumeID string) (*d.ContentRestriction, error) {
+	return s.checkScoped(ctx, viewer, volumeID, "volume", s.repos.ContentFilter.GetMangaVolumeRating)
+}
+
+// CheckAnime evaluates one anime against the viewer's age and filters
+func (s *ContentFilterService) CheckAnime(ctx context.Context, viewer d.ContentViewer, animeID string) (*d.ContentRestriction, error) {
+	animeUUID, err := uuid.Parse(animeID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid anime ID format: %w", err)
+	}
+	if viewer.IsAdmin {
+		return nil, nil
+	}
+
+	ratings, err := s.repos.ContentFilter.ListAnimeRatings(ctx, []uuid.UUID{animeUUID})
+	if err != nil {
+		return nil, err
+	}
+	facts, ok := ratings[animeUUID]
+	if !ok {
+		return nil, fmt.Errorf("anime not found")
+	}
+	return s.check(ctx, viewer, facts)
+}
+
+// CheckAnimeSeason evaluates the anime a season belongs to
+func (s *ContentFilterService) CheckAnimeSeason(ctx context.Context, viewer d.ContentViewer, seasonID string) (*d.ContentRestriction, error) {
+	return s.checkScoped(ctx, viewer, seasonID, "season", s.repos.ContentFilter.GetAnimeSeasonRating)
+}
+
+// CheckAnimeEpisode evaluates the anime an episode belongs to
+func (s *ContentFilterService) CheckAnimeEpisode(ctx context.Context, viewer d.ContentViewer, episodeID string) (*d.ContentRestriction, error) {
+	return s.checkScoped(ctx, viewer, episodeID, "episode", s.repos.ContentFilter.GetAnimeEpisodeRating)
+}
+
+// checkScoped evaluates an item rated through its parent work, such as a volume or an episode
+func (s *ContentFilterService) checkScoped(ctx context.Context, viewer d.ContentViewer, id, entity string, loadRating func(context.Context, uuid.UUID) (*d.ContentRatingFacts, error)) (*d.ContentRestriction, error) {
+	itemUUID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s ID format: %w", entity, err)
+	}
+	if viewer.IsAdmin {
+		return nil, nil
+	}
+
+	facts, err := loadRating(ctx, itemUUID)
+	if err != nil {
+		return nil, err
+	}
+	return s.check(ctx, viewer, *facts)
+}
+
+// check evaluates a single item
+func (s *ContentFilterService) check(ctx context.Context, viewer d.ContentViewer, facts d.ContentRatingFacts) (*d.ContentRestriction, error) {
+	var owners []uuid.UUID
+	if facts.OwnerTenantID != nil {
+		owners = append(owners, *facts.OwnerTenantID)
+	}
+	gate, err := s.loadGate(ctx, viewer, owners)
+	if err != nil {
+		return nil, err
+	}
+	return gate.evaluate(facts), nil
+}
+
+// loadGate reads the viewer's filters and the policies of the tenants owning the evaluated items
+func (s *ContentFilterService) loadGate(ctx context.Context, viewer d.ContentViewer, tenantIDs []uuid.UUID) (*contentGate, error) {
+	gate := &contentGate{viewer: viewer}
+	if viewer.UserID != nil {
+		filter, err := s.repos.ContentFilter.GetUserFilter(ctx, *viewer.UserID)
+		if err != nil {
+			return nil, err
+		}
+		gate.filter = filter
+	}
+
+	policies, err := s.repos.ContentFilter.GetTenantPolicies(ctx, tenantIDs)
+	if err != nil {
+		return nil, err
+	}
+	gate.policies = policies
+	return gate, nil
+}
+
+// contentGate holds everything needed to evaluate items for one viewer
+type contentGate struct {
+	viewer   d.ContentViewer
+	filter   *m.UserContentFilter // nil for anonymous viewers
+	policies map[uuid.UUID]*m.TenantContentPolicy
+}
+
+// evaluate applies age gating and the viewer's filters to one item. Age reasons use the owning
+// tenant's display (HIDE by default), mature content uses the viewer's chosen display, never-shown
+// warnings always hide and warn-me warnings only ask the client to warn first. The strictest
+// display wins. Returns nil when the item is unrestricted.
+func (g *contentGate) evaluate(facts d.ContentRatingFacts) *d.ContentRestriction {
+	var policy *m.TenantContentPolicy
+	if facts.OwnerTenantID != nil {
+		policy = g.policies[*facts.OwnerTenantID]
+	}
+
+	restriction := &d.ContentRestriction{}
+	escalate := func(display string) {
+		if restrictedDisplayRank[display] > restrictedDisplayRank[restriction.Display] {
+			restriction.Display = display
+		}
+	}
+
+	required := requiredAge(facts, policy)
+	ageDisplay := m.RestrictedDisplayHide
+	if policy != nil && policy.RestrictedDisplay != nil {
+		ageDisplay = *policy.RestrictedDisplay
+	}
+	switch {
+	case g.viewer.Age == nil && required > m.UnverifiedMaxAge:
+		restriction.Reasons = append(restriction.Reasons, d.RestrictionReason{Code: m.RestrictionAgeUnverified, RequiredAge: &required})
+		escalate(ageDisplay)
+	case g.viewer.Age != nil && *g.viewer.Age < required:
+		restriction.Reasons = append(restriction.Reasons, d.RestrictionReason{Code: m.RestrictionAgeRestricted, RequiredAge: &required})
+		escalate(ageDisplay)
+	}
+
+	if g.filter != nil {
+		if g.filter.HideMature && facts.Mature {
+			restriction.Reasons = append(restriction.Reasons, d.RestrictionReason{Code: m.RestrictionMatureHidden})
+			escalate(g.filter.RestrictedDisplay)
+		}
+		if blocked := intersectWarnings(facts.Warnings, g.filter.BlockedWarnings); len(blocked) > 0 {
+			restriction.Reasons = append(restriction.Reasons, d.RestrictionReason{Code: m.RestrictionWarningBlocked, Warnings: blocked})
+			escalate(m.RestrictedDisplayHide)
+		}
+		if warned := intersectWarnings(facts.Warnings, g.filter.WarnedWarnings); len(warned) > 0 {
+			restriction.Reasons = append(restriction.Reasons, d.RestrictionReason{Code: m.RestrictionWarningNotice, Warnings: warned})
+			escalate(m.RestrictedDisplayWarn)
+		}
+	}
+
+	if len(restriction.Reasons) == 0 {
+		return nil
+	}
+	return restriction
+}
+
+// restrictedDisplayRank orders displays from the least to the most restrictive
+var restrictedDisplayRank = map[string]int{
+	m.RestrictedDisplayWarn: 1,
+	m.RestrictedDisplayBlur: 2,
+	m.RestrictedDisplayHide: 3,
+}
+
+// requiredAge is the highest minimum age among the item's rating and mature flag
+func requiredAge(facts d.ContentRatingFacts, policy *m.TenantContentPolicy) int {
+	pick := func(override func(*m.TenantContentPolicy) *int, fallback int) int {
+		if policy != nil {
+			if value := override(policy); value != nil {
+				return *value
+			}
+		}
+		return fallback
+	}
+
+	required := 0
+	if facts.AgeRating != nil {
+		switch strings.ToUpper(strings.TrimSpace(*facts.AgeRating)) {
+		case "PG-13":
+			required = pick(func(p *m.TenantContentPolicy) *int { return p.MinAgePG13 }, m.DefaultMinAgePG13)
+		case "R":
+			required = pick(func(p *m.TenantContentPolicy) *int { return p.MinAgeR }, m.DefaultMinAgeR)
+		case "NC-17":
+			required = pick(func(p *m.TenantContentPolicy) *int { return p.MinAgeNC17 }, m.DefaultMinAgeNC17)
+		}
+	}
+	if facts.Mature {
+		if mature := pick(func(p *m.TenantContentPolicy) *int { return p.MinAgeMature }, m.DefaultMinAgeMature); mature > required {
+			required = mature
+		}
+	}
+	return required
+}
+
+// intersectWarnings returns the item's warnings found in the viewer's list. Items may still carry
+// free-form warnings written before the taxonomy, so both sides are compared as codes.
+func intersectWarnings(warnings, list []string) []string {
+	if len(warnings) == 0 || len(list) == 0 {
+		return nil
+	}
+	listed := make(map[string]bool, len(list))
+	for _, warning := range list {
+		listed[warningKey(warning)] = true
+	}
+
+	var matched []string
+	seen := make(map[string]bool)
+	for _, warning := range warnings {
+		key := warningKey(warning)
+		if !listed[key] || seen[key] {
+			continue
+		}
+		seen[key] = true
+		matched = append(matched, key)
+	}
+	return matched
+}
+
+// warningKey is the code form of a warning used for matching
+func warningKey(warning string) string {
+	return strings.NewReplacer(" ", "_", "-", "_").Replace(strings.ToLower(strings.TrimSpace(warning)))
+}
+
+// filterWarnings normalizes one of the viewer's warning lists
+func (s *ContentFilterService) filterWarnings(field string, warnings []string) ([]string, error) {
+	codes, err := normalizeWarningCodes(warnings)
+	if err != nil {
+		return nil, err
+	}
+	if len(codes) > maxFilterWarnings {
+		return nil, fmt.Errorf("invalid %s: at most %d are allowed", field, maxFilterWarnings)
+	}
+	return codes, nil
+}
+
+// checkWarningCodes rejects codes missing from the taxonomy; retired codes stay usable in filters
+// because novels may still carry them
+func (s *ContentFilterService) checkWarningCodes(ctx context.Context, lists ...[]string) error {
+	var codes []string
+	for _, list := range lists {
+		codes = append(codes, list...)
+	}
+	statuses, err := s.repos.ContentWarning.GetStatuses(ctx, codes)
+	if err != nil {
+		return err
+	}
+	for _, code := range codes {
+		if _, known := statuses[code]; !known {
+			return fmt.Errorf("invalid content warning %q: not in the content warning taxonomy", code)
+		}
+	}
+	return nil
+}
+
+// validateRestrictedDisplay checks an optional display setting is HIDE or BLUR
+func validateRestrictedDisplay(display *string) error {
+	if display != nil && *display != m.RestrictedDisplayHide && *display != m.RestrictedDisplayBlur {
+		return fmt.Errorf("invalid restricted_display: must be HIDE or BLUR")
+	}
+	return nil
+}
+
+// parseTenantForActor parses a tenant ID the actor belongs to; admins may act on any tenant
+func parseTenantForActor(tenantID string, actor d.ContentActor) (uuid.UUID, error) {
+	tenantUUID, err := uuid.Parse(tenantID)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid tenant ID format: %w", err)
+	}
+	if !actor.IsAdmin && (actor.TenantID == nil || *actor.TenantID != tenantUUID) {
+		return uuid.Nil, fmt.Errorf("permission denied: content policy belongs to another tenant")
+	}
+	return tenantUUID, nil
+}
+
+// ratingsOwners collects the distinct owning tenants of a set of ratings
+func ratingsOwners(ratings map[uuid.UUID]d.ContentRatingFacts) []uuid.UUID {
+	seen := make(map[uuid.UUID]struct{})
+	var owners []uuid.UUID
+	for _, facts := range ratings {
+		if facts.OwnerTenantID == nil {
+			continue
+		}
+		if _, ok := seen[*facts.OwnerTenantID]; ok {
+			continue
+		}
+		seen[*facts.OwnerTenantID] = struct{}{}
+		owners = append(owners, *facts.OwnerTenantID)
+	}
+	return owners
+}
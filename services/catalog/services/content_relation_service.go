@@ -0,0 +1,159 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+	"wibusystem/services/catalog/repositories"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// Giới hạn độ sâu khi duyệt đồ thị quan hệ
+const (
+	defaultRelationGraphDepth = 2
+	maxRelationGraphDepth     = 5
+)
+
+// ContentRelationService implements relation management between works
+type ContentRelationService struct {
+	repos *repositories.Repositories
+}
+
+// NewContentRelationService creates a new content relation service
+func NewContentRelationService(repos *repositories.Repositories) interfaces.ContentRelationServiceInterface {
+	return &ContentRelationService{
+		repos: repos,
+	}
+}
+
+// CreateRelation validates both ends and stores the relation with its inverse
+func (s *ContentRelationService) CreateRelation(ctx context.Context, req d.CreateContentRelationRequest) (*m.ContentRelation, error) {
+	sourceType, sourceID, err := parseRelationContent(req.SourceType, req.SourceID, "source")
+	if err != nil {
+		return nil, err
+	}
+	targetType, targetID, err := parseRelationContent(req.TargetType, req.TargetID, "target")
+	if err != nil {
+		return nil, err
+	}
+
+	relationType := m.ContentRelationType(req.RelationType)
+	if !relationType.IsValid() {
+		return nil, fmt.Errorf("invalid relation type: %s", req.RelationType)
+	}
+
+	if sourceType == targetType && sourceID == targetID {
+		return nil, fmt.Errorf("invalid relation: a work cannot be related to itself")
+	}
+
+	exists, err := s.repos.ContentRelation.ContentExists(ctx, sourceType, sourceID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("source content not found")
+	}
+
+	exists, err = s.repos.ContentRelation.ContentExists(ctx, targetType, targetID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("target content not found")
+	}
+
+	relation := &m.ContentRelation{
+		SourceID:     sourceID,
+		SourceType:   sourceType,
+		TargetID:     targetID,
+		TargetType:   targetType,
+		RelationType: relationType,
+	}
+	if err := s.repos.ContentRelation.Create(ctx, relation); err != nil {
+		return nil, err
+	}
+
+	return relation, nil
+}
+
+// GetRelation retrieves a relation by ID
+func (s *ContentRelationService) GetRelation(ctx context.Context, relationID string) (*m.ContentRelation, error) {
+	id, err := uuid.Parse(relationID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid relation ID format: %w", err)
+	}
+
+	return s.repos.ContentRelation.GetByID(ctx, id)
+}
+
+// ListRelations returns the relations of a work
+func (s *ContentRelationService) ListRelations(ctx context.Context, req d.ListContentRelationsRequest) ([]m.ContentRelation, error) {
+	contentType, contentID, err := parseRelationContent(req.ContentType, req.ContentID, "content")
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repos.ContentRelation.ListByContent(ctx, contentType, contentID)
+}
+
+// UpdateRelation changes the type of a relation and of its inverse
+func (s *ContentRelationService) UpdateRelation(ctx context.Context, relationID string, req d.UpdateContentRelationRequest) (*m.ContentRelation, error) {
+	id, err := uuid.Parse(relationID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid relation ID format: %w", err)
+	}
+
+	relationType := m.ContentRelationType(req.RelationType)
+	if !relationType.IsValid() {
+		return nil, fmt.Errorf("invalid relation type: %s", req.RelationType)
+	}
+
+	return s.repos.ContentRelation.UpdateType(ctx, id, relationType)
+}
+
+// DeleteRelation removes a relation and its inverse
+func (s *ContentRelationService) DeleteRelation(ctx context.Context, relationID string) error {
+	id, err := uuid.Parse(relationID)
+	if err != nil {
+		return fmt.Errorf("invalid relation ID format: %w", err)
+	}
+
+	return s.repos.ContentRelation.Delete(ctx, id)
+}
+
+// GetGraph returns the franchise graph around a work
+func (s *ContentRelationService) GetGraph(ctx context.Context, req d.ContentRelationGraphRequest) (*d.ContentRelationGraphResponse, error) {
+	contentType, contentID, err := parseRelationContent(req.ContentType, req.ContentID, "content")
+	if err != nil {
+		return nil, err
+	}
+
+	depth := req.Depth
+	if depth <= 0 {
+		depth = defaultRelationGraphDepth
+	}
+	if depth > maxRelationGraphDepth {
+		depth = maxRelationGraphDepth
+	}
+
+	return s.repos.ContentRelation.GetGraph(ctx, contentType, contentID, depth)
+}
+
+// parseRelationContent validates a (type, id) pair of a relation end
+func parseRelationContent(contentType, contentID, field string) (m.ContentType, uuid.UUID, error) {
+	parsedType := m.ContentType(contentType)
+	if !parsedType.IsValid() {
+		return "", uuid.Nil, fmt.Errorf("invalid %s type: %s", field, contentType)
+	}
+
+	parsedID, err := uuid.Parse(contentID)
+	if err != nil {
+		return "", uuid.Nil, fmt.Errorf("invalid %s ID format: %w", field, err)
+	}
+
+	return parsedType, parsedID, nil
+}
@@ -0,0 +1,252 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+	"wibusystem/services/catalog/repositories"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// maxContentWarnings caps the number of warnings a novel or chapter may carry
+const maxContentWarnings = 20
+
+// contentWarningCodePattern matches the codes accepted by the content_warning table
+var contentWarningCodePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,49}$`)
+
+// ContentWarningService implements the managed content warning taxonomy
+type ContentWarningService struct {
+	repos *repositories.Repositories
+}
+
+// NewContentWarningService creates a new content warning service
+func NewContentWarningService(repos *repositories.Repositories) interfaces.ContentWarningServiceInterface {
+	return &ContentWarningService{
+		repos: repos,
+	}
+}
+
+// ListWarnings returns the taxonomy in display order; each warning carries its label in the
+// reader's language instead of every translation
+func (s *ContentWarningService) ListWarnings(ctx context.Context, req d.ListContentWarningsRequest, languages []string) ([]*m.ContentWarning, error) {
+	warnings, err := s.repos.ContentWarning.List(ctx, req.IncludeInactive)
+	if err != nil {
+		return nil, err
+	}
+	for _, warning := range warnings {
+		localizeContentWarning(warning, languages)
+		warning.Translations = nil
+	}
+	return warnings, nil
+}
+
+// CreateWarning registers an active warning with its labels
+func (s *ContentWarningService) CreateWarning(ctx context.Context, req d.CreateContentWarningRequest) (*m.ContentWarning, error) {
+	code, err := normalizeWarningCode(req.Code)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateWarningSeverity(req.Severity); err != nil {
+		return nil, err
+	}
+	translations, err := warningTranslations(req.Translations)
+	if err != nil {
+		return nil, err
+	}
+	if len(translations) == 0 {
+		return nil, fmt.Errorf("invalid translations: at least one label is required")
+	}
+
+	warning := &m.ContentWarning{
+		Code:         code,
+		Severity:     req.Severity,
+		SortOrder:    req.SortOrder,
+		Translations: translations,
+	}
+	if err := s.repos.ContentWarning.Create(ctx, warning); err != nil {
+		return nil, err
+	}
+	localizeContentWarning(warning, nil)
+	return warning, nil
+}
+
+// UpdateWarning changes the provided fields; a deactivated warning can no longer be added to
+// novels or chapters but keeps filtering the ones that carry it
+func (s *ContentWarningService) UpdateWarning(ctx context.Context, code string, req d.UpdateContentWarningRequest) (*m.ContentWarning, error) {
+	warning, err := s.repos.ContentWarning.GetByCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Severity != nil {
+		if err := validateWarningSeverity(*req.Severity); err != nil {
+			return nil, err
+		}
+		warning.Severity = *req.Severity
+	}
+	if req.SortOrder != nil {
+		warning.SortOrder = *req.SortOrder
+	}
+	if req.IsActive != nil {
+		warning.IsActive = *req.IsActive
+	}
+	replaceTranslations := req.Translations != nil
+	if replaceTranslations {
+		translations, err := warningTranslations(req.Translations)
+		if err != nil {
+			return nil, err
+		}
+		if len(translations) == 0 {
+			return nil, fmt.Errorf("invalid translations: at least one label is required")
+		}
+		warning.Translations = translations
+	}
+
+	if err := s.repos.ContentWarning.Update(ctx, warning, replaceTranslations); err != nil {
+		return nil, err
+	}
+	localizeContentWarning(warning, nil)
+	return warning, nil
+}
+
+// localizeContentWarning sets the label and description from the first preferred language,
+// falling back to English and then to the code
+func localizeContentWarning(warning *m.ContentWarning, languages []string) {
+	warning.Label = warning.Code
+	warning.Description = nil
+
+	codes := make([]string, 0, len(warning.Translations))
+	for _, translation := range warning.Translations {
+		codes = append(codes, translation.LanguageCode)
+	}
+	served := pickContentLanguage(languages, "en", codes)
+	for _, translation := range warning.Translations {
+		if strings.EqualFold(translation.LanguageCode, served) {
+			warning.Label = translation.Label
+			warning.Description = translation.Description
+			return
+		}
+	}
+}
+
+// normalizeContentWarnings validates a content_warnings payload of a novel or chapter against the
+// taxonomy and returns it as a deduplicated array of codes. Inactive codes are only accepted when
+// the item already carried them.
+func normalizeContentWarnings(ctx context.Context, repos *repositories.Repositories, raw *json.RawMessage, current []string) (*json.RawMessage, error) {
+	if raw == nil {
+		return nil, nil
+	}
+
+	var input []string
+	if err := json.Unmarshal(*raw, &input); err != nil {
+		return nil, fmt.Errorf("invalid content warnings: must be an array of warning codes")
+	}
+	codes, err := normalizeWarningCodes(input)
+	if err != nil {
+		return nil, err
+	}
+	if len(codes) > maxContentWarnings {
+		return nil, fmt.Errorf("invalid content warnings: at most %d are allowed", maxContentWarnings)
+	}
+
+	statuses, err := repos.ContentWarning.GetStatuses(ctx, codes)
+	if err != nil {
+		return nil, err
+	}
+	kept := make(map[string]bool, len(current))
+	for _, code := range current {
+		kept[code] = true
+	}
+	for _, code := range codes {
+		active, known := statuses[code]
+		if !known {
+			return nil, fmt.Errorf("invalid content warnings: unknown warning %q", code)
+		}
+		if !active && !kept[code] {
+			return nil, fmt.Errorf("invalid content warnings: warning %q is no longer in use", code)
+		}
+	}
+
+	encoded, err := json.Marshal(codes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode content warnings: %w", err)
+	}
+	normalized := json.RawMessage(encoded)
+	return &normalized, nil
+}
+
+// decodeContentWarnings reads a stored content_warnings value; anything but an array of strings reads as empty
+func decodeContentWarnings(raw *json.RawMessage) []string {
+	var codes []string
+	if raw == nil || json.Unmarshal(*raw, &codes) != nil {
+		return nil
+	}
+	return codes
+}
+
+// normalizeWarningCodes normalizes and deduplicates warning codes, keeping their order
+func normalizeWarningCodes(values []string) ([]string, error) {
+	codes := make([]string, 0, len(values))
+	seen := make(map[string]bool, len(values))
+	for _, value := range values {
+		code, err := normalizeWarningCode(value)
+		if err != nil {
+			return nil, err
+		}
+		if seen[code] {
+			continue
+		}
+		seen[code] = true
+		codes = append(codes, code)
+	}
+	return codes, nil
+}
+
+// normalizeWarningCode lowercases a code and turns spaces and hyphens into underscores, so
+// "Self-harm" is stored as self_harm
+func normalizeWarningCode(value string) (string, error) {
+	code := strings.ToLower(strings.TrimSpace(value))
+	code = strings.NewReplacer(" ", "_", "-", "_").Replace(code)
+	if !contentWarningCodePattern.MatchString(code) {
+		return "", fmt.Errorf("invalid content warning %q: codes are 1 to 50 lowercase letters, digits or underscores", value)
+	}
+	return code, nil
+}
+
+// validateWarningSeverity checks a severity is MILD, MODERATE or SEVERE
+func validateWarningSeverity(severity string) error {
+	switch severity {
+	case m.ContentWarningSeverityMild, m.ContentWarningSeverityModerate, m.ContentWarningSeveritySevere:
+		return nil
+	default:
+		return fmt.Errorf("invalid severity: must be MILD, MODERATE or SEVERE")
+	}
+}
+
+// warningTranslations validates labels, one per language
+func warningTranslations(inputs []d.ContentWarningTranslationInput) ([]m.ContentWarningTranslation, error) {
+	translations := make([]m.ContentWarningTranslation, 0, len(inputs))
+	seen := make(map[string]bool, len(inputs))
+	for _, input := range inputs {
+		language := strings.ToLower(strings.TrimSpace(input.LanguageCode))
+		label := strings.TrimSpace(input.Label)
+		if language == "" || label == "" {
+			return nil, fmt.Errorf("invalid translations: language_code and label are required")
+		}
+		if seen[language] {
+			return nil, fmt.Errorf("invalid translations: duplicate language %q", language)
+		}
+		seen[language] = true
+		translations = append(translations, m.ContentWarningTranslation{
+			LanguageCode: language,
+			Label:        label,
+			Description:  input.Description,
+		})
+	}
+	return translations, nil
+}
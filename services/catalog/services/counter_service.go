@@ -0,0 +1,153 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+
+	d "wibusystem/pkg/common/dto"
+	"wibusystem/services/catalog/repositories"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// counterReconcileBatch is how many novels one reconciliation transaction covers
+const counterReconcileBatch = 200
+
+// CounterService implements reconciliation of denormalized novel and volume counters
+type CounterService struct {
+	repos *repositories.Repositories
+}
+
+// NewCounterService creates a new counter reconciliation service
+func NewCounterService(repos *repositories.Repositories) interfaces.CounterServiceInterface {
+	return &CounterService{
+		repos: repos,
+	}
+}
+
+// ReconcileAll walks every novel in ID order. Each batch commits on its own so a failure
+// keeps the corrections already made; per-drift details are not collected to keep the
+// report small, only the per-field totals are returned.
+func (s *CounterService) ReconcileAll(ctx context.Context) (*d.CounterReconcileReport, error) {
+	report := newCounterReconcileReport()
+	report.Drifts = nil
+
+	var cursor *uuid.UUID
+	for {
+		ids, err := s.repos.Counter.ListNovelIDs(ctx, cursor, counterReconcileBatch)
+		if err != nil {
+			return report, err
+		}
+		if len(ids) == 0 {
+			break
+		}
+
+		checked, drifts, err := s.repos.Counter.ReconcileNovels(ctx, ids)
+		if err != nil {
+			return report, err
+		}
+		addCounterDrifts(report, checked, drifts)
+
+		if len(ids) < counterReconcileBatch {
+			break
+		}
+		cursor = &ids[len(ids)-1]
+	}
+
+	return report, nil
+}
+
+// ReconcileNovel recomputes one novel and reports every corrected value
+func (s *CounterService) ReconcileNovel(ctx context.Context, novelID string) (*d.CounterReconcileReport, error) {
+	novelUUID, err := uuid.Parse(novelID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid novel ID format: %w", err)
+	}
+
+	report, err := s.ReconcileNovels(ctx, []uuid.UUID{novelUUID})
+	if err != nil {
+		return nil, err
+	}
+	if report.NovelsChecked == 0 {
+		return nil, fmt.Errorf("novel not found")
+	}
+
+	return report, nil
+}
+
+// ReconcileNovels recomputes the given novels, deduplicated, in batches
+func (s *CounterService) ReconcileNovels(ctx context.Context, novelIDs []uuid.UUID) (*d.CounterReconcileReport, error) {
+	report := newCounterReconcileReport()
+
+	seen := make(map[uuid.UUID]bool, len(novelIDs))
+	unique := make([]uuid.UUID, 0, len(novelIDs))
+	for _, id := range novelIDs {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+
+	for start := 0; start < len(unique); start += counterReconcileBatch {
+		end := start + counterReconcileBatch
+		if end > len(unique) {
+			end = len(unique)
+		}
+
+		checked, drifts, err := s.repos.Counter.ReconcileNovels(ctx, unique[start:end])
+		if err != nil {
+			return report, err
+		}
+		addCounterDrifts(report, checked, drifts)
+	}
+
+	return report, nil
+}
+
+// newCounterReconcileReport creates an empty report
+func newCounterReconcileReport() *d.CounterReconcileReport {
+	return &d.CounterReconcileReport{
+		Fields: make(map[string]int),
+		Drifts: make([]d.CounterDrift, 0),
+	}
+}
+
+// addCounterDrifts folds one batch into the report, counting each corrected entity once;
+// details are only kept when the report was created with a Drifts slice
+func addCounterDrifts(report *d.CounterReconcileReport, checked int, drifts []d.CounterDrift) {
+	report.NovelsChecked += checked
+
+	corrected := make(map[string]bool)
+	for _, drift := range drifts {
+		report.Fields[drift.EntityType+"."+drift.Field]++
+		if report.Drifts != nil {
+			report.Drifts = append(report.Drifts, drift)
+		}
+
+		key := drift.EntityType + ":" + drift.EntityID
+		if corrected[key] {
+			continue
+		}
+		corrected[key] = true
+		switch drift.EntityType {
+		case d.CounterEntityNovel:
+			report.NovelsCorrected++
+		case d.CounterEntityVolume:
+			report.VolumesCorrected++
+		}
+	}
+}
+
+// reconcileNovelCounters recomputes the counters of novels touched by a bulk change; the
+// scheduled sweep catches anything missed, so a failure is logged rather than failing the write
+func reconcileNovelCounters(ctx context.Context, repos *repositories.Repositories, novelIDs ...uuid.UUID) {
+	if len(novelIDs) == 0 {
+		return
+	}
+
+	if _, _, err := repos.Counter.ReconcileNovels(ctx, novelIDs); err != nil {
+		log.Printf("Warning: failed to reconcile novel counters: %v", err)
+	}
+}
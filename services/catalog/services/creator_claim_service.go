@@ -0,0 +1,152 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+	"wibusystem/services/catalog/repositories"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// CreatorClaimService implements the creator claim and review workflow
+type CreatorClaimService struct {
+	repos *repositories.Repositories
+}
+
+// NewCreatorClaimService creates a new creator claim service
+func NewCreatorClaimService(repos *repositories.Repositories) interfaces.CreatorClaimServiceInterface {
+	return &CreatorClaimService{
+		repos: repos,
+	}
+}
+
+// CreateClaim stores a pending claim on an unclaimed creator
+func (s *CreatorClaimService) CreateClaim(ctx context.Context, creatorID uuid.UUID, req d.CreateCreatorClaimRequest, actor d.ContentActor) (*m.CreatorClaim, error) {
+	evidence := strings.TrimSpace(req.Evidence)
+	if evidence == "" {
+		return nil, fmt.Errorf("evidence is required")
+	}
+
+	claim := &m.CreatorClaim{
+		CreatorID: creatorID,
+		UserID:    actor.UserID,
+		Evidence:  evidence,
+	}
+
+	if req.OnBehalfOfTenant {
+		if actor.TenantID == nil {
+			return nil, fmt.Errorf("tenant is required to claim on behalf of a tenant")
+		}
+		claim.TenantID = actor.TenantID
+	}
+
+	creator, err := s.repos.Creator.GetByID(ctx, creatorID)
+	if err != nil {
+		if strings.Contains(err.Error(), "no rows") {
+			return nil, fmt.Errorf("creator not found")
+		}
+		return nil, fmt.Errorf("failed to get creator: %w", err)
+	}
+	if creator.UserID != nil || creator.TenantID != nil {
+		return nil, fmt.Errorf("creator already claimed")
+	}
+
+	if err := s.repos.CreatorClaim.Create(ctx, claim); err != nil {
+		return nil, err
+	}
+	claim.CreatorName = creator.Name
+
+	return claim, nil
+}
+
+// GetClaim returns a claim to its claimant or a reviewer
+func (s *CreatorClaimService) GetClaim(ctx context.Context, claimID string, actor d.ContentActor, canReview bool) (*m.CreatorClaim, error) {
+	claim, err := s.getClaim(ctx, claimID)
+	if err != nil {
+		return nil, err
+	}
+
+	if claim.UserID != actor.UserID && !canReview {
+		return nil, fmt.Errorf("permission denied: only the claimant or a moderator can view this claim")
+	}
+
+	return claim, nil
+}
+
+// ListClaims returns the review queue; pending claims by default
+func (s *CreatorClaimService) ListClaims(ctx context.Context, req d.ListCreatorClaimsRequest) (*d.PaginatedCreatorClaimsResponse, error) {
+	if req.Status == "" {
+		req.Status = m.CreatorClaimPending
+	}
+
+	return s.list(ctx, req, nil)
+}
+
+// ListMyClaims returns the claims of one user in every status
+func (s *CreatorClaimService) ListMyClaims(ctx context.Context, userID uuid.UUID, req d.ListCreatorClaimsRequest) (*d.PaginatedCreatorClaimsResponse, error) {
+	return s.list(ctx, req, &userID)
+}
+
+// ReviewClaim approves a claim, linking the creator, or rejects it with a reason
+func (s *CreatorClaimService) ReviewClaim(ctx context.Context, claimID string, req d.ReviewCreatorClaimRequest, actor d.ContentActor) (*m.CreatorClaim, error) {
+	claim, err := s.getClaim(ctx, claimID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch req.Action {
+	case "approve":
+		return s.repos.CreatorClaim.Approve(ctx, claim.ID, actor.UserID)
+	case "reject":
+		reason := ""
+		if req.RejectionReason != nil {
+			reason = strings.TrimSpace(*req.RejectionReason)
+		}
+		if reason == "" {
+			return nil, fmt.Errorf("rejection reason is required")
+		}
+		if len(reason) > 1000 {
+			return nil, fmt.Errorf("invalid rejection reason: must not exceed 1000 characters")
+		}
+		if err := s.repos.CreatorClaim.Reject(ctx, claim.ID, actor.UserID, reason); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("invalid review action: %s", req.Action)
+	}
+
+	return s.repos.CreatorClaim.GetByID(ctx, claim.ID)
+}
+
+// list validates the filters and reads a page of claims
+func (s *CreatorClaimService) list(ctx context.Context, req d.ListCreatorClaimsRequest, userID *uuid.UUID) (*d.PaginatedCreatorClaimsResponse, error) {
+	if req.Status != "" && req.Status != m.CreatorClaimPending &&
+		req.Status != m.CreatorClaimApproved && req.Status != m.CreatorClaimRejected {
+		return nil, fmt.Errorf("invalid claim status: %s", req.Status)
+	}
+
+	claims, pagination, err := s.repos.CreatorClaim.List(ctx, req, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d.PaginatedCreatorClaimsResponse{
+		Claims:     claims,
+		Pagination: *pagination,
+	}, nil
+}
+
+// getClaim parses the ID and loads the claim
+func (s *CreatorClaimService) getClaim(ctx context.Context, claimID string) (*m.CreatorClaim, error) {
+	claimUUID, err := uuid.Parse(claimID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid claim ID format: %w", err)
+	}
+
+	return s.repos.CreatorClaim.GetByID(ctx, claimUUID)
+}
@@ -53,17 +53,33 @@ func (s *CreatorService) CreateCreator(ctx context.Context, req d.CreateCreatorR
 	return creator, nil
 }
 
-// GetCreatorByID retrieves a creator by ID
-func (s *CreatorService) GetCreatorByID(ctx context.Context, creatorID uuid.UUID) (*m.Creator, error) {
+// GetCreatorByID retrieves a creator page with its aliases and biographies; the description
+// is served in the first accepted language that has a biography
+func (s *CreatorService) GetCreatorByID(ctx context.Context, creatorID uuid.UUID, languages []string) (*m.Creator, error) {
 	if creatorID == uuid.Nil {
 		return nil, fmt.Errorf("creator ID cannot be nil")
 	}
 
 	creator, err := s.repos.Creator.GetByID(ctx, creatorID)
+	if err != nil && strings.Contains(err.Error(), "no rows") {
+		// A merged creator keeps answering on its old ID
+		targetID, redirectErr := s.repos.Creator.GetRedirect(ctx, creatorID)
+		if redirectErr != nil {
+			return nil, redirectErr
+		}
+		if targetID != nil {
+			creator, err = s.repos.Creator.GetByID(ctx, *targetID)
+		}
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to get creator by ID: %w", err)
 	}
 
+	if err := s.loadProfile(ctx, creator); err != nil {
+		return nil, err
+	}
+	localizeCreator(creator, languages)
+
 	return creator, nil
 }
 
@@ -112,12 +128,12 @@ func (s *CreatorService) UpdateCreator(ctx context.Context, creatorID uuid.UUID,
 
 		// Check if new name already exists (if different from current)
 		if strings.ToLower(name) != strings.ToLower(creator.Name) {
-			exists, err := s.CheckCreatorExists(ctx, name)
+			conflict, err := s.findNameConflict(ctx, name, &creatorID)
 			if err != nil {
 				return nil, fmt.Errorf("failed to check creator existence: %w", err)
 			}
-			if exists {
-				return nil, fmt.Errorf("creator with name '%s' already exists", name)
+			if conflict != nil {
+				return nil, fmt.Errorf("creator with name '%s' already exists", conflict.MatchedName)
 			}
 		}
 
@@ -175,15 +191,243 @@ func (s *CreatorService) ValidateCreatorName(name string) error {
 	return nil
 }
 
-// CheckCreatorExists checks if a creator name already exists
+// CheckCreatorExists checks if a creator name already exists, ignoring case, punctuation and spacing
+// and including the aliases of other creators
 func (s *CreatorService) CheckCreatorExists(ctx context.Context, name string) (bool, error) {
-	_, err := s.repos.Creator.GetByName(ctx, name)
+	conflict, err := s.findNameConflict(ctx, name, nil)
+	if err != nil {
+		return false, err
+	}
+	return conflict != nil, nil
+}
+
+// UpdateCreatorProfile lets the account or tenant that claimed a creator, or an admin, edit its page
+func (s *CreatorService) UpdateCreatorProfile(ctx context.Context, creatorID uuid.UUID, req d.UpdateCreatorProfileRequest, actor d.ContentActor) (*m.Creator, error) {
+	creator, err := s.repos.Creator.GetByID(ctx, creatorID)
 	if err != nil {
-		// If error is "not found", creator doesn't exist
 		if strings.Contains(err.Error(), "no rows") {
-			return false, nil
+			return nil, fmt.Errorf("creator not found")
 		}
-		return false, err
+		return nil, fmt.Errorf("failed to get creator: %w", err)
+	}
+
+	if !canManageCreator(creator, actor) {
+		return nil, fmt.Errorf("permission denied: only the claimed owner can edit this creator")
+	}
+
+	if req.Description != nil {
+		creator.Description = trimmedOrNil(req.Description)
+	}
+
+	if req.SocialLinks != nil {
+		links := make([]m.CreatorSocialLink, 0, len(req.SocialLinks))
+		for _, link := range req.SocialLinks {
+			platform := strings.ToLower(strings.TrimSpace(link.Platform))
+			if platform == "" {
+				return nil, fmt.Errorf("social link platform is required")
+			}
+			links = append(links, m.CreatorSocialLink{Platform: platform, URL: strings.TrimSpace(link.URL)})
+		}
+		creator.SocialLinks = links
+	}
+
+	var biographies []m.CreatorBiography
+	if req.Biographies != nil {
+		biographies = make([]m.CreatorBiography, 0, len(req.Biographies))
+		seen := make(map[string]bool, len(req.Biographies))
+		for _, input := range req.Biographies {
+			code, err := normalizeLanguageCode(input.LanguageCode)
+			if err != nil {
+				return nil, err
+			}
+			if seen[code] {
+				return nil, fmt.Errorf("invalid biographies: language %s is listed twice", code)
+			}
+			seen[code] = true
+
+			biography := strings.TrimSpace(input.Biography)
+			if biography == "" {
+				return nil, fmt.Errorf("biography is required")
+			}
+			biographies = append(biographies, m.CreatorBiography{CreatorID: creator.ID, LanguageCode: code, Biography: biography})
+		}
+	}
+
+	var aliases []m.CreatorAlias
+	if req.Aliases != nil {
+		aliases = make([]m.CreatorAlias, 0, len(req.Aliases))
+		seen := make(map[string]bool, len(req.Aliases))
+		for _, input := range req.Aliases {
+			name := strings.Join(strings.Fields(input.Alias), " ")
+			if name == "" {
+				return nil, fmt.Errorf("alias is required")
+			}
+			if seen[name] || strings.EqualFold(name, creator.Name) {
+				continue
+			}
+			seen[name] = true
+
+			aliasType := input.AliasType
+			if aliasType == "" {
+				aliasType = m.CreatorAliasAlias
+			}
+			if aliasType != m.CreatorAliasAlias && aliasType != m.CreatorAliasPenName {
+				return nil, fmt.Errorf("invalid alias type: %s", aliasType)
+			}
+
+			code, err := optionalLanguageCode(input.LanguageCode)
+			if err != nil {
+				return nil, err
+			}
+			aliases = append(aliases, m.CreatorAlias{CreatorID: creator.ID, Alias: name, AliasType: aliasType, LanguageCode: code})
+		}
+	}
+
+	if err := s.repos.Creator.UpdateProfile(ctx, creator, biographies, aliases); err != nil {
+		return nil, err
 	}
-	return true, nil
-}
\ No newline at end of file
+
+	if err := s.loadProfile(ctx, creator); err != nil {
+		return nil, err
+	}
+	return creator, nil
+}
+
+// ListCreatorWorks lists the public novels, manga and anime of a creator with their roles
+func (s *CreatorService) ListCreatorWorks(ctx context.Context, creatorID uuid.UUID, req d.ListCreatorWorksRequest) ([]d.CreatorWork, int64, error) {
+	if req.Page < 1 {
+		req.Page = 1
+	}
+	if req.PageSize < 1 || req.PageSize > 100 {
+		req.PageSize = 20
+	}
+
+	if _, err := s.repos.Creator.GetByID(ctx, creatorID); err != nil {
+		if strings.Contains(err.Error(), "no rows") {
+			return nil, 0, fmt.Errorf("creator not found")
+		}
+		return nil, 0, fmt.Errorf("failed to get creator: %w", err)
+	}
+
+	works, total, err := s.repos.Creator.ListWorks(ctx, creatorID, req)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return works, total, nil
+}
+
+// loadProfile attaches the aliases and biographies shown on a creator page
+func (s *CreatorService) loadProfile(ctx context.Context, creator *m.Creator) error {
+	aliases, err := s.repos.Creator.GetAliases(ctx, creator.ID)
+	if err != nil {
+		return err
+	}
+	biographies, err := s.repos.Creator.GetBiographies(ctx, creator.ID)
+	if err != nil {
+		return err
+	}
+
+	creator.Aliases = aliases
+	creator.Biographies = biographies
+	return nil
+}
+
+// localizeCreator replaces the default description with the biography in the first accepted
+// language that has one
+func localizeCreator(creator *m.Creator, languages []string) {
+	codes := make([]string, 0, len(creator.Biographies))
+	for _, biography := range creator.Biographies {
+		codes = append(codes, biography.LanguageCode)
+	}
+
+	// Creators have no original language: an empty one never matches a preference
+	served := pickContentLanguage(languages, "", codes)
+	for _, biography := range creator.Biographies {
+		if served != "" && strings.EqualFold(biography.LanguageCode, served) {
+			text := biography.Biography
+			creator.Description = &text
+			return
+		}
+	}
+}
+
+// canManageCreator reports whether the actor owns the creator page through an approved claim
+func canManageCreator(creator *m.Creator, actor d.ContentActor) bool {
+	if actor.IsAdmin {
+		return true
+	}
+	if creator.UserID != nil && *creator.UserID == actor.UserID {
+		return true
+	}
+	return creator.TenantID != nil && actor.TenantID != nil && *creator.TenantID == *actor.TenantID
+}
+
+// FindDuplicateCreators lists creators that may be the same as the given name
+func (s *CreatorService) FindDuplicateCreators(ctx context.Context, req d.FindDuplicatesRequest) ([]d.DuplicateCandidate, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+
+	return s.repos.Creator.FindDuplicates(ctx, []string{name}, nil, duplicateLimit(req.Limit))
+}
+
+// FindCreatorDuplicatesOf lists other creators that may be the same as an existing one
+func (s *CreatorService) FindCreatorDuplicatesOf(ctx context.Context, creatorID uuid.UUID, req d.FindDuplicatesRequest) ([]d.DuplicateCandidate, error) {
+	creator, err := s.repos.Creator.GetByID(ctx, creatorID)
+	if err != nil {
+		if strings.Contains(err.Error(), "no rows") {
+			return nil, fmt.Errorf("creator not found")
+		}
+		return nil, fmt.Errorf("failed to get creator: %w", err)
+	}
+
+	names := []string{creator.Name}
+	aliases, err := s.repos.Creator.GetAliases(ctx, creatorID)
+	if err != nil {
+		return nil, err
+	}
+	for _, alias := range aliases {
+		names = append(names, alias.Alias)
+	}
+
+	return s.repos.Creator.FindDuplicates(ctx, names, &creatorID, duplicateLimit(req.Limit))
+}
+
+// MergeCreators folds a duplicate creator into the target; the duplicate's ID redirects afterwards
+func (s *CreatorService) MergeCreators(ctx context.Context, creatorID uuid.UUID, req d.MergeCreatorRequest, actor d.ContentActor) (*d.CreatorMergeResponse, error) {
+	targetID, err := uuid.Parse(req.TargetCreatorID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid target creator ID format: %w", err)
+	}
+	if targetID == creatorID {
+		return nil, fmt.Errorf("invalid merge: a creator cannot be merged into itself")
+	}
+
+	return s.repos.Creator.Merge(ctx, creatorID, targetID, actor.UserID)
+}
+
+// findNameConflict returns another creator whose name or alias has the same name key
+func (s *CreatorService) findNameConflict(ctx context.Context, name string, excludeID *uuid.UUID) (*d.DuplicateCandidate, error) {
+	candidates, err := s.repos.Creator.FindDuplicates(ctx, []string{strings.TrimSpace(name)}, excludeID, 1)
+	if err != nil {
+		return nil, err
+	}
+	// Candidates are ordered strongest first, so a merely similar name means no conflict
+	if len(candidates) == 0 || candidates[0].MatchType == d.DuplicateMatchSimilar {
+		return nil, nil
+	}
+	return &candidates[0], nil
+}
+
+// duplicateLimit clamps the number of duplicate candidates returned
+func duplicateLimit(limit int) int {
+	if limit < 1 {
+		return 10
+	}
+	if limit > 50 {
+		return 50
+	}
+	return limit
+}
@@ -40,20 +40,43 @@ func (s *GenreService) CreateGenre(ctx context.Context, req d.CreateGenreRequest
 		return nil, fmt.Errorf("genre with name '%s' already exists", req.Name)
 	}
 
+	translations, err := validateGenreTranslations(req.Translations)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create genre
 	genre := &m.Genre{
-		Name: strings.TrimSpace(req.Name),
+		Name:        strings.TrimSpace(req.Name),
+		Description: trimmedOrNil(req.Description),
+		SortOrder:   req.SortOrder,
+	}
+
+	if req.ParentID != nil && *req.ParentID != "" {
+		parentID, err := s.resolveParent(ctx, uuid.Nil, *req.ParentID)
+		if err != nil {
+			return nil, err
+		}
+		genre.ParentID = &parentID
 	}
 
 	if err := s.repos.Genre.Create(ctx, genre); err != nil {
 		return nil, fmt.Errorf("failed to create genre: %w", err)
 	}
 
+	if len(translations) > 0 {
+		if err := s.repos.Genre.SetTranslations(ctx, genre.ID, translations); err != nil {
+			return nil, err
+		}
+		genre.Translations = translations
+	}
+
 	return genre, nil
 }
 
-// GetGenreByID retrieves a genre by ID
-func (s *GenreService) GetGenreByID(ctx context.Context, genreID uuid.UUID) (*m.Genre, error) {
+// GetGenreByID retrieves a genre by ID with all its translations; name and description
+// are served in the first of the reader's languages that has a translation
+func (s *GenreService) GetGenreByID(ctx context.Context, genreID uuid.UUID, languages []string) (*m.Genre, error) {
 	if genreID == uuid.Nil {
 		return nil, fmt.Errorf("genre ID cannot be nil")
 	}
@@ -63,11 +86,18 @@ func (s *GenreService) GetGenreByID(ctx context.Context, genreID uuid.UUID) (*m.
 		return nil, fmt.Errorf("failed to get genre by ID: %w", err)
 	}
 
+	translations, err := s.repos.Genre.GetTranslations(ctx, []uuid.UUID{genreID})
+	if err != nil {
+		return nil, err
+	}
+	genre.Translations = translations[genreID]
+	localizeGenre(genre, genre.Translations, languages)
+
 	return genre, nil
 }
 
 // ListGenres retrieves paginated list of genres with optional search
-func (s *GenreService) ListGenres(ctx context.Context, req d.ListGenresRequest) ([]*m.Genre, int64, error) {
+func (s *GenreService) ListGenres(ctx context.Context, req d.ListGenresRequest, languages []string) ([]*m.Genre, int64, error) {
 	// Validate pagination parameters
 	if req.Page < 1 {
 		req.Page = 1
@@ -87,9 +117,52 @@ func (s *GenreService) ListGenres(ctx context.Context, req d.ListGenresRequest)
 		return nil, 0, fmt.Errorf("failed to list genres: %w", err)
 	}
 
+	if err := s.localizeGenres(ctx, genres, languages); err != nil {
+		return nil, 0, err
+	}
+
 	return genres, total, nil
 }
 
+// GetGenreTree returns the whole taxonomy as a forest of top-level genres, siblings ordered
+// by sort_order then name, with names in the reader's language
+func (s *GenreService) GetGenreTree(ctx context.Context, languages []string) ([]*d.GenreTreeNode, error) {
+	genres, err := s.repos.Genre.ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.localizeGenres(ctx, genres, languages); err != nil {
+		return nil, err
+	}
+
+	nodes := make(map[uuid.UUID]*d.GenreTreeNode, len(genres))
+	for _, genre := range genres {
+		nodes[genre.ID] = &d.GenreTreeNode{
+			ID:          genre.ID.String(),
+			Name:        genre.Name,
+			Description: genre.Description,
+			SortOrder:   genre.SortOrder,
+			Children:    []*d.GenreTreeNode{},
+		}
+	}
+
+	// Genres arrive sorted, so appending keeps every level in order
+	roots := []*d.GenreTreeNode{}
+	for _, genre := range genres {
+		node := nodes[genre.ID]
+		if genre.ParentID != nil {
+			if parent, ok := nodes[*genre.ParentID]; ok {
+				parent.Children = append(parent.Children, node)
+				continue
+			}
+		}
+		roots = append(roots, node)
+	}
+
+	return roots, nil
+}
+
 // UpdateGenre updates genre information
 func (s *GenreService) UpdateGenre(ctx context.Context, genreID uuid.UUID, req d.UpdateGenreRequest) (*m.Genre, error) {
 	if genreID == uuid.Nil {
@@ -123,11 +196,46 @@ func (s *GenreService) UpdateGenre(ctx context.Context, genreID uuid.UUID, req d
 		genre.Name = name
 	}
 
+	if req.ParentID != nil {
+		genre.ParentID = nil
+		if *req.ParentID != "" {
+			parentID, err := s.resolveParent(ctx, genre.ID, *req.ParentID)
+			if err != nil {
+				return nil, err
+			}
+			genre.ParentID = &parentID
+		}
+	}
+
+	if req.Description != nil {
+		genre.Description = trimmedOrNil(req.Description)
+	}
+
+	if req.SortOrder != nil {
+		genre.SortOrder = *req.SortOrder
+	}
+
+	// A nil list keeps the translations, an empty one removes them
+	var translations []m.GenreTranslation
+	if req.Translations != nil {
+		translations, err = validateGenreTranslations(req.Translations)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Update genre
 	if err := s.repos.Genre.Update(ctx, genre); err != nil {
 		return nil, fmt.Errorf("failed to update genre: %w", err)
 	}
 
+	if req.Translations != nil {
+		if err := s.repos.Genre.SetTranslations(ctx, genre.ID, translations); err != nil {
+			return nil, err
+		}
+		genre.Translations = translations
+	}
+
 	return genre, nil
 }
 
@@ -151,6 +259,171 @@ func (s *GenreService) DeleteGenre(ctx context.Context, genreID uuid.UUID) error
 	return nil
 }
 
+// MergeGenres folds a duplicate genre into the target genre, reassigning every novel, manga
+// and anime link and every sub-genre, then deletes the duplicate
+func (s *GenreService) MergeGenres(ctx context.Context, genreID uuid.UUID, req d.MergeGenreRequest) (*d.GenreMergeResponse, error) {
+	if genreID == uuid.Nil {
+		return nil, fmt.Errorf("genre ID cannot be nil")
+	}
+
+	targetID, err := uuid.Parse(req.TargetGenreID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid target genre ID format: %w", err)
+	}
+	if targetID == genreID {
+		return nil, fmt.Errorf("invalid merge: a genre cannot be merged into itself")
+	}
+
+	return s.repos.Genre.Merge(ctx, genreID, targetID)
+}
+
+// resolveParent parses a parent genre ID and checks that it exists and does not sit below
+// the genre itself, which would create a cycle; genreID is uuid.Nil for a new genre
+func (s *GenreService) resolveParent(ctx context.Context, genreID uuid.UUID, parentID string) (uuid.UUID, error) {
+	parentUUID, err := uuid.Parse(parentID)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid parent genre ID format: %w", err)
+	}
+
+	if _, err := s.repos.Genre.GetByID(ctx, parentUUID); err != nil {
+		return uuid.Nil, fmt.Errorf("parent genre not found: %w", err)
+	}
+
+	if genreID == uuid.Nil {
+		return parentUUID, nil
+	}
+	if parentUUID == genreID {
+		return uuid.Nil, fmt.Errorf("invalid parent genre: a genre cannot be its own parent")
+	}
+
+	descendant, err := s.repos.Genre.IsDescendant(ctx, parentUUID, genreID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if descendant {
+		return uuid.Nil, fmt.Errorf("invalid parent genre: the parent is a sub-genre of this genre")
+	}
+
+	return parentUUID, nil
+}
+
+// localizeGenres serves the names and descriptions of several genres in the reader's language
+func (s *GenreService) localizeGenres(ctx context.Context, genres []*m.Genre, languages []string) error {
+	if len(genres) == 0 || len(languages) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(genres))
+	for _, genre := range genres {
+		ids = append(ids, genre.ID)
+	}
+
+	translations, err := s.repos.Genre.GetTranslations(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	for _, genre := range genres {
+		localizeGenre(genre, translations[genre.ID], languages)
+	}
+	return nil
+}
+
+// localizeGenre replaces the name and description with the translation in the first accepted
+// language that has one; the default name stays otherwise
+func localizeGenre(genre *m.Genre, translations []m.GenreTranslation, languages []string) {
+	codes := make([]string, 0, len(translations))
+	for _, translation := range translations {
+		codes = append(codes, translation.LanguageCode)
+	}
+
+	// Genres have no original language: an empty one never matches a preference
+	served := pickContentLanguage(languages, "", codes)
+	for _, translation := range translations {
+		if served == "" || !strings.EqualFold(translation.LanguageCode, served) {
+			continue
+		}
+		genre.Name = translation.Name
+		if translation.Description != nil {
+			genre.Description = translation.Description
+		}
+		return
+	}
+}
+
+// localizeGenreInfos names the genres of a content detail in the reader's language
+func localizeGenreInfos(ctx context.Context, repos *repositories.Repositories, genres []d.GenreInfo, languages []string) error {
+	if len(genres) == 0 || len(languages) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(genres))
+	for _, genre := range genres {
+		if id, err := uuid.Parse(genre.ID); err == nil {
+			ids = append(ids, id)
+		}
+	}
+
+	translations, err := repos.Genre.GetTranslations(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	for i := range genres {
+		id, err := uuid.Parse(genres[i].ID)
+		if err != nil {
+			continue
+		}
+		genre := m.Genre{Name: genres[i].Name}
+		localizeGenre(&genre, translations[id], languages)
+		genres[i].Name = genre.Name
+	}
+	return nil
+}
+
+// validateGenreTranslations normalizes language codes and rejects duplicates
+func validateGenreTranslations(inputs []d.GenreTranslationInput) ([]m.GenreTranslation, error) {
+	translations := make([]m.GenreTranslation, 0, len(inputs))
+	seen := make(map[string]bool, len(inputs))
+	for _, input := range inputs {
+		code, err := normalizeLanguageCode(input.LanguageCode)
+		if err != nil {
+			return nil, err
+		}
+		if seen[code] {
+			return nil, fmt.Errorf("invalid translations: duplicate language %s", code)
+		}
+		seen[code] = true
+
+		name := strings.TrimSpace(input.Name)
+		if name == "" {
+			return nil, fmt.Errorf("invalid translations: name is required for language %s", code)
+		}
+		if len([]rune(name)) > 100 {
+			return nil, fmt.Errorf("invalid translations: name for language %s must not exceed 100 characters", code)
+		}
+
+		translations = append(translations, m.GenreTranslation{
+			LanguageCode: code,
+			Name:         name,
+			Description:  trimmedOrNil(input.Description),
+		})
+	}
+	return translations, nil
+}
+
+// trimmedOrNil trims an optional text and drops it when blank
+func trimmedOrNil(value *string) *string {
+	if value == nil {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*value)
+	if trimmed == "" {
+		return nil
+	}
+	return &trimmed
+}
+
 // ValidateGenreName validates genre name
 func (s *GenreService) ValidateGenreName(name string) error {
 	name = strings.TrimSpace(name)
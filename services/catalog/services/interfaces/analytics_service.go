@@ -0,0 +1,22 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	d "wibusystem/pkg/common/dto"
+)
+
+// AnalyticsServiceInterface defines the contract for the owner analytics dashboard
+type AnalyticsServiceInterface interface {
+	// GetNovelAnalytics returns the series, totals and chapter drop-off of a novel.
+	// Admins bypass the ownership and VIEW_ANALYTICS check.
+	GetNovelAnalytics(ctx context.Context, novelID string, userID uuid.UUID, tenantID *uuid.UUID, isAdmin bool, req d.NovelAnalyticsRequest) (*d.NovelAnalyticsResponse, error)
+
+	// RecordChapterRead counts a chapter read; userID is nil for anonymous readers
+	RecordChapterRead(ctx context.Context, chapterID string, userID *uuid.UUID) error
+
+	// RollupAnalytics refreshes the recent aggregates and purges expired reader activity
+	RollupAnalytics(ctx context.Context) error
+}
@@ -0,0 +1,40 @@
+package interfaces
+
+import (
+	"context"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+)
+
+// AnimeEpisodeServiceInterface defines the contract for anime season, episode and subtitle operations
+type AnimeEpisodeServiceInterface interface {
+	// Seasons
+	CreateSeason(ctx context.Context, animeID string, req d.CreateAnimeSeasonRequest, actor d.ContentActor) (*d.AnimeSeasonSummary, error)
+	ListSeasons(ctx context.Context, animeID string) ([]d.AnimeSeasonSummary, error)
+	UpdateSeason(ctx context.Context, seasonID string, req d.UpdateAnimeSeasonRequest, actor d.ContentActor) (*d.AnimeSeasonSummary, error)
+	DeleteSeason(ctx context.Context, seasonID string, actor d.ContentActor) error
+
+	// Episodes
+	CreateEpisode(ctx context.Context, seasonID string, req d.CreateAnimeEpisodeRequest, actor d.ContentActor) (*d.AnimeEpisodeResponse, error)
+	// ListPublishedEpisodes lists the published episodes of a publicly visible anime
+	ListPublishedEpisodes(ctx context.Context, seasonID string, req d.ListAnimeEpisodesRequest) (*d.PaginatedAnimeEpisodesResponse, error)
+	// ListAllEpisodes lists drafts as well; restricted to owners and collaborators
+	ListAllEpisodes(ctx context.Context, seasonID string, req d.ListAnimeEpisodesRequest, actor d.ContentActor) (*d.PaginatedAnimeEpisodesResponse, error)
+	// GetPublishedEpisode returns a published episode; the video URL is only included for free episodes
+	GetPublishedEpisode(ctx context.Context, episodeID string) (*d.AnimeEpisodeResponse, error)
+	// GetEpisodeStream returns the episode with its video URL for entitled viewers, owners and collaborators
+	GetEpisodeStream(ctx context.Context, episodeID string, actor d.ContentActor) (*d.AnimeEpisodeResponse, error)
+	UpdateEpisode(ctx context.Context, episodeID string, req d.UpdateAnimeEpisodeRequest, actor d.ContentActor) (*d.AnimeEpisodeResponse, error)
+	DeleteEpisode(ctx context.Context, episodeID string, actor d.ContentActor) error
+	PublishEpisode(ctx context.Context, episodeID string, req d.PublishAnimeEpisodeRequest, actor d.ContentActor) (*d.AnimeEpisodeResponse, error)
+	UnpublishEpisode(ctx context.Context, episodeID string, actor d.ContentActor) (*d.AnimeEpisodeResponse, error)
+
+	// Subtitles
+	SetSubtitle(ctx context.Context, episodeID, languageCode string, req d.SetEpisodeSubtitleRequest, actor d.ContentActor) (*d.EpisodeSubtitleResponse, error)
+	// GetSubtitleFile returns a subtitle track of a published episode, with its WebVTT content when hosted
+	GetSubtitleFile(ctx context.Context, episodeID, languageCode string) (*m.EpisodeSubtitle, error)
+	// ShiftSubtitle moves every cue of a hosted subtitle by an offset
+	ShiftSubtitle(ctx context.Context, episodeID, languageCode string, req d.ShiftEpisodeSubtitleRequest, actor d.ContentActor) (*d.EpisodeSubtitleResponse, error)
+	DeleteSubtitle(ctx context.Context, episodeID, languageCode string, actor d.ContentActor) error
+}
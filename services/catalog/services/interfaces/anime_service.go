@@ -0,0 +1,22 @@
+package interfaces
+
+import (
+	"context"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+)
+
+// AnimeServiceInterface defines the contract for anime series operations.
+// Management methods check ownership or collaborator permissions unless the actor is an admin.
+type AnimeServiceInterface interface {
+	CreateAnime(ctx context.Context, req d.CreateAnimeRequest, actor d.ContentActor) (*m.Anime, error)
+	// ListAnime lists publicly visible anime with titles in the requested language
+	ListAnime(ctx context.Context, req d.ListAnimeRequest, language string) (*d.PaginatedAnimeResponse, error)
+	// GetAnimeByID returns a publicly visible anime with titles in the requested language
+	GetAnimeByID(ctx context.Context, id string, language string) (*d.AnimeDetailResponse, error)
+	UpdateAnime(ctx context.Context, id string, req d.UpdateAnimeRequest, actor d.ContentActor) (*m.Anime, error)
+	DeleteAnime(ctx context.Context, id string, actor d.ContentActor) error
+	SetTranslations(ctx context.Context, id string, req d.SetAnimeTranslationsRequest, actor d.ContentActor) error
+	SetCast(ctx context.Context, id string, req d.SetAnimeCastRequest, actor d.ContentActor) error
+}
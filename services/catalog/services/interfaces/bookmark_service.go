@@ -0,0 +1,18 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	d "wibusystem/pkg/common/dto"
+)
+
+// BookmarkServiceInterface defines the contract for reader bookmarks
+type BookmarkServiceInterface interface {
+	// AddBookmark bookmarks a novel for the reader (idempotent)
+	AddBookmark(ctx context.Context, userID uuid.UUID, novelID string) (*d.BookmarkResponse, error)
+
+	// RemoveBookmark removes the reader's bookmark (idempotent)
+	RemoveBookmark(ctx context.Context, userID uuid.UUID, novelID string) (*d.BookmarkResponse, error)
+}
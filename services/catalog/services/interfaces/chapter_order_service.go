@@ -0,0 +1,22 @@
+package interfaces
+
+import (
+	"context"
+
+	d "wibusystem/pkg/common/dto"
+)
+
+// ChapterOrderServiceInterface defines restructuring of a novel's chapters and volumes
+type ChapterOrderServiceInterface interface {
+	// ReorderChapters renumbers the chapters of a volume in the given order
+	ReorderChapters(ctx context.Context, volumeID string, req d.ReorderChaptersRequest, actor d.ContentActor) (*d.ChapterRestructureResponse, error)
+
+	// MoveChapters moves chapters of the same novel into a volume at the given position
+	MoveChapters(ctx context.Context, targetVolumeID string, req d.MoveChaptersRequest, actor d.ContentActor) (*d.ChapterRestructureResponse, error)
+
+	// SplitVolume moves a chapter and the chapters after it into a new volume
+	SplitVolume(ctx context.Context, volumeID string, req d.SplitVolumeRequest, actor d.ContentActor) (*d.ChapterRestructureResponse, error)
+
+	// MergeVolume appends a volume's chapters to another volume and moves it to the trash
+	MergeVolume(ctx context.Context, volumeID string, req d.MergeVolumeRequest, actor d.ContentActor) (*d.ChapterRestructureResponse, error)
+}
@@ -20,8 +20,9 @@ type ChapterServiceInterface interface {
 	// Parameters:
 	//   - id: UUID string of the chapter
 	//   - includeContent: Flag to include chapter content in the response
+	//   - languages: Preferred content languages, best first; the novel's original language is the fallback
 	// Returns chapter details or an error if not found.
-	GetChapterByID(ctx context.Context, id string, includeContent bool) (*d.ChapterResponse, error)
+	GetChapterByID(ctx context.Context, id string, includeContent bool, languages []string) (*d.ChapterResponse, error)
 
 	// ListChaptersByVolumeID retrieves a paginated list of chapters in a volume.
 	// Parameters:
@@ -0,0 +1,36 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	d "wibusystem/pkg/common/dto"
+)
+
+// CharacterContributionServiceInterface defines the contract for the character proposal and review workflow
+type CharacterContributionServiceInterface interface {
+	// CreateContribution proposes a new character, or an edit when character_id is set
+	CreateContribution(ctx context.Context, userID uuid.UUID, tenantID *uuid.UUID, req d.CreateCharacterContributionRequest) (*d.CharacterContributionResponse, error)
+
+	// UpdateContribution revises a pending contribution of the calling user
+	UpdateContribution(ctx context.Context, contributionID string, userID uuid.UUID, req d.UpdateCharacterContributionRequest) (*d.CharacterContributionResponse, error)
+
+	// GetContribution returns a contribution with its field-level diff; canReview grants access to others' contributions
+	GetContribution(ctx context.Context, contributionID string, userID uuid.UUID, canReview bool) (*d.CharacterContributionResponse, error)
+
+	// ListContributions returns the review queue
+	ListContributions(ctx context.Context, req d.ListCharacterContributionsRequest) (*d.PaginatedCharacterContributionsResponse, error)
+
+	// ListMyContributions returns the contributions of the calling user
+	ListMyContributions(ctx context.Context, userID uuid.UUID, req d.ListCharacterContributionsRequest) (*d.PaginatedCharacterContributionsResponse, error)
+
+	// ApproveContribution merges a pending contribution and attributes it to its contributor
+	ApproveContribution(ctx context.Context, contributionID string, reviewerID uuid.UUID) (*d.CharacterContributionResponse, error)
+
+	// RejectContribution rejects a pending contribution with a reason
+	RejectContribution(ctx context.Context, contributionID string, reviewerID uuid.UUID, req d.RejectCharacterContributionRequest) error
+
+	// ListContributors returns the users credited with approved contributions to a character
+	ListContributors(ctx context.Context, characterID uuid.UUID) ([]d.CharacterContributorResponse, error)
+}
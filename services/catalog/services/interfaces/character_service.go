@@ -30,4 +30,13 @@ type CharacterServiceInterface interface {
 
 	// CheckCharacterExists checks if a character name already exists
 	CheckCharacterExists(ctx context.Context, name string) (bool, error)
+
+	// FindDuplicateCharacters lists characters that may be the same as a name
+	FindDuplicateCharacters(ctx context.Context, req d.FindDuplicatesRequest) ([]d.DuplicateCandidate, error)
+
+	// FindCharacterDuplicatesOf lists other characters that may be the same as an existing one
+	FindCharacterDuplicatesOf(ctx context.Context, characterID uuid.UUID, req d.FindDuplicatesRequest) ([]d.DuplicateCandidate, error)
+
+	// MergeCharacters folds a duplicate character into another and keeps a redirect from its ID
+	MergeCharacters(ctx context.Context, characterID uuid.UUID, req d.MergeCharacterRequest, actor d.ContentActor) (*d.CharacterMergeResponse, error)
 }
\ No newline at end of file
@@ -0,0 +1,70 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+)
+
+// ContentFilterServiceInterface defines age gating and reader content filters
+type ContentFilterServiceInterface interface {
+	// GetMyFilter returns the current user's content filters
+	GetMyFilter(ctx context.Context, userID uuid.UUID) (*m.UserContentFilter, error)
+
+	// UpdateMyFilter changes the current user's content filters
+	UpdateMyFilter(ctx context.Context, userID uuid.UUID, req d.UpdateContentFilterRequest) (*m.UserContentFilter, error)
+
+	// GetTenantPolicy returns a tenant's age gating policy
+	GetTenantPolicy(ctx context.Context, tenantID string, actor d.ContentActor) (*m.TenantContentPolicy, error)
+
+	// UpdateTenantPolicy replaces a tenant's age gating policy
+	UpdateTenantPolicy(ctx context.Context, tenantID string, req d.UpdateTenantContentPolicyRequest, actor d.ContentActor) (*m.TenantContentPolicy, error)
+
+	// Visibility returns the viewer's gating for list queries to apply in SQL, nil for admins
+	Visibility(ctx context.Context, viewer d.ContentViewer) (*d.ContentVisibility, error)
+
+	// FilterNovels marks the blurred or warned novels of a page already gated by Visibility
+	FilterNovels(ctx context.Context, viewer d.ContentViewer, novels []d.NovelSummaryResponse) ([]d.NovelSummaryResponse, error)
+
+	// FilterRankedNovels marks the blurred or warned novels of a ranking page already gated by Visibility
+	FilterRankedNovels(ctx context.Context, viewer d.ContentViewer, novels []d.RankedNovelResponse) ([]d.RankedNovelResponse, error)
+
+	// FilterRecommendations marks the blurred or warned novels of recommendations already gated by Visibility
+	FilterRecommendations(ctx context.Context, viewer d.ContentViewer, novels []d.RecommendedNovelResponse) ([]d.RecommendedNovelResponse, error)
+
+	// FilterManga marks the blurred manga of a page already gated by Visibility
+	FilterManga(ctx context.Context, viewer d.ContentViewer, manga []d.MangaSummaryResponse) ([]d.MangaSummaryResponse, error)
+
+	// FilterAnime marks the blurred anime of a page already gated by Visibility
+	FilterAnime(ctx context.Context, viewer d.ContentViewer, anime []d.AnimeSummaryResponse) ([]d.AnimeSummaryResponse, error)
+
+	// CheckNovel returns the viewer's restriction on a novel, nil when unrestricted
+	CheckNovel(ctx context.Context, viewer d.ContentViewer, novelID string) (*d.ContentRestriction, error)
+
+	// FilterReaderChapters drops hidden chapters of a volume, marks blurred ones and returns how many were hidden
+	FilterReaderChapters(ctx context.Context, viewer d.ContentViewer, volumeID string, chapters []d.ReaderChapter) ([]d.ReaderChapter, int, error)
+
+	// CheckNovelChapter returns the viewer's restriction on a novel chapter, nil when unrestricted
+	CheckNovelChapter(ctx context.Context, viewer d.ContentViewer, chapterID string) (*d.ContentRestriction, error)
+
+	// CheckMangaChapter returns the viewer's restriction on a manga chapter, nil when unrestricted
+	CheckMangaChapter(ctx context.Context, viewer d.ContentViewer, chapterID string) (*d.ContentRestriction, error)
+
+	// CheckManga returns the viewer's restriction on a manga, nil when unrestricted
+	CheckManga(ctx context.Context, viewer d.ContentViewer, mangaID string) (*d.ContentRestriction, error)
+
+	// CheckMangaVolume returns the viewer's restriction on the manga of a volume, nil when unrestricted
+	CheckMangaVolume(ctx context.Context, viewer d.ContentViewer, volumeID string) (*d.ContentRestriction, error)
+
+	// CheckAnime returns the viewer's restriction on an anime, nil when unrestricted
+	CheckAnime(ctx context.Context, viewer d.ContentViewer, animeID string) (*d.ContentRestriction, error)
+
+	// CheckAnimeSeason returns the viewer's restriction on the anime of a season, nil when unrestricted
+	CheckAnimeSeason(ctx context.Context, viewer d.ContentViewer, seasonID string) (*d.ContentRestriction, error)
+
+	// CheckAnimeEpisode returns the viewer's restriction on the anime of an episode, nil when unrestricted
+	CheckAnimeEpisode(ctx context.Context, viewer d.ContentViewer, episodeID string) (*d.ContentRestriction, error)
+}
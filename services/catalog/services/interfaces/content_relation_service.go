@@ -0,0 +1,29 @@
+package interfaces
+
+import (
+	"context"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+)
+
+// ContentRelationServiceInterface defines the contract for relations between works
+type ContentRelationServiceInterface interface {
+	// CreateRelation links two existing works and records the inverse relation
+	CreateRelation(ctx context.Context, req d.CreateContentRelationRequest) (*m.ContentRelation, error)
+
+	// GetRelation retrieves a relation by ID
+	GetRelation(ctx context.Context, relationID string) (*m.ContentRelation, error)
+
+	// ListRelations returns the relations of a work, as seen from that work
+	ListRelations(ctx context.Context, req d.ListContentRelationsRequest) ([]m.ContentRelation, error)
+
+	// UpdateRelation changes the type of a relation and of its inverse
+	UpdateRelation(ctx context.Context, relationID string, req d.UpdateContentRelationRequest) (*m.ContentRelation, error)
+
+	// DeleteRelation removes a relation and its inverse
+	DeleteRelation(ctx context.Context, relationID string) error
+
+	// GetGraph returns the works connected to a root work up to the requested depth
+	GetGraph(ctx context.Context, req d.ContentRelationGraphRequest) (*d.ContentRelationGraphResponse, error)
+}
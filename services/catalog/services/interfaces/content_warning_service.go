@@ -0,0 +1,20 @@
+package interfaces
+
+import (
+	"context"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+)
+
+// ContentWarningServiceInterface defines the managed content warning taxonomy
+type ContentWarningServiceInterface interface {
+	// ListWarnings returns the taxonomy labelled in the first preferred language available
+	ListWarnings(ctx context.Context, req d.ListContentWarningsRequest, languages []string) ([]*m.ContentWarning, error)
+
+	// CreateWarning registers a content warning
+	CreateWarning(ctx context.Context, req d.CreateContentWarningRequest) (*m.ContentWarning, error)
+
+	// UpdateWarning changes a content warning's severity, order, status or labels
+	UpdateWarning(ctx context.Context, code string, req d.UpdateContentWarningRequest) (*m.ContentWarning, error)
+}
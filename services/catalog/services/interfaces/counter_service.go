@@ -0,0 +1,21 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	d "wibusystem/pkg/common/dto"
+)
+
+// CounterServiceInterface defines the reconciliation of denormalized novel and volume counters
+type CounterServiceInterface interface {
+	// ReconcileAll sweeps every novel in batches; invoked by the scheduler
+	ReconcileAll(ctx context.Context) (*d.CounterReconcileReport, error)
+
+	// ReconcileNovel recomputes the counters of one novel and its volumes; invoked by admins
+	ReconcileNovel(ctx context.Context, novelID string) (*d.CounterReconcileReport, error)
+
+	// ReconcileNovels recomputes the given novels; invoked by admins and import tooling after bulk imports
+	ReconcileNovels(ctx context.Context, novelIDs []uuid.UUID) (*d.CounterReconcileReport, error)
+}
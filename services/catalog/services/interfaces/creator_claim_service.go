@@ -0,0 +1,28 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+)
+
+// CreatorClaimServiceInterface defines the contract for claiming creator pages and reviewing the claims
+type CreatorClaimServiceInterface interface {
+	// CreateClaim asks to link a creator to the calling user, or to their tenant
+	CreateClaim(ctx context.Context, creatorID uuid.UUID, req d.CreateCreatorClaimRequest, actor d.ContentActor) (*m.CreatorClaim, error)
+
+	// GetClaim returns a claim; canReview grants access to others' claims
+	GetClaim(ctx context.Context, claimID string, actor d.ContentActor, canReview bool) (*m.CreatorClaim, error)
+
+	// ListClaims returns the review queue
+	ListClaims(ctx context.Context, req d.ListCreatorClaimsRequest) (*d.PaginatedCreatorClaimsResponse, error)
+
+	// ListMyClaims returns the claims of the calling user
+	ListMyClaims(ctx context.Context, userID uuid.UUID, req d.ListCreatorClaimsRequest) (*d.PaginatedCreatorClaimsResponse, error)
+
+	// ReviewClaim approves or rejects a pending claim
+	ReviewClaim(ctx context.Context, claimID string, req d.ReviewCreatorClaimRequest, actor d.ContentActor) (*m.CreatorClaim, error)
+}
@@ -13,8 +13,8 @@ type CreatorServiceInterface interface {
 	// CreateCreator creates a new creator with validation
 	CreateCreator(ctx context.Context, req d.CreateCreatorRequest) (*m.Creator, error)
 
-	// GetCreatorByID retrieves a creator by ID
-	GetCreatorByID(ctx context.Context, creatorID uuid.UUID) (*m.Creator, error)
+	// GetCreatorByID retrieves a creator page; the description follows the accepted languages
+	GetCreatorByID(ctx context.Context, creatorID uuid.UUID, languages []string) (*m.Creator, error)
 
 	// ListCreators retrieves paginated list of creators with optional search
 	ListCreators(ctx context.Context, req d.ListCreatorsRequest) ([]*m.Creator, int64, error)
@@ -30,4 +30,19 @@ type CreatorServiceInterface interface {
 
 	// CheckCreatorExists checks if a creator name already exists
 	CheckCreatorExists(ctx context.Context, name string) (bool, error)
+
+	// UpdateCreatorProfile edits the biographies, social links and aliases of a claimed creator
+	UpdateCreatorProfile(ctx context.Context, creatorID uuid.UUID, req d.UpdateCreatorProfileRequest, actor d.ContentActor) (*m.Creator, error)
+
+	// ListCreatorWorks lists the public works of a creator with their roles
+	ListCreatorWorks(ctx context.Context, creatorID uuid.UUID, req d.ListCreatorWorksRequest) ([]d.CreatorWork, int64, error)
+
+	// FindDuplicateCreators lists creators that may be the same as a name
+	FindDuplicateCreators(ctx context.Context, req d.FindDuplicatesRequest) ([]d.DuplicateCandidate, error)
+
+	// FindCreatorDuplicatesOf lists other creators that may be the same as an existing one
+	FindCreatorDuplicatesOf(ctx context.Context, creatorID uuid.UUID, req d.FindDuplicatesRequest) ([]d.DuplicateCandidate, error)
+
+	// MergeCreators folds a duplicate creator into another and keeps a redirect from its ID
+	MergeCreators(ctx context.Context, creatorID uuid.UUID, req d.MergeCreatorRequest, actor d.ContentActor) (*d.CreatorMergeResponse, error)
 }
\ No newline at end of file
@@ -14,11 +14,14 @@ type GenreServiceInterface interface {
 	// CreateGenre creates a new genre with validation
 	CreateGenre(ctx context.Context, req d.CreateGenreRequest) (*m.Genre, error)
 
-	// GetGenreByID retrieves a genre by ID
-	GetGenreByID(ctx context.Context, genreID uuid.UUID) (*m.Genre, error)
+	// GetGenreByID retrieves a genre by ID with its translations, named in the first matching language
+	GetGenreByID(ctx context.Context, genreID uuid.UUID, languages []string) (*m.Genre, error)
 
-	// ListGenres retrieves paginated list of genres with optional search
-	ListGenres(ctx context.Context, req d.ListGenresRequest) ([]*m.Genre, int64, error)
+	// ListGenres retrieves paginated list of genres with optional search, named in the first matching language
+	ListGenres(ctx context.Context, req d.ListGenresRequest, languages []string) ([]*m.Genre, int64, error)
+
+	// GetGenreTree retrieves the genre taxonomy as nested parent/child genres
+	GetGenreTree(ctx context.Context, languages []string) ([]*d.GenreTreeNode, error)
 
 	// UpdateGenre updates genre information
 	UpdateGenre(ctx context.Context, genreID uuid.UUID, req d.UpdateGenreRequest) (*m.Genre, error)
@@ -26,6 +29,9 @@ type GenreServiceInterface interface {
 	// DeleteGenre removes a genre
 	DeleteGenre(ctx context.Context, genreID uuid.UUID) error
 
+	// MergeGenres merges a duplicate genre into another, reassigning its content links and sub-genres
+	MergeGenres(ctx context.Context, genreID uuid.UUID, req d.MergeGenreRequest) (*d.GenreMergeResponse, error)
+
 	// ValidateGenreName validates genre name before creation/update
 	ValidateGenreName(name string) error
 
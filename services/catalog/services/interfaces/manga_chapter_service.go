@@ -0,0 +1,37 @@
+package interfaces
+
+import (
+	"context"
+
+	d "wibusystem/pkg/common/dto"
+)
+
+// MangaChapterServiceInterface defines the contract for manga volume, chapter and page operations
+type MangaChapterServiceInterface interface {
+	// Volumes
+	CreateVolume(ctx context.Context, mangaID string, req d.CreateMangaVolumeRequest, actor d.ContentActor) (*d.MangaVolumeSummary, error)
+	ListVolumes(ctx context.Context, mangaID string) ([]d.MangaVolumeSummary, error)
+	UpdateVolume(ctx context.Context, volumeID string, req d.UpdateMangaVolumeRequest, actor d.ContentActor) (*d.MangaVolumeSummary, error)
+	DeleteVolume(ctx context.Context, volumeID string, actor d.ContentActor) error
+
+	// Chapters
+	CreateChapter(ctx context.Context, volumeID string, req d.CreateMangaChapterRequest, actor d.ContentActor) (*d.MangaChapterResponse, error)
+	// ListPublishedChapters lists the published chapters of a publicly visible manga
+	ListPublishedChapters(ctx context.Context, volumeID string, req d.ListMangaChaptersRequest) (*d.PaginatedMangaChaptersResponse, error)
+	// ListAllChapters lists drafts as well; restricted to owners and collaborators
+	ListAllChapters(ctx context.Context, volumeID string, req d.ListMangaChaptersRequest, actor d.ContentActor) (*d.PaginatedMangaChaptersResponse, error)
+	// GetPublishedChapter returns a published chapter; pages are only included for free chapters
+	GetPublishedChapter(ctx context.Context, chapterID string) (*d.MangaChapterResponse, error)
+	// ReadChapter returns the chapter with its pages for entitled readers, owners and collaborators
+	ReadChapter(ctx context.Context, chapterID string, actor d.ContentActor) (*d.MangaChapterResponse, error)
+	UpdateChapter(ctx context.Context, chapterID string, req d.UpdateMangaChapterRequest, actor d.ContentActor) (*d.MangaChapterResponse, error)
+	DeleteChapter(ctx context.Context, chapterID string, actor d.ContentActor) error
+	PublishChapter(ctx context.Context, chapterID string, req d.PublishMangaChapterRequest, actor d.ContentActor) (*d.MangaChapterResponse, error)
+	UnpublishChapter(ctx context.Context, chapterID string, actor d.ContentActor) (*d.MangaChapterResponse, error)
+
+	// Pages
+	AddPages(ctx context.Context, chapterID string, req d.AddMangaPagesRequest, actor d.ContentActor) ([]d.MangaPageResponse, error)
+	ReorderPages(ctx context.Context, chapterID string, req d.ReorderMangaPagesRequest, actor d.ContentActor) ([]d.MangaPageResponse, error)
+	UpdatePage(ctx context.Context, pageID string, req d.UpdateMangaPageRequest, actor d.ContentActor) (*d.MangaPageResponse, error)
+	DeletePage(ctx context.Context, pageID string, actor d.ContentActor) error
+}
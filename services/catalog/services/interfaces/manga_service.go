@@ -0,0 +1,21 @@
+package interfaces
+
+import (
+	"context"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+)
+
+// MangaServiceInterface defines the contract for manga series operations.
+// Management methods check ownership or collaborator permissions unless the actor is an admin.
+type MangaServiceInterface interface {
+	CreateManga(ctx context.Context, req d.CreateMangaRequest, actor d.ContentActor) (*m.Manga, error)
+	// ListManga lists publicly visible manga with titles in the requested language
+	ListManga(ctx context.Context, req d.ListMangaRequest, language string) (*d.PaginatedMangaResponse, error)
+	// GetMangaByID returns a publicly visible manga with titles in the requested language
+	GetMangaByID(ctx context.Context, id string, language string) (*d.MangaDetailResponse, error)
+	UpdateManga(ctx context.Context, id string, req d.UpdateMangaRequest, actor d.ContentActor) (*m.Manga, error)
+	DeleteManga(ctx context.Context, id string, actor d.ContentActor) error
+	SetTranslations(ctx context.Context, id string, req d.SetMangaTranslationsRequest, actor d.ContentActor) error
+}
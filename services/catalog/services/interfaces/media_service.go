@@ -0,0 +1,28 @@
+package interfaces
+
+import (
+	"context"
+	"io"
+
+	d "wibusystem/pkg/common/dto"
+	"wibusystem/pkg/common/storage"
+)
+
+// MediaServiceInterface defines the contract for file uploads and signed downloads
+type MediaServiceInterface interface {
+	// Upload sniffs, size-checks and stores a file, reusing an existing asset with the same checksum;
+	// images are validated for the purpose (generic, cover, avatar, page) and stripped of metadata
+	Upload(ctx context.Context, file io.ReadSeeker, filename, purpose string, actor d.ContentActor) (*d.MediaAssetResponse, error)
+
+	// GetMedia returns an asset with a fresh signed download URL
+	GetMedia(ctx context.Context, mediaID string) (*d.MediaAssetResponse, error)
+
+	// OpenSignedFile opens an object whose signed URL is served by this service (local storage)
+	OpenSignedFile(ctx context.Context, key string, expires int64, signature string) (io.ReadCloser, *storage.ObjectInfo, error)
+
+	// ProcessPending derives renditions for a batch of queued images and returns how many succeeded
+	ProcessPending(ctx context.Context) (int, error)
+
+	// UploadLimit returns the maximum accepted file size in bytes
+	UploadLimit() int64
+}
@@ -0,0 +1,30 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	d "wibusystem/pkg/common/dto"
+)
+
+// ModerationServiceInterface defines the contract for content reports and the moderation queue
+type ModerationServiceInterface interface {
+	// CreateReport files a report; duplicate reports on the same target join one open case
+	CreateReport(ctx context.Context, reporterID uuid.UUID, req d.CreateReportRequest) (*d.CreateReportResponse, error)
+
+	// ListCases returns a page of the moderation queue
+	ListCases(ctx context.Context, moderatorID uuid.UUID, req d.ListModerationCasesRequest) (*d.PaginatedModerationCasesResponse, error)
+
+	// GetCase returns a case with its reports and audit trail
+	GetCase(ctx context.Context, caseID string) (*d.ModerationCaseDetailResponse, error)
+
+	// ClaimCase assigns an unclaimed case to the calling moderator
+	ClaimCase(ctx context.Context, caseID string, moderatorID uuid.UUID) error
+
+	// AssignCase assigns a case to another moderator
+	AssignCase(ctx context.Context, caseID string, actorID uuid.UUID, req d.AssignModerationCaseRequest) error
+
+	// ResolveCase applies a resolution action and closes the case
+	ResolveCase(ctx context.Context, caseID string, moderatorID uuid.UUID, req d.ResolveModerationCaseRequest) error
+}
@@ -11,7 +11,7 @@ import (
 type NovelServiceInterface interface {
 	CreateNovel(ctx context.Context, req d.CreateNovelRequest) (*m.Novel, error)
 	ListNovels(ctx context.Context, req d.ListNovelsRequest) (*d.PaginatedNovelsResponse, error)
-	GetNovelByID(ctx context.Context, id string, includeTranslations, includeStats bool, language string) (*d.NovelDetailResponse, error)
+	GetNovelByID(ctx context.Context, id string, includeTranslations, includeStats bool, languages []string) (*d.NovelDetailResponse, error)
 	UpdateNovel(ctx context.Context, id string, req d.UpdateNovelRequest) (*d.UpdateNovelResponse, error)
 	DeleteNovel(ctx context.Context, id string, deletedByUserID string) error
 }
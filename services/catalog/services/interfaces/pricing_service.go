@@ -0,0 +1,46 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+)
+
+// PricingServiceInterface defines discount campaigns, bundles and price quotes
+type PricingServiceInterface interface {
+	// CreateCampaign creates a discount campaign on a novel, tenant or genre
+	CreateCampaign(ctx context.Context, req d.CreateDiscountCampaignRequest, actor d.ContentActor) (*m.DiscountCampaign, error)
+
+	// GetCampaign returns a campaign to an actor who manages its scope
+	GetCampaign(ctx context.Context, campaignID string, actor d.ContentActor) (*m.DiscountCampaign, error)
+
+	// ListCampaigns lists the actor's campaigns; admins see every campaign
+	ListCampaigns(ctx context.Context, req d.ListDiscountCampaignsRequest, actor d.ContentActor) (*d.PaginatedDiscountCampaignsResponse, error)
+
+	// UpdateCampaign changes the discount, schedule or active flag of a campaign
+	UpdateCampaign(ctx context.Context, campaignID string, req d.UpdateDiscountCampaignRequest, actor d.ContentActor) (*m.DiscountCampaign, error)
+
+	// DeleteCampaign removes a campaign
+	DeleteCampaign(ctx context.Context, campaignID string, actor d.ContentActor) error
+
+	// CreateBundle creates a bundle of volumes of a novel
+	CreateBundle(ctx context.Context, novelID string, req d.CreateBundleRequest, actor d.ContentActor) (*m.NovelBundle, error)
+
+	// GetBundle returns a bundle with its volumes
+	GetBundle(ctx context.Context, bundleID string) (*m.NovelBundle, error)
+
+	// ListNovelBundles lists the bundles of a novel that are on sale now
+	ListNovelBundles(ctx context.Context, novelID string) ([]m.NovelBundle, error)
+
+	// UpdateBundle changes the price, schedule or volumes of a bundle
+	UpdateBundle(ctx context.Context, bundleID string, req d.UpdateBundleRequest, actor d.ContentActor) (*m.NovelBundle, error)
+
+	// DeleteBundle removes a bundle
+	DeleteBundle(ctx context.Context, bundleID string, actor d.ContentActor) error
+
+	// QuotePrice computes the effective price of a chapter, volume, series or bundle for a user
+	QuotePrice(ctx context.Context, userID uuid.UUID, req d.PriceQuoteRequest) (*d.PriceQuoteResponse, error)
+}
@@ -0,0 +1,16 @@
+package interfaces
+
+import (
+	"context"
+
+	d "wibusystem/pkg/common/dto"
+)
+
+// RankingServiceInterface defines the contract for novel ranking operations
+type RankingServiceInterface interface {
+	// ListRanking returns a page of a pre-computed ranking (trending_day, trending_week, ...)
+	ListRanking(ctx context.Context, rankingType string, req d.ListNovelRankingRequest) (*d.PaginatedNovelRankingResponse, error)
+
+	// RebuildRankings recomputes every ranking snapshot; invoked by the scheduler and admins
+	RebuildRankings(ctx context.Context) (*d.RebuildRankingsResponse, error)
+}
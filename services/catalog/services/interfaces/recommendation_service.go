@@ -0,0 +1,21 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	d "wibusystem/pkg/common/dto"
+)
+
+// RecommendationServiceInterface defines the contract for similar-novel recommendations
+type RecommendationServiceInterface interface {
+	// GetSimilarNovels returns the pre-computed neighbours of a novel
+	GetSimilarNovels(ctx context.Context, novelID string, req d.ListRecommendationsRequest) ([]d.RecommendedNovelResponse, error)
+
+	// GetRecommendationsForUser returns neighbours of the reader's bookmarked novels
+	GetRecommendationsForUser(ctx context.Context, userID uuid.UUID, req d.ListRecommendationsRequest) ([]d.RecommendedNovelResponse, error)
+
+	// RebuildNeighbours recomputes the neighbours table; invoked nightly and by admins
+	RebuildNeighbours(ctx context.Context) (int64, error)
+}
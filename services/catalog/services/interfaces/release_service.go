@@ -0,0 +1,26 @@
+package interfaces
+
+import (
+	"context"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+)
+
+// ReleaseServiceInterface defines wait-until-free and early access release models of novels
+type ReleaseServiceInterface interface {
+	// GetPolicy returns the release policy of a novel
+	GetPolicy(ctx context.Context, novelID string) (*m.NovelReleasePolicy, error)
+
+	// UpdatePolicy replaces the release policy of a novel
+	UpdatePolicy(ctx context.Context, novelID string, req d.UpdateReleasePolicyRequest, actor d.ContentActor) (*m.NovelReleasePolicy, error)
+
+	// ListReaderChapters lists the released and upcoming chapters of a volume with the reader's access
+	ListReaderChapters(ctx context.Context, volumeID string, req d.ListReaderChaptersRequest, actor d.ContentActor) (*d.PaginatedReaderChaptersResponse, error)
+
+	// AuthorizeChapterRead checks that the reader may read the chapter's content now
+	AuthorizeChapterRead(ctx context.Context, chapterID string, actor d.ContentActor) error
+
+	// UnlockChapter spends the reader's wait-until-free unlock on a locked chapter
+	UnlockChapter(ctx context.Context, chapterID string, actor d.ContentActor) (*d.ChapterUnlockResponse, error)
+}
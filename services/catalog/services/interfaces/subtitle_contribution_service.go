@@ -0,0 +1,32 @@
+package interfaces
+
+import (
+	"context"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+)
+
+// SubtitleContributionServiceInterface defines the contract for community subtitles and their review
+type SubtitleContributionServiceInterface interface {
+	// CreateContribution submits a subtitle for a published episode
+	CreateContribution(ctx context.Context, episodeID string, req d.CreateSubtitleContributionRequest, actor d.ContentActor) (*m.SubtitleContribution, error)
+
+	// UpdateContribution replaces the subtitle of a pending contribution of the calling user
+	UpdateContribution(ctx context.Context, contributionID string, req d.UpdateSubtitleContributionRequest, actor d.ContentActor) (*m.SubtitleContribution, error)
+
+	// GetContribution returns a contribution to its contributor or to a manager of the anime
+	GetContribution(ctx context.Context, contributionID string, actor d.ContentActor) (*m.SubtitleContribution, error)
+
+	// ListMyContributions returns the contributions of the calling user
+	ListMyContributions(ctx context.Context, req d.ListSubtitleContributionsRequest, actor d.ContentActor) (*d.PaginatedSubtitleContributionsResponse, error)
+
+	// ListContributions returns the review queue of one anime
+	ListContributions(ctx context.Context, animeID string, req d.ListSubtitleContributionsRequest, actor d.ContentActor) (*d.PaginatedSubtitleContributionsResponse, error)
+
+	// ApproveContribution publishes a pending contribution as the episode subtitle of its language
+	ApproveContribution(ctx context.Context, contributionID string, actor d.ContentActor) (*m.SubtitleContribution, error)
+
+	// RejectContribution rejects a pending contribution with a reason
+	RejectContribution(ctx context.Context, contributionID string, req d.RejectSubtitleContributionRequest, actor d.ContentActor) error
+}
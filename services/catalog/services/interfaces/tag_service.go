@@ -0,0 +1,31 @@
+package interfaces
+
+import (
+	"context"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+
+	"github.com/google/uuid"
+)
+
+// TagServiceInterface defines the contract for the tag registry
+type TagServiceInterface interface {
+	// CreateTag registers a canonical tag with its aliases and synonyms
+	CreateTag(ctx context.Context, req d.CreateTagRequest) (*m.Tag, error)
+
+	// GetTagByID retrieves a tag with its aliases
+	GetTagByID(ctx context.Context, tagID uuid.UUID) (*m.Tag, error)
+
+	// ListTags retrieves a paginated list of tags, most used first
+	ListTags(ctx context.Context, req d.ListTagsRequest) ([]*m.Tag, int64, error)
+
+	// AutocompleteTags returns the tags whose name or an alias starts with the query, ranked by usage
+	AutocompleteTags(ctx context.Context, req d.AutocompleteTagsRequest, languages []string) ([]d.TagSuggestion, error)
+
+	// UpdateTag updates a tag; a rename is applied to the novels carrying it
+	UpdateTag(ctx context.Context, tagID uuid.UUID, req d.UpdateTagRequest) (*m.Tag, error)
+
+	// MergeTags merges a duplicate tag into another
+	MergeTags(ctx context.Context, tagID uuid.UUID, req d.MergeTagRequest) (*d.TagMergeResponse, error)
+}
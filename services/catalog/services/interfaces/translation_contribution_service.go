@@ -0,0 +1,29 @@
+package interfaces
+
+import (
+	"context"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+)
+
+// TranslationContributionServiceInterface defines the contract for community translations and their moderator review
+type TranslationContributionServiceInterface interface {
+	// CreateContribution submits a translation of a novel or a chapter, checked against the glossary
+	CreateContribution(ctx context.Context, req d.CreateTranslationContributionRequest, actor d.ContentActor) (*d.TranslationContributionResponse, error)
+
+	// UpdateContribution replaces the translation of a pending contribution of the calling user
+	UpdateContribution(ctx context.Context, contributionID string, req d.UpdateTranslationContributionRequest, actor d.ContentActor) (*d.TranslationContributionResponse, error)
+
+	// GetContribution returns a contribution with its glossary violations; canReview grants access to others' contributions
+	GetContribution(ctx context.Context, contributionID string, actor d.ContentActor, canReview bool) (*d.TranslationContributionResponse, error)
+
+	// ListMyContributions returns the contributions of the calling user
+	ListMyContributions(ctx context.Context, req d.ListTranslationContributionsRequest, actor d.ContentActor) (*d.PaginatedTranslationContributionsResponse, error)
+
+	// ListContributions returns the moderator review queue
+	ListContributions(ctx context.Context, req d.ListTranslationContributionsRequest) (*d.PaginatedTranslationContributionsResponse, error)
+
+	// ReviewContribution approves or rejects a pending contribution
+	ReviewContribution(ctx context.Context, contributionID string, req d.ReviewTranslationContributionRequest, actor d.ContentActor) (*m.TranslationContribution, error)
+}
@@ -0,0 +1,33 @@
+package interfaces
+
+import (
+	"context"
+
+	d "wibusystem/pkg/common/dto"
+)
+
+// TranslationServiceInterface defines the contract for localized novel metadata and translated chapters.
+// Management methods check ownership or collaborator permissions unless the actor is an admin.
+type TranslationServiceInterface interface {
+	// ListNovelTranslations returns the localized titles and summaries of a novel
+	ListNovelTranslations(ctx context.Context, novelID string) (*d.NovelTranslationsResponse, error)
+	// SetNovelTranslations replaces the localized titles and summaries of a novel
+	SetNovelTranslations(ctx context.Context, novelID string, req d.SetNovelTranslationsRequest, actor d.ContentActor) (*d.NovelTranslationsResponse, error)
+
+	// ListChapterTranslations returns the translations of a chapter without their content
+	ListChapterTranslations(ctx context.Context, chapterID string, actor d.ContentActor) (*d.ChapterTranslationsResponse, error)
+	// GetChapterTranslation returns the translation of a chapter in one language with its content
+	GetChapterTranslation(ctx context.Context, chapterID, languageCode string, actor d.ContentActor) (*d.ChapterTranslationResponse, error)
+	// UpsertChapterTranslation creates or replaces the translation of a chapter in one language
+	UpsertChapterTranslation(ctx context.Context, chapterID, languageCode string, req d.UpsertChapterTranslationRequest, actor d.ContentActor) (*d.ChapterTranslationResponse, error)
+	// DeleteChapterTranslation removes the translation of a chapter in one language
+	DeleteChapterTranslation(ctx context.Context, chapterID, languageCode string, actor d.ContentActor) error
+
+	// GetGlossary returns the fixed term translations of a novel in one target language
+	GetGlossary(ctx context.Context, novelID, languageCode string) (*d.GlossaryResponse, error)
+	// SetGlossary replaces the fixed term translations of a novel in one target language
+	SetGlossary(ctx context.Context, novelID, languageCode string, req d.SetGlossaryRequest, actor d.ContentActor) (*d.GlossaryResponse, error)
+
+	// GenerateMachineDraft machine-translates a chapter and stores it as a pending contribution
+	GenerateMachineDraft(ctx context.Context, chapterID, languageCode string, actor d.ContentActor) (*d.MachineDraftResponse, error)
+}
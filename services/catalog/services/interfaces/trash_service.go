@@ -0,0 +1,33 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	d "wibusystem/pkg/common/dto"
+)
+
+// TrashServiceInterface defines the trash of soft-deleted novels, volumes and chapters
+type TrashServiceInterface interface {
+	// ListTrash lists the delete operations the actor may restore; admins see every item
+	ListTrash(ctx context.Context, req d.ListTrashRequest, actor d.ContentActor) (*d.PaginatedTrashResponse, error)
+
+	// RestoreNovel restores a novel with the volumes and chapters deleted together with it
+	RestoreNovel(ctx context.Context, novelID string, actor d.ContentActor) (*d.TrashRestoreResponse, error)
+
+	// RestoreVolume restores a volume with the chapters deleted together with it
+	RestoreVolume(ctx context.Context, volumeID string, actor d.ContentActor) (*d.TrashRestoreResponse, error)
+
+	// RestoreChapter restores a single chapter
+	RestoreChapter(ctx context.Context, chapterID string, actor d.ContentActor) (*d.TrashRestoreResponse, error)
+
+	// PurgeExpired purges or archives every item past the retention period; invoked by the scheduler
+	PurgeExpired(ctx context.Context) (*d.TrashPurgeReport, error)
+
+	// ListArchived lists the archived chapters the user still owns
+	ListArchived(ctx context.Context, userID uuid.UUID, req d.ListArchivedRequest) (*d.PaginatedArchivedResponse, error)
+
+	// ReadArchivedChapter returns the content of an archived chapter to a buyer
+	ReadArchivedChapter(ctx context.Context, userID uuid.UUID, chapterID string) (*d.ArchivedChapterContent, error)
+}
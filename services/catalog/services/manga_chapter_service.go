@@ -0,0 +1,562 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+	"wibusystem/services/catalog/repositories"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// MangaChapterService implements manga volume, chapter and page business logic
+type MangaChapterService struct {
+	repos *repositories.Repositories
+}
+
+// NewMangaChapterService creates a new manga chapter service
+func NewMangaChapterService(repos *repositories.Repositories) interfaces.MangaChapterServiceInterface {
+	return &MangaChapterService{
+		repos: repos,
+	}
+}
+
+// CreateVolume requires MANAGE_CHAPTERS, plus MANAGE_PRICING when prices are set
+func (s *MangaChapterService) CreateVolume(ctx context.Context, mangaID string, req d.CreateMangaVolumeRequest, actor d.ContentActor) (*d.MangaVolumeSummary, error) {
+	mangaUUID, err := uuid.Parse(mangaID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid manga ID format: %w", err)
+	}
+
+	if err := authorizeManga(ctx, s.repos, mangaUUID, actor, m.PermissionManageChapters); err != nil {
+		return nil, err
+	}
+	if req.PriceCoins != nil || req.RentalPriceCoins != nil || req.RentalDurationDays != nil {
+		if err := authorizeManga(ctx, s.repos, mangaUUID, actor, m.PermissionManagePricing); err != nil {
+			return nil, err
+		}
+	}
+
+	volume, err := s.repos.MangaChapter.CreateVolume(ctx, mangaUUID, req)
+	if err != nil {
+		return nil, err
+	}
+	return toMangaVolumeSummary(volume), nil
+}
+
+// ListVolumes lists the volumes of a publicly visible manga
+func (s *MangaChapterService) ListVolumes(ctx context.Context, mangaID string) ([]d.MangaVolumeSummary, error) {
+	mangaUUID, err := uuid.Parse(mangaID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid manga ID format: %w", err)
+	}
+
+	visible, err := s.repos.MangaChapter.IsMangaPubliclyVisible(ctx, mangaUUID)
+	if err != nil {
+		return nil, err
+	}
+	if !visible {
+		return nil, fmt.Errorf("manga not found")
+	}
+
+	// The detail query already aggregates published chapter counts per volume
+	detail, err := s.repos.Manga.GetMangaDetail(ctx, mangaUUID, "")
+	if err != nil {
+		return nil, err
+	}
+	return detail.Volumes, nil
+}
+
+// UpdateVolume requires MANAGE_CHAPTERS, plus MANAGE_PRICING when prices change
+func (s *MangaChapterService) UpdateVolume(ctx context.Context, volumeID string, req d.UpdateMangaVolumeRequest, actor d.ContentActor) (*d.MangaVolumeSummary, error) {
+	volumeUUID, mangaUUID, err := s.resolveVolume(ctx, volumeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeManga(ctx, s.repos, mangaUUID, actor, m.PermissionManageChapters); err != nil {
+		return nil, err
+	}
+	if req.PriceCoins != nil || req.RentalPriceCoins != nil || req.RentalDurationDays != nil {
+		if err := authorizeManga(ctx, s.repos, mangaUUID, actor, m.PermissionManagePricing); err != nil {
+			return nil, err
+		}
+	}
+
+	volume, err := s.repos.MangaChapter.UpdateVolume(ctx, volumeUUID, req)
+	if err != nil {
+		return nil, err
+	}
+	return toMangaVolumeSummary(volume), nil
+}
+
+// DeleteVolume deletes a volume nobody has bought or rented
+func (s *MangaChapterService) DeleteVolume(ctx context.Context, volumeID string, actor d.ContentActor) error {
+	volumeUUID, mangaUUID, err := s.resolveVolume(ctx, volumeID)
+	if err != nil {
+		return err
+	}
+
+	if err := authorizeManga(ctx, s.repos, mangaUUID, actor, m.PermissionManageChapters); err != nil {
+		return err
+	}
+
+	return s.repos.MangaChapter.DeleteVolume(ctx, volumeUUID)
+}
+
+// CreateChapter creates a draft chapter
+func (s *MangaChapterService) CreateChapter(ctx context.Context, volumeID string, req d.CreateMangaChapterRequest, actor d.ContentActor) (*d.MangaChapterResponse, error) {
+	volumeUUID, mangaUUID, err := s.resolveVolume(ctx, volumeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeManga(ctx, s.repos, mangaUUID, actor, m.PermissionManageChapters); err != nil {
+		return nil, err
+	}
+	if req.PriceCoins != nil {
+		if err := authorizeManga(ctx, s.repos, mangaUUID, actor, m.PermissionManagePricing); err != nil {
+			return nil, err
+		}
+	}
+
+	chapter, err := s.repos.MangaChapter.CreateChapter(ctx, volumeUUID, req, actor.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return toMangaChapterResponse(chapter, 0), nil
+}
+
+// ListPublishedChapters lists published chapters without their pages
+func (s *MangaChapterService) ListPublishedChapters(ctx context.Context, volumeID string, req d.ListMangaChaptersRequest) (*d.PaginatedMangaChaptersResponse, error) {
+	volumeUUID, mangaUUID, err := s.resolveVolume(ctx, volumeID)
+	if err != nil {
+		return nil, err
+	}
+
+	visible, err := s.repos.MangaChapter.IsMangaPubliclyVisible(ctx, mangaUUID)
+	if err != nil {
+		return nil, err
+	}
+	if !visible {
+		return nil, fmt.Errorf("volume not found")
+	}
+
+	return s.listChapters(ctx, volumeUUID, false, req)
+}
+
+// ListAllChapters lists drafts as well
+func (s *MangaChapterService) ListAllChapters(ctx context.Context, volumeID string, req d.ListMangaChaptersRequest, actor d.ContentActor) (*d.PaginatedMangaChaptersResponse, error) {
+	volumeUUID, mangaUUID, err := s.resolveVolume(ctx, volumeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeManga(ctx, s.repos, mangaUUID, actor, m.PermissionManageChapters); err != nil {
+		return nil, err
+	}
+
+	return s.listChapters(ctx, volumeUUID, true, req)
+}
+
+// GetPublishedChapter returns a published chapter of a publicly visible manga
+func (s *MangaChapterService) GetPublishedChapter(ctx context.Context, chapterID string) (*d.MangaChapterResponse, error) {
+	chapter, err := s.resolvePublishedChapter(ctx, chapterID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.chapterWithPages(ctx, chapter, chapter.IsPublic)
+}
+
+// ReadChapter returns the pages of free chapters, to entitled readers and to managers
+func (s *MangaChapterService) ReadChapter(ctx context.Context, chapterID string, actor d.ContentActor) (*d.MangaChapterResponse, error) {
+	chapterUUID, err := uuid.Parse(chapterID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid chapter ID format: %w", err)
+	}
+
+	mangaUUID, err := s.repos.MangaChapter.GetChapterMangaID(ctx, chapterUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Owners and collaborators can read drafts of their own manga
+	canManage, err := s.repos.Manga.CanManageManga(ctx, mangaUUID, actor.UserID, actor.TenantID, m.PermissionRead)
+	if err != nil {
+		return nil, err
+	}
+	if canManage || actor.IsAdmin {
+		chapter, err := s.repos.MangaChapter.GetChapterByID(ctx, chapterUUID)
+		if err != nil {
+			return nil, err
+		}
+		return s.chapterWithPages(ctx, chapter, true)
+	}
+
+	chapter, err := s.resolvePublishedChapter(ctx, chapterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !chapter.IsPublic {
+		entitled, err := s.repos.MangaChapter.CanReadChapter(ctx, chapter.ID, actor.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if !entitled {
+			return nil, fmt.Errorf("purchase required: buy the chapter, its volume or the series, or rent the volume or series")
+		}
+	}
+
+	return s.chapterWithPages(ctx, chapter, true)
+}
+
+// UpdateChapter requires MANAGE_CHAPTERS, plus MANAGE_PRICING when price or free access change
+func (s *MangaChapterService) UpdateChapter(ctx context.Context, chapterID string, req d.UpdateMangaChapterRequest, actor d.ContentActor) (*d.MangaChapterResponse, error) {
+	chapterUUID, mangaUUID, err := s.resolveChapter(ctx, chapterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeManga(ctx, s.repos, mangaUUID, actor, m.PermissionManageChapters); err != nil {
+		return nil, err
+	}
+	if req.PriceCoins != nil || req.IsPublic != nil {
+		if err := authorizeManga(ctx, s.repos, mangaUUID, actor, m.PermissionManagePricing); err != nil {
+			return nil, err
+		}
+	}
+
+	chapter, err := s.repos.MangaChapter.UpdateChapter(ctx, chapterUUID, req, actor.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return s.chapterWithPages(ctx, chapter, false)
+}
+
+// DeleteChapter deletes a chapter nobody has bought
+func (s *MangaChapterService) DeleteChapter(ctx context.Context, chapterID string, actor d.ContentActor) error {
+	chapterUUID, mangaUUID, err := s.resolveChapter(ctx, chapterID)
+	if err != nil {
+		return err
+	}
+
+	if err := authorizeManga(ctx, s.repos, mangaUUID, actor, m.PermissionManageChapters); err != nil {
+		return err
+	}
+
+	return s.repos.MangaChapter.DeleteChapter(ctx, chapterUUID)
+}
+
+// PublishChapter publishes a chapter now or at the requested time
+func (s *MangaChapterService) PublishChapter(ctx context.Context, chapterID string, req d.PublishMangaChapterRequest, actor d.ContentActor) (*d.MangaChapterResponse, error) {
+	chapterUUID, mangaUUID, err := s.resolveChapter(ctx, chapterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeManga(ctx, s.repos, mangaUUID, actor, m.PermissionPublish); err != nil {
+		return nil, err
+	}
+
+	publishAt := time.Now()
+	if req.PublishAt != nil {
+		publishAt = *req.PublishAt
+	}
+
+	chapter, err := s.repos.MangaChapter.PublishChapter(ctx, chapterUUID, publishAt, actor.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return s.chapterWithPages(ctx, chapter, false)
+}
+
+// UnpublishChapter turns a chapter back into a draft
+func (s *MangaChapterService) UnpublishChapter(ctx context.Context, chapterID string, actor d.ContentActor) (*d.MangaChapterResponse, error) {
+	chapterUUID, mangaUUID, err := s.resolveChapter(ctx, chapterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeManga(ctx, s.repos, mangaUUID, actor, m.PermissionPublish); err != nil {
+		return nil, err
+	}
+
+	chapter, err := s.repos.MangaChapter.UnpublishChapter(ctx, chapterUUID, actor.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return s.chapterWithPages(ctx, chapter, false)
+}
+
+// AddPages uploads pages in order, appended or inserted after a given page
+func (s *MangaChapterService) AddPages(ctx context.Context, chapterID string, req d.AddMangaPagesRequest, actor d.ContentActor) ([]d.MangaPageResponse, error) {
+	if len(req.ImageURLs) == 0 {
+		return nil, fmt.Errorf("invalid pages: at least one image URL is required")
+	}
+	if req.AfterPage != nil && *req.AfterPage < 0 {
+		return nil, fmt.Errorf("invalid after_page: must not be negative")
+	}
+
+	chapterUUID, mangaUUID, err := s.resolveChapter(ctx, chapterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeManga(ctx, s.repos, mangaUUID, actor, m.PermissionManageChapters); err != nil {
+		return nil, err
+	}
+
+	if req.AfterPage != nil {
+		counts, err := s.repos.MangaChapter.CountPages(ctx, []uuid.UUID{chapterUUID})
+		if err != nil {
+			return nil, err
+		}
+		if *req.AfterPage > counts[chapterUUID] {
+			return nil, fmt.Errorf("invalid after_page: chapter has %d pages", counts[chapterUUID])
+		}
+	}
+
+	pages, err := s.repos.MangaChapter.AddPages(ctx, chapterUUID, req.ImageURLs, req.AfterPage)
+	if err != nil {
+		return nil, err
+	}
+	return toMangaPageResponses(pages), nil
+}
+
+// ReorderPages renumbers all pages of a chapter in the requested order
+func (s *MangaChapterService) ReorderPages(ctx context.Context, chapterID string, req d.ReorderMangaPagesRequest, actor d.ContentActor) ([]d.MangaPageResponse, error) {
+	pageIDs := make([]uuid.UUID, 0, len(req.PageIDs))
+	for _, pageID := range req.PageIDs {
+		pageUUID, err := uuid.Parse(pageID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid page ID format: %w", err)
+		}
+		pageIDs = append(pageIDs, pageUUID)
+	}
+
+	chapterUUID, mangaUUID, err := s.resolveChapter(ctx, chapterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeManga(ctx, s.repos, mangaUUID, actor, m.PermissionManageChapters); err != nil {
+		return nil, err
+	}
+
+	pages, err := s.repos.MangaChapter.ReorderPages(ctx, chapterUUID, pageIDs)
+	if err != nil {
+		return nil, err
+	}
+	return toMangaPageResponses(pages), nil
+}
+
+// UpdatePage replaces the image of a page
+func (s *MangaChapterService) UpdatePage(ctx context.Context, pageID string, req d.UpdateMangaPageRequest, actor d.ContentActor) (*d.MangaPageResponse, error) {
+	pageUUID, mangaUUID, err := s.resolvePage(ctx, pageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeManga(ctx, s.repos, mangaUUID, actor, m.PermissionManageChapters); err != nil {
+		return nil, err
+	}
+
+	page, err := s.repos.MangaChapter.UpdatePage(ctx, pageUUID, req.ImageURL)
+	if err != nil {
+		return nil, err
+	}
+	return &d.MangaPageResponse{ID: page.ID.String(), PageNumber: page.PageNumber, ImageURL: page.ImageURL}, nil
+}
+
+// DeletePage removes a page; the following pages move forward
+func (s *MangaChapterService) DeletePage(ctx context.Context, pageID string, actor d.ContentActor) error {
+	pageUUID, mangaUUID, err := s.resolvePage(ctx, pageID)
+	if err != nil {
+		return err
+	}
+
+	if err := authorizeManga(ctx, s.repos, mangaUUID, actor, m.PermissionManageChapters); err != nil {
+		return err
+	}
+
+	return s.repos.MangaChapter.DeletePage(ctx, pageUUID)
+}
+
+// resolveVolume parses a volume ID and returns it with its manga ID
+func (s *MangaChapterService) resolveVolume(ctx context.Context, volumeID string) (uuid.UUID, uuid.UUID, error) {
+	volumeUUID, err := uuid.Parse(volumeID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("invalid volume ID format: %w", err)
+	}
+
+	mangaUUID, err := s.repos.MangaChapter.GetVolumeMangaID(ctx, volumeUUID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	return volumeUUID, mangaUUID, nil
+}
+
+// resolveChapter parses a chapter ID and returns it with its manga ID
+func (s *MangaChapterService) resolveChapter(ctx context.Context, chapterID string) (uuid.UUID, uuid.UUID, error) {
+	chapterUUID, err := uuid.Parse(chapterID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("invalid chapter ID format: %w", err)
+	}
+
+	mangaUUID, err := s.repos.MangaChapter.GetChapterMangaID(ctx, chapterUUID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	return chapterUUID, mangaUUID, nil
+}
+
+// resolvePage parses a page ID and returns it with its manga ID
+func (s *MangaChapterService) resolvePage(ctx context.Context, pageID string) (uuid.UUID, uuid.UUID, error) {
+	pageUUID, err := uuid.Parse(pageID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("invalid page ID format: %w", err)
+	}
+
+	_, mangaUUID, err := s.repos.MangaChapter.GetPageChapter(ctx, pageUUID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	return pageUUID, mangaUUID, nil
+}
+
+// resolvePublishedChapter loads a chapter that readers may see: published, of a publicly visible manga
+func (s *MangaChapterService) resolvePublishedChapter(ctx context.Context, chapterID string) (*m.MangaChapter, error) {
+	chapterUUID, mangaUUID, err := s.resolveChapter(ctx, chapterID)
+	if err != nil {
+		return nil, err
+	}
+
+	visible, err := s.repos.MangaChapter.IsMangaPubliclyVisible(ctx, mangaUUID)
+	if err != nil {
+		return nil, err
+	}
+	if !visible {
+		return nil, fmt.Errorf("chapter not found")
+	}
+
+	chapter, err := s.repos.MangaChapter.GetChapterByID(ctx, chapterUUID)
+	if err != nil {
+		return nil, err
+	}
+	// Scheduled chapters stay hidden until their release time
+	if chapter.IsDraft || (chapter.ReleasedAt != nil && chapter.ReleasedAt.After(time.Now())) {
+		return nil, fmt.Errorf("chapter not found")
+	}
+
+	return chapter, nil
+}
+
+// listChapters applies pagination defaults and maps the chapters with their page counts
+func (s *MangaChapterService) listChapters(ctx context.Context, volumeID uuid.UUID, includeDrafts bool, req d.ListMangaChaptersRequest) (*d.PaginatedMangaChaptersResponse, error) {
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 20
+	}
+	if req.PageSize > 100 {
+		req.PageSize = 100
+	}
+
+	chapters, total, err := s.repos.MangaChapter.ListChapters(ctx, volumeID, includeDrafts, req)
+	if err != nil {
+		return nil, err
+	}
+
+	chapterIDs := make([]uuid.UUID, 0, len(chapters))
+	for _, chapter := range chapters {
+		chapterIDs = append(chapterIDs, chapter.ID)
+	}
+	pageCounts, err := s.repos.MangaChapter.CountPages(ctx, chapterIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]d.MangaChapterResponse, 0, len(chapters))
+	for i := range chapters {
+		responses = append(responses, *toMangaChapterResponse(&chapters[i], pageCounts[chapters[i].ID]))
+	}
+
+	totalPages := int((total + int64(req.PageSize) - 1) / int64(req.PageSize))
+
+	return &d.PaginatedMangaChaptersResponse{
+		Chapters: responses,
+		Pagination: d.PaginationMeta{
+			Page:        req.Page,
+			PageSize:    req.PageSize,
+			Total:       total,
+			TotalPages:  totalPages,
+			HasNext:     req.Page < totalPages,
+			HasPrevious: req.Page > 1,
+		},
+	}, nil
+}
+
+// chapterWithPages maps a chapter with its page count; page URLs are only attached when includePages is true
+func (s *MangaChapterService) chapterWithPages(ctx context.Context, chapter *m.MangaChapter, includePages bool) (*d.MangaChapterResponse, error) {
+	pages, err := s.repos.MangaChapter.ListPages(ctx, chapter.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := toMangaChapterResponse(chapter, len(pages))
+	if includePages {
+		response.Pages = toMangaPageResponses(pages)
+	}
+	return response, nil
+}
+
+// toMangaVolumeSummary maps a volume to its response
+func toMangaVolumeSummary(volume *m.MangaVolume) *d.MangaVolumeSummary {
+	return &d.MangaVolumeSummary{
+		ID:                 volume.ID.String(),
+		VolumeNumber:       volume.VolumeNumber,
+		VolumeTitle:        volume.VolumeTitle,
+		CoverImage:         volume.CoverImage,
+		Description:        volume.Description,
+		PriceCoins:         volume.PriceCoins,
+		RentalPriceCoins:   volume.RentalPriceCoins,
+		RentalDurationDays: volume.RentalDurationDays,
+	}
+}
+
+// toMangaChapterResponse maps a chapter to its response without pages
+func toMangaChapterResponse(chapter *m.MangaChapter, pageCount int) *d.MangaChapterResponse {
+	return &d.MangaChapterResponse{
+		ID:            chapter.ID.String(),
+		VolumeID:      chapter.VolumeID.String(),
+		ChapterNumber: chapter.ChapterNumber,
+		Title:         chapter.Title,
+		IsPublic:      chapter.IsPublic,
+		PriceCoins:    chapter.PriceCoins,
+		IsDraft:       chapter.IsDraft,
+		ReleasedAt:    chapter.ReleasedAt,
+		PageCount:     pageCount,
+		CreatedAt:     chapter.CreatedAt,
+		UpdatedAt:     chapter.UpdatedAt,
+	}
+}
+
+// toMangaPageResponses maps pages in reading order
+func toMangaPageResponses(pages []m.MangaPage) []d.MangaPageResponse {
+	responses := make([]d.MangaPageResponse, 0, len(pages))
+	for _, page := range pages {
+		responses = append(responses, d.MangaPageResponse{
+			ID:         page.ID.String(),
+			PageNumber: page.PageNumber,
+			ImageURL:   page.ImageURL,
+		})
+	}
+	return responses
+}
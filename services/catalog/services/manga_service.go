@@ -0,0 +1,137 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+	"wibusystem/services/catalog/repositories"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// MangaService implements manga series business logic
+type MangaService struct {
+	repos *repositories.Repositories
+}
+
+// NewMangaService creates a new manga service
+func NewMangaService(repos *repositories.Repositories) interfaces.MangaServiceInterface {
+	return &MangaService{
+		repos: repos,
+	}
+}
+
+// CreateManga sets the owner from the actor and creates the manga
+func (s *MangaService) CreateManga(ctx context.Context, req d.CreateMangaRequest, actor d.ContentActor) (*m.Manga, error) {
+	// PERSONAL/COLLABORATIVE manga belong to the user, TENANT manga to the current tenant
+	switch req.OwnershipType {
+	case "TENANT":
+		if actor.TenantID == nil {
+			return nil, fmt.Errorf("invalid ownership: tenant context required for TENANT ownership")
+		}
+		req.PrimaryOwnerID = *actor.TenantID
+	default:
+		req.PrimaryOwnerID = actor.UserID
+	}
+	req.OriginalCreatorID = actor.UserID
+
+	return s.repos.Manga.CreateManga(ctx, req)
+}
+
+// ListManga lists publicly visible manga
+func (s *MangaService) ListManga(ctx context.Context, req d.ListMangaRequest, language string) (*d.PaginatedMangaResponse, error) {
+	return s.repos.Manga.ListManga(ctx, req, language)
+}
+
+// GetMangaByID returns the detail of a publicly visible manga
+func (s *MangaService) GetMangaByID(ctx context.Context, id string, language string) (*d.MangaDetailResponse, error) {
+	mangaUUID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid manga ID format: %w", err)
+	}
+
+	visible, err := s.repos.MangaChapter.IsMangaPubliclyVisible(ctx, mangaUUID)
+	if err != nil {
+		return nil, err
+	}
+	if !visible {
+		return nil, fmt.Errorf("manga not found")
+	}
+
+	return s.repos.Manga.GetMangaDetail(ctx, mangaUUID, language)
+}
+
+// UpdateManga requires EDIT, plus PUBLISH when visibility changes
+func (s *MangaService) UpdateManga(ctx context.Context, id string, req d.UpdateMangaRequest, actor d.ContentActor) (*m.Manga, error) {
+	mangaUUID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid manga ID format: %w", err)
+	}
+
+	if err := authorizeManga(ctx, s.repos, mangaUUID, actor, m.PermissionEdit); err != nil {
+		return nil, err
+	}
+	if req.IsPublic != nil || req.AccessLevel != nil {
+		if err := authorizeManga(ctx, s.repos, mangaUUID, actor, m.PermissionPublish); err != nil {
+			return nil, err
+		}
+	}
+
+	req.LastModifiedByUserID = actor.UserID
+	return s.repos.Manga.UpdateManga(ctx, mangaUUID, req)
+}
+
+// DeleteManga soft-deletes a manga nobody has bought or rented content from
+func (s *MangaService) DeleteManga(ctx context.Context, id string, actor d.ContentActor) error {
+	mangaUUID, err := uuid.Parse(id)
+	if err != nil {
+		return fmt.Errorf("invalid manga ID format: %w", err)
+	}
+
+	if err := authorizeManga(ctx, s.repos, mangaUUID, actor, m.PermissionDelete); err != nil {
+		return err
+	}
+
+	return s.repos.Manga.DeleteManga(ctx, mangaUUID, actor.UserID)
+}
+
+// SetTranslations replaces the localized titles of a manga
+func (s *MangaService) SetTranslations(ctx context.Context, id string, req d.SetMangaTranslationsRequest, actor d.ContentActor) error {
+	mangaUUID, err := uuid.Parse(id)
+	if err != nil {
+		return fmt.Errorf("invalid manga ID format: %w", err)
+	}
+
+	// The partial unique index only allows one primary title per language
+	primaries := make(map[string]bool)
+	for _, translation := range req.Translations {
+		if !translation.IsPrimary {
+			continue
+		}
+		if primaries[translation.LanguageCode] {
+			return fmt.Errorf("invalid translations: more than one primary title for language %s", translation.LanguageCode)
+		}
+		primaries[translation.LanguageCode] = true
+	}
+
+	if err := authorizeManga(ctx, s.repos, mangaUUID, actor, m.PermissionEdit); err != nil {
+		return err
+	}
+
+	return s.repos.Manga.SetTranslations(ctx, mangaUUID, req.Translations)
+}
+
+// authorizeManga lets admins, owners and collaborators holding the permission act on a manga
+func authorizeManga(ctx context.Context, repos *repositories.Repositories, mangaID uuid.UUID, actor d.ContentActor, permission string) error {
+	allowed, err := repos.Manga.CanManageManga(ctx, mangaID, actor.UserID, actor.TenantID, permission)
+	if err != nil {
+		return err
+	}
+	if !allowed && !actor.IsAdmin {
+		return fmt.Errorf("permission denied: %s on this manga is restricted to owners and collaborators", permission)
+	}
+	return nil
+}
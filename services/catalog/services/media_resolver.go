@@ -0,0 +1,125 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	m "wibusystem/pkg/common/model"
+	"wibusystem/pkg/common/storage"
+	"wibusystem/services/catalog/repositories"
+)
+
+// mediaResolver attaches uploaded images to entities and turns attachments into
+// signed MediaImage values for responses; it is shared by the content services
+type mediaResolver struct {
+	repos    *repositories.Repositories
+	store    storage.Storage
+	settings MediaSettings
+}
+
+// newMediaResolver creates a resolver signing URLs with the media settings
+func newMediaResolver(repos *repositories.Repositories, store storage.Storage, settings MediaSettings) *mediaResolver {
+	if settings.SignedURLExpiry <= 0 {
+		settings.SignedURLExpiry = defaultSignedURLExpiry
+	}
+	return &mediaResolver{repos: repos, store: store, settings: settings}
+}
+
+// mediaAttachment is a validated change of an entity image slot; a nil asset detaches
+type mediaAttachment struct {
+	kind    string
+	assetID *uuid.UUID
+}
+
+// Prepare validates a request media field before the entity is written: nil leaves the
+// slot unchanged, "" detaches it, otherwise the media must exist and have been uploaded
+// with the purpose the slot requires (so its dimensions were validated)
+func (r *mediaResolver) Prepare(ctx context.Context, kind string, mediaID *string) (*mediaAttachment, error) {
+	if mediaID == nil {
+		return nil, nil
+	}
+	value := strings.TrimSpace(*mediaID)
+	if value == "" {
+		return &mediaAttachment{kind: kind}, nil
+	}
+
+	assetID, err := uuid.Parse(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid media ID format: %w", err)
+	}
+	asset, err := r.repos.Media.GetByID(ctx, assetID)
+	if err != nil {
+		return nil, err
+	}
+	if purpose := mediaAttachmentPurposes[kind]; !slices.Contains(asset.Purposes, purpose) {
+		return nil, fmt.Errorf("invalid image: media must be uploaded with purpose=%s", purpose)
+	}
+	return &mediaAttachment{kind: kind, assetID: &assetID}, nil
+}
+
+// Apply stores a prepared attachment once the entity exists; nil is a no-op
+func (r *mediaResolver) Apply(ctx context.Context, entityID uuid.UUID, attachment *mediaAttachment) error {
+	if attachment == nil {
+		return nil
+	}
+	return r.repos.Media.SetAttachment(ctx, attachment.kind, entityID, attachment.assetID)
+}
+
+// Resolve returns the attached images of several entities of one kind, keyed by entity ID
+func (r *mediaResolver) Resolve(ctx context.Context, kind string, entityIDs []uuid.UUID) (map[uuid.UUID]*m.MediaImage, error) {
+	images := make(map[uuid.UUID]*m.MediaImage)
+	attachments, err := r.repos.Media.GetAttachments(ctx, kind, entityIDs)
+	if err != nil || len(attachments) == 0 {
+		return images, err
+	}
+
+	assetIDs := make([]uuid.UUID, 0, len(attachments))
+	for _, assetID := range attachments {
+		assetIDs = append(assetIDs, assetID)
+	}
+	assets, err := r.repos.Media.GetByIDs(ctx, assetIDs)
+	if err != nil {
+		return nil, err
+	}
+	variants, err := r.repos.Media.ListVariants(ctx, assetIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for entityID, assetID := range attachments {
+		asset, ok := assets[assetID]
+		if !ok {
+			continue
+		}
+		url, err := r.store.SignedURL(ctx, asset.StorageKey, r.settings.SignedURLExpiry)
+		if err != nil {
+			return nil, fmt.Errorf("failed to sign media URL: %w", err)
+		}
+		signed, err := signMediaVariants(ctx, r.store, variants[assetID], r.settings.SignedURLExpiry)
+		if err != nil {
+			return nil, err
+		}
+		images[entityID] = &m.MediaImage{
+			MediaID:  asset.ID.String(),
+			Status:   asset.ProcessingStatus,
+			URL:      url,
+			Width:    asset.Width,
+			Height:   asset.Height,
+			Variants: signed,
+		}
+	}
+	return images, nil
+}
+
+// ResolveOne returns the attached image of a single entity, or nil
+func (r *mediaResolver) ResolveOne(ctx context.Context, kind string, entityID uuid.UUID) (*m.MediaImage, error) {
+	images, err := r.Resolve(ctx, kind, []uuid.UUID{entityID})
+	if err != nil {
+		return nil, err
+	}
+	return images[entityID], nil
+}
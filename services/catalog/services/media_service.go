@@ -0,0 +1,469 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	d "wibusystem/pkg/common/dto"
+	"wibusystem/pkg/common/imaging"
+	m "wibusystem/pkg/common/model"
+	"wibusystem/pkg/common/storage"
+	"wibusystem/services/catalog/repositories"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// sniffLength is how many leading bytes http.DetectContentType inspects
+const sniffLength = 512
+
+// defaultSignedURLExpiry is used when the configuration leaves the expiry unset
+const defaultSignedURLExpiry = 15 * time.Minute
+
+// mediaExtensions fixes the extension of common image types; mime tables vary by OS
+var mediaExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+	"image/webp": ".webp",
+}
+
+// Image processing limits
+const (
+	mediaProcessingBatch      = 10
+	mediaProcessingStaleAfter = 10 * time.Minute // A claimed asset not finished by then is claimed again
+	mediaMaxAttempts          = 3
+	mediaMaxPixels            = 40_000_000 // Decoding larger images would exhaust memory
+	mediaAspectTolerance      = 0.10
+)
+
+// mediaDimensionRule constrains the shape of an image uploaded for a purpose
+type mediaDimensionRule struct {
+	aspect    float64 // width / height
+	label     string
+	minWidth  int
+	minHeight int
+}
+
+// mediaDimensionRules lists the purposes whose images are checked at upload
+var mediaDimensionRules = map[string]mediaDimensionRule{
+	m.MediaPurposeCover:  {aspect: 2.0 / 3.0, label: "2:3", minWidth: 300, minHeight: 450},
+	m.MediaPurposeAvatar: {aspect: 1, label: "1:1", minWidth: 128, minHeight: 128},
+}
+
+// mediaVariantSpec describes one rendition; crop renditions are filled to the exact
+// size, the others fit inside it (0 = unbounded). Images are never upscaled.
+type mediaVariantSpec struct {
+	name   string
+	width  int
+	height int
+	crop   bool
+}
+
+// mediaVariantSpecs lists the renditions derived for each purpose
+var mediaVariantSpecs = map[string][]mediaVariantSpec{
+	m.MediaPurposeCover: {
+		{name: "cover_small", width: 160, height: 240, crop: true},
+		{name: "cover_medium", width: 320, height: 480, crop: true},
+		{name: "cover_large", width: 640, height: 960, crop: true},
+	},
+	m.MediaPurposeAvatar: {
+		{name: "avatar_64", width: 64, height: 64, crop: true},
+		{name: "avatar_128", width: 128, height: 128, crop: true},
+		{name: "avatar_256", width: 256, height: 256, crop: true},
+	},
+	m.MediaPurposePage: {
+		{name: "page_preview", width: 360},
+		{name: "page_display", width: 1080},
+	},
+	m.MediaPurposeGeneric: {
+		{name: "thumb_320", width: 320, height: 320},
+	},
+}
+
+// mediaAttachmentPurposes is the purpose an asset must have been uploaded with to fill a slot
+var mediaAttachmentPurposes = map[string]string{
+	m.MediaAttachmentNovelCover:     m.MediaPurposeCover,
+	m.MediaAttachmentVolumeCover:    m.MediaPurposeCover,
+	m.MediaAttachmentCharacterImage: m.MediaPurposeAvatar,
+}
+
+// MediaSettings limits uploads and the lifetime of signed download URLs
+type MediaSettings struct {
+	MaxUploadBytes      int64
+	AllowedContentTypes []string
+	SignedURLExpiry     time.Duration
+}
+
+// MediaService implements uploads to object storage with checksum dedupe
+type MediaService struct {
+	repos    *repositories.Repositories
+	store    storage.Storage
+	settings MediaSettings
+}
+
+// NewMediaService creates a new media service
+func NewMediaService(repos *repositories.Repositories, store storage.Storage, settings MediaSettings) interfaces.MediaServiceInterface {
+	if settings.MaxUploadBytes <= 0 {
+		settings.MaxUploadBytes = 10 << 20
+	}
+	if settings.SignedURLExpiry <= 0 {
+		settings.SignedURLExpiry = defaultSignedURLExpiry
+	}
+	return &MediaService{
+		repos:    repos,
+		store:    store,
+		settings: settings,
+	}
+}
+
+// Upload stores a file under a key derived from its SHA-256 checksum. The content type is
+// sniffed from the bytes; images are checked against the purpose and stripped of metadata
+// before hashing, and renditions are queued. A file already uploaded by anyone is reused.
+func (s *MediaService) Upload(ctx context.Context, file io.ReadSeeker, filename, purpose string, actor d.ContentActor) (*d.MediaAssetResponse, error) {
+	if purpose == "" {
+		purpose = m.MediaPurposeGeneric
+	}
+	if _, ok := mediaVariantSpecs[purpose]; !ok {
+		return nil, fmt.Errorf("invalid purpose: must be one of generic, cover, avatar, page")
+	}
+
+	data, err := io.ReadAll(io.LimitReader(file, s.settings.MaxUploadBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read upload: %w", err)
+	}
+	if len(data) == 0 {
+		return nil, fmt.Errorf("invalid file: empty upload")
+	}
+	if int64(len(data)) > s.settings.MaxUploadBytes {
+		return nil, fmt.Errorf("file too large: the limit is %d bytes", s.settings.MaxUploadBytes)
+	}
+
+	contentType := http.DetectContentType(data[:min(len(data), sniffLength)])
+	if !s.allowed(contentType) {
+		return nil, fmt.Errorf("invalid file type: %s is not allowed", contentType)
+	}
+
+	var width, height *int
+	status := m.MediaProcessingSkipped
+	if format, err := imaging.Detect(data); err == nil {
+		w, h, err := imaging.Dimensions(data)
+		if err != nil {
+			return nil, err
+		}
+		if err := validateImageDimensions(purpose, w, h); err != nil {
+			return nil, err
+		}
+		if data, err = imaging.StripMetadata(data); err != nil {
+			return nil, err
+		}
+		width, height = &w, &h
+		if imaging.CanDecode(format) {
+			status = m.MediaProcessingPending
+		}
+	} else if purpose != m.MediaPurposeGeneric {
+		return nil, fmt.Errorf("invalid image: purpose %s requires an image", purpose)
+	}
+
+	sum := sha256.Sum256(data)
+	checksum := hex.EncodeToString(sum[:])
+	size := int64(len(data))
+
+	existing, err := s.repos.Media.GetByChecksum(ctx, checksum)
+	if err != nil && !strings.Contains(err.Error(), "not found") {
+		return nil, err
+	}
+	// Re-upload when the object went missing from storage so the asset heals itself
+	if existing != nil {
+		if _, statErr := s.store.Stat(ctx, existing.StorageKey); statErr != nil {
+			if !errors.Is(statErr, storage.ErrNotFound) {
+				return nil, fmt.Errorf("failed to check stored media: %w", statErr)
+			}
+			if err := s.store.Put(ctx, existing.StorageKey, bytes.NewReader(data), size, contentType); err != nil {
+				return nil, fmt.Errorf("failed to store media: %w", err)
+			}
+		}
+		if !slices.Contains(existing.Purposes, purpose) {
+			if existing, err = s.repos.Media.AddPurpose(ctx, existing.ID, purpose); err != nil {
+				return nil, err
+			}
+		}
+		return s.toResponse(ctx, existing, true)
+	}
+
+	key := mediaStorageKey(checksum, contentType)
+	if err := s.store.Put(ctx, key, bytes.NewReader(data), size, contentType); err != nil {
+		return nil, fmt.Errorf("failed to store media: %w", err)
+	}
+
+	asset := &m.MediaAsset{
+		ChecksumSHA256:   checksum,
+		StorageKey:       key,
+		ContentType:      contentType,
+		SizeBytes:        size,
+		OriginalFilename: cleanFilename(filename),
+		UploadedByUserID: actor.UserID,
+		TenantID:         actor.TenantID,
+		Width:            width,
+		Height:           height,
+		Purposes:         []string{purpose},
+		ProcessingStatus: status,
+	}
+	saved, created, err := s.repos.Media.Create(ctx, asset)
+	if err != nil {
+		return nil, err
+	}
+	if !created && !slices.Contains(saved.Purposes, purpose) {
+		if saved, err = s.repos.Media.AddPurpose(ctx, saved.ID, purpose); err != nil {
+			return nil, err
+		}
+	}
+	return s.toResponse(ctx, saved, !created)
+}
+
+// GetMedia returns an asset with a fresh signed URL
+func (s *MediaService) GetMedia(ctx context.Context, mediaID string) (*d.MediaAssetResponse, error) {
+	mediaUUID, err := uuid.Parse(mediaID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid media ID format: %w", err)
+	}
+
+	asset, err := s.repos.Media.GetByID(ctx, mediaUUID)
+	if err != nil {
+		return nil, err
+	}
+	return s.toResponse(ctx, asset, false)
+}
+
+// OpenSignedFile verifies a signed URL of the local backend and opens the object;
+// backends that serve their own signed URLs have nothing to serve here
+func (s *MediaService) OpenSignedFile(ctx context.Context, key string, expires int64, signature string) (io.ReadCloser, *storage.ObjectInfo, error) {
+	verifier, ok := s.store.(storage.URLVerifier)
+	if !ok {
+		return nil, nil, fmt.Errorf("media not found")
+	}
+	if err := verifier.VerifySignedURL(key, expires, signature); err != nil {
+		return nil, nil, fmt.Errorf("permission denied: %w", err)
+	}
+
+	body, info, err := s.store.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, nil, fmt.Errorf("media not found")
+		}
+		return nil, nil, err
+	}
+	return body, info, nil
+}
+
+// ProcessPending derives the renditions of a batch of queued images and returns how many
+// succeeded. A failing image is retried on later runs up to mediaMaxAttempts times.
+func (s *MediaService) ProcessPending(ctx context.Context) (int, error) {
+	assets, err := s.repos.Media.ClaimPending(ctx, mediaProcessingBatch, mediaProcessingStaleAfter)
+	if err != nil {
+		return 0, err
+	}
+
+	processed := 0
+	for _, asset := range assets {
+		if err := s.processAsset(ctx, asset); err != nil {
+			log.Printf("failed to process media %s (attempt %d): %v", asset.ID, asset.Attempts, err)
+			if markErr := s.repos.Media.MarkFailed(ctx, asset.ID, err.Error(), mediaMaxAttempts); markErr != nil {
+				return processed, markErr
+			}
+			continue
+		}
+		if err := s.repos.Media.MarkProcessed(ctx, asset.ID, asset.Purposes); err != nil {
+			return processed, err
+		}
+		processed++
+	}
+	return processed, nil
+}
+
+// processAsset decodes the stored original once and writes every rendition of its purposes
+func (s *MediaService) processAsset(ctx context.Context, asset *m.MediaAsset) error {
+	body, _, err := s.store.Get(ctx, asset.StorageKey)
+	if err != nil {
+		return fmt.Errorf("failed to read original: %w", err)
+	}
+	data, err := io.ReadAll(body)
+	body.Close()
+	if err != nil {
+		return fmt.Errorf("failed to read original: %w", err)
+	}
+
+	if w, h, err := imaging.Dimensions(data); err != nil {
+		return err
+	} else if w*h > mediaMaxPixels {
+		return fmt.Errorf("image too large to process: %dx%d", w, h)
+	}
+	img, err := imaging.Decode(data)
+	if err != nil {
+		return err
+	}
+
+	for _, purpose := range asset.Purposes {
+		for _, spec := range mediaVariantSpecs[purpose] {
+			rendition := imaging.Fit(img, spec.width, spec.height)
+			if spec.crop {
+				rendition = imaging.Fill(img, spec.width, spec.height)
+			}
+			encoded, contentType, err := imaging.Encode(rendition)
+			if err != nil {
+				return err
+			}
+
+			variant := &m.MediaVariant{
+				AssetID:     asset.ID,
+				Name:        spec.name,
+				StorageKey:  mediaVariantKey(asset.ChecksumSHA256, spec.name, contentType),
+				ContentType: contentType,
+				Width:       rendition.Bounds().Dx(),
+				Height:      rendition.Bounds().Dy(),
+				SizeBytes:   int64(len(encoded)),
+			}
+			if err := s.store.Put(ctx, variant.StorageKey, bytes.NewReader(encoded), variant.SizeBytes, contentType); err != nil {
+				return fmt.Errorf("failed to store %s: %w", spec.name, err)
+			}
+			if err := s.repos.Media.SaveVariant(ctx, variant); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// UploadLimit returns the maximum accepted file size in bytes
+func (s *MediaService) UploadLimit() int64 {
+	return s.settings.MaxUploadBytes
+}
+
+// allowed reports whether a sniffed content type may be uploaded
+func (s *MediaService) allowed(contentType string) bool {
+	base, _, _ := strings.Cut(contentType, ";")
+	for _, allowed := range s.settings.AllowedContentTypes {
+		if strings.EqualFold(strings.TrimSpace(base), allowed) {
+			return true
+		}
+	}
+	return false
+}
+
+// toResponse maps an asset and signs its download URL and those of its renditions
+func (s *MediaService) toResponse(ctx context.Context, asset *m.MediaAsset, deduplicated bool) (*d.MediaAssetResponse, error) {
+	expiresAt := time.Now().Add(s.settings.SignedURLExpiry)
+	url, err := s.store.SignedURL(ctx, asset.StorageKey, s.settings.SignedURLExpiry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign media URL: %w", err)
+	}
+
+	variants, err := s.repos.Media.ListVariants(ctx, []uuid.UUID{asset.ID})
+	if err != nil {
+		return nil, err
+	}
+	signed, err := signMediaVariants(ctx, s.store, variants[asset.ID], s.settings.SignedURLExpiry)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d.MediaAssetResponse{
+		ID:               asset.ID.String(),
+		ChecksumSHA256:   asset.ChecksumSHA256,
+		ContentType:      asset.ContentType,
+		SizeBytes:        asset.SizeBytes,
+		OriginalFilename: asset.OriginalFilename,
+		URL:              url,
+		URLExpiresAt:     expiresAt,
+		Deduplicated:     deduplicated,
+		CreatedAt:        asset.CreatedAt,
+		Width:            asset.Width,
+		Height:           asset.Height,
+		Purposes:         asset.Purposes,
+		ProcessingStatus: asset.ProcessingStatus,
+		Variants:         signed,
+	}, nil
+}
+
+// mediaStorageKey fans objects out by checksum prefix: media/ab/cd/<checksum>.<ext>
+func mediaStorageKey(checksum, contentType string) string {
+	base, _, _ := strings.Cut(contentType, ";")
+	ext, ok := mediaExtensions[base]
+	if !ok {
+		if extensions, _ := mime.ExtensionsByType(base); len(extensions) > 0 {
+			ext = extensions[0]
+		}
+	}
+	return fmt.Sprintf("media/%s/%s/%s%s", checksum[:2], checksum[2:4], checksum, ext)
+}
+
+// mediaVariantKey stores renditions next to the original: media/ab/cd/<checksum>/<name>.<ext>
+func mediaVariantKey(checksum, name, contentType string) string {
+	return fmt.Sprintf("media/%s/%s/%s/%s%s", checksum[:2], checksum[2:4], checksum, name, mediaExtensions[contentType])
+}
+
+// validateImageDimensions enforces the size limit and the aspect rule of a purpose
+func validateImageDimensions(purpose string, width, height int) error {
+	if width <= 0 || height <= 0 {
+		return fmt.Errorf("invalid image: unreadable dimensions")
+	}
+	if width*height > mediaMaxPixels {
+		return fmt.Errorf("invalid image: %dx%d exceeds %d pixels", width, height, mediaMaxPixels)
+	}
+
+	rule, ok := mediaDimensionRules[purpose]
+	if !ok {
+		return nil
+	}
+	if width < rule.minWidth || height < rule.minHeight {
+		return fmt.Errorf("invalid image: a %s must be at least %dx%d, got %dx%d", purpose, rule.minWidth, rule.minHeight, width, height)
+	}
+	ratio := float64(width) / float64(height)
+	if math.Abs(ratio-rule.aspect)/rule.aspect > mediaAspectTolerance {
+		return fmt.Errorf("invalid image: a %s must have a %s aspect ratio, got %dx%d", purpose, rule.label, width, height)
+	}
+	return nil
+}
+
+// signMediaVariants signs the URLs of renditions keyed by name
+func signMediaVariants(ctx context.Context, store storage.Storage, variants []m.MediaVariant, expiry time.Duration) (map[string]m.MediaImageVariant, error) {
+	signed := make(map[string]m.MediaImageVariant, len(variants))
+	for _, variant := range variants {
+		url, err := store.SignedURL(ctx, variant.StorageKey, expiry)
+		if err != nil {
+			return nil, fmt.Errorf("failed to sign media URL: %w", err)
+		}
+		signed[variant.Name] = m.MediaImageVariant{
+			URL:         url,
+			Width:       variant.Width,
+			Height:      variant.Height,
+			ContentType: variant.ContentType,
+		}
+	}
+	return signed, nil
+}
+
+// cleanFilename keeps the base name of a client file name for display
+func cleanFilename(filename string) *string {
+	name := strings.TrimSpace(filepath.Base(strings.ReplaceAll(filename, `\`, "/")))
+	if name == "" || name == "." || name == "/" {
+		return nil
+	}
+	if runes := []rune(name); len(runes) > 255 {
+		name = string(runes[:255])
+	}
+	return &name
+}
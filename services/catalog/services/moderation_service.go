@@ -0,0 +1,187 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+	"wibusystem/services/catalog/grpc"
+	"wibusystem/services/catalog/repositories"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// ModerationService implements content reporting and moderation business logic
+type ModerationService struct {
+	repos       *repositories.Repositories
+	grpcClients *grpc.ClientManager
+}
+
+// NewModerationService creates a new moderation service
+func NewModerationService(repos *repositories.Repositories, grpcClients *grpc.ClientManager) interfaces.ModerationServiceInterface {
+	return &ModerationService{
+		repos:       repos,
+		grpcClients: grpcClients,
+	}
+}
+
+// CreateReport validates the report and attaches it to the open case of its target
+func (s *ModerationService) CreateReport(ctx context.Context, reporterID uuid.UUID, req d.CreateReportRequest) (*d.CreateReportResponse, error) {
+	targetType := m.ReportTargetType(req.TargetType)
+	if !targetType.IsValid() {
+		return nil, fmt.Errorf("invalid report target type: %s", req.TargetType)
+	}
+
+	targetID, err := uuid.Parse(req.TargetID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid target ID format: %w", err)
+	}
+
+	reason := m.ReportReason(req.Reason)
+	if !reason.IsValid() {
+		return nil, fmt.Errorf("invalid report reason: %s", req.Reason)
+	}
+
+	if targetType == m.ReportTargetUser && targetID == reporterID {
+		return nil, fmt.Errorf("invalid report target: users cannot report themselves")
+	}
+
+	if req.Details != nil && len(*req.Details) > 2000 {
+		return nil, fmt.Errorf("invalid report details: must be at most 2000 characters")
+	}
+
+	report := m.ContentReport{
+		ReporterID: reporterID,
+		Reason:     reason,
+		Details:    req.Details,
+	}
+
+	return s.repos.Moderation.CreateReport(ctx, report, targetType, targetID)
+}
+
+// ListCases resolves the assigned_to filter and reads the queue
+func (s *ModerationService) ListCases(ctx context.Context, moderatorID uuid.UUID, req d.ListModerationCasesRequest) (*d.PaginatedModerationCasesResponse, error) {
+	if req.Status != "" && !m.ModerationCaseStatus(req.Status).IsValid() {
+		return nil, fmt.Errorf("invalid case status: %s", req.Status)
+	}
+	if req.TargetType != "" && !m.ReportTargetType(req.TargetType).IsValid() {
+		return nil, fmt.Errorf("invalid report target type: %s", req.TargetType)
+	}
+
+	var assignedTo *uuid.UUID
+	unassigned := false
+	switch req.AssignedTo {
+	case "":
+	case "me":
+		assignedTo = &moderatorID
+	case "none":
+		unassigned = true
+	default:
+		id, err := uuid.Parse(req.AssignedTo)
+		if err != nil {
+			return nil, fmt.Errorf("invalid moderator ID format: %w", err)
+		}
+		assignedTo = &id
+	}
+
+	return s.repos.Moderation.ListCases(ctx, req, assignedTo, unassigned)
+}
+
+// GetCase retrieves a case with its reports and audit trail
+func (s *ModerationService) GetCase(ctx context.Context, caseID string) (*d.ModerationCaseDetailResponse, error) {
+	caseUUID, err := uuid.Parse(caseID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid case ID format: %w", err)
+	}
+
+	return s.repos.Moderation.GetCase(ctx, caseUUID)
+}
+
+// ClaimCase assigns the case to the calling moderator unless someone else holds it
+func (s *ModerationService) ClaimCase(ctx context.Context, caseID string, moderatorID uuid.UUID) error {
+	caseUUID, err := uuid.Parse(caseID)
+	if err != nil {
+		return fmt.Errorf("invalid case ID format: %w", err)
+	}
+
+	return s.repos.Moderation.AssignCase(ctx, caseUUID, moderatorID, moderatorID, true)
+}
+
+// AssignCase hands the case to the given moderator, overriding any current assignee
+func (s *ModerationService) AssignCase(ctx context.Context, caseID string, actorID uuid.UUID, req d.AssignModerationCaseRequest) error {
+	caseUUID, err := uuid.Parse(caseID)
+	if err != nil {
+		return fmt.Errorf("invalid case ID format: %w", err)
+	}
+
+	moderatorUUID, err := uuid.Parse(req.ModeratorID)
+	if err != nil {
+		return fmt.Errorf("invalid moderator ID format: %w", err)
+	}
+
+	return s.repos.Moderation.AssignCase(ctx, caseUUID, actorID, moderatorUUID, false)
+}
+
+// ResolveCase closes the case. WARN_USER and SUSPEND_USER need a user: the one given in
+// the request, or the reported user / personal owner of the reported content. Suspension
+// is applied in the identify service first so a failed call leaves the case open.
+func (s *ModerationService) ResolveCase(ctx context.Context, caseID string, moderatorID uuid.UUID, req d.ResolveModerationCaseRequest) error {
+	caseUUID, err := uuid.Parse(caseID)
+	if err != nil {
+		return fmt.Errorf("invalid case ID format: %w", err)
+	}
+
+	action := m.ModerationAction(req.Action)
+	if !action.IsValid() {
+		return fmt.Errorf("invalid moderation action: %s", req.Action)
+	}
+
+	if req.Note != nil && len(*req.Note) > 2000 {
+		return fmt.Errorf("invalid resolution note: must be at most 2000 characters")
+	}
+
+	resolution := repositories.ModerationResolution{
+		Action:      action,
+		Note:        req.Note,
+		ModeratorID: moderatorID,
+	}
+
+	if action == m.ModerationActionWarnUser || action == m.ModerationActionSuspendUser {
+		if req.UserID != nil && *req.UserID != "" {
+			userUUID, err := uuid.Parse(*req.UserID)
+			if err != nil {
+				return fmt.Errorf("invalid user ID format: %w", err)
+			}
+			resolution.UserID = &userUUID
+		}
+
+		// Also rejects closed cases before a user account is touched
+		targetType, targetID, err := s.repos.Moderation.GetOpenCaseTarget(ctx, caseUUID)
+		if err != nil {
+			return err
+		}
+		if resolution.UserID == nil {
+			resolution.UserID, err = s.repos.Moderation.FindTargetOwner(ctx, targetType, targetID)
+			if err != nil {
+				return err
+			}
+		}
+		if resolution.UserID == nil {
+			return fmt.Errorf("target user is required for action %s", action)
+		}
+	}
+
+	if action == m.ModerationActionSuspendUser {
+		reason := fmt.Sprintf("moderation case %s", caseUUID)
+		if req.Note != nil && *req.Note != "" {
+			reason = *req.Note
+		}
+		if err := s.grpcClients.SuspendUser(ctx, resolution.UserID.String(), reason, moderatorID.String()); err != nil {
+			return fmt.Errorf("failed to suspend user: %w", err)
+		}
+	}
+
+	return s.repos.Moderation.ResolveCase(ctx, caseUUID, resolution)
+}
@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"fmt"
+	"log"
 
 	"github.com/google/uuid"
 
@@ -17,20 +18,57 @@ import (
 type NovelService struct {
 	repos       *repositories.Repositories
 	grpcClients *grpc.ClientManager
+	images      *mediaResolver
 }
 
-func NewNovelService(repos *repositories.Repositories, grpcClients *grpc.ClientManager) interfaces.NovelServiceInterface {
+func NewNovelService(repos *repositories.Repositories, grpcClients *grpc.ClientManager, images *mediaResolver) interfaces.NovelServiceInterface {
 	return &NovelService{
 		repos:       repos,
 		grpcClients: grpcClients,
+		images:      images,
 	}
 }
 
 func (n NovelService) CreateNovel(ctx context.Context, req d.CreateNovelRequest) (*m.Novel, error) {
-	return n.repos.Novel.CreateNovel(ctx, req)
+	// Validate the uploaded cover before creating the novel
+	cover, err := n.images.Prepare(ctx, m.MediaAttachmentNovelCover, req.CoverMediaID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Store canonical tag names so aliases and spelling variants share one tag
+	tags, tagNames, err := normalizeNovelTags(ctx, n.repos, req.Tags)
+	if err != nil {
+		return nil, err
+	}
+	req.Tags = tags
+
+	// Content warnings must be active codes of the warning taxonomy
+	req.ContentWarnings, err = normalizeContentWarnings(ctx, n.repos, req.ContentWarnings, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	novel, err := n.repos.Novel.CreateNovel(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	refreshTagUsage(ctx, n.repos, tagNames)
+
+	if err := n.images.Apply(ctx, novel.ID, cover); err != nil {
+		return nil, err
+	}
+	return novel, nil
 }
 
 func (n NovelService) ListNovels(ctx context.Context, req d.ListNovelsRequest) (*d.PaginatedNovelsResponse, error) {
+	// Match the tags filter through aliases and synonyms
+	tags, err := resolveTagFilter(ctx, n.repos, req.Tags)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve tags: %w", err)
+	}
+	req.Tags = tags
+
 	// Get novels from repository
 	response, err := n.repos.Novel.ListNovels(ctx, req)
 	if err != nil {
@@ -85,10 +123,26 @@ func (n NovelService) ListNovels(ctx context.Context, req d.ListNovelsRequest) (
 		}
 	}
 
+	// Batch-load uploaded covers with their renditions
+	novelIDs := make([]uuid.UUID, 0, len(response.Novels))
+	for _, novel := range response.Novels {
+		if id, err := uuid.Parse(novel.ID); err == nil {
+			novelIDs = append(novelIDs, id)
+		}
+	}
+	covers, err := n.images.Resolve(ctx, m.MediaAttachmentNovelCover, novelIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load novel covers: %w", err)
+	}
+
 	// Update response with populated user and tenant data
 	for i := range response.Novels {
 		novel := &response.Novels[i]
 
+		if id, err := uuid.Parse(novel.ID); err == nil {
+			novel.CoverMedia = covers[id]
+		}
+
 		// Populate primary owner info based on ownership type
 		if novel.PrimaryOwnerID != "" {
 			switch novel.OwnershipType {
@@ -116,7 +170,7 @@ func (n NovelService) ListNovels(ctx context.Context, req d.ListNovelsRequest) (
 
 // GetNovelByID implements detailed novel retrieval with optional translations and stats
 // Uses NovelQueryRepository (CQRS pattern) for optimized single-query data fetching
-func (n NovelService) GetNovelByID(ctx context.Context, id string, includeTranslations, includeStats bool, language string) (*d.NovelDetailResponse, error) {
+func (n NovelService) GetNovelByID(ctx context.Context, id string, includeTranslations, includeStats bool, languages []string) (*d.NovelDetailResponse, error) {
 	// Parse UUID
 	novelUUID, err := uuid.Parse(id)
 	if err != nil {
@@ -129,16 +183,36 @@ func (n NovelService) GetNovelByID(ctx context.Context, id string, includeTransl
 		return nil, fmt.Errorf("failed to get novel with full details: %w", err)
 	}
 
-	// Set current language from request parameter
-	response.CurrentLanguage = language
+	// Serve the name and summary in the best language the reader accepts
+	translations, err := n.repos.Translation.ListNovelTranslations(ctx, novelUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load novel translations: %w", err)
+	}
+	if len(languages) > 0 {
+		response.CurrentLanguage = languages[0]
+	}
+	localizeNovelDetail(response, translations, languages)
+	if err := localizeGenreInfos(ctx, n.repos, response.Genres, languages); err != nil {
+		return nil, fmt.Errorf("failed to load genre translations: %w", err)
+	}
+
+	// Attach uploaded cover with renditions
+	response.CoverMedia, err = n.images.ResolveOne(ctx, m.MediaAttachmentNovelCover, novelUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load novel cover: %w", err)
+	}
+
+	// Record the view for trending; a stats failure must not fail the read
+	if err := n.repos.Ranking.IncrementDailyStats(ctx, m.NovelDailyStats{NovelID: novelUUID, ViewCount: 1}); err != nil {
+		log.Printf("failed to record novel view for %s: %v", novelUUID, err)
+	}
 
 	// Load translations if includeTranslations is true
 	if includeTranslations {
-		translations, err := n.repos.Novel.GetNovelTranslations(ctx, novelUUID, language)
-		if err != nil {
-			return nil, fmt.Errorf("failed to load novel translations: %w", err)
+		response.Translations = make([]interface{}, 0, len(translations))
+		for _, translation := range translations {
+			response.Translations = append(response.Translations, toNovelTranslationResponse(translation))
 		}
-		response.Translations = translations
 	}
 
 	// Load stats if includeStats is true
@@ -161,11 +235,51 @@ func (n NovelService) UpdateNovel(ctx context.Context, id string, req d.UpdateNo
 		return nil, fmt.Errorf("invalid novel ID format: %w", err)
 	}
 
+	// Validate the uploaded cover before writing anything
+	cover, err := n.images.Prepare(ctx, m.MediaAttachmentNovelCover, req.CoverMediaID)
+	if err != nil {
+		return nil, err
+	}
+
+	var current *m.Novel
+	if req.Tags != nil || req.ContentWarnings != nil {
+		current, err = n.repos.Novel.GetNovelByID(ctx, novelUUID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get novel: %w", err)
+		}
+	}
+
+	// Normalize tags and remember the previous ones to recount both
+	var previousTags, tagNames []string
+	if req.Tags != nil {
+		previousTags = novelTagNames(current.Tags)
+
+		req.Tags, tagNames, err = normalizeNovelTags(ctx, n.repos, req.Tags)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Content warnings must be taxonomy codes; retired ones the novel already carries may stay
+	if req.ContentWarnings != nil {
+		req.ContentWarnings, err = normalizeContentWarnings(ctx, n.repos, req.ContentWarnings, decodeContentWarnings(current.ContentWarnings))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Update novel through repository
 	novel, err := n.repos.Novel.UpdateNovel(ctx, novelUUID, req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update novel: %w", err)
 	}
+	if req.Tags != nil {
+		refreshTagUsage(ctx, n.repos, previousTags, tagNames)
+	}
+
+	if err := n.images.Apply(ctx, novel.ID, cover); err != nil {
+		return nil, err
+	}
 
 	// Create response
 	response := &d.UpdateNovelResponse{
@@ -194,11 +308,18 @@ func (n NovelService) DeleteNovel(ctx context.Context, id string, deletedByUserI
 		return fmt.Errorf("invalid user ID format: %w", err)
 	}
 
+	// Remember the tags so their usage counts drop with the novel
+	var tagNames []string
+	if current, err := n.repos.Novel.GetNovelByID(ctx, novelUUID); err == nil {
+		tagNames = novelTagNames(current.Tags)
+	}
+
 	// Delete novel through repository (includes purchase checks)
 	err = n.repos.Novel.DeleteNovel(ctx, novelUUID, deletedByUUID)
 	if err != nil {
 		return fmt.Errorf("failed to delete novel: %w", err)
 	}
+	refreshTagUsage(ctx, n.repos, tagNames)
 
 	return nil
 }
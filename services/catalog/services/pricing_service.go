@@ -0,0 +1,455 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+	"wibusystem/services/catalog/repositories"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// PricingService implements discount campaigns, bundles and price quotes
+type PricingService struct {
+	repos *repositories.Repositories
+}
+
+// NewPricingService creates a new pricing service
+func NewPricingService(repos *repositories.Repositories) interfaces.PricingServiceInterface {
+	return &PricingService{
+		repos: repos,
+	}
+}
+
+// CreateCampaign creates a discount campaign on a scope the actor manages
+func (s *PricingService) CreateCampaign(ctx context.Context, req d.CreateDiscountCampaignRequest, actor d.ContentActor) (*m.DiscountCampaign, error) {
+	scopeID, err := uuid.Parse(req.ScopeID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid scope ID format: %w", err)
+	}
+
+	campaign := &m.DiscountCampaign{
+		Name:            strings.TrimSpace(req.Name),
+		Description:     req.Description,
+		DiscountType:    req.DiscountType,
+		DiscountValue:   req.DiscountValue,
+		ScopeType:       req.ScopeType,
+		ScopeID:         scopeID,
+		StartsAt:        req.StartsAt,
+		EndsAt:          req.EndsAt,
+		IsActive:        true,
+		CreatedByUserID: actor.UserID,
+		TenantID:        actor.TenantID,
+	}
+	if err := validateCampaign(campaign); err != nil {
+		return nil, err
+	}
+
+	exists, err := s.repos.Pricing.ScopeExists(ctx, campaign.ScopeType, scopeID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("%s not found", strings.ToLower(campaign.ScopeType))
+	}
+	if err := s.authorizeCampaignScope(ctx, campaign.ScopeType, scopeID, actor); err != nil {
+		return nil, err
+	}
+
+	if err := s.repos.Pricing.CreateCampaign(ctx, campaign); err != nil {
+		return nil, err
+	}
+	return campaign, nil
+}
+
+// GetCampaign returns a campaign to an actor who manages its scope
+func (s *PricingService) GetCampaign(ctx context.Context, campaignID string, actor d.ContentActor) (*m.DiscountCampaign, error) {
+	return s.loadManagedCampaign(ctx, campaignID, actor)
+}
+
+// ListCampaigns lists the campaigns created by the actor or their tenant; admins see every campaign
+func (s *PricingService) ListCampaigns(ctx context.Context, req d.ListDiscountCampaignsRequest, actor d.ContentActor) (*d.PaginatedDiscountCampaignsResponse, error) {
+	switch req.ScopeType {
+	case "", m.DiscountScopeNovel, m.DiscountScopeTenant, m.DiscountScopeGenre:
+	default:
+		return nil, fmt.Errorf("invalid scope type: %s", req.ScopeType)
+	}
+	switch req.Status {
+	case "", "scheduled", "running", "ended":
+	default:
+		return nil, fmt.Errorf("invalid campaign status: %s", req.Status)
+	}
+	if req.ScopeID != "" {
+		if _, err := uuid.Parse(req.ScopeID); err != nil {
+			return nil, fmt.Errorf("invalid scope ID format: %w", err)
+		}
+	}
+
+	var userID *uuid.UUID
+	if !actor.IsAdmin {
+		userID = &actor.UserID
+	}
+
+	campaigns, pagination, err := s.repos.Pricing.ListCampaigns(ctx, req, userID, actor.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d.PaginatedDiscountCampaignsResponse{
+		Campaigns:  campaigns,
+		Pagination: *pagination,
+	}, nil
+}
+
+// UpdateCampaign changes the discount, schedule or active flag of a campaign
+func (s *PricingService) UpdateCampaign(ctx context.Context, campaignID string, req d.UpdateDiscountCampaignRequest, actor d.ContentActor) (*m.DiscountCampaign, error) {
+	campaign, err := s.loadManagedCampaign(ctx, campaignID, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		campaign.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Description != nil {
+		campaign.Description = req.Description
+	}
+	if req.DiscountType != nil {
+		campaign.DiscountType = *req.DiscountType
+	}
+	if req.DiscountValue != nil {
+		campaign.DiscountValue = *req.DiscountValue
+	}
+	if req.StartsAt != nil {
+		campaign.StartsAt = *req.StartsAt
+	}
+	if req.EndsAt != nil {
+		campaign.EndsAt = *req.EndsAt
+	}
+	if req.IsActive != nil {
+		campaign.IsActive = *req.IsActive
+	}
+	if err := validateCampaign(campaign); err != nil {
+		return nil, err
+	}
+
+	if err := s.repos.Pricing.UpdateCampaign(ctx, campaign); err != nil {
+		return nil, err
+	}
+	return campaign, nil
+}
+
+// DeleteCampaign removes a campaign
+func (s *PricingService) DeleteCampaign(ctx context.Context, campaignID string, actor d.ContentActor) error {
+	campaign, err := s.loadManagedCampaign(ctx, campaignID, actor)
+	if err != nil {
+		return err
+	}
+	return s.repos.Pricing.DeleteCampaign(ctx, campaign.ID)
+}
+
+// CreateBundle creates a bundle of volumes of a novel; requires MANAGE_PRICING
+func (s *PricingService) CreateBundle(ctx context.Context, novelID string, req d.CreateBundleRequest, actor d.ContentActor) (*m.NovelBundle, error) {
+	novelUUID, err := uuid.Parse(novelID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid novel ID format: %w", err)
+	}
+	volumeIDs, err := parseBundleVolumeIDs(req.VolumeIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := &m.NovelBundle{
+		NovelID:         novelUUID,
+		Name:            strings.TrimSpace(req.Name),
+		Description:     req.Description,
+		PriceCoins:      req.PriceCoins,
+		StartsAt:        req.StartsAt,
+		EndsAt:          req.EndsAt,
+		IsActive:        true,
+		CreatedByUserID: actor.UserID,
+		VolumeIDs:       volumeIDs,
+	}
+	if err := validateBundle(bundle); err != nil {
+		return nil, err
+	}
+
+	if err := authorizeNovel(ctx, s.repos, novelUUID, actor, m.PermissionManagePricing); err != nil {
+		return nil, err
+	}
+
+	if err := s.repos.Pricing.CreateBundle(ctx, bundle); err != nil {
+		return nil, err
+	}
+	return s.repos.Pricing.GetBundle(ctx, bundle.ID)
+}
+
+// GetBundle returns a bundle with its volumes
+func (s *PricingService) GetBundle(ctx context.Context, bundleID string) (*m.NovelBundle, error) {
+	bundleUUID, err := uuid.Parse(bundleID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid bundle ID format: %w", err)
+	}
+	return s.repos.Pricing.GetBundle(ctx, bundleUUID)
+}
+
+// ListNovelBundles lists the bundles of a novel that are on sale now
+func (s *PricingService) ListNovelBundles(ctx context.Context, novelID string) ([]m.NovelBundle, error) {
+	novelUUID, err := uuid.Parse(novelID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid novel ID format: %w", err)
+	}
+	return s.repos.Pricing.ListNovelBundles(ctx, novelUUID)
+}
+
+// UpdateBundle changes a bundle; requires MANAGE_PRICING on its novel
+func (s *PricingService) UpdateBundle(ctx context.Context, bundleID string, req d.UpdateBundleRequest, actor d.ContentActor) (*m.NovelBundle, error) {
+	bundle, err := s.loadManagedBundle(ctx, bundleID, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		bundle.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Description != nil {
+		bundle.Description = req.Description
+	}
+	if req.PriceCoins != nil {
+		bundle.PriceCoins = *req.PriceCoins
+	}
+	if req.StartsAt != nil {
+		bundle.StartsAt = req.StartsAt
+	}
+	if req.EndsAt != nil {
+		bundle.EndsAt = req.EndsAt
+	}
+	if req.IsActive != nil {
+		bundle.IsActive = *req.IsActive
+	}
+	replaceVolumes := req.VolumeIDs != nil
+	if replaceVolumes {
+		volumeIDs, err := parseBundleVolumeIDs(req.VolumeIDs)
+		if err != nil {
+			return nil, err
+		}
+		bundle.VolumeIDs = volumeIDs
+	}
+	if err := validateBundle(bundle); err != nil {
+		return nil, err
+	}
+
+	if err := s.repos.Pricing.UpdateBundle(ctx, bundle, replaceVolumes); err != nil {
+		return nil, err
+	}
+	return s.repos.Pricing.GetBundle(ctx, bundle.ID)
+}
+
+// DeleteBundle removes a bundle; requires MANAGE_PRICING on its novel
+func (s *PricingService) DeleteBundle(ctx context.Context, bundleID string, actor d.ContentActor) error {
+	bundle, err := s.loadManagedBundle(ctx, bundleID, actor)
+	if err != nil {
+		return err
+	}
+	return s.repos.Pricing.DeleteBundle(ctx, bundle.ID)
+}
+
+// QuotePrice computes what the user would pay for an item now. What the user already owns is
+// credited first (for bundles at the bundle's own discount rate), then the largest running
+// campaign discount is applied to the rest. Campaigns do not stack with each other or with bundles.
+func (s *PricingService) QuotePrice(ctx context.Context, userID uuid.UUID, req d.PriceQuoteRequest) (*d.PriceQuoteResponse, error) {
+	switch req.ItemType {
+	case d.QuoteItemNovelChapter, d.QuoteItemNovelVolume, d.QuoteItemNovelSeries, d.QuoteItemNovelBundle:
+	default:
+		return nil, fmt.Errorf("invalid item type: %s", req.ItemType)
+	}
+	itemID, err := uuid.Parse(req.ItemID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid item ID format: %w", err)
+	}
+
+	target, err := s.repos.Pricing.GetQuoteTarget(ctx, req.ItemType, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	quote := &d.PriceQuoteResponse{
+		ItemType: req.ItemType,
+		ItemID:   itemID.String(),
+		NovelID:  target.NovelID.String(),
+		QuotedAt: time.Now(),
+	}
+	if target.IsFree {
+		return quote, nil
+	}
+	if target.PriceCoins == nil {
+		return nil, fmt.Errorf("%s is not for sale", strings.ToLower(req.ItemType))
+	}
+	quote.BasePriceCoins = *target.PriceCoins
+
+	ownership, err := s.repos.Pricing.GetOwnership(ctx, userID, req.ItemType, itemID, target)
+	if err != nil {
+		return nil, err
+	}
+	quote.OwnedVolumes = ownership.OwnedVolumes
+	quote.OwnedChapters = ownership.OwnedChapters
+	if ownership.AlreadyOwned {
+		quote.AlreadyOwned = true
+		quote.OwnedCredit = quote.BasePriceCoins
+		return quote, nil
+	}
+
+	credit := ownership.CreditCoins
+	if req.ItemType == d.QuoteItemNovelBundle && target.ListPriceCoins > 0 {
+		credit = credit * quote.BasePriceCoins / target.ListPriceCoins
+	}
+	quote.OwnedCredit = min(credit, quote.BasePriceCoins)
+	subtotal := quote.BasePriceCoins - quote.OwnedCredit
+
+	if subtotal > 0 && req.ItemType != d.QuoteItemNovelBundle {
+		campaigns, err := s.repos.Pricing.ListRunningCampaigns(ctx, target.NovelID)
+		if err != nil {
+			return nil, err
+		}
+		for _, campaign := range campaigns {
+			amount := campaignDiscount(campaign, subtotal)
+			if amount > quote.DiscountCoins {
+				quote.DiscountCoins = amount
+				quote.Discount = &d.AppliedDiscount{
+					CampaignID:    campaign.ID.String(),
+					Name:          campaign.Name,
+					DiscountType:  campaign.DiscountType,
+					DiscountValue: campaign.DiscountValue,
+					EndsAt:        campaign.EndsAt,
+				}
+			}
+		}
+	}
+
+	quote.FinalPrice = subtotal - quote.DiscountCoins
+	return quote, nil
+}
+
+// loadManagedCampaign loads a campaign and checks the actor manages its scope
+func (s *PricingService) loadManagedCampaign(ctx context.Context, campaignID string, actor d.ContentActor) (*m.DiscountCampaign, error) {
+	campaignUUID, err := uuid.Parse(campaignID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid campaign ID format: %w", err)
+	}
+
+	campaign, err := s.repos.Pricing.GetCampaign(ctx, campaignUUID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizeCampaignScope(ctx, campaign.ScopeType, campaign.ScopeID, actor); err != nil {
+		return nil, err
+	}
+	return campaign, nil
+}
+
+// authorizeCampaignScope checks the actor may discount the scope: MANAGE_PRICING on a novel,
+// membership of a tenant, and administrators only for genres that span many publishers
+func (s *PricingService) authorizeCampaignScope(ctx context.Context, scopeType string, scopeID uuid.UUID, actor d.ContentActor) error {
+	if actor.IsAdmin {
+		return nil
+	}
+
+	switch scopeType {
+	case m.DiscountScopeNovel:
+		return authorizeNovel(ctx, s.repos, scopeID, actor, m.PermissionManagePricing)
+	case m.DiscountScopeTenant:
+		if actor.TenantID == nil || *actor.TenantID != scopeID {
+			return fmt.Errorf("permission denied: tenant campaigns are restricted to members of the tenant")
+		}
+		return nil
+	default:
+		return fmt.Errorf("permission denied: genre campaigns are restricted to administrators")
+	}
+}
+
+// loadManagedBundle loads a bundle and checks MANAGE_PRICING on its novel
+func (s *PricingService) loadManagedBundle(ctx context.Context, bundleID string, actor d.ContentActor) (*m.NovelBundle, error) {
+	bundle, err := s.GetBundle(ctx, bundleID)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeNovel(ctx, s.repos, bundle.NovelID, actor, m.PermissionManagePricing); err != nil {
+		return nil, err
+	}
+	return bundle, nil
+}
+
+// campaignDiscount is the coins a campaign takes off a price, never more than the price
+func campaignDiscount(campaign m.DiscountCampaign, price int) int {
+	if campaign.DiscountType == m.DiscountTypePercentage {
+		return price * campaign.DiscountValue / 100
+	}
+	return min(campaign.DiscountValue, price)
+}
+
+// validateCampaign checks a campaign before it is stored
+func validateCampaign(campaign *m.DiscountCampaign) error {
+	if campaign.Name == "" {
+		return fmt.Errorf("invalid campaign: name is required")
+	}
+	switch campaign.ScopeType {
+	case m.DiscountScopeNovel, m.DiscountScopeTenant, m.DiscountScopeGenre:
+	default:
+		return fmt.Errorf("invalid scope type: %s", campaign.ScopeType)
+	}
+	switch campaign.DiscountType {
+	case m.DiscountTypePercentage:
+		if campaign.DiscountValue < 1 || campaign.DiscountValue > 100 {
+			return fmt.Errorf("invalid discount value: percentage must be between 1 and 100")
+		}
+	case m.DiscountTypeFixed:
+		if campaign.DiscountValue < 1 {
+			return fmt.Errorf("invalid discount value: must be at least 1 coin")
+		}
+	default:
+		return fmt.Errorf("invalid discount type: %s", campaign.DiscountType)
+	}
+	if !campaign.EndsAt.After(campaign.StartsAt) {
+		return fmt.Errorf("invalid campaign schedule: ends_at must be after starts_at")
+	}
+	return nil
+}
+
+// validateBundle checks a bundle before it is stored
+func validateBundle(bundle *m.NovelBundle) error {
+	if bundle.Name == "" {
+		return fmt.Errorf("invalid bundle: name is required")
+	}
+	if bundle.PriceCoins < 0 {
+		return fmt.Errorf("invalid bundle price: must not be negative")
+	}
+	if bundle.StartsAt != nil && bundle.EndsAt != nil && !bundle.EndsAt.After(*bundle.StartsAt) {
+		return fmt.Errorf("invalid bundle schedule: ends_at must be after starts_at")
+	}
+	return nil
+}
+
+// parseBundleVolumeIDs parses the volumes of a bundle; a bundle needs at least two distinct volumes
+func parseBundleVolumeIDs(ids []string) ([]uuid.UUID, error) {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	parsed := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		volumeID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid volume ID format: %w", err)
+		}
+		if !seen[volumeID] {
+			seen[volumeID] = true
+			parsed = append(parsed, volumeID)
+		}
+	}
+	if len(parsed) < 2 {
+		return nil, fmt.Errorf("invalid bundle: at least two volumes are required")
+	}
+	return parsed, nil
+}
@@ -0,0 +1,118 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+	"wibusystem/services/catalog/repositories"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// defaultEngagementWeights favours deliberate signals (bookmarks) over passive views
+var defaultEngagementWeights = m.EngagementWeights{
+	View:     1,
+	Bookmark: 5,
+}
+
+// trendingWindow describes the look-back window and decay of a trending ranking
+type trendingWindow struct {
+	windowDays   int
+	halfLifeDays float64
+}
+
+// trendingWindows maps each trending ranking to its scoring window
+var trendingWindows = map[m.NovelRankingType]trendingWindow{
+	m.RankingTrendingDay:   {windowDays: 2, halfLifeDays: 0.5},
+	m.RankingTrendingWeek:  {windowDays: 7, halfLifeDays: 2},
+	m.RankingTrendingMonth: {windowDays: 30, halfLifeDays: 7},
+}
+
+// Rising compares the last risingRecentDays against the risingBaselineDays before them
+const (
+	risingRecentDays   = 3
+	risingBaselineDays = 14
+)
+
+// RankingService implements trending and ranking business logic
+type RankingService struct {
+	repos *repositories.Repositories
+}
+
+// NewRankingService creates a new ranking service
+func NewRankingService(repos *repositories.Repositories) interfaces.RankingServiceInterface {
+	return &RankingService{
+		repos: repos,
+	}
+}
+
+// ListRanking validates the ranking type and reads the snapshot page
+func (s *RankingService) ListRanking(ctx context.Context, rankingType string, req d.ListNovelRankingRequest) (*d.PaginatedNovelRankingResponse, error) {
+	parsed, err := parseRankingType(rankingType)
+	if err != nil {
+		return nil, err
+	}
+
+	// Match the tag filter through aliases and synonyms
+	if req.Tag != "" {
+		tags, err := resolveTagFilter(ctx, s.repos, []string{req.Tag})
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve tag: %w", err)
+		}
+		if len(tags) > 0 {
+			req.Tag = tags[0]
+		}
+	}
+
+	response, err := s.repos.Ranking.ListRanking(ctx, parsed, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ranking: %w", err)
+	}
+
+	return response, nil
+}
+
+// RebuildRankings recomputes all ranking snapshots. Each ranking is rebuilt in its own
+// transaction so one failure does not leave the others stale.
+func (s *RankingService) RebuildRankings(ctx context.Context) (*d.RebuildRankingsResponse, error) {
+	result := &d.RebuildRankingsResponse{Rankings: make(map[string]int64)}
+	var failures []string
+
+	for _, rankingType := range m.AllNovelRankingTypes {
+		var count int64
+		var err error
+
+		switch rankingType {
+		case m.RankingMostBookmarked:
+			count, err = s.repos.Ranking.RebuildMostBookmarkedSnapshot(ctx)
+		case m.RankingRising:
+			count, err = s.repos.Ranking.RebuildRisingSnapshot(ctx, risingRecentDays, risingBaselineDays, defaultEngagementWeights)
+		default:
+			window := trendingWindows[rankingType]
+			count, err = s.repos.Ranking.RebuildTrendingSnapshot(ctx, rankingType, window.windowDays, window.halfLifeDays, defaultEngagementWeights)
+		}
+
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", rankingType, err))
+			continue
+		}
+		result.Rankings[string(rankingType)] = count
+	}
+
+	if len(failures) > 0 {
+		return result, fmt.Errorf("failed to rebuild rankings: %s", strings.Join(failures, "; "))
+	}
+
+	return result, nil
+}
+
+// parseRankingType accepts path-friendly names such as "trending-week" or "most_bookmarked"
+func parseRankingType(value string) (m.NovelRankingType, error) {
+	normalized := m.NovelRankingType(strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(value), "-", "_")))
+	if !normalized.IsValid() {
+		return "", fmt.Errorf("invalid ranking type: %s", value)
+	}
+	return normalized, nil
+}
@@ -0,0 +1,75 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+	"wibusystem/services/catalog/repositories"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// similarNeighbourLimit caps how many neighbours are stored per novel
+const similarNeighbourLimit = 50
+
+// defaultSimilarityWeights blends the similarity components; they sum to 1
+var defaultSimilarityWeights = m.SimilarityWeights{
+	Genre:      0.35,
+	Tag:        0.25,
+	Creator:    0.15,
+	CoBookmark: 0.25,
+}
+
+// RecommendationService implements similar-novel recommendation logic
+type RecommendationService struct {
+	repos *repositories.Repositories
+}
+
+// NewRecommendationService creates a new recommendation service
+func NewRecommendationService(repos *repositories.Repositories) interfaces.RecommendationServiceInterface {
+	return &RecommendationService{
+		repos: repos,
+	}
+}
+
+// GetSimilarNovels returns neighbours of the given novel
+func (s *RecommendationService) GetSimilarNovels(ctx context.Context, novelID string, req d.ListRecommendationsRequest) ([]d.RecommendedNovelResponse, error) {
+	novelUUID, err := uuid.Parse(novelID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid novel ID format: %w", err)
+	}
+
+	return s.repos.Recommendation.ListSimilarNovels(ctx, novelUUID, normalizeRecommendationLimit(req.Limit), req.IncludeMature, req.Visibility)
+}
+
+// GetRecommendationsForUser returns personalised recommendations for a reader
+func (s *RecommendationService) GetRecommendationsForUser(ctx context.Context, userID uuid.UUID, req d.ListRecommendationsRequest) ([]d.RecommendedNovelResponse, error) {
+	if userID == uuid.Nil {
+		return nil, fmt.Errorf("user ID is required")
+	}
+
+	return s.repos.Recommendation.ListRecommendationsForUser(ctx, userID, normalizeRecommendationLimit(req.Limit), req.IncludeMature, req.Visibility)
+}
+
+// RebuildNeighbours recomputes the neighbours table
+func (s *RecommendationService) RebuildNeighbours(ctx context.Context) (int64, error) {
+	count, err := s.repos.Recommendation.RebuildNeighbours(ctx, defaultSimilarityWeights, similarNeighbourLimit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to rebuild novel neighbours: %w", err)
+	}
+	return count, nil
+}
+
+// normalizeRecommendationLimit applies the default (10) and maximum (50) result counts
+func normalizeRecommendationLimit(limit int) int {
+	if limit <= 0 {
+		return 10
+	}
+	if limit > similarNeighbourLimit {
+		return similarNeighbourLimit
+	}
+	return limit
+}
@@ -0,0 +1,302 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+	"wibusystem/services/catalog/repositories"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// ReleaseService implements wait-until-free unlocks and VIP early access of novel chapters
+type ReleaseService struct {
+	repos *repositories.Repositories
+}
+
+// NewReleaseService creates a new release service
+func NewReleaseService(repos *repositories.Repositories) interfaces.ReleaseServiceInterface {
+	return &ReleaseService{
+		repos: repos,
+	}
+}
+
+// GetPolicy returns the release policy of a novel; disabled settings are omitted
+func (s *ReleaseService) GetPolicy(ctx context.Context, novelID string) (*m.NovelReleasePolicy, error) {
+	novelUUID, err := uuid.Parse(novelID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid novel ID format: %w", err)
+	}
+	return s.repos.Release.GetPolicy(ctx, novelUUID)
+}
+
+// UpdatePolicy replaces every setting of the policy; it requires MANAGE_PRICING on the novel
+func (s *ReleaseService) UpdatePolicy(ctx context.Context, novelID string, req d.UpdateReleasePolicyRequest, actor d.ContentActor) (*m.NovelReleasePolicy, error) {
+	novelUUID, err := uuid.Parse(novelID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid novel ID format: %w", err)
+	}
+	if err := validateReleaseSetting("free_after_days", req.FreeAfterDays, 3650); err != nil {
+		return nil, err
+	}
+	if err := validateReleaseSetting("unlock_interval_hours", req.UnlockIntervalHours, 8760); err != nil {
+		return nil, err
+	}
+	if err := validateReleaseSetting("early_access_hours", req.EarlyAccessHours, 8760); err != nil {
+		return nil, err
+	}
+
+	policy, err := s.repos.Release.GetPolicy(ctx, novelUUID)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeNovel(ctx, s.repos, novelUUID, actor, m.PermissionManagePricing); err != nil {
+		return nil, err
+	}
+
+	updatedBy := actor.UserID
+	policy.FreeAfterDays = req.FreeAfterDays
+	policy.UnlockIntervalHours = req.UnlockIntervalHours
+	policy.EarlyAccessHours = req.EarlyAccessHours
+	policy.UpdatedByUserID = &updatedBy
+	if err := s.repos.Release.UpsertPolicy(ctx, policy); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// ListReaderChapters lists released chapters and the chapters inside the early access window,
+// each with what the reader can do with it right now
+func (s *ReleaseService) ListReaderChapters(ctx context.Context, volumeID string, req d.ListReaderChaptersRequest, actor d.ContentActor) (*d.PaginatedReaderChaptersResponse, error) {
+	volumeUUID, err := uuid.Parse(volumeID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid volume ID format: %w", err)
+	}
+
+	novelID, err := s.repos.ChapterOrder.GetVolumeNovelID(ctx, volumeUUID)
+	if err != nil {
+		return nil, err
+	}
+	policy, err := s.repos.Release.GetPolicy(ctx, novelID)
+	if err != nil {
+		return nil, err
+	}
+	facts, err := s.repos.Release.GetReaderFacts(ctx, actor.UserID, novelID)
+	if err != nil {
+		return nil, err
+	}
+
+	earlyAccessHours := 0
+	if policy.EarlyAccessHours != nil {
+		earlyAccessHours = *policy.EarlyAccessHours
+	}
+	rows, pagination, err := s.repos.Release.ListReaderChapters(ctx, volumeUUID, actor.UserID, earlyAccessHours, req)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	status := releaseStatus(policy, facts, now)
+	chapters := make([]d.ReaderChapter, 0, len(rows))
+	for _, row := range rows {
+		chapters = append(chapters, evaluateChapterAccess(row, policy, facts.IsVIP, status.UnlockAvailable, now))
+	}
+
+	return &d.PaginatedReaderChaptersResponse{
+		Chapters:   chapters,
+		Release:    status,
+		Pagination: *pagination,
+	}, nil
+}
+
+// AuthorizeChapterRead lets readers in to free, owned or unlocked released chapters and VIPs in
+// to early access chapters; owners, collaborators and admins can read every chapter
+func (s *ReleaseService) AuthorizeChapterRead(ctx context.Context, chapterID string, actor d.ContentActor) error {
+	chapterUUID, err := uuid.Parse(chapterID)
+	if err != nil {
+		return fmt.Errorf("invalid chapter ID format: %w", err)
+	}
+
+	info, err := s.repos.Release.GetChapterAccess(ctx, chapterUUID, actor.UserID)
+	if err != nil {
+		return err
+	}
+
+	var access d.ReaderChapter
+	if info.Released {
+		policy, err := s.repos.Release.GetPolicy(ctx, info.NovelID)
+		if err != nil {
+			return err
+		}
+		facts, err := s.repos.Release.GetReaderFacts(ctx, actor.UserID, info.NovelID)
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		access = evaluateChapterAccess(info.Chapter, policy, facts.IsVIP, releaseStatus(policy, facts, now).UnlockAvailable, now)
+		if access.CanRead {
+			return nil
+		}
+	}
+
+	// Staff read drafts, scheduled and paid chapters without a purchase
+	if actor.IsAdmin {
+		return nil
+	}
+	manager, err := s.repos.Translation.CanManageNovel(ctx, info.NovelID, actor.UserID, actor.TenantID, m.PermissionRead)
+	if err != nil {
+		return err
+	}
+	if manager {
+		return nil
+	}
+
+	switch {
+	case !info.Released:
+		return fmt.Errorf("chapter not found")
+	case access.Access == m.ChapterAccessUpcoming:
+		return fmt.Errorf("chapter not released yet: available at %s", access.ReleaseAt.Format(time.RFC3339))
+	case access.CanUnlock:
+		return fmt.Errorf("purchase required: buy or unlock this chapter for free to read it")
+	default:
+		return fmt.Errorf("purchase required: buy this chapter, its volume or the series to read it")
+	}
+}
+
+// UnlockChapter unlocks a released paid chapter the reader cannot read yet, once per the
+// novel's unlock interval; the unlock is permanent
+func (s *ReleaseService) UnlockChapter(ctx context.Context, chapterID string, actor d.ContentActor) (*d.ChapterUnlockResponse, error) {
+	chapterUUID, err := uuid.Parse(chapterID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid chapter ID format: %w", err)
+	}
+
+	info, err := s.repos.Release.GetChapterAccess(ctx, chapterUUID, actor.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !info.Released {
+		return nil, fmt.Errorf("chapter not found")
+	}
+	policy, err := s.repos.Release.GetPolicy(ctx, info.NovelID)
+	if err != nil {
+		return nil, err
+	}
+	if policy.UnlockIntervalHours == nil {
+		return nil, fmt.Errorf("free unlocks are not offered for this novel")
+	}
+
+	now := time.Now()
+	access := evaluateChapterAccess(info.Chapter, policy, false, true, now)
+	switch access.Access {
+	case m.ChapterAccessUpcoming:
+		return nil, fmt.Errorf("chapter not released yet: available at %s", access.ReleaseAt.Format(time.RFC3339))
+	case m.ChapterAccessFree, m.ChapterAccessOwned:
+		return nil, fmt.Errorf("chapter already readable: no unlock needed")
+	}
+
+	interval := time.Duration(*policy.UnlockIntervalHours) * time.Hour
+	unlockedAt, err := s.repos.Release.UnlockChapter(ctx, actor.UserID, chapterUUID, info.NovelID, interval)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d.ChapterUnlockResponse{
+		ChapterID:    chapterUUID.String(),
+		NovelID:      info.NovelID.String(),
+		UnlockedAt:   unlockedAt,
+		NextUnlockAt: unlockedAt.Add(interval),
+	}, nil
+}
+
+// releaseStatus reports the policy and whether the reader's free unlock is ready
+func releaseStatus(policy *m.NovelReleasePolicy, facts *d.ReaderFacts, now time.Time) d.ReaderReleaseStatus {
+	status := d.ReaderReleaseStatus{
+		FreeAfterDays:       policy.FreeAfterDays,
+		UnlockIntervalHours: policy.UnlockIntervalHours,
+		EarlyAccessHours:    policy.EarlyAccessHours,
+		IsVIP:               facts.IsVIP,
+		ServerTime:          now,
+	}
+	if policy.UnlockIntervalHours == nil {
+		return status
+	}
+
+	status.UnlockAvailable = true
+	if facts.LastUnlockAt != nil {
+		next := facts.LastUnlockAt.Add(time.Duration(*policy.UnlockIntervalHours) * time.Hour)
+		if next.After(now) {
+			status.UnlockAvailable = false
+			status.NextUnlockAt = &next
+		}
+	}
+	return status
+}
+
+// evaluateChapterAccess applies the release rules to one chapter. A released chapter is FREE
+// when it has no price or its wait-until-free delay has passed, OWNED when bought, rented or
+// unlocked, and LOCKED otherwise. Before release, VIPs inside the early access window get
+// EARLY_ACCESS to chapters they could read once released; everyone else sees UPCOMING.
+func evaluateChapterAccess(row d.ReaderChapterRow, policy *m.NovelReleasePolicy, isVIP, unlockAvailable bool, now time.Time) d.ReaderChapter {
+	chapter := d.ReaderChapter{
+		ID:                 row.ID.String(),
+		VolumeID:           row.VolumeID.String(),
+		ChapterNumber:      row.ChapterNumber,
+		Title:              row.Title,
+		PriceCoins:         row.PriceCoins,
+		WordCount:          row.WordCount,
+		ReadingTimeMinutes: row.ReadingTimeMinutes,
+		HasMatureContent:   row.HasMatureContent,
+		ContentWarnings:    row.ContentWarnings,
+		ReleaseAt:          row.PublishedAt,
+	}
+
+	if policy.EarlyAccessHours != nil {
+		earlyAt := row.PublishedAt.Add(-time.Duration(*policy.EarlyAccessHours) * time.Hour)
+		chapter.EarlyAccessAt = &earlyAt
+	}
+	paid := row.PriceCoins != nil && *row.PriceCoins > 0
+	if paid && policy.FreeAfterDays != nil {
+		freeAt := row.PublishedAt.AddDate(0, 0, *policy.FreeAfterDays)
+		chapter.FreeAt = &freeAt
+	}
+	free := !paid || (chapter.FreeAt != nil && !now.Before(*chapter.FreeAt))
+	owned := row.Owned || row.Unlocked
+
+	switch {
+	case !now.Before(row.PublishedAt):
+		switch {
+		case free:
+			chapter.Access = m.ChapterAccessFree
+		case owned:
+			chapter.Access = m.ChapterAccessOwned
+		default:
+			chapter.Access = m.ChapterAccessLocked
+			chapter.CanUnlock = policy.UnlockIntervalHours != nil && unlockAvailable
+		}
+	case isVIP && chapter.EarlyAccessAt != nil && !now.Before(*chapter.EarlyAccessAt):
+		if free || owned {
+			chapter.Access = m.ChapterAccessEarlyAccess
+		} else {
+			// Early access does not waive the price; free unlocks wait for the release
+			chapter.Access = m.ChapterAccessLocked
+		}
+	default:
+		chapter.Access = m.ChapterAccessUpcoming
+	}
+	chapter.CanRead = chapter.Access != m.ChapterAccessLocked && chapter.Access != m.ChapterAccessUpcoming
+
+	return chapter
+}
+
+// validateReleaseSetting checks an optional policy setting is within 1..max
+func validateReleaseSetting(name string, value *int, max int) error {
+	if value != nil && (*value < 1 || *value > max) {
+		return fmt.Errorf("invalid %s: must be between 1 and %d", name, max)
+	}
+	return nil
+}
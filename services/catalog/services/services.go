@@ -2,6 +2,10 @@
 package services
 
 import (
+	"time"
+
+	"wibusystem/pkg/common/mt"
+	"wibusystem/pkg/common/storage"
 	"wibusystem/services/catalog/grpc"
 	"wibusystem/services/catalog/repositories"
 	"wibusystem/services/catalog/services/interfaces"
@@ -9,22 +13,73 @@ import (
 
 // Services aggregates service interfaces used by handlers.
 type Services struct {
-	Genre     interfaces.GenreServiceInterface
-	Character interfaces.CharacterServiceInterface
-	Creator   interfaces.CreatorServiceInterface
-	Novel     interfaces.NovelServiceInterface
-	Volume    interfaces.VolumeServiceInterface
-	Chapter   interfaces.ChapterServiceInterface
+	Genre                   interfaces.GenreServiceInterface
+	Character               interfaces.CharacterServiceInterface
+	Creator                 interfaces.CreatorServiceInterface
+	Novel                   interfaces.NovelServiceInterface
+	Volume                  interfaces.VolumeServiceInterface
+	Chapter                 interfaces.ChapterServiceInterface
+	Ranking                 interfaces.RankingServiceInterface
+	Bookmark                interfaces.BookmarkServiceInterface
+	Recommendation          interfaces.RecommendationServiceInterface
+	Analytics               interfaces.AnalyticsServiceInterface
+	Moderation              interfaces.ModerationServiceInterface
+	CharacterContribution   interfaces.CharacterContributionServiceInterface
+	Relation                interfaces.ContentRelationServiceInterface
+	Anime                   interfaces.AnimeServiceInterface
+	AnimeEpisode            interfaces.AnimeEpisodeServiceInterface
+	Manga                   interfaces.MangaServiceInterface
+	MangaChapter            interfaces.MangaChapterServiceInterface
+	SubtitleContribution    interfaces.SubtitleContributionServiceInterface
+	Media                   interfaces.MediaServiceInterface
+	Translation             interfaces.TranslationServiceInterface
+	TranslationContribution interfaces.TranslationContributionServiceInterface
+	Tag                     interfaces.TagServiceInterface
+	CreatorClaim            interfaces.CreatorClaimServiceInterface
+	Counter                 interfaces.CounterServiceInterface
+	Trash                   interfaces.TrashServiceInterface
+	ChapterOrder            interfaces.ChapterOrderServiceInterface
+	Pricing                 interfaces.PricingServiceInterface
+	Release                 interfaces.ReleaseServiceInterface
+	ContentFilter           interfaces.ContentFilterServiceInterface
+	ContentWarning          interfaces.ContentWarningServiceInterface
 }
 
-// NewServices instantiates concrete service implementations.
-func NewServices(repos *repositories.Repositories, grpcClients *grpc.ClientManager) *Services {
+// NewServices instantiates concrete service implementations; store backs media uploads,
+// translator, which may be nil, produces machine-translated chapter drafts and
+// trashRetention is how long deleted novels stay restorable.
+func NewServices(repos *repositories.Repositories, grpcClients *grpc.ClientManager, store storage.Storage, media MediaSettings, translator mt.Translator, trashRetention time.Duration) *Services {
+	images := newMediaResolver(repos, store, media)
 	return &Services{
-		Genre:     NewGenreService(repos),
-		Character: NewCharacterService(repos),
-		Creator:   NewCreatorService(repos),
-		Novel:     NewNovelService(repos, grpcClients),
-		Volume:    NewVolumeService(repos),
-		Chapter:   NewChapterService(repos),
+		Genre:                   NewGenreService(repos),
+		Character:               NewCharacterService(repos, images),
+		Creator:                 NewCreatorService(repos),
+		Novel:                   NewNovelService(repos, grpcClients, images),
+		Volume:                  NewVolumeService(repos, images),
+		Chapter:                 NewChapterService(repos),
+		Ranking:                 NewRankingService(repos),
+		Bookmark:                NewBookmarkService(repos),
+		Recommendation:          NewRecommendationService(repos),
+		Analytics:               NewAnalyticsService(repos),
+		Moderation:              NewModerationService(repos, grpcClients),
+		CharacterContribution:   NewCharacterContributionService(repos),
+		Relation:                NewContentRelationService(repos),
+		Anime:                   NewAnimeService(repos),
+		AnimeEpisode:            NewAnimeEpisodeService(repos),
+		Manga:                   NewMangaService(repos),
+		MangaChapter:            NewMangaChapterService(repos),
+		SubtitleContribution:    NewSubtitleContributionService(repos),
+		Media:                   NewMediaService(repos, store, media),
+		Translation:             NewTranslationService(repos, translator),
+		TranslationContribution: NewTranslationContributionService(repos),
+		Tag:                     NewTagService(repos),
+		CreatorClaim:            NewCreatorClaimService(repos),
+		Counter:                 NewCounterService(repos),
+		Trash:                   NewTrashService(repos, trashRetention),
+		ChapterOrder:            NewChapterOrderService(repos),
+		Pricing:                 NewPricingService(repos),
+		Release:                 NewReleaseService(repos),
+		ContentFilter:           NewContentFilterService(repos),
+		ContentWarning:          NewContentWarningService(repos),
 	}
 }
@@ -0,0 +1,238 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+	"wibusystem/services/catalog/repositories"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// SubtitleContributionService implements community subtitle submission and review
+type SubtitleContributionService struct {
+	repos *repositories.Repositories
+}
+
+// NewSubtitleContributionService creates a new subtitle contribution service
+func NewSubtitleContributionService(repos *repositories.Repositories) interfaces.SubtitleContributionServiceInterface {
+	return &SubtitleContributionService{
+		repos: repos,
+	}
+}
+
+// CreateContribution validates the subtitle and stores it as a pending contribution.
+// Only published episodes of publicly visible anime accept contributions.
+func (s *SubtitleContributionService) CreateContribution(ctx context.Context, episodeID string, req d.CreateSubtitleContributionRequest, actor d.ContentActor) (*m.SubtitleContribution, error) {
+	languageCode := strings.TrimSpace(req.LanguageCode)
+	if len(languageCode) < 2 || len(languageCode) > 10 {
+		return nil, fmt.Errorf("invalid language code: %s", req.LanguageCode)
+	}
+
+	episodeUUID, err := s.resolvePublishedEpisode(ctx, episodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	content, sourceFormat, cueCount, err := normalizeSubtitle(req.Content, req.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	contribution := &m.SubtitleContribution{
+		EpisodeID:    episodeUUID,
+		LanguageCode: languageCode,
+		SourceFormat: string(sourceFormat),
+		Content:      content,
+		CueCount:     cueCount,
+		Note:         req.Note,
+		UserID:       actor.UserID,
+		TenantID:     actor.TenantID,
+	}
+
+	if err := s.repos.SubtitleContribution.Create(ctx, contribution); err != nil {
+		return nil, err
+	}
+	return contribution, nil
+}
+
+// UpdateContribution lets a contributor replace the subtitle of their own pending contribution
+func (s *SubtitleContributionService) UpdateContribution(ctx context.Context, contributionID string, req d.UpdateSubtitleContributionRequest, actor d.ContentActor) (*m.SubtitleContribution, error) {
+	contribution, err := s.getContribution(ctx, contributionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if contribution.UserID != actor.UserID {
+		return nil, fmt.Errorf("permission denied: only the contributor can update this contribution")
+	}
+	if contribution.Status != m.SubtitleContributionPending {
+		return nil, fmt.Errorf("subtitle contribution already reviewed")
+	}
+
+	content, sourceFormat, cueCount, err := normalizeSubtitle(req.Content, req.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	contribution.Content = content
+	contribution.SourceFormat = string(sourceFormat)
+	contribution.CueCount = cueCount
+	if req.Note != nil {
+		contribution.Note = req.Note
+	}
+
+	if err := s.repos.SubtitleContribution.UpdatePending(ctx, contribution); err != nil {
+		return nil, err
+	}
+	return contribution, nil
+}
+
+// GetContribution returns a contribution to its contributor or to a manager of the anime
+func (s *SubtitleContributionService) GetContribution(ctx context.Context, contributionID string, actor d.ContentActor) (*m.SubtitleContribution, error) {
+	contribution, err := s.getContribution(ctx, contributionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if contribution.UserID != actor.UserID {
+		if err := s.authorizeReview(ctx, contribution, actor); err != nil {
+			return nil, err
+		}
+	}
+	return contribution, nil
+}
+
+// ListMyContributions returns the contributions of the calling user in every status
+func (s *SubtitleContributionService) ListMyContributions(ctx context.Context, req d.ListSubtitleContributionsRequest, actor d.ContentActor) (*d.PaginatedSubtitleContributionsResponse, error) {
+	return s.list(ctx, req, nil, &actor.UserID)
+}
+
+// ListContributions returns the review queue of one anime; pending contributions by default
+func (s *SubtitleContributionService) ListContributions(ctx context.Context, animeID string, req d.ListSubtitleContributionsRequest, actor d.ContentActor) (*d.PaginatedSubtitleContributionsResponse, error) {
+	animeUUID, err := uuid.Parse(animeID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid anime ID format: %w", err)
+	}
+
+	if err := authorizeAnime(ctx, s.repos, animeUUID, actor, m.PermissionManageChapters); err != nil {
+		return nil, err
+	}
+
+	if req.Status == "" {
+		req.Status = m.SubtitleContributionPending
+	}
+	return s.list(ctx, req, &animeUUID, nil)
+}
+
+// ApproveContribution replaces the episode subtitle of the contribution's language with the proposal
+func (s *SubtitleContributionService) ApproveContribution(ctx context.Context, contributionID string, actor d.ContentActor) (*m.SubtitleContribution, error) {
+	contribution, err := s.getContribution(ctx, contributionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authorizeReview(ctx, contribution, actor); err != nil {
+		return nil, err
+	}
+
+	if err := s.repos.SubtitleContribution.Approve(ctx, contribution.ID, actor.UserID); err != nil {
+		return nil, err
+	}
+	return s.repos.SubtitleContribution.GetByID(ctx, contribution.ID)
+}
+
+// RejectContribution closes a pending contribution with a reason for the contributor
+func (s *SubtitleContributionService) RejectContribution(ctx context.Context, contributionID string, req d.RejectSubtitleContributionRequest, actor d.ContentActor) error {
+	contribution, err := s.getContribution(ctx, contributionID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.authorizeReview(ctx, contribution, actor); err != nil {
+		return err
+	}
+
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		return fmt.Errorf("rejection reason is required")
+	}
+	if len(reason) > 1000 {
+		return fmt.Errorf("invalid rejection reason: must not exceed 1000 characters")
+	}
+
+	return s.repos.SubtitleContribution.Reject(ctx, contribution.ID, actor.UserID, reason)
+}
+
+// list validates the status filter and reads a page of contributions
+func (s *SubtitleContributionService) list(ctx context.Context, req d.ListSubtitleContributionsRequest, animeID, userID *uuid.UUID) (*d.PaginatedSubtitleContributionsResponse, error) {
+	if req.Status != "" && req.Status != m.SubtitleContributionPending &&
+		req.Status != m.SubtitleContributionApproved && req.Status != m.SubtitleContributionRejected {
+		return nil, fmt.Errorf("invalid contribution status: %s", req.Status)
+	}
+
+	contributions, pagination, err := s.repos.SubtitleContribution.List(ctx, req, animeID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d.PaginatedSubtitleContributionsResponse{
+		Contributions: contributions,
+		Pagination:    *pagination,
+	}, nil
+}
+
+// getContribution parses the ID and loads the contribution
+func (s *SubtitleContributionService) getContribution(ctx context.Context, contributionID string) (*m.SubtitleContribution, error) {
+	contributionUUID, err := uuid.Parse(contributionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid contribution ID format: %w", err)
+	}
+
+	return s.repos.SubtitleContribution.GetByID(ctx, contributionUUID)
+}
+
+// authorizeReview checks that the actor manages the episodes of the contribution's anime
+func (s *SubtitleContributionService) authorizeReview(ctx context.Context, contribution *m.SubtitleContribution, actor d.ContentActor) error {
+	animeUUID, err := s.repos.AnimeEpisode.GetEpisodeAnimeID(ctx, contribution.EpisodeID)
+	if err != nil {
+		return err
+	}
+	return authorizeAnime(ctx, s.repos, animeUUID, actor, m.PermissionManageChapters)
+}
+
+// resolvePublishedEpisode parses an episode ID and checks that readers can see the episode
+func (s *SubtitleContributionService) resolvePublishedEpisode(ctx context.Context, episodeID string) (uuid.UUID, error) {
+	episodeUUID, err := uuid.Parse(episodeID)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid episode ID format: %w", err)
+	}
+
+	animeUUID, err := s.repos.AnimeEpisode.GetEpisodeAnimeID(ctx, episodeUUID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	visible, err := s.repos.AnimeEpisode.IsAnimePubliclyVisible(ctx, animeUUID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if !visible {
+		return uuid.Nil, fmt.Errorf("episode not found")
+	}
+
+	episode, err := s.repos.AnimeEpisode.GetEpisodeByID(ctx, episodeUUID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if episode.IsDraft || (episode.PublishedAt != nil && episode.PublishedAt.After(time.Now())) {
+		return uuid.Nil, fmt.Errorf("episode not found")
+	}
+
+	return episodeUUID, nil
+}
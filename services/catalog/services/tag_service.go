@@ -0,0 +1,415 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/google/uuid"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+	"wibusystem/services/catalog/repositories"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// maxNovelTags caps the number of tags a novel may carry
+const maxNovelTags = 30
+
+// maxTagNameLength matches the length accepted by the tags filter of ListNovels
+const maxTagNameLength = 50
+
+// TagService implements the tag registry: canonical tags, aliases and synonyms
+type TagService struct {
+	repos *repositories.Repositories
+}
+
+// NewTagService creates a new tag service
+func NewTagService(repos *repositories.Repositories) interfaces.TagServiceInterface {
+	return &TagService{
+		repos: repos,
+	}
+}
+
+// CreateTag registers a canonical tag with its aliases
+func (s *TagService) CreateTag(ctx context.Context, req d.CreateTagRequest) (*m.Tag, error) {
+	name, err := validateTagName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	languageCode, err := optionalLanguageCode(req.LanguageCode)
+	if err != nil {
+		return nil, err
+	}
+
+	tag := &m.Tag{
+		Name:           name,
+		NormalizedName: normalizeTagKey(name),
+		LanguageCode:   languageCode,
+		Description:    trimmedOrNil(req.Description),
+	}
+
+	aliases, err := validateTagAliases(req.Aliases, tag.NormalizedName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repos.Tag.Create(ctx, tag, aliases); err != nil {
+		return nil, err
+	}
+
+	// Novels may already carry the name, typed before the tag was registered
+	if err := s.repos.Tag.RefreshUsageCounts(ctx, []string{tag.Name}); err != nil {
+		return nil, err
+	}
+
+	return s.repos.Tag.GetByID(ctx, tag.ID)
+}
+
+// GetTagByID retrieves a tag with its aliases
+func (s *TagService) GetTagByID(ctx context.Context, tagID uuid.UUID) (*m.Tag, error) {
+	if tagID == uuid.Nil {
+		return nil, fmt.Errorf("tag ID cannot be nil")
+	}
+
+	return s.repos.Tag.GetByID(ctx, tagID)
+}
+
+// ListTags retrieves tags, most used first
+func (s *TagService) ListTags(ctx context.Context, req d.ListTagsRequest) ([]*m.Tag, int64, error) {
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 || req.PageSize > 100 {
+		req.PageSize = 20
+	}
+
+	offset := (req.Page - 1) * req.PageSize
+	return s.repos.Tag.List(ctx, req.PageSize, offset, normalizeTagKey(req.Search))
+}
+
+// AutocompleteTags returns the tags starting with the typed prefix, most used first; each
+// suggestion is displayed with its synonym in the first of the reader's languages that has one
+func (s *TagService) AutocompleteTags(ctx context.Context, req d.AutocompleteTagsRequest, languages []string) ([]d.TagSuggestion, error) {
+	prefix := normalizeTagKey(req.Query)
+	if prefix == "" {
+		return nil, fmt.Errorf("query is required")
+	}
+	if req.Limit <= 0 || req.Limit > 50 {
+		req.Limit = 10
+	}
+
+	suggestions, err := s.repos.Tag.Autocomplete(ctx, prefix, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	if len(suggestions) == 0 || len(languages) == 0 {
+		return suggestions, nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(suggestions))
+	for _, suggestion := range suggestions {
+		if id, err := uuid.Parse(suggestion.ID); err == nil {
+			ids = append(ids, id)
+		}
+	}
+
+	aliases, err := s.repos.Tag.GetAliases(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range suggestions {
+		id, err := uuid.Parse(suggestions[i].ID)
+		if err != nil {
+			continue
+		}
+		localizeTagSuggestion(&suggestions[i], aliases[id], languages)
+	}
+
+	return suggestions, nil
+}
+
+// UpdateTag updates a tag; a rename is applied to every novel and the old name kept as an alias
+func (s *TagService) UpdateTag(ctx context.Context, tagID uuid.UUID, req d.UpdateTagRequest) (*m.Tag, error) {
+	if tagID == uuid.Nil {
+		return nil, fmt.Errorf("tag ID cannot be nil")
+	}
+
+	previous, err := s.repos.Tag.GetByID(ctx, tagID)
+	if err != nil {
+		return nil, err
+	}
+
+	tag := *previous
+	if req.Name != nil {
+		name, err := validateTagName(*req.Name)
+		if err != nil {
+			return nil, err
+		}
+		tag.Name = name
+		tag.NormalizedName = normalizeTagKey(name)
+	}
+
+	if req.LanguageCode != nil {
+		languageCode, err := optionalLanguageCode(req.LanguageCode)
+		if err != nil {
+			return nil, err
+		}
+		tag.LanguageCode = languageCode
+	}
+
+	if req.Description != nil {
+		tag.Description = trimmedOrNil(req.Description)
+	}
+
+	var aliases []m.TagAlias
+	if req.Aliases != nil {
+		aliases, err = validateTagAliases(req.Aliases, tag.NormalizedName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.repos.Tag.Update(ctx, &tag, previous, aliases); err != nil {
+		return nil, err
+	}
+
+	if tag.Name != previous.Name {
+		if err := s.repos.Tag.RefreshUsageCounts(ctx, []string{tag.Name}); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.repos.Tag.GetByID(ctx, tagID)
+}
+
+// MergeTags folds a duplicate tag into another: novels switch to the target tag and the
+// duplicate's name and aliases keep resolving to it
+func (s *TagService) MergeTags(ctx context.Context, tagID uuid.UUID, req d.MergeTagRequest) (*d.TagMergeResponse, error) {
+	if tagID == uuid.Nil {
+		return nil, fmt.Errorf("tag ID cannot be nil")
+	}
+
+	targetID, err := uuid.Parse(req.TargetTagID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid target tag ID format: %w", err)
+	}
+	if targetID == tagID {
+		return nil, fmt.Errorf("invalid merge: a tag cannot be merged into itself")
+	}
+
+	return s.repos.Tag.Merge(ctx, tagID, targetID)
+}
+
+// localizeTagSuggestion displays a suggestion with its synonym in the reader's language; the
+// canonical name stays when it is already in that language or no synonym matches
+func localizeTagSuggestion(suggestion *d.TagSuggestion, aliases []m.TagAlias, languages []string) {
+	original := ""
+	if suggestion.LanguageCode != nil {
+		original = *suggestion.LanguageCode
+	}
+
+	codes := make([]string, 0, len(aliases))
+	for _, alias := range aliases {
+		if alias.LanguageCode != nil {
+			codes = append(codes, *alias.LanguageCode)
+		}
+	}
+
+	served := pickContentLanguage(languages, original, codes)
+	if served == "" || strings.EqualFold(served, original) {
+		return
+	}
+	for _, alias := range aliases {
+		if alias.LanguageCode != nil && strings.EqualFold(*alias.LanguageCode, served) {
+			suggestion.DisplayName = alias.Alias
+			suggestion.LanguageCode = alias.LanguageCode
+			return
+		}
+	}
+}
+
+// normalizeNovelTags validates the tags of a novel and replaces every tag by its canonical
+// name, registering the unknown ones; it returns the canonical names in their original order
+func normalizeNovelTags(ctx context.Context, repos *repositories.Repositories, raw *json.RawMessage) (*json.RawMessage, []string, error) {
+	if raw == nil {
+		return nil, nil, nil
+	}
+
+	var input []string
+	if err := json.Unmarshal(*raw, &input); err != nil {
+		return nil, nil, fmt.Errorf("invalid tags: must be an array of strings")
+	}
+	if len(input) > maxNovelTags {
+		return nil, nil, fmt.Errorf("invalid tags: a novel can have at most %d tags", maxNovelTags)
+	}
+
+	candidates := make([]m.Tag, 0, len(input))
+	keys := make([]string, 0, len(input))
+	seen := make(map[string]bool, len(input))
+	for _, value := range input {
+		name, err := validateTagName(value)
+		if err != nil {
+			return nil, nil, err
+		}
+		key := normalizeTagKey(name)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		keys = append(keys, key)
+		candidates = append(candidates, m.Tag{Name: name, NormalizedName: key})
+	}
+
+	resolved, err := repos.Tag.Register(ctx, candidates)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	names := make([]string, 0, len(keys))
+	added := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		name, ok := resolved[key]
+		if !ok || added[name] {
+			continue
+		}
+		added[name] = true
+		names = append(names, name)
+	}
+
+	encoded, err := json.Marshal(names)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encode tags: %w", err)
+	}
+	normalized := json.RawMessage(encoded)
+	return &normalized, names, nil
+}
+
+// resolveTagFilter maps the tags of a listing filter to canonical names, so that any alias,
+// synonym or spelling variant finds the novels; unknown tags are kept as typed
+func resolveTagFilter(ctx context.Context, repos *repositories.Repositories, tags []string) ([]string, error) {
+	if len(tags) == 0 {
+		return tags, nil
+	}
+
+	keys := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		keys = append(keys, normalizeTagKey(tag))
+	}
+
+	resolved, err := repos.Tag.Resolve(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(tags))
+	for i, tag := range tags {
+		if name, ok := resolved[keys[i]]; ok {
+			names = append(names, name)
+			continue
+		}
+		if cleaned := cleanTagName(tag); cleaned != "" {
+			names = append(names, cleaned)
+		}
+	}
+	return names, nil
+}
+
+// refreshTagUsage recounts the novels of the given tags; counts only rank autocomplete
+// suggestions, so a failure is logged rather than failing the novel write
+func refreshTagUsage(ctx context.Context, repos *repositories.Repositories, tagSets ...[]string) {
+	var names []string
+	seen := make(map[string]bool)
+	for _, tags := range tagSets {
+		for _, name := range tags {
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+
+	if err := repos.Tag.RefreshUsageCounts(ctx, names); err != nil {
+		log.Printf("Warning: failed to refresh tag usage counts: %v", err)
+	}
+}
+
+// novelTagNames decodes the canonical tag names stored on a novel
+func novelTagNames(raw *json.RawMessage) []string {
+	if raw == nil {
+		return nil
+	}
+	var names []string
+	if err := json.Unmarshal(*raw, &names); err != nil {
+		return nil
+	}
+	return names
+}
+
+// validateTagName cleans a tag name and checks its length
+func validateTagName(name string) (string, error) {
+	cleaned := cleanTagName(name)
+	if cleaned == "" {
+		return "", fmt.Errorf("tag name is required")
+	}
+	if len([]rune(cleaned)) > maxTagNameLength {
+		return "", fmt.Errorf("invalid tag %q: must not exceed %d characters", cleaned, maxTagNameLength)
+	}
+	return cleaned, nil
+}
+
+// validateTagAliases cleans aliases and their languages and rejects duplicates, including
+// an alias equal to the canonical name
+func validateTagAliases(inputs []d.TagAliasInput, canonicalKey string) ([]m.TagAlias, error) {
+	aliases := make([]m.TagAlias, 0, len(inputs))
+	seen := map[string]bool{canonicalKey: true}
+	for _, input := range inputs {
+		alias, err := validateTagName(input.Alias)
+		if err != nil {
+			return nil, fmt.Errorf("invalid aliases: %w", err)
+		}
+		key := normalizeTagKey(alias)
+		if seen[key] {
+			return nil, fmt.Errorf("invalid aliases: duplicate alias %q", alias)
+		}
+		seen[key] = true
+
+		languageCode, err := optionalLanguageCode(input.LanguageCode)
+		if err != nil {
+			return nil, err
+		}
+
+		aliases = append(aliases, m.TagAlias{
+			Alias:           alias,
+			NormalizedAlias: key,
+			LanguageCode:    languageCode,
+		})
+	}
+	return aliases, nil
+}
+
+// optionalLanguageCode normalizes an optional language code; a blank one means none
+func optionalLanguageCode(code *string) (*string, error) {
+	if code == nil || strings.TrimSpace(*code) == "" {
+		return nil, nil
+	}
+	normalized, err := normalizeLanguageCode(*code)
+	if err != nil {
+		return nil, err
+	}
+	return &normalized, nil
+}
+
+// cleanTagName trims a tag and collapses inner whitespace
+func cleanTagName(name string) string {
+	return strings.Join(strings.Fields(name), " ")
+}
+
+// normalizeTagKey is the case-insensitive lookup key shared by canonical names and aliases
+func normalizeTagKey(name string) string {
+	return strings.ToLower(cleanTagName(name))
+}
@@ -0,0 +1,367 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+	"wibusystem/pkg/common/mt"
+	"wibusystem/services/catalog/repositories"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// TranslationContributionService implements community translation submission and moderator review
+type TranslationContributionService struct {
+	repos *repositories.Repositories
+}
+
+// NewTranslationContributionService creates a new translation contribution service
+func NewTranslationContributionService(repos *repositories.Repositories) interfaces.TranslationContributionServiceInterface {
+	return &TranslationContributionService{
+		repos: repos,
+	}
+}
+
+// translationSource is the original-language text a contribution translates
+type translationSource struct {
+	novelID  uuid.UUID
+	language string
+	text     mt.Text
+	// permission is required to translate content readers cannot see yet; empty when public
+	permission string
+}
+
+// CreateContribution stores a translation of a novel (title and summary) or a chapter as a
+// pending contribution and checks it against the novel's glossary of the target language.
+// Violations do not block the submission; they are counted for the review queue.
+func (s *TranslationContributionService) CreateContribution(ctx context.Context, req d.CreateTranslationContributionRequest, actor d.ContentActor) (*d.TranslationContributionResponse, error) {
+	code, err := normalizeLanguageCode(req.TargetLanguage)
+	if err != nil {
+		return nil, err
+	}
+
+	referenceUUID, err := uuid.Parse(req.ReferenceID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid reference ID format: %w", err)
+	}
+
+	title, err := validateTranslationText(req.Title, req.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := s.loadSource(ctx, req.ReferenceType, referenceUUID)
+	if err != nil {
+		return nil, err
+	}
+	if source.permission != "" {
+		if err := authorizeNovel(ctx, s.repos, source.novelID, actor, source.permission); err != nil {
+			return nil, err
+		}
+	}
+	if strings.EqualFold(code, source.language) {
+		return nil, fmt.Errorf("invalid language code: %s is the novel's original language", code)
+	}
+
+	contribution := &m.TranslationContribution{
+		ReferenceType:        req.ReferenceType,
+		ReferenceID:          referenceUUID,
+		Title:                title,
+		Content:              req.Content,
+		SourceLanguage:       strings.ToLower(source.language),
+		TargetLanguage:       code,
+		IsMachineTranslation: req.IsMachineTranslation,
+		UserID:               actor.UserID,
+		TenantID:             actor.TenantID,
+	}
+
+	violations, err := checkTranslationGlossary(ctx, s.repos, source.novelID, code, source.text, mt.Text{Title: title, Content: req.Content})
+	if err != nil {
+		return nil, err
+	}
+	contribution.GlossaryViolationCount = len(violations)
+
+	if err := s.repos.TranslationContribution.Create(ctx, contribution); err != nil {
+		return nil, err
+	}
+
+	return &d.TranslationContributionResponse{
+		TranslationContribution: *contribution,
+		GlossaryViolations:      violations,
+	}, nil
+}
+
+// UpdateContribution lets a contributor replace the translation of their own pending contribution
+func (s *TranslationContributionService) UpdateContribution(ctx context.Context, contributionID string, req d.UpdateTranslationContributionRequest, actor d.ContentActor) (*d.TranslationContributionResponse, error) {
+	contribution, err := s.getContribution(ctx, contributionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if contribution.UserID != actor.UserID {
+		return nil, fmt.Errorf("permission denied: only the contributor can update this contribution")
+	}
+	if contribution.Status != m.TranslationContributionPending {
+		return nil, fmt.Errorf("translation contribution already reviewed")
+	}
+
+	title, err := validateTranslationText(req.Title, req.Content)
+	if err != nil {
+		return nil, err
+	}
+	contribution.Title = title
+	contribution.Content = req.Content
+
+	violations, err := s.checkContribution(ctx, contribution)
+	if err != nil {
+		return nil, err
+	}
+	contribution.GlossaryViolationCount = len(violations)
+
+	if err := s.repos.TranslationContribution.UpdatePending(ctx, contribution); err != nil {
+		return nil, err
+	}
+
+	return &d.TranslationContributionResponse{
+		TranslationContribution: *contribution,
+		GlossaryViolations:      violations,
+	}, nil
+}
+
+// GetContribution returns a contribution with the glossary check of its translation against the
+// current glossary; canReview grants access to other users' contributions
+func (s *TranslationContributionService) GetContribution(ctx context.Context, contributionID string, actor d.ContentActor, canReview bool) (*d.TranslationContributionResponse, error) {
+	contribution, err := s.getContribution(ctx, contributionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if contribution.UserID != actor.UserID && !canReview {
+		return nil, fmt.Errorf("permission denied: only the contributor or a moderator can view this contribution")
+	}
+
+	violations, err := s.checkContribution(ctx, contribution)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d.TranslationContributionResponse{
+		TranslationContribution: *contribution,
+		GlossaryViolations:      violations,
+	}, nil
+}
+
+// ListMyContributions returns the contributions of the calling user in every status
+func (s *TranslationContributionService) ListMyContributions(ctx context.Context, req d.ListTranslationContributionsRequest, actor d.ContentActor) (*d.PaginatedTranslationContributionsResponse, error) {
+	return s.list(ctx, req, &actor.UserID)
+}
+
+// ListContributions returns the moderator review queue; pending contributions by default
+func (s *TranslationContributionService) ListContributions(ctx context.Context, req d.ListTranslationContributionsRequest) (*d.PaginatedTranslationContributionsResponse, error) {
+	if req.Status == "" {
+		req.Status = m.TranslationContributionPending
+	}
+	return s.list(ctx, req, nil)
+}
+
+// ReviewContribution approves a pending contribution, publishing it as the translation of its
+// novel or chapter, or rejects it with a reason for the contributor
+func (s *TranslationContributionService) ReviewContribution(ctx context.Context, contributionID string, req d.ReviewTranslationContributionRequest, actor d.ContentActor) (*m.TranslationContribution, error) {
+	contribution, err := s.getContribution(ctx, contributionID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch req.Action {
+	case "approve":
+		if err := s.repos.TranslationContribution.Approve(ctx, contribution.ID, actor.UserID); err != nil {
+			return nil, err
+		}
+	case "reject":
+		reason := ""
+		if req.RejectionReason != nil {
+			reason = strings.TrimSpace(*req.RejectionReason)
+		}
+		if reason == "" {
+			return nil, fmt.Errorf("rejection reason is required")
+		}
+		if len(reason) > 1000 {
+			return nil, fmt.Errorf("invalid rejection reason: must not exceed 1000 characters")
+		}
+		if err := s.repos.TranslationContribution.Reject(ctx, contribution.ID, actor.UserID, reason); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("invalid review action: %s", req.Action)
+	}
+
+	return s.repos.TranslationContribution.GetByID(ctx, contribution.ID)
+}
+
+// list validates the filters and reads a page of contributions
+func (s *TranslationContributionService) list(ctx context.Context, req d.ListTranslationContributionsRequest, userID *uuid.UUID) (*d.PaginatedTranslationContributionsResponse, error) {
+	if req.Status != "" && req.Status != m.TranslationContributionPending &&
+		req.Status != m.TranslationContributionApproved && req.Status != m.TranslationContributionRejected {
+		return nil, fmt.Errorf("invalid contribution status: %s", req.Status)
+	}
+	if req.ReferenceType != "" && req.ReferenceType != m.TranslationReferenceNovel &&
+		req.ReferenceType != m.TranslationReferenceNovelChapter {
+		return nil, fmt.Errorf("invalid reference type: %s", req.ReferenceType)
+	}
+	if req.Language != "" {
+		code, err := normalizeLanguageCode(req.Language)
+		if err != nil {
+			return nil, err
+		}
+		req.Language = code
+	}
+
+	contributions, pagination, err := s.repos.TranslationContribution.List(ctx, req, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d.PaginatedTranslationContributionsResponse{
+		Contributions: contributions,
+		Pagination:    *pagination,
+	}, nil
+}
+
+// getContribution parses the ID and loads the contribution
+func (s *TranslationContributionService) getContribution(ctx context.Context, contributionID string) (*m.TranslationContribution, error) {
+	contributionUUID, err := uuid.Parse(contributionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid contribution ID format: %w", err)
+	}
+
+	return s.repos.TranslationContribution.GetByID(ctx, contributionUUID)
+}
+
+// checkContribution checks a contribution against the current source text and glossary
+func (s *TranslationContributionService) checkContribution(ctx context.Context, contribution *m.TranslationContribution) ([]d.GlossaryViolation, error) {
+	source, err := s.loadSource(ctx, contribution.ReferenceType, contribution.ReferenceID)
+	if err != nil {
+		return nil, err
+	}
+
+	translation := mt.Text{Title: contribution.Title, Content: contribution.Content}
+	return checkTranslationGlossary(ctx, s.repos, source.novelID, contribution.TargetLanguage, source.text, translation)
+}
+
+// loadSource reads the original title and content of the translated novel or chapter.
+// A novel contributes its name and summary; draft, unpublished or private content
+// requires a permission on the novel to be translated.
+func (s *TranslationContributionService) loadSource(ctx context.Context, referenceType string, referenceID uuid.UUID) (*translationSource, error) {
+	switch referenceType {
+	case m.TranslationReferenceNovelChapter:
+		novelID, original, err := s.repos.Translation.GetChapterLanguage(ctx, referenceID)
+		if err != nil {
+			return nil, err
+		}
+		chapter, err := s.repos.Chapter.GetChapterByID(ctx, referenceID, true)
+		if err != nil {
+			return nil, err
+		}
+
+		source := &translationSource{novelID: novelID, language: original}
+		if chapter.Title != nil {
+			source.text.Title = *chapter.Title
+		}
+		if chapter.Content != nil {
+			source.text.Content = *chapter.Content
+		}
+		if chapter.IsDraft || !chapter.IsPublic {
+			source.permission = m.PermissionManageChapters
+		}
+		return source, nil
+
+	case m.TranslationReferenceNovel:
+		novel, err := s.repos.Novel.GetNovelByID(ctx, referenceID)
+		if err != nil {
+			return nil, err
+		}
+		if novel.IsDeleted {
+			return nil, fmt.Errorf("novel not found")
+		}
+
+		source := &translationSource{novelID: novel.ID, language: novel.OriginalLanguage}
+		if novel.Name != nil {
+			source.text.Title = *novel.Name
+		}
+		if novel.Summary != nil {
+			source.text.Content = *novel.Summary
+		}
+		if !novel.IsPublic {
+			source.permission = m.PermissionEdit
+		}
+		return source, nil
+
+	default:
+		return nil, fmt.Errorf("invalid reference type: %s", referenceType)
+	}
+}
+
+// validateTranslationText trims the title and checks the content is a JSON document
+func validateTranslationText(title string, content json.RawMessage) (string, error) {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return "", fmt.Errorf("invalid translation contribution: title is required")
+	}
+	if len(content) == 0 || string(content) == "null" || !json.Valid(content) {
+		return "", fmt.Errorf("invalid translation contribution: content must be a JSON document")
+	}
+	return title, nil
+}
+
+// checkTranslationGlossary reports where a translation departs from the novel's glossary of its language
+func checkTranslationGlossary(ctx context.Context, repos *repositories.Repositories, novelID uuid.UUID, languageCode string, source, translation mt.Text) ([]d.GlossaryViolation, error) {
+	terms, err := repos.Translation.ListGlossary(ctx, novelID, languageCode)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := []d.GlossaryViolation{}
+	if len(terms) == 0 {
+		return violations, nil
+	}
+
+	glossary := make([]mt.GlossaryTerm, 0, len(terms))
+	for _, term := range terms {
+		glossary = append(glossary, mt.GlossaryTerm{Source: term.SourceTerm, Translation: term.Translation})
+	}
+
+	found, err := mt.CheckGlossary(source, translation, glossary)
+	if err != nil {
+		return nil, fmt.Errorf("invalid translation contribution: %w", err)
+	}
+
+	for _, violation := range found {
+		violations = append(violations, d.GlossaryViolation{
+			Kind:           violation.Kind,
+			TermID:         terms[violation.Term].ID.String(),
+			SourceTerm:     violation.Source,
+			Expected:       violation.Expected,
+			SourcePosition: toTextPosition(violation.SourcePosition),
+			Position:       toTextPosition(violation.Position),
+		})
+	}
+	return violations, nil
+}
+
+// toTextPosition converts a checker position to its DTO
+func toTextPosition(position *mt.Position) *d.TextPosition {
+	if position == nil {
+		return nil
+	}
+	return &d.TextPosition{
+		Field:  position.Field,
+		Block:  position.Block,
+		Offset: position.Offset,
+		Length: position.Length,
+	}
+}
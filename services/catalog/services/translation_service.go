@@ -0,0 +1,582 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+
+	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
+	"wibusystem/pkg/common/mt"
+	"wibusystem/services/catalog/repositories"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// languageCodePattern accepts ISO 639-1/639-2 codes with an optional region, within VARCHAR(5)
+var languageCodePattern = regexp.MustCompile(`^[a-z]{2,3}(-[a-z0-9]{2,3})?$`)
+
+// TranslationService implements localized novel metadata and translated chapter management
+type TranslationService struct {
+	repos      *repositories.Repositories
+	translator mt.Translator // nil when machine translation is disabled
+}
+
+// NewTranslationService creates a new translation service; translator may be nil
+func NewTranslationService(repos *repositories.Repositories, translator mt.Translator) interfaces.TranslationServiceInterface {
+	return &TranslationService{
+		repos:      repos,
+		translator: translator,
+	}
+}
+
+// ListNovelTranslations returns the localized titles and summaries of a novel
+func (s *TranslationService) ListNovelTranslations(ctx context.Context, novelID string) (*d.NovelTranslationsResponse, error) {
+	novelUUID, err := uuid.Parse(novelID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid novel ID format: %w", err)
+	}
+
+	original, err := s.repos.Translation.GetNovelLanguage(ctx, novelUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	translations, err := s.repos.Translation.ListNovelTranslations(ctx, novelUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &d.NovelTranslationsResponse{
+		NovelID:          novelUUID.String(),
+		OriginalLanguage: original,
+		Translations:     make([]d.NovelTranslationResponse, 0, len(translations)),
+	}
+	for _, translation := range translations {
+		response.Translations = append(response.Translations, toNovelTranslationResponse(translation))
+	}
+
+	return response, nil
+}
+
+// SetNovelTranslations replaces the localized titles and summaries of a novel
+func (s *TranslationService) SetNovelTranslations(ctx context.Context, novelID string, req d.SetNovelTranslationsRequest, actor d.ContentActor) (*d.NovelTranslationsResponse, error) {
+	novelUUID, err := uuid.Parse(novelID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid novel ID format: %w", err)
+	}
+
+	// The partial unique index only allows one primary title per language
+	primaries := make(map[string]bool)
+	for i, translation := range req.Translations {
+		code, err := normalizeLanguageCode(translation.LanguageCode)
+		if err != nil {
+			return nil, err
+		}
+		req.Translations[i].LanguageCode = code
+
+		req.Translations[i].Title = strings.TrimSpace(translation.Title)
+		if req.Translations[i].Title == "" {
+			return nil, fmt.Errorf("invalid translations: title is required for language %s", code)
+		}
+		if translation.Summary != nil && !json.Valid(*translation.Summary) {
+			return nil, fmt.Errorf("invalid translations: summary for language %s is not valid JSON", code)
+		}
+
+		if !translation.IsPrimary {
+			continue
+		}
+		if primaries[code] {
+			return nil, fmt.Errorf("invalid translations: more than one primary title for language %s", code)
+		}
+		primaries[code] = true
+	}
+
+	if err := authorizeNovel(ctx, s.repos, novelUUID, actor, m.PermissionEdit); err != nil {
+		return nil, err
+	}
+
+	if err := s.repos.Translation.SetNovelTranslations(ctx, novelUUID, req.Translations); err != nil {
+		return nil, err
+	}
+
+	return s.ListNovelTranslations(ctx, novelID)
+}
+
+// ListChapterTranslations returns the translations of a chapter without their content
+func (s *TranslationService) ListChapterTranslations(ctx context.Context, chapterID string, actor d.ContentActor) (*d.ChapterTranslationsResponse, error) {
+	chapterUUID, original, err := s.authorizeChapter(ctx, chapterID, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	translations, err := s.repos.Translation.ListChapterTranslations(ctx, chapterUUID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &d.ChapterTranslationsResponse{
+		ChapterID:        chapterUUID.String(),
+		OriginalLanguage: original,
+		Translations:     make([]d.ChapterTranslationResponse, 0, len(translations)),
+	}
+	for _, translation := range translations {
+		response.Translations = append(response.Translations, toChapterTranslationResponse(translation))
+	}
+
+	return response, nil
+}
+
+// GetChapterTranslation returns the translation of a chapter in one language with its content
+func (s *TranslationService) GetChapterTranslation(ctx context.Context, chapterID, languageCode string, actor d.ContentActor) (*d.ChapterTranslationResponse, error) {
+	code, err := normalizeLanguageCode(languageCode)
+	if err != nil {
+		return nil, err
+	}
+
+	chapterUUID, _, err := s.authorizeChapter(ctx, chapterID, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	translation, err := s.repos.Translation.GetChapterTranslation(ctx, chapterUUID, code)
+	if err != nil {
+		return nil, err
+	}
+
+	response := toChapterTranslationResponse(*translation)
+	return &response, nil
+}
+
+// UpsertChapterTranslation creates or replaces the translation of a chapter in one language
+func (s *TranslationService) UpsertChapterTranslation(ctx context.Context, chapterID, languageCode string, req d.UpsertChapterTranslationRequest, actor d.ContentActor) (*d.ChapterTranslationResponse, error) {
+	code, err := normalizeLanguageCode(languageCode)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Content) == 0 || string(req.Content) == "null" || !json.Valid(req.Content) {
+		return nil, fmt.Errorf("invalid chapter translation: content must be a JSON document")
+	}
+	if req.Title != nil {
+		title := strings.TrimSpace(*req.Title)
+		req.Title = &title
+		if title == "" {
+			req.Title = nil
+		}
+	}
+
+	chapterUUID, original, err := s.authorizeChapter(ctx, chapterID, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	// The original language lives in novel_chapter itself
+	if strings.EqualFold(code, original) {
+		return nil, fmt.Errorf("invalid language code: %s is the novel's original language, update the chapter instead", code)
+	}
+
+	translation, err := s.repos.Translation.UpsertChapterTranslation(ctx, chapterUUID, code, req, actor.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := toChapterTranslationResponse(*translation)
+
+	// Glossary violations are reported to the editor without blocking the update
+	violations, err := s.checkChapterTranslation(ctx, chapterUUID, code, *translation)
+	if err != nil {
+		return nil, err
+	}
+	response.GlossaryViolations = violations
+
+	return &response, nil
+}
+
+// checkChapterTranslation checks a chapter translation against the chapter and the novel's glossary
+func (s *TranslationService) checkChapterTranslation(ctx context.Context, chapterID uuid.UUID, languageCode string, translation m.NovelChapterTranslation) ([]d.GlossaryViolation, error) {
+	novelID, _, err := s.repos.Translation.GetChapterLanguage(ctx, chapterID)
+	if err != nil {
+		return nil, err
+	}
+	chapter, err := s.repos.Chapter.GetChapterByID(ctx, chapterID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var source, target mt.Text
+	if chapter.Title != nil {
+		source.Title = *chapter.Title
+	}
+	if chapter.Content != nil {
+		source.Content = *chapter.Content
+	}
+	if translation.Title != nil {
+		target.Title = *translation.Title
+	}
+	if translation.Content != nil {
+		target.Content = *translation.Content
+	}
+
+	return checkTranslationGlossary(ctx, s.repos, novelID, languageCode, source, target)
+}
+
+// DeleteChapterTranslation removes the translation of a chapter in one language
+func (s *TranslationService) DeleteChapterTranslation(ctx context.Context, chapterID, languageCode string, actor d.ContentActor) error {
+	code, err := normalizeLanguageCode(languageCode)
+	if err != nil {
+		return err
+	}
+
+	chapterUUID, _, err := s.authorizeChapter(ctx, chapterID, actor)
+	if err != nil {
+		return err
+	}
+
+	return s.repos.Translation.DeleteChapterTranslation(ctx, chapterUUID, code)
+}
+
+// GetGlossary returns the fixed term translations of a novel in one target language
+func (s *TranslationService) GetGlossary(ctx context.Context, novelID, languageCode string) (*d.GlossaryResponse, error) {
+	novelUUID, err := uuid.Parse(novelID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid novel ID format: %w", err)
+	}
+
+	code, err := normalizeLanguageCode(languageCode)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.repos.Translation.GetNovelLanguage(ctx, novelUUID); err != nil {
+		return nil, err
+	}
+
+	terms, err := s.repos.Translation.ListGlossary(ctx, novelUUID, code)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &d.GlossaryResponse{
+		NovelID:      novelUUID.String(),
+		LanguageCode: code,
+		Terms:        make([]d.GlossaryTermResponse, 0, len(terms)),
+	}
+	for _, term := range terms {
+		item := d.GlossaryTermResponse{
+			ID:          term.ID.String(),
+			SourceTerm:  term.SourceTerm,
+			Translation: term.Translation,
+			Notes:       term.Notes,
+			UpdatedAt:   term.UpdatedAt,
+		}
+		if term.CharacterID != nil {
+			characterID := term.CharacterID.String()
+			item.CharacterID = &characterID
+		}
+		response.Terms = append(response.Terms, item)
+	}
+
+	return response, nil
+}
+
+// SetGlossary replaces the fixed term translations of a novel in one target language
+func (s *TranslationService) SetGlossary(ctx context.Context, novelID, languageCode string, req d.SetGlossaryRequest, actor d.ContentActor) (*d.GlossaryResponse, error) {
+	novelUUID, err := uuid.Parse(novelID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid novel ID format: %w", err)
+	}
+
+	code, err := normalizeLanguageCode(languageCode)
+	if err != nil {
+		return nil, err
+	}
+
+	// Terms are matched case-insensitively, so "Lin Feng" and "lin feng" are the same term
+	seen := make(map[string]bool, len(req.Terms))
+	for i, term := range req.Terms {
+		req.Terms[i].SourceTerm = strings.TrimSpace(term.SourceTerm)
+		req.Terms[i].Translation = strings.TrimSpace(term.Translation)
+		if term.Notes != nil {
+			notes := strings.TrimSpace(*term.Notes)
+			req.Terms[i].Notes = &notes
+			if notes == "" {
+				req.Terms[i].Notes = nil
+			}
+		}
+		if req.Terms[i].SourceTerm == "" || req.Terms[i].Translation == "" {
+			return nil, fmt.Errorf("invalid glossary: source term and translation are required")
+		}
+		key := strings.ToLower(req.Terms[i].SourceTerm)
+		if seen[key] {
+			return nil, fmt.Errorf("invalid glossary: duplicate term %q", req.Terms[i].SourceTerm)
+		}
+		seen[key] = true
+	}
+
+	original, err := s.repos.Translation.GetNovelLanguage(ctx, novelUUID)
+	if err != nil {
+		return nil, err
+	}
+	if strings.EqualFold(code, original) {
+		return nil, fmt.Errorf("invalid language code: %s is the novel's original language", code)
+	}
+
+	if err := authorizeNovel(ctx, s.repos, novelUUID, actor, m.PermissionEdit); err != nil {
+		return nil, err
+	}
+
+	if err := s.repos.Translation.SetGlossary(ctx, novelUUID, code, req.Terms, actor.UserID); err != nil {
+		return nil, err
+	}
+
+	return s.GetGlossary(ctx, novelID, code)
+}
+
+// GenerateMachineDraft machine-translates a chapter from the novel's original language, honouring
+// the novel's glossary, and stores the result as a pending machine-made contribution for review.
+// Draft and unpublished chapters can only be translated by the novel's managers.
+func (s *TranslationService) GenerateMachineDraft(ctx context.Context, chapterID, languageCode string, actor d.ContentActor) (*d.MachineDraftResponse, error) {
+	if s.translator == nil {
+		return nil, mt.ErrNotConfigured
+	}
+
+	code, err := normalizeLanguageCode(languageCode)
+	if err != nil {
+		return nil, err
+	}
+
+	chapterUUID, err := uuid.Parse(chapterID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid chapter ID format: %w", err)
+	}
+
+	novelID, original, err := s.repos.Translation.GetChapterLanguage(ctx, chapterUUID)
+	if err != nil {
+		return nil, err
+	}
+	if strings.EqualFold(code, original) {
+		return nil, fmt.Errorf("invalid language code: %s is the novel's original language", code)
+	}
+
+	chapter, err := s.repos.Chapter.GetChapterByID(ctx, chapterUUID, true)
+	if err != nil {
+		return nil, err
+	}
+	if chapter.IsDraft || !chapter.IsPublic {
+		if err := authorizeNovel(ctx, s.repos, novelID, actor, m.PermissionManageChapters); err != nil {
+			return nil, err
+		}
+	}
+	if chapter.Content == nil {
+		return nil, fmt.Errorf("invalid chapter: the chapter has no content to translate")
+	}
+
+	pending, err := s.repos.TranslationContribution.HasPendingMachineDraft(ctx, m.TranslationReferenceNovelChapter, chapterUUID, actor.UserID, code)
+	if err != nil {
+		return nil, err
+	}
+	if pending {
+		return nil, fmt.Errorf("machine draft already exists: review or reject the pending draft in %s first", code)
+	}
+
+	terms, err := s.repos.Translation.ListGlossary(ctx, novelID, code)
+	if err != nil {
+		return nil, err
+	}
+	glossary := make([]mt.GlossaryTerm, 0, len(terms))
+	for _, term := range terms {
+		glossary = append(glossary, mt.GlossaryTerm{Source: term.SourceTerm, Translation: term.Translation})
+	}
+
+	request := mt.Request{
+		Content:  *chapter.Content,
+		Source:   strings.ToLower(original),
+		Target:   code,
+		Glossary: glossary,
+	}
+	if chapter.Title != nil {
+		request.Title = *chapter.Title
+	}
+
+	result, err := mt.TranslateChapter(ctx, s.translator, request)
+	if err != nil {
+		return nil, fmt.Errorf("machine translation failed: %w", err)
+	}
+
+	provider := s.translator.Name()
+	contribution := &m.TranslationContribution{
+		ReferenceType:        m.TranslationReferenceNovelChapter,
+		ReferenceID:          chapterUUID,
+		Title:                result.Title,
+		Content:              result.Content,
+		SourceLanguage:       request.Source,
+		TargetLanguage:       code,
+		IsMachineTranslation: true,
+		MachineProvider:      &provider,
+		UserID:               actor.UserID,
+		TenantID:             actor.TenantID,
+	}
+	if err := s.repos.TranslationContribution.Create(ctx, contribution); err != nil {
+		return nil, err
+	}
+
+	return &d.MachineDraftResponse{
+		Contribution: *contribution,
+		Provider:     provider,
+		Blocks:       result.Blocks,
+		GlossaryHits: result.GlossaryHits,
+	}, nil
+}
+
+// authorizeChapter resolves the chapter's novel and checks the MANAGE_CHAPTERS permission on it
+func (s *TranslationService) authorizeChapter(ctx context.Context, chapterID string, actor d.ContentActor) (uuid.UUID, string, error) {
+	chapterUUID, err := uuid.Parse(chapterID)
+	if err != nil {
+		return uuid.Nil, "", fmt.Errorf("invalid chapter ID format: %w", err)
+	}
+
+	novelID, original, err := s.repos.Translation.GetChapterLanguage(ctx, chapterUUID)
+	if err != nil {
+		return uuid.Nil, "", err
+	}
+
+	if err := authorizeNovel(ctx, s.repos, novelID, actor, m.PermissionManageChapters); err != nil {
+		return uuid.Nil, "", err
+	}
+
+	return chapterUUID, original, nil
+}
+
+// authorizeNovel lets admins, owners and collaborators holding the permission act on a novel
+func authorizeNovel(ctx context.Context, repos *repositories.Repositories, novelID uuid.UUID, actor d.ContentActor, permission string) error {
+	allowed, err := repos.Translation.CanManageNovel(ctx, novelID, actor.UserID, actor.TenantID, permission)
+	if err != nil {
+		return err
+	}
+	if !allowed && !actor.IsAdmin {
+		return fmt.Errorf("permission denied: %s on this novel is restricted to owners and collaborators", permission)
+	}
+	return nil
+}
+
+// normalizeLanguageCode lower-cases a language code and checks it fits the language_code columns
+func normalizeLanguageCode(code string) (string, error) {
+	normalized := strings.ToLower(strings.TrimSpace(code))
+	if len(normalized) > 5 || !languageCodePattern.MatchString(normalized) {
+		return "", fmt.Errorf("invalid language code: %q", code)
+	}
+	return normalized, nil
+}
+
+// pickContentLanguage returns the first preferred language that can be served: the original
+// language or one with a translation. A regional preference (en-us) also matches its base
+// language (en). Without any match the original language is served.
+func pickContentLanguage(preferences []string, original string, available []string) string {
+	original = strings.ToLower(original)
+	translated := make(map[string]bool, len(available))
+	for _, code := range available {
+		translated[strings.ToLower(code)] = true
+	}
+
+	for _, preference := range preferences {
+		preference = strings.ToLower(strings.TrimSpace(preference))
+		if preference == "" {
+			continue
+		}
+		candidates := []string{preference}
+		if base, _, found := strings.Cut(preference, "-"); found {
+			candidates = append(candidates, base)
+		}
+		for _, candidate := range candidates {
+			if candidate == original {
+				return original
+			}
+			if translated[candidate] {
+				return candidate
+			}
+		}
+	}
+
+	return original
+}
+
+// availableContentLanguages lists the original language first, then the translated languages
+func availableContentLanguages(original string, translated []string) []string {
+	languages := make([]string, 0, len(translated)+1)
+	seen := make(map[string]bool, len(translated)+1)
+	for _, code := range append([]string{original}, translated...) {
+		code = strings.ToLower(code)
+		if code == "" || seen[code] {
+			continue
+		}
+		seen[code] = true
+		languages = append(languages, code)
+	}
+	return languages
+}
+
+// localizeNovelDetail replaces the name and summary of a novel with the translation in the best
+// accepted language and reports the served and available languages. Within a language the
+// primary title wins; translations are expected in ListNovelTranslations order.
+func localizeNovelDetail(response *d.NovelDetailResponse, translations []m.NovelTranslation, preferences []string) {
+	codes := make([]string, 0, len(translations))
+	for _, translation := range translations {
+		codes = append(codes, translation.LanguageCode)
+	}
+
+	served := pickContentLanguage(preferences, response.OriginalLanguage, codes)
+	response.ServedLanguage = served
+	response.AvailableLanguages = availableContentLanguages(response.OriginalLanguage, codes)
+
+	if served == strings.ToLower(response.OriginalLanguage) {
+		return
+	}
+	for _, translation := range translations {
+		if strings.ToLower(translation.LanguageCode) != served {
+			continue
+		}
+		response.Name = translation.Title
+		if translation.Summary != nil {
+			var summary map[string]interface{}
+			if err := json.Unmarshal(*translation.Summary, &summary); err == nil {
+				response.Summary = summary
+			}
+		}
+		return
+	}
+}
+
+// toNovelTranslationResponse maps a novel translation to its response DTO
+func toNovelTranslationResponse(translation m.NovelTranslation) d.NovelTranslationResponse {
+	return d.NovelTranslationResponse{
+		LanguageCode: translation.LanguageCode,
+		Title:        translation.Title,
+		Summary:      translation.Summary,
+		IsPrimary:    translation.IsPrimary,
+		UpdatedAt:    translation.UpdatedAt,
+	}
+}
+
+// toChapterTranslationResponse maps a chapter translation to its response DTO
+func toChapterTranslationResponse(translation m.NovelChapterTranslation) d.ChapterTranslationResponse {
+	response := d.ChapterTranslationResponse{
+		ChapterID:          translation.ChapterID.String(),
+		LanguageCode:       translation.LanguageCode,
+		Title:              translation.Title,
+		Content:            translation.Content,
+		WordCount:          translation.WordCount,
+		CharacterCount:     translation.CharacterCount,
+		ReadingTimeMinutes: translation.ReadingTimeMinutes,
+		CreatedAt:          translation.CreatedAt,
+		UpdatedAt:          translation.UpdatedAt,
+	}
+	if translation.TranslatedByUserID != nil {
+		userID := translation.TranslatedByUserID.String()
+		response.TranslatedByUserID = &userID
+	}
+	return response
+}
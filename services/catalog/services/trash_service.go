@@ -0,0 +1,197 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	d "wibusystem/pkg/common/dto"
+	"wibusystem/services/catalog/repositories"
+	"wibusystem/services/catalog/services/interfaces"
+)
+
+// defaultTrashRetention applies when no positive retention is configured
+const defaultTrashRetention = 30 * 24 * time.Hour
+
+// trashPurgeBatch is how many expired items one purge pass loads at a time
+const trashPurgeBatch = 100
+
+// TrashService implements restore and retention of soft-deleted novels, volumes and chapters
+type TrashService struct {
+	repos     *repositories.Repositories
+	retention time.Duration
+}
+
+// NewTrashService creates a new trash service; deleted content stays restorable for retention
+func NewTrashService(repos *repositories.Repositories, retention time.Duration) interfaces.TrashServiceInterface {
+	if retention <= 0 {
+		retention = defaultTrashRetention
+	}
+	return &TrashService{
+		repos:     repos,
+		retention: retention,
+	}
+}
+
+// ListTrash lists the actor's trash with the time each item will be purged
+func (s *TrashService) ListTrash(ctx context.Context, req d.ListTrashRequest, actor d.ContentActor) (*d.PaginatedTrashResponse, error) {
+	switch req.Type {
+	case "", d.TrashItemNovel, d.TrashItemVolume, d.TrashItemChapter:
+	default:
+		return nil, fmt.Errorf("invalid trash item type: %s", req.Type)
+	}
+
+	var userID *uuid.UUID
+	if !actor.IsAdmin {
+		userID = &actor.UserID
+	}
+
+	items, pagination, err := s.repos.Trash.List(ctx, req, userID, actor.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range items {
+		items[i].PurgeAt = items[i].DeletedAt.Add(s.retention)
+	}
+
+	return &d.PaginatedTrashResponse{
+		Items:      items,
+		Pagination: *pagination,
+	}, nil
+}
+
+// RestoreNovel restores a novel together with the volumes and chapters trashed with it
+func (s *TrashService) RestoreNovel(ctx context.Context, novelID string, actor d.ContentActor) (*d.TrashRestoreResponse, error) {
+	return s.restore(ctx, d.TrashItemNovel, novelID, actor, s.repos.Trash.RestoreNovel)
+}
+
+// RestoreVolume restores a volume into its live novel
+func (s *TrashService) RestoreVolume(ctx context.Context, volumeID string, actor d.ContentActor) (*d.TrashRestoreResponse, error) {
+	return s.restore(ctx, d.TrashItemVolume, volumeID, actor, s.repos.Trash.RestoreVolume)
+}
+
+// RestoreChapter restores a chapter into its live volume
+func (s *TrashService) RestoreChapter(ctx context.Context, chapterID string, actor d.ContentActor) (*d.TrashRestoreResponse, error) {
+	return s.restore(ctx, d.TrashItemChapter, chapterID, actor, s.repos.Trash.RestoreChapter)
+}
+
+// restore checks that the actor may manage the novel the item belongs to, restores it and
+// recomputes the novel's counters now that volumes and chapters are back. A restored novel's
+// tags count again, so their usage is refreshed the same way DeleteNovel does.
+func (s *TrashService) restore(ctx context.Context, itemType, id string, actor d.ContentActor,
+	restoreFn func(context.Context, uuid.UUID) (*d.TrashRestoreResponse, error)) (*d.TrashRestoreResponse, error) {
+	itemUUID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s ID format: %w", itemType, err)
+	}
+
+	novelID, err := s.repos.Trash.ResolveNovelID(ctx, itemType, itemUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !actor.IsAdmin {
+		allowed, err := s.repos.Trash.CanManageDeletedNovel(ctx, novelID, actor.UserID, actor.TenantID)
+		if err != nil {
+			return nil, err
+		}
+		if !allowed {
+			return nil, fmt.Errorf("permission denied: restoring this %s is restricted to owners and collaborators", itemType)
+		}
+	}
+
+	result, err := restoreFn(ctx, itemUUID)
+	if err != nil {
+		return nil, err
+	}
+	reconcileNovelCounters(ctx, s.repos, novelID)
+
+	if itemType == d.TrashItemNovel {
+		novel, err := s.repos.Novel.GetNovelByID(ctx, novelID)
+		if err != nil {
+			log.Printf("Warning: failed to load restored novel %s for tag usage: %v", novelID, err)
+		} else {
+			refreshTagUsage(ctx, s.repos, novelTagNames(novel.Tags))
+		}
+	}
+
+	return result, nil
+}
+
+// PurgeExpired works through the expired items oldest first. Each item commits on its own;
+// a failing item is logged and left for the next run, and the pass stops once a whole batch
+// fails so it cannot spin on the same rows.
+func (s *TrashService) PurgeExpired(ctx context.Context) (*d.TrashPurgeReport, error) {
+	report := &d.TrashPurgeReport{}
+	cutoff := time.Now().Add(-s.retention)
+
+	for {
+		items, err := s.repos.Trash.ListExpired(ctx, cutoff, trashPurgeBatch)
+		if err != nil {
+			return report, err
+		}
+
+		progressed := false
+		for _, item := range items {
+			archived, err := s.repos.Trash.PurgeItem(ctx, item)
+			if err != nil {
+				// Already removed together with an expired parent earlier in this pass
+				if strings.Contains(err.Error(), "not found in trash") {
+					progressed = true
+					continue
+				}
+				log.Printf("Warning: failed to purge %s %s: %v", item.ItemType, item.ID, err)
+				report.Failed++
+				continue
+			}
+
+			progressed = true
+			if archived {
+				report.Archived++
+			} else {
+				report.Purged++
+			}
+		}
+
+		if len(items) < trashPurgeBatch || !progressed {
+			break
+		}
+	}
+
+	return report, nil
+}
+
+// ListArchived lists the user's archived chapters
+func (s *TrashService) ListArchived(ctx context.Context, userID uuid.UUID, req d.ListArchivedRequest) (*d.PaginatedArchivedResponse, error) {
+	chapters, pagination, err := s.repos.Trash.ListArchived(ctx, userID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d.PaginatedArchivedResponse{
+		Chapters:   chapters,
+		Pagination: *pagination,
+	}, nil
+}
+
+// ReadArchivedChapter returns an archived chapter only to users who still own it
+func (s *TrashService) ReadArchivedChapter(ctx context.Context, userID uuid.UUID, chapterID string) (*d.ArchivedChapterContent, error) {
+	chapterUUID, err := uuid.Parse(chapterID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid chapter ID format: %w", err)
+	}
+
+	chapter, entitled, err := s.repos.Trash.GetArchivedChapter(ctx, chapterUUID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !entitled {
+		return nil, fmt.Errorf("permission denied: archived chapters are only available to their buyers")
+	}
+
+	return chapter, nil
+}
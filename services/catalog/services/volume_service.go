@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 
 	d "wibusystem/pkg/common/dto"
+	m "wibusystem/pkg/common/model"
 	"wibusystem/services/catalog/repositories"
 	"wibusystem/services/catalog/services/interfaces"
 )
@@ -15,14 +16,17 @@ import (
 // This service handles all business operations for novel volumes,
 // coordinating between HTTP handlers and repository layer.
 type VolumeService struct {
-	repos *repositories.Repositories
+	repos  *repositories.Repositories
+	images *mediaResolver
 }
 
 // NewVolumeService creates a new volume service instance
-// Takes repositories as dependency for data access operations
-func NewVolumeService(repos *repositories.Repositories) interfaces.VolumeServiceInterface {
+// Takes repositories as dependency for data access operations and the
+// media resolver for uploaded cover images
+func NewVolumeService(repos *repositories.Repositories, images *mediaResolver) interfaces.VolumeServiceInterface {
 	return &VolumeService{
-		repos: repos,
+		repos:  repos,
+		images: images,
 	}
 }
 
@@ -35,12 +39,22 @@ func (s *VolumeService) CreateVolume(ctx context.Context, novelID string, req d.
 		return nil, fmt.Errorf("invalid novel ID format: %w", err)
 	}
 
+	// Validate the uploaded cover before writing anything
+	cover, err := s.images.Prepare(ctx, m.MediaAttachmentVolumeCover, req.CoverMediaID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create volume through repository
 	volume, err := s.repos.Volume.CreateVolume(ctx, novelUUID, req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create volume: %w", err)
 	}
 
+	if err := s.images.Apply(ctx, volume.ID, cover); err != nil {
+		return nil, err
+	}
+
 	// Map to response DTO
 	response := &d.CreateVolumeResponse{
 		ID:           volume.ID.String(),
@@ -85,6 +99,12 @@ func (s *VolumeService) GetVolumeByID(ctx context.Context, id string) (*d.Volume
 		Chapters:     []interface{}{}, // Empty array, will be populated when chapter service is ready
 	}
 
+	// Attach uploaded cover with renditions
+	response.CoverMedia, err = s.images.ResolveOne(ctx, m.MediaAttachmentVolumeCover, volume.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load volume cover: %w", err)
+	}
+
 	return response, nil
 }
 
@@ -103,6 +123,23 @@ func (s *VolumeService) ListVolumesByNovelID(ctx context.Context, novelID string
 		return nil, fmt.Errorf("failed to list volumes: %w", err)
 	}
 
+	// Attach uploaded covers with one batched lookup
+	ids := make([]uuid.UUID, 0)
+	for _, volume := range response.Volumes {
+		if id, err := uuid.Parse(volume.ID); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	covers, err := s.images.Resolve(ctx, m.MediaAttachmentVolumeCover, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load volume covers: %w", err)
+	}
+	for i := range response.Volumes {
+		if id, err := uuid.Parse(response.Volumes[i].ID); err == nil {
+			response.Volumes[i].CoverMedia = covers[id]
+		}
+	}
+
 	return response, nil
 }
 
@@ -115,12 +152,22 @@ func (s *VolumeService) UpdateVolume(ctx context.Context, id string, req d.Updat
 		return nil, fmt.Errorf("invalid volume ID format: %w", err)
 	}
 
+	// Validate the uploaded cover before writing anything
+	cover, err := s.images.Prepare(ctx, m.MediaAttachmentVolumeCover, req.CoverMediaID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Update volume through repository
 	volume, err := s.repos.Volume.UpdateVolume(ctx, volumeUUID, req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update volume: %w", err)
 	}
 
+	if err := s.images.Apply(ctx, volume.ID, cover); err != nil {
+		return nil, err
+	}
+
 	// Map to response DTO
 	response := &d.UpdateVolumeResponse{
 		ID:        volume.ID.String(),
@@ -141,10 +188,13 @@ func (s *VolumeService) DeleteVolume(ctx context.Context, id string) error {
 	}
 
 	// Delete volume through repository (includes purchase checks)
-	err = s.repos.Volume.DeleteVolume(ctx, volumeUUID)
+	novelID, err := s.repos.Volume.DeleteVolume(ctx, volumeUUID)
 	if err != nil {
 		return fmt.Errorf("failed to delete volume: %w", err)
 	}
 
+	// The volume's chapters went with it, so the novel totals change in bulk
+	reconcileNovelCounters(ctx, s.repos, novelID)
+
 	return nil
 }
\ No newline at end of file
@@ -3,23 +3,34 @@ package grpc
 
 import (
 	"context"
+	"log"
+	"strconv"
+	"sync"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/ory/fosite"
 	"github.com/ory/fosite/handler/openid"
 
+	m "wibusystem/pkg/common/model"
 	"wibusystem/pkg/common/oauth"
 	"wibusystem/services/identify/oauth2"
+	"wibusystem/services/identify/services/interfaces"
 )
 
 // FositeTokenValidator implements TokenValidator interface using fosite OAuth2Provider
 type FositeTokenValidator struct {
-	provider *oauth2.Provider
+	provider    *oauth2.Provider
+	userService interfaces.UserServiceInterface
+	birthdates  *birthdateCache
 }
 
 // NewFositeTokenValidator creates a new fosite-based token validator
-func NewFositeTokenValidator(provider *oauth2.Provider) *FositeTokenValidator {
+func NewFositeTokenValidator(provider *oauth2.Provider, userService interfaces.UserServiceInterface) *FositeTokenValidator {
 	return &FositeTokenValidator{
-		provider: provider,
+		provider:    provider,
+		userService: userService,
+		birthdates:  newBirthdateCache(),
 	}
 }
 
@@ -89,6 +100,10 @@ func (v *FositeTokenValidator) ValidateToken(ctx context.Context, req *oauth.Val
 			}
 		}
 
+		// Age is read from the profile rather than the session so a birthdate declared after login
+		// applies to existing tokens; lookups are cached so validation rarely reaches the database
+		v.addAgeClaim(ctx, result.UserInfo)
+
 		// Set issued at and expires at times
 		if session.Claims != nil {
 			if !session.Claims.IssuedAt.IsZero() {
@@ -126,6 +141,98 @@ func (v *FositeTokenValidator) ValidateToken(ctx context.Context, req *oauth.Val
 	return result, nil
 }
 
+// addAgeClaim adds the user's age to the extra claims when the user declared a birthdate
+func (v *FositeTokenValidator) addAgeClaim(ctx context.Context, info *oauth.UserInfo) {
+	if v.userService == nil {
+		return
+	}
+	userID, err := uuid.Parse(info.Subject)
+	if err != nil {
+		return
+	}
+
+	now := time.Now()
+	birthdate, found := v.birthdates.get(userID, now)
+	if !found {
+		user, err := v.userService.GetUserByID(ctx, userID)
+		if err != nil {
+			log.Printf("grpc.validate_token: failed to load user %s for age claim: %v", userID, err)
+			return
+		}
+		birthdate = user.Birthdate
+		v.birthdates.set(userID, birthdate, now)
+	}
+	if birthdate == nil {
+		return
+	}
+	age, _ := (&m.User{Birthdate: birthdate}).AgeOn(now)
+
+	if info.Extra == nil {
+		info.Extra = map[string]string{}
+	}
+	info.Extra[oauth.ClaimAge] = strconv.Itoa(age)
+}
+
+// Birthdates can be set once and never change, so declared ones are kept for a day; users without
+// one are rechecked every few minutes so a newly declared birthdate applies to existing tokens
+const (
+	birthdateDeclaredTTL   = 24 * time.Hour
+	birthdateUndeclaredTTL = 5 * time.Minute
+	birthdateCacheMaxSize  = 100000
+)
+
+// birthdateCache caches user birthdates for the age claim
+type birthdateCache struct {
+	entries map[uuid.UUID]birthdateEntry
+	mutex   sync.RWMutex
+}
+
+// birthdateEntry is a cached birthdate; birthdate is nil when the user has not declared one
+type birthdateEntry struct {
+	birthdate *time.Time
+	expiresAt time.Time
+}
+
+// newBirthdateCache creates an empty birthdate cache
+func newBirthdateCache() *birthdateCache {
+	return &birthdateCache{entries: make(map[uuid.UUID]birthdateEntry)}
+}
+
+// get returns the cached birthdate; found is false when the user is not cached or the entry expired
+func (c *birthdateCache) get(userID uuid.UUID, now time.Time) (birthdate *time.Time, found bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	entry, ok := c.entries[userID]
+	if !ok || now.After(entry.expiresAt) {
+		return nil, false
+	}
+	return entry.birthdate, true
+}
+
+// set caches a birthdate, dropping expired entries once the cache is full
+func (c *birthdateCache) set(userID uuid.UUID, birthdate *time.Time, now time.Time) {
+	ttl := birthdateUndeclaredTTL
+	if birthdate != nil {
+		ttl = birthdateDeclaredTTL
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if len(c.entries) >= birthdateCacheMaxSize {
+		for id, entry := range c.entries {
+			if now.After(entry.expiresAt) {
+				delete(c.entries, id)
+			}
+		}
+		if len(c.entries) >= birthdateCacheMaxSize {
+			c.entries = make(map[uuid.UUID]birthdateEntry)
+		}
+	}
+	c.entries[userID] = birthdateEntry{birthdate: birthdate, expiresAt: now.Add(ttl)}
+}
+
 // argumentsToStringSlice converts fosite.Arguments to []string
 // argumentsToStringSlice converts fosite.Arguments to []string
 func argumentsToStringSlice(args fosite.Arguments) []string {
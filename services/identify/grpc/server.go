@@ -14,7 +14,7 @@ import (
 // SetupGRPCServer creates and configures a gRPC server with token validation, user, and tenant services
 func SetupGRPCServer(provider *oauth2.Provider, userService interfaces.UserServiceInterface, tenantService interfaces.TenantServiceInterface, cfg *config.ServerConfig) (*grpcserver.Server, error) {
 	// Create fosite token validator
-	validator := NewFositeTokenValidator(provider)
+	validator := NewFositeTokenValidator(provider, userService)
 
 	// Create gRPC server
 	server, err := grpcserver.NewServer(cfg, validator)
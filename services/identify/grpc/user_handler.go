@@ -3,11 +3,13 @@ package grpc
 import (
 	"context"
 	"fmt"
+	"log"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	d "wibusystem/pkg/common/dto"
 	pb "wibusystem/pkg/grpc/userservice"
 	"wibusystem/services/identify/services/interfaces"
 )
@@ -128,6 +130,42 @@ func (h *UserServiceHandler) GetUsers(ctx context.Context, req *pb.GetUsersReque
 	}, nil
 }
 
+// SuspendUser implements the SuspendUser RPC method
+func (h *UserServiceHandler) SuspendUser(ctx context.Context, req *pb.SuspendUserRequest) (*pb.SuspendUserResponse, error) {
+	// Validate request
+	if req.UserId == "" {
+		return &pb.SuspendUserResponse{
+			Error: "user_id is required",
+		}, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.SuspendUserResponse{
+			Error: "invalid user_id format",
+		}, status.Error(codes.InvalidArgument, "invalid user_id format")
+	}
+
+	blocked := true
+	if err := h.userService.UpdateUserStatus(ctx, userID, d.UpdateUserStatusRequest{IsBlocked: &blocked}); err != nil {
+		if isNotFoundError(err) {
+			return &pb.SuspendUserResponse{
+				Error: "user not found",
+			}, status.Error(codes.NotFound, "user not found")
+		}
+
+		return &pb.SuspendUserResponse{
+			Error: "internal server error",
+		}, status.Error(codes.Internal, "failed to suspend user")
+	}
+
+	log.Printf("User %s suspended by %s: %s", req.UserId, req.ModeratorId, req.Reason)
+
+	return &pb.SuspendUserResponse{
+		Success: true,
+	}, nil
+}
+
 // isNotFoundError checks if the error indicates a user was not found
 // This is a simple string-based check - in a real implementation,
 // you might want to use custom error types
@@ -25,6 +25,10 @@ func mapServiceError(c *gin.Context, err error, context string) (status int, cod
 		return http.StatusUnauthorized, "invalid_credentials",
 			i18n.Localize(c, "identify.errors.invalid_credentials.message", "Invalid credentials"),
 			i18n.Localize(c, "identify.errors.invalid_credentials.description", "Invalid email or password")
+	case contains(errStr, "account is suspended"):
+		return http.StatusForbidden, "account_suspended",
+			i18n.Localize(c, "identify.errors.account_suspended.message", "Account suspended"),
+			i18n.Localize(c, "identify.errors.account_suspended.description", "Your account has been suspended")
 	case contains(errStr, "not found"):
 		return http.StatusNotFound, "not_found",
 			i18n.Localize(c, "identify.errors.not_found.message", "Resource not found"),
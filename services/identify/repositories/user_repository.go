@@ -22,6 +22,7 @@ type UserRepository interface {
 	Delete(ctx context.Context, id uuid.UUID) error
 	List(ctx context.Context, limit, offset int) ([]*m.User, int64, error)
 	UpdateLastLogin(ctx context.Context, id uuid.UUID) error
+	UpdateBlocked(ctx context.Context, id uuid.UUID, blocked bool) error
 }
 
 type userRepository struct {
@@ -64,7 +65,7 @@ func (r *userRepository) Create(ctx context.Context, user *m.User) error {
 // GetByID returns a user by ID or an error if not found.
 func (r *userRepository) GetByID(ctx context.Context, id uuid.UUID) (*m.User, error) {
 	query := `
-		SELECT id, email, username, display_name, avatar_url, cover_image_url, bio, is_blocked, created_at, updated_at, last_login_at
+		SELECT id, email, username, display_name, avatar_url, cover_image_url, bio, is_blocked, created_at, updated_at, last_login_at, birthdate
 		FROM users
 		WHERE id = $1
 	`
@@ -85,6 +86,7 @@ func (r *userRepository) GetByID(ctx context.Context, id uuid.UUID) (*m.User, er
 		&user.CreatedAt,
 		&user.UpdatedAt,
 		&lastLoginAt,
+		&user.Birthdate,
 	)
 
 	if err != nil {
@@ -108,7 +110,7 @@ func (r *userRepository) GetByID(ctx context.Context, id uuid.UUID) (*m.User, er
 // GetByEmail returns a user by email.
 func (r *userRepository) GetByEmail(ctx context.Context, email string) (*m.User, error) {
 	query := `
-		SELECT id, email, username, display_name, avatar_url, cover_image_url, bio, is_blocked, created_at, updated_at, last_login_at
+		SELECT id, email, username, display_name, avatar_url, cover_image_url, bio, is_blocked, created_at, updated_at, last_login_at, birthdate
 		FROM users
 		WHERE email = $1
 	`
@@ -129,6 +131,7 @@ func (r *userRepository) GetByEmail(ctx context.Context, email string) (*m.User,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 		&lastLoginAt,
+		&user.Birthdate,
 	)
 
 	if err != nil {
@@ -152,7 +155,7 @@ func (r *userRepository) GetByEmail(ctx context.Context, email string) (*m.User,
 // GetByUsername returns a user by username.
 func (r *userRepository) GetByUsername(ctx context.Context, username string) (*m.User, error) {
 	query := `
-		SELECT id, email, username, display_name, avatar_url, cover_image_url, bio, is_blocked, created_at, updated_at, last_login_at
+		SELECT id, email, username, display_name, avatar_url, cover_image_url, bio, is_blocked, created_at, updated_at, last_login_at, birthdate
 		FROM users
 		WHERE username = $1
 	`
@@ -173,6 +176,7 @@ func (r *userRepository) GetByUsername(ctx context.Context, username string) (*m
 		&user.CreatedAt,
 		&user.UpdatedAt,
 		&lastLoginAt,
+		&user.Birthdate,
 	)
 
 	if err != nil {
@@ -197,7 +201,7 @@ func (r *userRepository) GetByUsername(ctx context.Context, username string) (*m
 func (r *userRepository) Update(ctx context.Context, user *m.User) error {
 	query := `
 		UPDATE users
-		SET username = $2, display_name = $3, avatar_url = $4, cover_image_url = $5, bio = $6, updated_at = NOW()
+		SET username = $2, display_name = $3, avatar_url = $4, cover_image_url = $5, bio = $6, birthdate = $7, updated_at = NOW()
 		WHERE id = $1
 	`
 
@@ -209,7 +213,7 @@ func (r *userRepository) Update(ctx context.Context, user *m.User) error {
 		displayName = &user.DisplayName
 	}
 
-	result, err := r.pool.Exec(ctx, query, user.ID, username, displayName, user.AvatarURL, user.CoverImageURL, user.Bio)
+	result, err := r.pool.Exec(ctx, query, user.ID, username, displayName, user.AvatarURL, user.CoverImageURL, user.Bio, user.Birthdate)
 	if err != nil {
 		return fmt.Errorf("failed to update user: %w", err)
 	}
@@ -249,7 +253,7 @@ func (r *userRepository) List(ctx context.Context, limit, offset int) ([]*m.User
 
 	// Get users with pagination
 	query := `
-		SELECT id, email, username, display_name, avatar_url, cover_image_url, bio, is_blocked, created_at, updated_at, last_login_at
+		SELECT id, email, username, display_name, avatar_url, cover_image_url, bio, is_blocked, created_at, updated_at, last_login_at, birthdate
 		FROM users
 		ORDER BY created_at DESC
 		LIMIT $1 OFFSET $2
@@ -279,6 +283,7 @@ func (r *userRepository) List(ctx context.Context, limit, offset int) ([]*m.User
 			&user.CreatedAt,
 			&user.UpdatedAt,
 			&lastLoginAt,
+			&user.Birthdate,
 		)
 		if err != nil {
 			return nil, 0, fmt.Errorf("failed to scan user: %w", err)
@@ -320,3 +325,19 @@ func (r *userRepository) UpdateLastLogin(ctx context.Context, id uuid.UUID) erro
 
 	return nil
 }
+
+// UpdateBlocked sets the is_blocked flag used to suspend an account.
+func (r *userRepository) UpdateBlocked(ctx context.Context, id uuid.UUID, blocked bool) error {
+	query := `UPDATE users SET is_blocked = $2, updated_at = NOW() WHERE id = $1`
+
+	result, err := r.pool.Exec(ctx, query, id, blocked)
+	if err != nil {
+		return fmt.Errorf("failed to update user status: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("user with ID %s not found", id)
+	}
+
+	return nil
+}
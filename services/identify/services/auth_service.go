@@ -84,6 +84,11 @@ func (s *AuthService) Login(ctx context.Context, req d.LoginRequest) (*interface
 		return nil, fmt.Errorf("invalid credentials")
 	}
 
+	// Suspended accounts (e.g. by moderation) cannot sign in
+	if user.IsBlocked {
+		return nil, fmt.Errorf("account is suspended")
+	}
+
 	// Update last login timestamps
 	_ = s.userService.UpdateLastLogin(ctx, user.ID)
 	_ = s.repos.Credential.UpdateLastUsed(ctx, credential.ID)
@@ -34,6 +34,9 @@ type UserServiceInterface interface {
 	// UpdateLastLogin updates user's last login timestamp
 	UpdateLastLogin(ctx context.Context, userID uuid.UUID) error
 
+	// UpdateUserStatus blocks or unblocks a user account
+	UpdateUserStatus(ctx context.Context, userID uuid.UUID, req d.UpdateUserStatusRequest) error
+
 	// ValidateUserData validates user data before creation/update
 	ValidateUserData(req d.CreateUserRequest) error
 
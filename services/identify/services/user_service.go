@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -175,6 +176,18 @@ func (s *UserService) UpdateUser(ctx context.Context, userID uuid.UUID, req d.Up
 		user.DisplayName = *req.DisplayName
 	}
 
+	// Birthdate drives age verification, so users can set it once but not change it afterwards
+	if req.Birthdate != nil {
+		birthdate, err := ParseBirthdate(*req.Birthdate, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		if user.Birthdate != nil && !user.Birthdate.Equal(birthdate) {
+			return nil, fmt.Errorf("birthdate is already set and must not be changed")
+		}
+		user.Birthdate = &birthdate
+	}
+
 	if err := s.repos.User.Update(ctx, user); err != nil {
 		return nil, fmt.Errorf("failed to update user: %w", err)
 	}
@@ -195,6 +208,23 @@ func (s *UserService) DeleteUser(ctx context.Context, userID uuid.UUID) error {
 	return nil
 }
 
+// UpdateUserStatus blocks or unblocks a user account
+func (s *UserService) UpdateUserStatus(ctx context.Context, userID uuid.UUID, req d.UpdateUserStatusRequest) error {
+	if userID == uuid.Nil {
+		return fmt.Errorf("user ID cannot be nil")
+	}
+
+	if req.IsBlocked == nil {
+		return fmt.Errorf("is_blocked is required")
+	}
+
+	if err := s.repos.User.UpdateBlocked(ctx, userID, *req.IsBlocked); err != nil {
+		return fmt.Errorf("failed to update user status: %w", err)
+	}
+
+	return nil
+}
+
 // UpdateLastLogin updates user's last login timestamp
 func (s *UserService) UpdateLastLogin(ctx context.Context, userID uuid.UUID) error {
 	if userID == uuid.Nil {
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 	"unicode"
 
 	"github.com/go-playground/validator/v10"
@@ -175,6 +176,24 @@ func (vs *ValidationService) ValidateUsername(username string) []string {
 	return errors
 }
 
+// ParseBirthdate parses a YYYY-MM-DD birthdate and rejects dates in the future or before 1900
+func ParseBirthdate(birthdate string, today time.Time) (time.Time, error) {
+	parsed, err := time.Parse("2006-01-02", strings.TrimSpace(birthdate))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("birthdate must use the YYYY-MM-DD format")
+	}
+
+	todayDate := time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC)
+	if parsed.After(todayDate) {
+		return time.Time{}, fmt.Errorf("birthdate must not be in the future")
+	}
+	if parsed.Year() < 1900 {
+		return time.Time{}, fmt.Errorf("birthdate must not be before 1900-01-01")
+	}
+
+	return parsed, nil
+}
+
 // Custom validators for struct tags
 
 // validateStrongPassword is a custom validator for strong passwords
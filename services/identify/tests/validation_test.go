@@ -2,7 +2,9 @@ package tests
 
 import (
 	"testing"
+	"time"
 
+	m "wibusystem/pkg/common/model"
 	"wibusystem/services/identify/services"
 )
 
@@ -261,3 +263,68 @@ func TestInputSanitizer_SanitizeFileName(t *testing.T) {
 		})
 	}
 }
+
+func TestParseBirthdate(t *testing.T) {
+	today := time.Date(2026, time.October, 18, 15, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		birthdate string
+		wantErr   bool
+	}{
+		{
+			name:      "valid birthdate",
+			birthdate: "2008-10-18",
+			wantErr:   false,
+		},
+		{
+			name:      "born today",
+			birthdate: "2026-10-18",
+			wantErr:   false,
+		},
+		{
+			name:      "future birthdate",
+			birthdate: "2026-10-19",
+			wantErr:   true,
+		},
+		{
+			name:      "first day of 1900",
+			birthdate: "1900-01-01",
+			wantErr:   false,
+		},
+		{
+			name:      "before 1900",
+			birthdate: "1899-12-31",
+			wantErr:   true,
+		},
+		{
+			name:      "wrong format",
+			birthdate: "18/10/2008",
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := services.ParseBirthdate(tt.birthdate, today)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseBirthdate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUserAgeOn(t *testing.T) {
+	birthdate := time.Date(2008, time.October, 19, 0, 0, 0, 0, time.UTC)
+	user := &m.User{Birthdate: &birthdate}
+
+	if age, ok := user.AgeOn(time.Date(2026, time.October, 18, 23, 0, 0, 0, time.UTC)); !ok || age != 17 {
+		t.Errorf("AgeOn() the day before the birthday = %d, %v, want 17, true", age, ok)
+	}
+	if age, ok := user.AgeOn(time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)); !ok || age != 18 {
+		t.Errorf("AgeOn() on the birthday = %d, %v, want 18, true", age, ok)
+	}
+	if _, ok := (&m.User{}).AgeOn(time.Now()); ok {
+		t.Errorf("AgeOn() without a birthdate should not be ok")
+	}
+}